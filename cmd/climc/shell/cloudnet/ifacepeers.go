@@ -0,0 +1,45 @@
+// Copyright 2019 Yunion
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cloudnet
+
+import (
+	"yunion.io/x/onecloud/pkg/mcclient"
+	modules "yunion.io/x/onecloud/pkg/mcclient/modules/cloudnet"
+	base_options "yunion.io/x/onecloud/pkg/mcclient/options"
+	options "yunion.io/x/onecloud/pkg/mcclient/options/cloudnet"
+)
+
+func init() {
+	R(&options.IfacePeerGetOptions{}, "iface-peer-show", "Show iface peer", func(s *mcclient.ClientSession, opts *options.IfacePeerGetOptions) error {
+		ifacePeer, err := modules.IfacePeers.Get(s, opts.ID, nil)
+		if err != nil {
+			return err
+		}
+		printObject(ifacePeer)
+		return nil
+	})
+	R(&options.IfacePeerListOptions{}, "iface-peer-list", "List iface peers", func(s *mcclient.ClientSession, opts *options.IfacePeerListOptions) error {
+		params, err := base_options.ListStructToParams(opts)
+		if err != nil {
+			return err
+		}
+		result, err := modules.IfacePeers.List(s, params)
+		if err != nil {
+			return err
+		}
+		printList(result, modules.IfacePeers.GetColumns(s))
+		return nil
+	})
+}
@@ -131,4 +131,16 @@ func init() {
 		printObject(router)
 		return nil
 	})
+	R(&options.RouterActionRotateWgKeysOptions{}, "router-rotate-wg-keys", "Router rotate wireguard iface keys", func(s *mcclient.ClientSession, opts *options.RouterActionRotateWgKeysOptions) error {
+		params, err := base_options.StructToParams(opts)
+		if err != nil {
+			return err
+		}
+		router, err := modules.Routers.PerformAction(s, opts.ID, "rotate-wg-keys", params)
+		if err != nil {
+			return err
+		}
+		printObject(router)
+		return nil
+	})
 }
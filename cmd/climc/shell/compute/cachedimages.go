@@ -15,6 +15,12 @@
 package compute
 
 import (
+	"strings"
+
+	"yunion.io/x/jsonutils"
+
+	"yunion.io/x/onecloud/pkg/apis"
+	api "yunion.io/x/onecloud/pkg/apis/compute"
 	"yunion.io/x/onecloud/pkg/mcclient"
 	"yunion.io/x/onecloud/pkg/mcclient/modules"
 	"yunion.io/x/onecloud/pkg/mcclient/options"
@@ -73,4 +79,42 @@ func init() {
 		printBatchResults(results, modules.Cachedimages.GetColumns(s))
 		return nil
 	})
+
+	parseTags := func(tags []string) []apis.STag {
+		ret := make([]apis.STag, 0, len(tags))
+		for _, tag := range tags {
+			parts := strings.SplitN(tag, "=", 2)
+			t := apis.STag{Key: parts[0]}
+			if len(parts) == 2 {
+				t.Value = parts[1]
+			}
+			ret = append(ret, t)
+		}
+		return ret
+	}
+
+	type CachedImagePrewarmOptions struct {
+		Image    []string `help:"ID or Name of images to prewarm"`
+		ImageTag []string `help:"Prewarm images with the tag, e.g. user:os=linux"`
+		Format   string   `help:"Image format" choices:"qcow2|raw|vmdk|iso|vhd"`
+		Zone     string   `help:"Prewarm to hosts of the zone"`
+		Schedtag string   `help:"Prewarm to hosts with the schedtag"`
+		HostTag  []string `help:"Prewarm to hosts with the tag, e.g. user:rack=r1"`
+	}
+	R(&CachedImagePrewarmOptions{}, "cached-image-prewarm", "Prewarm images to the image cache of hosts", func(s *mcclient.ClientSession, args *CachedImagePrewarmOptions) error {
+		input := api.CachedimagePrewarmInput{
+			Image:      args.Image,
+			ImageTags:  parseTags(args.ImageTag),
+			Format:     args.Format,
+			ZoneId:     args.Zone,
+			SchedtagId: args.Schedtag,
+			HostTags:   parseTags(args.HostTag),
+		}
+		result, err := modules.Cachedimages.PerformClassAction(s, "prewarm", jsonutils.Marshal(input))
+		if err != nil {
+			return err
+		}
+		printObject(result)
+		return nil
+	})
 }
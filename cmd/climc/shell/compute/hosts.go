@@ -16,11 +16,14 @@ package compute
 
 import (
 	"fmt"
+	"strings"
 
 	"yunion.io/x/jsonutils"
 	"yunion.io/x/log"
 
+	api "yunion.io/x/onecloud/pkg/apis/compute"
 	"yunion.io/x/onecloud/pkg/mcclient"
+	"yunion.io/x/onecloud/pkg/mcclient/modulebase"
 	"yunion.io/x/onecloud/pkg/mcclient/modules"
 	"yunion.io/x/onecloud/pkg/mcclient/options"
 	"yunion.io/x/onecloud/pkg/util/fileutils2"
@@ -708,6 +711,73 @@ func init() {
 		return nil
 	})
 
+	type HostBiosSettingsOptions struct {
+		ID          string   `help:"ID or name of host" json:"-"`
+		Attr        []string `help:"BIOS attribute, e.g. BootMode=Uefi" metavar:"KEY=VALUE" json:"-"`
+		Reboot      bool     `help:"Reboot host to apply settings" json:"reboot"`
+		ForceReboot bool     `help:"Allow to reboot running host" json:"force_reboot"`
+	}
+	R(&HostBiosSettingsOptions{}, "host-bios-settings", "Set BIOS attributes of host via Redfish", func(s *mcclient.ClientSession, args *HostBiosSettingsOptions) error {
+		attrs := jsonutils.NewDict()
+		for _, attr := range args.Attr {
+			pos := strings.Index(attr, "=")
+			if pos <= 0 {
+				return fmt.Errorf("invalid attribute %s, should be KEY=VALUE", attr)
+			}
+			attrs.Add(jsonutils.NewString(attr[pos+1:]), attr[:pos])
+		}
+		params := jsonutils.Marshal(args).(*jsonutils.JSONDict)
+		params.Add(attrs, "attributes")
+		result, err := modules.Hosts.PerformAction(s, args.ID, "bios-settings", params)
+		if err != nil {
+			return err
+		}
+		printObject(result)
+		return nil
+	})
+
+	type HostFirmwareUpdateOptions struct {
+		ID          string   `help:"ID or name of host" json:"-"`
+		ImageUri    string   `help:"URL of firmware image accessible by BMC" json:"image_uri"`
+		Image       string   `help:"ID or name of firmware image" json:"image"`
+		Target      []string `help:"Firmware inventory path to update" json:"targets"`
+		Reboot      bool     `help:"Reboot host after update" json:"reboot"`
+		ForceReboot bool     `help:"Allow to reboot running host" json:"force_reboot"`
+	}
+	R(&HostFirmwareUpdateOptions{}, "host-firmware-update", "Update firmware of host via Redfish", func(s *mcclient.ClientSession, args *HostFirmwareUpdateOptions) error {
+		params := jsonutils.Marshal(args)
+		result, err := modules.Hosts.PerformAction(s, args.ID, "firmware-update", params)
+		if err != nil {
+			return err
+		}
+		printObject(result)
+		return nil
+	})
+
+	type HostFirmwareInventoryOptions struct {
+		ID string `help:"ID or name of host"`
+	}
+	R(&HostFirmwareInventoryOptions{}, "host-firmware-inventory", "Show firmware inventory of host", func(s *mcclient.ClientSession, args *HostFirmwareInventoryOptions) error {
+		meta, err := modules.Hosts.GetMetadata(s, args.ID, nil)
+		if err != nil {
+			return err
+		}
+		invStr, _ := meta.GetString(api.HOST_METADATA_FIRMWARE_INVENTORY)
+		if len(invStr) == 0 {
+			return fmt.Errorf("no firmware inventory collected")
+		}
+		inv, err := jsonutils.ParseString(invStr)
+		if err != nil {
+			return err
+		}
+		fws, err := inv.GetArray()
+		if err != nil {
+			return err
+		}
+		printList(&modulebase.ListResult{Data: fws, Total: len(fws)}, []string{"Id", "Name", "Version", "Updateable", "Health"})
+		return nil
+	})
+
 	type HostSSHLoginOptions struct {
 		ID   string `help:"ID or name of host"`
 		Port int    `help:"SSH service port" default:"22"`
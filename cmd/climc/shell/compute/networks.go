@@ -64,6 +64,10 @@ func init() {
 		ENDIP       string `help:"End of IPv4 address rnage"`
 		NETMASK     int64  `help:"Length of network mask"`
 		Gateway     string `help:"Default gateway"`
+		StartIp6    string `help:"Start of IPv6 address range"`
+		EndIp6      string `help:"End of IPv6 address range"`
+		NetMask6    int64  `help:"Length of IPv6 prefix"`
+		Gateway6    string `help:"Default IPv6 gateway"`
 		VlanId      int64  `help:"Vlan ID" default:"1"`
 		IfnameHint  string `help:"Hint for ifname generation"`
 		AllocPolicy string `help:"Address allocation policy" choices:"none|stepdown|stepup|random"`
@@ -81,6 +85,14 @@ func init() {
 		if len(args.Gateway) > 0 {
 			params.Add(jsonutils.NewString(args.Gateway), "guest_gateway")
 		}
+		if len(args.StartIp6) > 0 {
+			params.Add(jsonutils.NewString(args.StartIp6), "guest_ip6_start")
+			params.Add(jsonutils.NewString(args.EndIp6), "guest_ip6_end")
+			params.Add(jsonutils.NewInt(args.NetMask6), "guest_ip6_mask")
+		}
+		if len(args.Gateway6) > 0 {
+			params.Add(jsonutils.NewString(args.Gateway6), "guest_gateway6")
+		}
 		if args.VlanId > 0 {
 			params.Add(jsonutils.NewInt(args.VlanId), "vlan_id")
 		}
@@ -116,6 +128,7 @@ func init() {
 		Zone    string `help:"ID or Name of zone in which the network is created"`
 		NAME    string `help:"Name of new network"`
 		PREFIX  string `help:"Start of IPv4 address range"`
+		Prefix6 string `help:"IPv6 prefix, e.g. fd00:1:2:3::/64"`
 		BgpType string `help:"Internet service provider name" positional:"false"`
 		Desc    string `help:"Description" metavar:"DESCRIPTION"`
 	}
@@ -123,6 +136,9 @@ func init() {
 		params := jsonutils.NewDict()
 		params.Add(jsonutils.NewString(args.NAME), "name")
 		params.Add(jsonutils.NewString(args.PREFIX), "guest_ip_prefix")
+		if len(args.Prefix6) > 0 {
+			params.Add(jsonutils.NewString(args.Prefix6), "guest_ip6_prefix")
+		}
 		if len(args.BgpType) > 0 {
 			params.Add(jsonutils.NewString(args.BgpType), "bgp_type")
 		}
@@ -142,4 +142,21 @@ func init() {
 		printObject(ret)
 		return nil
 	})
+
+	type ScalingGroupSetDesiredCapacityOptions struct {
+		ID              string `help:"ScalingGroup ID or Name"`
+		DESIREDCAPACITY int    `help:"Desired instance number" json:"desired_capacity"`
+	}
+	R(&ScalingGroupSetDesiredCapacityOptions{}, "scaling-group-set-desired-capacity", "Set desired instance number of ScalingGroup",
+		func(s *mcclient.ClientSession, args *ScalingGroupSetDesiredCapacityOptions) error {
+			params := jsonutils.NewDict()
+			params.Set("desired_capacity", jsonutils.NewInt(int64(args.DESIREDCAPACITY)))
+			ret, err := modules.ScalingGroup.PerformAction(s, args.ID, "set-desired-capacity", params)
+			if err != nil {
+				return err
+			}
+			printObject(ret)
+			return nil
+		},
+	)
 }
@@ -22,6 +22,7 @@ import (
 
 	apis "yunion.io/x/onecloud/pkg/apis/compute"
 	"yunion.io/x/onecloud/pkg/mcclient"
+	"yunion.io/x/onecloud/pkg/mcclient/modulebase"
 	"yunion.io/x/onecloud/pkg/mcclient/modules"
 	"yunion.io/x/onecloud/pkg/mcclient/options"
 )
@@ -66,10 +67,11 @@ func init() {
 		Timer
 		CycleTimer
 
-		ResourceType string   `help:"resource type"`
-		Operation    string   `help:"operation"`
-		LabelType    string   `help:"label type"`
-		Labels       []string `help:"labels"`
+		ResourceType    string   `help:"resource type" choices:"server|disk|dbinstance|elasticcache|scalinggroup|loadbalancer"`
+		Operation       string   `help:"operation, see scheduledtask-operations"`
+		OperationParams string   `help:"operation params in json, e.g. '{\"size\": 20480}'"`
+		LabelType       string   `help:"label type" choices:"id|tag|project"`
+		Labels          []string `help:"labels"`
 	}
 	R(&ScheduledTaskCreateOptions{}, "scheduledtask-create", "Create Scheduled Task", func(s *mcclient.ClientSession, args *ScheduledTaskCreateOptions) error {
 		formatStr := "2006-01-02 15:04:05"
@@ -112,6 +114,17 @@ func init() {
 			LabelType:    args.LabelType,
 			Labels:       args.Labels,
 		}
+		if len(args.OperationParams) > 0 {
+			params, err := jsonutils.ParseString(args.OperationParams)
+			if err != nil {
+				return fmt.Errorf("invalid json for 'operation_params': %v", err)
+			}
+			dict, ok := params.(*jsonutils.JSONDict)
+			if !ok {
+				return fmt.Errorf("'operation_params' should be a json object")
+			}
+			stCreateInput.OperationParams = dict
+		}
 		stCreateInput.Name = args.NAME
 		ret, err := modules.ScheduledTask.Create(s, jsonutils.Marshal(stCreateInput))
 		if err != nil {
@@ -121,6 +134,25 @@ func init() {
 		return nil
 	})
 
+	type ScheduledTaskOperationsOptions struct {
+		ResourceType string `help:"resource type" json:"resource_type"`
+	}
+	R(&ScheduledTaskOperationsOptions{}, "scheduledtask-operations", "List supported operations of Scheduled Task",
+		func(s *mcclient.ClientSession, args *ScheduledTaskOperationsOptions) error {
+			params := jsonutils.NewDict()
+			if len(args.ResourceType) > 0 {
+				params.Set("resource_type", jsonutils.NewString(args.ResourceType))
+			}
+			ret, err := modules.ScheduledTask.Get(s, "operations", params)
+			if err != nil {
+				return err
+			}
+			opers, _ := ret.GetArray("operations")
+			printList(&modulebase.ListResult{Data: opers, Total: len(opers)}, []string{"resource_type", "operation", "required_params"})
+			return nil
+		},
+	)
+
 	type ScheduledTaskEnableOptions struct {
 		ID string `help:"ScheduledTask ID or Name"`
 	}
@@ -321,6 +321,55 @@ func init() {
 		return nil
 	})
 
+	type CredentialEncryptKeyOptions struct {
+		Project       string `help:"Project"`
+		ProjectDomain string `help:"domain of project"`
+	}
+	type CredentialEncryptKeyCreateOptions struct {
+		CredentialEncryptKeyOptions
+		Name string `help:"name of the encryption key"`
+	}
+	R(&CredentialEncryptKeyCreateOptions{}, "credential-create-enc-key", "Create a project scoped encryption master key", func(s *mcclient.ClientSession, args *CredentialEncryptKeyCreateOptions) error {
+		var pid string
+		var err error
+		if len(args.Project) > 0 {
+			pid, err = modules.Projects.FetchId(s, args.Project, args.ProjectDomain)
+			if err != nil {
+				return err
+			}
+		}
+		key, err := modules.Credentials.CreateEncryptKey(s, pid, args.Name)
+		if err != nil {
+			return err
+		}
+		key.Key = ""
+		printObject(jsonutils.Marshal(&key))
+		return nil
+	})
+
+	R(&CredentialEncryptKeyOptions{}, "credential-get-enc-keys", "Get encryption master keys of project", func(s *mcclient.ClientSession, args *CredentialEncryptKeyOptions) error {
+		var pid string
+		var err error
+		if len(args.Project) > 0 {
+			pid, err = modules.Projects.FetchId(s, args.Project, args.ProjectDomain)
+			if err != nil {
+				return err
+			}
+		}
+		keys, err := modules.Credentials.GetEncryptKeys(s, pid)
+		if err != nil {
+			return err
+		}
+		result := modulebase.ListResult{}
+		result.Data = make([]jsonutils.JSONObject, len(keys))
+		for i := range keys {
+			keys[i].Key = ""
+			result.Data[i] = jsonutils.Marshal(keys[i])
+		}
+		printList(&result, nil)
+		return nil
+	})
+
 	type CredentialDeleteOptions struct {
 		ID string `help:"ID of credentail"`
 	}
@@ -87,6 +87,7 @@ func init() {
 		Disabled bool   `help:"Set the domain disabled"`
 
 		Displayname string `help:"display name"`
+		MfaMethod   string `help:"Second factor authentication method required by the domain" choices:"none|totp|webauthn"`
 	}
 	R(&DomainCreateOptions{}, "domain-create", "Create a new domain", func(s *mcclient.ClientSession, args *DomainCreateOptions) error {
 		params := jsonutils.NewDict()
@@ -102,6 +103,9 @@ func init() {
 		if len(args.Displayname) > 0 {
 			params.Add(jsonutils.NewString(args.Displayname), "displayname")
 		}
+		if len(args.MfaMethod) > 0 {
+			params.Add(jsonutils.NewString(args.MfaMethod), "mfa_method")
+		}
 		result, err := modules.Domains.Create(s, params)
 		if err != nil {
 			return err
@@ -119,6 +123,7 @@ func init() {
 		Driver   string `help:"Set the domain Driver"`
 
 		Displayname string `help:"display name"`
+		MfaMethod   string `help:"Second factor authentication method required by the domain" choices:"none|totp|webauthn"`
 	}
 	R(&DomainUpdateOptions{}, "domain-update", "Update a domain", func(s *mcclient.ClientSession, args *DomainUpdateOptions) error {
 		obj, err := modules.Domains.Get(s, args.ID, nil)
@@ -148,6 +153,9 @@ func init() {
 		if len(args.Displayname) > 0 {
 			params.Add(jsonutils.NewString(args.Displayname), "displayname")
 		}
+		if len(args.MfaMethod) > 0 {
+			params.Add(jsonutils.NewString(args.MfaMethod), "mfa_method")
+		}
 		result, err := modules.Domains.Patch(s, objId, params)
 		if err != nil {
 			return err
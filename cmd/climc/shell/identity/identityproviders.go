@@ -320,6 +320,44 @@ func init() {
 		return nil
 	})
 
+	type IdentityProviderCreateSCIMOptions struct {
+		NAME string `help:"name of identity provider" json:"-"`
+
+		TargetDomain string `help:"target domain without creating new domain" json:"-"`
+
+		api.SSCIMIdpConfigOptions
+	}
+	R(&IdentityProviderCreateSCIMOptions{}, "idp-create-scim", "Create an identity provider with SCIM 2.0 provisioning driver", func(s *mcclient.ClientSession, args *IdentityProviderCreateSCIMOptions) error {
+		params := jsonutils.NewDict()
+		params.Add(jsonutils.NewString(args.NAME), "name")
+		if len(args.TargetDomain) > 0 {
+			params.Add(jsonutils.NewString(args.TargetDomain), "target_domain")
+		}
+		params.Add(jsonutils.NewString(api.IdentityDriverSCIM), "driver")
+		params.Add(jsonutils.Marshal(args), "config", "scim")
+		idp, err := modules.IdentityProviders.Create(s, params)
+		if err != nil {
+			return err
+		}
+		printObject(idp)
+		return nil
+	})
+
+	type IdentityProviderConfigSCIMOptions struct {
+		ID string `help:"ID of idp to config" json:"-"`
+		api.SSCIMIdpConfigOptions
+	}
+	R(&IdentityProviderConfigSCIMOptions{}, "idp-config-scim", "Config an Identity provider with SCIM 2.0 provisioning driver", func(s *mcclient.ClientSession, args *IdentityProviderConfigSCIMOptions) error {
+		config := jsonutils.NewDict()
+		config.Add(jsonutils.Marshal(args), "config", "scim")
+		nconf, err := modules.IdentityProviders.PerformAction(s, args.ID, "config", config)
+		if err != nil {
+			return err
+		}
+		fmt.Println(nconf.PrettyString())
+		return nil
+	})
+
 	type IdentityProviderConfigEditOptions struct {
 		IDP string `help:"identity provider name or ID"`
 	}
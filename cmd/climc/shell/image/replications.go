@@ -0,0 +1,39 @@
+// Copyright 2019 Yunion
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package image
+
+import (
+	"yunion.io/x/onecloud/cmd/climc/shell"
+	"yunion.io/x/onecloud/pkg/mcclient/modules"
+	options "yunion.io/x/onecloud/pkg/mcclient/options/image"
+)
+
+func init() {
+	cmd := shell.NewResourceCmd(&modules.ImageReplicationPolicies).WithKeyword("image-replication-policy")
+	cmd.List(new(options.ImageReplicationPolicyListOptions))
+	cmd.Create(new(options.ImageReplicationPolicyCreateOptions))
+	cmd.Update(new(options.ImageReplicationPolicyUpdateOptions))
+	cmd.Show(new(options.ImageReplicationPolicyIdOptions))
+	cmd.Delete(new(options.ImageReplicationPolicyIdOptions))
+	cmd.Perform("enable", new(options.ImageReplicationPolicyIdOptions))
+	cmd.Perform("disable", new(options.ImageReplicationPolicyIdOptions))
+	cmd.Perform("sync", new(options.ImageReplicationPolicyIdOptions))
+
+	replicationCmd := shell.NewResourceCmd(&modules.ImageReplications).WithKeyword("image-replication")
+	replicationCmd.List(new(options.ImageReplicationListOptions))
+	replicationCmd.Show(new(options.ImageReplicationIdOptions))
+	replicationCmd.Delete(new(options.ImageReplicationIdOptions))
+	replicationCmd.Perform("retry", new(options.ImageReplicationIdOptions))
+}
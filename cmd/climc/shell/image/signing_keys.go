@@ -0,0 +1,35 @@
+// Copyright 2019 Yunion
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package image
+
+import (
+	"yunion.io/x/onecloud/cmd/climc/shell"
+	"yunion.io/x/onecloud/pkg/mcclient/modules"
+	options "yunion.io/x/onecloud/pkg/mcclient/options/image"
+)
+
+func init() {
+	cmd := shell.NewResourceCmd(&modules.ImageSigningKeys).WithKeyword("image-signing-key")
+	cmd.List(new(options.ImageSigningKeyListOptions))
+	cmd.Create(new(options.ImageSigningKeyCreateOptions))
+	cmd.Update(new(options.ImageSigningKeyUpdateOptions))
+	cmd.Show(new(options.ImageSigningKeyIdOptions))
+	cmd.Delete(new(options.ImageSigningKeyIdOptions))
+	cmd.Perform("enable", new(options.ImageSigningKeyIdOptions))
+	cmd.Perform("disable", new(options.ImageSigningKeyIdOptions))
+
+	imageCmd := shell.NewResourceCmd(&modules.Images).WithKeyword("image")
+	imageCmd.Perform("sign", new(options.ImageSignOptions))
+}
@@ -0,0 +1,38 @@
+// Copyright 2019 Yunion
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package logger
+
+import (
+	"yunion.io/x/onecloud/cmd/climc/shell"
+	"yunion.io/x/onecloud/pkg/mcclient/modules"
+	options "yunion.io/x/onecloud/pkg/mcclient/options/logger"
+)
+
+func init() {
+	cmd := shell.NewResourceCmd(&modules.WebhookSubscriptions).WithKeyword("webhook-subscription")
+	cmd.List(new(options.WebhookSubscriptionListOptions))
+	cmd.Create(new(options.WebhookSubscriptionCreateOptions))
+	cmd.Update(new(options.WebhookSubscriptionUpdateOptions))
+	cmd.Show(new(options.WebhookSubscriptionIdOptions))
+	cmd.Delete(new(options.WebhookSubscriptionIdOptions))
+	cmd.Perform("enable", new(options.WebhookSubscriptionIdOptions))
+	cmd.Perform("disable", new(options.WebhookSubscriptionIdOptions))
+
+	deliveryCmd := shell.NewResourceCmd(&modules.WebhookDeliveries).WithKeyword("webhook-delivery")
+	deliveryCmd.List(new(options.WebhookDeliveryListOptions))
+	deliveryCmd.Show(new(options.WebhookDeliveryIdOptions))
+	deliveryCmd.Delete(new(options.WebhookDeliveryIdOptions))
+	deliveryCmd.Perform("redeliver", new(options.WebhookDeliveryIdOptions))
+}
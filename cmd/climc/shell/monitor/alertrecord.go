@@ -25,4 +25,7 @@ func init() {
 	cmd.List(new(options.AlertRecordListOptions))
 	cmd.Show(new(options.AlertRecordShowOptions))
 	cmd.Get("", new(options.AlertRecordTotalOptions))
+	cmd.Perform("ack", new(options.AlertRecordAckOptions))
+	cmd.Perform("assign", new(options.AlertRecordAssignOptions))
+	cmd.Perform("resolve", new(options.AlertRecordAckOptions))
 }
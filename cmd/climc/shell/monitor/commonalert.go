@@ -28,4 +28,5 @@ func init() {
 	cmd.Perform("disable", &options.CommonAlertShowOptions{})
 	cmd.BatchDelete(new(options.CommonAlertDeleteOptions))
 	cmd.Perform("config", &options.CommonAlertUpdateOptions{})
+	cmd.UpdateWithKeyword("set-escalation-policy", &options.CommonAlertSetEscalationPolicyOptions{})
 }
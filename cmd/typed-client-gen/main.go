@@ -0,0 +1,126 @@
+// Copyright 2019 Yunion
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"flag"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"yunion.io/x/log"
+
+	"yunion.io/x/onecloud/pkg/appsrv"
+	"yunion.io/x/onecloud/pkg/cloudcommon/db"
+	compute "yunion.io/x/onecloud/pkg/compute/service"
+	image "yunion.io/x/onecloud/pkg/image/service"
+	identity "yunion.io/x/onecloud/pkg/keystone/service"
+	"yunion.io/x/onecloud/pkg/mcclient/typed/gen"
+	monitor "yunion.io/x/onecloud/pkg/monitor/service"
+)
+
+type service struct {
+	initHandlers func(app *appsrv.Application)
+	constructor  string
+	apiPkgPath   string
+}
+
+var services = map[string]service{
+	"compute": {
+		initHandlers: compute.InitHandlers,
+		constructor:  "NewComputeManager",
+		apiPkgPath:   gen.ApisPkgPath + "/compute",
+	},
+	"image": {
+		initHandlers: image.InitHandlers,
+		constructor:  "NewImageManager",
+		apiPkgPath:   gen.ApisPkgPath + "/image",
+	},
+	"identity": {
+		initHandlers: identity.InitHandlers,
+		constructor:  "NewIdentityV3Manager",
+		apiPkgPath:   gen.ApisPkgPath + "/identity",
+	},
+	"monitor": {
+		initHandlers: monitor.InitHandlers,
+		constructor:  "NewMonitorV2Manager",
+		apiPkgPath:   gen.ApisPkgPath + "/monitor",
+	},
+}
+
+func serviceNames() []string {
+	names := make([]string, 0, len(services))
+	for name := range services {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+func main() {
+	var (
+		svcName    = flag.String("service", "compute", fmt.Sprintf("service to generate, one of %s", strings.Join(serviceNames(), "|")))
+		modulesDir = flag.String("modules-dir", "pkg/mcclient/modules", "directory of the mcclient modules")
+		outputDir  = flag.String("output-dir", "", "directory of the generated package, default to pkg/mcclient/typed/<service>")
+		headerFile = flag.String("header-file", "scripts/copyright.txt", "file put at the top of the generated code")
+	)
+	flag.Parse()
+
+	svc, ok := services[*svcName]
+	if !ok {
+		log.Fatalf("unknown service %q, one of %s", *svcName, strings.Join(serviceNames(), "|"))
+	}
+	if len(*outputDir) == 0 {
+		*outputDir = filepath.Join("pkg/mcclient/typed", *svcName)
+	}
+
+	modules, err := gen.ParseModuleVars(*modulesDir, svc.constructor)
+	if err != nil {
+		log.Fatalf("parse modules: %v", err)
+	}
+
+	app := appsrv.NewApplication("typed-client-gen", 1, false)
+	svc.initHandlers(app)
+	specs := db.GetDispatchedModelApiSpecs(app)
+
+	header := ""
+	if len(*headerFile) > 0 {
+		content, err := ioutil.ReadFile(*headerFile)
+		if err != nil {
+			log.Fatalf("read header file: %v", err)
+		}
+		header = string(content)
+	}
+	g := &gen.SGenerator{
+		PkgName:    *svcName,
+		ApiPkgPath: svc.apiPkgPath,
+		Header:     header,
+	}
+	output, err := g.Generate(specs, modules)
+	if err != nil {
+		log.Fatalf("generate: %v", err)
+	}
+	if err := os.MkdirAll(*outputDir, 0755); err != nil {
+		log.Fatalf("mkdir %s: %v", *outputDir, err)
+	}
+	filename := filepath.Join(*outputDir, "zz_generated.client.go")
+	if err := ioutil.WriteFile(filename, output, 0644); err != nil {
+		log.Fatalf("write %s: %v", filename, err)
+	}
+	log.Infof("%s generated", filename)
+}
@@ -38,6 +38,8 @@ import (
 func InitHandlers(app *appsrv.Application) {
 	db.InitAllManagers()
 
+	db.AddOpenApiHandler("", app)
+
 	db.RegisterModelManager(db.OpsLog)
 	db.RegisterModelManager(db.Metadata)
 	db.RegisterModelManager(db.UserCacheManager)
@@ -38,6 +38,7 @@ import (
 	"yunion.io/x/onecloud/pkg/httperrors"
 	"yunion.io/x/onecloud/pkg/mcclient"
 	"yunion.io/x/onecloud/pkg/mcclient/auth"
+	"yunion.io/x/onecloud/pkg/mcclient/modules"
 )
 
 const (
@@ -257,17 +258,15 @@ func (t *SAuthToken) updateRetryCount() {
 	}
 }
 
-func (t *SAuthToken) VerifyTotpPasscode(s *mcclient.ClientSession, uid, passcode string) error {
+// verifySecondFactor runs verify under the retry limit of the second factor
+// and marks the token verified on success
+func (t *SAuthToken) verifySecondFactor(verify func() error) error {
 	if t.lockExpireTime > uint32(time.Now().Unix()) {
 		return errors.Wrapf(httperrors.ErrResourceBusy, "locked, retry after %d seconds", t.lockExpireTime-uint32(time.Now().Unix()))
 	}
 
-	secret, err := fetchUserTotpCredSecret(s, uid)
-	if err != nil {
-		return errors.Wrap(err, "fetch totp secrets error")
-	}
-
-	if totp.Validate(passcode, secret) {
+	err := verify()
+	if err == nil {
 		t.verifyTotp = true
 		t.lockExpireTime = 0
 		t.retryCount = 0
@@ -275,7 +274,36 @@ func (t *SAuthToken) VerifyTotpPasscode(s *mcclient.ClientSession, uid, passcode
 	}
 
 	t.updateRetryCount()
-	return errors.Wrap(httperrors.ErrInvalidCredential, "invalid passcode")
+	return err
+}
+
+func (t *SAuthToken) VerifyTotpPasscode(s *mcclient.ClientSession, uid, passcode string) error {
+	return t.verifySecondFactor(func() error {
+		secret, err := fetchUserTotpCredSecret(s, uid)
+		if err != nil {
+			return errors.Wrap(err, "fetch totp secrets error")
+		}
+		if !totp.Validate(passcode, secret) {
+			return errors.Wrap(httperrors.ErrInvalidCredential, "invalid passcode")
+		}
+		return nil
+	})
+}
+
+// VerifyRecoveryCode consumes a one-time recovery code as the second factor
+func (t *SAuthToken) VerifyRecoveryCode(s *mcclient.ClientSession, uid, code string) error {
+	return t.verifySecondFactor(func() error {
+		err := modules.Credentials.ConsumeRecoveryCode(s, uid, code)
+		if err != nil {
+			return errors.Wrap(err, "ConsumeRecoveryCode")
+		}
+		return nil
+	})
+}
+
+// VerifyWebAuthn verifies a WebAuthn assertion as the second factor
+func (t *SAuthToken) VerifyWebAuthn(verify func() error) error {
+	return t.verifySecondFactor(verify)
 }
 
 func SignJWT(t jwt.Token) (string, error) {
@@ -17,6 +17,10 @@ package clientman
 import (
 	"reflect"
 	"testing"
+
+	"yunion.io/x/pkg/errors"
+
+	"yunion.io/x/onecloud/pkg/httperrors"
 )
 
 func TestEncoeDecode(t *testing.T) {
@@ -54,3 +58,29 @@ func TestEncoeDecode(t *testing.T) {
 		t.Fatalf("token2 != token")
 	}
 }
+
+func TestVerifySecondFactor(t *testing.T) {
+	token := NewAuthToken("token", true, true, false)
+	fail := func() error { return errors.Error("invalid") }
+	for i := 0; i < MAX_OTP_RETRY; i++ {
+		if err := token.verifySecondFactor(fail); errors.Cause(err) != errors.Error("invalid") {
+			t.Fatalf("attempt %d: unexpected error %v", i, err)
+		}
+	}
+	err := token.verifySecondFactor(func() error { return nil })
+	if errors.Cause(err) != httperrors.ErrResourceBusy {
+		t.Fatalf("verify should be locked after %d failures, got %v", MAX_OTP_RETRY, err)
+	}
+	if token.verifyTotp {
+		t.Fatalf("locked token should not be verified")
+	}
+
+	token = NewAuthToken("token", true, true, false)
+	token.verifySecondFactor(fail)
+	if err := token.verifySecondFactor(func() error { return nil }); err != nil {
+		t.Fatalf("verify fail %s", err)
+	}
+	if !token.verifyTotp || token.retryCount != 0 {
+		t.Fatalf("token should be verified and retry count reset")
+	}
+}
@@ -0,0 +1,106 @@
+// Copyright 2019 Yunion
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package clientman
+
+import (
+	"time"
+
+	"yunion.io/x/jsonutils"
+	"yunion.io/x/pkg/errors"
+
+	"yunion.io/x/onecloud/pkg/mcclient"
+	"yunion.io/x/onecloud/pkg/mcclient/modules"
+)
+
+// SWebAuthnSession keeps the challenge of an ongoing WebAuthn ceremony in
+// a cookie between the options request and the response, it is encrypted
+// the same way as SAuthToken
+type SWebAuthnSession struct {
+	UserId    string `json:"user_id"`
+	Ceremony  string `json:"ceremony"`
+	Challenge string `json:"challenge"`
+	Expires   int64  `json:"expires"`
+}
+
+func NewWebAuthnSession(userId string, ceremony string, challenge string, timeout time.Duration) *SWebAuthnSession {
+	return &SWebAuthnSession{
+		UserId:    userId,
+		Ceremony:  ceremony,
+		Challenge: challenge,
+		Expires:   time.Now().Add(timeout).Unix(),
+	}
+}
+
+func (s SWebAuthnSession) Encode() string {
+	encBytes := []byte(jsonutils.Marshal(s).String())
+	if privateKey != nil {
+		return EncryptString(encBytes)
+	} else {
+		return compressString(encBytes)
+	}
+}
+
+func DecodeWebAuthnSession(str string) (*SWebAuthnSession, error) {
+	var sBytes []byte
+	var err error
+	if privateKey != nil {
+		sBytes, err = DecryptString(str)
+		if err != nil {
+			return nil, errors.Wrap(err, "decryptString")
+		}
+	} else {
+		sBytes, err = decompressString(str)
+		if err != nil {
+			return nil, errors.Wrap(err, "decompressString")
+		}
+	}
+	obj, err := jsonutils.Parse(sBytes)
+	if err != nil {
+		return nil, errors.Wrap(err, "jsonutils.Parse")
+	}
+	ret := &SWebAuthnSession{}
+	err = obj.Unmarshal(ret)
+	if err != nil {
+		return nil, errors.Wrap(err, "Unmarshal")
+	}
+	return ret, nil
+}
+
+// Validate checks that the session is of the ceremony of the user and not
+// expired
+func (s SWebAuthnSession) Validate(userId string, ceremony string) error {
+	if s.UserId != userId || s.Ceremony != ceremony {
+		return errors.Wrap(errors.ErrInvalidStatus, "session mismatch")
+	}
+	if s.Expires < time.Now().Unix() {
+		return errors.Wrap(errors.ErrTimeout, "session expired")
+	}
+	return nil
+}
+
+// Consume validates the session and marks its challenge used in keystone
+// till the session expires, so that a captured session cookie together with
+// its assertion can not be replayed to any apigateway instance
+func (s SWebAuthnSession) Consume(session *mcclient.ClientSession, userId string, ceremony string) error {
+	err := s.Validate(userId, ceremony)
+	if err != nil {
+		return err
+	}
+	err = modules.Credentials.ConsumeWebAuthnChallenge(session, userId, s.Challenge, s.Expires)
+	if err != nil {
+		return errors.Wrap(err, "ConsumeWebAuthnChallenge")
+	}
+	return nil
+}
@@ -0,0 +1,48 @@
+// Copyright 2019 Yunion
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package clientman
+
+import (
+	"testing"
+	"time"
+)
+
+func TestWebAuthnSession(t *testing.T) {
+	SetupTest()
+	session := NewWebAuthnSession("user-id", "webauthn.get", "challenge", time.Minute)
+	decoded, err := DecodeWebAuthnSession(session.Encode())
+	if err != nil {
+		t.Fatalf("DecodeWebAuthnSession fail %s", err)
+	}
+	if *decoded != *session {
+		t.Fatalf("decoded %#v != %#v", decoded, session)
+	}
+	if err := decoded.Validate("user-id", "webauthn.get"); err != nil {
+		t.Errorf("Validate fail %s", err)
+	}
+	if err := decoded.Validate("other-user", "webauthn.get"); err == nil {
+		t.Errorf("session of other user should fail")
+	}
+	if err := decoded.Validate("user-id", "webauthn.create"); err == nil {
+		t.Errorf("session of other ceremony should fail")
+	}
+	decoded.Expires = time.Now().Add(-time.Second).Unix()
+	if err := decoded.Validate("user-id", "webauthn.get"); err == nil {
+		t.Errorf("expired session should fail")
+	}
+	if _, err := DecodeWebAuthnSession("invalid"); err == nil {
+		t.Errorf("decode invalid session should fail")
+	}
+}
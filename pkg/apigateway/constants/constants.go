@@ -19,4 +19,6 @@ const (
 	AUTH_HEADER        = "Authorization"
 	YUNION_AUTH_COOKIE = "yunionauth"
 	REGION_COOKIE      = "region"
+
+	WEBAUTHN_SESSION_COOKIE = "webauthn_session"
 )
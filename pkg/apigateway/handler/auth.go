@@ -73,6 +73,8 @@ func (h *AuthHandlers) AddMethods() {
 		NewHP(handleOIDCConfiguration, "oidc", ".well-known", "openid-configuration"),
 		NewHP(handleOIDCJWKeys, "oidc", "keys"),
 		NewHP(handleOIDCUserInfo, "oidc", "user"),
+		// mfa
+		NewHP(getMfaStatus, "mfa"),
 	)
 	h.AddByMethod(POST, nil,
 		NewHP(h.initTotpSecrets, "initcredential"),
@@ -83,6 +85,12 @@ func (h *AuthHandlers) AddMethods() {
 		NewHP(h.postLogoutHandler, "logout"),
 		NewHP(h.handleSsoLogin, "ssologin"),
 		NewHP(handleOIDCToken, "oidc", "token"),
+		// webauthn
+		NewHP(beginWebAuthnRegistration, "webauthn", "register", "begin"),
+		NewHP(finishWebAuthnRegistration, "webauthn", "register", "finish"),
+		NewHP(beginWebAuthnVerify, "webauthn", "verify", "begin"),
+		NewHP(finishWebAuthnVerify, "webauthn", "verify", "finish"),
+		NewHP(verifyRecoveryCode, "recovery-codes", "verify"),
 	)
 
 	// auth middleware handler
@@ -94,18 +102,21 @@ func (h *AuthHandlers) AddMethods() {
 		NewHP(h.getResources, "scoped_resources"),
 		NewHP(fetchIdpBasicConfig, "idp", "<idp_id>", "info"),
 		NewHP(fetchIdpSAMLMetadata, "idp", "<idp_id>", "saml-metadata"),
+		NewHP(listWebAuthnCredentials, "webauthn", "credentials"),
 	)
 	h.AddByMethod(POST, FetchAuthToken,
 		NewHP(h.resetUserPassword, "password"),
 		NewHP(h.getPermissionDetails, "permissions"),
 		NewHP(h.doCreatePolicies, "policies"),
 		NewHP(handleUnlinkIdp, "unlink-idp"),
+		NewHP(createRecoveryCodes, "recovery-codes"),
 	)
 	h.AddByMethod(PATCH, FetchAuthToken,
 		NewHP(h.doPatchPolicy, "policies", "<policy_id>"),
 	)
 	h.AddByMethod(DELETE, FetchAuthToken,
 		NewHP(h.doDeletePolicies, "policies"),
+		NewHP(deleteWebAuthnCredential, "webauthn", "credentials", "<cred_id>"),
 	)
 }
 
@@ -575,7 +586,7 @@ func (h *AuthHandlers) doLogin(ctx context.Context, w http.ResponseWriter, req *
 			return err
 		}
 		isIdpLogin := body.Contains("idp_driver")
-		authToken = clientman.NewAuthToken(token.GetTokenString(), isUserEnableTotp(userInfo), isTotpInit, isIdpLogin)
+		authToken = clientman.NewAuthToken(token.GetTokenString(), isUserMfaRequired(s, userInfo), isTotpInit, isIdpLogin)
 	}
 
 	if !isUserAllowWebconsole(userInfo) {
@@ -1213,12 +1224,22 @@ func (h *AuthHandlers) resetUserPassword(ctx context.Context, w http.ResponseWri
 	}
 
 	s := auth.GetAdminSession(ctx, FetchRegion(req), "")
-	// 2.如果已开启MFA，验证 随机密码正确
-	if isMfaEnabled(user) {
-		err = authToken.VerifyTotpPasscode(s, t.GetUserId(), passcode)
-		if err != nil {
-			httperrors.InputParameterError(ctx, w, "invalid passcode")
-			return
+	// 2.如果已开启MFA，验证 随机密码或者认证器签名正确
+	if isMfaEnabled(s, user) {
+		if assertion, _ := body.Get("webauthn"); assertion != nil {
+			err = authToken.VerifyWebAuthn(func() error {
+				return verifyWebAuthnAssertion(w, req, s, t.GetUserId(), assertion)
+			})
+			if err != nil {
+				httperrors.InputParameterError(ctx, w, "invalid webauthn assertion")
+				return
+			}
+		} else {
+			err = authToken.VerifyTotpPasscode(s, t.GetUserId(), passcode)
+			if err != nil {
+				httperrors.InputParameterError(ctx, w, "invalid passcode")
+				return
+			}
 		}
 	}
 
@@ -1243,15 +1264,11 @@ func isIdpUser(user jsonutils.JSONObject) bool {
 	return false
 }
 
-// refer: isUserEnableTotp
-func isMfaEnabled(user jsonutils.JSONObject) bool {
+// refer: isUserMfaRequired
+func isMfaEnabled(s *mcclient.ClientSession, user jsonutils.JSONObject) bool {
 	if !options.Options.EnableTotp {
 		return false
 	}
 
-	if ok, _ := user.Bool("enable_mfa"); ok {
-		return true
-	}
-
-	return false
+	return isUserMfaRequired(s, user)
 }
@@ -0,0 +1,164 @@
+// Copyright 2019 Yunion
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package handler
+
+import (
+	"context"
+	"net/http"
+
+	"yunion.io/x/jsonutils"
+	"yunion.io/x/log"
+
+	"yunion.io/x/onecloud/pkg/apigateway/options"
+	api "yunion.io/x/onecloud/pkg/apis/identity"
+	"yunion.io/x/onecloud/pkg/appsrv"
+	"yunion.io/x/onecloud/pkg/httperrors"
+	"yunion.io/x/onecloud/pkg/mcclient"
+	"yunion.io/x/onecloud/pkg/mcclient/auth"
+	"yunion.io/x/onecloud/pkg/mcclient/modules"
+	"yunion.io/x/onecloud/pkg/util/httputils"
+)
+
+// 获取域要求的二次认证方式, 空字符串表示没有要求
+func fetchDomainMfaMethod(s *mcclient.ClientSession, domainId string) string {
+	if len(domainId) == 0 {
+		return ""
+	}
+	domain, err := modules.Domains.Get(s, domainId, nil)
+	if err != nil {
+		log.Errorf("fetch domain %s fail %s", domainId, err)
+		return ""
+	}
+	method, _ := domain.GetString("mfa_method")
+	if method == api.MfaMethodNone {
+		return ""
+	}
+	return method
+}
+
+// 检查域策略是否允许使用该二次认证方式
+func checkDomainMfaMethod(s *mcclient.ClientSession, domainId string, method string) error {
+	required := fetchDomainMfaMethod(s, domainId)
+	if len(required) > 0 && required != method {
+		return httperrors.NewForbiddenError("domain requires %s as the second factor", required)
+	}
+	return nil
+}
+
+// 用户开启了二次认证, 或者用户所在的域要求二次认证
+func isUserMfaRequired(s *mcclient.ClientSession, userInfo jsonutils.JSONObject) bool {
+	if isUserEnableTotp(userInfo) {
+		return true
+	}
+	domainId, _ := userInfo.GetString("domain_id")
+	return len(fetchDomainMfaMethod(s, domainId)) > 0
+}
+
+func isNotFoundError(err error) bool {
+	if e, ok := err.(*httputils.JSONClientError); ok && e.Code == 404 {
+		return true
+	}
+	return false
+}
+
+// 获取用户二次认证状态: 域策略要求的方式, 已设置的TOTP, WebAuthn认证器及剩余的恢复码数量
+func getMfaStatus(ctx context.Context, w http.ResponseWriter, req *http.Request) {
+	t, authToken, err := fetchAuthInfo(ctx, req)
+	if err != nil {
+		httperrors.InvalidCredentialError(ctx, w, "fetchAuthInfo fail: %s", err)
+		return
+	}
+
+	s := auth.GetAdminSession(ctx, FetchRegion(req), "")
+	uid := t.GetUserId()
+	totpInit, err := isUserTotpCredInitialed(s, uid)
+	if err != nil {
+		httperrors.GeneralServerError(ctx, w, err)
+		return
+	}
+	creds, err := modules.Credentials.GetWebAuthnCredentials(s, uid)
+	if err != nil {
+		httperrors.GeneralServerError(ctx, w, err)
+		return
+	}
+	recoveryCodes := 0
+	codes, err := modules.Credentials.GetRecoveryCodes(s, uid)
+	if err == nil {
+		recoveryCodes = len(codes.Codes)
+	} else if !isNotFoundError(err) {
+		httperrors.GeneralServerError(ctx, w, err)
+		return
+	}
+
+	resp := jsonutils.NewDict()
+	resp.Add(jsonutils.NewBool(options.Options.EnableTotp), "system_mfa_on")
+	resp.Add(jsonutils.NewBool(authToken.IsTotpEnabled()), "mfa_on")
+	resp.Add(jsonutils.NewBool(authToken.IsTotpVerified()), "mfa_verified")
+	resp.Add(jsonutils.NewString(fetchDomainMfaMethod(s, t.GetDomainId())), "required_method")
+	resp.Add(jsonutils.NewBool(totpInit), "totp_init")
+	resp.Add(jsonutils.NewInt(int64(len(creds))), "webauthn_credentials")
+	resp.Add(jsonutils.NewInt(int64(recoveryCodes)), "recovery_codes")
+	appsrv.SendJSON(w, resp)
+}
+
+// 重新生成恢复码, 旧的恢复码失效. 恢复码只在生成时返回一次
+func createRecoveryCodes(ctx context.Context, w http.ResponseWriter, req *http.Request) {
+	t := AppContextToken(ctx)
+
+	s := auth.GetAdminSession(ctx, FetchRegion(req), "")
+	codes, err := modules.Credentials.CreateRecoveryCodes(s, t.GetUserId())
+	if err != nil {
+		httperrors.GeneralServerError(ctx, w, err)
+		return
+	}
+
+	resp := jsonutils.NewDict()
+	resp.Add(jsonutils.NewStringArray(codes), "codes")
+	appsrv.SendJSON(w, resp)
+}
+
+// 使用一次性恢复码完成二次认证
+func verifyRecoveryCode(ctx context.Context, w http.ResponseWriter, req *http.Request) {
+	t, authToken, err := fetchAuthInfo(ctx, req)
+	if err != nil {
+		httperrors.InvalidCredentialError(ctx, w, "fetchAuthInfo fail: %s", err)
+		return
+	}
+
+	_, _, body := appsrv.FetchEnv(ctx, w, req)
+	if body == nil {
+		httperrors.InvalidInputError(ctx, w, "request body is empty")
+		return
+	}
+
+	code, _ := body.GetString("code")
+	if len(code) == 0 {
+		httperrors.MissingParameterError(ctx, w, "code")
+		return
+	}
+
+	s := auth.GetAdminSession(ctx, FetchRegion(req), "")
+	err = authToken.VerifyRecoveryCode(s, t.GetUserId(), code)
+
+	saveAuthCookie(w, authToken, t)
+
+	if err != nil {
+		log.Warningf("VerifyRecoveryCode %s", err.Error())
+		httperrors.InvalidCredentialError(ctx, w, "invalid recovery code: %v", err)
+		return
+	}
+
+	appsrv.SendJSON(w, jsonutils.NewDict())
+}
@@ -27,6 +27,7 @@ import (
 	"yunion.io/x/jsonutils"
 	"yunion.io/x/log"
 
+	api "yunion.io/x/onecloud/pkg/apis/identity"
 	"yunion.io/x/onecloud/pkg/appsrv"
 	"yunion.io/x/onecloud/pkg/httperrors"
 	"yunion.io/x/onecloud/pkg/mcclient"
@@ -249,6 +250,12 @@ func validatePasscodeHandler(ctx context.Context, w http.ResponseWriter, req *ht
 		return
 	}
 
+	err = checkDomainMfaMethod(s, t.GetDomainId(), api.MfaMethodTotp)
+	if err != nil {
+		httperrors.GeneralServerError(ctx, w, err)
+		return
+	}
+
 	if len(passcode) != 6 {
 		httperrors.InputParameterError(ctx, w, "passcode is a 6-digits string")
 		return
@@ -0,0 +1,411 @@
+// Copyright 2019 Yunion
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package handler
+
+import (
+	"context"
+	"encoding/hex"
+	"net/http"
+	"net/url"
+	"time"
+
+	"yunion.io/x/jsonutils"
+	"yunion.io/x/log"
+	"yunion.io/x/pkg/errors"
+
+	"yunion.io/x/onecloud/pkg/apigateway/clientman"
+	"yunion.io/x/onecloud/pkg/apigateway/constants"
+	"yunion.io/x/onecloud/pkg/apigateway/options"
+	api "yunion.io/x/onecloud/pkg/apis/identity"
+	"yunion.io/x/onecloud/pkg/appctx"
+	"yunion.io/x/onecloud/pkg/appsrv"
+	"yunion.io/x/onecloud/pkg/httperrors"
+	"yunion.io/x/onecloud/pkg/mcclient"
+	"yunion.io/x/onecloud/pkg/mcclient/auth"
+	"yunion.io/x/onecloud/pkg/mcclient/modules"
+	"yunion.io/x/onecloud/pkg/util/webauthnutils"
+)
+
+const (
+	webauthnTimeout = 5 * time.Minute
+
+	defaultWebAuthnCredentialName = "security key"
+)
+
+// WebAuthn 依赖方配置, 未配置时使用请求的 Origin
+func getRelyingParty(req *http.Request) (*webauthnutils.SRelyingParty, error) {
+	origins := options.Options.WebauthnOrigins
+	if len(origins) == 0 {
+		origin := req.Header.Get("Origin")
+		if len(origin) == 0 {
+			return nil, httperrors.NewInputParameterError("missing Origin header")
+		}
+		origins = []string{origin}
+	}
+	rpId := options.Options.WebauthnRpId
+	if len(rpId) == 0 {
+		u, err := url.Parse(origins[0])
+		if err != nil || len(u.Hostname()) == 0 {
+			return nil, httperrors.NewInputParameterError("invalid origin %s", origins[0])
+		}
+		rpId = u.Hostname()
+	}
+	return &webauthnutils.SRelyingParty{
+		Id:                      rpId,
+		Name:                    options.Options.WebauthnRpName,
+		Origins:                 origins,
+		RequireUserVerification: options.Options.WebauthnRequireUserVerification,
+	}, nil
+}
+
+func decodeWebAuthnCredentials(creds []modules.SWebAuthnCredential) []webauthnutils.SCredential {
+	ret := make([]webauthnutils.SCredential, 0, len(creds))
+	for i := range creds {
+		credId, err := webauthnutils.DecodeBase64(creds[i].CredentialId)
+		if err != nil {
+			log.Errorf("invalid webauthn credential %s: %s", creds[i].Id, err)
+			continue
+		}
+		pubKey, err := webauthnutils.DecodeBase64(creds[i].PublicKey)
+		if err != nil {
+			log.Errorf("invalid webauthn credential %s: %s", creds[i].Id, err)
+			continue
+		}
+		ret = append(ret, webauthnutils.SCredential{
+			Id:        credId,
+			PublicKey: pubKey,
+			SignCount: uint32(creds[i].SignCount),
+		})
+	}
+	return ret
+}
+
+func credentialIds(creds []webauthnutils.SCredential) [][]byte {
+	ret := make([][]byte, len(creds))
+	for i := range creds {
+		ret[i] = creds[i].Id
+	}
+	return ret
+}
+
+// 开始 WebAuthn 流程, 挑战码保存在 cookie 中
+func startWebAuthnSession(w http.ResponseWriter, uid string, ceremony string) ([]byte, error) {
+	challenge, err := webauthnutils.NewChallenge()
+	if err != nil {
+		return nil, errors.Wrap(err, "NewChallenge")
+	}
+	session := clientman.NewWebAuthnSession(uid, ceremony, webauthnutils.EncodeBase64(challenge), webauthnTimeout)
+	saveCookie(w, constants.WEBAUTHN_SESSION_COOKIE, session.Encode(), options.Options.CookieDomain, time.Now().Add(webauthnTimeout), false)
+	return challenge, nil
+}
+
+// 结束 WebAuthn 流程, 返回挑战码. 已使用的挑战码保存在 keystone 直到过期, 只能使用一次
+func finishWebAuthnSession(w http.ResponseWriter, req *http.Request, s *mcclient.ClientSession, uid string, ceremony string) ([]byte, error) {
+	sessionStr := getCookie2(req, constants.WEBAUTHN_SESSION_COOKIE, false)
+	clearCookie(w, constants.WEBAUTHN_SESSION_COOKIE, options.Options.CookieDomain)
+	if len(sessionStr) == 0 {
+		return nil, errors.Wrap(httperrors.ErrInvalidCredential, "no webauthn session")
+	}
+	session, err := clientman.DecodeWebAuthnSession(sessionStr)
+	if err != nil {
+		return nil, errors.Wrap(httperrors.ErrInvalidCredential, "invalid webauthn session")
+	}
+	err = session.Consume(s, uid, ceremony)
+	if err != nil {
+		return nil, errors.Wrapf(httperrors.ErrInvalidCredential, "invalid webauthn session: %s", err)
+	}
+	return webauthnutils.DecodeBase64(session.Challenge)
+}
+
+// 第一个认证器可以在二次认证之前注册, 和 TOTP 的 initcredential 一致. 注册更多认证器需要先完成二次认证
+func checkWebAuthnRegistration(authToken *clientman.SAuthToken, creds []modules.SWebAuthnCredential) error {
+	if len(creds) > 0 && !authToken.IsTotpVerified() {
+		return errors.Wrap(httperrors.ErrInvalidCredential, "second factor authentication required")
+	}
+	return nil
+}
+
+// 获取注册认证器的参数
+func beginWebAuthnRegistration(ctx context.Context, w http.ResponseWriter, req *http.Request) {
+	t, authToken, err := fetchAuthInfo(ctx, req)
+	if err != nil {
+		httperrors.InvalidCredentialError(ctx, w, "fetchAuthInfo fail: %s", err)
+		return
+	}
+
+	s := auth.GetAdminSession(ctx, FetchRegion(req), "")
+	uid := t.GetUserId()
+	creds, err := modules.Credentials.GetWebAuthnCredentials(s, uid)
+	if err != nil {
+		httperrors.GeneralServerError(ctx, w, err)
+		return
+	}
+	err = checkWebAuthnRegistration(authToken, creds)
+	if err != nil {
+		httperrors.GeneralServerError(ctx, w, err)
+		return
+	}
+	rp, err := getRelyingParty(req)
+	if err != nil {
+		httperrors.GeneralServerError(ctx, w, err)
+		return
+	}
+
+	challenge, err := startWebAuthnSession(w, uid, webauthnutils.CEREMONY_CREATE)
+	if err != nil {
+		httperrors.GeneralServerError(ctx, w, err)
+		return
+	}
+	user := webauthnutils.SUserEntity{
+		Id:          webauthnutils.EncodeBase64([]byte(uid)),
+		Name:        t.GetUserName(),
+		DisplayName: t.GetUserName(),
+	}
+	opts := rp.NewCreationOptions(challenge, user, credentialIds(decodeWebAuthnCredentials(creds)), webauthnTimeout)
+
+	resp := jsonutils.NewDict()
+	resp.Add(jsonutils.Marshal(opts), "publicKey")
+	appsrv.SendJSON(w, resp)
+}
+
+// 验证认证器的注册结果并保存
+func finishWebAuthnRegistration(ctx context.Context, w http.ResponseWriter, req *http.Request) {
+	t, authToken, err := fetchAuthInfo(ctx, req)
+	if err != nil {
+		httperrors.InvalidCredentialError(ctx, w, "fetchAuthInfo fail: %s", err)
+		return
+	}
+
+	_, _, body := appsrv.FetchEnv(ctx, w, req)
+	if body == nil {
+		httperrors.InvalidInputError(ctx, w, "request body is empty")
+		return
+	}
+	input := webauthnutils.SCredentialCreationResponse{}
+	err = body.Unmarshal(&input, "credential")
+	if err != nil {
+		httperrors.InputParameterError(ctx, w, "invalid credential: %v", err)
+		return
+	}
+	name, _ := body.GetString("name")
+	if len(name) == 0 {
+		name = defaultWebAuthnCredentialName
+	}
+
+	s := auth.GetAdminSession(ctx, FetchRegion(req), "")
+	uid := t.GetUserId()
+	challenge, err := finishWebAuthnSession(w, req, s, uid, webauthnutils.CEREMONY_CREATE)
+	if err != nil {
+		httperrors.GeneralServerError(ctx, w, err)
+		return
+	}
+	creds, err := modules.Credentials.GetWebAuthnCredentials(s, uid)
+	if err != nil {
+		httperrors.GeneralServerError(ctx, w, err)
+		return
+	}
+	err = checkWebAuthnRegistration(authToken, creds)
+	if err != nil {
+		httperrors.GeneralServerError(ctx, w, err)
+		return
+	}
+	rp, err := getRelyingParty(req)
+	if err != nil {
+		httperrors.GeneralServerError(ctx, w, err)
+		return
+	}
+
+	cred, err := rp.VerifyRegistration(input, challenge)
+	if err != nil {
+		log.Warningf("VerifyRegistration %s", err)
+		httperrors.InvalidCredentialError(ctx, w, "webauthn registration failed: %v", err)
+		return
+	}
+	for _, c := range decodeWebAuthnCredentials(creds) {
+		if string(c.Id) == string(cred.Id) {
+			httperrors.ConflictError(ctx, w, "authenticator already registered")
+			return
+		}
+	}
+
+	blob := api.SWebAuthnCredentialBlob{
+		CredentialId: webauthnutils.EncodeBase64(cred.Id),
+		PublicKey:    webauthnutils.EncodeBase64(cred.PublicKey),
+		SignCount:    int64(cred.SignCount),
+		Aaguid:       hex.EncodeToString(cred.Aaguid),
+	}
+	id, err := modules.Credentials.CreateWebAuthnCredential(s, uid, name, blob)
+	if err != nil {
+		httperrors.GeneralServerError(ctx, w, err)
+		return
+	}
+
+	resp := jsonutils.NewDict()
+	resp.Add(jsonutils.NewString(id), "id")
+	resp.Add(jsonutils.NewString(name), "name")
+	appsrv.SendJSON(w, resp)
+}
+
+// 获取认证器验证的参数
+func beginWebAuthnVerify(ctx context.Context, w http.ResponseWriter, req *http.Request) {
+	t, _, err := fetchAuthInfo(ctx, req)
+	if err != nil {
+		httperrors.InvalidCredentialError(ctx, w, "fetchAuthInfo fail: %s", err)
+		return
+	}
+
+	s := auth.GetAdminSession(ctx, FetchRegion(req), "")
+	uid := t.GetUserId()
+	creds, err := modules.Credentials.GetWebAuthnCredentials(s, uid)
+	if err != nil {
+		httperrors.GeneralServerError(ctx, w, err)
+		return
+	}
+	if len(creds) == 0 {
+		httperrors.NotFoundError(ctx, w, "no webauthn credentials")
+		return
+	}
+	rp, err := getRelyingParty(req)
+	if err != nil {
+		httperrors.GeneralServerError(ctx, w, err)
+		return
+	}
+
+	challenge, err := startWebAuthnSession(w, uid, webauthnutils.CEREMONY_GET)
+	if err != nil {
+		httperrors.GeneralServerError(ctx, w, err)
+		return
+	}
+	opts := rp.NewRequestOptions(challenge, credentialIds(decodeWebAuthnCredentials(creds)), webauthnTimeout)
+
+	resp := jsonutils.NewDict()
+	resp.Add(jsonutils.Marshal(opts), "publicKey")
+	appsrv.SendJSON(w, resp)
+}
+
+// 验证认证器的签名, 并更新签名计数器
+func verifyWebAuthnAssertion(w http.ResponseWriter, req *http.Request, s *mcclient.ClientSession, uid string, assertion jsonutils.JSONObject) error {
+	challenge, err := finishWebAuthnSession(w, req, s, uid, webauthnutils.CEREMONY_GET)
+	if err != nil {
+		return err
+	}
+	input := webauthnutils.SCredentialAssertionResponse{}
+	if assertion == nil || assertion.Unmarshal(&input) != nil {
+		return httperrors.NewInputParameterError("invalid webauthn assertion")
+	}
+	rp, err := getRelyingParty(req)
+	if err != nil {
+		return err
+	}
+	creds, err := modules.Credentials.GetWebAuthnCredentials(s, uid)
+	if err != nil {
+		return errors.Wrap(err, "GetWebAuthnCredentials")
+	}
+	// decodeWebAuthnCredentials skips invalid credentials, find in the
+	// decoded ones to keep the indexes aligned
+	for i := range creds {
+		decoded := decodeWebAuthnCredentials(creds[i : i+1])
+		if len(decoded) == 0 || input.FindCredential(decoded) < 0 {
+			continue
+		}
+		signCount, err := rp.VerifyAssertion(input, challenge, decoded[0])
+		if err != nil {
+			return errors.Wrap(httperrors.ErrInvalidCredential, err.Error())
+		}
+		err = modules.Credentials.UpdateWebAuthnSignCount(s, creds[i], int64(signCount))
+		if err != nil {
+			return errors.Wrap(err, "UpdateWebAuthnSignCount")
+		}
+		return nil
+	}
+	return errors.Wrap(httperrors.ErrInvalidCredential, "unknown webauthn credential")
+}
+
+// 使用认证器完成二次认证
+func finishWebAuthnVerify(ctx context.Context, w http.ResponseWriter, req *http.Request) {
+	t, authToken, err := fetchAuthInfo(ctx, req)
+	if err != nil {
+		httperrors.InvalidCredentialError(ctx, w, "fetchAuthInfo fail: %s", err)
+		return
+	}
+
+	_, _, body := appsrv.FetchEnv(ctx, w, req)
+	if body == nil {
+		httperrors.InvalidInputError(ctx, w, "request body is empty")
+		return
+	}
+
+	s := auth.GetAdminSession(ctx, FetchRegion(req), "")
+	err = checkDomainMfaMethod(s, t.GetDomainId(), api.MfaMethodWebAuthn)
+	if err != nil {
+		httperrors.GeneralServerError(ctx, w, err)
+		return
+	}
+
+	assertion, _ := body.Get("credential")
+	err = authToken.VerifyWebAuthn(func() error {
+		return verifyWebAuthnAssertion(w, req, s, t.GetUserId(), assertion)
+	})
+
+	saveAuthCookie(w, authToken, t)
+
+	if err != nil {
+		log.Warningf("VerifyWebAuthn %s", err.Error())
+		httperrors.InvalidCredentialError(ctx, w, "webauthn verification failed: %v", err)
+		return
+	}
+
+	appsrv.SendJSON(w, jsonutils.NewDict())
+}
+
+// 列出用户注册的认证器
+func listWebAuthnCredentials(ctx context.Context, w http.ResponseWriter, req *http.Request) {
+	t := AppContextToken(ctx)
+
+	s := auth.GetAdminSession(ctx, FetchRegion(req), "")
+	creds, err := modules.Credentials.GetWebAuthnCredentials(s, t.GetUserId())
+	if err != nil {
+		httperrors.GeneralServerError(ctx, w, err)
+		return
+	}
+
+	data := jsonutils.NewArray()
+	for i := range creds {
+		cred := jsonutils.NewDict()
+		cred.Add(jsonutils.NewString(creds[i].Id), "id")
+		cred.Add(jsonutils.NewString(creds[i].Name), "name")
+		cred.Add(jsonutils.NewString(creds[i].Aaguid), "aaguid")
+		cred.Add(jsonutils.NewTimeString(time.Unix(creds[i].Timestamp, 0)), "created_at")
+		data.Add(cred)
+	}
+	resp := jsonutils.NewDict()
+	resp.Add(data, "data")
+	appsrv.SendJSON(w, resp)
+}
+
+// 删除用户注册的认证器
+func deleteWebAuthnCredential(ctx context.Context, w http.ResponseWriter, req *http.Request) {
+	t := AppContextToken(ctx)
+	params := appctx.AppContextParams(ctx)
+
+	s := auth.GetAdminSession(ctx, FetchRegion(req), "")
+	err := modules.Credentials.RemoveWebAuthnCredential(s, t.GetUserId(), params["<cred_id>"])
+	if err != nil {
+		httperrors.GeneralServerError(ctx, w, err)
+		return
+	}
+
+	appsrv.SendJSON(w, jsonutils.NewDict())
+}
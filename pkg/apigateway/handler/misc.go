@@ -40,6 +40,7 @@ import (
 	"yunion.io/x/onecloud/pkg/mcclient/modulebase"
 	"yunion.io/x/onecloud/pkg/mcclient/modules"
 	"yunion.io/x/onecloud/pkg/util/httputils"
+	"yunion.io/x/onecloud/pkg/util/openapi"
 )
 
 const contentTypeSpreadsheet = "application/vnd.openxmlformats-officedocument.spreadsheetml.sheet"
@@ -98,6 +99,24 @@ func (h *MiscHandler) Bind(app *appsrv.Application) {
 	app.AddHandler3(imageUploader)
 	s3upload := uploadHandlerInfo(POST, prefix+"s3uploads", FetchAuthToken(h.postS3UploadHandler))
 	app.AddHandler3(s3upload)
+	app.AddHandler(GET, prefix+"api-docs/<service>", FetchAuthToken(h.getApiDocsHandler))
+}
+
+// getApiDocsHandler fetches the OpenAPI document of the backend service,
+// the Swagger 2.0 document is returned when format=swagger
+func (h *MiscHandler) getApiDocsHandler(ctx context.Context, w http.ResponseWriter, req *http.Request) {
+	params := appctx.AppContextParams(ctx)
+	specPath := openapi.OPENAPI_SPEC_PATH
+	if req.URL.Query().Get("format") == "swagger" {
+		specPath = openapi.SWAGGER_SPEC_PATH
+	}
+	s := FetchSession(ctx, req, "")
+	_, doc, err := s.JSONRequest(params["<service>"], "", httputils.GET, "/"+specPath, nil, nil)
+	if err != nil {
+		httperrors.GeneralServerError(ctx, w, err)
+		return
+	}
+	appsrv.SendJSON(w, doc)
 }
 
 func UploadHandlerInfo(method, prefix string, handler func(context.Context, http.ResponseWriter, *http.Request)) *appsrv.SHandlerInfo {
@@ -28,6 +28,11 @@ type GatewayOptions struct {
 
 	EnableTotp bool `help:"Enable two-factor authentication" default:"true"`
 
+	WebauthnRpId                    string   `help:"WebAuthn relying party ID, default to the host name of the web console"`
+	WebauthnRpName                  string   `help:"WebAuthn relying party name shown by authenticators" default:"Onecloud"`
+	WebauthnOrigins                 []string `help:"Allowed origins of WebAuthn ceremonies, default to the origin of the web console"`
+	WebauthnRequireUserVerification bool     `help:"Require authenticators to verify the user by PIN or biometrics" default:"false"`
+
 	SsoRedirectUrl     string `help:"SSO idp redirect URL"`
 	SsoAuthCallbackUrl string `help:"SSO idp auth callback URL"`
 	SsoLinkCallbackUrl string `help:"SSO idp link user callback URL"`
@@ -45,7 +45,8 @@ func (ps *SProxySetting) String() string {
 func (ps *SProxySetting) IsZero() bool {
 	if ps.HTTPProxy == "" &&
 		ps.HTTPSProxy == "" &&
-		ps.NoProxy == "" {
+		ps.NoProxy == "" &&
+		ps.CloudproxyForwardId == "" {
 		return true
 	}
 	return false
@@ -27,6 +27,9 @@ type ProxySetting struct {
 	HttpProxy  string
 	HttpsProxy string
 	NoProxy    string
+
+	// 通过 cloudproxy 的 dynamic 类型转发访问, 与 http_proxy 和 https_proxy 互斥
+	CloudproxyForwardId string
 }
 
 func (v *ProxySetting) Sanitize() error {
@@ -44,6 +47,11 @@ func (v *ProxySetting) Sanitize() error {
 		v.HttpsProxy = u.String()
 	}
 
+	v.CloudproxyForwardId = strings.TrimSpace(v.CloudproxyForwardId)
+	if v.CloudproxyForwardId != "" && (v.HttpProxy != "" || v.HttpsProxy != "") {
+		return errors.Error("cloudproxy_forward_id conflicts with http_proxy and https_proxy")
+	}
+
 	if noProxy, err := parseNoProxy(v.NoProxy); err == nil {
 		v.NoProxy = strings.Join(noProxy, ",")
 	} else {
@@ -24,4 +24,6 @@ type SProxySetting struct {
 	HTTPProxy  string `json:"http_proxy"`
 	HTTPSProxy string `json:"https_proxy"`
 	NoProxy    string `json:"no_proxy"`
+	// 通过 cloudproxy 的 dynamic 类型转发访问, 与 http_proxy 和 https_proxy 互斥
+	CloudproxyForwardId string `json:"cloudproxy_forward_id"`
 }
@@ -21,21 +21,32 @@ import (
 const (
 	PM_SCOPE_VPC     = "vpc"
 	PM_SCOPE_NETWORK = "network"
+
+	// destination acl of dynamic forwards
+	PM_SCOPE_CIDR   = "cidr"
+	PM_SCOPE_DOMAIN = "domain"
 )
 
 var PM_SCOPES = choices.NewChoices(
 	PM_SCOPE_VPC,
 	PM_SCOPE_NETWORK,
+	PM_SCOPE_CIDR,
+	PM_SCOPE_DOMAIN,
 )
 
 const (
 	FORWARD_TYPE_LOCAL  = "local"
 	FORWARD_TYPE_REMOTE = "remote"
+
+	// FORWARD_TYPE_DYNAMIC listens on proxy agent as SOCKS5 and HTTP
+	// proxy, with connections tunneled through proxy endpoint
+	FORWARD_TYPE_DYNAMIC = "dynamic"
 )
 
 var FORWARD_TYPES = choices.NewChoices(
 	FORWARD_TYPE_LOCAL,
 	FORWARD_TYPE_REMOTE,
+	FORWARD_TYPE_DYNAMIC,
 )
 
 const (
@@ -77,4 +77,6 @@ type ForwardDetails struct {
 	Opaque string
 
 	BindAddr string
+	// ProxyUrl is for use in proxysetting when type is dynamic
+	ProxyUrl string
 }
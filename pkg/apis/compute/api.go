@@ -57,7 +57,6 @@ type NetworkConfig struct {
 
 	// 子网内的IPv6地址
 	// required: false
-	// swagger:ignore
 	Address6 string `json:"address6"`
 
 	// 驱动方式
@@ -206,6 +205,11 @@ type DiskConfig struct {
 
 	//swagger:ignore
 	DiskId string `json:"disk_id"`
+
+	// 加密主密钥ID, 即keystone中类型为enc_key的凭证, 仅KVM文件存储(local, nfs, gpfs)的数据盘支持加密
+	// 通过加密快照创建的磁盘自动继承快照的密钥
+	// required: false
+	EncryptKeyId string `json:"encrypt_key_id"`
 }
 
 type IsolatedDeviceConfig struct {
@@ -54,3 +54,29 @@ type CachedimageDetails struct {
 	// example: 0
 	CachedCount int `json:"cached_count"`
 }
+
+type CachedimagePrewarmInput struct {
+	// 预热的镜像(ID或Name)
+	Image []string `json:"image"`
+	// 预热带有指定标签的镜像
+	ImageTags []apis.STag `json:"image_tags"`
+	// 镜像格式
+	// example: qcow2
+	Format string `json:"format"`
+
+	// 预热到指定可用区(ID或Name)的宿主机
+	ZoneId string `json:"zone_id"`
+	// 预热到关联了指定调度标签(ID或Name)的宿主机
+	SchedtagId string `json:"schedtag_id"`
+	// 预热到带有指定标签的宿主机
+	HostTags []apis.STag `json:"host_tags"`
+}
+
+type CachedimagePrewarmOutput struct {
+	// 预热的镜像个数
+	ImageCount int `json:"image_count"`
+	// 预热的宿主机个数
+	HostCount int `json:"host_count"`
+	// 发起的缓存任务个数, 已缓存或正在缓存的镜像不会重复缓存
+	TaskCount int `json:"task_count"`
+}
@@ -14,6 +14,11 @@
 
 package compute
 
+import (
+	"yunion.io/x/onecloud/pkg/apis"
+	"yunion.io/x/onecloud/pkg/cloudprovider"
+)
+
 const (
 	CDN_DOMAIN_STATUS_ONLINE      = "online"
 	CDN_DOMAIN_STATUS_OFFLINE     = "offline"
@@ -25,6 +30,29 @@ const (
 	CDN_DOMAIN_ORIGIN_TYPE_DOMAIN = "domain"
 	CDN_DOMAIN_ORIGIN_TYPE_IP     = "ip"
 	CDN_DOMAIN_ORIGIN_TYPE_BUCKET = "bucket"
+
+	CDN_DOMAIN_STATUS_CREATING      = "creating"
+	CDN_DOMAIN_STATUS_CREATE_FAILED = "create_failed"
+	CDN_DOMAIN_STATUS_DELETING      = "deleting"
+	CDN_DOMAIN_STATUS_DELETE_FAILED = "delete_failed"
+	CDN_DOMAIN_STATUS_PURGING       = "purging"
+	CDN_DOMAIN_STATUS_PREFETCHING   = "prefetching"
+	CDN_DOMAIN_STATUS_UNKNOWN       = "unknown"
+
+	CDN_SERVICE_TYPE_WEB      = "web"
+	CDN_SERVICE_TYPE_DOWNLOAD = "download"
+	CDN_SERVICE_TYPE_MEDIA    = "media"
+
+	CDN_PURGE_TYPE_FILE      = "file"
+	CDN_PURGE_TYPE_DIRECTORY = "directory"
+)
+
+var (
+	CDN_DOMAIN_AREAS         = []string{CDN_DOMAIN_AREA_MAINLAND, CDN_DOMAIN_AREA_OVERSEAS, CDN_DOMAIN_AREA_GLOBAL}
+	CDN_DOMAIN_ORIGIN_TYPES  = []string{CDN_DOMAIN_ORIGIN_TYPE_DOMAIN, CDN_DOMAIN_ORIGIN_TYPE_IP, CDN_DOMAIN_ORIGIN_TYPE_BUCKET}
+	CDN_SERVICE_TYPES        = []string{CDN_SERVICE_TYPE_WEB, CDN_SERVICE_TYPE_DOWNLOAD, CDN_SERVICE_TYPE_MEDIA}
+	CDN_PURGE_TYPES          = []string{CDN_PURGE_TYPE_FILE, CDN_PURGE_TYPE_DIRECTORY}
+	CDN_DOMAIN_BUSY_STATUSES = []string{CDN_DOMAIN_STATUS_CREATING, CDN_DOMAIN_STATUS_DELETING, CDN_DOMAIN_STATUS_PURGING, CDN_DOMAIN_STATUS_PREFETCHING}
 )
 
 type CdnDomain struct {
@@ -45,3 +73,62 @@ type CdnDomain struct {
 type CdnDomains struct {
 	Data []CdnDomain `json:"data"`
 }
+
+// name即为加速域名
+type CDNDomainCreateInput struct {
+	apis.VirtualResourceCreateInput
+
+	CloudproviderResourceInput
+
+	// 加速区域
+	//
+	// | 区域		| 说明			|
+	// |----------	|---------------|
+	// | mainland	| 中国大陆		|
+	// | overseas	| 中国大陆以外	|
+	// | global		| 全球			|
+	// default: mainland
+	Area string `json:"area"`
+
+	// 业务类型
+	// enum: web, download, media
+	// default: web
+	ServiceType string `json:"service_type"`
+
+	// 源站列表
+	Origins *cloudprovider.SCdnOrigins `json:"origins"`
+}
+
+// 资源返回详情
+type CDNDomainDetails struct {
+	apis.VirtualResourceDetails
+	ManagedResourceInfo
+}
+
+// 资源列表请求参数
+type CDNDomainListInput struct {
+	apis.VirtualResourceListInput
+	apis.ExternalizedResourceBaseListInput
+
+	ManagedResourceListInput
+
+	// 按加速区域过滤
+	Area []string `json:"area"`
+	// 按业务类型过滤
+	ServiceType []string `json:"service_type"`
+}
+
+type CDNDomainPurgeCacheInput struct {
+	// 刷新类型
+	// enum: file, directory
+	// default: file
+	Type string `json:"type"`
+
+	// 需要刷新的url或路径, 路径以'/'开头时自动补全加速域名
+	Urls []string `json:"urls"`
+}
+
+type CDNDomainPrefetchInput struct {
+	// 需要预热的url或路径, 路径以'/'开头时自动补全加速域名
+	Urls []string `json:"urls"`
+}
@@ -51,6 +51,7 @@ const (
 	CLOUD_PROVIDER_CTYUN     = "Ctyun"
 	CLOUD_PROVIDER_ECLOUD    = "Ecloud"
 	CLOUD_PROVIDER_JDCLOUD   = "JDcloud"
+	CLOUD_PROVIDER_OVIRT     = "oVirt"
 
 	CLOUD_PROVIDER_GENERICS3 = "S3"
 	CLOUD_PROVIDER_CEPH      = "Ceph"
@@ -96,7 +97,7 @@ const (
 var (
 	CLOUD_PROVIDER_VALID_STATUS        = []string{CLOUD_PROVIDER_CONNECTED}
 	CLOUD_PROVIDER_VALID_HEALTH_STATUS = []string{CLOUD_PROVIDER_HEALTH_NORMAL, CLOUD_PROVIDER_HEALTH_NO_PERMISSION}
-	PRIVATE_CLOUD_PROVIDERS            = []string{CLOUD_PROVIDER_ZSTACK, CLOUD_PROVIDER_OPENSTACK, CLOUD_PROVIDER_APSARA}
+	PRIVATE_CLOUD_PROVIDERS            = []string{CLOUD_PROVIDER_ZSTACK, CLOUD_PROVIDER_OPENSTACK, CLOUD_PROVIDER_APSARA, CLOUD_PROVIDER_OVIRT}
 
 	CLOUD_PROVIDERS = []string{
 		CLOUD_PROVIDER_ONECLOUD,
@@ -114,6 +115,7 @@ var (
 		CLOUD_PROVIDER_CTYUN,
 		CLOUD_PROVIDER_ECLOUD,
 		CLOUD_PROVIDER_JDCLOUD,
+		CLOUD_PROVIDER_OVIRT,
 	}
 
 	CLOUD_PROVIDER_HOST_TYPE_MAP = map[string][]string{
@@ -164,6 +166,9 @@ var (
 		CLOUD_PROVIDER_JDCLOUD: {
 			HOST_TYPE_JDCLOUD,
 		},
+		CLOUD_PROVIDER_OVIRT: {
+			HOST_TYPE_OVIRT,
+		},
 	}
 )
 
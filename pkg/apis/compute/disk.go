@@ -221,6 +221,18 @@ type DiskDetails struct {
 	MaxManualSnapshotCount int `json:"max_manual_snapshot_count"`
 }
 
+// DiskEncryptInfo is the unwrapped data key of an encrypted disk, only
+// returned to hosts when they need to open the disk
+type DiskEncryptInfo struct {
+	// 加密主密钥ID
+	EncryptKeyId string `json:"encrypt_key_id"`
+	// 加密格式
+	// example: luks
+	EncryptFormat string `json:"encrypt_format"`
+	// base64编码的数据密钥
+	Key string `json:"key"`
+}
+
 type DiskResourceInfoBase struct {
 	// 磁盘名称
 	Disk string `json:"disk"`
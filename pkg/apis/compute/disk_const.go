@@ -59,4 +59,6 @@ const (
 
 	DISK_NOT_EXIST = "not_exist"
 	DISK_EXIST     = "exist"
+
+	DISK_ENCRYPT_FORMAT_LUKS = "luks"
 )
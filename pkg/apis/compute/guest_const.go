@@ -166,6 +166,7 @@ const (
 	HYPERVISOR_CTYUN     = "ctyun"
 	HYPERVISOR_ECLOUD    = "ecloud"
 	HYPERVISOR_JDCLOUD   = "jdcloud"
+	HYPERVISOR_OVIRT     = "ovirt"
 
 	//	HYPERVISOR_DEFAULT = HYPERVISOR_KVM
 	HYPERVISOR_DEFAULT = HYPERVISOR_KVM
@@ -197,6 +198,7 @@ var HYPERVISORS = []string{
 	HYPERVISOR_CTYUN,
 	HYPERVISOR_ECLOUD,
 	HYPERVISOR_JDCLOUD,
+	HYPERVISOR_OVIRT,
 }
 
 var ONECLOUD_HYPERVISORS = []string{
@@ -222,6 +224,7 @@ var PRIVATE_CLOUD_HYPERVISORS = []string{
 	HYPERVISOR_ZSTACK,
 	HYPERVISOR_OPENSTACK,
 	HYPERVISOR_APSARA,
+	HYPERVISOR_OVIRT,
 }
 
 // var HYPERVISORS = []string{HYPERVISOR_ALIYUN}
@@ -244,6 +247,7 @@ var HYPERVISOR_HOSTTYPE = map[string]string{
 	HYPERVISOR_CTYUN:     HOST_TYPE_CTYUN,
 	HYPERVISOR_ECLOUD:    HOST_TYPE_ECLOUD,
 	HYPERVISOR_JDCLOUD:   HOST_TYPE_JDCLOUD,
+	HYPERVISOR_OVIRT:     HOST_TYPE_OVIRT,
 }
 
 var HOSTTYPE_HYPERVISOR = map[string]string{
@@ -264,6 +268,7 @@ var HOSTTYPE_HYPERVISOR = map[string]string{
 	HOST_TYPE_CTYUN:      HYPERVISOR_CTYUN,
 	HOST_TYPE_ECLOUD:     HYPERVISOR_ECLOUD,
 	HOST_TYPE_JDCLOUD:    HYPERVISOR_JDCLOUD,
+	HOST_TYPE_OVIRT:      HYPERVISOR_OVIRT,
 }
 
 const (
@@ -432,3 +432,31 @@ type HostUpdateInput struct {
 	// 主机启动模式, 可能值位PXE和ISO
 	BootMode string `json:"boot_mode"`
 }
+
+type HostBiosSettingsInput struct {
+	// BIOS属性, 例如 {"BootMode": "Uefi"}
+	Attributes *jsonutils.JSONDict `json:"attributes"`
+	// 设置后是否重启使其生效
+	Reboot bool `json:"reboot"`
+	// 主机运行中(running)时重启需指定强制重启
+	ForceReboot bool `json:"force_reboot"`
+}
+
+type HostFirmwareUpdateInput struct {
+	// 固件镜像URL, 需可被BMC访问
+	ImageUri string `json:"image_uri"`
+	// 固件镜像名称或ID, 由baremetal agent的镜像缓存提供下载
+	Image string `json:"image"`
+	// swagger:ignore
+	ImageId string `json:"image_id"`
+	// swagger:ignore
+	ImageFormat string `json:"image_format"`
+	// swagger:ignore
+	ImageChecksum string `json:"image_checksum"`
+	// 待升级的固件, FirmwareInventory中的资源路径
+	Targets []string `json:"targets"`
+	// 升级完成后是否重启
+	Reboot bool `json:"reboot"`
+	// 主机运行中(running)时重启需指定强制重启
+	ForceReboot bool `json:"force_reboot"`
+}
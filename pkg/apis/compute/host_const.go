@@ -36,6 +36,7 @@ const (
 	HOST_TYPE_CTYUN     = "ctyun"
 	HOST_TYPE_ECLOUD    = "ecloud"
 	HOST_TYPE_JDCLOUD   = "jdcloud"
+	HOST_TYPE_OVIRT     = "ovirt"
 
 	HOST_TYPE_DEFAULT = HOST_TYPE_HYPERVISOR
 
@@ -81,6 +82,14 @@ const (
 	BAREMETAL_EJECTING_ISO    = "ejecting_iso"
 	BAREMETAL_EJECT_FAIL      = "eject_fail"
 
+	BAREMETAL_START_BIOS_SETTINGS = "start_bios_settings"
+	BAREMETAL_BIOS_SETTING        = "bios_setting"
+	BAREMETAL_BIOS_SETTINGS_FAIL  = "bios_settings_fail"
+
+	BAREMETAL_START_FIRMWARE_UPDATE = "start_firmware_update"
+	BAREMETAL_FIRMWARE_UPDATING     = "firmware_updating"
+	BAREMETAL_FIRMWARE_UPDATE_FAIL  = "firmware_update_fail"
+
 	HOST_STATUS_RUNNING = BAREMETAL_RUNNING
 	HOST_STATUS_READY   = BAREMETAL_READY
 	HOST_STATUS_UNKNOWN = BAREMETAL_UNKNOWN
@@ -91,6 +100,12 @@ const (
 	BAREMETAL_CDROM_ACTION_EJECT  = "eject"
 )
 
+const (
+	HOST_METADATA_FIRMWARE_INVENTORY     = "firmware_inventory"
+	HOST_METADATA_FIRMWARE_UPDATE_STATUS = "firmware_update_status"
+	HOST_METADATA_BIOS_ATTRIBUTES        = "bios_attributes"
+)
+
 const (
 	HostResourceTypeShared         = "shared"
 	HostResourceTypeDefault        = HostResourceTypeShared
@@ -116,6 +131,7 @@ var HOST_TYPES = []string{
 	HOST_TYPE_CTYUN,
 	HOST_TYPE_GOOGLE,
 	HOST_TYPE_JDCLOUD,
+	HOST_TYPE_OVIRT,
 }
 
 var NIC_TYPES = []string{NIC_TYPE_IPMI, NIC_TYPE_ADMIN}
@@ -78,6 +78,9 @@ type IsolatedDeviceCreateInput struct {
 
 	// 设备VendorId
 	VendorDeviceId string `json:"vendor_device_id"`
+
+	// SR-IOV VF所属二层网络, 仅NIC-VF类型设备有效
+	WireResourceInput
 }
 
 type IsolatedDeviceReservedResourceInput struct {
@@ -94,4 +97,7 @@ type IsolatedDeviceReservedResourceInput struct {
 type IsolatedDeviceUpdateInput struct {
 	apis.StandaloneResourceBaseUpdateInput
 	IsolatedDeviceReservedResourceInput
+
+	// SR-IOV VF所属二层网络, 仅NIC-VF类型设备有效
+	WireResourceInput
 }
@@ -20,6 +20,10 @@ const (
 	GPU_VGA_TYPE    = "GPU-VGA" // # for display
 	USB_TYPE        = "USB"
 	NIC_TYPE        = "NIC"
+	NIC_VF_TYPE     = "NIC-VF" // # SR-IOV virtual function, bound to guest network
+
+	// guest network driver of SR-IOV virtual function passthrough
+	NETWORK_DRIVER_VFIO = "vfio-pci"
 
 	NVIDIA_VENDOR_ID = "10de"
 	AMD_VENDOR_ID    = "1002"
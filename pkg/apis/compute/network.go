@@ -177,6 +177,32 @@ type NetworkCreateInput struct {
 	// example: 192.168.222.1,192.168.222.4
 	GuestDHCP string `json:"guest_dhcp"`
 
+	// description: ipv6 prefix of guest, if not set, you could set guest_ip6_start,guest_ip6_end and guest_ip6_mask params
+	// example: fd00:1:2:3::/64
+	GuestIp6Prefix string `json:"guest_ip6_prefix"`
+
+	// description: ipv6 range start, if set guest_ip6_prefix, this parameter will be useless
+	// example: fd00:1:2:3::10
+	GuestIp6Start string `json:"guest_ip6_start"`
+
+	// description: ipv6 range end, if set guest_ip6_prefix, this parameter will be useless
+	// example: fd00:1:2:3::ffff
+	GuestIp6End string `json:"guest_ip6_end"`
+
+	// description: ipv6 prefix length, if set guest_ip6_prefix, this parameter will be useless
+	// example: 64
+	// maximum: 126
+	// minimum: 48
+	GuestIp6Mask int8 `json:"guest_ip6_mask"`
+
+	// description: guest ipv6 gateway
+	// example: fd00:1:2:3::1
+	GuestGateway6 string `json:"guest_gateway6"`
+
+	// description: guest ipv6 dns
+	// example: 2400:3200::1
+	GuestDns6 string `json:"guest_dns6"`
+
 	// swagger:ignore
 	WireId string `json:"wire_id"`
 
@@ -319,6 +345,19 @@ type NetworkUpdateInput struct {
 
 	GuestDomain string `json:"guest_domain"`
 
+	// IPv6起始地址
+	GuestIp6Start string `json:"guest_ip6_start"`
+	// IPv6结束地址
+	GuestIp6End string `json:"guest_ip6_end"`
+	// IPv6前缀长度
+	GuestIp6Mask *int8 `json:"guest_ip6_mask"`
+	// IPv6网关地址
+	GuestGateway6 string `json:"guest_gateway6"`
+	// IPv6 DNS
+	GuestDns6 string `json:"guest_dns6"`
+
+	GuestDomain6 string `json:"guest_domain6"`
+
 	VlanId *int `json:"vlan_id"`
 
 	// 分配策略
@@ -174,3 +174,9 @@ type SGPerformDetachScalingGroupInput struct {
 	// example: true
 	Auto bool `json:"auto"`
 }
+
+type ScalingGroupSetDesiredCapacityInput struct {
+	// description: 期望实例数, 需介于最小实例数和最大实例数之间
+	// example: 2
+	DesiredCapacity int `json:"desired_capacity"`
+}
@@ -15,8 +15,12 @@
 package compute
 
 import (
+	"reflect"
 	"time"
 
+	"yunion.io/x/jsonutils"
+	"yunion.io/x/pkg/gotypes"
+
 	"yunion.io/x/onecloud/pkg/apis"
 )
 
@@ -46,7 +50,7 @@ type ScheduledTaskListInput struct {
 
 	// description: resource type
 	// example: server
-	// enum: server
+	// enum: server,disk,dbinstance,elasticcache,scalinggroup,loadbalancer
 	ResourceType string `json:"resource_type"`
 
 	// description: label type
@@ -55,7 +59,6 @@ type ScheduledTaskListInput struct {
 
 	// description: operation
 	// example: stop
-	// enum: start,stop,restart
 	Operation string `json:"operation"`
 }
 
@@ -71,15 +74,17 @@ type ScheduledTaskCreateInput struct {
 	CycleTimer    CycleTimerCreateInput `json:"cycle_timer"`
 
 	// description: resource type
-	// enum: server
+	// enum: server,disk,dbinstance,elasticcache,scalinggroup,loadbalancer
 	// example: server
 	ResourceType string `json:"resource_type"`
-	// description: operation
-	// enum: start,stop,restart
+	// description: operation, the supported operations of each resource type can be got by /scheduledtasks/operations
 	// example: stop
 	Operation string `json:"operation"`
+	// description: operation params, passed to the perform action of resource
+	// example: {"size": 20480}
+	OperationParams *jsonutils.JSONDict `json:"operation_params"`
 	// description: label type
-	// enum: tag,id
+	// enum: tag,id,project
 	// example: id
 	LabelType string `json:"label_type"`
 	// description: labels
@@ -115,9 +120,39 @@ type ScheduledTaskActivityListInput struct {
 	ScheduledTask string `json:"scheduled_task"`
 }
 
+// 定时任务执行时单个资源的执行结果
+type ScheduledTaskActivityResult struct {
+	ResourceId   string `json:"resource_id"`
+	ResourceName string `json:"resource_name"`
+	Succeed      bool   `json:"succeed"`
+	Reason       string `json:"reason"`
+}
+
+type ScheduledTaskActivityResults []ScheduledTaskActivityResult
+
+func (results ScheduledTaskActivityResults) String() string {
+	return jsonutils.Marshal(results).String()
+}
+
+func (results ScheduledTaskActivityResults) IsZero() bool {
+	return len(results) == 0
+}
+
+type ScheduledTaskOperation struct {
+	ResourceType   string   `json:"resource_type"`
+	Operation      string   `json:"operation"`
+	RequiredParams []string `json:"required_params"`
+}
+
 type ScheduledTaskSetLabelsInput struct {
 	Labels []string `json:"labels"`
 }
 
 type ScheduledTaskTriggerInput struct {
 }
+
+func init() {
+	gotypes.RegisterSerializable(reflect.TypeOf(&ScheduledTaskActivityResults{}), func() gotypes.ISerializable {
+		return &ScheduledTaskActivityResults{}
+	})
+}
@@ -21,14 +21,27 @@ const (
 	ST_STATUS_READY         = "ready"
 	ST_STATUS_CREATE_FAILED = "create_failed"
 
-	ST_RESOURCE_SERVER = "server"
+	ST_RESOURCE_SERVER       = "server"
+	ST_RESOURCE_DISK         = "disk"
+	ST_RESOURCE_DBINSTANCE   = "dbinstance"
+	ST_RESOURCE_ELASTICCACHE = "elasticcache"
+	ST_RESOURCE_SCALINGGROUP = "scalinggroup"
+	ST_RESOURCE_LOADBALANCER = "loadbalancer"
 
-	ST_RESOURCE_OPERATION_START   = "start"
-	ST_RESOURCE_OPERATION_STOP    = "stop"
-	ST_RESOURCE_OPERATION_RESTART = "restart"
+	ST_RESOURCE_OPERATION_START                = "start"
+	ST_RESOURCE_OPERATION_STOP                 = "stop"
+	ST_RESOURCE_OPERATION_RESTART              = "restart"
+	ST_RESOURCE_OPERATION_SNAPSHOT             = "snapshot"
+	ST_RESOURCE_OPERATION_RESIZE               = "resize"
+	ST_RESOURCE_OPERATION_CHANGE_CONFIG        = "change-config"
+	ST_RESOURCE_OPERATION_CHANGE_SPEC          = "change-spec"
+	ST_RESOURCE_OPERATION_SET_DESIRED_CAPACITY = "set-desired-capacity"
+	ST_RESOURCE_OPERATION_ENABLE               = "enable"
+	ST_RESOURCE_OPERATION_DISABLE              = "disable"
 
-	ST_LABEL_ID  = "id"
-	ST_LABEL_TAG = "tag"
+	ST_LABEL_ID      = "id"
+	ST_LABEL_TAG     = "tag"
+	ST_LABEL_PROJECT = "project"
 
 	ST_ACTIVITY_STATUS_EXEC         = "execution"    // 执行中
 	ST_ACTIVITY_STATUS_SUCCEED      = "succeed"      // 成功
@@ -36,3 +49,20 @@ const (
 	ST_ACTIVITY_STATUS_FAILED       = "failed"       // 失败
 	ST_ACTIVITY_STATUS_REJECT       = "reject"       // 拒绝
 )
+
+var (
+	ST_RESOURCE_TYPES = []string{
+		ST_RESOURCE_SERVER,
+		ST_RESOURCE_DISK,
+		ST_RESOURCE_DBINSTANCE,
+		ST_RESOURCE_ELASTICCACHE,
+		ST_RESOURCE_SCALINGGROUP,
+		ST_RESOURCE_LOADBALANCER,
+	}
+
+	ST_LABEL_TYPES = []string{
+		ST_LABEL_ID,
+		ST_LABEL_TAG,
+		ST_LABEL_PROJECT,
+	}
+)
@@ -22,6 +22,7 @@ import (
 	"yunion.io/x/pkg/util/secrules"
 
 	"yunion.io/x/onecloud/pkg/apis"
+	"yunion.io/x/onecloud/pkg/util/regutils2"
 )
 
 type SSecgroupRuleResource struct {
@@ -128,7 +129,7 @@ func (input *SSecgroupRuleResource) Check() error {
 	}
 
 	if len(input.CIDR) > 0 {
-		if !regutils.MatchCIDR(input.CIDR) && !regutils.MatchIPAddr(input.CIDR) {
+		if !regutils.MatchCIDR(input.CIDR) && !regutils.MatchIPAddr(input.CIDR) && !regutils2.MatchCIDR6(input.CIDR) {
 			return fmt.Errorf("invalid ip address: %s", input.CIDR)
 		}
 	} else {
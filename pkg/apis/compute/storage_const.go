@@ -84,6 +84,12 @@ const (
 	STORAGE_ZSTACK_LOCAL_STORAGE = "localstorage"
 	STORAGE_ZSTACK_CEPH          = "ceph"
 
+	// oVirt storage type, nfs and iscsi storage domains use STORAGE_NFS and STORAGE_OPENSTACK_ISCSI
+	STORAGE_OVIRT_FCP       = "fcp"
+	STORAGE_OVIRT_GLUSTERFS = "glusterfs"
+	STORAGE_OVIRT_LOCALFS   = "localfs"
+	STORAGE_OVIRT_POSIXFS   = "posixfs"
+
 	// Google storage type
 	STORAGE_GOOGLE_LOCAL_SSD   = "local-ssd"   //本地SSD暂存盘 (最多8个)
 	STORAGE_GOOGLE_PD_STANDARD = "pd-standard" //标准永久性磁盘
@@ -148,6 +154,7 @@ var (
 		STORAGE_OPENSTACK_ISCSI, STORAGE_UCLOUD_CLOUD_NORMAL, STORAGE_UCLOUD_CLOUD_SSD,
 		STORAGE_UCLOUD_LOCAL_NORMAL, STORAGE_UCLOUD_LOCAL_SSD, STORAGE_UCLOUD_EXCLUSIVE_LOCAL_DISK,
 		STORAGE_ZSTACK_LOCAL_STORAGE, STORAGE_ZSTACK_CEPH, STORAGE_GPFS, STORAGE_CIFS,
+		STORAGE_OVIRT_FCP, STORAGE_OVIRT_GLUSTERFS, STORAGE_OVIRT_LOCALFS, STORAGE_OVIRT_POSIXFS,
 	}
 
 	HOST_STORAGE_LOCAL_TYPES = []string{STORAGE_LOCAL, STORAGE_BAREMETAL, STORAGE_ZSTACK_LOCAL_STORAGE, STORAGE_OPENSTACK_NOVA}
@@ -45,3 +45,19 @@ type StoragecacheListInput struct {
 	// 路径过滤
 	Path []string `json:"path"`
 }
+
+type StoragecacheCachedImage struct {
+	// 镜像ID
+	ImageId string `json:"image_id"`
+	// 缓存文件路径
+	Path string `json:"path"`
+	// 缓存镜像格式
+	Format string `json:"format"`
+}
+
+type StoragecacheReportCachedImagesInput struct {
+	// 已缓存完成的镜像列表
+	Images []StoragecacheCachedImage `json:"images"`
+	// 是否全量上报, 全量上报时未上报的已缓存镜像状态置为unknown
+	Full bool `json:"full"`
+}
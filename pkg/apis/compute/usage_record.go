@@ -0,0 +1,146 @@
+// Copyright 2019 Yunion
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package compute
+
+import (
+	"time"
+
+	"yunion.io/x/onecloud/pkg/apis"
+)
+
+const (
+	USAGE_RESOURCE_SERVER = "server"
+	USAGE_RESOURCE_DISK   = "disk"
+	USAGE_RESOURCE_EIP    = "eip"
+	USAGE_RESOURCE_BUCKET = "bucket"
+	USAGE_RESOURCE_GPU    = "gpu"
+
+	USAGE_RECORD_DEFAULT_CURRENCY = "CNY"
+)
+
+var USAGE_RESOURCE_TYPES = []string{
+	USAGE_RESOURCE_SERVER,
+	USAGE_RESOURCE_DISK,
+	USAGE_RESOURCE_EIP,
+	USAGE_RESOURCE_BUCKET,
+	USAGE_RESOURCE_GPU,
+}
+
+type UsageRecordListInput struct {
+	apis.StandaloneAnonResourceListInput
+	apis.ProjectizedResourceListInput
+	ZonalFilterListInput
+
+	// 资源类型
+	// enum: server, disk, eip, bucket, gpu
+	ResourceType []string `json:"resource_type"`
+	// 资源ID
+	ResourceId []string `json:"resource_id"`
+	// 规格
+	Spec []string `json:"spec"`
+
+	// 统计周期开始时间
+	StartTime time.Time `json:"start_time"`
+	// 统计周期结束时间
+	EndTime time.Time `json:"end_time"`
+}
+
+type UsageRecordDetails struct {
+	apis.StandaloneAnonResourceDetails
+	apis.ProjectizedResourceInfo
+	ZoneResourceInfo
+
+	SUsageRecord
+}
+
+type UsageRecordProjectCostInput struct {
+	apis.ScopedResourceInput
+
+	// 统计周期开始时间, 默认为当月第一天
+	StartTime time.Time `json:"start_time"`
+	// 统计周期结束时间, 默认为当前时间
+	EndTime time.Time `json:"end_time"`
+
+	// 资源类型
+	ResourceType []string `json:"resource_type"`
+	// 项目ID
+	ProjectIds []string `json:"project_ids"`
+}
+
+type UsageRecordProjectCost struct {
+	ProjectId     string `json:"tenant_id"`
+	Project       string `json:"tenant"`
+	ProjectDomain string `json:"project_domain"`
+	ResourceType  string `json:"resource_type"`
+
+	// 累计使用时长(小时)
+	UsageHours float64 `json:"usage_hours"`
+	// 费用
+	Cost     float64 `json:"cost"`
+	Currency string  `json:"currency"`
+}
+
+type UsageRecordProjectCostOutput struct {
+	StartTime time.Time                `json:"start_time"`
+	EndTime   time.Time                `json:"end_time"`
+	Data      []UsageRecordProjectCost `json:"data"`
+}
+
+type RateCardListInput struct {
+	apis.EnabledStatusStandaloneResourceListInput
+	ZonalFilterListInput
+
+	// 资源类型
+	ResourceType []string `json:"resource_type"`
+	// 规格
+	Spec []string `json:"spec"`
+}
+
+type RateCardDetails struct {
+	apis.EnabledStatusStandaloneResourceDetails
+	ZoneResourceInfo
+
+	SRateCard
+}
+
+type RateCardCreateInput struct {
+	apis.EnabledStatusStandaloneResourceCreateInput
+
+	// 资源类型
+	// enum: server, disk, eip, bucket, gpu
+	// required: true
+	ResourceType string `json:"resource_type"`
+
+	// 可用区, 为空表示适用于所有可用区
+	ZoneResourceInput
+
+	// 规格, 主机为套餐名称, 磁盘为存储类型, GPU为设备型号, 为空表示适用于该资源类型的所有规格
+	Spec string `json:"spec"`
+
+	// 每计量单位每小时价格
+	// 主机: 台, 磁盘和存储桶: GB, EIP: Mbps, GPU: 块
+	Price float64 `json:"price"`
+
+	// 货币, 默认CNY
+	Currency string `json:"currency"`
+}
+
+type RateCardUpdateInput struct {
+	apis.EnabledStatusStandaloneResourceBaseUpdateInput
+
+	Price *float64 `json:"price"`
+
+	Currency string `json:"currency"`
+}
@@ -504,6 +504,7 @@ type SDisk struct {
 	SBillingResourceBase
 	SStorageResourceBase
 	apis.SMultiArchResourceBase
+	SEncryptedResource
 	// 磁盘存储类型
 	// example: qcow2
 	DiskFormat string `json:"disk_format"`
@@ -830,6 +831,14 @@ type SElasticip struct {
 	AutoDellocate *bool `json:"auto_dellocate,omitempty"`
 }
 
+// SEncryptedResource is an autogenerated struct via yunion.io/x/onecloud/pkg/compute/models.SEncryptedResource.
+type SEncryptedResource struct {
+	// 加密主密钥ID
+	EncryptKeyId string `json:"encrypt_key_id"`
+	// 被主密钥加密的数据密钥
+	EncryptedDataKey string `json:"encrypted_data_key"`
+}
+
 // SExternalProject is an autogenerated struct via yunion.io/x/onecloud/pkg/compute/models.SExternalProject.
 type SExternalProject struct {
 	apis.SVirtualResourceBase
@@ -1292,6 +1301,10 @@ type SIsolatedDevice struct {
 	// # pci address of `Bus:Device.Function` format, or usb bus address of `bus.addr`
 	Addr           string `json:"addr"`
 	VendorDeviceId string `json:"vendor_device_id"`
+	// SR-IOV VF所属二层网络Id
+	WireId string `json:"wire_id"`
+	// SR-IOV VF绑定的云主机网卡序号, -1表示未绑定
+	NetworkIndex int `json:"network_index"`
 	// reserved memory size for isolated device, default 8G
 	ReservedMemory int `json:"reserved_memory"`
 	// reserved cpu count for isolated device, default 8
@@ -1869,6 +1882,20 @@ type SQcloudCachedLbbg struct {
 	AssociatedType string `json:"associated_type"`
 }
 
+// SRateCard is an autogenerated struct via yunion.io/x/onecloud/pkg/compute/models.SRateCard.
+type SRateCard struct {
+	apis.SEnabledStatusStandaloneResourceBase
+	SZoneResourceBase
+	// 资源类型
+	ResourceType string `json:"resource_type"`
+	// 规格
+	Spec string `json:"spec"`
+	// 每计量单位每小时价格
+	Price float64 `json:"price"`
+	// 货币
+	Currency string `json:"currency"`
+}
+
 // SReservedip is an autogenerated struct via yunion.io/x/onecloud/pkg/compute/models.SReservedip.
 type SReservedip struct {
 	apis.SResourceBase
@@ -2178,6 +2205,7 @@ type SSnapshot struct {
 	SManagedResourceBase
 	SCloudregionResourceBase
 	apis.SMultiArchResourceBase
+	SEncryptedResource
 	// 磁盘Id
 	DiskId string `json:"disk_id"`
 	// Only onecloud has StorageId
@@ -2287,6 +2315,8 @@ type SStoragecachedimage struct {
 	// 镜像状态
 	Status string `json:"status"`
 	Path   string `json:"path"`
+	// 缓存镜像格式
+	Format string `json:"format"`
 	// 上次下载时间
 	LastDownload time.Time `json:"last_download"`
 	// 下载引用次数
@@ -2322,6 +2352,35 @@ type STimer struct {
 	IsExpired bool   `json:"is_expired"`
 }
 
+// SUsageRecord is an autogenerated struct via yunion.io/x/onecloud/pkg/compute/models.SUsageRecord.
+type SUsageRecord struct {
+	apis.SStandaloneAnonResourceBase
+	apis.SProjectizedResourceBase
+	SZoneResourceBase
+	// 资源类型
+	ResourceType string `json:"resource_type"`
+	// 资源ID
+	ResourceId string `json:"resource_id"`
+	// 资源名称
+	ResourceName string `json:"resource_name"`
+	// 规格
+	Spec string `json:"spec"`
+	// GPU所挂载的主机ID
+	GuestId string `json:"guest_id"`
+	// 计量数量, 主机: 台, 磁盘和存储桶: GB, EIP: Mbps, GPU: 块
+	Amount float64 `json:"amount"`
+	// 统计周期开始时间
+	StartTime time.Time `json:"start_time"`
+	// 统计周期结束时间
+	EndTime time.Time `json:"end_time"`
+	// 统计周期内的使用时长, 单位秒
+	Duration   int     `json:"duration"`
+	RateCardId string  `json:"rate_card_id"`
+	Price      float64 `json:"price"`
+	Cost       float64 `json:"cost"`
+	Currency   string  `json:"currency"`
+}
+
 // SVCenter is an autogenerated struct via yunion.io/x/onecloud/pkg/compute/models.SVCenter.
 type SVCenter struct {
 	apis.SEnabledStatusStandaloneResourceBase
@@ -41,6 +41,8 @@ const (
 	SERVICE_TYPE_REGION            = "compute"
 	SERVICE_TYPE_SUGGESTION        = "suggestion"
 
+	SERVICE_TYPE_TORRENT_TRACKER = "torrent-tracker"
+
 	SERVICE_TYPE_ETCD     = "etcd"
 	SERVICE_TYPE_INFLUXDB = "influxdb"
 )
@@ -25,6 +25,9 @@ const (
 	TOTP_TYPE             = "totp"
 	RECOVERY_SECRETS_TYPE = "recovery_secret"
 	OIDC_CREDENTIAL_TYPE  = "oidc"
+	ENCRYPT_KEY_TYPE      = "enc_key"
+
+	ENCRYPT_KEY_ALG_AES_256 = "aes-256"
 )
 
 type SAccessKeySecretBlob struct {
@@ -43,3 +46,11 @@ type SAccessKeySecretInfo struct {
 	AccessKey string
 	SAccessKeySecretBlob
 }
+
+// SEncryptKeySecretBlob is the blob of a project scoped master key which
+// wraps the data keys of encrypted resources, e.g. disks
+type SEncryptKeySecretBlob struct {
+	Alg string `json:"alg"`
+	// base64 encoded key
+	Key string `json:"key"`
+}
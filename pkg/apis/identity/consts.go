@@ -66,6 +66,7 @@ const (
 	IdentityDriverSAML   = "saml"
 	IdentityDriverOIDC   = "oidc"   // OpenID Connect
 	IdentityDriverOAuth2 = "oauth2" // OAuth2.0
+	IdentityDriverSCIM   = "scim"   // SCIM 2.0 provisioning
 
 	IdentityDriverStatusConnected    = "connected"
 	IdentityDriverStatusDisconnected = "disconnected"
@@ -75,6 +76,7 @@ const (
 	IdentityProviderSyncLocal  = "local"
 	IdentityProviderSyncFull   = "full"
 	IdentityProviderSyncOnAuth = "auth"
+	IdentityProviderSyncPush   = "push"
 
 	IdentitySyncStatusQueued  = "queued"
 	IdentitySyncStatusSyncing = "syncing"
@@ -95,6 +97,9 @@ var (
 		"ldap": []string{
 			"password",
 		},
+		"scim": []string{
+			"bearer_token",
+		},
 	}
 
 	CommonWhitelistOptionMap = map[string][]string{
@@ -31,4 +31,7 @@ type CredentialUpdateInput struct {
 
 	// enabled
 	Enabled *bool `json:"enabled"`
+
+	// 更新凭证内容, 目前仅支持 webauthn 类型
+	Blob string `json:"blob"`
 }
@@ -40,6 +40,10 @@ type DomainUpdateInput struct {
 
 	// 是否启用
 	Enabled *bool `json:"enabled"`
+
+	// 要求域内用户使用的二次认证方式
+	// enum: none, totp, webauthn
+	MfaMethod string `json:"mfa_method"`
 }
 
 type DomainCreateInput struct {
@@ -50,4 +54,8 @@ type DomainCreateInput struct {
 
 	// 是否启用
 	Enabled *bool `json:"enabled"`
+
+	// 要求域内用户使用的二次认证方式
+	// enum: none, totp, webauthn
+	MfaMethod string `json:"mfa_method"`
 }
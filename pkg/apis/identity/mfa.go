@@ -0,0 +1,70 @@
+// Copyright 2019 Yunion
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package identity
+
+const (
+	WEBAUTHN_CREDENTIAL_TYPE = "webauthn"
+	RECOVERY_CODES_TYPE      = "recovery_codes"
+
+	// the second factor methods a domain can require its users to use
+	MfaMethodNone     = "none"
+	MfaMethodTotp     = "totp"
+	MfaMethodWebAuthn = "webauthn"
+
+	RecoveryCodeCount = 10
+)
+
+var (
+	MfaMethods = []string{
+		MfaMethodNone,
+		MfaMethodTotp,
+		MfaMethodWebAuthn,
+	}
+)
+
+// SWebAuthnCredentialBlob is the blob of a credential of type webauthn,
+// each registered authenticator of a user is stored as a credential
+type SWebAuthnCredentialBlob struct {
+	// base64url encoded credential id
+	CredentialId string `json:"credential_id"`
+	// base64url encoded COSE public key
+	PublicKey string `json:"public_key"`
+	// signature counter of the authenticator
+	SignCount int64 `json:"sign_count"`
+	// hex encoded AAGUID of the authenticator model
+	Aaguid    string `json:"aaguid"`
+	Timestamp int64  `json:"timestamp"`
+}
+
+// SRecoveryCodesBlob is the blob of a credential of type recovery_codes,
+// only the hashes of unused codes are stored
+type SRecoveryCodesBlob struct {
+	Codes     []string `json:"codes"`
+	Timestamp int64    `json:"timestamp"`
+}
+
+type CredentialConsumeRecoveryCodeInput struct {
+	// 恢复码
+	Code string `json:"code"`
+}
+
+type CredentialConsumeWebAuthnChallengeInput struct {
+	// 用户ID
+	UserId string `json:"user_id"`
+	// base64url编码的挑战码
+	Challenge string `json:"challenge"`
+	// 挑战码过期时间, unix时间戳
+	Expires int64 `json:"expires"`
+}
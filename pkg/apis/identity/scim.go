@@ -0,0 +1,26 @@
+// Copyright 2019 Yunion
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package identity
+
+const (
+	SCIMBearerTokenMinLength = 16
+)
+
+// SCIM 2.0 provisioning Config Options
+type SSCIMIdpConfigOptions struct {
+	// bearer token the SCIM client, e.g. Okta or Azure AD, uses to access
+	// the provisioning endpoint /scim/v2/<idp_id>
+	BearerToken string `json:"bearer_token"`
+}
@@ -60,6 +60,8 @@ type SDomain struct {
 	IsDomain *bool       `json:"is_domain,omitempty"`
 	DomainId string      `json:"domain_id"`
 	ParentId string      `json:"parent_id"`
+	// 要求域内用户使用的二次认证方式
+	MfaMethod string `json:"mfa_method"`
 }
 
 // SEnabledIdentityBaseResource is an autogenerated struct via yunion.io/x/onecloud/pkg/keystone/models.SEnabledIdentityBaseResource.
@@ -55,6 +55,19 @@ const (
 	IMAGE_DISABLE_USB_KBD     = "disable_usb_kbd"
 
 	IMAGE_STATUS_UPDATING = "updating"
+
+	// the image is not signed or its signature does not verify with
+	// the trusted signing keys of its domain
+	IMAGE_STATUS_UNVERIFIED = "unverified"
+
+	IMAGE_SIGNATURE_STATUS_UNSIGNED = "unsigned"
+	IMAGE_SIGNATURE_STATUS_VERIFIED = "verified"
+	IMAGE_SIGNATURE_STATUS_INVALID  = "invalid"
+
+	// image meta of uploading a queued image in chunks, the image stays
+	// queued and reports the received bytes in its size until the final chunk
+	IMAGE_UPLOAD_OFFSET = "upload_offset"
+	IMAGE_UPLOAD_FINAL  = "upload_final"
 )
 
 var (
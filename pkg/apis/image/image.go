@@ -89,6 +89,15 @@ type ImageCreateInput struct {
 
 	// 镜像属性
 	Properties map[string]string `json:"properties"`
+
+	// 镜像的分离签名, cosign签名的base64编码或者minisign的.minisig文件内容
+	Signature string `json:"signature"`
+}
+
+type ImagePerformSignInput struct {
+	// 镜像的分离签名, cosign签名的base64编码或者minisign的.minisig文件内容
+	// required: true
+	Signature string `json:"signature"`
 }
 
 type ImageUpdateStatusInput struct {
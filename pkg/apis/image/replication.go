@@ -0,0 +1,121 @@
+// Copyright 2019 Yunion
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package image
+
+import (
+	"reflect"
+
+	"yunion.io/x/jsonutils"
+	"yunion.io/x/pkg/gotypes"
+
+	"yunion.io/x/onecloud/pkg/apis"
+)
+
+const (
+	IMAGE_REPLICATION_STATUS_PENDING     = "pending"
+	IMAGE_REPLICATION_STATUS_REPLICATING = "replicating"
+	IMAGE_REPLICATION_STATUS_VERIFYING   = "verifying"
+	IMAGE_REPLICATION_STATUS_READY       = "ready"
+	IMAGE_REPLICATION_STATUS_FAILED      = "failed"
+
+	// the target image records where it is replicated from in its properties
+	IMAGE_REPLICATED_FROM_REGION   = "replicated_from_region"
+	IMAGE_REPLICATED_FROM_IMAGE    = "replicated_from_image"
+	IMAGE_REPLICATED_FROM_CHECKSUM = "replicated_from_checksum"
+)
+
+type ImageReplicationPolicyCreateInput struct {
+	apis.StandaloneResourceCreateInput
+	apis.EnabledBaseResourceCreateInput
+
+	// 镜像复制的目标区域, 目标区域需要部署glance服务
+	// required: true
+	TargetRegions []string `json:"target_regions"`
+
+	// 按标签匹配需要复制的镜像, 需匹配全部标签
+	Tags []apis.STag `json:"tags"`
+
+	// 按项目匹配需要复制的镜像, 项目ID或名称
+	ProjectIds []string `json:"project_ids"`
+}
+
+type ImageReplicationPolicyUpdateInput struct {
+	apis.StandaloneResourceBaseUpdateInput
+
+	TargetRegions []string    `json:"target_regions"`
+	Tags          []apis.STag `json:"tags"`
+	ProjectIds    []string    `json:"project_ids"`
+}
+
+type ImageReplicationPolicyListInput struct {
+	apis.StandaloneResourceListInput
+	apis.EnabledResourceBaseListInput
+
+	// 以目标区域过滤
+	TargetRegion string `json:"target_region"`
+}
+
+type ImageReplicationPolicyDetails struct {
+	apis.StandaloneResourceDetails
+
+	SImageReplicationPolicy
+
+	// 各状态的复制任务数量
+	ReplicationCount map[string]int `json:"replication_count"`
+}
+
+type ImageReplicationListInput struct {
+	apis.StatusStandaloneResourceListInput
+
+	// 以复制策略过滤
+	PolicyId string `json:"policy_id"`
+	// 以源镜像过滤
+	ImageId string `json:"image_id"`
+	// 以目标区域过滤
+	TargetRegion []string `json:"target_region"`
+}
+
+type ImageReplicationDetails struct {
+	apis.StatusStandaloneResourceDetails
+
+	SImageReplication
+
+	// 复制策略名称
+	Policy string `json:"policy"`
+	// 源镜像名称
+	Image string `json:"image"`
+}
+
+type ImageReplicationSubformat struct {
+	Format   string `json:"format"`
+	Checksum string `json:"checksum"`
+	Status   string `json:"status"`
+}
+
+type ImageReplicationSubformats []ImageReplicationSubformat
+
+func (s ImageReplicationSubformats) String() string {
+	return jsonutils.Marshal(s).String()
+}
+
+func (s ImageReplicationSubformats) IsZero() bool {
+	return len(s) == 0
+}
+
+func init() {
+	gotypes.RegisterSerializable(reflect.TypeOf(&ImageReplicationSubformats{}), func() gotypes.ISerializable {
+		return &ImageReplicationSubformats{}
+	})
+}
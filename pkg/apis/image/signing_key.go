@@ -0,0 +1,54 @@
+// Copyright 2019 Yunion
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package image
+
+import "yunion.io/x/onecloud/pkg/apis"
+
+type ImageSigningKeyCreateInput struct {
+	apis.DomainLevelResourceCreateInput
+	apis.EnabledBaseResourceCreateInput
+
+	// 公钥, PEM格式的ECDSA/RSA公钥(如cosign生成的公钥)或者minisign公钥
+	// required: true
+	PublicKey string `json:"public_key"`
+
+	// swagger:ignore
+	Algorithm string `json:"algorithm"`
+	// swagger:ignore
+	Fingerprint string `json:"fingerprint"`
+}
+
+type ImageSigningKeyUpdateInput struct {
+	apis.DomainLevelResourceBaseUpdateInput
+}
+
+type ImageSigningKeyListInput struct {
+	apis.DomainLevelResourceListInput
+	apis.EnabledResourceBaseListInput
+
+	// 以公钥算法过滤
+	Algorithm []string `json:"algorithm"`
+	// 以公钥指纹过滤
+	Fingerprint string `json:"fingerprint"`
+}
+
+type ImageSigningKeyDetails struct {
+	apis.DomainLevelResourceDetails
+
+	SImageSigningKey
+
+	// 此公钥校验通过的镜像数量
+	SignedImageCount int `json:"signed_image_count"`
+}
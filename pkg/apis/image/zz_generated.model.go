@@ -15,6 +15,8 @@
 package image
 
 import (
+	time "time"
+
 	"yunion.io/x/onecloud/pkg/apis"
 )
 
@@ -62,6 +64,14 @@ type SImage struct {
 	// image copy from url, save origin checksum before probe
 	// 从镜像时长导入的镜像校验和
 	OssChecksum string `json:"oss_checksum"`
+	// SHA-256 校验和
+	Sha256Checksum string `json:"sha256_checksum"`
+	// 镜像的分离签名
+	Signature string `json:"signature"`
+	// 签名校验状态
+	SignatureStatus string `json:"signature_status"`
+	// 校验签名的公钥ID
+	SigningKeyId string `json:"signing_key_id"`
 }
 
 // SImageMember is an autogenerated struct via yunion.io/x/onecloud/pkg/image/models.SImageMember.
@@ -85,6 +95,59 @@ type SImageProperty struct {
 	Value string `json:"value"`
 }
 
+// SImageReplication is an autogenerated struct via yunion.io/x/onecloud/pkg/image/models.SImageReplication.
+type SImageReplication struct {
+	apis.SStatusStandaloneResourceBase
+	// 复制策略ID
+	PolicyId string `json:"policy_id"`
+	// 源镜像ID
+	ImageId string `json:"image_id"`
+	// 目标区域
+	TargetRegion string `json:"target_region"`
+	// 目标镜像ID
+	TargetImageId string `json:"target_image_id"`
+	// 已上传到目标镜像的大小
+	UploadedSize int64 `json:"uploaded_size"`
+	// 源镜像校验和
+	Checksum string `json:"checksum"`
+	// 目标镜像校验和
+	TargetChecksum string `json:"target_checksum"`
+	// 目标镜像的子格式
+	TargetSubformats *ImageReplicationSubformats `json:"target_subformats"`
+	// 已尝试次数
+	Attempts int `json:"attempts"`
+	// 最近一次失败原因
+	LastError string `json:"last_error"`
+	// 开始校验时间
+	VerifyStartedAt time.Time `json:"verify_started_at"`
+	// 复制完成时间
+	ReplicatedAt time.Time `json:"replicated_at"`
+}
+
+// SImageReplicationPolicy is an autogenerated struct via yunion.io/x/onecloud/pkg/image/models.SImageReplicationPolicy.
+type SImageReplicationPolicy struct {
+	apis.SStandaloneResourceBase
+	apis.SEnabledResourceBase
+	// 复制的目标区域
+	TargetRegions interface{} `json:"target_regions"`
+	// 按标签匹配镜像
+	Tags interface{} `json:"tags"`
+	// 按项目匹配镜像
+	ProjectIds interface{} `json:"project_ids"`
+}
+
+// SImageSigningKey is an autogenerated struct via yunion.io/x/onecloud/pkg/image/models.SImageSigningKey.
+type SImageSigningKey struct {
+	apis.SDomainLevelResourceBase
+	apis.SEnabledResourceBase
+	// 公钥, PEM格式或者minisign格式
+	PublicKey string `json:"public_key"`
+	// 公钥算法
+	Algorithm string `json:"algorithm"`
+	// 公钥指纹
+	Fingerprint string `json:"fingerprint"`
+}
+
 // SImageSubformat is an autogenerated struct via yunion.io/x/onecloud/pkg/image/models.SImageSubformat.
 type SImageSubformat struct {
 	SImagePeripheral
@@ -92,6 +155,7 @@ type SImageSubformat struct {
 	Size            int64  `json:"size"`
 	Location        string `json:"location"`
 	Checksum        string `json:"checksum"`
+	Sha256Checksum  string `json:"sha256_checksum"`
 	FastHash        string `json:"fast_hash"`
 	Status          string `json:"status"`
 	TorrentSize     int64  `json:"torrent_size"`
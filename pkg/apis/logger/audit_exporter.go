@@ -0,0 +1,125 @@
+// Copyright 2019 Yunion
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package logger
+
+import (
+	"time"
+
+	"yunion.io/x/onecloud/pkg/apis"
+)
+
+const (
+	AUDIT_EXPORTER_STATUS_READY = "ready"
+	AUDIT_EXPORTER_STATUS_ERROR = "error"
+
+	// 仅导出创建之后的操作日志
+	AUDIT_EXPORTER_START_LATEST = "latest"
+	// 从保留的最早的操作日志开始导出
+	AUDIT_EXPORTER_START_EARLIEST = "earliest"
+)
+
+type AuditExporterCreateInput struct {
+	apis.EnabledStatusStandaloneResourceCreateInput
+
+	// 接收端类型
+	// required: true
+	// enum: syslog, kafka, http
+	Type string `json:"type"`
+
+	// 日志格式, 默认为json
+	// enum: rfc5424, cef, leef, json
+	Format string `json:"format"`
+
+	// syslog服务器的host:port, 以逗号分隔的kafka broker或HTTP采集器的地址
+	// required: true
+	// example: siem.example.com:6514
+	Address string `json:"address"`
+
+	// kafka topic
+	Topic string `json:"topic"`
+
+	// 是否使用TLS连接syslog服务器或kafka
+	Tls *bool `json:"tls"`
+
+	// 是否跳过TLS证书校验
+	InsecureSkipVerify *bool `json:"insecure_skip_verify"`
+
+	// 校验接收端证书的CA证书(PEM格式), 为空则使用系统CA
+	CaCert string `json:"ca_cert"`
+
+	// HTTP请求附带的header, 如Authorization
+	Headers map[string]string `json:"headers"`
+
+	// 导出的资源类型, 为空表示所有资源类型
+	ObjType []string `json:"obj_type"`
+
+	// 导出的操作, 为空表示所有操作
+	Action []string `json:"action"`
+
+	// 导出的服务, 为空表示所有服务
+	Service []string `json:"service"`
+
+	// 导出的起点, 默认为latest
+	// enum: latest, earliest
+	StartFrom string `json:"start_from"`
+}
+
+type AuditExporterUpdateInput struct {
+	apis.EnabledStatusStandaloneResourceBaseUpdateInput
+
+	// 日志格式
+	Format string `json:"format"`
+
+	// 接收端地址
+	Address string `json:"address"`
+
+	// kafka topic
+	Topic string `json:"topic"`
+
+	Tls                *bool   `json:"tls"`
+	InsecureSkipVerify *bool   `json:"insecure_skip_verify"`
+	CaCert             *string `json:"ca_cert"`
+
+	Headers map[string]string `json:"headers"`
+
+	ObjType []string `json:"obj_type"`
+	Action  []string `json:"action"`
+	Service []string `json:"service"`
+}
+
+type AuditExporterListInput struct {
+	apis.EnabledStatusStandaloneResourceListInput
+
+	// 以接收端类型过滤
+	Type []string `json:"type"`
+	// 以日志格式过滤
+	Format []string `json:"format"`
+}
+
+type AuditExporterDetails struct {
+	apis.EnabledStatusStandaloneResourceDetails
+
+	// 尚未导出的操作日志数量, 以操作日志ID之差估算
+	PendingCount int64 `json:"pending_count"`
+
+	// HTTP请求附带的header, 值已隐藏
+	Headers map[string]string `json:"headers"`
+}
+
+type AuditExporterResetCursorInput struct {
+	// 从该时间之后的操作日志重新导出
+	// required: true
+	Since time.Time `json:"since"`
+}
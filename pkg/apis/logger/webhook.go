@@ -0,0 +1,174 @@
+// Copyright 2019 Yunion
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package logger
+
+import (
+	"time"
+
+	"yunion.io/x/jsonutils"
+
+	"yunion.io/x/onecloud/pkg/apis"
+)
+
+const (
+	WEBHOOK_SUBSCRIPTION_STATUS_READY = "ready"
+
+	WEBHOOK_SCOPE_SYSTEM  = "system"
+	WEBHOOK_SCOPE_DOMAIN  = "domain"
+	WEBHOOK_SCOPE_PROJECT = "project"
+
+	// 日志服务记录的操作日志
+	WEBHOOK_SOURCE_ACTIONLOG = "actionlog"
+	// 各服务记录的资源事件
+	WEBHOOK_SOURCE_OPSLOG = "opslog"
+
+	// 等待投递
+	WEBHOOK_DELIVERY_STATUS_PENDING = "pending"
+	// 投递失败, 等待重试
+	WEBHOOK_DELIVERY_STATUS_RETRYING = "retrying"
+	// 投递成功
+	WEBHOOK_DELIVERY_STATUS_SUCCESS = "success"
+	// 超过最大重试次数, 进入死信列表
+	WEBHOOK_DELIVERY_STATUS_DEAD = "dead"
+
+	WEBHOOK_DEFAULT_MAX_RETRIES = 8
+
+	WEBHOOK_HEADER_EVENT     = "X-Onecloud-Event"
+	WEBHOOK_HEADER_DELIVERY  = "X-Onecloud-Delivery"
+	WEBHOOK_HEADER_TIMESTAMP = "X-Onecloud-Timestamp"
+	WEBHOOK_HEADER_SIGNATURE = "X-Onecloud-Signature"
+)
+
+type WebhookSubscriptionCreateInput struct {
+	apis.VirtualResourceCreateInput
+	apis.EnabledBaseResourceCreateInput
+
+	// 接收事件的HTTP(S)地址
+	// required: true
+	// example: https://example.com/onecloud/events
+	Url string `json:"url"`
+
+	// 签名密钥, 投递时使用HMAC-SHA256对请求签名, 为空则不签名
+	Secret string `json:"secret"`
+
+	// 订阅的资源类型, 为空表示所有资源类型
+	// example: server
+	ObjType []string `json:"obj_type"`
+
+	// 订阅的操作, 为空表示所有操作
+	// example: create
+	Action []string `json:"action"`
+
+	// 订阅的事件来源, 为空表示所有来源
+	// enum: actionlog, opslog
+	Source []string `json:"source"`
+
+	// 订阅的事件范围, 默认为project
+	// enum: system, domain, project
+	ResourceScope string `json:"resource_scope"`
+
+	// 最大重试次数, 默认为8
+	MaxRetries *int `json:"max_retries"`
+}
+
+type WebhookSubscriptionUpdateInput struct {
+	apis.VirtualResourceBaseUpdateInput
+
+	// 接收事件的HTTP(S)地址
+	Url string `json:"url"`
+
+	// 签名密钥
+	Secret *string `json:"secret"`
+
+	// 订阅的资源类型
+	ObjType []string `json:"obj_type"`
+
+	// 订阅的操作
+	Action []string `json:"action"`
+
+	// 订阅的事件来源
+	Source []string `json:"source"`
+
+	// 最大重试次数
+	MaxRetries *int `json:"max_retries"`
+}
+
+type WebhookSubscriptionListInput struct {
+	apis.VirtualResourceListInput
+	apis.EnabledResourceBaseListInput
+
+	// 以订阅的事件范围过滤
+	ResourceScope []string `json:"resource_scope"`
+}
+
+type WebhookSubscriptionDetails struct {
+	apis.VirtualResourceDetails
+
+	// 等待投递的事件数量
+	PendingCount int `json:"pending_count"`
+	// 死信数量
+	DeadCount int `json:"dead_count"`
+}
+
+type WebhookDeliveryListInput struct {
+	apis.StandaloneAnonResourceListInput
+	apis.ProjectizedResourceListInput
+	apis.StatusResourceBaseListInput
+
+	// 以订阅过滤
+	SubscriptionId string `json:"subscription_id"`
+
+	// 以资源类型过滤
+	ObjType []string `json:"obj_type"`
+	// 以资源ID过滤
+	ObjId []string `json:"obj_id"`
+	// 以操作过滤
+	Action []string `json:"action"`
+	// 以事件来源过滤
+	Source []string `json:"source"`
+}
+
+type WebhookDeliveryDetails struct {
+	apis.StandaloneAnonResourceDetails
+	apis.ProjectizedResourceInfo
+
+	// 订阅名称
+	Subscription string `json:"subscription"`
+}
+
+// WebhookEvent is the json body posted to the url of a subscription
+type WebhookEvent struct {
+	// 投递ID, 重试时保持不变, 可用于去重
+	DeliveryId string `json:"delivery_id"`
+	// 订阅ID
+	SubscriptionId string `json:"subscription_id"`
+	// 事件来源, actionlog或opslog
+	Source string `json:"source"`
+
+	ObjType string               `json:"obj_type"`
+	ObjId   string               `json:"obj_id"`
+	ObjName string               `json:"obj_name"`
+	Action  string               `json:"action"`
+	Notes   jsonutils.JSONObject `json:"notes"`
+	Success bool                 `json:"success"`
+	Service string               `json:"service"`
+
+	UserId         string `json:"user_id"`
+	User           string `json:"user"`
+	OwnerProjectId string `json:"owner_tenant_id"`
+	OwnerDomainId  string `json:"owner_domain_id"`
+
+	OpsTime time.Time `json:"ops_time"`
+}
@@ -0,0 +1,59 @@
+// Copyright 2019 Yunion
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package monitor
+
+import "yunion.io/x/onecloud/pkg/apis"
+
+type AlertEscalationTier struct {
+	// 报警持续未确认多久后通知该层级, 比如: 15m, 1h
+	Interval string `json:"interval"`
+	// 通知方式, 为空时使用接收者所有的通知方式
+	Channel string `json:"channel"`
+	// 通知用户
+	UserIds []string `json:"user_ids"`
+	// 通知用户组
+	GroupIds []string `json:"group_ids"`
+	// 通知机器人, 包括钉钉, 飞书, 企业微信及 webhook 类型的机器人
+	RobotIds []string `json:"robot_ids"`
+}
+
+type AlertEscalationPolicyCreateInput struct {
+	apis.StandaloneResourceCreateInput
+	apis.ScopedResourceCreateInput
+
+	Tiers []AlertEscalationTier `json:"tiers"`
+}
+
+type AlertEscalationPolicyUpdateInput struct {
+	apis.StandaloneResourceBaseUpdateInput
+
+	Tiers []AlertEscalationTier `json:"tiers"`
+}
+
+type AlertEscalationPolicyListInput struct {
+	apis.Meta
+
+	apis.ScopedResourceBaseListInput
+	apis.EnabledResourceBaseListInput
+	apis.StatusStandaloneResourceListInput
+}
+
+type AlertEscalationPolicyDetails struct {
+	apis.StatusStandaloneResourceDetails
+	apis.ScopedResourceBaseInfo
+
+	// 关联的报警数量
+	AlertCount int `json:"alert_count"`
+}
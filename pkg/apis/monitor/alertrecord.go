@@ -22,6 +22,12 @@ const (
 	SEND_STATE_SHIELD = "shield"
 )
 
+const (
+	ALERT_RECORD_ACK_STATE_UNACKED  = "unacknowledged"
+	ALERT_RECORD_ACK_STATE_ACKED    = "acknowledged"
+	ALERT_RECORD_ACK_STATE_RESOLVED = "resolved"
+)
+
 type AlertRecordListInput struct {
 	apis.Meta
 
@@ -35,6 +41,10 @@ type AlertRecordListInput struct {
 	ResType  string `json:"res_type"`
 	Alerting bool   `json:"alerting"`
 	ResName  string `json:"res_name"`
+	// 确认状态, 比如: unacknowledged, acknowledged, resolved
+	AckState string `json:"ack_state"`
+	// 处理人
+	Assignee string `json:"assignee"`
 }
 
 type AlertRecordDetails struct {
@@ -43,6 +53,27 @@ type AlertRecordDetails struct {
 
 	ResNum    int64  `json:"res_num"`
 	AlertName string `json:"alert_name"`
+	// 处理人名称
+	Assignee string `json:"assignee"`
+	// 确认人名称
+	AckedByName string `json:"acked_by_name"`
+}
+
+type AlertRecordAckInput struct {
+	// 备注
+	Comment string `json:"comment"`
+}
+
+type AlertRecordAssignInput struct {
+	// 处理人, 用户id或名称
+	Assignee string `json:"assignee"`
+	// 备注
+	Comment string `json:"comment"`
+}
+
+type AlertRecordResolveInput struct {
+	// 备注
+	Comment string `json:"comment"`
 }
 
 type AlertRecordCreateInput struct {
@@ -68,6 +68,8 @@ type CommonAlertCreateInput struct {
 	GetPointStr bool   `json:"get_point_str"`
 	MetaName    string `json:"meta_name"`
 	Description string `json:"description"`
+	// 报警升级策略, id或名称
+	EscalationPolicyId string `json:"escalation_policy_id"`
 }
 
 type CommonMetricInputQuery struct {
@@ -120,6 +122,8 @@ type CommonAlertUpdateInput struct {
 	ForceUpdate bool   `json:"force_update"`
 	GetPointStr bool   `json:"get_point_str"`
 	MetaName    string `json:"meta_name"`
+	// 报警升级策略, id或名称, 为空字符串时解除关联
+	EscalationPolicyId *string `json:"escalation_policy_id"`
 }
 
 type CommonAlertDetails struct {
@@ -137,6 +141,8 @@ type CommonAlertDetails struct {
 	// 报警类型
 	AlertType                string                      `json:"alert_type"`
 	CommonAlertMetricDetails []*CommonAlertMetricDetails `json:"common_alert_metric_details"`
+	// 报警升级策略名称
+	EscalationPolicy string `json:"escalation_policy"`
 }
 
 type CommonAlertMetricDetails struct {
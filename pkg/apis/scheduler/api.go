@@ -136,6 +136,10 @@ type CandidateResource struct {
 
 	// Error means no candidate found, include reasons
 	Error string `json:"error"`
+
+	// ImageCache is whether the image of system disk cached on the candidate,
+	// only returned by forecast
+	ImageCache string `json:"image_cache,omitempty"`
 }
 
 type ScheduleOutput struct {
@@ -20,3 +20,12 @@ const (
 	SERVICE_TYPE    = apis.SERVICE_TYPE_SCHEDULER
 	SERVICE_VERSION = ""
 )
+
+const (
+	// IMAGE_CACHE_DATA_KEY is the key of image cache status in the data of schedule results
+	IMAGE_CACHE_DATA_KEY = "image_cache"
+
+	IMAGE_CACHE_STATUS_CACHED              = "cached"
+	IMAGE_CACHE_STATUS_CACHED_OTHER_FORMAT = "cached_other_format"
+	IMAGE_CACHE_STATUS_UNCACHED            = "uncached"
+)
@@ -17,6 +17,7 @@ package webconsole
 import (
 	"encoding/base64"
 	"net/url"
+	"time"
 
 	"yunion.io/x/onecloud/pkg/apis"
 )
@@ -51,3 +52,45 @@ func (resp *ServerRemoteConsoleResponse) GetConnectProtocol() (string, error) {
 	protocol := query.Get("protocol")
 	return protocol, nil
 }
+
+type SshJumpHost struct {
+	// ProxyEndpoint is id or name of a cloudproxy endpoint used as jump host
+	ProxyEndpoint string `json:"proxy_endpoint"`
+
+	Host       string `json:"host"`
+	Port       int    `json:"port"`
+	Username   string `json:"username"`
+	Password   string `json:"password"`
+	PrivateKey string `json:"private_key"`
+}
+
+type SSshRequest struct {
+	Port       int    `json:"port"`
+	Username   string `json:"username"`
+	Password   string `json:"password"`
+	PrivateKey string `json:"private_key"`
+
+	// JumpHosts are connected in order before the target host
+	JumpHosts []SshJumpHost `json:"jump_hosts"`
+}
+
+type SftpFileInfo struct {
+	Name    string    `json:"name"`
+	Size    int64     `json:"size"`
+	Mode    string    `json:"mode"`
+	ModTime time.Time `json:"mod_time"`
+	IsDir   bool      `json:"is_dir"`
+}
+
+type SServerRdpRequest struct {
+	// Port of the RDP service, default 3389
+	Port int `json:"port"`
+
+	Width  int `json:"width"`
+	Height int `json:"height"`
+	Dpi    int `json:"dpi"`
+
+	// UseCloudproxy connects through a local forward of cloudproxy for
+	// guests not reachable from webconsole
+	UseCloudproxy bool `json:"use_cloudproxy"`
+}
@@ -384,6 +384,15 @@ func (app *Application) AddDefaultHandler(method string, prefix string, handler
 	app.AddHandler3(hi)
 }
 
+// WalkHandlers calls f with every registered handler
+func (app *Application) WalkHandlers(f func(method string, path string, hi *SHandlerInfo)) {
+	for method, root := range app.roots {
+		root.Walk(func(path string, data interface{}) {
+			f(method, path, data.(*SHandlerInfo))
+		})
+	}
+}
+
 func (app *Application) addDefaultHandlers() {
 	app.AddDefaultHandler("GET", "/version", VersionHandler, "version")
 	app.AddDefaultHandler("GET", "/stats", StatisticHandler, "stats")
@@ -25,6 +25,7 @@ import (
 
 	api "yunion.io/x/onecloud/pkg/apis/compute"
 	o "yunion.io/x/onecloud/pkg/baremetal/options"
+	"yunion.io/x/onecloud/pkg/baremetal/tasks"
 	"yunion.io/x/onecloud/pkg/cloudcommon/consts"
 	"yunion.io/x/onecloud/pkg/mcclient"
 	"yunion.io/x/onecloud/pkg/mcclient/auth"
@@ -250,3 +251,36 @@ func (job *SSendMetricsJob) Do(ctx context.Context, now time.Time) error {
 	job.lastTime = now
 	return nil
 }
+
+type SFirmwareInventoryJob struct {
+	SBaseBaremetalCronJob
+}
+
+func NewFirmwareInventoryJob(baremetal *SBaremetalInstance, interval time.Duration) IBaremetalCronJob {
+	return &SFirmwareInventoryJob{
+		SBaseBaremetalCronJob: SBaseBaremetalCronJob{
+			baremetal: baremetal,
+			interval:  interval,
+		},
+	}
+}
+
+func (job *SFirmwareInventoryJob) Name() string {
+	return "FirmwareInventoryJob"
+}
+
+func (job *SFirmwareInventoryJob) Do(ctx context.Context, now time.Time) error {
+	if !job.baremetal.isRedfishCapable() {
+		return nil
+	}
+	redfishApi := job.baremetal.GetRedfishCli(ctx)
+	if redfishApi == nil {
+		return errors.Error("no redfish api")
+	}
+	err := tasks.SyncFirmwareInventory(ctx, job.baremetal, redfishApi)
+	if err != nil {
+		return errors.Wrap(err, "tasks.SyncFirmwareInventory")
+	}
+	job.lastTime = now
+	return nil
+}
@@ -73,6 +73,8 @@ func initBaremetalsHandler(app *appsrv.Application) {
 	AddHandler(app, "POST", bmActionPrefix("ipmi-probe"), bmObjMiddleware(handleBaremetalIpmiProbe))
 	AddHandler(app, "POST", bmActionPrefix("cdrom"), bmObjMiddleware(handleBaremetalCdromTask))
 	AddHandler(app, "POST", bmActionPrefix("jnlp"), bmObjMiddleware(handleBaremetalJnlpTask))
+	AddHandler(app, "POST", bmActionPrefix("bios-settings"), bmObjMiddleware(handleBaremetalBiosSettingsTask))
+	AddHandler(app, "POST", bmActionPrefix("firmware-update"), bmObjMiddleware(handleBaremetalFirmwareUpdateTask))
 
 	// server actions handler
 	AddHandler(app, "POST", srvActionPrefix("create"), srvClassMiddleware(handleServerCreate))
@@ -162,6 +164,16 @@ func handleBaremetalCdromTask(ctx *Context, bm *baremetal.SBaremetalInstance) {
 	ctx.ResponseOk()
 }
 
+func handleBaremetalBiosSettingsTask(ctx *Context, bm *baremetal.SBaremetalInstance) {
+	bm.StartBaremetalBiosSettingsTask(ctx.UserCred(), ctx.TaskId(), ctx.Data())
+	ctx.ResponseOk()
+}
+
+func handleBaremetalFirmwareUpdateTask(ctx *Context, bm *baremetal.SBaremetalInstance) {
+	bm.StartBaremetalFirmwareUpdateTask(ctx.UserCred(), ctx.TaskId(), ctx.Data())
+	ctx.ResponseOk()
+}
+
 func handleBaremetalJnlpTask(ctx *Context, bm *baremetal.SBaremetalInstance) {
 	jnlp, err := bm.GetConsoleJNLP(ctx)
 	if err != nil {
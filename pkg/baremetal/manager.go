@@ -525,6 +525,7 @@ func newBaremetalInstance(man *SBaremetalManager, desc jsonutils.JSONObject) (*S
 		NewStatusProbeJob(bm, time.Duration(o.Options.StatusProbeIntervalSeconds)*time.Second),
 		NewLogFetchJob(bm, time.Duration(o.Options.LogFetchIntervalSeconds)*time.Second),
 		NewSendMetricsJob(bm, time.Duration(o.Options.SendMetricsIntervalSeconds)*time.Second),
+		NewFirmwareInventoryJob(bm, time.Duration(o.Options.FirmwareInventoryIntervalSeconds)*time.Second),
 	}
 	err := os.MkdirAll(bm.GetDir(), 0755)
 	if err != nil {
@@ -1033,6 +1034,20 @@ func (b *SBaremetalInstance) GetImageCacheUrl() string {
 	return fmt.Sprintf("http://%s:%d", serverIP, o.Options.Port+1000)
 }
 
+// AcquireImage downloads the image into the agent image cache, which is
+// served under /images/ of GetImageCacheUrl, the image is kept until released
+func (b *SBaremetalInstance) AcquireImage(ctx context.Context, imageId, format, checksum string) error {
+	cacheMan := b.manager.Agent.CacheManager
+	if imgCache := cacheMan.AcquireImage(ctx, imageId, b.GetZoneName(), "", format, checksum); imgCache == nil {
+		return errors.Errorf("failed to cache image %s", imageId)
+	}
+	return nil
+}
+
+func (b *SBaremetalInstance) ReleaseImage(ctx context.Context, imageId string) {
+	b.manager.Agent.CacheManager.ReleaseImage(ctx, imageId)
+}
+
 func (b *SBaremetalInstance) getBootIsoUrl() string {
 	serverIP, err := b.manager.Agent.GetDHCPServerIP()
 	if err != nil {
@@ -1835,6 +1850,16 @@ func (b *SBaremetalInstance) StartBaremetalCdromTask(userCred mcclient.TokenCred
 	return nil
 }
 
+func (b *SBaremetalInstance) StartBaremetalBiosSettingsTask(userCred mcclient.TokenCredential, taskId string, data jsonutils.JSONObject) error {
+	b.StartNewTask(tasks.NewBaremetalBiosSettingsTask, userCred, taskId, data)
+	return nil
+}
+
+func (b *SBaremetalInstance) StartBaremetalFirmwareUpdateTask(userCred mcclient.TokenCredential, taskId string, data jsonutils.JSONObject) error {
+	b.StartNewTask(tasks.NewBaremetalFirmwareUpdateTask, userCred, taskId, data)
+	return nil
+}
+
 func (b *SBaremetalInstance) DelayedServerReset(_ jsonutils.JSONObject) (jsonutils.JSONObject, error) {
 	err := b.DoPXEBoot()
 	return nil, err
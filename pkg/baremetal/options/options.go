@@ -47,9 +47,10 @@ type BaremetalOptions struct {
 	EnablePxeBoot bool   `help:"Enable DHCP PXE boot" default:"true"`
 	BootIsoPath   string `help:"iso boot image path"`
 
-	StatusProbeIntervalSeconds int `help:"interval to probe baremetal status, default is 60 seconds" default:"60"`
-	LogFetchIntervalSeconds    int `help:"interval to fetch baremetal log, default is 900 seconds" default:"900"`
-	SendMetricsIntervalSeconds int `help:"interval to send baremetal metrics, default is 300 seconds" default:"300"`
+	StatusProbeIntervalSeconds       int `help:"interval to probe baremetal status, default is 60 seconds" default:"60"`
+	LogFetchIntervalSeconds          int `help:"interval to fetch baremetal log, default is 900 seconds" default:"900"`
+	SendMetricsIntervalSeconds       int `help:"interval to send baremetal metrics, default is 300 seconds" default:"300"`
+	FirmwareInventoryIntervalSeconds int `help:"interval to collect baremetal firmware inventory, default is 86400 seconds" default:"86400"`
 }
 
 var (
@@ -0,0 +1,99 @@
+// Copyright 2019 Yunion
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package tasks
+
+import (
+	"context"
+	"time"
+
+	"yunion.io/x/jsonutils"
+	"yunion.io/x/log"
+	"yunion.io/x/pkg/errors"
+
+	api "yunion.io/x/onecloud/pkg/apis/compute"
+	"yunion.io/x/onecloud/pkg/mcclient"
+	"yunion.io/x/onecloud/pkg/util/redfish"
+)
+
+const (
+	biosSettingsPollInterval = 10 * time.Second
+	biosSettingsTimeout      = 30 * time.Minute
+)
+
+type SBaremetalBiosSettingsTask struct {
+	SBaremetalTaskBase
+}
+
+func NewBaremetalBiosSettingsTask(
+	userCred mcclient.TokenCredential,
+	baremetal IBaremetal,
+	taskId string,
+	data jsonutils.JSONObject,
+) ITask {
+	task := &SBaremetalBiosSettingsTask{
+		SBaremetalTaskBase: newBaremetalTaskBase(userCred, baremetal, taskId, data),
+	}
+	task.SetVirtualObject(task)
+	task.SetStage(task.DoBiosSettings)
+	return task
+}
+
+func (self *SBaremetalBiosSettingsTask) GetName() string {
+	return "BaremetalBiosSettingsTask"
+}
+
+func (self *SBaremetalBiosSettingsTask) DoBiosSettings(ctx context.Context, args interface{}) error {
+	input := api.HostBiosSettingsInput{}
+	err := self.GetData().Unmarshal(&input)
+	if err != nil {
+		return errors.Wrap(err, "Unmarshal HostBiosSettingsInput")
+	}
+	if input.Attributes == nil || input.Attributes.Length() == 0 {
+		return errors.Error("empty bios attributes")
+	}
+	redfishCli, err := getBaremetalRedfishApi(ctx, self.Baremetal)
+	if err != nil {
+		return errors.Wrap(err, "getBaremetalRedfishApi")
+	}
+	jobPath, err := redfishCli.SetBiosAttributes(ctx, input.Attributes)
+	if err != nil {
+		return errors.Wrap(err, "SetBiosAttributes")
+	}
+	if !input.Reboot {
+		// pending settings take effect on next boot
+		self.Baremetal.AutoSyncStatus()
+		SetTaskComplete(self, nil)
+		return nil
+	}
+	err = redfishCli.Reset(ctx, "ForceRestart")
+	if err != nil {
+		return errors.Wrap(err, "Reset ForceRestart")
+	}
+	if len(jobPath) > 0 {
+		_, err = redfish.WaitTask(ctx, redfishCli, jobPath, biosSettingsPollInterval, biosSettingsTimeout, nil)
+		if err != nil {
+			return errors.Wrap(err, "WaitTask")
+		}
+		attrs, err := redfishCli.GetBiosAttributes(ctx)
+		if err != nil {
+			log.Errorf("GetBiosAttributes of %s: %s", self.Baremetal.GetName(), err)
+		} else if err := setBaremetalMetadata(self.Baremetal, api.HOST_METADATA_BIOS_ATTRIBUTES, attrs); err != nil {
+			log.Errorf("save bios attributes of %s: %s", self.Baremetal.GetName(), err)
+		}
+	}
+	self.Baremetal.AutoSyncStatus()
+	SetTaskComplete(self, nil)
+	return nil
+}
@@ -0,0 +1,165 @@
+// Copyright 2019 Yunion
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package tasks
+
+import (
+	"context"
+	"time"
+
+	"yunion.io/x/jsonutils"
+	"yunion.io/x/log"
+	"yunion.io/x/pkg/errors"
+
+	api "yunion.io/x/onecloud/pkg/apis/compute"
+	"yunion.io/x/onecloud/pkg/mcclient"
+	"yunion.io/x/onecloud/pkg/mcclient/modules"
+	"yunion.io/x/onecloud/pkg/util/httputils"
+	"yunion.io/x/onecloud/pkg/util/redfish"
+)
+
+const (
+	firmwareUpdatePollInterval = 10 * time.Second
+	firmwareUpdateTimeout      = 2 * time.Hour
+)
+
+func getBaremetalRedfishApi(ctx context.Context, baremetal IBaremetal) (redfish.IRedfishDriver, error) {
+	ipmiInfo := baremetal.GetRawIPMIConfig()
+	if ipmiInfo == nil || !ipmiInfo.RedfishApi {
+		return nil, errors.Error("BMC not redfish-compatible")
+	}
+	if ipmiInfo.IpAddr == "" {
+		return nil, errors.Error("empty IPMI ip_addr")
+	}
+	if ipmiInfo.Username == "" {
+		return nil, errors.Error("empty IPMI username")
+	}
+	if ipmiInfo.Password == "" {
+		return nil, errors.Error("empty IPMI password")
+	}
+	redfishCli := redfish.NewRedfishDriver(ctx, "https://"+ipmiInfo.IpAddr, ipmiInfo.Username, ipmiInfo.Password, false)
+	if redfishCli == nil {
+		return nil, errors.Error("invalid redfish Api client")
+	}
+	return redfishCli, nil
+}
+
+func setBaremetalMetadata(baremetal IBaremetal, key string, val jsonutils.JSONObject) error {
+	data := jsonutils.NewDict()
+	data.Add(val, key)
+	_, err := modules.Hosts.SetMetadata(baremetal.GetClientSession(), baremetal.GetId(), data)
+	if err != nil {
+		return errors.Wrapf(err, "SetMetadata %s", key)
+	}
+	return nil
+}
+
+// SyncFirmwareInventory saves firmware versions reported by BMC to host metadata
+func SyncFirmwareInventory(ctx context.Context, baremetal IBaremetal, drv redfish.IRedfishDriver) error {
+	fws, err := drv.GetFirmwareInventory(ctx)
+	if err != nil {
+		return errors.Wrap(err, "GetFirmwareInventory")
+	}
+	return setBaremetalMetadata(baremetal, api.HOST_METADATA_FIRMWARE_INVENTORY, jsonutils.Marshal(fws))
+}
+
+type SBaremetalFirmwareUpdateTask struct {
+	SBaremetalTaskBase
+}
+
+func NewBaremetalFirmwareUpdateTask(
+	userCred mcclient.TokenCredential,
+	baremetal IBaremetal,
+	taskId string,
+	data jsonutils.JSONObject,
+) ITask {
+	task := &SBaremetalFirmwareUpdateTask{
+		SBaremetalTaskBase: newBaremetalTaskBase(userCred, baremetal, taskId, data),
+	}
+	task.SetVirtualObject(task)
+	task.SetStage(task.DoFirmwareUpdate)
+	return task
+}
+
+func (self *SBaremetalFirmwareUpdateTask) GetName() string {
+	return "BaremetalFirmwareUpdateTask"
+}
+
+// getImageUri returns the uri of firmware image for BMC, an image is
+// downloaded into the agent image cache and served by the file server of agent
+func (self *SBaremetalFirmwareUpdateTask) getImageUri(input api.HostFirmwareUpdateInput) (string, error) {
+	if len(input.ImageUri) > 0 {
+		return input.ImageUri, nil
+	}
+	if len(input.ImageId) == 0 {
+		return "", errors.Error("empty image_uri and image_id")
+	}
+	imageBaseUrl := self.Baremetal.GetImageCacheUrl()
+	if len(imageBaseUrl) == 0 {
+		return "", errors.Error("empty image base url")
+	}
+	return httputils.JoinPath(imageBaseUrl, "/images/"+input.ImageId), nil
+}
+
+func (self *SBaremetalFirmwareUpdateTask) reportProgress(status redfish.STaskStatus) {
+	err := setBaremetalMetadata(self.Baremetal, api.HOST_METADATA_FIRMWARE_UPDATE_STATUS, jsonutils.NewString(status.String()))
+	if err != nil {
+		log.Errorf("report firmware update progress of %s: %s", self.Baremetal.GetName(), err)
+	}
+}
+
+func (self *SBaremetalFirmwareUpdateTask) DoFirmwareUpdate(ctx context.Context, args interface{}) error {
+	redfishCli, err := getBaremetalRedfishApi(ctx, self.Baremetal)
+	if err != nil {
+		return errors.Wrap(err, "getBaremetalRedfishApi")
+	}
+	input := api.HostFirmwareUpdateInput{}
+	self.GetData().Unmarshal(&input)
+	if len(input.ImageUri) == 0 && len(input.ImageId) > 0 {
+		err = self.Baremetal.AcquireImage(ctx, input.ImageId, input.ImageFormat, input.ImageChecksum)
+		if err != nil {
+			return errors.Wrap(err, "AcquireImage")
+		}
+		defer self.Baremetal.ReleaseImage(ctx, input.ImageId)
+	}
+	imageUri, err := self.getImageUri(input)
+	if err != nil {
+		return errors.Wrap(err, "getImageUri")
+	}
+	taskPath, err := redfishCli.SimpleUpdate(ctx, imageUri, input.Targets)
+	if err != nil {
+		return errors.Wrap(err, "SimpleUpdate")
+	}
+	if len(taskPath) > 0 {
+		_, err = redfish.WaitTask(ctx, redfishCli, taskPath, firmwareUpdatePollInterval, firmwareUpdateTimeout, self.reportProgress)
+		if err != nil {
+			return errors.Wrap(err, "WaitTask")
+		}
+	} else {
+		log.Warningf("BMC of %s does not report firmware update task, skip tracking progress", self.Baremetal.GetName())
+	}
+	if input.Reboot {
+		err = redfishCli.Reset(ctx, "ForceRestart")
+		if err != nil {
+			return errors.Wrap(err, "Reset ForceRestart")
+		}
+	}
+	err = SyncFirmwareInventory(ctx, self.Baremetal, redfishCli)
+	if err != nil {
+		log.Errorf("SyncFirmwareInventory of %s after update: %s", self.Baremetal.GetName(), err)
+	}
+	self.Baremetal.AutoSyncStatus()
+	SetTaskComplete(self, nil)
+	return nil
+}
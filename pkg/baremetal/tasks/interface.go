@@ -15,6 +15,7 @@
 package tasks
 
 import (
+	"context"
 	"net"
 
 	"yunion.io/x/jsonutils"
@@ -63,6 +64,8 @@ type IBaremetal interface {
 	SendNicInfo(nic *types.SNicDevInfo, idx int, nicType string, reset bool, ipAddr string, reserve bool) error
 	DoNTPConfig() error
 	GetImageCacheUrl() string
+	AcquireImage(ctx context.Context, imageId, format, checksum string) error
+	ReleaseImage(ctx context.Context, imageId string)
 
 	RemoveServer()
 	InitializeServer(session *mcclient.ClientSession, name string) error
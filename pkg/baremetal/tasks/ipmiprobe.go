@@ -147,6 +147,11 @@ func (self *SBaremetalIpmiProbeTask) doRedfishIpmiProbe(ctx context.Context, drv
 			}
 		}
 	}
+	err = SyncFirmwareInventory(ctx, self.Baremetal, drv)
+	if err != nil {
+		// not all BMC implement UpdateService, ignore
+		log.Warningf("SyncFirmwareInventory: %s", err)
+	}
 	self.Baremetal.SyncStatus("", "Probe Redfish finished")
 	SetTaskComplete(self, nil)
 	return true, nil
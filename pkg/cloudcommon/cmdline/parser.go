@@ -115,6 +115,8 @@ func ParseDiskConfig(diskStr string, idx int) (*compute.DiskConfig, error) {
 			diskConfig.SizeMb = -1
 		} else if utils.IsInStringArray(p, compute.STORAGE_ALL_TYPES) {
 			diskConfig.Backend = p
+		} else if strings.HasPrefix(p, "encrypt_key=") {
+			diskConfig.EncryptKeyId = p[len("encrypt_key="):]
 		} else if strings.HasPrefix(p, "snapshot-") {
 			// HACK: use snapshot creat disk format snapshot-id
 			// example: snapshot-3140cecb-ccc4-4865-abae-3a5ba8c69d9b
@@ -157,6 +157,7 @@ func TestFetchDiskConfigsByJSON(t *testing.T) {
 				map[string]string{
 					"disk.0": "10g:/data1",
 					"disk.1": "20g:ext4:ssd",
+					"disk.2": "30g:encrypt_key=enc-key-1",
 				},
 			)},
 			want: []*compute.DiskConfig{
@@ -171,6 +172,11 @@ func TestFetchDiskConfigsByJSON(t *testing.T) {
 					Fs:     "ext4",
 					Medium: compute.DISK_TYPE_SSD,
 				},
+				{
+					Index:        2,
+					SizeMb:       30 * 1024,
+					EncryptKeyId: "enc-key-1",
+				},
 			},
 			wantErr: false,
 		},
@@ -0,0 +1,95 @@
+// Copyright 2019 Yunion
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package db
+
+import (
+	"reflect"
+
+	"yunion.io/x/pkg/utils"
+
+	"yunion.io/x/onecloud/pkg/appsrv"
+)
+
+// SModelApiSpec describes the typed inputs and outputs of the methods of a
+// model manager that are reachable through the db dispatcher. A nil type
+// means the method is absent or does not take a typed argument.
+type SModelApiSpec struct {
+	Keyword       string
+	KeywordPlural string
+
+	ListInput   reflect.Type
+	CreateInput reflect.Type
+	UpdateInput reflect.Type
+	Details     reflect.Type
+
+	Actions []SModelActionSpec
+}
+
+// SModelActionSpec describes a Perform<Action> method of a model
+type SModelActionSpec struct {
+	Action   string
+	FuncName string
+	Input    reflect.Type
+	Output   reflect.Type
+}
+
+// GetDispatchedModelApiSpecs returns the api specs of the model managers
+// dispatched by app, sorted by keyword. Joint model managers are skipped.
+func GetDispatchedModelApiSpecs(app *appsrv.Application) []SModelApiSpec {
+	managers, _, _ := walkDispatchedManagers(app)
+	specs := make([]SModelApiSpec, 0, len(managers))
+	for _, keyword := range sortedKeys(managers) {
+		specs = append(specs, GetModelApiSpec(managers[keyword]))
+	}
+	return specs
+}
+
+// GetModelApiSpec describes the list/create/update inputs, the details and
+// the Perform* methods of a model manager
+func GetModelApiSpec(manager IModelManager) SModelApiSpec {
+	spec := SModelApiSpec{
+		Keyword:       manager.Keyword(),
+		KeywordPlural: manager.KeywordPlural(),
+		Details:       modelDetailsType(manager),
+	}
+	managerType := reflect.TypeOf(manager)
+	if input, ok := methodInputType(managerType, "ListItemFilter", 4); ok {
+		spec.ListInput = input
+	}
+	if input, ok := methodInputType(managerType, "ValidateCreateData", 5); ok {
+		spec.CreateInput = input
+	}
+	modelType := modelPtrType(manager)
+	if modelType == nil {
+		return spec
+	}
+	if input, ok := methodInputType(modelType, "ValidateUpdateData", 4); ok {
+		spec.UpdateInput = input
+	}
+	for _, action := range sortedKeys(methodSpecs(modelType, "Perform")) {
+		funcName := "Perform" + utils.Kebab2Camel(action, "-")
+		input, ok := methodInputType(modelType, funcName, 4)
+		if !ok {
+			continue
+		}
+		spec.Actions = append(spec.Actions, SModelActionSpec{
+			Action:   action,
+			FuncName: funcName,
+			Input:    input,
+			Output:   methodOutputType(modelType, funcName),
+		})
+	}
+	return spec
+}
@@ -158,6 +158,11 @@ func CheckSync(autoSync bool) bool {
 func EnsureAppInitSyncDB(app *appsrv.Application, opt *common_options.DBOptions, modelInitDBFunc func() error) {
 	cloudcommon.InitDB(opt)
 
+	exportOpsLog := isOpsLogExportEnabled(opt)
+	if exportOpsLog {
+		RegisterModelManager(OpsLogExportCursorManager)
+	}
+
 	if !CheckSync(opt.AutoSyncTable) {
 		log.Fatalf("database schema not in sync!")
 	}
@@ -174,6 +179,10 @@ func EnsureAppInitSyncDB(app *appsrv.Application, opt *common_options.DBOptions,
 	}
 
 	cloudcommon.AppDBInit(app)
+
+	if exportOpsLog {
+		StartOpsLogExportWorker(opt)
+	}
 }
 
 func GetModelManager(keyword string) IModelManager {
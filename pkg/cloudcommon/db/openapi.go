@@ -0,0 +1,453 @@
+// Copyright 2019 Yunion
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package db
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"reflect"
+	"sort"
+	"strings"
+
+	"yunion.io/x/jsonutils"
+	"yunion.io/x/pkg/util/version"
+	"yunion.io/x/pkg/utils"
+
+	"yunion.io/x/onecloud/pkg/appsrv"
+	"yunion.io/x/onecloud/pkg/cloudcommon/consts"
+	"yunion.io/x/onecloud/pkg/httperrors"
+	"yunion.io/x/onecloud/pkg/mcclient/auth"
+	"yunion.io/x/onecloud/pkg/util/openapi"
+)
+
+var (
+	contextType = reflect.TypeOf((*context.Context)(nil)).Elem()
+)
+
+// AddOpenApiHandler serves the OpenAPI 3 and Swagger 2.0 documents of the
+// model managers dispatched by app
+func AddOpenApiHandler(prefix string, app *appsrv.Application) {
+	app.AddHandler2("GET", fmt.Sprintf("%s/%s", prefix, openapi.OPENAPI_SPEC_PATH), auth.Authenticate(openApiHandler), nil, "get_openapi_spec", nil)
+	app.AddHandler2("GET", fmt.Sprintf("%s/%s", prefix, openapi.SWAGGER_SPEC_PATH), auth.Authenticate(swaggerHandler), nil, "get_swagger_spec", nil)
+}
+
+func requestServerUrl(r *http.Request) string {
+	scheme := "http"
+	if r.TLS != nil {
+		scheme = "https"
+	}
+	return fmt.Sprintf("%s://%s", scheme, r.Host)
+}
+
+func openApiHandler(ctx context.Context, w http.ResponseWriter, r *http.Request) {
+	doc := NewOpenApiDocument(appsrv.AppContextApp(ctx))
+	doc.Servers = []openapi.SServer{{Url: requestServerUrl(r)}}
+	output, err := doc.Marshal()
+	if err != nil {
+		httperrors.GeneralServerError(ctx, w, err)
+		return
+	}
+	w.Header().Set("Content-Type", "application/json;charset=utf-8")
+	w.Write(output)
+}
+
+func swaggerHandler(ctx context.Context, w http.ResponseWriter, r *http.Request) {
+	doc := NewOpenApiDocument(appsrv.AppContextApp(ctx))
+	doc.Servers = []openapi.SServer{{Url: requestServerUrl(r)}}
+	output, err := doc.ToSwagger().Marshal()
+	if err != nil {
+		httperrors.GeneralServerError(ctx, w, err)
+		return
+	}
+	w.Header().Set("Content-Type", "application/json;charset=utf-8")
+	w.Write(output)
+}
+
+// NewOpenApiDocument walks the model managers registered to app by the
+// db dispatchers and describes their list/create/update inputs and
+// their Perform*/GetDetails*/GetProperty* methods
+func NewOpenApiDocument(app *appsrv.Application) *openapi.SDocument {
+	title := consts.GetServiceType()
+	if len(title) == 0 {
+		title = app.GetName()
+	}
+	doc := openapi.NewDocument(title, version.GetShortString())
+	managers, joints, paths := walkDispatchedManagers(app)
+	for _, keyword := range sortedKeys(managers) {
+		manager := managers[keyword]
+		addOpenApiModelManager(doc, strings.TrimSuffix(paths[keyword], manager.KeywordPlural()), manager)
+	}
+	for _, keyword := range sortedKeys(joints) {
+		manager := joints[keyword]
+		addOpenApiJointModelManager(doc, strings.TrimSuffix(paths[keyword], manager.KeywordPlural()), manager)
+	}
+	return doc
+}
+
+// walkDispatchedManagers collects the model managers and joint model managers
+// dispatched by app, together with the list path of each of them
+func walkDispatchedManagers(app *appsrv.Application) (map[string]IModelManager, map[string]IJointModelManager, map[string]string) {
+	// a manager may be dispatched under several prefixes, e.g.
+	// /users/<user_id>/parameters, only the shortest one is kept
+	managers := make(map[string]IModelManager)
+	joints := make(map[string]IJointModelManager)
+	paths := make(map[string]string)
+	app.WalkHandlers(func(method string, path string, hi *appsrv.SHandlerInfo) {
+		if method != "GET" {
+			return
+		}
+		var manager IModelManager
+		switch dispatcher := hi.GetMetadata()["manager"].(type) {
+		case *DBJointModelDispatcher:
+			if hi.GetName(nil) == "list_joint" {
+				manager = dispatcher.JointModelManager()
+				joints[manager.Keyword()] = dispatcher.JointModelManager()
+			}
+		case *DBModelDispatcher:
+			if hi.GetName(nil) == "list" {
+				manager = dispatcher.modelManager
+				managers[manager.Keyword()] = manager
+			}
+		}
+		if manager == nil {
+			return
+		}
+		if prev, ok := paths[manager.Keyword()]; !ok || len(path) < len(prev) {
+			paths[manager.Keyword()] = path
+		}
+	})
+	return managers, joints, paths
+}
+
+func sortedKeys(m interface{}) []string {
+	keys := make([]string, 0)
+	for _, k := range reflect.ValueOf(m).MapKeys() {
+		keys = append(keys, k.String())
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+// methodInputType returns the type of the last argument of method funcName
+// if it is called with argc arguments and a context as the first one
+func methodInputType(t reflect.Type, funcName string, argc int) (reflect.Type, bool) {
+	method, ok := t.MethodByName(funcName)
+	if !ok {
+		return nil, false
+	}
+	// the receiver is the first argument of the method of a type
+	if method.Type.NumIn() != argc+1 || method.Type.In(1) != contextType {
+		return nil, false
+	}
+	return method.Type.In(argc), true
+}
+
+// methodOutputType returns the type of the first result of a method that
+// returns (T, error)
+func methodOutputType(t reflect.Type, funcName string) reflect.Type {
+	method, ok := t.MethodByName(funcName)
+	if !ok || method.Type.NumOut() != 2 {
+		return nil
+	}
+	return method.Type.Out(0)
+}
+
+// methodSpecs returns the kebab form of the methods with prefix funcPrefix
+// that are reachable from the dispatcher
+func methodSpecs(t reflect.Type, funcPrefix string) map[string]string {
+	ret := make(map[string]string)
+	for i := 0; i < t.NumMethod(); i++ {
+		name := t.Method(i).Name
+		if !strings.HasPrefix(name, funcPrefix) || len(name) == len(funcPrefix) {
+			continue
+		}
+		spec := utils.CamelSplit(name[len(funcPrefix):], "-")
+		if funcPrefix+utils.Kebab2Camel(spec, "-") != name {
+			continue
+		}
+		ret[spec] = name
+	}
+	return ret
+}
+
+func wrapSchema(key string, schema *openapi.SSchema) *openapi.SSchema {
+	return &openapi.SSchema{
+		Type:       "object",
+		Properties: map[string]*openapi.SSchema{key: schema},
+	}
+}
+
+func jsonResponses(schema *openapi.SSchema) map[string]*openapi.SResponse {
+	return map[string]*openapi.SResponse{
+		"200": {
+			Description: "OK",
+			Content:     openapi.NewJsonContent(schema),
+		},
+	}
+}
+
+func idParameter(name string) openapi.SParameter {
+	return openapi.SParameter{
+		Name:     name,
+		In:       "path",
+		Required: true,
+		Schema:   &openapi.SSchema{Type: "string"},
+	}
+}
+
+func optionalSchemaOf(doc *openapi.SDocument, t reflect.Type) *openapi.SSchema {
+	if t == nil || t == jsonObjectType {
+		return &openapi.SSchema{Type: "object"}
+	}
+	return doc.SchemaOf(t)
+}
+
+var jsonObjectType = reflect.TypeOf((*jsonutils.JSONObject)(nil)).Elem()
+
+func modelDetailsType(manager IModelManager) reflect.Type {
+	managerType := reflect.TypeOf(manager)
+	method, ok := managerType.MethodByName("FetchCustomizeColumns")
+	if ok && method.Type.NumOut() == 1 && method.Type.Out(0).Kind() == reflect.Slice {
+		return method.Type.Out(0).Elem()
+	}
+	if manager.TableSpec() != nil {
+		return manager.TableSpec().DataType()
+	}
+	return nil
+}
+
+func modelPtrType(manager IModelManager) reflect.Type {
+	if manager.TableSpec() == nil {
+		return nil
+	}
+	return reflect.PtrTo(manager.TableSpec().DataType())
+}
+
+func addOpenApiModelManager(doc *openapi.SDocument, prefix string, manager IModelManager) {
+	keyword := manager.Keyword()
+	plural := manager.KeywordPlural()
+	managerType := reflect.TypeOf(manager)
+	modelType := modelPtrType(manager)
+	base := fmt.Sprintf("%s%s", prefix, plural)
+	item := fmt.Sprintf("%s/{id}", base)
+	tags := []string{plural}
+	doc.AddTag(plural, fmt.Sprintf("%s resources", keyword))
+
+	details := optionalSchemaOf(doc, modelDetailsType(manager))
+
+	listOp := &openapi.SOperation{
+		Tags:        tags,
+		Summary:     fmt.Sprintf("List %s", plural),
+		OperationId: fmt.Sprintf("%s_list", plural),
+		Responses: jsonResponses(&openapi.SSchema{
+			Type: "object",
+			Properties: map[string]*openapi.SSchema{
+				plural:        {Type: "array", Items: details},
+				"total":       {Type: "integer", Format: "int64"},
+				"limit":       {Type: "integer", Format: "int64"},
+				"offset":      {Type: "integer", Format: "int64"},
+				"next_marker": {Type: "string"},
+			},
+		}),
+	}
+	if input, ok := methodInputType(managerType, "ListItemFilter", 4); ok {
+		listOp.Parameters = doc.QueryParameters(input)
+	}
+	doc.AddOperation("GET", base, listOp)
+
+	if input, ok := methodInputType(managerType, "ValidateCreateData", 5); ok {
+		doc.AddOperation("POST", base, &openapi.SOperation{
+			Tags:        tags,
+			Summary:     fmt.Sprintf("Create %s", keyword),
+			OperationId: fmt.Sprintf("%s_create", plural),
+			RequestBody: &openapi.SRequestBody{
+				Required: true,
+				Content:  openapi.NewJsonContent(wrapSchema(keyword, optionalSchemaOf(doc, input))),
+			},
+			Responses: jsonResponses(wrapSchema(keyword, details)),
+		})
+	}
+
+	doc.AddOperation("GET", item, &openapi.SOperation{
+		Tags:        tags,
+		Summary:     fmt.Sprintf("Get details of %s", keyword),
+		OperationId: fmt.Sprintf("%s_get", plural),
+		Parameters:  []openapi.SParameter{idParameter("id")},
+		Responses:   jsonResponses(wrapSchema(keyword, details)),
+	})
+	doc.AddOperation("DELETE", item, &openapi.SOperation{
+		Tags:        tags,
+		Summary:     fmt.Sprintf("Delete %s", keyword),
+		OperationId: fmt.Sprintf("%s_delete", plural),
+		Parameters:  []openapi.SParameter{idParameter("id")},
+		Responses:   jsonResponses(wrapSchema(keyword, details)),
+	})
+
+	for _, spec := range sortedKeys(methodSpecs(managerType, "GetProperty")) {
+		funcName := "GetProperty" + utils.Kebab2Camel(spec, "-")
+		input, ok := methodInputType(managerType, funcName, 3)
+		if !ok {
+			continue
+		}
+		doc.AddOperation("GET", fmt.Sprintf("%s/%s", base, spec), &openapi.SOperation{
+			Tags:        tags,
+			Summary:     fmt.Sprintf("Get property %s of %s", spec, plural),
+			OperationId: fmt.Sprintf("%s_get_property_%s", plural, spec),
+			Parameters:  doc.QueryParameters(input),
+			Responses:   jsonResponses(wrapSchema(keyword, optionalSchemaOf(doc, methodOutputType(managerType, funcName)))),
+		})
+	}
+	for _, spec := range sortedKeys(methodSpecs(managerType, "Perform")) {
+		funcName := "Perform" + utils.Kebab2Camel(spec, "-")
+		input, ok := methodInputType(managerType, funcName, 4)
+		if !ok {
+			continue
+		}
+		doc.AddOperation("POST", fmt.Sprintf("%s/%s", base, spec), &openapi.SOperation{
+			Tags:        tags,
+			Summary:     fmt.Sprintf("Perform class action %s on %s", spec, plural),
+			OperationId: fmt.Sprintf("%s_perform_class_%s", plural, spec),
+			RequestBody: &openapi.SRequestBody{
+				Content: openapi.NewJsonContent(wrapSchema(plural, optionalSchemaOf(doc, input))),
+			},
+			Responses: jsonResponses(wrapSchema(plural, optionalSchemaOf(doc, methodOutputType(managerType, funcName)))),
+		})
+	}
+
+	if modelType == nil {
+		return
+	}
+	if input, ok := methodInputType(modelType, "ValidateUpdateData", 4); ok {
+		doc.AddOperation("PUT", item, &openapi.SOperation{
+			Tags:        tags,
+			Summary:     fmt.Sprintf("Update %s", keyword),
+			OperationId: fmt.Sprintf("%s_update", plural),
+			Parameters:  []openapi.SParameter{idParameter("id")},
+			RequestBody: &openapi.SRequestBody{
+				Required: true,
+				Content:  openapi.NewJsonContent(wrapSchema(keyword, optionalSchemaOf(doc, input))),
+			},
+			Responses: jsonResponses(wrapSchema(keyword, details)),
+		})
+	}
+	for _, spec := range sortedKeys(methodSpecs(modelType, "GetDetails")) {
+		funcName := "GetDetails" + utils.Kebab2Camel(spec, "-")
+		input, ok := methodInputType(modelType, funcName, 3)
+		if !ok {
+			continue
+		}
+		params := []openapi.SParameter{idParameter("id")}
+		params = append(params, doc.QueryParameters(input)...)
+		doc.AddOperation("GET", fmt.Sprintf("%s/%s", item, spec), &openapi.SOperation{
+			Tags:        tags,
+			Summary:     fmt.Sprintf("Get %s of %s", spec, keyword),
+			OperationId: fmt.Sprintf("%s_get_%s", plural, spec),
+			Parameters:  params,
+			Responses:   jsonResponses(wrapSchema(keyword, optionalSchemaOf(doc, methodOutputType(modelType, funcName)))),
+		})
+	}
+	for _, spec := range sortedKeys(methodSpecs(modelType, "Perform")) {
+		funcName := "Perform" + utils.Kebab2Camel(spec, "-")
+		input, ok := methodInputType(modelType, funcName, 4)
+		if !ok {
+			continue
+		}
+		doc.AddOperation("POST", fmt.Sprintf("%s/%s", item, spec), &openapi.SOperation{
+			Tags:        tags,
+			Summary:     fmt.Sprintf("Perform action %s on %s", spec, keyword),
+			OperationId: fmt.Sprintf("%s_perform_%s", plural, spec),
+			Parameters:  []openapi.SParameter{idParameter("id")},
+			RequestBody: &openapi.SRequestBody{
+				Content: openapi.NewJsonContent(wrapSchema(keyword, optionalSchemaOf(doc, input))),
+			},
+			Responses: jsonResponses(wrapSchema(keyword, optionalSchemaOf(doc, methodOutputType(modelType, funcName)))),
+		})
+	}
+}
+
+func addOpenApiJointModelManager(doc *openapi.SDocument, prefix string, manager IJointModelManager) {
+	keyword := manager.Keyword()
+	plural := manager.KeywordPlural()
+	master := manager.GetMasterManager().KeywordPlural()
+	slave := manager.GetSlaveManager().KeywordPlural()
+	tags := []string{plural}
+	doc.AddTag(plural, fmt.Sprintf("%s joint resources between %s and %s", keyword, master, slave))
+
+	details := optionalSchemaOf(doc, modelDetailsType(manager))
+	listOp := &openapi.SOperation{
+		Tags:        tags,
+		Summary:     fmt.Sprintf("List %s", plural),
+		OperationId: fmt.Sprintf("%s_list", plural),
+		Responses: jsonResponses(&openapi.SSchema{
+			Type: "object",
+			Properties: map[string]*openapi.SSchema{
+				plural:   {Type: "array", Items: details},
+				"total":  {Type: "integer", Format: "int64"},
+				"limit":  {Type: "integer", Format: "int64"},
+				"offset": {Type: "integer", Format: "int64"},
+			},
+		}),
+	}
+	if input, ok := methodInputType(reflect.TypeOf(manager), "ListItemFilter", 4); ok {
+		listOp.Parameters = doc.QueryParameters(input)
+	}
+	doc.AddOperation("GET", fmt.Sprintf("%s%s", prefix, plural), listOp)
+
+	item := fmt.Sprintf("%s%s/{master_id}/%s/{slave_id}", prefix, master, slave)
+	params := []openapi.SParameter{idParameter("master_id"), idParameter("slave_id")}
+	doc.AddOperation("GET", item, &openapi.SOperation{
+		Tags:        tags,
+		Summary:     fmt.Sprintf("Get details of %s", keyword),
+		OperationId: fmt.Sprintf("%s_get", plural),
+		Parameters:  params,
+		Responses:   jsonResponses(wrapSchema(keyword, details)),
+	})
+	attachOp := &openapi.SOperation{
+		Tags:        tags,
+		Summary:     fmt.Sprintf("Attach %s to %s", slave, master),
+		OperationId: fmt.Sprintf("%s_attach", plural),
+		Parameters:  params,
+		Responses:   jsonResponses(wrapSchema(keyword, details)),
+	}
+	if input, ok := methodInputType(reflect.TypeOf(manager), "ValidateCreateData", 5); ok {
+		attachOp.RequestBody = &openapi.SRequestBody{
+			Content: openapi.NewJsonContent(wrapSchema(keyword, optionalSchemaOf(doc, input))),
+		}
+	}
+	doc.AddOperation("POST", item, attachOp)
+	updateOp := &openapi.SOperation{
+		Tags:        tags,
+		Summary:     fmt.Sprintf("Update %s", keyword),
+		OperationId: fmt.Sprintf("%s_update", plural),
+		Parameters:  params,
+		Responses:   jsonResponses(wrapSchema(keyword, details)),
+	}
+	if modelType := modelPtrType(manager); modelType != nil {
+		if input, ok := methodInputType(modelType, "ValidateUpdateData", 4); ok {
+			updateOp.RequestBody = &openapi.SRequestBody{
+				Content: openapi.NewJsonContent(wrapSchema(keyword, optionalSchemaOf(doc, input))),
+			}
+		}
+	}
+	doc.AddOperation("PUT", item, updateOp)
+	doc.AddOperation("DELETE", item, &openapi.SOperation{
+		Tags:        tags,
+		Summary:     fmt.Sprintf("Detach %s from %s", slave, master),
+		OperationId: fmt.Sprintf("%s_detach", plural),
+		Parameters:  params,
+		Responses:   jsonResponses(wrapSchema(keyword, details)),
+	})
+}
@@ -285,6 +285,16 @@ const (
 	ACT_UPDATE_MONITOR_RESOURCE_JOINT = "update_monitor_resource_joint"
 	ACT_DETACH_MONITOR_RESOURCE_JOINT = "detach_monitor_resource_joint"
 
+	ACT_ALERT_ACK      = "alert_ack"
+	ACT_ALERT_ASSIGN   = "alert_assign"
+	ACT_ALERT_RESOLVE  = "alert_resolve"
+	ACT_ALERT_ESCALATE = "alert_escalate"
+
 	ACT_MERGE_NETWORK        = "merge_network"
 	ACT_MERGE_NETWORK_FAILED = "merge_network_failed"
+
+	ACT_CDN_PURGE_CACHE        = "cdn_purge_cache"
+	ACT_CDN_PURGE_CACHE_FAILED = "cdn_purge_cache_failed"
+	ACT_CDN_PREFETCH           = "cdn_prefetch"
+	ACT_CDN_PREFETCH_FAILED    = "cdn_prefetch_failed"
 )
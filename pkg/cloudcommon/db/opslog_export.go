@@ -0,0 +1,290 @@
+// Copyright 2019 Yunion
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package db
+
+import (
+	"context"
+	"crypto/md5"
+	"fmt"
+	"os"
+	"strings"
+	"time"
+
+	"yunion.io/x/log"
+	"yunion.io/x/pkg/errors"
+	"yunion.io/x/pkg/utils"
+	"yunion.io/x/sqlchemy"
+
+	"yunion.io/x/onecloud/pkg/cloudcommon/consts"
+	common_options "yunion.io/x/onecloud/pkg/cloudcommon/options"
+	"yunion.io/x/onecloud/pkg/util/auditexport"
+)
+
+const (
+	opslogExportBatchSize = 200
+	opslogExportInterval  = 5 * time.Second
+	// opslogs younger than the settle delay are left to the next round, an
+	// auto increment id may become visible after a larger one inserted by a
+	// concurrent transaction
+	opslogExportSettleDelay = 2 * time.Second
+	// only the replica holding the lease on the cursor exports, the lease is
+	// renewed on every batch and taken over by another replica after expiry
+	opslogExportLeaseTTL = 6 * opslogExportInterval
+)
+
+type SOpsLogExportCursorManager struct {
+	SModelBaseManager
+}
+
+var OpsLogExportCursorManager *SOpsLogExportCursorManager
+
+func init() {
+	OpsLogExportCursorManager = &SOpsLogExportCursorManager{
+		SModelBaseManager: NewModelBaseManager(
+			SOpsLogExportCursor{},
+			"opslog_export_cursor_tbl",
+			"opslog_export_cursor",
+			"opslog_export_cursors",
+		),
+	}
+	OpsLogExportCursorManager.SetVirtualObject(OpsLogExportCursorManager)
+}
+
+// SOpsLogExportCursor records the last opslog accepted by an export target,
+// exporting resumes after it on restart
+type SOpsLogExportCursor struct {
+	SModelBase
+
+	// 导出目标url的摘要
+	Id string `width:"128" charset:"ascii" primary:"true"`
+
+	// 最近导出的操作日志ID
+	LastId int64 `nullable:"false" default:"0"`
+
+	// 持有导出租约的服务实例
+	LeaseHolder string `width:"128" charset:"ascii" nullable:"true"`
+	// 租约过期时间
+	LeaseExpireAt time.Time `nullable:"true"`
+
+	UpdatedAt time.Time `nullable:"true"`
+}
+
+func opslogExportCursorId(url string) string {
+	return fmt.Sprintf("%x", md5.Sum([]byte(url)))
+}
+
+// opslogExportLeaseHolder identifies the replica in the lease of cursor
+func opslogExportLeaseHolder() string {
+	hostname, _ := os.Hostname()
+	return fmt.Sprintf("%s-%d-%s", hostname, os.Getpid(), utils.GenRequestId(6))
+}
+
+// ensureCursor creates the cursor of a new export target, which starts from
+// the opslogs created after it is set. The cursor of an existing target is
+// never overwritten, replicas racing to create it keep the first one
+func (manager *SOpsLogExportCursorManager) ensureCursor(ctx context.Context, id string) error {
+	cnt, err := manager.Query().Equals("id", id).CountWithError()
+	if err != nil {
+		return errors.Wrap(err, "query cursor")
+	}
+	if cnt > 0 {
+		return nil
+	}
+	opslog := SOpsLog{}
+	err = OpsLog.Query().Desc("id").First(&opslog)
+	if err != nil && errors.Cause(err) != sqlchemy.ErrEmptyQuery {
+		return errors.Wrap(err, "query last opslog")
+	}
+	cursor := &SOpsLogExportCursor{
+		Id:        id,
+		LastId:    opslog.Id,
+		UpdatedAt: time.Now().UTC(),
+	}
+	cursor.SetModelManager(manager, cursor)
+	err = manager.TableSpec().Insert(ctx, cursor)
+	if err != nil {
+		cnt, _ := manager.Query().Equals("id", id).CountWithError()
+		if cnt > 0 {
+			return nil
+		}
+		return errors.Wrap(err, "insert cursor")
+	}
+	return nil
+}
+
+func (manager *SOpsLogExportCursorManager) fetchCursor(id string) (int64, error) {
+	cursor := SOpsLogExportCursor{}
+	err := manager.Query().Equals("id", id).First(&cursor)
+	if err != nil {
+		return 0, errors.Wrap(err, "query cursor")
+	}
+	return cursor.LastId, nil
+}
+
+// acquireLeaseSql takes the lease if it is free, expired or held by holder
+func acquireLeaseSql(table string) string {
+	return fmt.Sprintf("UPDATE `%s` SET `lease_holder` = ?, `lease_expire_at` = ? WHERE `id` = ? AND (`lease_holder` = ? OR `lease_holder` IS NULL OR `lease_holder` = '' OR `lease_expire_at` IS NULL OR `lease_expire_at` < ?)", table)
+}
+
+// saveCursorSql moves the cursor and renews the lease only if holder still
+// holds the lease, a replica that lost it can not move the cursor back
+func saveCursorSql(table string) string {
+	return fmt.Sprintf("UPDATE `%s` SET `last_id` = ?, `lease_expire_at` = ?, `updated_at` = ? WHERE `id` = ? AND `lease_holder` = ?", table)
+}
+
+func execAffected(sql string, args ...interface{}) (bool, error) {
+	result, err := sqlchemy.GetDB().Exec(sql, args...)
+	if err != nil {
+		return false, err
+	}
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return false, err
+	}
+	return affected > 0, nil
+}
+
+func (manager *SOpsLogExportCursorManager) acquireLease(id, holder string) (bool, error) {
+	now := time.Now().UTC()
+	return execAffected(acquireLeaseSql(manager.TableSpec().Name()), holder, now.Add(opslogExportLeaseTTL), id, holder, now)
+}
+
+func (manager *SOpsLogExportCursorManager) saveCursor(id, holder string, lastId int64) error {
+	now := time.Now().UTC()
+	ok, err := execAffected(saveCursorSql(manager.TableSpec().Name()), lastId, now.Add(opslogExportLeaseTTL), now, id, holder)
+	if err != nil {
+		return err
+	}
+	if !ok {
+		return errors.Wrap(errors.ErrInvalidStatus, "lease of opslog export cursor lost")
+	}
+	return nil
+}
+
+func opslogToAuditEvent(opslog *SOpsLog) auditexport.SEvent {
+	return auditexport.SEvent{
+		Id:             opslog.Id,
+		Source:         consts.GetServiceType() + "-" + OpsLog.Keyword(),
+		Service:        consts.GetServiceType(),
+		ObjType:        opslog.ObjType,
+		ObjId:          opslog.ObjId,
+		ObjName:        opslog.ObjName,
+		Action:         opslog.Action,
+		Notes:          opslog.Notes,
+		Success:        !strings.HasSuffix(opslog.Action, "_fail"),
+		UserId:         opslog.UserId,
+		User:           opslog.User,
+		DomainId:       opslog.DomainId,
+		Domain:         opslog.Domain,
+		ProjectId:      opslog.ProjectId,
+		Project:        opslog.Project,
+		Roles:          opslog.Roles,
+		OwnerDomainId:  opslog.OwnerDomainId,
+		OwnerProjectId: opslog.OwnerProjectId,
+		OpsTime:        opslog.OpsTime,
+	}
+}
+
+// exportOpsLogs sends the opslogs after the cursor in batches, the cursor
+// only moves on after a batch is accepted so that every opslog is exported
+// at least once. Only the replica holding the lease of the cursor exports
+func exportOpsLogs(ctx context.Context, exporter *auditexport.SExporter, cursorId, holder string) error {
+	err := OpsLogExportCursorManager.ensureCursor(ctx, cursorId)
+	if err != nil {
+		return errors.Wrap(err, "ensureCursor")
+	}
+	ok, err := OpsLogExportCursorManager.acquireLease(cursorId, holder)
+	if err != nil {
+		return errors.Wrap(err, "acquireLease")
+	}
+	if !ok {
+		return nil
+	}
+	lastId, err := OpsLogExportCursorManager.fetchCursor(cursorId)
+	if err != nil {
+		return errors.Wrap(err, "fetchCursor")
+	}
+	for {
+		q := OpsLog.Query().GT("id", lastId).LT("ops_time", time.Now().UTC().Add(-opslogExportSettleDelay))
+		q = q.Asc("id").Limit(opslogExportBatchSize)
+		opslogs := make([]SOpsLog, 0)
+		err := FetchModelObjects(OpsLog, q, &opslogs)
+		if err != nil {
+			return errors.Wrap(err, "FetchModelObjects")
+		}
+		if len(opslogs) == 0 {
+			return nil
+		}
+		events := make([]auditexport.SEvent, len(opslogs))
+		for i := range opslogs {
+			events[i] = opslogToAuditEvent(&opslogs[i])
+		}
+		err = exporter.Export(ctx, events)
+		if err != nil {
+			return errors.Wrap(err, "Export")
+		}
+		lastId = opslogs[len(opslogs)-1].Id
+		err = OpsLogExportCursorManager.saveCursor(cursorId, holder, lastId)
+		if err != nil {
+			return errors.Wrap(err, "saveCursor")
+		}
+		if len(opslogs) < opslogExportBatchSize {
+			return nil
+		}
+	}
+}
+
+func isOpsLogExportEnabled(opt *common_options.DBOptions) bool {
+	return len(opt.OpslogExportUrl) > 0 && GetModelManager(OpsLog.Keyword()) != nil
+}
+
+// StartOpsLogExportWorker streams the opslogs of the service to the audit
+// receiver given by OpslogExportUrl
+func StartOpsLogExportWorker(opt *common_options.DBOptions) {
+	conf, err := auditexport.ParseUrl(opt.OpslogExportUrl)
+	if err != nil {
+		log.Errorf("invalid opslog_export_url: %s", err)
+		return
+	}
+	conf.InsecureSkipVerify = opt.OpslogExportInsecure
+	format := opt.OpslogExportFormat
+	cursorId := opslogExportCursorId(opt.OpslogExportUrl)
+	holder := opslogExportLeaseHolder()
+	go func() {
+		var exporter *auditexport.SExporter
+		ticker := time.NewTicker(opslogExportInterval)
+		defer ticker.Stop()
+		for range ticker.C {
+			if !consts.OpsLogEnabled() {
+				continue
+			}
+			if exporter == nil {
+				e, err := auditexport.NewExporter(conf, format)
+				if err != nil {
+					log.Errorf("create opslog exporter: %s", err)
+					continue
+				}
+				exporter = e
+			}
+			err := exportOpsLogs(context.Background(), exporter, cursorId, holder)
+			if err != nil {
+				log.Errorf("export opslogs: %s", err)
+				// reconnect on next round
+				exporter.Close()
+				exporter = nil
+			}
+		}
+	}()
+}
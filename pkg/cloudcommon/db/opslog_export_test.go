@@ -0,0 +1,42 @@
+// Copyright 2019 Yunion
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package db
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestOpsLogExportLeaseSql(t *testing.T) {
+	sql := acquireLeaseSql("opslog_export_cursor_tbl")
+	for _, want := range []string{"`lease_holder` = ?", "`lease_expire_at` < ?", "WHERE `id` = ?"} {
+		if !strings.Contains(sql, want) {
+			t.Errorf("acquire lease sql should contain %s: %s", want, sql)
+		}
+	}
+	if strings.Count(sql, "?") != 5 {
+		t.Errorf("acquire lease sql should take 5 args: %s", sql)
+	}
+	sql = saveCursorSql("opslog_export_cursor_tbl")
+	if !strings.HasSuffix(sql, "AND `lease_holder` = ?") {
+		t.Errorf("save cursor should be conditional on the lease holder: %s", sql)
+	}
+	if strings.Count(sql, "?") != 5 {
+		t.Errorf("save cursor sql should take 5 args: %s", sql)
+	}
+	if opslogExportLeaseHolder() == opslogExportLeaseHolder() {
+		t.Errorf("lease holders of workers should differ")
+	}
+}
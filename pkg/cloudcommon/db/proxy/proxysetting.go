@@ -29,9 +29,13 @@ import (
 	"yunion.io/x/pkg/errors"
 
 	proxyapi "yunion.io/x/onecloud/pkg/apis/cloudcommon/proxy"
+	cloudproxy_api "yunion.io/x/onecloud/pkg/apis/cloudproxy"
+	"yunion.io/x/onecloud/pkg/cloudcommon/consts"
 	"yunion.io/x/onecloud/pkg/cloudcommon/db"
 	"yunion.io/x/onecloud/pkg/httperrors"
 	"yunion.io/x/onecloud/pkg/mcclient"
+	"yunion.io/x/onecloud/pkg/mcclient/auth"
+	cloudproxy_modules "yunion.io/x/onecloud/pkg/mcclient/modules/cloudproxy"
 	"yunion.io/x/onecloud/pkg/util/httputils"
 	"yunion.io/x/onecloud/pkg/util/rbacutils"
 )
@@ -60,6 +64,8 @@ type SProxySetting struct {
 	HTTPProxy  string `create:"domain_optional" list:"domain" update:"domain"`
 	HTTPSProxy string `create:"domain_optional" list:"domain" update:"domain"`
 	NoProxy    string `create:"domain_optional" list:"domain" update:"domain"`
+	// 通过 cloudproxy 的 dynamic 类型转发访问, 与 http_proxy 和 https_proxy 互斥
+	CloudproxyForwardId string `width:"36" charset:"ascii" nullable:"true" create:"domain_optional" list:"domain" update:"domain"`
 }
 
 func (man *SProxySettingManager) ValidateCreateData(ctx context.Context, userCred mcclient.TokenCredential, ownerId mcclient.IIdentityProvider, query jsonutils.JSONObject, data proxyapi.ProxySettingCreateInput) (proxyapi.ProxySettingCreateInput, error) {
@@ -67,6 +73,11 @@ func (man *SProxySettingManager) ValidateCreateData(ctx context.Context, userCre
 	if err := data.ProxySetting.Sanitize(); err != nil {
 		return data, httperrors.NewInputParameterError("%s", err)
 	}
+	if data.CloudproxyForwardId != "" {
+		if _, err := fetchCloudproxyForwardUrl(ctx, data.CloudproxyForwardId); err != nil {
+			return data, httperrors.NewInputParameterError("invalid cloudproxy_forward_id: %s", err)
+		}
+	}
 	data.InfrasResourceBaseCreateInput, err = man.SInfrasResourceBaseManager.ValidateCreateData(
 		ctx,
 		userCred,
@@ -85,6 +96,11 @@ func (ps *SProxySetting) ValidateUpdateData(ctx context.Context, userCred mcclie
 	if err := data.ProxySetting.Sanitize(); err != nil {
 		return data, httperrors.NewInputParameterError("%s", err)
 	}
+	if data.CloudproxyForwardId != "" {
+		if _, err := fetchCloudproxyForwardUrl(ctx, data.CloudproxyForwardId); err != nil {
+			return data, httperrors.NewInputParameterError("invalid cloudproxy_forward_id: %s", err)
+		}
+	}
 	data.InfrasResourceBaseUpdateInput, err = ps.SInfrasResourceBase.ValidateUpdateData(
 		ctx,
 		userCred,
@@ -94,10 +110,41 @@ func (ps *SProxySetting) ValidateUpdateData(ctx context.Context, userCred mcclie
 	return data, err
 }
 
+// fetchCloudproxyForwardUrl returns the socks5 url of a dynamic forward of
+// cloudproxy, the forward listens on the address advertised by its agent
+var fetchCloudproxyForwardUrl = func(ctx context.Context, forwardId string) (string, error) {
+	s := auth.GetAdminSession(ctx, consts.GetRegion(), "")
+	fwd, err := cloudproxy_modules.Forwards.Get(s, forwardId, nil)
+	if err != nil {
+		return "", errors.Wrapf(err, "get cloudproxy forward %s", forwardId)
+	}
+	fwdType, _ := fwd.GetString("type")
+	if fwdType != cloudproxy_api.FORWARD_TYPE_DYNAMIC {
+		return "", errors.Wrapf(errors.ErrInvalidStatus, "forward %s is of type %s, not %s", forwardId, fwdType, cloudproxy_api.FORWARD_TYPE_DYNAMIC)
+	}
+	proxyUrl, _ := fwd.GetString("proxy_url")
+	if proxyUrl == "" {
+		return "", errors.Wrapf(errors.ErrInvalidStatus, "forward %s has no proxy agent", forwardId)
+	}
+	return proxyUrl, nil
+}
+
 func (ps *SProxySetting) HttpTransportProxyFunc() httputils.TransportProxyFunc {
+	httpProxy, httpsProxy := ps.HTTPProxy, ps.HTTPSProxy
+	if ps.CloudproxyForwardId != "" {
+		proxyUrl, err := fetchCloudproxyForwardUrl(context.Background(), ps.CloudproxyForwardId)
+		if err != nil {
+			// requests fail instead of bypassing the forward
+			err = errors.Wrapf(err, "proxysetting %s", ps.Id)
+			return func(req *http.Request) (*url.URL, error) {
+				return nil, err
+			}
+		}
+		httpProxy, httpsProxy = proxyUrl, proxyUrl
+	}
 	cfg := &httpproxy.Config{
-		HTTPProxy:  ps.HTTPProxy,
-		HTTPSProxy: ps.HTTPSProxy,
+		HTTPProxy:  httpProxy,
+		HTTPSProxy: httpsProxy,
 		NoProxy:    ps.NoProxy,
 	}
 	proxyFunc := cfg.ProxyFunc()
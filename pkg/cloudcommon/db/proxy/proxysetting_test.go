@@ -0,0 +1,160 @@
+// Copyright 2019 Yunion
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package proxy
+
+import (
+	"context"
+	"encoding/binary"
+	"io"
+	"io/ioutil"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"testing"
+
+	"yunion.io/x/pkg/errors"
+)
+
+// startTestSocks5 serves a minimal socks5 proxy that connects every request
+// to target, it records the requested destinations
+func startTestSocks5(t *testing.T, target string) (string, chan string, func()) {
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatalf("listen: %v", err)
+	}
+	dests := make(chan string, 16)
+	serve := func(conn net.Conn) {
+		defer conn.Close()
+		buf := make([]byte, 262)
+		// greeting: ver, nmethods, methods
+		if _, err := io.ReadFull(conn, buf[:2]); err != nil {
+			return
+		}
+		if _, err := io.ReadFull(conn, buf[:buf[1]]); err != nil {
+			return
+		}
+		conn.Write([]byte{5, 0})
+		// request: ver, cmd, rsv, atyp, addr, port
+		if _, err := io.ReadFull(conn, buf[:4]); err != nil {
+			return
+		}
+		var host string
+		switch buf[3] {
+		case 1, 4:
+			n := 4
+			if buf[3] == 4 {
+				n = 16
+			}
+			if _, err := io.ReadFull(conn, buf[:n]); err != nil {
+				return
+			}
+			host = net.IP(buf[:n]).String()
+		case 3:
+			if _, err := io.ReadFull(conn, buf[:1]); err != nil {
+				return
+			}
+			n := int(buf[0])
+			if _, err := io.ReadFull(conn, buf[:n]); err != nil {
+				return
+			}
+			host = string(buf[:n])
+		default:
+			return
+		}
+		if _, err := io.ReadFull(conn, buf[:2]); err != nil {
+			return
+		}
+		dests <- net.JoinHostPort(host, strconv.Itoa(int(binary.BigEndian.Uint16(buf[:2]))))
+		upstream, err := net.Dial("tcp", target)
+		if err != nil {
+			conn.Write([]byte{5, 5, 0, 1, 0, 0, 0, 0, 0, 0})
+			return
+		}
+		defer upstream.Close()
+		conn.Write([]byte{5, 0, 0, 1, 0, 0, 0, 0, 0, 0})
+		go io.Copy(upstream, conn)
+		io.Copy(conn, upstream)
+	}
+	go func() {
+		for {
+			conn, err := listener.Accept()
+			if err != nil {
+				return
+			}
+			go serve(conn)
+		}
+	}()
+	return "socks5://" + listener.Addr().String(), dests, func() { listener.Close() }
+}
+
+func TestHttpTransportProxyFuncCloudproxyForward(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte("hello " + r.Host))
+	}))
+	defer srv.Close()
+	proxyUrl, dests, stop := startTestSocks5(t, srv.Listener.Addr().String())
+	defer stop()
+
+	fetch := fetchCloudproxyForwardUrl
+	defer func() {
+		fetchCloudproxyForwardUrl = fetch
+	}()
+	fetchCloudproxyForwardUrl = func(ctx context.Context, forwardId string) (string, error) {
+		if forwardId != "fwd-dynamic" {
+			return "", errors.Wrapf(errors.ErrNotFound, "forward %s", forwardId)
+		}
+		return proxyUrl, nil
+	}
+
+	ps := &SProxySetting{
+		NoProxy:             "direct.example.com",
+		CloudproxyForwardId: "fwd-dynamic",
+	}
+	proxyFunc := ps.HttpTransportProxyFunc()
+
+	req, _ := http.NewRequest("GET", "https://direct.example.com/", nil)
+	if u, err := proxyFunc(req); err != nil || u != nil {
+		t.Errorf("no_proxy hosts should be connected directly, got %v %v", u, err)
+	}
+	for _, s := range []string{"http://ecs.example.com/", "https://ecs.example.com/"} {
+		req, _ := http.NewRequest("GET", s, nil)
+		u, err := proxyFunc(req)
+		if err != nil || u == nil || u.String() != proxyUrl {
+			t.Errorf("%s should be connected through %s, got %v %v", s, proxyUrl, u, err)
+		}
+	}
+
+	// the provider client connects through the forward, the host does not resolve otherwise
+	client := &http.Client{Transport: &http.Transport{Proxy: proxyFunc}}
+	resp, err := client.Get("http://ecs.example.com/")
+	if err != nil {
+		t.Fatalf("get through the forward: %v", err)
+	}
+	body, _ := ioutil.ReadAll(resp.Body)
+	resp.Body.Close()
+	if string(body) != "hello ecs.example.com" {
+		t.Errorf("unexpected response %q", body)
+	}
+	if dest := <-dests; dest != "ecs.example.com:80" {
+		t.Errorf("unexpected destination %s", dest)
+	}
+
+	ps.CloudproxyForwardId = "fwd-missing"
+	req, _ = http.NewRequest("GET", "https://ecs.example.com/", nil)
+	if _, err := ps.HttpTransportProxyFunc()(req); err == nil {
+		t.Errorf("requests should fail instead of bypassing a missing forward")
+	}
+}
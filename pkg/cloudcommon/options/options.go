@@ -147,6 +147,10 @@ type DBOptions struct {
 
 	LockmanMethod string `help:"method for lock synchronization" choices:"inmemory|etcd" default:"inmemory"`
 
+	OpslogExportUrl      string `help:"export opslogs to audit receiver, e.g. syslog+tls://host:6514, kafka://broker1,broker2/topic or https://collector/path"`
+	OpslogExportFormat   string `help:"format of exported opslogs" choices:"rfc5424|cef|leef|json" default:"json"`
+	OpslogExportInsecure bool   `help:"skip tls verification of the opslog audit receiver" default:"false"`
+
 	// SplitableMaxKeepSegments  int `help:"maximal segements of splitable to keep, default 6 segments" default:"6"`
 	// SplitableMaxDurationHours int `help:"maximal number of hours that a splitable segement lasts, default 30 days" default:"720"`
 
@@ -40,6 +40,8 @@ import (
 func InitHandlers(app *appsrv.Application) {
 	db.InitAllManagers()
 
+	db.AddOpenApiHandler("", app)
+
 	taskman.AddTaskHandler("v1", app)
 	db.AddScopeResourceCountHandler("", app)
 
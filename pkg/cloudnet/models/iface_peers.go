@@ -19,12 +19,23 @@ import (
 	"fmt"
 	"net"
 	"strings"
+	"time"
 
+	"github.com/pkg/errors"
+
+	"yunion.io/x/jsonutils"
 	"yunion.io/x/log"
 	yerrors "yunion.io/x/pkg/util/errors"
 	"yunion.io/x/pkg/util/netutils"
+	"yunion.io/x/sqlchemy"
 
+	"yunion.io/x/onecloud/pkg/apis"
+	api "yunion.io/x/onecloud/pkg/apis/cloudnet"
 	"yunion.io/x/onecloud/pkg/cloudcommon/db"
+	"yunion.io/x/onecloud/pkg/cloudcommon/notifyclient"
+	"yunion.io/x/onecloud/pkg/cloudcommon/validators"
+	cnutils "yunion.io/x/onecloud/pkg/cloudnet/utils"
+	"yunion.io/x/onecloud/pkg/httperrors"
 	"yunion.io/x/onecloud/pkg/mcclient"
 )
 
@@ -41,6 +52,13 @@ type SIfacePeer struct {
 	AllowedIPs          string
 	Endpoint            string
 	PersistentKeepalive int
+
+	// Status is derived from latest handshake reported by "wg show"
+	Status          string    `width:"16" charset:"ascii" nullable:"false" default:"unknown" list:"user"`
+	LastHandshake   time.Time `nullable:"true" list:"user"`
+	TransferRx      int64     `nullable:"false" default:"0" list:"user"`
+	TransferTx      int64     `nullable:"false" default:"0" list:"user"`
+	StatusUpdatedAt time.Time `nullable:"true" list:"user"`
 }
 
 type SIfacePeerManager struct {
@@ -61,6 +79,39 @@ func init() {
 	IfacePeerManager.SetVirtualObject(IfacePeerManager)
 }
 
+func (man *SIfacePeerManager) ValidateCreateData(ctx context.Context, userCred mcclient.TokenCredential, ownerId mcclient.IIdentityProvider, query jsonutils.JSONObject, data *jsonutils.JSONDict) (*jsonutils.JSONDict, error) {
+	return nil, httperrors.NewUnsupportOperationError("iface peers are managed through mesh networks")
+}
+
+// 虚拟路由器接口对端列表
+func (man *SIfacePeerManager) ListItemFilter(ctx context.Context, q *sqlchemy.SQuery, userCred mcclient.TokenCredential, query jsonutils.JSONObject) (*sqlchemy.SQuery, error) {
+	input := apis.StandaloneResourceListInput{}
+	err := query.Unmarshal(&input)
+	if err != nil {
+		return nil, errors.Wrap(err, "query.Unmarshal")
+	}
+	q, err = man.SStandaloneResourceBaseManager.ListItemFilter(ctx, q, userCred, input)
+	if err != nil {
+		return nil, errors.Wrap(err, "SStandaloneResourceBaseManager.ListItemFilter")
+	}
+	data := query.(*jsonutils.JSONDict)
+	q, err = validators.ApplyModelFilters(q, data, []*validators.ModelFilterOptions{
+		{Key: "router", ModelKeyword: "router", OwnerId: userCred},
+		{Key: "peer_router", ModelKeyword: "router", OwnerId: userCred},
+	})
+	if err != nil {
+		return nil, err
+	}
+	if status, _ := data.GetString("status"); status != "" {
+		q = q.Equals("status", status)
+	}
+	return q, nil
+}
+
+func (ifacePeer *SIfacePeer) ValidateDeleteCondition(ctx context.Context) error {
+	return httperrors.NewUnsupportOperationError("iface peers are managed through mesh networks")
+}
+
 func (ifacePeer *SIfacePeer) subnetsStrList() []string {
 	return strings.Split(ifacePeer.AllowedIPs, ",")
 }
@@ -210,3 +261,33 @@ func (man *SIfacePeerManager) updateEndpointIPByPeerRouter(ctx context.Context,
 	}
 	return yerrors.NewAggregate(errs)
 }
+
+func (ifacePeer *SIfacePeer) updateStats(ctx context.Context, stat *cnutils.WgPeerStat, now time.Time, staleAfter time.Duration) error {
+	status := api.IFACE_PEER_STATUS_UP
+	age := stat.HandshakeAge(now)
+	if age < 0 {
+		status = api.IFACE_PEER_STATUS_DOWN
+	} else if age > staleAfter {
+		status = api.IFACE_PEER_STATUS_STALE
+	}
+	oldStatus := ifacePeer.Status
+	_, err := db.Update(ifacePeer, func() error {
+		ifacePeer.Status = status
+		ifacePeer.LastHandshake = stat.LatestHandshake
+		ifacePeer.TransferRx = stat.TransferRx
+		ifacePeer.TransferTx = stat.TransferTx
+		ifacePeer.StatusUpdatedAt = now
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+	if oldStatus == api.IFACE_PEER_STATUS_UP && status != api.IFACE_PEER_STATUS_UP {
+		reason := fmt.Sprintf("peer %s (%s) is %s", ifacePeer.Endpoint, ifacePeer.PublicKey, status)
+		if !stat.LatestHandshake.IsZero() {
+			reason += fmt.Sprintf(", latest handshake %s ago", age.Truncate(time.Second))
+		}
+		notifyclient.NotifySystemWarningWithCtx(ctx, ifacePeer.Id, ifacePeer.Name, api.IFACE_PEER_HANDSHAKE_STALE, reason)
+	}
+	return nil
+}
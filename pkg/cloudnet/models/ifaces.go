@@ -20,6 +20,7 @@ import (
 	"regexp"
 	"strconv"
 	"strings"
+	"time"
 
 	"github.com/pkg/errors"
 
@@ -50,6 +51,8 @@ type SIface struct {
 	ListenPort int `nullable:"false"`
 
 	IsSystem bool `nullable:"false"`
+
+	KeyRotatedAt time.Time `nullable:"true" list:"user"`
 }
 
 type SIfaceManager struct {
@@ -372,6 +375,8 @@ func (man *SIfaceManager) addWireguardIface(ctx context.Context, userCred mcclie
 		PrivateKey: k.String(),
 		PublicKey:  k.PublicKey().String(),
 		ListenPort: port,
+
+		KeyRotatedAt: time.Now(),
 	}
 	iface.IsSystem = true
 
@@ -0,0 +1,275 @@
+// Copyright 2019 Yunion
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package models
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+
+	"yunion.io/x/log"
+	yerrors "yunion.io/x/pkg/util/errors"
+
+	api "yunion.io/x/onecloud/pkg/apis/cloudnet"
+	"yunion.io/x/onecloud/pkg/cloudcommon/db"
+	"yunion.io/x/onecloud/pkg/cloudcommon/notifyclient"
+	"yunion.io/x/onecloud/pkg/cloudnet/options"
+	cnutils "yunion.io/x/onecloud/pkg/cloudnet/utils"
+	"yunion.io/x/onecloud/pkg/mcclient"
+	"yunion.io/x/onecloud/pkg/util/ssh"
+)
+
+func wgConfPath(ifname string) string {
+	return fmt.Sprintf("/etc/wireguard/%s.conf", ifname)
+}
+
+// wgConfSedCmd replaces a "Key = value" line in wg-quick config rendered
+// from wgX_conf_j2.  Changing the file in place keeps it the same as what
+// ansible would render next time, so that realize will not restart wg-quick
+func wgConfSedCmd(ifname, key, oldVal, newVal string) string {
+	pattern := ".*"
+	if oldVal != "" {
+		pattern = oldVal
+	}
+	return fmt.Sprintf("sed -i 's|^%s = %s$|%s = %s|' %s", key, pattern, key, newVal, wgConfPath(ifname))
+}
+
+// wgRotatePeer carries what's needed to update one remote side of the
+// rotated iface
+type wgRotatePeer struct {
+	ifacePeer *SIfacePeer
+	ifname    string
+}
+
+type wgRotateRouter struct {
+	router *SRouter
+	client *ssh.Client
+	peers  []wgRotatePeer
+}
+
+func (rr *wgRotateRouter) addPeers(pubkey string) error {
+	for _, p := range rr.peers {
+		cmd := fmt.Sprintf("wg set %s peer %s", p.ifname, pubkey)
+		if p.ifacePeer.Endpoint != "" {
+			cmd += " endpoint " + p.ifacePeer.Endpoint
+		}
+		if p.ifacePeer.PersistentKeepalive > 0 {
+			cmd += fmt.Sprintf(" persistent-keepalive %d", p.ifacePeer.PersistentKeepalive)
+		}
+		if _, err := rr.router.sshRun(rr.client, cmd); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (rr *wgRotateRouter) removePeers(pubkey string) error {
+	var errs []error
+	for _, p := range rr.peers {
+		cmd := fmt.Sprintf("wg set %s peer %s remove", p.ifname, pubkey)
+		if _, err := rr.router.sshRun(rr.client, cmd); err != nil {
+			errs = append(errs, err)
+		}
+	}
+	return yerrors.NewAggregate(errs)
+}
+
+func (rr *wgRotateRouter) switchPeers(oldPubkey, newPubkey string) error {
+	var errs []error
+	for _, p := range rr.peers {
+		cmds := []string{
+			fmt.Sprintf("wg set %s peer %s allowed-ips %s", p.ifname, newPubkey, p.ifacePeer.AllowedIPs),
+			fmt.Sprintf("wg set %s peer %s remove", p.ifname, oldPubkey),
+			wgConfSedCmd(p.ifname, "PublicKey", oldPubkey, newPubkey),
+		}
+		if _, err := rr.router.sshRun(rr.client, cmds...); err != nil {
+			errs = append(errs, errors.WithMessagef(err, "router %s iface %s", rr.router.Name, p.ifname))
+		}
+	}
+	return yerrors.NewAggregate(errs)
+}
+
+// rotateKey replaces key of the wireguard iface, updating both sides of each
+// peer on the fly.
+//
+// New public key is first added on remote sides as a peer without
+// allowed-ips, which does not disturb existing traffic.  Then private key of
+// the iface is switched, which expires current sessions and handshakes with
+// the new key get matched by remote sides.  Finally allowed-ips are moved to
+// the new peer entry and the old one removed.  Failure before the private
+// key switch is rolled back.  Failure after it is reported while database
+// still records the new key, so that a later realize can fix remaining ones
+func (iface *SIface) rotateKey(ctx context.Context, userCred mcclient.TokenCredential) error {
+	if !iface.isTypeWireguard() {
+		return fmt.Errorf("iface %s(%s) is not a wireguard iface", iface.Name, iface.Id)
+	}
+	router, err := iface.getRouter()
+	if err != nil {
+		return errors.WithMessagef(err, "get iface router %s", iface.RouterId)
+	}
+	if !router.RealizeWgIfaces {
+		return fmt.Errorf("router %s does not realize wireguard ifaces", router.Name)
+	}
+	refs, err := IfacePeerManager.getByFilter(map[string]string{
+		"peer_iface_id": iface.Id,
+	})
+	if err != nil {
+		return errors.WithMessagef(err, "get peers referring iface")
+	}
+
+	remotes := []*wgRotateRouter{}
+	remoteMap := map[string]*wgRotateRouter{}
+	defer func() {
+		for _, rr := range remotes {
+			rr.client.Close()
+		}
+	}()
+	for i := range refs {
+		ifacePeer := &refs[i]
+		rr, ok := remoteMap[ifacePeer.RouterId]
+		if !ok {
+			peerRouter, err := RouterManager.getById(ifacePeer.RouterId)
+			if err != nil {
+				return errors.WithMessagef(err, "get router %s", ifacePeer.RouterId)
+			}
+			if !peerRouter.RealizeWgIfaces {
+				continue
+			}
+			client, err := peerRouter.sshClient(ctx, userCred)
+			if err != nil {
+				return errors.WithMessagef(err, "router %s", peerRouter.Name)
+			}
+			rr = &wgRotateRouter{
+				router: peerRouter,
+				client: client,
+			}
+			remotes = append(remotes, rr)
+			remoteMap[ifacePeer.RouterId] = rr
+		}
+		obj, err := db.FetchById(IfaceManager, ifacePeer.IfaceId)
+		if err != nil {
+			return errors.WithMessagef(err, "get iface %s", ifacePeer.IfaceId)
+		}
+		rr.peers = append(rr.peers, wgRotatePeer{
+			ifacePeer: ifacePeer,
+			ifname:    obj.(*SIface).Ifname,
+		})
+	}
+
+	client, err := router.sshClient(ctx, userCred)
+	if err != nil {
+		return errors.WithMessagef(err, "router %s", router.Name)
+	}
+	defer client.Close()
+
+	key := cnutils.MustNewKey()
+	privateKey := key.String()
+	publicKey := key.PublicKey().String()
+	oldPublicKey := iface.PublicKey
+
+	rollback := func(added []*wgRotateRouter) {
+		for _, rr := range added {
+			if err := rr.removePeers(publicKey); err != nil {
+				log.Errorf("iface %s key rotation: rollback router %s: %v", iface.Name, rr.router.Name, err)
+			}
+		}
+	}
+	for i, rr := range remotes {
+		if err := rr.addPeers(publicKey); err != nil {
+			rollback(remotes[:i+1])
+			return errors.WithMessagef(err, "add new peer on router %s", rr.router.Name)
+		}
+	}
+	{
+		// pass the private key through stdin to keep it off command line
+		cmd := fmt.Sprintf("umask 077; f=$(mktemp) && cat >\"$f\" && wg set %s private-key \"$f\" && %s; rc=$?; rm -f \"$f\"; exit $rc",
+			iface.Ifname, wgConfSedCmd(iface.Ifname, "PrivateKey", "", `'"$(cat "$f")"'`))
+		if _, err := router.sshRunWithInput(client, strings.NewReader(privateKey+"\n"), cmd); err != nil {
+			rollback(remotes)
+			return errors.WithMessagef(err, "set private key on router %s", router.Name)
+		}
+	}
+
+	var errs []error
+	for _, rr := range remotes {
+		if err := rr.switchPeers(oldPublicKey, publicKey); err != nil {
+			errs = append(errs, err)
+		}
+	}
+	for i := range refs {
+		ifacePeer := &refs[i]
+		if _, err := db.Update(ifacePeer, func() error {
+			ifacePeer.PublicKey = publicKey
+			return nil
+		}); err != nil {
+			errs = append(errs, err)
+		}
+	}
+	if _, err := db.Update(iface, func() error {
+		iface.PrivateKey = privateKey
+		iface.PublicKey = publicKey
+		iface.KeyRotatedAt = time.Now()
+		return nil
+	}); err != nil {
+		errs = append(errs, err)
+	}
+	db.OpsLog.LogEvent(iface, "rotate_key", publicKey, userCred)
+	return yerrors.NewAggregate(errs)
+}
+
+// RotateWireguardKeys rotates keys of wireguard ifaces older than
+// WireguardKeyRotationDays
+func (man *SIfaceManager) RotateWireguardKeys(ctx context.Context, userCred mcclient.TokenCredential, isStart bool) {
+	days := options.Options.WireguardKeyRotationDays
+	if days <= 0 {
+		return
+	}
+	routers, err := RouterManager.getWireguardRouters()
+	if err != nil {
+		log.Errorf("fetch wireguard routers: %v", err)
+		return
+	}
+	routerIds := make([]string, len(routers))
+	for i := range routers {
+		routerIds[i] = routers[i].Id
+	}
+	if len(routerIds) == 0 {
+		return
+	}
+	ifaces := []SIface{}
+	q := man.Query().In("router_id", routerIds).IsNotEmpty("private_key").GT("listen_port", 0)
+	if err := db.FetchModelObjects(man, q, &ifaces); err != nil {
+		log.Errorf("fetch wireguard ifaces: %v", err)
+		return
+	}
+	cutoff := time.Now().Add(-time.Duration(days) * 24 * time.Hour)
+	for i := range ifaces {
+		iface := &ifaces[i]
+		rotatedAt := iface.KeyRotatedAt
+		if rotatedAt.IsZero() {
+			rotatedAt = iface.CreatedAt
+		}
+		if rotatedAt.After(cutoff) {
+			continue
+		}
+		if err := iface.rotateKey(ctx, userCred); err != nil {
+			log.Errorf("rotate key of iface %s(%s): %v", iface.Name, iface.Id, err)
+			notifyclient.NotifySystemErrorWithCtx(ctx, iface.Id, iface.Name, api.IFACE_KEY_ROTATE_FAILED, err.Error())
+		}
+	}
+}
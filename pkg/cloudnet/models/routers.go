@@ -271,6 +271,41 @@ func (router *SRouter) PerformUnregisterIfname(ctx context.Context, userCred mcc
 	return nil, nil
 }
 
+func (router *SRouter) AllowPerformRotateWgKeys(ctx context.Context, userCred mcclient.TokenCredential, query jsonutils.JSONObject, data jsonutils.JSONObject) bool {
+	return db.IsAdminAllowPerform(userCred, router, "rotate-wg-keys")
+}
+
+// PerformRotateWgKeys rotates keys of wireguard ifaces on the router, or
+// only the one specified by ifname
+func (router *SRouter) PerformRotateWgKeys(ctx context.Context, userCred mcclient.TokenCredential, query jsonutils.JSONObject, data jsonutils.JSONObject) (jsonutils.JSONObject, error) {
+	ifname, _ := data.GetString("ifname")
+	ifaces := []*SIface{}
+	if ifname != "" {
+		iface, err := IfaceManager.getByRouterIfname(router, ifname)
+		if err != nil {
+			return nil, httperrors.NewBadRequestError("get iface: %s", err)
+		}
+		ifaces = append(ifaces, iface)
+	} else {
+		routerIfaces, err := IfaceManager.getByRouter(router)
+		if err != nil {
+			return nil, httperrors.NewInternalServerError("get router ifaces: %v", err)
+		}
+		for i := range routerIfaces {
+			ifaces = append(ifaces, &routerIfaces[i])
+		}
+	}
+	for _, iface := range ifaces {
+		if !iface.isTypeWireguard() {
+			continue
+		}
+		if err := iface.rotateKey(ctx, userCred); err != nil {
+			return nil, httperrors.NewBadRequestError("rotate key of iface %s: %v", iface.Ifname, err)
+		}
+	}
+	return nil, nil
+}
+
 func (router *SRouter) mustFindFreePort(ctx context.Context) int {
 	// loop through ifaces listen port
 	ifaces, err := IfaceManager.getByRouter(router)
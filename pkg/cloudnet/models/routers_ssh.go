@@ -0,0 +1,85 @@
+// Copyright 2019 Yunion
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package models
+
+import (
+	"context"
+	"io"
+	"strings"
+
+	"github.com/pkg/errors"
+
+	"yunion.io/x/jsonutils"
+
+	"yunion.io/x/onecloud/pkg/cloudnet/options"
+	"yunion.io/x/onecloud/pkg/mcclient"
+	"yunion.io/x/onecloud/pkg/mcclient/auth"
+	mcclient_modules "yunion.io/x/onecloud/pkg/mcclient/modules"
+	"yunion.io/x/onecloud/pkg/util/ssh"
+)
+
+// sshClient connects to the router with its own private key.  When the
+// router has no key of its own, the admin keypair, which is also what
+// ansible uses for realizing, will be used
+func (router *SRouter) sshClient(ctx context.Context, userCred mcclient.TokenCredential) (*ssh.Client, error) {
+	privateKey := router.PrivateKey
+	if privateKey == "" {
+		s := auth.GetAdminSession(ctx, options.Options.Region, "v2")
+		key, err := mcclient_modules.Sshkeypairs.GetById(s, userCred.GetProjectId(), jsonutils.Marshal(map[string]bool{"admin": true}))
+		if err != nil {
+			return nil, errors.WithMessagef(err, "get admin keypair")
+		}
+		privateKey, _ = key.GetString("private_key")
+	}
+	port := router.Port
+	if port <= 0 {
+		port = 22
+	}
+	conf := ssh.ClientConfig{
+		Username:   router.User,
+		Host:       router.Host,
+		Port:       port,
+		PrivateKey: privateKey,
+	}
+	client, err := conf.NewClient()
+	if err != nil {
+		return nil, errors.WithMessagef(err, "ssh %s@%s:%d", router.User, router.Host, port)
+	}
+	return client, nil
+}
+
+// sshRun runs cmds on the router, escalating with sudo like ansible_become
+// when not logged in as root
+func (router *SRouter) sshRun(client *ssh.Client, cmds ...string) ([]string, error) {
+	if router.User != "root" {
+		wrapped := make([]string, len(cmds))
+		for i, cmd := range cmds {
+			wrapped[i] = "sudo -n sh -c " + shellQuote(cmd)
+		}
+		cmds = wrapped
+	}
+	return client.Run(cmds...)
+}
+
+func shellQuote(s string) string {
+	return "'" + strings.Replace(s, "'", `'\''`, -1) + "'"
+}
+
+func (router *SRouter) sshRunWithInput(client *ssh.Client, input io.Reader, cmd string) ([]string, error) {
+	if router.User != "root" {
+		cmd = "sudo -n sh -c " + shellQuote(cmd)
+	}
+	return client.RunWithInput(input, cmd)
+}
@@ -0,0 +1,155 @@
+// Copyright 2019 Yunion
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package models
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+
+	"yunion.io/x/log"
+
+	api "yunion.io/x/onecloud/pkg/apis/cloudnet"
+	"yunion.io/x/onecloud/pkg/cloudcommon/db"
+	"yunion.io/x/onecloud/pkg/cloudnet/options"
+	cnutils "yunion.io/x/onecloud/pkg/cloudnet/utils"
+	"yunion.io/x/onecloud/pkg/mcclient"
+	"yunion.io/x/onecloud/pkg/mcclient/auth"
+	"yunion.io/x/onecloud/pkg/util/influxdb"
+)
+
+func (man *SRouterManager) getWireguardRouters() ([]SRouter, error) {
+	routers := []SRouter{}
+	q := man.Query().IsTrue("realize_wg_ifaces")
+	if err := db.FetchModelObjects(man, q, &routers); err != nil {
+		return nil, err
+	}
+	return routers, nil
+}
+
+// CollectWireguardStats gathers "wg show" output from routers, updates
+// status of iface peers and sends the counters to influxdb
+func (man *SRouterManager) CollectWireguardStats(ctx context.Context, userCred mcclient.TokenCredential, isStart bool) {
+	routers, err := man.getWireguardRouters()
+	if err != nil {
+		log.Errorf("fetch wireguard routers: %v", err)
+		return
+	}
+	now := time.Now()
+	metrics := []influxdb.SMetricData{}
+	for i := range routers {
+		router := &routers[i]
+		m, err := router.collectWireguardStats(ctx, userCred, now)
+		if err != nil {
+			log.Errorf("collect wireguard stats of router %s(%s): %v", router.Name, router.Id, err)
+			continue
+		}
+		metrics = append(metrics, m...)
+	}
+	if len(metrics) == 0 {
+		return
+	}
+	s := auth.GetAdminSession(ctx, options.Options.Region, "")
+	urls, err := s.GetServiceURLs("influxdb", options.Options.SessionEndpointType)
+	if err != nil {
+		log.Errorf("get influxdb service urls: %v", err)
+		return
+	}
+	if len(urls) == 0 {
+		return
+	}
+	if err := influxdb.SendMetrics(urls, "telegraf", metrics, false); err != nil {
+		log.Errorf("send wireguard metrics: %v", err)
+	}
+}
+
+func (router *SRouter) fetchWireguardStats(ctx context.Context, userCred mcclient.TokenCredential) ([]cnutils.WgPeerStat, error) {
+	client, err := router.sshClient(ctx, userCred)
+	if err != nil {
+		return nil, err
+	}
+	defer client.Close()
+	lines, err := router.sshRun(client, "wg show all dump")
+	if err != nil {
+		return nil, errors.WithMessagef(err, "wg show all dump")
+	}
+	return cnutils.ParseWgShowAllDump(strings.Join(lines, "\n"))
+}
+
+func (router *SRouter) collectWireguardStats(ctx context.Context, userCred mcclient.TokenCredential, now time.Time) ([]influxdb.SMetricData, error) {
+	stats, err := router.fetchWireguardStats(ctx, userCred)
+	if err != nil {
+		return nil, err
+	}
+	ifaces, err := IfaceManager.getByRouter(router)
+	if err != nil {
+		return nil, errors.WithMessagef(err, "get router ifaces")
+	}
+	ifaceMap := map[string]*SIface{}
+	for i := range ifaces {
+		ifaceMap[ifaces[i].Ifname] = &ifaces[i]
+	}
+	staleAfter := time.Duration(options.Options.WireguardHandshakeStaleSeconds) * time.Second
+	metrics := []influxdb.SMetricData{}
+	for i := range stats {
+		stat := &stats[i]
+		iface, ok := ifaceMap[stat.Ifname]
+		if !ok {
+			continue
+		}
+		ifacePeer, err := IfacePeerManager.getByIfacePublicKey(iface, stat.PublicKey)
+		if err != nil {
+			if !IsNotFound(err) {
+				log.Errorf("router %s iface %s: get peer %s: %v", router.Name, iface.Ifname, stat.PublicKey, err)
+			}
+			// peers not managed by us
+			continue
+		}
+		if err := ifacePeer.updateStats(ctx, stat, now, staleAfter); err != nil {
+			log.Errorf("update iface peer %s stats: %v", ifacePeer.Id, err)
+		}
+		handshakeAge := int64(-1)
+		if age := stat.HandshakeAge(now); age >= 0 {
+			handshakeAge = int64(age / time.Second)
+		}
+		up := 0
+		if ifacePeer.Status == api.IFACE_PEER_STATUS_UP {
+			up = 1
+		}
+		metrics = append(metrics, influxdb.SMetricData{
+			Name: "wireguard_peer",
+			Tags: []influxdb.SKeyValue{
+				{Key: "router", Value: router.Name},
+				{Key: "router_id", Value: router.Id},
+				{Key: "iface", Value: iface.Ifname},
+				{Key: "peer", Value: ifacePeer.Name},
+				{Key: "peer_id", Value: ifacePeer.Id},
+				{Key: "peer_router_id", Value: ifacePeer.PeerRouterId},
+				{Key: "endpoint", Value: stat.Endpoint},
+			},
+			Metrics: []influxdb.SKeyValue{
+				{Key: "handshake_age", Value: fmt.Sprintf("%d", handshakeAge)},
+				{Key: "rx_bytes", Value: fmt.Sprintf("%d", stat.TransferRx)},
+				{Key: "tx_bytes", Value: fmt.Sprintf("%d", stat.TransferTx)},
+				{Key: "up", Value: fmt.Sprintf("%d", up)},
+			},
+			Timestamp: now,
+		})
+	}
+	return metrics, nil
+}
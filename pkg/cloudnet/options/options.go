@@ -19,6 +19,10 @@ import common_options "yunion.io/x/onecloud/pkg/cloudcommon/options"
 type CloudnetOptions struct {
 	common_options.CommonOptions
 	common_options.DBOptions
+
+	WireguardStatsIntervalSeconds  int `help:"interval to collect wireguard peer stats from routers" default:"60"`
+	WireguardHandshakeStaleSeconds int `help:"peer is considered stale when its latest handshake is older than this" default:"300"`
+	WireguardKeyRotationDays       int `help:"rotate wireguard iface keys older than this many days, 0 to disable" default:"0"`
 }
 
 var (
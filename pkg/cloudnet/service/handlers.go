@@ -38,17 +38,19 @@ import (
 func InitHandlers(app *appsrv.Application) {
 	db.InitAllManagers()
 
+	db.AddOpenApiHandler("", app)
+
 	db.RegisterModelManager(db.OpsLog)
 	db.RegisterModelManager(db.TenantCacheManager)
 	db.RegisterModelManager(db.UserCacheManager)
 	db.RegisterModelManager(models.IfaceManager)
-	db.RegisterModelManager(models.IfacePeerManager)
 	db.RegisterModelManager(models.MeshNetworkMemberManager)
 	for _, manager := range []db.IModelManager{
 		models.RouterManager,
 		models.MeshNetworkManager,
 		models.RouteManager,
 		models.RuleManager,
+		models.IfacePeerManager,
 	} {
 		db.RegisterModelManager(manager)
 		handler := db.NewModelHandler(manager)
@@ -16,6 +16,7 @@ package service
 
 import (
 	"os"
+	"time"
 
 	_ "github.com/go-sql-driver/mysql"
 
@@ -23,6 +24,7 @@ import (
 
 	"yunion.io/x/onecloud/pkg/cloudcommon"
 	common_app "yunion.io/x/onecloud/pkg/cloudcommon/app"
+	"yunion.io/x/onecloud/pkg/cloudcommon/cronman"
 	"yunion.io/x/onecloud/pkg/cloudcommon/db"
 	common_options "yunion.io/x/onecloud/pkg/cloudcommon/options"
 	"yunion.io/x/onecloud/pkg/cloudnet/models"
@@ -47,5 +49,13 @@ func StartService() {
 	db.EnsureAppInitSyncDB(app, dbOpts, models.InitDB)
 	defer cloudcommon.CloseDB()
 
+	if !opts.IsSlaveNode {
+		cron := cronman.InitCronJobManager(true, opts.CronJobWorkerCount)
+		cron.AddJobAtIntervals("CollectWireguardStats", time.Duration(opts.WireguardStatsIntervalSeconds)*time.Second, models.RouterManager.CollectWireguardStats)
+		cron.AddJobAtIntervals("RotateWireguardKeys", time.Hour, models.IfaceManager.RotateWireguardKeys)
+		cron.Start()
+		defer cron.Stop()
+	}
+
 	common_app.ServeForever(app, baseOpts)
 }
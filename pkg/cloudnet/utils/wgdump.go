@@ -0,0 +1,99 @@
+// Copyright 2019 Yunion
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package utils
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// WgPeerStat is one peer line from the output of "wg show all dump"
+type WgPeerStat struct {
+	Ifname              string
+	PublicKey           string
+	Endpoint            string
+	AllowedIPs          string
+	LatestHandshake     time.Time
+	TransferRx          int64
+	TransferTx          int64
+	PersistentKeepalive int
+}
+
+// HandshakeAge returns time elapsed since the latest handshake.  Negative
+// value is returned when no handshake has happened yet
+func (stat *WgPeerStat) HandshakeAge(now time.Time) time.Duration {
+	if stat.LatestHandshake.IsZero() {
+		return -1
+	}
+	return now.Sub(stat.LatestHandshake)
+}
+
+// ParseWgShowAllDump parses output of "wg show all dump".  Interface lines
+// are skipped and peer lines are returned
+func ParseWgShowAllDump(output string) ([]WgPeerStat, error) {
+	r := []WgPeerStat{}
+	for i, line := range strings.Split(output, "\n") {
+		line = strings.TrimSpace(line)
+		if line == "" {
+			continue
+		}
+		fields := strings.Split(line, "\t")
+		switch len(fields) {
+		case 5:
+			// ifname, private key, public key, listen port, fwmark
+			continue
+		case 9:
+		default:
+			return nil, fmt.Errorf("line %d: unexpected field count %d", i+1, len(fields))
+		}
+		stat := WgPeerStat{
+			Ifname:     fields[0],
+			PublicKey:  fields[1],
+			Endpoint:   fields[3],
+			AllowedIPs: fields[4],
+		}
+		if stat.Endpoint == "(none)" {
+			stat.Endpoint = ""
+		}
+		if stat.AllowedIPs == "(none)" {
+			stat.AllowedIPs = ""
+		}
+		handshake, err := strconv.ParseInt(fields[5], 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("line %d: bad latest handshake %q: %v", i+1, fields[5], err)
+		}
+		if handshake > 0 {
+			stat.LatestHandshake = time.Unix(handshake, 0)
+		}
+		stat.TransferRx, err = strconv.ParseInt(fields[6], 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("line %d: bad transfer rx %q: %v", i+1, fields[6], err)
+		}
+		stat.TransferTx, err = strconv.ParseInt(fields[7], 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("line %d: bad transfer tx %q: %v", i+1, fields[7], err)
+		}
+		if fields[8] != "off" {
+			stat.PersistentKeepalive, err = strconv.Atoi(fields[8])
+			if err != nil {
+				return nil, fmt.Errorf("line %d: bad persistent keepalive %q: %v", i+1, fields[8], err)
+			}
+		}
+		r = append(r, stat)
+	}
+	return r, nil
+}
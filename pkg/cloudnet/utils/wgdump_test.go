@@ -0,0 +1,72 @@
+// Copyright 2019 Yunion
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package utils
+
+import (
+	"testing"
+	"time"
+)
+
+func TestParseWgShowAllDump(t *testing.T) {
+	output := "wg0\tWJYVsrTtAae1QS9YzefV4OmVM6mkJglR+GEgxQpTs2g=\tmOX0S5AuRqd8lQZWcqTlzOS+veo404gE7NyV4u3xVkg=\t20000\toff\n" +
+		"wg0\tGpfPRS4rLSWKTCk7Ld4Lr5ZB7HvPcaiDXkGAhCk7ank=\t(none)\t10.168.222.3:20000\t10.0.0.0/24,10.0.1.0/24\t1577836800\t1024\t2048\t10\n" +
+		"wg0\tb8wLeD8RvkSdtIqyhwJbeKWVobWCRr2FFn3jVdwuH1Q=\t(none)\t(none)\t10.0.2.0/24\t0\t0\t0\toff\n"
+	stats, err := ParseWgShowAllDump(output)
+	if err != nil {
+		t.Fatalf("parse: %v", err)
+	}
+	if len(stats) != 2 {
+		t.Fatalf("want 2 peers, got %d", len(stats))
+	}
+	t.Run("active", func(t *testing.T) {
+		stat := stats[0]
+		if stat.Ifname != "wg0" {
+			t.Errorf("ifname: got %q", stat.Ifname)
+		}
+		if stat.Endpoint != "10.168.222.3:20000" {
+			t.Errorf("endpoint: got %q", stat.Endpoint)
+		}
+		if stat.AllowedIPs != "10.0.0.0/24,10.0.1.0/24" {
+			t.Errorf("allowed ips: got %q", stat.AllowedIPs)
+		}
+		if stat.TransferRx != 1024 || stat.TransferTx != 2048 {
+			t.Errorf("transfer: got rx %d, tx %d", stat.TransferRx, stat.TransferTx)
+		}
+		if stat.PersistentKeepalive != 10 {
+			t.Errorf("persistent keepalive: got %d", stat.PersistentKeepalive)
+		}
+		now := time.Unix(1577836860, 0)
+		if age := stat.HandshakeAge(now); age != time.Minute {
+			t.Errorf("handshake age: got %s", age)
+		}
+	})
+	t.Run("never", func(t *testing.T) {
+		stat := stats[1]
+		if stat.Endpoint != "" {
+			t.Errorf("endpoint: got %q", stat.Endpoint)
+		}
+		if !stat.LatestHandshake.IsZero() {
+			t.Errorf("latest handshake: got %s", stat.LatestHandshake)
+		}
+		if age := stat.HandshakeAge(time.Now()); age >= 0 {
+			t.Errorf("handshake age: got %s", age)
+		}
+	})
+	t.Run("bad", func(t *testing.T) {
+		if _, err := ParseWgShowAllDump("wg0\tx\ty\n"); err == nil {
+			t.Errorf("expect error for malformed line")
+		}
+	})
+}
@@ -14,6 +14,13 @@
 
 package cloudprovider
 
+import (
+	"reflect"
+
+	"yunion.io/x/jsonutils"
+	"yunion.io/x/pkg/gotypes"
+)
+
 type SCdnDomain struct {
 	// cdn加速域名
 	Domain string
@@ -28,3 +35,59 @@ type SCdnDomain struct {
 	// 源站类型 domain|ip|bucket
 	OriginType string
 }
+
+type SCdnOrigin struct {
+	// 源站类型 domain|ip|bucket
+	Type string
+	// 源站地址
+	Origin string
+	// 回源端口, 0表示使用默认端口
+	Port int
+	// 优先级, 数值越小优先级越高
+	Priority int
+	// 回源Host
+	ServerName string
+}
+
+type SCdnOrigins []SCdnOrigin
+
+func (self SCdnOrigins) IsZero() bool {
+	return len(self) == 0
+}
+
+func (self SCdnOrigins) String() string {
+	return jsonutils.Marshal(self).String()
+}
+
+type CdnCreateOptions struct {
+	// 加速域名
+	Domain string
+	// 加速区域 mainland|overseas|global
+	Area string
+	// 业务类型 web|download|media
+	ServiceType string
+	// 源站列表
+	Origins SCdnOrigins
+}
+
+type ICloudCDNDomain interface {
+	IVirtualResource
+
+	GetArea() string
+	GetServiceType() string
+	GetCname() string
+	GetOrigins() *SCdnOrigins
+
+	// objectType: file|directory, paths 为完整的url
+	PurgeCache(objectType string, paths []string) error
+	// urls 为完整的url
+	Prefetch(urls []string) error
+
+	Delete() error
+}
+
+func init() {
+	gotypes.RegisterSerializable(reflect.TypeOf(&SCdnOrigins{}), func() gotypes.ISerializable {
+		return &SCdnOrigins{}
+	})
+}
@@ -289,6 +289,10 @@ type ICloudProvider interface {
 	GetICloudInterVpcNetworks() ([]ICloudInterVpcNetwork, error)
 	GetICloudInterVpcNetworkById(id string) (ICloudInterVpcNetwork, error)
 	CreateICloudInterVpcNetwork(opts *SInterVpcNetworkCreateOptions) (ICloudInterVpcNetwork, error)
+
+	GetICloudCDNDomains() ([]ICloudCDNDomain, error)
+	GetICloudCDNDomainByName(name string) (ICloudCDNDomain, error)
+	CreateICloudCDNDomain(opts *CdnCreateOptions) (ICloudCDNDomain, error)
 }
 
 func IsSupportProject(prod ICloudProvider) bool {
@@ -299,6 +303,10 @@ func IsSupportDnsZone(prod ICloudProvider) bool {
 	return utils.IsInStringArray(CLOUD_CAPABILITY_DNSZONE, prod.GetCapabilities())
 }
 
+func IsSupportCDN(prod ICloudProvider) bool {
+	return utils.IsInStringArray(CLOUD_CAPABILITY_CDN, prod.GetCapabilities())
+}
+
 func IsSupportInterVpcNetwork(prod ICloudProvider) bool {
 	return utils.IsInStringArray(CLOUD_CAPABILITY_INTERVPCNETWORK, prod.GetCapabilities())
 }
@@ -493,6 +501,18 @@ func (self *SBaseProvider) CreateICloudDnsZone(opts *SDnsZoneCreateOptions) (ICl
 	return nil, ErrNotImplemented
 }
 
+func (self *SBaseProvider) GetICloudCDNDomains() ([]ICloudCDNDomain, error) {
+	return nil, ErrNotImplemented
+}
+
+func (self *SBaseProvider) GetICloudCDNDomainByName(name string) (ICloudCDNDomain, error) {
+	return nil, ErrNotImplemented
+}
+
+func (self *SBaseProvider) CreateICloudCDNDomain(opts *CdnCreateOptions) (ICloudCDNDomain, error) {
+	return nil, ErrNotImplemented
+}
+
 func (self *SBaseProvider) GetCloudRegionExternalIdPrefix() string {
 	return self.factory.GetId()
 }
@@ -60,6 +60,7 @@ const (
 	CLOUD_CAPABILITY_MONGO_DB        = "mongodb"   // MongoDB
 	CLOUD_CAPABILITY_ES              = "es"        // ElasticSearch
 	CLOUD_CAPABILITY_KAFKA           = "kafka"     // Kafka
+	CLOUD_CAPABILITY_CDN             = "cdn"       // CDN
 )
 
 const (
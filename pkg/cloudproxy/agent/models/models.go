@@ -21,7 +21,8 @@ import (
 type ProxyEndpoint struct {
 	proxy_models.SProxyEndpoint
 
-	Forwards Forwards `json:"-"`
+	Forwards     Forwards     `json:"-"`
+	ProxyMatches ProxyMatches `json:"-"`
 }
 
 func (el *ProxyEndpoint) Copy() *ProxyEndpoint {
@@ -41,3 +42,13 @@ func (el *Forward) Copy() *Forward {
 		SForward: el.SForward,
 	}
 }
+
+type ProxyMatch struct {
+	proxy_models.SProxyMatch
+}
+
+func (el *ProxyMatch) Copy() *ProxyMatch {
+	return &ProxyMatch{
+		SProxyMatch: el.SProxyMatch,
+	}
+}
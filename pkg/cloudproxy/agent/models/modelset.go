@@ -26,6 +26,7 @@ import (
 type (
 	ProxyEndpoints map[string]*ProxyEndpoint
 	Forwards       map[string]*Forward
+	ProxyMatches   map[string]*ProxyMatch
 )
 
 func (set ProxyEndpoints) ModelManager() mcclient_modulebase.IBaseManager {
@@ -68,6 +69,23 @@ func (ms ProxyEndpoints) joinForwards(subEntries Forwards) bool {
 	return correct
 }
 
+func (ms ProxyEndpoints) joinProxyMatches(subEntries ProxyMatches) bool {
+	for _, m := range ms {
+		m.ProxyMatches = ProxyMatches{}
+	}
+	for _, subEntry := range subEntries {
+		epId := subEntry.ProxyEndpointId
+		m, ok := ms[epId]
+		if !ok {
+			// proxy matches may refer to endpoints not visible, or
+			// just removed.  They are of no use for us anyway
+			continue
+		}
+		m.ProxyMatches[subEntry.Id] = subEntry
+	}
+	return true
+}
+
 func (set Forwards) ModelManager() mcclient_modulebase.IBaseManager {
 	return &mcclient_modules.Forwards
 }
@@ -88,3 +106,24 @@ func (set Forwards) Copy() apihelper.IModelSet {
 	}
 	return setCopy
 }
+
+func (set ProxyMatches) ModelManager() mcclient_modulebase.IBaseManager {
+	return &mcclient_modules.ProxyMatches
+}
+
+func (set ProxyMatches) NewModel() db.IModel {
+	return &ProxyMatch{}
+}
+
+func (set ProxyMatches) AddModel(i db.IModel) {
+	m := i.(*ProxyMatch)
+	set[m.Id] = m
+}
+
+func (set ProxyMatches) Copy() apihelper.IModelSet {
+	setCopy := ProxyMatches{}
+	for id, el := range set {
+		setCopy[id] = el.Copy()
+	}
+	return setCopy
+}
@@ -23,24 +23,28 @@ import (
 type ModelSetsMaxUpdatedAt struct {
 	ProxyEndpoints time.Time
 	Forwards       time.Time
+	ProxyMatches   time.Time
 }
 
 func NewModelSetsMaxUpdatedAt() *ModelSetsMaxUpdatedAt {
 	return &ModelSetsMaxUpdatedAt{
 		ProxyEndpoints: apihelper.PseudoZeroTime,
 		Forwards:       apihelper.PseudoZeroTime,
+		ProxyMatches:   apihelper.PseudoZeroTime,
 	}
 }
 
 type ModelSets struct {
 	ProxyEndpoints ProxyEndpoints
 	Forwards       Forwards
+	ProxyMatches   ProxyMatches
 }
 
 func NewModelSets() *ModelSets {
 	return &ModelSets{
 		ProxyEndpoints: ProxyEndpoints{},
 		Forwards:       Forwards{},
+		ProxyMatches:   ProxyMatches{},
 	}
 }
 
@@ -49,6 +53,7 @@ func (mss *ModelSets) ModelSetList() []apihelper.IModelSet {
 	return []apihelper.IModelSet{
 		mss.ProxyEndpoints,
 		mss.Forwards,
+		mss.ProxyMatches,
 	}
 }
 
@@ -60,6 +65,7 @@ func (mss *ModelSets) copy_() *ModelSets {
 	mssCopy := &ModelSets{
 		ProxyEndpoints: mss.ProxyEndpoints.Copy().(ProxyEndpoints),
 		Forwards:       mss.Forwards.Copy().(Forwards),
+		ProxyMatches:   mss.ProxyMatches.Copy().(ProxyMatches),
 	}
 	return mssCopy
 }
@@ -97,6 +103,7 @@ func (mss *ModelSets) ApplyUpdates(mssNews apihelper.IModelSets) apihelper.Model
 func (mss *ModelSets) join() bool {
 	var p []bool
 	p = append(p, mss.ProxyEndpoints.joinForwards(mss.Forwards))
+	p = append(p, mss.ProxyEndpoints.joinProxyMatches(mss.ProxyMatches))
 	for _, b := range p {
 		if !b {
 			return false
@@ -0,0 +1,86 @@
+// Copyright 2019 Yunion
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ssh
+
+import (
+	"net"
+	"strings"
+	"sync"
+)
+
+// DestACL decides which destinations a dynamic forward may dial.  It denies
+// all when no rule is set.
+//
+// Hostnames are not resolved locally as they may only be resolvable behind
+// the proxy endpoint.  They are allowed only by domain rules, while IP
+// addresses are allowed only by cidr rules
+type DestACL struct {
+	mu      *sync.RWMutex
+	cidrs   []*net.IPNet
+	domains []string
+}
+
+func NewDestACL() *DestACL {
+	return &DestACL{
+		mu: &sync.RWMutex{},
+	}
+}
+
+// Set replaces rules of the acl.  Invalid cidrs are ignored
+func (acl *DestACL) Set(cidrs []string, domains []string) {
+	ipnets := make([]*net.IPNet, 0, len(cidrs))
+	for _, cidr := range cidrs {
+		_, ipnet, err := net.ParseCIDR(cidr)
+		if err != nil {
+			continue
+		}
+		ipnets = append(ipnets, ipnet)
+	}
+	doms := make([]string, 0, len(domains))
+	for _, dom := range domains {
+		dom = strings.TrimPrefix(strings.ToLower(dom), ".")
+		if dom != "" {
+			doms = append(doms, dom)
+		}
+	}
+
+	acl.mu.Lock()
+	defer acl.mu.Unlock()
+	acl.cidrs = ipnets
+	acl.domains = doms
+}
+
+// Allow reports whether host, an IP address or a hostname, can be dialed.
+// Domain rule "example.com" matches both "example.com" and its subdomains
+func (acl *DestACL) Allow(host string) bool {
+	acl.mu.RLock()
+	defer acl.mu.RUnlock()
+
+	if ip := net.ParseIP(host); ip != nil {
+		for _, ipnet := range acl.cidrs {
+			if ipnet.Contains(ip) {
+				return true
+			}
+		}
+		return false
+	}
+	host = strings.TrimSuffix(strings.ToLower(host), ".")
+	for _, dom := range acl.domains {
+		if host == dom || strings.HasSuffix(host, "."+dom) {
+			return true
+		}
+	}
+	return false
+}
@@ -104,12 +104,15 @@ type Client struct {
 
 	lfc chan LocalForwardReq
 	rfc chan RemoteForwardReq
+	dfc chan DynamicForwardReq
 
 	lfclosec chan LocalForwardReq
 	rfclosec chan RemoteForwardReq
+	dfclosec chan DynamicForwardReq
 
-	localForwards  portMap
-	remoteForwards portMap
+	localForwards   portMap
+	remoteForwards  portMap
+	dynamicForwards portMap
 }
 
 func NewClient(cc *ssh_util.ClientConfig) *Client {
@@ -121,12 +124,15 @@ func NewClient(cc *ssh_util.ClientConfig) *Client {
 
 		lfc: make(chan LocalForwardReq),
 		rfc: make(chan RemoteForwardReq),
+		dfc: make(chan DynamicForwardReq),
 
 		lfclosec: make(chan LocalForwardReq),
 		rfclosec: make(chan RemoteForwardReq),
+		dfclosec: make(chan DynamicForwardReq),
 
-		localForwards:  portMap{},
-		remoteForwards: portMap{},
+		localForwards:   portMap{},
+		remoteForwards:  portMap{},
+		dynamicForwards: portMap{},
 	}
 	return c
 }
@@ -175,6 +181,11 @@ func (c *Client) Start(ctx context.Context) {
 				sshc.Close()
 				break
 			}
+			if v := c.dynamicForwards.get(int(port), addr); v != nil {
+				log.Errorf("ssh client local port %d collides with dynamic forward: %#v", port, v)
+				sshc.Close()
+				break
+			}
 			sshClient = sshc
 		case req := <-c.lfc:
 			if sshClient != nil {
@@ -184,10 +195,16 @@ func (c *Client) Start(ctx context.Context) {
 			if sshClient != nil {
 				c.remoteForward(ctx, sshClient, req)
 			}
+		case req := <-c.dfc:
+			if sshClient != nil {
+				c.dynamicForward(ctx, sshClient, req)
+			}
 		case req := <-c.lfclosec:
 			c.localForwardClose(ctx, req)
 		case req := <-c.rfclosec:
 			c.remoteForwardClose(ctx, req)
+		case req := <-c.dfclosec:
+			c.dynamicForwardClose(ctx, req)
 		case <-pingT.C:
 			//TODO ping check
 			//ping fail
@@ -353,6 +370,53 @@ func (c *Client) remoteForwardDone(raddr string, rport int) {
 	c.remoteForwards.delete(rport, raddr)
 }
 
+func (c *Client) DynamicForward(ctx context.Context, req DynamicForwardReq) {
+	select {
+	case c.dfc <- req:
+	case <-ctx.Done():
+	}
+}
+
+func (c *Client) dynamicForward(ctx context.Context, sshc *ssh.Client, req DynamicForwardReq) {
+	if err := c.dynamicForward_(ctx, sshc, req); err != nil {
+		log.Errorf("dynamic forward: %v", err)
+	}
+}
+
+func (c *Client) dynamicForward_(ctx context.Context, sshc *ssh.Client, req DynamicForwardReq) error {
+	// check LocalAddr/LocalPort existence
+	if c.dynamicForwards.contains(req.LocalPort, req.LocalAddr) {
+		return errors.Errorf("local addr occupied: %s:%d", req.LocalAddr, req.LocalPort)
+	}
+
+	addr := net.JoinHostPort(req.LocalAddr, fmt.Sprintf("%d", req.LocalPort))
+	listener, err := net.Listen("tcp", addr)
+	if err != nil {
+		return errors.Wrapf(err, "tcp listen %s", addr)
+	}
+	fwd := &dynamicForwarder{
+		listener: listener,
+
+		dial: sshc.Dial,
+		acl:  req.ACL,
+
+		done:     c.dynamicForwardDone,
+		doneAddr: req.LocalAddr,
+		donePort: req.LocalPort,
+
+		tick:   req.Tick,
+		tickCb: req.TickCb,
+	}
+
+	c.dynamicForwards.set(req.LocalPort, req.LocalAddr, fwd)
+	go fwd.Start(ctx)
+	return nil
+}
+
+func (c *Client) dynamicForwardDone(laddr string, lport int) {
+	c.dynamicForwards.delete(lport, laddr)
+}
+
 func (c *Client) LocalForwardClose(ctx context.Context, req LocalForwardReq) {
 	select {
 	case c.lfclosec <- req:
@@ -382,3 +446,18 @@ func (c *Client) remoteForwardClose(ctx context.Context, req RemoteForwardReq) {
 		fwd.Stop(ctx)
 	}
 }
+
+func (c *Client) DynamicForwardClose(ctx context.Context, req DynamicForwardReq) {
+	select {
+	case c.dfclosec <- req:
+	case <-ctx.Done():
+	}
+}
+
+func (c *Client) dynamicForwardClose(ctx context.Context, req DynamicForwardReq) {
+	v := c.dynamicForwards.get(req.LocalPort, req.LocalAddr)
+	if v != nil {
+		fwd := v.(*dynamicForwarder)
+		fwd.Stop(ctx)
+	}
+}
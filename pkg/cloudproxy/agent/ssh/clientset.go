@@ -107,6 +107,7 @@ func (cs *ClientSet) ForwardKeySet() ForwardKeySet {
 		for _, client := range epcs.clients {
 			fks.addByPortMap(epKey, ForwardKeyTypeL, client.localForwards)
 			fks.addByPortMap(epKey, ForwardKeyTypeR, client.remoteForwards)
+			fks.addByPortMap(epKey, ForwardKeyTypeD, client.dynamicForwards)
 		}
 	}
 	return fks
@@ -128,6 +129,14 @@ func (cs *ClientSet) RemoteForward(ctx context.Context, epKey string, req Remote
 	client.RemoteForward(ctx, req)
 }
 
+func (cs *ClientSet) DynamicForward(ctx context.Context, epKey string, req DynamicForwardReq) {
+	client, created := cs.getOrCreateClient(epKey, ForwardKeyTypeD)
+	if created {
+		go client.Start(ctx)
+	}
+	client.DynamicForward(ctx, req)
+}
+
 func (cs *ClientSet) CloseForward(ctx context.Context, fk ForwardKey) {
 	client := cs.getClient(fk.EpKey, fk.Type)
 	if client == nil {
@@ -144,6 +153,11 @@ func (cs *ClientSet) CloseForward(ctx context.Context, fk ForwardKey) {
 			RemoteAddr: fk.KeyAddr,
 			RemotePort: fk.KeyPort,
 		})
+	case ForwardKeyTypeD:
+		client.DynamicForwardClose(ctx, DynamicForwardReq{
+			LocalAddr: fk.KeyAddr,
+			LocalPort: fk.KeyPort,
+		})
 	}
 }
 
@@ -0,0 +1,448 @@
+// Copyright 2019 Yunion
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ssh
+
+import (
+	"bufio"
+	"context"
+	"encoding/binary"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"net/http/httputil"
+	"strconv"
+	"sync"
+	"time"
+
+	"yunion.io/x/log"
+	"yunion.io/x/pkg/errors"
+)
+
+const (
+	ErrDestDenied = errors.Error("destination denied by acl")
+)
+
+type DynamicForwardReq struct {
+	// LocalAddr is the address to listen on for SOCKS5 and HTTP proxy
+	// requests
+	LocalAddr string
+	// LocalPort is the port to listen on for SOCKS5 and HTTP proxy
+	// requests
+	LocalPort int
+
+	// ACL is consulted on each request.  It can be updated while the
+	// forward is running
+	ACL *DestACL
+
+	Tick   time.Duration
+	TickCb TickFunc
+}
+
+type dialContextFunc func(ctx context.Context, n, addr string) (net.Conn, error)
+
+// dynamicForwarder serves SOCKS5 and HTTP proxy protocol on the same
+// listener.  SOCKS5 connections are told apart by the version byte, the rest
+// are handed over to a http server which handles CONNECT and plain http
+// proxy requests
+type dynamicForwarder struct {
+	listener net.Listener
+
+	dial dialFunc
+	acl  *DestACL
+
+	done     doneFunc
+	doneAddr string
+	donePort int
+
+	tick   time.Duration
+	tickCb TickFunc
+}
+
+func (fwd *dynamicForwarder) Stop(ctx context.Context) {
+	fwd.listener.Close()
+}
+
+func (fwd *dynamicForwarder) allow(host string) bool {
+	return fwd.acl != nil && fwd.acl.Allow(host)
+}
+
+func (fwd *dynamicForwarder) dialContext(ctx context.Context, n, addr string) (net.Conn, error) {
+	host, _, err := net.SplitHostPort(addr)
+	if err != nil {
+		return nil, errors.Wrapf(err, "split host port %s", addr)
+	}
+	if !fwd.allow(host) {
+		return nil, errors.Wrap(ErrDestDenied, addr)
+	}
+	return fwd.dial(n, addr)
+}
+
+func (fwd *dynamicForwarder) Start(ctx context.Context) {
+	ctx, cancelFunc := context.WithCancel(ctx)
+	defer cancelFunc()
+
+	if fwd.done != nil {
+		defer fwd.done(fwd.doneAddr, fwd.donePort)
+	}
+
+	listener := fwd.listener
+	defer listener.Close()
+
+	httpListener := newConnChanListener(listener.Addr())
+	httpHandler := newHttpProxyHandler(ctx, fwd.dialContext, fwd.allow)
+	httpServer := &http.Server{
+		Handler: httpHandler,
+	}
+	go httpServer.Serve(httpListener)
+	defer func() {
+		httpServer.Close()
+		httpHandler.transport.CloseIdleConnections()
+	}()
+
+	go func() {
+		for {
+			conn, err := listener.Accept()
+			if err != nil {
+				log.Warningf("dynamic forward: accept: %v", err)
+				cancelFunc()
+				break
+			}
+			go fwd.serveConn(ctx, conn, httpListener)
+		}
+	}()
+
+	if fwd.tick > 0 && fwd.tickCb != nil {
+		go func() {
+			ticker := time.NewTicker(fwd.tick)
+			defer ticker.Stop()
+			for {
+				select {
+				case <-ticker.C:
+					fwd.tickCb(ctx)
+				case <-ctx.Done():
+					return
+				}
+			}
+		}()
+	}
+	<-ctx.Done()
+}
+
+func (fwd *dynamicForwarder) serveConn(ctx context.Context, conn net.Conn, httpListener *connChanListener) {
+	br := bufio.NewReader(conn)
+	conn.SetReadDeadline(time.Now().Add(31 * time.Second))
+	b, err := br.Peek(1)
+	conn.SetReadDeadline(time.Time{})
+	if err != nil {
+		conn.Close()
+		return
+	}
+	bconn := &bufferedConn{
+		Conn: conn,
+		r:    br,
+	}
+	if b[0] == socks5Version {
+		defer bconn.Close()
+		remote, err := socks5Handshake(ctx, bconn, fwd.dialContext)
+		if err != nil {
+			log.Warningf("dynamic forward: socks5 from %s: %v", conn.RemoteAddr(), err)
+			return
+		}
+		pipe(ctx, bconn, remote)
+		return
+	}
+	httpListener.put(bconn)
+}
+
+// pipe copies data between a and b until either side is done, then closes
+// both
+func pipe(ctx context.Context, a, b net.Conn) {
+	donec := make(chan struct{}, 2)
+	go func() {
+		io.Copy(a, b)
+		donec <- struct{}{}
+	}()
+	go func() {
+		io.Copy(b, a)
+		donec <- struct{}{}
+	}()
+	select {
+	case <-donec:
+	case <-ctx.Done():
+	}
+	a.Close()
+	b.Close()
+}
+
+type bufferedConn struct {
+	net.Conn
+	r *bufio.Reader
+}
+
+func (c *bufferedConn) Read(p []byte) (int, error) {
+	return c.r.Read(p)
+}
+
+const (
+	socks5Version = 0x05
+
+	socks5AuthNone         = 0x00
+	socks5AuthNoAcceptable = 0xff
+
+	socks5CmdConnect = 0x01
+
+	socks5AddrIPv4   = 0x01
+	socks5AddrDomain = 0x03
+	socks5AddrIPv6   = 0x04
+
+	socks5RepSucceeded           = 0x00
+	socks5RepGeneralFailure      = 0x01
+	socks5RepNotAllowed          = 0x02
+	socks5RepHostUnreachable     = 0x04
+	socks5RepCmdNotSupported     = 0x07
+	socks5RepAddrTypeUnsupported = 0x08
+)
+
+func socks5Reply(w io.Writer, rep byte) error {
+	// bound address is not of interest to clients connecting through us
+	_, err := w.Write([]byte{socks5Version, rep, 0x00, socks5AddrIPv4, 0, 0, 0, 0, 0, 0})
+	return err
+}
+
+// socks5Handshake negotiates a CONNECT request without authentication as
+// described in RFC 1928, then dials the requested destination
+func socks5Handshake(ctx context.Context, conn io.ReadWriter, dial dialContextFunc) (net.Conn, error) {
+	buf := make([]byte, 256)
+	if _, err := io.ReadFull(conn, buf[:2]); err != nil {
+		return nil, errors.Wrap(err, "read greeting")
+	}
+	if buf[0] != socks5Version {
+		return nil, errors.Errorf("unexpected version %d", buf[0])
+	}
+	nmethods := int(buf[1])
+	if _, err := io.ReadFull(conn, buf[:nmethods]); err != nil {
+		return nil, errors.Wrap(err, "read auth methods")
+	}
+	method := byte(socks5AuthNoAcceptable)
+	for _, m := range buf[:nmethods] {
+		if m == socks5AuthNone {
+			method = socks5AuthNone
+			break
+		}
+	}
+	if _, err := conn.Write([]byte{socks5Version, method}); err != nil {
+		return nil, errors.Wrap(err, "write auth method")
+	}
+	if method == socks5AuthNoAcceptable {
+		return nil, errors.Error("no acceptable auth method")
+	}
+
+	if _, err := io.ReadFull(conn, buf[:4]); err != nil {
+		return nil, errors.Wrap(err, "read request")
+	}
+	if buf[0] != socks5Version {
+		return nil, errors.Errorf("unexpected request version %d", buf[0])
+	}
+	cmd, atyp := buf[1], buf[3]
+	var host string
+	switch atyp {
+	case socks5AddrIPv4, socks5AddrIPv6:
+		n := net.IPv4len
+		if atyp == socks5AddrIPv6 {
+			n = net.IPv6len
+		}
+		if _, err := io.ReadFull(conn, buf[:n]); err != nil {
+			return nil, errors.Wrap(err, "read address")
+		}
+		host = net.IP(buf[:n]).String()
+	case socks5AddrDomain:
+		if _, err := io.ReadFull(conn, buf[:1]); err != nil {
+			return nil, errors.Wrap(err, "read domain length")
+		}
+		n := int(buf[0])
+		if _, err := io.ReadFull(conn, buf[:n]); err != nil {
+			return nil, errors.Wrap(err, "read domain")
+		}
+		host = string(buf[:n])
+	default:
+		socks5Reply(conn, socks5RepAddrTypeUnsupported)
+		return nil, errors.Errorf("unsupported address type %d", atyp)
+	}
+	if _, err := io.ReadFull(conn, buf[:2]); err != nil {
+		return nil, errors.Wrap(err, "read port")
+	}
+	port := binary.BigEndian.Uint16(buf[:2])
+	if cmd != socks5CmdConnect {
+		socks5Reply(conn, socks5RepCmdNotSupported)
+		return nil, errors.Errorf("unsupported command %d", cmd)
+	}
+
+	addr := net.JoinHostPort(host, strconv.Itoa(int(port)))
+	remote, err := dial(ctx, "tcp", addr)
+	if err != nil {
+		rep := byte(socks5RepHostUnreachable)
+		if errors.Cause(err) == ErrDestDenied {
+			rep = socks5RepNotAllowed
+		}
+		socks5Reply(conn, rep)
+		return nil, err
+	}
+	if err := socks5Reply(conn, socks5RepSucceeded); err != nil {
+		remote.Close()
+		return nil, errors.Wrap(err, "write reply")
+	}
+	return remote, nil
+}
+
+type httpProxyHandler struct {
+	ctx       context.Context
+	dial      dialContextFunc
+	allow     func(host string) bool
+	transport *http.Transport
+	proxy     *httputil.ReverseProxy
+}
+
+func newHttpProxyHandler(ctx context.Context, dial dialContextFunc, allow func(host string) bool) *httpProxyHandler {
+	h := &httpProxyHandler{
+		ctx:   ctx,
+		dial:  dial,
+		allow: allow,
+		transport: &http.Transport{
+			DialContext:     dial,
+			MaxIdleConns:    16,
+			IdleConnTimeout: 90 * time.Second,
+		},
+	}
+	h.proxy = &httputil.ReverseProxy{
+		// request url is already absolute for proxy requests
+		Director:  func(req *http.Request) {},
+		Transport: h.transport,
+		ErrorHandler: func(w http.ResponseWriter, req *http.Request, err error) {
+			log.Warningf("dynamic forward: http proxy %s: %v", req.URL, err)
+			w.WriteHeader(httpProxyErrorStatus(err))
+		},
+	}
+	return h
+}
+
+func httpProxyErrorStatus(err error) int {
+	if errors.Cause(err) == ErrDestDenied {
+		return http.StatusForbidden
+	}
+	return http.StatusBadGateway
+}
+
+func (h *httpProxyHandler) ServeHTTP(w http.ResponseWriter, req *http.Request) {
+	if req.Method == http.MethodConnect {
+		h.serveConnect(w, req)
+		return
+	}
+	if req.URL.Scheme != "http" || req.URL.Host == "" {
+		http.Error(w, "not a proxy request", http.StatusBadRequest)
+		return
+	}
+	// pooled connections to destination skip dialing, check acl here
+	// as it may have changed
+	if !h.allow(req.URL.Hostname()) {
+		http.Error(w, ErrDestDenied.Error(), http.StatusForbidden)
+		return
+	}
+	h.proxy.ServeHTTP(w, req)
+}
+
+func (h *httpProxyHandler) serveConnect(w http.ResponseWriter, req *http.Request) {
+	if _, _, err := net.SplitHostPort(req.Host); err != nil {
+		http.Error(w, fmt.Sprintf("bad connect host %q", req.Host), http.StatusBadRequest)
+		return
+	}
+	hj, ok := w.(http.Hijacker)
+	if !ok {
+		http.Error(w, "hijack not supported", http.StatusInternalServerError)
+		return
+	}
+	remote, err := h.dial(req.Context(), "tcp", req.Host)
+	if err != nil {
+		log.Warningf("dynamic forward: connect %s: %v", req.Host, err)
+		http.Error(w, err.Error(), httpProxyErrorStatus(err))
+		return
+	}
+	conn, brw, err := hj.Hijack()
+	if err != nil {
+		remote.Close()
+		log.Warningf("dynamic forward: hijack: %v", err)
+		return
+	}
+	if _, err := io.WriteString(conn, "HTTP/1.1 200 Connection established\r\n\r\n"); err != nil {
+		conn.Close()
+		remote.Close()
+		return
+	}
+	if n := brw.Reader.Buffered(); n > 0 {
+		// client may send data before seeing our response
+		data, _ := brw.Reader.Peek(n)
+		if _, err := remote.Write(data); err != nil {
+			conn.Close()
+			remote.Close()
+			return
+		}
+	}
+	pipe(h.ctx, conn, remote)
+}
+
+// connChanListener is a net.Listener with connections fed from elsewhere
+type connChanListener struct {
+	addr   net.Addr
+	connc  chan net.Conn
+	closec chan struct{}
+	once   *sync.Once
+}
+
+func newConnChanListener(addr net.Addr) *connChanListener {
+	return &connChanListener{
+		addr:   addr,
+		connc:  make(chan net.Conn),
+		closec: make(chan struct{}),
+		once:   &sync.Once{},
+	}
+}
+
+func (l *connChanListener) put(conn net.Conn) {
+	select {
+	case l.connc <- conn:
+	case <-l.closec:
+		conn.Close()
+	}
+}
+
+func (l *connChanListener) Accept() (net.Conn, error) {
+	select {
+	case conn := <-l.connc:
+		return conn, nil
+	case <-l.closec:
+		return nil, errors.Error("listener closed")
+	}
+}
+
+func (l *connChanListener) Close() error {
+	l.once.Do(func() {
+		close(l.closec)
+	})
+	return nil
+}
+
+func (l *connChanListener) Addr() net.Addr {
+	return l.addr
+}
@@ -0,0 +1,140 @@
+// Copyright 2019 Yunion
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ssh
+
+import (
+	"context"
+	"fmt"
+	"io/ioutil"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+	"time"
+)
+
+func TestDestACL(t *testing.T) {
+	acl := NewDestACL()
+	if acl.Allow("10.0.0.1") {
+		t.Errorf("empty acl should deny all")
+	}
+	acl.Set([]string{"10.0.0.0/8", "bad"}, []string{"corp.example.com", ".lab.local"})
+	cases := []struct {
+		host string
+		want bool
+	}{
+		{"10.1.2.3", true},
+		{"192.168.0.1", false},
+		{"corp.example.com", true},
+		{"API.corp.example.com", true},
+		{"keystone.lab.local.", true},
+		{"lab.local", true},
+		{"evilcorp.example.com", false},
+		{"example.com", false},
+	}
+	for _, c := range cases {
+		if got := acl.Allow(c.host); got != c.want {
+			t.Errorf("allow %q: want %v, got %v", c.host, c.want, got)
+		}
+	}
+}
+
+func startTestDynamicForwarder(t *testing.T, acl *DestACL) (string, func()) {
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatalf("listen: %v", err)
+	}
+	fwd := &dynamicForwarder{
+		listener: listener,
+		dial:     net.Dial,
+		acl:      acl,
+	}
+	ctx, cancelFunc := context.WithCancel(context.Background())
+	go fwd.Start(ctx)
+	return listener.Addr().String(), cancelFunc
+}
+
+func TestDynamicForwarder(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprintf(w, "hello %s", r.URL.Path)
+	}))
+	defer srv.Close()
+
+	acl := NewDestACL()
+	acl.Set([]string{"127.0.0.0/8"}, nil)
+	proxyAddr, stop := startTestDynamicForwarder(t, acl)
+	defer stop()
+
+	get := func(t *testing.T, proxyUrl, target string) (*http.Response, error) {
+		u, _ := url.Parse(proxyUrl)
+		client := &http.Client{
+			Transport: &http.Transport{
+				Proxy: http.ProxyURL(u),
+			},
+			Timeout: 5 * time.Second,
+		}
+		return client.Get(target)
+	}
+
+	for _, scheme := range []string{"socks5", "http"} {
+		proxyUrl := scheme + "://" + proxyAddr
+		t.Run(scheme, func(t *testing.T) {
+			resp, err := get(t, proxyUrl, srv.URL+"/world")
+			if err != nil {
+				t.Fatalf("get: %v", err)
+			}
+			defer resp.Body.Close()
+			body, _ := ioutil.ReadAll(resp.Body)
+			if string(body) != "hello /world" {
+				t.Errorf("unexpected body %q", body)
+			}
+		})
+	}
+
+	t.Run("connect", func(t *testing.T) {
+		conn, err := net.Dial("tcp", proxyAddr)
+		if err != nil {
+			t.Fatalf("dial proxy: %v", err)
+		}
+		defer conn.Close()
+		host := srv.Listener.Addr().String()
+		fmt.Fprintf(conn, "CONNECT %s HTTP/1.1\r\nHost: %s\r\n\r\n", host, host)
+		fmt.Fprintf(conn, "GET /tunnel HTTP/1.1\r\nHost: %s\r\nConnection: close\r\n\r\n", host)
+		conn.SetReadDeadline(time.Now().Add(5 * time.Second))
+		data, _ := ioutil.ReadAll(conn)
+		want := "HTTP/1.1 200 Connection established\r\n\r\nHTTP/1.1 200 OK"
+		if len(data) < len(want) || string(data[:len(want)]) != want {
+			t.Errorf("unexpected response %q", data)
+		}
+	})
+
+	t.Run("denied", func(t *testing.T) {
+		acl.Set(nil, []string{"example.com"})
+		defer acl.Set([]string{"127.0.0.0/8"}, nil)
+
+		if _, err := get(t, "socks5://"+proxyAddr, srv.URL); err == nil {
+			t.Errorf("socks5: expect error for denied destination")
+		}
+		resp, err := get(t, "http://"+proxyAddr, srv.URL)
+		if err != nil {
+			t.Fatalf("http: %v", err)
+		}
+		resp.Body.Close()
+		if resp.StatusCode != http.StatusForbidden {
+			t.Errorf("http: want status %d, got %d", http.StatusForbidden, resp.StatusCode)
+		}
+	})
+}
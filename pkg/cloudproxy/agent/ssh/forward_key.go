@@ -21,6 +21,7 @@ import (
 const (
 	ForwardKeyTypeL = "L"
 	ForwardKeyTypeR = "R"
+	ForwardKeyTypeD = "D"
 )
 
 type ForwardKey struct {
@@ -49,6 +49,9 @@ type Worker struct {
 	apih         *apihelper.APIHelper
 	clientSet    *agentssh.ClientSet
 	sessionCache *auth.SessionCache
+
+	// destination acls of dynamic forwards, keyed by proxy endpoint id
+	acls map[string]*agentssh.DestACL
 }
 
 func NewWorker(commonOpts *common_options.CommonOptions, opts *agentoptions.Options) *Worker {
@@ -69,6 +72,7 @@ func NewWorker(commonOpts *common_options.CommonOptions, opts *agentoptions.Opti
 
 		apih:      apih,
 		clientSet: agentssh.NewClientSet(),
+		acls:      map[string]*agentssh.DestACL{},
 		sessionCache: &auth.SessionCache{
 			Region:        commonOpts.Region,
 			APIVersion:    "v2",
@@ -233,6 +237,7 @@ func (w *Worker) run(ctx context.Context, mss *agentmodels.ModelSets) (err error
 		}
 	}
 	w.clientSet.ResetUnmarked(ctx)
+	w.updateACLs(mss)
 
 	removes := w.clientSet.ForwardKeySet()
 	adds := agentssh.ForwardKeySet{}
@@ -258,6 +263,10 @@ func (w *Worker) run(ctx context.Context, mss *agentmodels.ModelSets) (err error
 				addr = forward.ProxyEndpoint.IntranetIpAddr
 				port = forward.BindPort
 				typ = agentssh.ForwardKeyTypeR
+			case api.FORWARD_TYPE_DYNAMIC:
+				addr = w.bindAddr
+				port = forward.BindPort
+				typ = agentssh.ForwardKeyTypeD
 			default:
 				log.Warningf("unknown forward type %s", forward.Type)
 				continue
@@ -305,7 +314,42 @@ func (w *Worker) run(ctx context.Context, mss *agentmodels.ModelSets) (err error
 				Tick:       tick,
 				TickCb:     tickCb,
 			})
+		case agentssh.ForwardKeyTypeD:
+			w.clientSet.DynamicForward(ctx, fk.EpKey, agentssh.DynamicForwardReq{
+				LocalAddr: fk.KeyAddr,
+				LocalPort: fk.KeyPort,
+				ACL:       w.acls[fk.EpKey],
+				Tick:      tick,
+				TickCb:    tickCb,
+			})
 		}
 	}
 	return nil
 }
+
+// updateACLs refreshes destination acls from proxy matches in place, so that
+// running dynamic forwards pick up changes without being restarted
+func (w *Worker) updateACLs(mss *agentmodels.ModelSets) {
+	for epId := range w.acls {
+		if _, ok := mss.ProxyEndpoints[epId]; !ok {
+			delete(w.acls, epId)
+		}
+	}
+	for epId, pep := range mss.ProxyEndpoints {
+		var cidrs, domains []string
+		for _, pm := range pep.ProxyMatches {
+			switch pm.MatchScope {
+			case api.PM_SCOPE_CIDR:
+				cidrs = append(cidrs, pm.MatchValue)
+			case api.PM_SCOPE_DOMAIN:
+				domains = append(domains, pm.MatchValue)
+			}
+		}
+		acl, ok := w.acls[epId]
+		if !ok {
+			acl = agentssh.NewDestACL()
+			w.acls[epId] = acl
+		}
+		acl.Set(cidrs, domains)
+	}
+}
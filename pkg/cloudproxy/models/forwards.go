@@ -18,6 +18,7 @@ import (
 	"context"
 	"fmt"
 	"math/rand"
+	"net"
 	"time"
 
 	"yunion.io/x/jsonutils"
@@ -40,8 +41,8 @@ type SForward struct {
 	ProxyAgentId    string `width:"36" charset:"ascii" nullable:"true" list:"user" update:"user" create:"optional"`
 
 	Type        string `width:"16" charset:"ascii" nullable:"false" list:"user" create:"required"`
-	RemoteAddr  string `width:"16" charset:"ascii" nullable:"false" list:"user" create:"required"`
-	RemotePort  int    `width:"16" charset:"ascii" nullable:"false" list:"user" create:"required"`
+	RemoteAddr  string `width:"16" charset:"ascii" nullable:"false" list:"user" create:"optional"`
+	RemotePort  int    `width:"16" charset:"ascii" nullable:"false" list:"user" create:"optional"`
 	BindPortReq int    `width:"16" charset:"ascii" nullable:"false" list:"user" update:"user" create:"optional"`
 
 	Opaque string `width:"36" charset:"ascii" nullable:"true" list:"user" update:"user" create:"optional"`
@@ -159,7 +160,7 @@ func (man *SForwardManager) validatePortReq(
 	validateOne := func(portReq int) (*jsonutils.JSONDict, error) {
 		var err error
 		switch typ {
-		case cloudproxy_api.FORWARD_TYPE_LOCAL:
+		case cloudproxy_api.FORWARD_TYPE_LOCAL, cloudproxy_api.FORWARD_TYPE_DYNAMIC:
 			if agentId == "" {
 				data, err = man.validateLocalSelectAgent(ctx, data, portReq)
 			} else {
@@ -226,6 +227,9 @@ func (man *SForwardManager) PerformCreateFromServer(ctx context.Context, userCre
 		}
 	}
 
+	if typeV.Value == cloudproxy_api.FORWARD_TYPE_DYNAMIC {
+		return nil, httperrors.NewInputParameterError("dynamic forward is not bound to a server")
+	}
 	serverId := input.ServerId
 	if serverId == "" {
 		return nil, httperrors.NewBadRequestError("server_id is required")
@@ -284,8 +288,6 @@ func (man *SForwardManager) ValidateCreateData(ctx context.Context, userCred mcc
 		agentV.Optional(true),
 
 		typeV,
-		validators.NewIPv4AddrValidator("remote_addr"),
-		validators.NewPortValidator("remote_port"),
 		portReqV.Optional(true),
 
 		validators.NewNonNegativeValidator("last_seen_timeout").Optional(true),
@@ -294,6 +296,17 @@ func (man *SForwardManager) ValidateCreateData(ctx context.Context, userCred mcc
 			return nil, err
 		}
 	}
+	if typeV.Value != cloudproxy_api.FORWARD_TYPE_DYNAMIC {
+		// dynamic forward gets destination from each proxy request
+		for _, v := range []validators.IValidator{
+			validators.NewIPv4AddrValidator("remote_addr"),
+			validators.NewPortValidator("remote_port"),
+		} {
+			if err := v.Validate(data); err != nil {
+				return nil, err
+			}
+		}
+	}
 
 	typ := typeV.Value
 	epId := endpointV.Model.GetId()
@@ -342,7 +355,7 @@ func (fwd *SForward) ValidateUpdateData(ctx context.Context, userCred mcclient.T
 			agentId = agentV.Model.GetId()
 		}
 		switch typ := fwd.Type; typ {
-		case cloudproxy_api.FORWARD_TYPE_LOCAL:
+		case cloudproxy_api.FORWARD_TYPE_LOCAL, cloudproxy_api.FORWARD_TYPE_DYNAMIC:
 			data, err = ForwardManager.validateLocalSetPort(ctx, data, agentId, portReq)
 		case cloudproxy_api.FORWARD_TYPE_REMOTE:
 			data, err = ForwardManager.validateRemoteSetPort(ctx, data, agentId, portReq)
@@ -461,6 +474,12 @@ func (man *SForwardManager) FetchCustomizeColumns(
 				if paOK {
 					d.Set("bind_addr", jsonutils.NewString(pa.AdvertiseAddr))
 				}
+			case cloudproxy_api.FORWARD_TYPE_DYNAMIC:
+				if paOK {
+					d.Set("bind_addr", jsonutils.NewString(pa.AdvertiseAddr))
+					hostPort := net.JoinHostPort(pa.AdvertiseAddr, fmt.Sprintf("%d", fwd.BindPort))
+					d.Set("proxy_url", jsonutils.NewString("socks5://"+hostPort))
+				}
 			case cloudproxy_api.FORWARD_TYPE_REMOTE:
 				if peOK {
 					d.Set("bind_addr", jsonutils.NewString(pe.IntranetIpAddr))
@@ -16,6 +16,9 @@ package models
 
 import (
 	"context"
+	"net"
+	"regexp"
+	"strings"
 
 	"yunion.io/x/jsonutils"
 	"yunion.io/x/pkg/errors"
@@ -25,6 +28,7 @@ import (
 	cloudproxy_api "yunion.io/x/onecloud/pkg/apis/cloudproxy"
 	"yunion.io/x/onecloud/pkg/cloudcommon/db"
 	"yunion.io/x/onecloud/pkg/cloudcommon/validators"
+	"yunion.io/x/onecloud/pkg/httperrors"
 	"yunion.io/x/onecloud/pkg/mcclient"
 )
 
@@ -33,7 +37,7 @@ type SProxyMatch struct {
 
 	ProxyEndpointId string `width:"36" charset:"ascii" nullable:"false" list:"user" create:"required" update:"user"`
 	MatchScope      string `width:"16" charset:"ascii" nullable:"false" list:"user" create:"required" update:"user"`
-	MatchValue      string `width:"36" charset:"ascii" nullable:"false" list:"user" create:"required" update:"user"`
+	MatchValue      string `width:"128" charset:"ascii" nullable:"false" list:"user" create:"required" update:"user"`
 }
 
 type SProxyMatchManager struct {
@@ -65,6 +69,12 @@ func (man *SProxyMatchManager) ValidateCreateData(ctx context.Context, userCred
 			return nil, err
 		}
 	}
+	matchValue, _ := data.GetString("match_value")
+	matchValue, err := validateMatchValue(matchScopeV.Value, matchValue)
+	if err != nil {
+		return nil, err
+	}
+	data.Set("match_value", jsonutils.NewString(matchValue))
 	return data, nil
 }
 
@@ -80,9 +90,60 @@ func (pm *SProxyMatch) ValidateUpdateData(ctx context.Context, userCred mcclient
 			return nil, err
 		}
 	}
+	if data.Contains("match_scope") || data.Contains("match_value") {
+		matchScope := pm.MatchScope
+		if data.Contains("match_scope") {
+			matchScope = matchScopeV.Value
+		}
+		matchValue := pm.MatchValue
+		if data.Contains("match_value") {
+			matchValue, _ = data.GetString("match_value")
+		}
+		matchValue, err := validateMatchValue(matchScope, matchValue)
+		if err != nil {
+			return nil, err
+		}
+		data.Set("match_value", jsonutils.NewString(matchValue))
+	}
 	return data, nil
 }
 
+var regexpMatchDomain = regexp.MustCompile(`^\.?([a-z0-9]([a-z0-9-]*[a-z0-9])?\.)*[a-z0-9]([a-z0-9-]*[a-z0-9])?$`)
+
+// validateMatchValue checks and normalizes value of destination acl scopes
+func validateMatchValue(scope, value string) (string, error) {
+	value = strings.TrimSpace(value)
+	if value == "" {
+		return "", httperrors.NewInputParameterError("empty match_value")
+	}
+	switch scope {
+	case api.PM_SCOPE_CIDR:
+		if !strings.Contains(value, "/") {
+			ip := net.ParseIP(value)
+			if ip == nil {
+				return "", httperrors.NewInputParameterError("invalid ip address %q", value)
+			}
+			if ip.To4() != nil {
+				value += "/32"
+			} else {
+				value += "/128"
+			}
+		}
+		_, ipnet, err := net.ParseCIDR(value)
+		if err != nil {
+			return "", httperrors.NewInputParameterError("invalid cidr %q: %v", value, err)
+		}
+		return ipnet.String(), nil
+	case api.PM_SCOPE_DOMAIN:
+		value = strings.ToLower(value)
+		if !regexpMatchDomain.MatchString(value) {
+			return "", httperrors.NewInputParameterError("invalid domain %q", value)
+		}
+		return value, nil
+	}
+	return value, nil
+}
+
 func (man *SProxyMatchManager) findMatch(ctx context.Context, networkId, vpcId string) *SProxyMatch {
 	q := man.Query()
 	qfScope := q.Field("match_scope")
@@ -0,0 +1,180 @@
+// Copyright 2019 Yunion
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package guestdrivers
+
+import (
+	"context"
+	"fmt"
+
+	"yunion.io/x/jsonutils"
+	"yunion.io/x/pkg/utils"
+
+	api "yunion.io/x/onecloud/pkg/apis/compute"
+	"yunion.io/x/onecloud/pkg/cloudcommon/db/quotas"
+	"yunion.io/x/onecloud/pkg/cloudprovider"
+	"yunion.io/x/onecloud/pkg/compute/models"
+	"yunion.io/x/onecloud/pkg/httperrors"
+	"yunion.io/x/onecloud/pkg/mcclient"
+	"yunion.io/x/onecloud/pkg/util/billing"
+	"yunion.io/x/onecloud/pkg/util/rbacutils"
+)
+
+type SOvirtGuestDriver struct {
+	SManagedVirtualizedGuestDriver
+}
+
+func init() {
+	driver := SOvirtGuestDriver{}
+	models.RegisterGuestDriver(&driver)
+}
+
+func (self *SOvirtGuestDriver) DoScheduleCPUFilter() bool { return true }
+
+func (self *SOvirtGuestDriver) DoScheduleMemoryFilter() bool { return true }
+
+func (self *SOvirtGuestDriver) DoScheduleSKUFilter() bool { return false }
+
+func (self *SOvirtGuestDriver) DoScheduleStorageFilter() bool { return true }
+
+func (self *SOvirtGuestDriver) GetHypervisor() string {
+	return api.HYPERVISOR_OVIRT
+}
+
+func (self *SOvirtGuestDriver) GetProvider() string {
+	return api.CLOUD_PROVIDER_OVIRT
+}
+
+func (self *SOvirtGuestDriver) GetComputeQuotaKeys(scope rbacutils.TRbacScope, ownerId mcclient.IIdentityProvider, brand string) models.SComputeResourceKeys {
+	keys := models.SComputeResourceKeys{}
+	keys.SBaseProjectQuotaKeys = quotas.OwnerIdProjectQuotaKeys(scope, ownerId)
+	keys.CloudEnv = api.CLOUD_ENV_PRIVATE_CLOUD
+	keys.Provider = api.CLOUD_PROVIDER_OVIRT
+	keys.Brand = brand
+	keys.Hypervisor = api.HYPERVISOR_OVIRT
+	return keys
+}
+
+func (self *SOvirtGuestDriver) GetDefaultSysDiskBackend() string {
+	return api.STORAGE_NFS
+}
+
+func (self *SOvirtGuestDriver) GetMinimalSysDiskSizeGb() int {
+	return 10
+}
+
+func (self *SOvirtGuestDriver) GetStorageTypes() []string {
+	return []string{
+		api.STORAGE_NFS,
+		api.STORAGE_OPENSTACK_ISCSI,
+		api.STORAGE_OVIRT_FCP,
+		api.STORAGE_OVIRT_GLUSTERFS,
+		api.STORAGE_OVIRT_LOCALFS,
+		api.STORAGE_OVIRT_POSIXFS,
+	}
+}
+
+func (self *SOvirtGuestDriver) GetMaxSecurityGroupCount() int {
+	//暂不支持绑定安全组
+	return 0
+}
+
+func (self *SOvirtGuestDriver) ChooseHostStorage(host *models.SHost, guest *models.SGuest, diskConfig *api.DiskConfig, storageIds []string) (*models.SStorage, error) {
+	return self.chooseHostStorage(self, host, diskConfig.Backend, storageIds), nil
+}
+
+func (self *SOvirtGuestDriver) GetDetachDiskStatus() ([]string, error) {
+	return []string{api.VM_READY, api.VM_RUNNING}, nil
+}
+
+func (self *SOvirtGuestDriver) GetAttachDiskStatus() ([]string, error) {
+	return []string{api.VM_READY, api.VM_RUNNING}, nil
+}
+
+func (self *SOvirtGuestDriver) GetRebuildRootStatus() ([]string, error) {
+	return []string{}, httperrors.NewUnsupportOperationError("%s not support rebuild root", self.GetHypervisor())
+}
+
+func (self *SOvirtGuestDriver) GetChangeConfigStatus(guest *models.SGuest) ([]string, error) {
+	return []string{api.VM_READY}, nil
+}
+
+// 登录信息写入虚拟机初始化配置, 在下次开机时由 cloud-init 或 sysprep 生效
+func (self *SOvirtGuestDriver) GetDeployStatus() ([]string, error) {
+	return []string{api.VM_READY}, nil
+}
+
+func (self *SOvirtGuestDriver) IsNeedRestartForResetLoginInfo() bool {
+	return false
+}
+
+func (self *SOvirtGuestDriver) ValidateResizeDisk(guest *models.SGuest, disk *models.SDisk, storage *models.SStorage) error {
+	if !utils.IsInStringArray(guest.Status, []string{api.VM_READY, api.VM_RUNNING}) {
+		return fmt.Errorf("Cannot resize disk when guest in status %s", guest.Status)
+	}
+	return nil
+}
+
+func (self *SOvirtGuestDriver) ValidateCreateEip(ctx context.Context, userCred mcclient.TokenCredential, data jsonutils.JSONObject) error {
+	return httperrors.NewInputParameterError("%s not support create eip", self.GetHypervisor())
+}
+
+func (self *SOvirtGuestDriver) ValidateCreateData(ctx context.Context, userCred mcclient.TokenCredential, input *api.ServerCreateInput) (*api.ServerCreateInput, error) {
+	input, err := self.SManagedVirtualizedGuestDriver.ValidateCreateData(ctx, userCred, input)
+	if err != nil {
+		return nil, err
+	}
+	if len(input.Networks) > 1 {
+		return nil, httperrors.NewInputParameterError("cannot support more than 1 nic")
+	}
+	if len(input.Eip) > 0 || input.EipBw > 0 {
+		return nil, httperrors.NewUnsupportOperationError("%s not support create virtual machine with eip", self.GetHypervisor())
+	}
+	return input, nil
+}
+
+func (self *SOvirtGuestDriver) GetGuestInitialStateAfterCreate() string {
+	return api.VM_RUNNING
+}
+
+func (self *SOvirtGuestDriver) GetGuestInitialStateAfterRebuild() string {
+	return api.VM_READY
+}
+
+func (self *SOvirtGuestDriver) IsNeedInjectPasswordByCloudInit(desc *cloudprovider.SManagedVMCreateConfig) bool {
+	return true
+}
+
+func (self *SOvirtGuestDriver) GetInstanceCapability() cloudprovider.SInstanceCapability {
+	return cloudprovider.SInstanceCapability{
+		Hypervisor: self.GetHypervisor(),
+		Provider:   self.GetProvider(),
+		DefaultAccount: cloudprovider.SDefaultAccount{
+			Linux: cloudprovider.SOsDefaultAccount{
+				DefaultAccount: api.VM_DEFAULT_LINUX_LOGIN_USER,
+			},
+			Windows: cloudprovider.SOsDefaultAccount{
+				DefaultAccount: api.VM_DEFAULT_WINDOWS_LOGIN_USER,
+			},
+		},
+	}
+}
+
+func (self *SOvirtGuestDriver) AllowReconfigGuest() bool {
+	return true
+}
+
+func (self *SOvirtGuestDriver) IsSupportedBillingCycle(bc billing.SBillingCycle) bool {
+	return false
+}
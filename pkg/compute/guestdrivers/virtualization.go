@@ -176,6 +176,7 @@ func (self *SVirtualizedGuestDriver) Attach2RandomNetwork(guest *models.SGuest,
 		Network:             selNet,
 		PendingUsage:        pendingUsage,
 		IpAddr:              netConfig.Address,
+		Ip6Addr:             netConfig.Address6,
 		NicDriver:           netConfig.Driver,
 		BwLimit:             netConfig.BwLimit,
 		Virtual:             netConfig.Vip,
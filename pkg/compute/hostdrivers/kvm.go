@@ -286,6 +286,14 @@ func (self *SKVMHostDriver) RequestAllocateDiskOnStorage(ctx context.Context, us
 		}
 	}
 
+	if disk.IsEncrypted() {
+		if !utils.IsInStringArray(storage.StorageType, api.FIEL_STORAGE) && storage.StorageType != api.STORAGE_RBD {
+			return httperrors.NewNotSupportedError("storage %s does not support encryption", storage.StorageType)
+		}
+		// host fetches the data key by encrypt-info of the disk
+		content.Set("encrypt_key_id", jsonutils.NewString(disk.EncryptKeyId))
+	}
+
 	url := fmt.Sprintf("/disks/%s/create/%s", storage.Id, disk.Id)
 	body := jsonutils.NewDict()
 	body.Add(content, "disk")
@@ -0,0 +1,59 @@
+// Copyright 2019 Yunion
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package hostdrivers
+
+import (
+	"context"
+
+	"yunion.io/x/jsonutils"
+
+	api "yunion.io/x/onecloud/pkg/apis/compute"
+	"yunion.io/x/onecloud/pkg/compute/models"
+	"yunion.io/x/onecloud/pkg/httperrors"
+	"yunion.io/x/onecloud/pkg/mcclient"
+)
+
+type SOvirtHostDriver struct {
+	SManagedVirtualizationHostDriver
+}
+
+func init() {
+	driver := SOvirtHostDriver{}
+	models.RegisterHostDriver(&driver)
+}
+
+func (self *SOvirtHostDriver) GetHostType() string {
+	return api.HOST_TYPE_OVIRT
+}
+
+func (self *SOvirtHostDriver) GetHypervisor() string {
+	return api.HYPERVISOR_OVIRT
+}
+
+func (self *SOvirtHostDriver) ValidateDiskSize(storage *models.SStorage, sizeGb int) error {
+	return nil
+}
+
+func (self *SOvirtHostDriver) ValidateResetDisk(ctx context.Context, userCred mcclient.TokenCredential, disk *models.SDisk, snapshot *models.SSnapshot, guests []models.SGuest, data *jsonutils.JSONDict) (*jsonutils.JSONDict, error) {
+	if len(guests) == 0 {
+		return nil, httperrors.NewBadRequestError("oVirt reset disk operation requried disk attached to guest")
+	}
+	for _, guest := range guests {
+		if guest.Status != api.VM_READY {
+			return nil, httperrors.NewBadRequestError("oVirt reset disk operation requried guest status is ready")
+		}
+	}
+	return data, nil
+}
@@ -26,6 +26,7 @@ import (
 	"yunion.io/x/pkg/errors"
 	"yunion.io/x/pkg/tristate"
 	"yunion.io/x/pkg/util/timeutils"
+	"yunion.io/x/pkg/utils"
 	"yunion.io/x/sqlchemy"
 
 	api "yunion.io/x/onecloud/pkg/apis/compute"
@@ -416,6 +417,108 @@ func (self *SCachedimage) PerformUncacheImage(ctx context.Context, userCred mccl
 	return storagecache.PerformUncacheImage(ctx, userCred, query, jsonutils.Marshal(map[string]interface{}{"image": self.Id, "is_force": input.IsForce}))
 }
 
+func (manager *SCachedimageManager) AllowPerformPrewarm(ctx context.Context, userCred mcclient.TokenCredential, query jsonutils.JSONObject, data jsonutils.JSONObject) bool {
+	return db.IsAdminAllowClassPerform(userCred, manager, "prewarm")
+}
+
+// 预热镜像到宿主机的本地镜像缓存
+func (manager *SCachedimageManager) PerformPrewarm(ctx context.Context, userCred mcclient.TokenCredential, query jsonutils.JSONObject, input api.CachedimagePrewarmInput) (jsonutils.JSONObject, error) {
+	if len(input.Image) == 0 && len(input.ImageTags) == 0 {
+		return nil, httperrors.NewMissingParameterError("image")
+	}
+	imageIds, err := manager.getPrewarmImageIds(ctx, userCred, input)
+	if err != nil {
+		return nil, err
+	}
+	hosts, err := manager.getPrewarmHosts(ctx, userCred, input)
+	if err != nil {
+		return nil, err
+	}
+
+	output := api.CachedimagePrewarmOutput{
+		ImageCount: len(imageIds),
+		HostCount:  len(hosts),
+	}
+	for i := range hosts {
+		sc := hosts[i].GetLocalStoragecache()
+		if sc == nil {
+			continue
+		}
+		for _, imageId := range imageIds {
+			scimg := StoragecachedimageManager.GetStoragecachedimage(sc.Id, imageId)
+			if scimg != nil && utils.IsInStringArray(scimg.Status, []string{api.CACHED_IMAGE_STATUS_ACTIVE, api.CACHED_IMAGE_STATUS_CACHING}) {
+				continue
+			}
+			err := sc.StartImageCacheTask(ctx, userCred, imageId, input.Format, false, "")
+			if err != nil {
+				return nil, errors.Wrapf(err, "cache image %s to host %s", imageId, hosts[i].Name)
+			}
+			output.TaskCount += 1
+		}
+	}
+	return jsonutils.Marshal(output), nil
+}
+
+func (manager *SCachedimageManager) getPrewarmImageIds(ctx context.Context, userCred mcclient.TokenCredential, input api.CachedimagePrewarmInput) ([]string, error) {
+	imageIds := make([]string, 0)
+	for _, imageStr := range input.Image {
+		image, err := manager.getImageInfo(ctx, userCred, imageStr, false)
+		if err != nil {
+			return nil, httperrors.NewImageNotFoundError(imageStr)
+		}
+		if !utils.IsInStringArray(image.Id, imageIds) {
+			imageIds = append(imageIds, image.Id)
+		}
+	}
+	if len(input.ImageTags) > 0 {
+		params := jsonutils.NewDict()
+		for i, tag := range input.ImageTags {
+			params.Set(fmt.Sprintf("tags.%d.key", i), jsonutils.NewString(tag.Key))
+			if len(tag.Value) > 0 {
+				params.Set(fmt.Sprintf("tags.%d.value", i), jsonutils.NewString(tag.Value))
+			}
+		}
+		params.Set("scope", jsonutils.NewString("system"))
+		params.Set("limit", jsonutils.NewInt(0))
+		s := auth.GetAdminSession(ctx, options.Options.Region, "")
+		result, err := modules.Images.List(s, params)
+		if err != nil {
+			return nil, errors.Wrap(err, "modules.Images.List")
+		}
+		for _, image := range result.Data {
+			imageId, _ := image.GetString("id")
+			status, _ := image.GetString("status")
+			if status != cloudprovider.IMAGE_STATUS_ACTIVE || utils.IsInStringArray(imageId, imageIds) {
+				continue
+			}
+			imageIds = append(imageIds, imageId)
+		}
+	}
+	return imageIds, nil
+}
+
+func (manager *SCachedimageManager) getPrewarmHosts(ctx context.Context, userCred mcclient.TokenCredential, input api.CachedimagePrewarmInput) ([]SHost, error) {
+	enabled := true
+	hostInput := api.HostListInput{
+		HostType:   []string{api.HOST_TYPE_HYPERVISOR},
+		HostStatus: []string{api.HOST_ONLINE},
+	}
+	hostInput.Enabled = &enabled
+	hostInput.ZonalFilterListInput.ZoneId = input.ZoneId
+	hostInput.SchedtagId = input.SchedtagId
+	hostInput.Tags = input.HostTags
+	q, err := HostManager.ListItemFilter(ctx, HostManager.Query(), userCred, hostInput)
+	if err != nil {
+		return nil, errors.Wrap(err, "HostManager.ListItemFilter")
+	}
+	hosts := make([]SHost, 0)
+	err = db.FetchModelObjects(HostManager, q, &hosts)
+	if err != nil {
+		return nil, errors.Wrap(err, "db.FetchModelObjects")
+	}
+	return hosts, nil
+}
+
 func (self *SCachedimage) addRefCount() {
 	if self.GetStatus() != api.CACHED_IMAGE_STATUS_ACTIVE {
 		return
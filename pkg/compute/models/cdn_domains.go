@@ -0,0 +1,579 @@
+// Copyright 2019 Yunion
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package models
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"strings"
+	"time"
+
+	"yunion.io/x/jsonutils"
+	"yunion.io/x/log"
+	"yunion.io/x/pkg/errors"
+	"yunion.io/x/pkg/util/compare"
+	"yunion.io/x/pkg/util/regutils"
+	"yunion.io/x/pkg/utils"
+	"yunion.io/x/sqlchemy"
+
+	api "yunion.io/x/onecloud/pkg/apis/compute"
+	"yunion.io/x/onecloud/pkg/cloudcommon/db"
+	"yunion.io/x/onecloud/pkg/cloudcommon/db/lockman"
+	"yunion.io/x/onecloud/pkg/cloudcommon/db/taskman"
+	"yunion.io/x/onecloud/pkg/cloudprovider"
+	"yunion.io/x/onecloud/pkg/httperrors"
+	"yunion.io/x/onecloud/pkg/mcclient"
+	"yunion.io/x/onecloud/pkg/util/stringutils2"
+)
+
+type SCDNDomainManager struct {
+	db.SVirtualResourceBaseManager
+	db.SExternalizedResourceBaseManager
+
+	SManagedResourceBaseManager
+}
+
+var CDNDomainManager *SCDNDomainManager
+
+func init() {
+	CDNDomainManager = &SCDNDomainManager{
+		SVirtualResourceBaseManager: db.NewVirtualResourceBaseManager(
+			SCDNDomain{},
+			"cdn_domains_tbl",
+			"cdn_domain",
+			"cdn_domains",
+		),
+	}
+	CDNDomainManager.SetVirtualObject(CDNDomainManager)
+}
+
+type SCDNDomain struct {
+	db.SVirtualResourceBase
+	db.SExternalizedResourceBase
+
+	SManagedResourceBase
+
+	// CNAME记录
+	Cname string `width:"256" charset:"ascii" nullable:"false" list:"user"`
+
+	// 加速区域 mainland|overseas|global
+	Area string `width:"32" charset:"ascii" nullable:"false" list:"user" create:"optional"`
+
+	// 业务类型 web|download|media
+	ServiceType string `width:"32" charset:"ascii" nullable:"false" list:"user" create:"optional"`
+
+	// 源站信息
+	Origins *cloudprovider.SCdnOrigins `length:"medium" list:"user" create:"required"`
+}
+
+// CDN加速域名列表
+func (manager *SCDNDomainManager) ListItemFilter(
+	ctx context.Context,
+	q *sqlchemy.SQuery,
+	userCred mcclient.TokenCredential,
+	query api.CDNDomainListInput,
+) (*sqlchemy.SQuery, error) {
+	var err error
+	q, err = manager.SVirtualResourceBaseManager.ListItemFilter(ctx, q, userCred, query.VirtualResourceListInput)
+	if err != nil {
+		return nil, errors.Wrap(err, "SVirtualResourceBaseManager.ListItemFilter")
+	}
+	q, err = manager.SExternalizedResourceBaseManager.ListItemFilter(ctx, q, userCred, query.ExternalizedResourceBaseListInput)
+	if err != nil {
+		return nil, errors.Wrap(err, "SExternalizedResourceBaseManager.ListItemFilter")
+	}
+	q, err = manager.SManagedResourceBaseManager.ListItemFilter(ctx, q, userCred, query.ManagedResourceListInput)
+	if err != nil {
+		return nil, errors.Wrap(err, "SManagedResourceBaseManager.ListItemFilter")
+	}
+	if len(query.Area) > 0 {
+		q = q.In("area", query.Area)
+	}
+	if len(query.ServiceType) > 0 {
+		q = q.In("service_type", query.ServiceType)
+	}
+	return q, nil
+}
+
+func (manager *SCDNDomainManager) OrderByExtraFields(
+	ctx context.Context,
+	q *sqlchemy.SQuery,
+	userCred mcclient.TokenCredential,
+	query api.CDNDomainListInput,
+) (*sqlchemy.SQuery, error) {
+	q, err := manager.SVirtualResourceBaseManager.OrderByExtraFields(ctx, q, userCred, query.VirtualResourceListInput)
+	if err != nil {
+		return nil, errors.Wrap(err, "SVirtualResourceBaseManager.OrderByExtraFields")
+	}
+	q, err = manager.SManagedResourceBaseManager.OrderByExtraFields(ctx, q, userCred, query.ManagedResourceListInput)
+	if err != nil {
+		return nil, errors.Wrap(err, "SManagedResourceBaseManager.OrderByExtraFields")
+	}
+	return q, nil
+}
+
+func (manager *SCDNDomainManager) QueryDistinctExtraField(q *sqlchemy.SQuery, field string) (*sqlchemy.SQuery, error) {
+	q, err := manager.SVirtualResourceBaseManager.QueryDistinctExtraField(q, field)
+	if err == nil {
+		return q, nil
+	}
+	q, err = manager.SManagedResourceBaseManager.QueryDistinctExtraField(q, field)
+	if err == nil {
+		return q, nil
+	}
+	return q, httperrors.ErrNotFound
+}
+
+func (manager *SCDNDomainManager) ValidateCreateData(
+	ctx context.Context,
+	userCred mcclient.TokenCredential,
+	ownerId mcclient.IIdentityProvider,
+	query jsonutils.JSONObject,
+	input api.CDNDomainCreateInput,
+) (api.CDNDomainCreateInput, error) {
+	var err error
+	var provider *SCloudprovider
+	provider, input.CloudproviderResourceInput, err = ValidateCloudproviderResourceInput(userCred, input.CloudproviderResourceInput)
+	if err != nil {
+		return input, errors.Wrap(err, "ValidateCloudproviderResourceInput")
+	}
+	driver, err := provider.GetProvider()
+	if err != nil {
+		return input, httperrors.NewGeneralError(errors.Wrap(err, "GetProvider"))
+	}
+	if !cloudprovider.IsSupportCDN(driver) {
+		return input, httperrors.NewNotSupportedError("cloudprovider %s not support cdn", provider.Name)
+	}
+
+	input.Name = strings.ToLower(input.Name)
+	if !regutils.MatchDomainName(strings.TrimPrefix(input.Name, "*.")) {
+		return input, httperrors.NewInputParameterError("invalid domain name %s", input.Name)
+	}
+	cnt, err := manager.Query().Equals("manager_id", provider.Id).Equals("name", input.Name).CountWithError()
+	if err != nil {
+		return input, httperrors.NewGeneralError(err)
+	}
+	if cnt > 0 {
+		return input, httperrors.NewDuplicateResourceError("cdn domain %s already exists", input.Name)
+	}
+
+	if len(input.Area) == 0 {
+		input.Area = api.CDN_DOMAIN_AREA_MAINLAND
+	}
+	if !utils.IsInStringArray(input.Area, api.CDN_DOMAIN_AREAS) {
+		return input, httperrors.NewInputParameterError("invalid area %s, want %s", input.Area, api.CDN_DOMAIN_AREAS)
+	}
+	if len(input.ServiceType) == 0 {
+		input.ServiceType = api.CDN_SERVICE_TYPE_WEB
+	}
+	if !utils.IsInStringArray(input.ServiceType, api.CDN_SERVICE_TYPES) {
+		return input, httperrors.NewInputParameterError("invalid service_type %s, want %s", input.ServiceType, api.CDN_SERVICE_TYPES)
+	}
+
+	if input.Origins == nil || len(*input.Origins) == 0 {
+		return input, httperrors.NewMissingParameterError("origins")
+	}
+	for i, origin := range *input.Origins {
+		if len(origin.Type) == 0 {
+			origin.Type = api.CDN_DOMAIN_ORIGIN_TYPE_DOMAIN
+		}
+		if !utils.IsInStringArray(origin.Type, api.CDN_DOMAIN_ORIGIN_TYPES) {
+			return input, httperrors.NewInputParameterError("invalid origin type %s, want %s", origin.Type, api.CDN_DOMAIN_ORIGIN_TYPES)
+		}
+		if len(origin.Origin) == 0 {
+			return input, httperrors.NewMissingParameterError(fmt.Sprintf("origins.%d.origin", i))
+		}
+		if origin.Type == api.CDN_DOMAIN_ORIGIN_TYPE_IP && !regutils.MatchIPAddr(origin.Origin) {
+			return input, httperrors.NewInputParameterError("invalid origin ip %s", origin.Origin)
+		}
+		if origin.Port < 0 || origin.Port > 65535 {
+			return input, httperrors.NewInputParameterError("invalid origin port %d", origin.Port)
+		}
+		(*input.Origins)[i] = origin
+	}
+
+	input.VirtualResourceCreateInput, err = manager.SVirtualResourceBaseManager.ValidateCreateData(ctx, userCred, ownerId, query, input.VirtualResourceCreateInput)
+	if err != nil {
+		return input, errors.Wrap(err, "SVirtualResourceBaseManager.ValidateCreateData")
+	}
+	return input, nil
+}
+
+func (self *SCDNDomain) PostCreate(ctx context.Context, userCred mcclient.TokenCredential, ownerId mcclient.IIdentityProvider, query jsonutils.JSONObject, data jsonutils.JSONObject) {
+	self.SVirtualResourceBase.PostCreate(ctx, userCred, ownerId, query, data)
+	self.StartCreateTask(ctx, userCred, "")
+}
+
+func (self *SCDNDomain) StartCreateTask(ctx context.Context, userCred mcclient.TokenCredential, parentTaskId string) error {
+	task, err := taskman.TaskManager.NewTask(ctx, "CDNDomainCreateTask", self, userCred, nil, parentTaskId, "", nil)
+	if err != nil {
+		self.SetStatus(userCred, api.CDN_DOMAIN_STATUS_CREATE_FAILED, errors.Wrapf(err, "NewTask").Error())
+		return err
+	}
+	self.SetStatus(userCred, api.CDN_DOMAIN_STATUS_CREATING, "")
+	task.ScheduleRun(nil)
+	return nil
+}
+
+func (manager *SCDNDomainManager) FetchCustomizeColumns(
+	ctx context.Context,
+	userCred mcclient.TokenCredential,
+	query jsonutils.JSONObject,
+	objs []interface{},
+	fields stringutils2.SSortedStrings,
+	isList bool,
+) []api.CDNDomainDetails {
+	rows := make([]api.CDNDomainDetails, len(objs))
+	virtRows := manager.SVirtualResourceBaseManager.FetchCustomizeColumns(ctx, userCred, query, objs, fields, isList)
+	manRows := manager.SManagedResourceBaseManager.FetchCustomizeColumns(ctx, userCred, query, objs, fields, isList)
+	for i := range rows {
+		rows[i] = api.CDNDomainDetails{
+			VirtualResourceDetails: virtRows[i],
+			ManagedResourceInfo:    manRows[i],
+		}
+	}
+	return rows
+}
+
+func (manager *SCDNDomainManager) ListItemExportKeys(ctx context.Context,
+	q *sqlchemy.SQuery,
+	userCred mcclient.TokenCredential,
+	keys stringutils2.SSortedStrings,
+) (*sqlchemy.SQuery, error) {
+	var err error
+
+	q, err = manager.SVirtualResourceBaseManager.ListItemExportKeys(ctx, q, userCred, keys)
+	if err != nil {
+		return nil, errors.Wrap(err, "SVirtualResourceBaseManager.ListItemExportKeys")
+	}
+
+	if keys.ContainsAny(manager.SManagedResourceBaseManager.GetExportKeys()...) {
+		q, err = manager.SManagedResourceBaseManager.ListItemExportKeys(ctx, q, userCred, keys)
+		if err != nil {
+			return nil, errors.Wrap(err, "SManagedResourceBaseManager.ListItemExportKeys")
+		}
+	}
+
+	return q, nil
+}
+
+// 加速域名, 同步的资源名称可能因重名被修改, 以ExternalId为准
+func (self *SCDNDomain) GetDomain() string {
+	if len(self.ExternalId) > 0 {
+		return self.ExternalId
+	}
+	return self.Name
+}
+
+func (self *SCDNDomain) GetICDNDomain() (cloudprovider.ICloudCDNDomain, error) {
+	if len(self.ExternalId) == 0 {
+		return nil, errors.Wrapf(cloudprovider.ErrNotFound, "empty externalId")
+	}
+	provider, err := self.GetDriver()
+	if err != nil {
+		return nil, errors.Wrap(err, "GetDriver")
+	}
+	return provider.GetICloudCDNDomainByName(self.ExternalId)
+}
+
+func (self *SCDNDomain) Delete(ctx context.Context, userCred mcclient.TokenCredential) error {
+	return nil
+}
+
+func (self *SCDNDomain) RealDelete(ctx context.Context, userCred mcclient.TokenCredential) error {
+	return self.SVirtualResourceBase.Delete(ctx, userCred)
+}
+
+func (self *SCDNDomain) CustomizeDelete(ctx context.Context, userCred mcclient.TokenCredential, query jsonutils.JSONObject, data jsonutils.JSONObject) error {
+	return self.StartDeleteTask(ctx, userCred, "")
+}
+
+func (self *SCDNDomain) StartDeleteTask(ctx context.Context, userCred mcclient.TokenCredential, parentTaskId string) error {
+	task, err := taskman.TaskManager.NewTask(ctx, "CDNDomainDeleteTask", self, userCred, nil, parentTaskId, "", nil)
+	if err != nil {
+		return err
+	}
+	self.SetStatus(userCred, api.CDN_DOMAIN_STATUS_DELETING, "")
+	task.ScheduleRun(nil)
+	return nil
+}
+
+func (self *SCDNDomain) validateOperation(ctx context.Context, action string) error {
+	if utils.IsInStringArray(self.Status, api.CDN_DOMAIN_BUSY_STATUSES) {
+		return httperrors.NewInvalidStatusError("cannot %s cdn domain in status %s", action, self.Status)
+	}
+	if len(self.ExternalId) == 0 {
+		return httperrors.NewInvalidStatusError("cdn domain %s not created on cloud", self.Name)
+	}
+	return nil
+}
+
+// normalizeCDNUrls 将路径补全为完整的url, 并校验url是否属于加速域名
+func normalizeCDNUrls(domain string, urls []string) ([]string, error) {
+	ret := []string{}
+	for _, u := range urls {
+		u = strings.TrimSpace(u)
+		if len(u) == 0 {
+			continue
+		}
+		if strings.HasPrefix(u, "/") {
+			u = "http://" + domain + u
+		} else if !strings.Contains(u, "://") {
+			u = "http://" + u
+		}
+		parsed, err := url.Parse(u)
+		if err != nil {
+			return nil, errors.Wrapf(err, "invalid url %s", u)
+		}
+		if parsed.Scheme != "http" && parsed.Scheme != "https" {
+			return nil, errors.Errorf("invalid url scheme %s", parsed.Scheme)
+		}
+		host := strings.ToLower(parsed.Hostname())
+		if strings.HasPrefix(domain, "*.") {
+			if !strings.HasSuffix(host, domain[1:]) {
+				return nil, errors.Errorf("url %s not belong to domain %s", u, domain)
+			}
+		} else if host != domain {
+			return nil, errors.Errorf("url %s not belong to domain %s", u, domain)
+		}
+		if !utils.IsInStringArray(u, ret) {
+			ret = append(ret, u)
+		}
+	}
+	if len(ret) == 0 {
+		return nil, errors.Errorf("empty urls")
+	}
+	return ret, nil
+}
+
+func (self *SCDNDomain) AllowPerformPurgeCache(ctx context.Context, userCred mcclient.TokenCredential, query jsonutils.JSONObject, data jsonutils.JSONObject) bool {
+	return self.IsOwner(userCred) || db.IsAdminAllowPerform(userCred, self, "purge-cache")
+}
+
+// 刷新CDN缓存
+func (self *SCDNDomain) PerformPurgeCache(ctx context.Context, userCred mcclient.TokenCredential, query jsonutils.JSONObject, input api.CDNDomainPurgeCacheInput) (jsonutils.JSONObject, error) {
+	err := self.validateOperation(ctx, "purge cache of")
+	if err != nil {
+		return nil, err
+	}
+	if len(input.Type) == 0 {
+		input.Type = api.CDN_PURGE_TYPE_FILE
+	}
+	if !utils.IsInStringArray(input.Type, api.CDN_PURGE_TYPES) {
+		return nil, httperrors.NewInputParameterError("invalid type %s, want %s", input.Type, api.CDN_PURGE_TYPES)
+	}
+	input.Urls, err = normalizeCDNUrls(self.GetDomain(), input.Urls)
+	if err != nil {
+		return nil, httperrors.NewInputParameterError("%v", err)
+	}
+	return nil, self.startCacheTask(ctx, userCred, "CDNDomainPurgeCacheTask", api.CDN_DOMAIN_STATUS_PURGING, jsonutils.Marshal(input).(*jsonutils.JSONDict))
+}
+
+func (self *SCDNDomain) AllowPerformPrefetch(ctx context.Context, userCred mcclient.TokenCredential, query jsonutils.JSONObject, data jsonutils.JSONObject) bool {
+	return self.IsOwner(userCred) || db.IsAdminAllowPerform(userCred, self, "prefetch")
+}
+
+// 预热CDN缓存
+func (self *SCDNDomain) PerformPrefetch(ctx context.Context, userCred mcclient.TokenCredential, query jsonutils.JSONObject, input api.CDNDomainPrefetchInput) (jsonutils.JSONObject, error) {
+	err := self.validateOperation(ctx, "prefetch")
+	if err != nil {
+		return nil, err
+	}
+	input.Urls, err = normalizeCDNUrls(self.GetDomain(), input.Urls)
+	if err != nil {
+		return nil, httperrors.NewInputParameterError("%v", err)
+	}
+	return nil, self.startCacheTask(ctx, userCred, "CDNDomainPrefetchTask", api.CDN_DOMAIN_STATUS_PREFETCHING, jsonutils.Marshal(input).(*jsonutils.JSONDict))
+}
+
+func (self *SCDNDomain) startCacheTask(ctx context.Context, userCred mcclient.TokenCredential, taskName, status string, params *jsonutils.JSONDict) error {
+	params.Set("origin_status", jsonutils.NewString(self.Status))
+	task, err := taskman.TaskManager.NewTask(ctx, taskName, self, userCred, params, "", "", nil)
+	if err != nil {
+		return err
+	}
+	self.SetStatus(userCred, status, "")
+	task.ScheduleRun(nil)
+	return nil
+}
+
+func (self *SCDNDomain) AllowPerformSyncstatus(ctx context.Context, userCred mcclient.TokenCredential, query jsonutils.JSONObject, data jsonutils.JSONObject) bool {
+	return self.IsOwner(userCred) || db.IsAdminAllowPerform(userCred, self, "syncstatus")
+}
+
+// 同步CDN加速域名状态
+func (self *SCDNDomain) PerformSyncstatus(ctx context.Context, userCred mcclient.TokenCredential, query jsonutils.JSONObject, data jsonutils.JSONObject) (jsonutils.JSONObject, error) {
+	var openTask = true
+	count, err := taskman.TaskManager.QueryTasksOfObject(self, time.Now().Add(-3*time.Minute), &openTask).CountWithError()
+	if err != nil {
+		return nil, err
+	}
+	if count > 0 {
+		return nil, httperrors.NewBadRequestError("CDN domain has %d task active, can't sync status", count)
+	}
+	return nil, StartResourceSyncStatusTask(ctx, userCred, self, "CDNDomainSyncstatusTask", "")
+}
+
+func (self *SCloudprovider) GetCDNDomains() ([]SCDNDomain, error) {
+	q := CDNDomainManager.Query().Equals("manager_id", self.Id)
+	domains := []SCDNDomain{}
+	err := db.FetchModelObjects(CDNDomainManager, q, &domains)
+	if err != nil {
+		return nil, errors.Wrapf(err, "db.FetchModelObjects")
+	}
+	return domains, nil
+}
+
+func (self *SCloudprovider) SyncCDNDomains(ctx context.Context, userCred mcclient.TokenCredential, exts []cloudprovider.ICloudCDNDomain) compare.SyncResult {
+	lockman.LockRawObject(ctx, CDNDomainManager.KeywordPlural(), self.Id)
+	defer lockman.ReleaseRawObject(ctx, CDNDomainManager.KeywordPlural(), self.Id)
+
+	result := compare.SyncResult{}
+
+	dbDomains, err := self.GetCDNDomains()
+	if err != nil {
+		result.Error(err)
+		return result
+	}
+
+	removed := make([]SCDNDomain, 0)
+	commondb := make([]SCDNDomain, 0)
+	commonext := make([]cloudprovider.ICloudCDNDomain, 0)
+	added := make([]cloudprovider.ICloudCDNDomain, 0)
+	err = compare.CompareSets(dbDomains, exts, &removed, &commondb, &commonext, &added)
+	if err != nil {
+		result.Error(err)
+		return result
+	}
+
+	for i := 0; i < len(removed); i++ {
+		err := removed[i].syncRemoveCloudCDNDomain(ctx, userCred)
+		if err != nil {
+			result.DeleteError(err)
+			continue
+		}
+		result.Delete()
+	}
+
+	for i := 0; i < len(commondb); i++ {
+		err := commondb[i].SyncWithCloudCDNDomain(ctx, userCred, commonext[i])
+		if err != nil {
+			result.UpdateError(err)
+			continue
+		}
+		result.Update()
+	}
+
+	for i := 0; i < len(added); i++ {
+		_, err := self.newFromCloudCDNDomain(ctx, userCred, added[i])
+		if err != nil {
+			result.AddError(err)
+			continue
+		}
+		result.Add()
+	}
+	return result
+}
+
+func (self *SCloudprovider) SyncCallSyncCloudproviderCDNDomains(ctx context.Context, userCred mcclient.TokenCredential) {
+	driver, err := self.GetProvider()
+	if err != nil {
+		log.Errorf("failed to get ICloudProvider from SCloudprovider:%s %s", self.GetName(), self.Id)
+		return
+	}
+	if !cloudprovider.IsSupportCDN(driver) {
+		return
+	}
+	domains, err := driver.GetICloudCDNDomains()
+	if err != nil {
+		log.Errorf("failed to get cdn domains for Manager %s error: %v", self.Id, err)
+		return
+	}
+	result := self.SyncCDNDomains(ctx, userCred, domains)
+	log.Infof("Sync cdn domains for cloudprovider %s result: %s", self.GetName(), result.Result())
+}
+
+func (self *SCDNDomain) syncRemoveCloudCDNDomain(ctx context.Context, userCred mcclient.TokenCredential) error {
+	lockman.LockObject(ctx, self)
+	defer lockman.ReleaseObject(ctx, self)
+
+	// 本地正在创建的域名尚未同步到云上
+	if self.Status == api.CDN_DOMAIN_STATUS_CREATING {
+		return nil
+	}
+	return self.RealDelete(ctx, userCred)
+}
+
+func (self *SCDNDomain) SyncWithCloudCDNDomain(ctx context.Context, userCred mcclient.TokenCredential, ext cloudprovider.ICloudCDNDomain) error {
+	diff, err := db.UpdateWithLock(ctx, self, func() error {
+		self.ExternalId = ext.GetGlobalId()
+		if !utils.IsInStringArray(self.Status, api.CDN_DOMAIN_BUSY_STATUSES) {
+			self.Status = ext.GetStatus()
+		}
+		self.Cname = ext.GetCname()
+		self.Area = ext.GetArea()
+		self.ServiceType = ext.GetServiceType()
+		self.Origins = ext.GetOrigins()
+		return nil
+	})
+	if err != nil {
+		return errors.Wrapf(err, "db.UpdateWithLock")
+	}
+
+	syncVirtualResourceMetadata(ctx, userCred, self, ext)
+	if provider := self.GetCloudprovider(); provider != nil {
+		SyncCloudProject(userCred, self, provider.GetOwnerId(), ext, provider.Id)
+	}
+	db.OpsLog.LogSyncUpdate(self, diff, userCred)
+	return nil
+}
+
+func (self *SCloudprovider) newFromCloudCDNDomain(ctx context.Context, userCred mcclient.TokenCredential, ext cloudprovider.ICloudCDNDomain) (*SCDNDomain, error) {
+	domain := SCDNDomain{}
+	domain.SetModelManager(CDNDomainManager, &domain)
+
+	domain.ExternalId = ext.GetGlobalId()
+	domain.ManagerId = self.Id
+	domain.IsEmulated = ext.IsEmulated()
+	domain.Status = ext.GetStatus()
+	domain.Cname = ext.GetCname()
+	domain.Area = ext.GetArea()
+	domain.ServiceType = ext.GetServiceType()
+	domain.Origins = ext.GetOrigins()
+
+	var err error
+	err = func() error {
+		// 这里加锁是为了防止名称重复
+		lockman.LockRawObject(ctx, CDNDomainManager.Keyword(), "name")
+		defer lockman.ReleaseRawObject(ctx, CDNDomainManager.Keyword(), "name")
+
+		domain.Name, err = db.GenerateName(ctx, CDNDomainManager, self.GetOwnerId(), ext.GetName())
+		if err != nil {
+			return errors.Wrapf(err, "db.GenerateName")
+		}
+		return CDNDomainManager.TableSpec().Insert(ctx, &domain)
+	}()
+	if err != nil {
+		return nil, errors.Wrapf(err, "newFromCloudCDNDomain.Insert")
+	}
+
+	syncVirtualResourceMetadata(ctx, userCred, &domain, ext)
+	SyncCloudProject(userCred, &domain, self.GetOwnerId(), ext, self.Id)
+
+	db.OpsLog.LogEvent(&domain, db.ACT_CREATE, domain.GetShortDesc(ctx), userCred)
+
+	return &domain, nil
+}
@@ -0,0 +1,90 @@
+// Copyright 2019 Yunion
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package models
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestNormalizeCDNUrls(t *testing.T) {
+	cases := []struct {
+		name   string
+		domain string
+		in     []string
+		out    []string
+		isErr  bool
+	}{
+		{
+			name:   "path",
+			domain: "www.example.com",
+			in:     []string{"/index.html", " /static/ "},
+			out:    []string{"http://www.example.com/index.html", "http://www.example.com/static/"},
+		},
+		{
+			name:   "no scheme",
+			domain: "www.example.com",
+			in:     []string{"www.example.com/a.js"},
+			out:    []string{"http://www.example.com/a.js"},
+		},
+		{
+			name:   "https and duplicated",
+			domain: "www.example.com",
+			in:     []string{"https://www.example.com/a.js", "https://www.example.com/a.js", ""},
+			out:    []string{"https://www.example.com/a.js"},
+		},
+		{
+			name:   "wildcard domain",
+			domain: "*.example.com",
+			in:     []string{"http://img.example.com/a.png"},
+			out:    []string{"http://img.example.com/a.png"},
+		},
+		{
+			name:   "other domain",
+			domain: "www.example.com",
+			in:     []string{"http://www.example.org/a.js"},
+			isErr:  true,
+		},
+		{
+			name:   "bad scheme",
+			domain: "www.example.com",
+			in:     []string{"ftp://www.example.com/a.js"},
+			isErr:  true,
+		},
+		{
+			name:   "empty",
+			domain: "www.example.com",
+			in:     []string{" "},
+			isErr:  true,
+		},
+	}
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			out, err := normalizeCDNUrls(c.domain, c.in)
+			if c.isErr {
+				if err == nil {
+					t.Fatalf("expect error, got %v", out)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if !reflect.DeepEqual(out, c.out) {
+				t.Fatalf("want %v, got %v", c.out, out)
+			}
+		})
+	}
+}
@@ -17,6 +17,7 @@ package models
 import (
 	"context"
 	"database/sql"
+	"encoding/base64"
 	"fmt"
 	"path"
 	"strings"
@@ -84,6 +85,7 @@ type SDisk struct {
 	SStorageResourceBase `width:"128" charset:"ascii" nullable:"true" list:"admin" create:"optional"`
 	db.SMultiArchResourceBase
 	db.SAutoDeleteResourceBase
+	SEncryptedResource
 
 	// 磁盘存储类型
 	// example: qcow2
@@ -377,6 +379,9 @@ func (self *SDisk) CustomizeCreate(ctx context.Context, userCred mcclient.TokenC
 		return err
 	}
 	self.fetchDiskInfo(input.DiskConfig)
+	if err := self.initDiskEncryption(ctx, input.DiskConfig, ownerId); err != nil {
+		return err
+	}
 	return self.SVirtualResourceBase.CustomizeCreate(ctx, userCred, ownerId, query, data)
 }
 
@@ -722,6 +727,26 @@ func (self *SDisk) StartAllocate(ctx context.Context, host *SHost, storage *SSto
 	}
 }
 
+func (self *SDisk) AllowGetDetailsEncryptInfo(ctx context.Context, userCred mcclient.TokenCredential, query jsonutils.JSONObject) bool {
+	return db.IsAdminAllowGetSpec(userCred, self, "encrypt-info")
+}
+
+// GetDetailsEncryptInfo returns the plain data key to hosts opening the disk
+func (self *SDisk) GetDetailsEncryptInfo(ctx context.Context, userCred mcclient.TokenCredential, query jsonutils.JSONObject) (api.DiskEncryptInfo, error) {
+	ret := api.DiskEncryptInfo{}
+	if !self.IsEncrypted() {
+		return ret, httperrors.NewBadRequestError("disk %s is not encrypted", self.Name)
+	}
+	dataKey, err := self.getDataKey(ctx)
+	if err != nil {
+		return ret, errors.Wrap(err, "getDataKey")
+	}
+	ret.EncryptKeyId = self.EncryptKeyId
+	ret.EncryptFormat = api.DISK_ENCRYPT_FORMAT_LUKS
+	ret.Key = base64.StdEncoding.EncodeToString(dataKey)
+	return ret, nil
+}
+
 func (self *SDisk) AllowGetDetailsConvertSnapshot(ctx context.Context, userCred mcclient.TokenCredential, query jsonutils.JSONObject) bool {
 	return self.IsOwner(userCred) || db.IsAdminAllowPerform(userCred, self, "convert-snapshot")
 }
@@ -1685,9 +1710,48 @@ func parseDiskInfo(ctx context.Context, userCred mcclient.TokenCredential, info
 	if len(info.ImageId) == 0 && info.SizeMb == 0 {
 		return nil, httperrors.NewInputParameterError("Diskinfo index %d: both imageID and size are absent", info.Index)
 	}
+	if len(info.EncryptKeyId) > 0 {
+		if err := validateDiskEncryption(ctx, info); err != nil {
+			return nil, err
+		}
+	}
 	return info, nil
 }
 
+func validateDiskEncryption(ctx context.Context, info *api.DiskConfig) error {
+	if len(info.Backend) > 0 && !isEncryptSupportedStorageType(info.Backend) {
+		return httperrors.NewInputParameterError("Diskinfo index %d: storage %s does not support encryption", info.Index, info.Backend)
+	}
+	if len(info.SnapshotId) > 0 {
+		snapshot := SnapshotManager.FetchSnapshotById(info.SnapshotId)
+		if snapshot != nil && !snapshot.IsEncrypted() {
+			return httperrors.NewInputParameterError("Diskinfo index %d: disk cloned from unencrypted snapshot can not be encrypted", info.Index)
+		}
+	}
+	keyId, err := ValidateEncryptKey(ctx, info.EncryptKeyId)
+	if err != nil {
+		return err
+	}
+	info.EncryptKeyId = keyId
+	return nil
+}
+
+// initDiskEncryption generates the data key of a new disk, disks cloned from an
+// encrypted snapshot share the key of the snapshot
+func (self *SDisk) initDiskEncryption(ctx context.Context, diskConfig *api.DiskConfig, ownerId mcclient.IIdentityProvider) error {
+	if len(diskConfig.SnapshotId) > 0 {
+		snapshot := SnapshotManager.FetchSnapshotById(diskConfig.SnapshotId)
+		if snapshot != nil && snapshot.IsEncrypted() {
+			self.inheritEncryption(&snapshot.SEncryptedResource)
+			return nil
+		}
+	}
+	if len(diskConfig.EncryptKeyId) == 0 {
+		return nil
+	}
+	return self.initEncryption(ctx, diskConfig.EncryptKeyId, ownerId)
+}
+
 func fillDiskConfigBySnapshot(userCred mcclient.TokenCredential, diskConfig *api.DiskConfig, snapshotId string) error {
 	iSnapshot, err := SnapshotManager.FetchByIdOrName(userCred, snapshotId)
 	if err != nil {
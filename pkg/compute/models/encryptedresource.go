@@ -0,0 +1,134 @@
+// Copyright 2019 Yunion
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package models
+
+import (
+	"context"
+	"encoding/base64"
+
+	"yunion.io/x/pkg/errors"
+	"yunion.io/x/pkg/utils"
+
+	api "yunion.io/x/onecloud/pkg/apis/compute"
+	"yunion.io/x/onecloud/pkg/compute/options"
+	"yunion.io/x/onecloud/pkg/httperrors"
+	"yunion.io/x/onecloud/pkg/mcclient"
+	"yunion.io/x/onecloud/pkg/mcclient/auth"
+	"yunion.io/x/onecloud/pkg/mcclient/modules"
+	"yunion.io/x/onecloud/pkg/util/seclib2"
+)
+
+// SEncryptedResource holds the envelope encryption key of a resource: a random
+// data key wrapped by a project scoped master key kept in keystone credentials
+type SEncryptedResource struct {
+	// 加密主密钥ID
+	EncryptKeyId string `width:"36" charset:"ascii" nullable:"true" list:"user"`
+	// 被主密钥加密的数据密钥
+	EncryptedDataKey string `width:"256" charset:"ascii" nullable:"true"`
+}
+
+func (self *SEncryptedResource) IsEncrypted() bool {
+	return len(self.EncryptKeyId) > 0
+}
+
+func fetchEncryptKey(ctx context.Context, keyId string) (modules.SEncryptKeySecret, error) {
+	s := auth.GetAdminSession(ctx, options.Options.Region, "")
+	key, err := modules.Credentials.GetEncryptKey(s, keyId)
+	if err != nil {
+		if errors.Cause(err) == httperrors.ErrInvalidFormat {
+			return key, httperrors.NewInputParameterError("credential %s is not an encryption key", keyId)
+		}
+		return key, errors.Wrapf(err, "GetEncryptKey %s", keyId)
+	}
+	return key, nil
+}
+
+func decodeMasterKey(key modules.SEncryptKeySecret) ([]byte, error) {
+	masterKey, err := base64.StdEncoding.DecodeString(key.Key)
+	if err != nil {
+		return nil, errors.Wrapf(err, "decode master key %s", key.KeyId)
+	}
+	return masterKey, nil
+}
+
+// ValidateEncryptKey checks the master key exists and normalizes name to id
+func ValidateEncryptKey(ctx context.Context, keyId string) (string, error) {
+	key, err := fetchEncryptKey(ctx, keyId)
+	if err != nil {
+		return "", err
+	}
+	return key.KeyId, nil
+}
+
+// initEncryption generates a new data key and wraps it by the master key, the
+// master key must belong to the project of the resource
+func (self *SEncryptedResource) initEncryption(ctx context.Context, keyId string, ownerId mcclient.IIdentityProvider) error {
+	key, err := fetchEncryptKey(ctx, keyId)
+	if err != nil {
+		return err
+	}
+	if key.ProjectId != ownerId.GetProjectId() {
+		return httperrors.NewForbiddenError("encryption key %s does not belong to project %s", key.KeyName, ownerId.GetProjectId())
+	}
+	masterKey, err := decodeMasterKey(key)
+	if err != nil {
+		return err
+	}
+	dataKey, err := seclib2.GenerateDataKey()
+	if err != nil {
+		return errors.Wrap(err, "GenerateDataKey")
+	}
+	wrapped, err := seclib2.WrapKey(masterKey, dataKey)
+	if err != nil {
+		return errors.Wrap(err, "WrapKey")
+	}
+	self.EncryptKeyId = key.KeyId
+	self.EncryptedDataKey = wrapped
+	return nil
+}
+
+// inheritEncryption shares the data key of src, e.g. disks cloned from an
+// encrypted snapshot are backed by the snapshot file and must use its key
+func (self *SEncryptedResource) inheritEncryption(src *SEncryptedResource) {
+	self.EncryptKeyId = src.EncryptKeyId
+	self.EncryptedDataKey = src.EncryptedDataKey
+}
+
+// getDataKey unwraps the data key, it must never be persisted or logged
+func (self *SEncryptedResource) getDataKey(ctx context.Context) ([]byte, error) {
+	key, err := fetchEncryptKey(ctx, self.EncryptKeyId)
+	if err != nil {
+		return nil, err
+	}
+	masterKey, err := decodeMasterKey(key)
+	if err != nil {
+		return nil, err
+	}
+	dataKey, err := seclib2.UnwrapKey(masterKey, self.EncryptedDataKey)
+	if err != nil {
+		return nil, errors.Wrapf(err, "unwrap data key by %s", self.EncryptKeyId)
+	}
+	return dataKey, nil
+}
+
+// isEncryptSupportedStorageType tells whether disks of the storage type can be
+// encrypted, qcow2 files on file storages and luks volumes on rbd
+func isEncryptSupportedStorageType(storageType string) bool {
+	return utils.IsInStringArray(storageType, api.FIEL_STORAGE) || storageType == api.STORAGE_RBD
+}
+
+func isEncryptSupportedStorage(storage *SStorage) bool {
+	return isEncryptSupportedStorageType(storage.StorageType)
+}
@@ -775,6 +775,10 @@ func (self *SGuest) ValidateAttachDisk(ctx context.Context, disk *SDisk) error {
 	if disk.Status != api.DISK_READY {
 		return httperrors.NewInputParameterError("Disk in %s not able to attach", disk.Status)
 	}
+	if disk.IsEncrypted() && self.Status != api.VM_READY {
+		// the key secret is only passed to qemu on startup
+		return httperrors.NewInputParameterError("Encrypted disk %s can only be attached to a stopped guest", disk.Name)
+	}
 	guestStatus, err := self.GetDriver().GetAttachDiskStatus()
 	if err != nil {
 		return err
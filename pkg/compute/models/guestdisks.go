@@ -216,6 +216,10 @@ func (self *SGuestdisk) GetJsonDescAtHost(host *SHost) jsonutils.JSONObject {
 	}
 	desc.Add(jsonutils.NewString(disk.DiskFormat), "format")
 	desc.Add(jsonutils.NewInt(int64(self.Index)), "index")
+	if disk.IsEncrypted() {
+		// host fetches the data key by encrypt-info of the disk
+		desc.Add(jsonutils.NewString(disk.EncryptKeyId), "encrypt_key_id")
+	}
 
 	tid := disk.GetTemplateId()
 	if len(tid) > 0 {
@@ -242,6 +242,7 @@ type newGuestNetworkArgs struct {
 	index int8
 
 	ipAddr              string
+	ip6Addr             string
 	allocDir            api.IPAllocationDirection
 	tryReserved         bool
 	requireDesignatedIP bool
@@ -269,6 +270,7 @@ func (manager *SGuestnetworkManager) newGuestNetwork(
 		network              = args.network
 		index                = args.index
 		address              = args.ipAddr
+		address6             = args.ip6Addr
 		mac                  = args.macAddr
 		driver               = args.nicDriver
 		bwLimit              = args.bwLimit
@@ -337,6 +339,19 @@ func (manager *SGuestnetworkManager) newGuestNetwork(
 			gn.IpAddr = ipAddr
 		}
 
+		if provider == api.CLOUD_PROVIDER_ONECLOUD && network.IsSupportIPv6() {
+			ip6Addr, err := network.GetFreeIP6(nil, address6, allocDir)
+			if err != nil {
+				return nil, errors.Wrap(err, "GetFreeIP6")
+			}
+			if len(address6) > 0 && ip6Addr != address6 && requiredDesignatedIp {
+				return nil, fmt.Errorf("candidate ipv6 %s is occupied!", address6)
+			}
+			gn.Ip6Addr = ip6Addr
+		} else if len(address6) > 0 {
+			return nil, errors.Wrapf(httperrors.ErrNotSupported, "network %s has no ipv6 range", network.Name)
+		}
+
 		if vpc.Id != api.DEFAULT_VPC_ID && provider == api.CLOUD_PROVIDER_ONECLOUD {
 			var err error
 			GuestnetworkManager.lockAllocMappedAddr(ctx)
@@ -572,6 +587,16 @@ func (self *SGuestnetwork) getJsonDesc(network *SNetwork) *jsonutils.JSONDict {
 	}
 	desc.Add(jsonutils.NewString(network.GetDNS()), "dns")
 	desc.Add(jsonutils.NewString(network.GetDomain()), "domain")
+	if len(self.Ip6Addr) > 0 && network.IsSupportIPv6() {
+		desc.Add(jsonutils.NewString(self.Ip6Addr), "ip6")
+		desc.Add(jsonutils.NewInt(int64(network.GuestIp6Mask)), "masklen6")
+		if len(network.GuestGateway6) > 0 {
+			desc.Add(jsonutils.NewString(network.GuestGateway6), "gateway6")
+		}
+		if dns6 := network.GetDNS6(); len(dns6) > 0 {
+			desc.Add(jsonutils.NewString(dns6), "dns6")
+		}
+	}
 	routes := network.GetRoutes()
 	if routes != nil && len(routes) > 0 {
 		desc.Add(jsonutils.Marshal(routes), "routes")
@@ -667,6 +692,12 @@ func (manager *SGuestnetworkManager) DeleteGuestNics(ctx context.Context, userCr
 		}
 		// ??
 		// gn.Delete(ctx, userCred)
+		if gn.Driver == api.NETWORK_DRIVER_VFIO {
+			err := IsolatedDeviceManager.releaseSRIOVNicOfGuestnetwork(ctx, userCred, guest, gn.Index)
+			if err != nil {
+				log.Errorf("release SR-IOV nic of guest %s index %d: %s", gn.GuestId, gn.Index, err)
+			}
+		}
 		err := gn.Delete(ctx, userCred)
 		if err != nil {
 			log.Errorf("%s", err)
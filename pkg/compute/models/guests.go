@@ -2746,6 +2746,7 @@ type Attach2NetworkArgs struct {
 	Network *SNetwork
 
 	IpAddr              string
+	Ip6Addr             string
 	AllocDir            api.IPAllocationDirection
 	TryReserved         bool
 	RequireDesignatedIP bool
@@ -2768,6 +2769,7 @@ func (args *Attach2NetworkArgs) onceArgs(i int) attach2NetworkOnceArgs {
 		network: args.Network,
 
 		ipAddr:              args.IpAddr,
+		ip6Addr:             args.Ip6Addr,
 		allocDir:            args.AllocDir,
 		tryReserved:         args.TryReserved,
 		requireDesignatedIP: args.RequireDesignatedIP,
@@ -2783,6 +2785,7 @@ func (args *Attach2NetworkArgs) onceArgs(i int) attach2NetworkOnceArgs {
 	}
 	if i > 0 {
 		r.ipAddr = ""
+		r.ip6Addr = ""
 		r.bwLimit = 0
 		r.virtual = true
 		r.tryReserved = false
@@ -2798,6 +2801,7 @@ type attach2NetworkOnceArgs struct {
 	network *SNetwork
 
 	ipAddr              string
+	ip6Addr             string
 	allocDir            api.IPAllocationDirection
 	tryReserved         bool
 	requireDesignatedIP bool
@@ -2866,6 +2870,7 @@ func (self *SGuest) attach2NetworkOnce(
 		index: index,
 
 		ipAddr:              args.ipAddr,
+		ip6Addr:             args.ip6Addr,
 		allocDir:            args.allocDir,
 		tryReserved:         args.tryReserved,
 		requireDesignatedIP: args.requireDesignatedIP,
@@ -2885,6 +2890,13 @@ func (self *SGuest) attach2NetworkOnce(
 	if err != nil {
 		return nil, errors.Wrap(err, "GuestnetworkManager.newGuestNetwork")
 	}
+	if guestnic.Driver == api.NETWORK_DRIVER_VFIO && !guestnic.Virtual {
+		err = IsolatedDeviceManager.attachSRIOVNicToGuestnetwork(ctx, userCred, self, guestnic)
+		if err != nil {
+			GuestnetworkManager.DeleteGuestNics(ctx, userCred, []SGuestnetwork{*guestnic}, false)
+			return nil, errors.Wrap(err, "IsolatedDeviceManager.attachSRIOVNicToGuestnetwork")
+		}
+	}
 	var (
 		network      = args.network
 		pendingUsage = args.pendingUsage
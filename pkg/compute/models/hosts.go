@@ -5763,6 +5763,97 @@ func (self *SHost) StartEjectIsoTask(ctx context.Context, userCred mcclient.Toke
 	}
 }
 
+func (self *SHost) AllowPerformBiosSettings(ctx context.Context,
+	userCred mcclient.TokenCredential,
+	query jsonutils.JSONObject,
+	data jsonutils.JSONObject) bool {
+	return db.IsAdminAllowPerform(userCred, self, "bios-settings")
+}
+
+func (self *SHost) PerformBiosSettings(ctx context.Context, userCred mcclient.TokenCredential, query jsonutils.JSONObject, input api.HostBiosSettingsInput) (jsonutils.JSONObject, error) {
+	if !self.IsBaremetal {
+		return nil, httperrors.NewNotSupportedError("not a baremetal")
+	}
+	if !self.isRedfishCapable() {
+		return nil, httperrors.NewNotSupportedError("BMC of host %s not support redfish", self.Name)
+	}
+	if !utils.IsInStringArray(self.Status, []string{api.BAREMETAL_READY, api.BAREMETAL_RUNNING, api.BAREMETAL_BIOS_SETTINGS_FAIL}) {
+		return nil, httperrors.NewInvalidStatusError("Cannot do bios-settings in status %s", self.Status)
+	}
+	if input.Attributes == nil || input.Attributes.Length() == 0 {
+		return nil, httperrors.NewMissingParameterError("attributes")
+	}
+	if input.Reboot && self.Status == api.BAREMETAL_RUNNING && !input.ForceReboot {
+		return nil, httperrors.NewInputParameterError("host %s is running, reboot requires force_reboot", self.Name)
+	}
+	return nil, self.StartBiosSettingsTask(ctx, userCred, input, "")
+}
+
+func (self *SHost) StartBiosSettingsTask(ctx context.Context, userCred mcclient.TokenCredential, input api.HostBiosSettingsInput, parentTaskId string) error {
+	data := jsonutils.Marshal(input).(*jsonutils.JSONDict)
+	self.SetStatus(userCred, api.BAREMETAL_START_BIOS_SETTINGS, "start bios settings task")
+	if task, err := taskman.TaskManager.NewTask(ctx, "BaremetalBiosSettingsTask", self, userCred, data, parentTaskId, "", nil); err != nil {
+		log.Errorln(err)
+		return err
+	} else {
+		task.ScheduleRun(nil)
+		return nil
+	}
+}
+
+func (self *SHost) AllowPerformFirmwareUpdate(ctx context.Context,
+	userCred mcclient.TokenCredential,
+	query jsonutils.JSONObject,
+	data jsonutils.JSONObject) bool {
+	return db.IsAdminAllowPerform(userCred, self, "firmware-update")
+}
+
+func (self *SHost) PerformFirmwareUpdate(ctx context.Context, userCred mcclient.TokenCredential, query jsonutils.JSONObject, input api.HostFirmwareUpdateInput) (jsonutils.JSONObject, error) {
+	if !self.IsBaremetal {
+		return nil, httperrors.NewNotSupportedError("not a baremetal")
+	}
+	if !self.isRedfishCapable() {
+		return nil, httperrors.NewNotSupportedError("BMC of host %s not support redfish", self.Name)
+	}
+	if !utils.IsInStringArray(self.Status, []string{api.BAREMETAL_READY, api.BAREMETAL_RUNNING, api.BAREMETAL_FIRMWARE_UPDATE_FAIL}) {
+		return nil, httperrors.NewInvalidStatusError("Cannot do firmware-update in status %s", self.Status)
+	}
+	if len(input.ImageUri) == 0 && len(input.Image) == 0 {
+		return nil, httperrors.NewMissingParameterError("image_uri")
+	}
+	if input.Reboot && self.Status == api.BAREMETAL_RUNNING && !input.ForceReboot {
+		return nil, httperrors.NewInputParameterError("host %s is running, reboot requires force_reboot", self.Name)
+	}
+	if len(input.Image) > 0 {
+		image, err := CachedimageManager.getImageInfo(ctx, userCred, input.Image, false)
+		if err != nil {
+			if errors.Cause(err) == sql.ErrNoRows {
+				return nil, httperrors.NewResourceNotFoundError2("image", input.Image)
+			}
+			return nil, httperrors.NewGeneralError(err)
+		}
+		if image.Status != cloudprovider.IMAGE_STATUS_ACTIVE {
+			return nil, httperrors.NewInvalidStatusError("Image status is not active")
+		}
+		input.ImageId = image.Id
+		input.ImageFormat = image.DiskFormat
+		input.ImageChecksum = image.Checksum
+	}
+	return nil, self.StartFirmwareUpdateTask(ctx, userCred, input, "")
+}
+
+func (self *SHost) StartFirmwareUpdateTask(ctx context.Context, userCred mcclient.TokenCredential, input api.HostFirmwareUpdateInput, parentTaskId string) error {
+	data := jsonutils.Marshal(input).(*jsonutils.JSONDict)
+	self.SetStatus(userCred, api.BAREMETAL_START_FIRMWARE_UPDATE, "start firmware update task")
+	if task, err := taskman.TaskManager.NewTask(ctx, "BaremetalFirmwareUpdateTask", self, userCred, data, parentTaskId, "", nil); err != nil {
+		log.Errorln(err)
+		return err
+	} else {
+		task.ScheduleRun(nil)
+		return nil
+	}
+}
+
 func (self *SHost) AllowPerformSyncConfig(ctx context.Context,
 	userCred mcclient.TokenCredential,
 	query jsonutils.JSONObject,
@@ -29,6 +29,7 @@ import (
 	api "yunion.io/x/onecloud/pkg/apis/compute"
 	"yunion.io/x/onecloud/pkg/cloudcommon/consts"
 	"yunion.io/x/onecloud/pkg/cloudcommon/db"
+	"yunion.io/x/onecloud/pkg/cloudcommon/db/lockman"
 	"yunion.io/x/onecloud/pkg/httperrors"
 	"yunion.io/x/onecloud/pkg/mcclient"
 	"yunion.io/x/onecloud/pkg/util/rbacutils"
@@ -95,6 +96,12 @@ type SIsolatedDevice struct {
 
 	VendorDeviceId string `width:"16" charset:"ascii" nullable:"true" list:"domain" create:"domain_optional"`
 
+	// SR-IOV VF所属二层网络Id
+	WireId string `width:"36" charset:"ascii" nullable:"true" index:"true" list:"domain" update:"domain" create:"domain_optional"`
+
+	// SR-IOV VF绑定的云主机网卡序号, -1表示未绑定
+	NetworkIndex int `nullable:"true" default:"-1" list:"domain"`
+
 	// reserved memory size for isolated device, default 8G
 	ReservedMemory int `nullable:"true" default:"8192" list:"domain" update:"domain" create:"domain_optional"`
 
@@ -152,6 +159,18 @@ func (manager *SIsolatedDeviceManager) ValidateCreateData(ctx context.Context,
 	if input.ReservedStorage != nil && *input.ReservedStorage < 0 {
 		return input, httperrors.NewInputParameterError("reserved storage must >= 0")
 	}
+
+	if input.DevType == api.NIC_VF_TYPE {
+		if len(input.WireId) == 0 {
+			return input, httperrors.NewMissingParameterError("wire_id")
+		}
+		_, input.WireResourceInput, err = ValidateWireResourceInput(userCred, input.WireResourceInput)
+		if err != nil {
+			return input, errors.Wrap(err, "ValidateWireResourceInput")
+		}
+	} else {
+		input.WireId = ""
+	}
 	return input, nil
 }
 
@@ -178,6 +197,19 @@ func (self *SIsolatedDevice) ValidateUpdateData(
 	if input.ReservedStorage != nil && *input.ReservedStorage < 0 {
 		return input, httperrors.NewInputParameterError("reserved storage must >= 0")
 	}
+	if len(input.WireId) > 0 {
+		if self.DevType != api.NIC_VF_TYPE {
+			return input, httperrors.NewInputParameterError("wire_id is only valid for %s", api.NIC_VF_TYPE)
+		}
+		var wire *SWire
+		wire, input.WireResourceInput, err = ValidateWireResourceInput(userCred, input.WireResourceInput)
+		if err != nil {
+			return input, errors.Wrap(err, "ValidateWireResourceInput")
+		}
+		if wire.Id != self.WireId && len(self.GuestId) > 0 {
+			return input, httperrors.NewInvalidStatusError("cannot change wire of %s attached to guest", self.Name)
+		}
+	}
 	return input, nil
 }
 
@@ -497,6 +529,72 @@ func (manager *SIsolatedDeviceManager) findHostUnusedByModel(model string, hostI
 	return devs, nil
 }
 
+func (manager *SIsolatedDeviceManager) findHostUnusedSRIOVNics(hostId string, wireId string) ([]SIsolatedDevice, error) {
+	devs := make([]SIsolatedDevice, 0)
+	q := manager.findUnusedQuery()
+	q = q.Equals("dev_type", api.NIC_VF_TYPE).Equals("host_id", hostId).Equals("wire_id", wireId)
+	err := db.FetchModelObjects(manager, q, &devs)
+	if err != nil {
+		return nil, err
+	}
+	return devs, nil
+}
+
+// attachSRIOVNicToGuestnetwork binds a free SR-IOV VF of the wire of guest network to guest nic
+func (manager *SIsolatedDeviceManager) attachSRIOVNicToGuestnetwork(ctx context.Context, userCred mcclient.TokenCredential, guest *SGuest, gn *SGuestnetwork) error {
+	lockman.LockClass(ctx, manager, guest.HostId)
+	defer lockman.ReleaseClass(ctx, manager, guest.HostId)
+
+	network := gn.GetNetwork()
+	if network == nil {
+		return errors.Wrapf(errors.ErrNotFound, "network of guest nic %d", gn.Index)
+	}
+	devs, err := manager.findHostUnusedSRIOVNics(guest.HostId, network.WireId)
+	if err != nil {
+		return errors.Wrap(err, "findHostUnusedSRIOVNics")
+	}
+	if len(devs) == 0 {
+		return httperrors.NewInsufficientResourceError("no free SR-IOV VF of wire %s on host %s", network.WireId, guest.HostId)
+	}
+	dev := &devs[0]
+	_, err = db.Update(dev, func() error {
+		dev.GuestId = guest.Id
+		dev.NetworkIndex = int(gn.Index)
+		return nil
+	})
+	if err != nil {
+		return errors.Wrap(err, "db.Update")
+	}
+	db.OpsLog.LogEvent(guest, db.ACT_GUEST_ATTACH_ISOLATED_DEVICE, dev.GetShortDesc(ctx), userCred)
+	HostManager.ClearSchedDescCache(guest.HostId)
+	return nil
+}
+
+func (manager *SIsolatedDeviceManager) releaseSRIOVNicOfGuestnetwork(ctx context.Context, userCred mcclient.TokenCredential, guest *SGuest, index int8) error {
+	devs := make([]SIsolatedDevice, 0)
+	q := manager.Query().Equals("guest_id", guest.Id).Equals("dev_type", api.NIC_VF_TYPE).Equals("network_index", index)
+	err := db.FetchModelObjects(manager, q, &devs)
+	if err != nil {
+		return errors.Wrap(err, "db.FetchModelObjects")
+	}
+	for i := range devs {
+		dev := &devs[i]
+		_, err := db.Update(dev, func() error {
+			dev.GuestId = ""
+			dev.NetworkIndex = -1
+			return nil
+		})
+		if err != nil {
+			return errors.Wrap(err, "db.Update")
+		}
+		db.OpsLog.LogEvent(guest, db.ACT_GUEST_DETACH_ISOLATED_DEVICE, dev.GetShortDesc(ctx), userCred)
+	}
+	if len(devs) > 0 {
+		HostManager.ClearSchedDescCache(guest.HostId)
+	}
+	return nil
+}
+
 func (manager *SIsolatedDeviceManager) ReleaseDevicesOfGuest(ctx context.Context, guest *SGuest, userCred mcclient.TokenCredential) error {
 	devs := manager.findAttachedDevicesOfGuest(guest)
 	if devs == nil {
@@ -505,6 +603,7 @@ func (manager *SIsolatedDeviceManager) ReleaseDevicesOfGuest(ctx context.Context
 	for _, dev := range devs {
 		_, err := db.Update(&dev, func() error {
 			dev.GuestId = ""
+			dev.NetworkIndex = -1
 			return nil
 		})
 		if err != nil {
@@ -593,6 +692,10 @@ func (self *SIsolatedDevice) getDesc() *jsonutils.JSONDict {
 	desc.Add(jsonutils.NewString(self.Addr), "addr")
 	desc.Add(jsonutils.NewString(self.VendorDeviceId), "vendor_device_id")
 	desc.Add(jsonutils.NewString(self.getVendor()), "vendor")
+	if self.DevType == api.NIC_VF_TYPE {
+		desc.Add(jsonutils.NewString(self.WireId), "wire_id")
+		desc.Add(jsonutils.NewInt(int64(self.NetworkIndex)), "network_index")
+	}
 	return desc
 }
 
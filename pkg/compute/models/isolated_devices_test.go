@@ -0,0 +1,37 @@
+// Copyright 2019 Yunion
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package models
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	api "yunion.io/x/onecloud/pkg/apis/compute"
+)
+
+func TestIsolatedDeviceWireIdUpdate(t *testing.T) {
+	field, _ := reflect.TypeOf(SIsolatedDevice{}).FieldByName("WireId")
+	if tag := field.Tag.Get("update"); tag != "domain" {
+		t.Errorf("wire_id of isolated device should be updatable by domain, got %q", tag)
+	}
+
+	dev := &SIsolatedDevice{DevType: api.GPU_HPC_TYPE}
+	input := api.IsolatedDeviceUpdateInput{}
+	input.WireId = "wire1"
+	if _, err := dev.ValidateUpdateData(context.Background(), nil, nil, input); err == nil {
+		t.Errorf("wire_id of %s device should be rejected", dev.DevType)
+	}
+}
@@ -61,6 +61,7 @@ import (
 	"yunion.io/x/onecloud/pkg/mcclient/auth"
 	"yunion.io/x/onecloud/pkg/util/billing"
 	"yunion.io/x/onecloud/pkg/util/logclient"
+	"yunion.io/x/onecloud/pkg/util/netutils2"
 	"yunion.io/x/onecloud/pkg/util/rand"
 	"yunion.io/x/onecloud/pkg/util/rbacutils"
 	"yunion.io/x/onecloud/pkg/util/stringutils2"
@@ -116,13 +117,18 @@ type SNetwork struct {
 
 	GuestDomain string `width:"128" charset:"ascii" nullable:"true" get:"user" update:"user"`
 
-	GuestIp6Start string `width:"64" charset:"ascii" nullable:"true"`
-	GuestIp6End   string `width:"64" charset:"ascii" nullable:"true"`
-	GuestIp6Mask  int8   `nullable:"true"`
-	GuestGateway6 string `width:"64" charset:"ascii" nullable:"true"`
-	GuestDns6     string `width:"64" charset:"ascii" nullable:"true"`
+	// IPv6起始地址
+	GuestIp6Start string `width:"64" charset:"ascii" nullable:"true" list:"user" update:"user" create:"optional"`
+	// IPv6结束地址
+	GuestIp6End string `width:"64" charset:"ascii" nullable:"true" list:"user" update:"user" create:"optional"`
+	// IPv6前缀长度
+	GuestIp6Mask int8 `nullable:"true" list:"user" update:"user" create:"optional"`
+	// IPv6网关地址
+	GuestGateway6 string `width:"64" charset:"ascii" nullable:"true" list:"user" update:"user" create:"optional"`
+	// IPv6 DNS
+	GuestDns6 string `width:"64" charset:"ascii" nullable:"true" list:"user" update:"user" create:"optional"`
 
-	GuestDomain6 string `width:"128" charset:"ascii" nullable:"true"`
+	GuestDomain6 string `width:"128" charset:"ascii" nullable:"true" get:"user" update:"user"`
 
 	VlanId int `nullable:"false" default:"1" list:"user" update:"user" create:"optional"`
 
@@ -1132,6 +1138,19 @@ func isValidNetworkInfo(userCred mcclient.TokenCredential, netConfig *api.Networ
 				}
 			}
 		}
+		if len(netConfig.Address6) > 0 {
+			addr6, err := netutils2.NormalizeIPV6(netConfig.Address6)
+			if err != nil {
+				return httperrors.NewInputParameterError("Invalid ipv6 address %s", netConfig.Address6)
+			}
+			if !net.IsAddress6InRange(addr6) {
+				return httperrors.NewInputParameterError("Address %s not in range", netConfig.Address6)
+			}
+			if _, ok := net.GetUsedAddresses6()[addr6]; ok {
+				return httperrors.NewInputParameterError("Address %s has been used", netConfig.Address6)
+			}
+			netConfig.Address6 = addr6
+		}
 		if netConfig.BwLimit > api.MAX_BANDWIDTH {
 			return httperrors.NewInputParameterError("Bandwidth limit cannot exceed %dMbps", api.MAX_BANDWIDTH)
 		}
@@ -1661,6 +1680,37 @@ func (manager *SNetworkManager) ValidateCreateData(ctx context.Context, userCred
 		}
 	}
 
+	if len(input.GuestIp6Prefix) > 0 || len(input.GuestIp6Start) > 0 || len(input.GuestIp6End) > 0 {
+		if region.Provider != api.CLOUD_PROVIDER_ONECLOUD {
+			return input, httperrors.NewNotSupportedError("ipv6 is only supported by on-premise networks")
+		}
+		v6, err := validateIPv6Input(sNetworkIPv6Input{
+			prefix:  input.GuestIp6Prefix,
+			start:   input.GuestIp6Start,
+			end:     input.GuestIp6End,
+			masklen: input.GuestIp6Mask,
+			gateway: input.GuestGateway6,
+			dns:     input.GuestDns6,
+		}, vpc.Id != api.DEFAULT_VPC_ID)
+		if err != nil {
+			return input, err
+		}
+		nets, err := vpc.GetNetworks()
+		if err != nil {
+			return input, httperrors.NewInternalServerError("fail to GetNetworks of vpc: %v", err)
+		}
+		iprange, _ := netutils2.NewIPV6AddrRange(v6.start, v6.end)
+		if isOverlapNetworks6(nets, iprange) {
+			return input, httperrors.NewInputParameterError("Conflict ipv6 address space with existing networks in vpc %q", vpc.GetName())
+		}
+		input.GuestIp6Prefix = v6.prefix
+		input.GuestIp6Start = v6.start
+		input.GuestIp6End = v6.end
+		input.GuestIp6Mask = v6.masklen
+		input.GuestGateway6 = v6.gateway
+		input.GuestDns6 = v6.dns
+	}
+
 	input.GuestIpStart = ipStart.String()
 	input.GuestIpEnd = ipEnd.String()
 	input.SharableVirtualResourceCreateInput, err = manager.SSharableVirtualResourceBaseManager.ValidateCreateData(ctx, userCred, ownerId, query, input.SharableVirtualResourceCreateInput)
@@ -1777,6 +1827,54 @@ func (self *SNetwork) validateUpdateData(ctx context.Context, userCred mcclient.
 		}
 	}
 
+	if len(input.GuestIp6Start) > 0 || len(input.GuestIp6End) > 0 || input.GuestIp6Mask != nil || len(input.GuestGateway6) > 0 || len(input.GuestDns6) > 0 {
+		v6 := sNetworkIPv6Input{
+			start:   self.GuestIp6Start,
+			end:     self.GuestIp6End,
+			masklen: self.GuestIp6Mask,
+			gateway: self.GuestGateway6,
+			dns:     self.GuestDns6,
+		}
+		if len(input.GuestIp6Start) > 0 {
+			v6.start = input.GuestIp6Start
+		}
+		if len(input.GuestIp6End) > 0 {
+			v6.end = input.GuestIp6End
+		}
+		if input.GuestIp6Mask != nil {
+			v6.masklen = *input.GuestIp6Mask
+		}
+		if len(input.GuestGateway6) > 0 {
+			v6.gateway = input.GuestGateway6
+		}
+		if len(input.GuestDns6) > 0 {
+			v6.dns = input.GuestDns6
+		}
+		v6, err = validateIPv6Input(v6, false)
+		if err != nil {
+			return input, err
+		}
+		iprange, _ := netutils2.NewIPV6AddrRange(v6.start, v6.end)
+		nets := NetworkManager.getAllNetworks(self.WireId, self.Id)
+		if nets == nil {
+			return input, httperrors.NewInternalServerError("query all networks fail")
+		}
+		if isOverlapNetworks6(nets, iprange) {
+			return input, httperrors.NewInputParameterError("Conflict ipv6 address space with existing networks")
+		}
+		for usedIpStr := range self.GetUsedAddresses6() {
+			usedIp, _ := netutils2.ParseIPV6(usedIpStr)
+			if !iprange.Contains(usedIp) {
+				return input, httperrors.NewInputParameterError("IPv6 address been assigned out of new range")
+			}
+		}
+		input.GuestIp6Start = v6.start
+		input.GuestIp6End = v6.end
+		input.GuestIp6Mask = &v6.masklen
+		input.GuestGateway6 = v6.gateway
+		input.GuestDns6 = v6.dns
+	}
+
 	if input.IsAutoAlloc != nil && *input.IsAutoAlloc {
 		if self.ServerType != api.NETWORK_TYPE_GUEST {
 			return input, httperrors.NewInputParameterError("network server_type %s not support auto alloc", self.ServerType)
@@ -1801,6 +1899,12 @@ func (self *SNetwork) ValidateUpdateData(ctx context.Context, userCred mcclient.
 		input.GuestDns = ""
 		input.GuestDomain = ""
 		input.GuestDhcp = ""
+		input.GuestIp6Start = ""
+		input.GuestIp6End = ""
+		input.GuestIp6Mask = nil
+		input.GuestGateway6 = ""
+		input.GuestDns6 = ""
+		input.GuestDomain6 = ""
 	}
 
 	var err error
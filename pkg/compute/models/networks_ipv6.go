@@ -0,0 +1,260 @@
+// Copyright 2019 Yunion
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package models
+
+import (
+	"net"
+	"strings"
+
+	"yunion.io/x/log"
+	"yunion.io/x/pkg/errors"
+
+	api "yunion.io/x/onecloud/pkg/apis/compute"
+	"yunion.io/x/onecloud/pkg/httperrors"
+	"yunion.io/x/onecloud/pkg/util/netutils2"
+)
+
+// IsSupportIPv6 reports whether the network has an ipv6 address range
+// configured in addition to the ipv4 one
+func (self *SNetwork) IsSupportIPv6() bool {
+	return len(self.GuestIp6Start) > 0 && len(self.GuestIp6End) > 0 && self.GuestIp6Mask > 0
+}
+
+func (self *SNetwork) getIP6Range() (netutils2.IPV6AddrRange, error) {
+	return netutils2.NewIPV6AddrRange(self.GuestIp6Start, self.GuestIp6End)
+}
+
+func (self *SNetwork) GetDNS6() string {
+	return self.GuestDns6
+}
+
+func (self *SNetwork) GetDomain6() string {
+	if len(self.GuestDomain6) > 0 {
+		return self.GuestDomain6
+	}
+	return self.GetDomain()
+}
+
+// GetIP6Prefix returns the ipv6 prefix in cidr notation, e.g. fd00:1::/64
+func (self *SNetwork) GetIP6Prefix() string {
+	if !self.IsSupportIPv6() {
+		return ""
+	}
+	prefix, err := netutils2.IPV6Network(self.GuestIp6Start, int(self.GuestIp6Mask))
+	if err != nil {
+		return ""
+	}
+	return prefix.String()
+}
+
+func (self *SNetwork) GetUsedAddresses6() map[string]bool {
+	used := make(map[string]bool)
+	q := GuestnetworkManager.Query("ip6_addr").Equals("network_id", self.Id).IsNotEmpty("ip6_addr")
+	results, err := q.AllStringMap()
+	if err != nil {
+		log.Errorf("GetUsedAddresses6 fail %s", err)
+		return used
+	}
+	for _, result := range results {
+		used[result["ip6_addr"]] = true
+	}
+	return used
+}
+
+func (self *SNetwork) getFreeIP6(addrTable map[string]bool, candidate string, allocDir api.IPAllocationDirection) (string, error) {
+	iprange, err := self.getIP6Range()
+	if err != nil {
+		return "", errors.Wrap(err, "getIP6Range")
+	}
+	if len(candidate) > 0 {
+		candIP, err := netutils2.ParseIPV6(candidate)
+		if err != nil {
+			return "", httperrors.NewInputParameterError("invalid ipv6 address %s", candidate)
+		}
+		if !iprange.Contains(candIP) {
+			return "", httperrors.NewInputParameterError("candidate %s out of range", candidate)
+		}
+		if _, ok := addrTable[candIP.String()]; !ok {
+			return candIP.String(), nil
+		}
+	}
+	if len(self.AllocPolicy) > 0 && api.IPAllocationDirection(self.AllocPolicy) != api.IPAllocationNone {
+		allocDir = api.IPAllocationDirection(self.AllocPolicy)
+	}
+	// ipv6 ranges are usually too large to be walked address by address,
+	// so the number of probes is bounded by the number of used addresses
+	maxTries := len(addrTable) + 1
+	if len(allocDir) == 0 || allocDir == api.IPAllocationStepdown {
+		ip := iprange.EndIp()
+		for i := 0; i < maxTries && iprange.Contains(ip); i++ {
+			if !isIpUsed(ip.String(), addrTable, nil) {
+				return ip.String(), nil
+			}
+			ip = netutils2.IPV6StepDown(ip)
+		}
+	} else {
+		if allocDir == api.IPAllocationRadnom {
+			const MAX_TRIES = 5
+			for i := 0; i < MAX_TRIES; i += 1 {
+				ip := iprange.Random()
+				if !isIpUsed(ip.String(), addrTable, nil) {
+					return ip.String(), nil
+				}
+			}
+		}
+		ip := iprange.StartIp()
+		for i := 0; i < maxTries && iprange.Contains(ip); i++ {
+			if !isIpUsed(ip.String(), addrTable, nil) {
+				return ip.String(), nil
+			}
+			ip = netutils2.IPV6StepUp(ip)
+		}
+	}
+	return "", httperrors.NewInsufficientResourceError("Out of IPv6 address")
+}
+
+// GetFreeIP6 allocates an ipv6 address, the caller should hold the network lock
+func (self *SNetwork) GetFreeIP6(addrTable map[string]bool, candidate string, allocDir api.IPAllocationDirection) (string, error) {
+	if !self.IsSupportIPv6() {
+		return "", errors.Wrapf(httperrors.ErrNotSupported, "network %s has no ipv6 range", self.Name)
+	}
+	if addrTable == nil {
+		addrTable = self.GetUsedAddresses6()
+	}
+	return self.getFreeIP6(addrTable, candidate, allocDir)
+}
+
+func (self *SNetwork) IsAddress6InRange(addr string) bool {
+	if !self.IsSupportIPv6() {
+		return false
+	}
+	ip, err := netutils2.ParseIPV6(addr)
+	if err != nil {
+		return false
+	}
+	iprange, err := self.getIP6Range()
+	if err != nil {
+		return false
+	}
+	return iprange.Contains(ip)
+}
+
+type sNetworkIPv6Input struct {
+	prefix  string
+	start   string
+	end     string
+	masklen int8
+	gateway string
+	dns     string
+}
+
+// validateIPv6Input checks the ipv6 settings of a network. The range can be
+// given either as a prefix or as start, end and mask. When vpcReserve is set,
+// the first address of the prefix is taken as gateway as it is done for
+// ipv4 addresses of onecloud vpc networks.
+func validateIPv6Input(input sNetworkIPv6Input, vpcReserve bool) (sNetworkIPv6Input, error) {
+	var (
+		start, end net.IP
+		err        error
+		prefix     *net.IPNet
+	)
+	if len(input.prefix) > 0 {
+		ip, ipnet, err := net.ParseCIDR(input.prefix)
+		if err != nil || ip.To4() != nil {
+			return input, httperrors.NewInputParameterError("invalid guest_ip6_prefix %s", input.prefix)
+		}
+		ones, _ := ipnet.Mask.Size()
+		input.masklen = int8(ones)
+		prefix = ipnet
+	}
+	if input.masklen < netutils2.IPV6_MIN_PREFIX_LEN || input.masklen > netutils2.IPV6_MAX_PREFIX_LEN {
+		return input, httperrors.NewInputParameterError("ipv6 prefix length should be between %d and %d", netutils2.IPV6_MIN_PREFIX_LEN, netutils2.IPV6_MAX_PREFIX_LEN)
+	}
+	if prefix != nil {
+		// skip the subnet-router anycast address
+		start = netutils2.IPV6StepUp(prefix.IP)
+		end = make(net.IP, net.IPv6len)
+		for i := range end {
+			end[i] = prefix.IP[i] | ^prefix.Mask[i]
+		}
+	} else {
+		start, err = netutils2.ParseIPV6(input.start)
+		if err != nil {
+			return input, httperrors.NewInputParameterError("invalid guest_ip6_start %s", input.start)
+		}
+		end, err = netutils2.ParseIPV6(input.end)
+		if err != nil {
+			return input, httperrors.NewInputParameterError("invalid guest_ip6_end %s", input.end)
+		}
+		prefix, _ = netutils2.IPV6Network(start.String(), int(input.masklen))
+		if !prefix.Contains(end) {
+			return input, httperrors.NewInputParameterError("ipv6 start and end address not in the same subnet")
+		}
+	}
+	if vpcReserve {
+		// reserve the 1st addr as gateway
+		gw := netutils2.IPV6StepUp(prefix.IP)
+		input.gateway = gw.String()
+		reserved, _ := netutils2.NewIPV6AddrRange(prefix.IP.String(), gw.String())
+		if reserved.Contains(start) {
+			start = netutils2.IPV6StepUp(gw)
+		}
+	}
+	if len(input.gateway) > 0 {
+		gw, err := netutils2.ParseIPV6(input.gateway)
+		if err != nil {
+			return input, httperrors.NewInputParameterError("invalid guest_gateway6 %s", input.gateway)
+		}
+		if !prefix.Contains(gw) {
+			return input, httperrors.NewInputParameterError("ipv6 gateway must be in the same subnet as start, end ip")
+		}
+		input.gateway = gw.String()
+	}
+	if len(input.dns) > 0 {
+		dnsList := strings.Split(input.dns, ",")
+		for i := range dnsList {
+			dns, err := netutils2.ParseIPV6(strings.TrimSpace(dnsList[i]))
+			if err != nil {
+				return input, httperrors.NewInputParameterError("invalid guest_dns6 %s", dnsList[i])
+			}
+			dnsList[i] = dns.String()
+		}
+		input.dns = strings.Join(dnsList, ",")
+	}
+	iprange, err := netutils2.NewIPV6AddrRange(start.String(), end.String())
+	if err != nil {
+		return input, httperrors.NewInputParameterError("invalid ipv6 range: %v", err)
+	}
+	input.start = iprange.StartIp().String()
+	input.end = iprange.EndIp().String()
+	input.prefix = prefix.String()
+	return input, nil
+}
+
+func isOverlapNetworks6(nets []SNetwork, iprange netutils2.IPV6AddrRange) bool {
+	for i := range nets {
+		if !nets[i].IsSupportIPv6() {
+			continue
+		}
+		iprange2, err := nets[i].getIP6Range()
+		if err != nil {
+			continue
+		}
+		if iprange2.IsOverlap(iprange) {
+			return true
+		}
+	}
+	return false
+}
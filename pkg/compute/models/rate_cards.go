@@ -0,0 +1,250 @@
+// Copyright 2019 Yunion
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package models
+
+import (
+	"context"
+
+	"yunion.io/x/jsonutils"
+	"yunion.io/x/pkg/errors"
+	"yunion.io/x/pkg/utils"
+	"yunion.io/x/sqlchemy"
+
+	api "yunion.io/x/onecloud/pkg/apis/compute"
+	"yunion.io/x/onecloud/pkg/cloudcommon/db"
+	"yunion.io/x/onecloud/pkg/httperrors"
+	"yunion.io/x/onecloud/pkg/mcclient"
+	"yunion.io/x/onecloud/pkg/util/stringutils2"
+)
+
+// +onecloud:swagger-gen-model-singular=ratecard
+// +onecloud:swagger-gen-model-plural=ratecards
+type SRateCardManager struct {
+	db.SEnabledStatusStandaloneResourceBaseManager
+	SZoneResourceBaseManager
+}
+
+var RateCardManager *SRateCardManager
+
+func init() {
+	RateCardManager = &SRateCardManager{
+		SEnabledStatusStandaloneResourceBaseManager: db.NewEnabledStatusStandaloneResourceBaseManager(
+			SRateCard{},
+			"ratecards_tbl",
+			"ratecard",
+			"ratecards",
+		),
+	}
+	RateCardManager.SetVirtualObject(RateCardManager)
+}
+
+// SRateCard defines the hourly price of one unit of an on-premise resource.
+// An empty ZoneId or Spec matches any zone or spec, the most specific card wins.
+type SRateCard struct {
+	db.SEnabledStatusStandaloneResourceBase
+	SZoneResourceBase
+
+	// 资源类型
+	ResourceType string `width:"16" charset:"ascii" nullable:"false" list:"user" create:"admin_required" index:"true"`
+	// 规格
+	Spec string `width:"64" charset:"utf8" nullable:"false" default:"" list:"user" create:"admin_optional"`
+	// 每计量单位每小时价格
+	Price float64 `nullable:"false" default:"0" list:"user" create:"admin_required" update:"admin"`
+	// 货币
+	Currency string `width:"8" charset:"ascii" nullable:"false" list:"user" create:"admin_optional" update:"admin"`
+}
+
+func (manager *SRateCardManager) ValidateCreateData(
+	ctx context.Context,
+	userCred mcclient.TokenCredential,
+	ownerId mcclient.IIdentityProvider,
+	query jsonutils.JSONObject,
+	input api.RateCardCreateInput,
+) (api.RateCardCreateInput, error) {
+	var err error
+	if !utils.IsInStringArray(input.ResourceType, api.USAGE_RESOURCE_TYPES) {
+		return input, httperrors.NewInputParameterError("invalid resource_type %q, must be one of %s", input.ResourceType, api.USAGE_RESOURCE_TYPES)
+	}
+	if input.Price < 0 {
+		return input, httperrors.NewInputParameterError("price must not be negative")
+	}
+	if len(input.ZoneId) > 0 {
+		_, input.ZoneResourceInput, err = ValidateZoneResourceInput(userCred, input.ZoneResourceInput)
+		if err != nil {
+			return input, errors.Wrap(err, "ValidateZoneResourceInput")
+		}
+	}
+	if len(input.Currency) == 0 {
+		input.Currency = api.USAGE_RECORD_DEFAULT_CURRENCY
+	}
+	cnt, err := manager.Query().Equals("resource_type", input.ResourceType).
+		Equals("zone_id", input.ZoneId).Equals("spec", input.Spec).CountWithError()
+	if err != nil {
+		return input, errors.Wrap(err, "CountWithError")
+	}
+	if cnt > 0 {
+		return input, httperrors.NewDuplicateResourceError("rate card of %s zone %q spec %q already exists", input.ResourceType, input.ZoneId, input.Spec)
+	}
+	input.SetEnabled()
+	input.EnabledStatusStandaloneResourceCreateInput, err = manager.SEnabledStatusStandaloneResourceBaseManager.ValidateCreateData(ctx, userCred, ownerId, query, input.EnabledStatusStandaloneResourceCreateInput)
+	if err != nil {
+		return input, err
+	}
+	return input, nil
+}
+
+func (self *SRateCard) ValidateUpdateData(ctx context.Context, userCred mcclient.TokenCredential, query jsonutils.JSONObject, input api.RateCardUpdateInput) (api.RateCardUpdateInput, error) {
+	var err error
+	if input.Price != nil && *input.Price < 0 {
+		return input, httperrors.NewInputParameterError("price must not be negative")
+	}
+	input.EnabledStatusStandaloneResourceBaseUpdateInput, err = self.SEnabledStatusStandaloneResourceBase.ValidateUpdateData(ctx, userCred, query, input.EnabledStatusStandaloneResourceBaseUpdateInput)
+	if err != nil {
+		return input, errors.Wrap(err, "SEnabledStatusStandaloneResourceBase.ValidateUpdateData")
+	}
+	return input, nil
+}
+
+// 计费价格列表
+func (manager *SRateCardManager) ListItemFilter(
+	ctx context.Context,
+	q *sqlchemy.SQuery,
+	userCred mcclient.TokenCredential,
+	query api.RateCardListInput,
+) (*sqlchemy.SQuery, error) {
+	var err error
+	q, err = manager.SEnabledStatusStandaloneResourceBaseManager.ListItemFilter(ctx, q, userCred, query.EnabledStatusStandaloneResourceListInput)
+	if err != nil {
+		return nil, errors.Wrap(err, "SEnabledStatusStandaloneResourceBaseManager.ListItemFilter")
+	}
+	if len(query.ZoneId) > 0 || len(query.ZoneIds) > 0 || len(query.CloudregionId) > 0 {
+		q, err = manager.SZoneResourceBaseManager.ListItemFilter(ctx, q, userCred, query.ZonalFilterListInput)
+		if err != nil {
+			return nil, errors.Wrap(err, "SZoneResourceBaseManager.ListItemFilter")
+		}
+	}
+	if len(query.ResourceType) > 0 {
+		q = q.In("resource_type", query.ResourceType)
+	}
+	if len(query.Spec) > 0 {
+		q = q.In("spec", query.Spec)
+	}
+	return q, nil
+}
+
+func (manager *SRateCardManager) OrderByExtraFields(
+	ctx context.Context,
+	q *sqlchemy.SQuery,
+	userCred mcclient.TokenCredential,
+	query api.RateCardListInput,
+) (*sqlchemy.SQuery, error) {
+	var err error
+	q, err = manager.SEnabledStatusStandaloneResourceBaseManager.OrderByExtraFields(ctx, q, userCred, query.EnabledStatusStandaloneResourceListInput)
+	if err != nil {
+		return nil, errors.Wrap(err, "SEnabledStatusStandaloneResourceBaseManager.OrderByExtraFields")
+	}
+	q, err = manager.SZoneResourceBaseManager.OrderByExtraFields(ctx, q, userCred, query.ZonalFilterListInput)
+	if err != nil {
+		return nil, errors.Wrap(err, "SZoneResourceBaseManager.OrderByExtraFields")
+	}
+	return q, nil
+}
+
+func (manager *SRateCardManager) QueryDistinctExtraField(q *sqlchemy.SQuery, field string) (*sqlchemy.SQuery, error) {
+	var err error
+	q, err = manager.SEnabledStatusStandaloneResourceBaseManager.QueryDistinctExtraField(q, field)
+	if err == nil {
+		return q, nil
+	}
+	q, err = manager.SZoneResourceBaseManager.QueryDistinctExtraField(q, field)
+	if err == nil {
+		return q, nil
+	}
+	return q, httperrors.ErrNotFound
+}
+
+func (manager *SRateCardManager) FetchCustomizeColumns(
+	ctx context.Context,
+	userCred mcclient.TokenCredential,
+	query jsonutils.JSONObject,
+	objs []interface{},
+	fields stringutils2.SSortedStrings,
+	isList bool,
+) []api.RateCardDetails {
+	rows := make([]api.RateCardDetails, len(objs))
+	stdRows := manager.SEnabledStatusStandaloneResourceBaseManager.FetchCustomizeColumns(ctx, userCred, query, objs, fields, isList)
+	zoneRows := manager.SZoneResourceBaseManager.FetchCustomizeColumns(ctx, userCred, query, objs, fields, isList)
+	for i := range rows {
+		rows[i] = api.RateCardDetails{
+			EnabledStatusStandaloneResourceDetails: stdRows[i],
+			ZoneResourceInfo:                       zoneRows[i],
+		}
+	}
+	return rows
+}
+
+func (manager *SRateCardManager) ListItemExportKeys(ctx context.Context, q *sqlchemy.SQuery, userCred mcclient.TokenCredential, keys stringutils2.SSortedStrings) (*sqlchemy.SQuery, error) {
+	q, err := manager.SEnabledStatusStandaloneResourceBaseManager.ListItemExportKeys(ctx, q, userCred, keys)
+	if err != nil {
+		return nil, errors.Wrap(err, "SEnabledStatusStandaloneResourceBaseManager.ListItemExportKeys")
+	}
+	if keys.ContainsAny(manager.SZoneResourceBaseManager.GetExportKeys()...) {
+		q, err = manager.SZoneResourceBaseManager.ListItemExportKeys(ctx, q, userCred, keys)
+		if err != nil {
+			return nil, errors.Wrap(err, "SZoneResourceBaseManager.ListItemExportKeys")
+		}
+	}
+	return q, nil
+}
+
+func (manager *SRateCardManager) fetchEnabledRateCards() ([]SRateCard, error) {
+	q := manager.Query().IsTrue("enabled")
+	cards := []SRateCard{}
+	err := db.FetchModelObjects(manager, q, &cards)
+	if err != nil {
+		return nil, errors.Wrap(err, "db.FetchModelObjects")
+	}
+	return cards, nil
+}
+
+// matchRateCard returns the most specific enabled card of resource type,
+// a card matching the spec takes precedence over one matching the zone
+func matchRateCard(cards []SRateCard, resType, zoneId, spec string) *SRateCard {
+	var match *SRateCard
+	score := -1
+	for i := range cards {
+		card := &cards[i]
+		if card.ResourceType != resType {
+			continue
+		}
+		if len(card.ZoneId) > 0 && card.ZoneId != zoneId {
+			continue
+		}
+		if len(card.Spec) > 0 && card.Spec != spec {
+			continue
+		}
+		s := 0
+		if len(card.Spec) > 0 {
+			s += 2
+		}
+		if len(card.ZoneId) > 0 {
+			s += 1
+		}
+		if s > score {
+			match, score = card, s
+		}
+	}
+	return match
+}
@@ -686,6 +686,45 @@ func (sg *SScalingGroup) PerformDisable(ctx context.Context, userCred mcclient.T
 	return nil, nil
 }
 
+// sScalingDesiredCapacity 直接设置伸缩组的期望实例数, 用于定时任务等外部调用
+type sScalingDesiredCapacity struct {
+	capacity int
+}
+
+func (sdc sScalingDesiredCapacity) Exec(int) int {
+	return sdc.capacity
+}
+
+func (sdc sScalingDesiredCapacity) CheckCoolTime() bool {
+	return false
+}
+
+func (sdc sScalingDesiredCapacity) TriggerDescription() string {
+	return fmt.Sprintf(`A user request to set the Desired Instance Number to "%d"`, sdc.capacity)
+}
+
+func (sg *SScalingGroup) AllowPerformSetDesiredCapacity(ctx context.Context, userCred mcclient.TokenCredential,
+	query jsonutils.JSONObject, input api.ScalingGroupSetDesiredCapacityInput) bool {
+	return sg.IsOwner(userCred) || db.IsAdminAllowPerform(userCred, sg, "set-desired-capacity")
+}
+
+func (sg *SScalingGroup) PerformSetDesiredCapacity(ctx context.Context, userCred mcclient.TokenCredential,
+	query jsonutils.JSONObject, input api.ScalingGroupSetDesiredCapacityInput) (jsonutils.JSONObject, error) {
+	if sg.Enabled.IsFalse() {
+		return nil, httperrors.NewInvalidStatusError("ScalingGroup %s is disabled", sg.Name)
+	}
+	if input.DesiredCapacity < sg.MinInstanceNumber || input.DesiredCapacity > sg.MaxInstanceNumber {
+		return nil, httperrors.NewInputParameterError("desired_capacity should between min_instance_number %d and max_instance_number %d",
+			sg.MinInstanceNumber, sg.MaxInstanceNumber)
+	}
+	action := sScalingDesiredCapacity{input.DesiredCapacity}
+	err := sg.Scale(ctx, action, action, 0)
+	if err != nil {
+		return nil, errors.Wrap(err, "ScalingGroup.Scale")
+	}
+	return nil, nil
+}
+
 func (s *SGuest) AllowPerformDetachScalingGroup(ctx context.Context, userCred mcclient.TokenCredential,
 	query jsonutils.JSONObject, input api.SGPerformDetachScalingGroupInput) bool {
 	return s.IsOwner(userCred) || db.IsAdminAllowPerform(userCred, s, "detach-scaling-group")
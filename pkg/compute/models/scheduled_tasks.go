@@ -16,7 +16,9 @@ package models
 
 import (
 	"context"
+	"database/sql"
 	"fmt"
+	"sort"
 	"strings"
 	"time"
 
@@ -75,7 +77,10 @@ type SScheduledTask struct {
 
 	ResourceType string `width:"32" charset:"ascii" create:"required" list:"user" get:"user"`
 	Operation    string `width:"32" charset:"ascii" create:"required" list:"user" get:"user"`
-	LabelType    string `width:"4" charset:"ascii" create:"required" list:"user" get:"user"`
+	LabelType    string `width:"16" charset:"ascii" create:"required" list:"user" get:"user"`
+
+	// 调用资源操作时传入的参数
+	OperationParams *jsonutils.JSONDict `list:"user" get:"user" create:"optional"`
 }
 
 func (stm *SScheduledTaskManager) ListItemFilter(ctx context.Context, q *sqlchemy.SQuery, userCred mcclient.TokenCredential, input api.ScheduledTaskListInput) (*sqlchemy.SQuery, error) {
@@ -164,15 +169,25 @@ func (stm *SScheduledTaskManager) ValidateCreateData(ctx context.Context, userCr
 	if !utils.IsInStringArray(input.ScheduledType, []string{api.ST_TYPE_TIMING, api.ST_TYPE_CYCLE}) {
 		return input, httperrors.NewInputParameterError("unkown scheduled type '%s'", input.ScheduledType)
 	}
-	if !utils.IsInStringArray(input.ResourceType, []string{api.ST_RESOURCE_SERVER}) {
+	if !utils.IsInStringArray(input.ResourceType, api.ST_RESOURCE_TYPES) {
 		return input, httperrors.NewInputParameterError("unkown resource type '%s'", input.ResourceType)
 	}
-	if !utils.IsInStringArray(input.Operation, []string{api.ST_RESOURCE_OPERATION_RESTART, api.ST_RESOURCE_OPERATION_STOP, api.ST_RESOURCE_OPERATION_START}) {
-		return input, httperrors.NewInputParameterError("unkown resource operation '%s'", input.Operation)
+	oper, ok := ResourceOperationMap[fmt.Sprintf("%s.%s", input.ResourceType, input.Operation)]
+	if !ok {
+		return input, httperrors.NewInputParameterError("unkown operation '%s' for resource type '%s'", input.Operation, input.ResourceType)
+	}
+	for _, key := range oper.RequiredParams {
+		if input.OperationParams == nil || !input.OperationParams.Contains(key) {
+			return input, httperrors.NewMissingParameterError(fmt.Sprintf("operation_params.%s", key))
+		}
 	}
-	if !utils.IsInStringArray(input.LabelType, []string{api.ST_LABEL_ID, api.ST_LABEL_TAG}) {
+	if !utils.IsInStringArray(input.LabelType, api.ST_LABEL_TYPES) {
 		return input, httperrors.NewInputParameterError("unkown label type '%s'", input.LabelType)
 	}
+	input.Labels, err = stm.validateLabels(ctx, userCred, ownerId, input.ResourceType, input.LabelType, input.Labels)
+	if err != nil {
+		return input, err
+	}
 	// check timer or cycletimer
 	if input.ScheduledType == api.ST_TYPE_TIMING {
 		input.Timer, err = checkTimerCreateInput(input.Timer)
@@ -185,6 +200,101 @@ func (stm *SScheduledTaskManager) ValidateCreateData(ctx context.Context, userCr
 	return input, nil
 }
 
+// validateLabels 将项目名称和资源名称转换为ID, 避免改名后匹配不到资源,
+// 同时拒绝定时任务所属项目看不到的项目和资源
+func (stm *SScheduledTaskManager) validateLabels(ctx context.Context, userCred mcclient.TokenCredential, ownerId mcclient.IIdentityProvider, resourceType, labelType string, labels []string) ([]string, error) {
+	switch labelType {
+	case api.ST_LABEL_PROJECT:
+		return stm.validateProjectLabels(ctx, userCred, ownerId, resourceType, labels)
+	case api.ST_LABEL_ID:
+		return stm.validateIdLabels(ownerId, resourceType, labels)
+	}
+	return labels, nil
+}
+
+// validateProjectLabels only accepts the projects in the domain of the owner,
+// projects other than the owner's require domain privilege on the resource
+func (stm *SScheduledTaskManager) validateProjectLabels(ctx context.Context, userCred mcclient.TokenCredential, ownerId mcclient.IIdentityProvider, resourceType string, labels []string) ([]string, error) {
+	manager := db.GetModelManager(resourceType)
+	if manager == nil {
+		return nil, httperrors.NewInputParameterError("unkown resource type '%s'", resourceType)
+	}
+	ret := make([]string, 0, len(labels))
+	for _, label := range labels {
+		tenant, err := db.TenantCacheManager.FetchTenantByIdOrName(ctx, label)
+		if err != nil {
+			if errors.Cause(err) == sql.ErrNoRows {
+				return nil, httperrors.NewResourceNotFoundError2("project", label)
+			}
+			return nil, httperrors.NewGeneralError(err)
+		}
+		if tenant.DomainId != ownerId.GetProjectDomainId() {
+			return nil, httperrors.NewResourceNotFoundError2("project", label)
+		}
+		if tenant.Id != ownerId.GetProjectId() && !db.IsDomainAllowList(userCred, manager) {
+			return nil, httperrors.NewForbiddenError("not allow to operate %ss of project %s", resourceType, tenant.Name)
+		}
+		if !utils.IsInStringArray(tenant.Id, ret) {
+			ret = append(ret, tenant.Id)
+		}
+	}
+	return ret, nil
+}
+
+// validateIdLabels only accepts the resources of the owner project
+func (stm *SScheduledTaskManager) validateIdLabels(ownerId mcclient.IIdentityProvider, resourceType string, labels []string) ([]string, error) {
+	manager := db.GetModelManager(resourceType)
+	if manager == nil {
+		return nil, httperrors.NewInputParameterError("unkown resource type '%s'", resourceType)
+	}
+	ret := make([]string, 0, len(labels))
+	for _, label := range labels {
+		obj, err := db.FetchByIdOrName(manager, ownerId, label)
+		if err != nil {
+			if errors.Cause(err) == sql.ErrNoRows {
+				return nil, httperrors.NewResourceNotFoundError2(resourceType, label)
+			}
+			return nil, httperrors.NewGeneralError(err)
+		}
+		if obj.GetOwnerId().GetProjectId() != ownerId.GetProjectId() {
+			return nil, httperrors.NewResourceNotFoundError2(resourceType, label)
+		}
+		if !utils.IsInStringArray(obj.GetId(), ret) {
+			ret = append(ret, obj.GetId())
+		}
+	}
+	return ret, nil
+}
+
+func (stm *SScheduledTaskManager) AllowGetPropertyOperations(ctx context.Context, userCred mcclient.TokenCredential, query jsonutils.JSONObject) bool {
+	return true
+}
+
+// 获取定时任务支持的资源操作
+func (stm *SScheduledTaskManager) GetPropertyOperations(ctx context.Context, userCred mcclient.TokenCredential, query jsonutils.JSONObject) (jsonutils.JSONObject, error) {
+	resourceType, _ := query.GetString("resource_type")
+	opers := make([]api.ScheduledTaskOperation, 0, len(ResourceOperationMap))
+	for _, oper := range ResourceOperationMap {
+		if len(resourceType) > 0 && string(oper.Resource) != resourceType {
+			continue
+		}
+		opers = append(opers, api.ScheduledTaskOperation{
+			ResourceType:   string(oper.Resource),
+			Operation:      oper.Operation,
+			RequiredParams: oper.RequiredParams,
+		})
+	}
+	sort.Slice(opers, func(i, j int) bool {
+		if opers[i].ResourceType != opers[j].ResourceType {
+			return opers[i].ResourceType < opers[j].ResourceType
+		}
+		return opers[i].Operation < opers[j].Operation
+	})
+	ret := jsonutils.NewDict()
+	ret.Set("operations", jsonutils.Marshal(opers))
+	return ret, nil
+}
+
 func (st *SScheduledTask) AllowPerformEnable(ctx context.Context, userCred mcclient.TokenCredential,
 	query jsonutils.JSONObject, input apis.PerformEnableInput) bool {
 	return true
@@ -316,6 +426,11 @@ func (st *SScheduledTask) AllowPerformSetLabels(ctx context.Context, userCred mc
 }
 
 func (st *SScheduledTask) PerformSetLabels(ctx context.Context, userCred mcclient.TokenCredential, query jsonutils.JSONObject, input api.ScheduledTaskSetLabelsInput) (jsonutils.JSONObject, error) {
+	var err error
+	input.Labels, err = ScheduledTaskManager.validateLabels(ctx, userCred, st.GetOwnerId(), st.ResourceType, st.LabelType, input.Labels)
+	if err != nil {
+		return nil, err
+	}
 	nowLabels, err := st.STLabels()
 	if err != nil {
 		return nil, err
@@ -391,7 +506,11 @@ func (st *SScheduledTask) CustomizeDelete(ctx context.Context, userCred mcclient
 
 func (st *SScheduledTask) Action(ctx context.Context, userCred mcclient.TokenCredential) SAction {
 	session := auth.GetSession(ctx, userCred, "", "")
-	return Action.ResourceOperation(st.ResourceOperation()).Session(session)
+	action := Action.ResourceOperation(st.ResourceOperation()).Session(session)
+	if st.OperationParams != nil {
+		action = action.DefaultParams(st.OperationParams)
+	}
+	return action
 }
 
 func (st *SScheduledTask) ExecuteNotify(ctx context.Context, userCred mcclient.TokenCredential, name string) {
@@ -431,28 +550,12 @@ func (st *SScheduledTask) Execute(ctx context.Context, userCred mcclient.TokenCr
 		return err
 	}
 
-	var (
-		ids   []string
-		opts  options.BaseListOptions
-		f     bool
-		limit int
-	)
-	switch st.LabelType {
-	case api.ST_LABEL_TAG:
-		opts = options.BaseListOptions{
-			Details: &f,
-			Limit:   &limit,
-			Scope:   "system",
-			Tags:    labels,
-		}
-	case api.ST_LABEL_ID:
-		opts = options.BaseListOptions{
-			Details: &f,
-			Limit:   &limit,
-			Scope:   "system",
-			Filter:  []string{fmt.Sprintf("id.in(%s)", strings.Join(labels, ","))},
-		}
+	if len(labels) == 0 {
+		// 未绑定任何标示时不应操作全部资源
+		return errors.Errorf("scheduled task has no labels")
 	}
+	var ids []string
+	opts := st.listOptions(labels)
 	res, err := action.List(&WrapperListOptions{opts})
 	if err != nil {
 		return err
@@ -461,24 +564,26 @@ func (st *SScheduledTask) Execute(ctx context.Context, userCred mcclient.TokenCr
 		ids = append(ids, id)
 	}
 
+	sort.Strings(ids)
+
 	maxLimit := 20
-	type result struct {
-		id      string
-		succeed bool
-		reason  string
-	}
 	workerQueue := make(chan struct{}, maxLimit)
-	results := make([]result, len(ids))
-	log.Infof("servers to scheduledtask: %v", ids)
+	results := make(api.ScheduledTaskActivityResults, len(ids))
+	log.Infof("%ss to scheduledtask: %v", st.ResourceType, ids)
 	for i, id := range ids {
 		workerQueue <- struct{}{}
 		go func(n int, id string) {
-			ok, reason := action.Apply(id)
+			ok, reason := action.Apply(id, res[id])
 			log.Infof("exec successfully: %t, reason: %s", ok, reason)
 			if ok {
 				st.ExecuteNotify(ctx, userCred, res[id])
 			}
-			results[n] = result{id, ok, reason}
+			results[n] = api.ScheduledTaskActivityResult{
+				ResourceId:   id,
+				ResourceName: res[id],
+				Succeed:      ok,
+				Reason:       reason,
+			}
 			<-workerQueue
 		}(i, id)
 	}
@@ -488,53 +593,54 @@ func (st *SScheduledTask) Execute(ctx context.Context, userCred mcclient.TokenCr
 	}
 	failedReasons := make([]string, 0, 1)
 	succeedIds := make([]string, 0, 1)
-	displayStrs, err := st.id2displayStr(ids)
-	if err != nil {
-		return err
-	}
 	for _, ret := range results {
-		if ret.succeed {
-			succeedIds = append(succeedIds, displayStrs[ret.id])
+		display := fmt.Sprintf("%s(%s)", ret.ResourceName, ret.ResourceId)
+		if ret.Succeed {
+			succeedIds = append(succeedIds, display)
 			continue
 		}
-		failedReasons = append(failedReasons, fmt.Sprintf("\t%s: %s", displayStrs[ret.id], ret.reason))
+		failedReasons = append(failedReasons, fmt.Sprintf("\t%s: %s", display, ret.Reason))
 	}
+	over = true
 	if len(failedReasons) == 0 {
-		sa.Succeed()
+		sa.SetResults(api.ST_ACTIVITY_STATUS_SUCCEED, "", results)
 		return nil
 	}
 	if len(failedReasons) == len(ids) {
 		reason := fmt.Sprintf("All %ss %s failed:\n%s", st.ResourceType, st.Operation, strings.Join(failedReasons, ";\n"))
-		sa.Fail(reason)
+		sa.SetResults(api.ST_ACTIVITY_STATUS_FAILED, reason, results)
 		return nil
 	}
 	reason := fmt.Sprintf("Some %ss %s successfully:\n\t%s\n\n. Some %ss %s failed:\n%s", st.ResourceType, st.Operation, strings.Join(succeedIds, ";"), st.ResourceType, st.Operation, strings.Join(failedReasons, ";\n"))
-	sa.PartFail(reason)
+	sa.SetResults(api.ST_ACTIVITY_STATUS_PART_SUCCEED, reason, results)
 	return nil
 }
 
-func (st *SScheduledTask) id2displayStr(ids []string) (map[string]string, error) {
-	ret := make(map[string]string, len(ids))
-	if st.ResourceType != api.ST_RESOURCE_SERVER {
-		for _, id := range ids {
-			ret[id] = id
-		}
-		return ret, nil
-	}
-	type idName struct {
-		Id   string
-		Name string
-	}
-	q := GuestManager.Query("id", "name").In("id", ids)
-	idNames := make([]idName, 0, len(ids))
-	err := q.All(&idNames)
-	if err != nil {
-		return nil, errors.Wrap(err, "SQuery.All")
-	}
-	for _, idName := range idNames {
-		ret[idName.Id] = fmt.Sprintf("%s(%s)", idName.Name, idName.Id)
+// listOptions selects the resources of labels within the scope of the owner,
+// resources are listed by admin session, the owner project or domain filter
+// keeps the scheduled task from touching resources of other projects
+func (st *SScheduledTask) listOptions(labels []string) options.BaseListOptions {
+	f := false
+	limit := 0
+	opts := options.BaseListOptions{
+		Details: &f,
+		Limit:   &limit,
+		Scope:   "system",
 	}
-	return ret, nil
+	switch st.LabelType {
+	case api.ST_LABEL_TAG:
+		opts.Tenant = st.ProjectId
+		opts.Tags = labels
+	case api.ST_LABEL_ID:
+		opts.Tenant = st.ProjectId
+		opts.Filter = []string{fmt.Sprintf("id.in(%s)", strings.Join(labels, ","))}
+	case api.ST_LABEL_PROJECT:
+		opts.ProjectDomain = st.DomainId
+		opts.Filter = []string{fmt.Sprintf("tenant_id.in(%s)", strings.Join(labels, ","))}
+	default:
+		opts.Tenant = st.ProjectId
+	}
+	return opts
 }
 
 func (st *SScheduledTask) NewActivity(ctx context.Context, reject bool) (*SScheduledTaskActivity, error) {
@@ -544,6 +650,8 @@ func (st *SScheduledTask) NewActivity(ctx context.Context, reject bool) (*SSched
 	}
 	sa.Status = api.ST_ACTIVITY_STATUS_EXEC
 	sa.ScheduledTaskId = st.Id
+	sa.ResourceType = st.ResourceType
+	sa.Operation = st.Operation
 	if reject {
 		sa.Status = api.ST_ACTIVITY_STATUS_REJECT
 		sa.EndTime = now
@@ -628,6 +736,11 @@ func (stm *SScheduledTaskManager) Timer(ctx context.Context, userCred mcclient.T
 
 func init() {
 	Register(ResourceServer, modules.Servers.ResourceManager)
+	Register(ResourceDisk, modules.Disks)
+	Register(ResourceDBInstance, modules.DBInstance)
+	Register(ResourceElasticcache, modules.ElasticCache.ResourceManager)
+	Register(ResourceScalingGroup, modules.ScalingGroup)
+	Register(ResourceLoadbalancer, modules.Loadbalancers.ResourceManager)
 }
 
 // Modules describe the correspondence between Resource and modulebase.ResourceManager,
@@ -643,13 +756,30 @@ func Register(resource Resource, manager modulebase.ResourceManager) {
 type Resource string
 
 const (
-	ResourceServer Resource = api.ST_RESOURCE_SERVER
+	ResourceServer       Resource = api.ST_RESOURCE_SERVER
+	ResourceDisk         Resource = api.ST_RESOURCE_DISK
+	ResourceDBInstance   Resource = api.ST_RESOURCE_DBINSTANCE
+	ResourceElasticcache Resource = api.ST_RESOURCE_ELASTICCACHE
+	ResourceScalingGroup Resource = api.ST_RESOURCE_SCALINGGROUP
+	ResourceLoadbalancer Resource = api.ST_RESOURCE_LOADBALANCER
 )
 
 // ResourceOperation describe the operation for onecloud resource like create, update, delete and so on.
 type ResourceOperation struct {
-	Resource      Resource
-	Operation     string
+	Resource  Resource
+	Operation string
+	// Action is the perform action sent to the resource, the same as Operation if empty
+	Action string
+	// Params are the fixed params of the operation, scheduled task params can not override them
+	Params map[string]string
+	// RequiredParams must be provided by scheduled task
+	RequiredParams []string
+	// NameParam is filled with "<resource name>-<timestamp>" on each execution if not empty
+	NameParam string
+	// Request replaces the default PerformAction request if not nil
+	Request func(session *mcclient.ClientSession, id string, params *jsonutils.JSONDict) error
+	// Timeout of waiting for StatusSuccess, default is the timeout of Action
+	Timeout       time.Duration
 	StatusSuccess []string
 	Fail          []ResourceOperationFail
 }
@@ -659,6 +789,17 @@ type ResourceOperationFail struct {
 	LogEvent string
 }
 
+func (oper ResourceOperation) key() string {
+	return fmt.Sprintf("%s.%s", oper.Resource, oper.Operation)
+}
+
+func (oper ResourceOperation) action() string {
+	if len(oper.Action) > 0 {
+		return oper.Action
+	}
+	return oper.Operation
+}
+
 // It is clearer to write each ResourceOperation as a constant
 var (
 	ServerStart = ResourceOperation{
@@ -686,13 +827,150 @@ var (
 			{api.VM_STOP_FAILED, db.ACT_STOP_FAIL},
 		},
 	}
-	ResourceOperationMap = map[string]ResourceOperation{
-		fmt.Sprintf("%s.%s", ResourceServer, api.ST_RESOURCE_OPERATION_START):   ServerStart,
-		fmt.Sprintf("%s.%s", ResourceServer, api.ST_RESOURCE_OPERATION_STOP):    ServerStop,
-		fmt.Sprintf("%s.%s", ResourceServer, api.ST_RESOURCE_OPERATION_RESTART): ServerRestart,
+	ServerSnapshot = ResourceOperation{
+		Resource:      ResourceServer,
+		Operation:     api.ST_RESOURCE_OPERATION_SNAPSHOT,
+		Action:        "instance-snapshot",
+		NameParam:     "name",
+		Timeout:       time.Hour,
+		StatusSuccess: []string{api.VM_READY, api.VM_RUNNING},
+		Fail: []ResourceOperationFail{
+			{api.VM_INSTANCE_SNAPSHOT_FAILED, db.ACT_UPDATE_STATUS},
+		},
+	}
+	ServerChangeConfig = ResourceOperation{
+		Resource:      ResourceServer,
+		Operation:     api.ST_RESOURCE_OPERATION_CHANGE_CONFIG,
+		Timeout:       30 * time.Minute,
+		StatusSuccess: []string{api.VM_READY, api.VM_RUNNING},
+		Fail: []ResourceOperationFail{
+			{api.VM_CHANGE_FLAVOR_FAIL, db.ACT_CHANGE_FLAVOR_FAIL},
+		},
+	}
+
+	DiskSnapshot = ResourceOperation{
+		Resource:  ResourceDisk,
+		Operation: api.ST_RESOURCE_OPERATION_SNAPSHOT,
+		NameParam: "name",
+		// disk snapshot is created by snapshot resource rather than perform action of disk
+		Request: func(session *mcclient.ClientSession, id string, params *jsonutils.JSONDict) error {
+			params.Set("disk", jsonutils.NewString(id))
+			_, err := modules.Snapshots.Create(session, params)
+			return err
+		},
+	}
+	DiskResize = ResourceOperation{
+		Resource:       ResourceDisk,
+		Operation:      api.ST_RESOURCE_OPERATION_RESIZE,
+		RequiredParams: []string{"size"},
+		Timeout:        30 * time.Minute,
+		StatusSuccess:  []string{api.DISK_READY},
+		Fail: []ResourceOperationFail{
+			{api.DISK_RESIZE_FAILED, db.ACT_UPDATE_STATUS},
+		},
+	}
+
+	DBInstanceRestart = ResourceOperation{
+		Resource:      ResourceDBInstance,
+		Operation:     api.ST_RESOURCE_OPERATION_RESTART,
+		Action:        "reboot",
+		Timeout:       30 * time.Minute,
+		StatusSuccess: []string{api.DBINSTANCE_RUNNING},
+		Fail: []ResourceOperationFail{
+			{api.DBINSTANCE_REBOOT_FAILED, db.ACT_UPDATE_STATUS},
+		},
+	}
+	DBInstanceChangeConfig = ResourceOperation{
+		Resource:      ResourceDBInstance,
+		Operation:     api.ST_RESOURCE_OPERATION_CHANGE_CONFIG,
+		Timeout:       time.Hour,
+		StatusSuccess: []string{api.DBINSTANCE_RUNNING},
+		Fail: []ResourceOperationFail{
+			{api.DBINSTANCE_CHANGE_CONFIG_FAILED, db.ACT_UPDATE_STATUS},
+		},
 	}
+
+	ElasticcacheRestart = ResourceOperation{
+		Resource:      ResourceElasticcache,
+		Operation:     api.ST_RESOURCE_OPERATION_RESTART,
+		Timeout:       30 * time.Minute,
+		StatusSuccess: []string{api.ELASTIC_CACHE_STATUS_RUNNING},
+		Fail: []ResourceOperationFail{
+			{api.ELASTIC_CACHE_STATUS_RESTART_FAILED, db.ACT_UPDATE_STATUS},
+		},
+	}
+	ElasticcacheChangeSpec = ResourceOperation{
+		Resource:       ResourceElasticcache,
+		Operation:      api.ST_RESOURCE_OPERATION_CHANGE_SPEC,
+		RequiredParams: []string{"sku"},
+		Timeout:        time.Hour,
+		StatusSuccess:  []string{api.ELASTIC_CACHE_STATUS_RUNNING},
+		Fail: []ResourceOperationFail{
+			{api.ELASTIC_CACHE_STATUS_CHANGE_FAILED, db.ACT_UPDATE_STATUS},
+		},
+	}
+
+	ScalingGroupSetDesiredCapacity = ResourceOperation{
+		Resource:       ResourceScalingGroup,
+		Operation:      api.ST_RESOURCE_OPERATION_SET_DESIRED_CAPACITY,
+		RequiredParams: []string{"desired_capacity"},
+	}
+	ScalingGroupEnable = ResourceOperation{
+		Resource:  ResourceScalingGroup,
+		Operation: api.ST_RESOURCE_OPERATION_ENABLE,
+	}
+	ScalingGroupDisable = ResourceOperation{
+		Resource:  ResourceScalingGroup,
+		Operation: api.ST_RESOURCE_OPERATION_DISABLE,
+	}
+
+	LoadbalancerStart = ResourceOperation{
+		Resource:      ResourceLoadbalancer,
+		Operation:     api.ST_RESOURCE_OPERATION_START,
+		Action:        "status",
+		Params:        map[string]string{"status": api.LB_STATUS_ENABLED},
+		StatusSuccess: []string{api.LB_STATUS_ENABLED},
+		Fail: []ResourceOperationFail{
+			{api.LB_STATUS_START_FAILED, db.ACT_UPDATE_STATUS},
+		},
+	}
+	LoadbalancerStop = ResourceOperation{
+		Resource:      ResourceLoadbalancer,
+		Operation:     api.ST_RESOURCE_OPERATION_STOP,
+		Action:        "status",
+		Params:        map[string]string{"status": api.LB_STATUS_DISABLED},
+		StatusSuccess: []string{api.LB_STATUS_DISABLED},
+		Fail: []ResourceOperationFail{
+			{api.LB_STATUS_STOP_FAILED, db.ACT_UPDATE_STATUS},
+		},
+	}
+
+	ResourceOperationMap = map[string]ResourceOperation{}
 )
 
+func init() {
+	for _, oper := range []ResourceOperation{
+		ServerStart,
+		ServerStop,
+		ServerRestart,
+		ServerSnapshot,
+		ServerChangeConfig,
+		DiskSnapshot,
+		DiskResize,
+		DBInstanceRestart,
+		DBInstanceChangeConfig,
+		ElasticcacheRestart,
+		ElasticcacheChangeSpec,
+		ScalingGroupSetDesiredCapacity,
+		ScalingGroupEnable,
+		ScalingGroupDisable,
+		LoadbalancerStart,
+		LoadbalancerStop,
+	} {
+		ResourceOperationMap[oper.key()] = oper
+	}
+}
+
 // Action itself is meaningless, a meaningful Action is generated by
 // calling Resource, Operation, Session and DefaultParams.
 // A example:
@@ -709,6 +987,9 @@ type SAction struct {
 
 func (r SAction) ResourceOperation(oper ResourceOperation) SAction {
 	r.operation = oper
+	if oper.Timeout > 0 {
+		r.timeout = oper.Timeout
+	}
 	return r
 }
 
@@ -753,26 +1034,41 @@ func (r SAction) List(opts *WrapperListOptions) (map[string]string, error) {
 	return out, nil
 }
 
-func (r SAction) Apply(id string) (success bool, failReason string) {
+// params merges the params of scheduled task and operation for resource named name
+func (r SAction) params(name string) *jsonutils.JSONDict {
+	params := jsonutils.NewDict()
+	if r.defautParams != nil {
+		params.Update(r.defautParams)
+	}
+	for k, v := range r.operation.Params {
+		params.Set(k, jsonutils.NewString(v))
+	}
+	if len(r.operation.NameParam) > 0 && !params.Contains(r.operation.NameParam) {
+		params.Set(r.operation.NameParam, jsonutils.NewString(fmt.Sprintf("%s-%s", name, time.Now().Format("20060102150405"))))
+	}
+	return params
+}
+
+func (r SAction) Apply(id, name string) (success bool, failReason string) {
 	success = true
 	resourceManager, ok := Modules[r.operation.Resource]
 	if !ok {
 		return false, fmt.Sprintf("no such resource '%s' in Modules", r.operation.Resource)
 	}
-	var requestFunc func(session *mcclient.ClientSession, id string, params *jsonutils.JSONDict) error
-
-	action := utils.CamelSplit(r.operation.Operation, "-")
-	requestFunc = func(session *mcclient.ClientSession, id string, params *jsonutils.JSONDict) error {
-		if params == nil {
-			params = jsonutils.NewDict()
+	requestFunc := r.operation.Request
+	if requestFunc == nil {
+		action := utils.CamelSplit(r.operation.action(), "-")
+		requestFunc = func(session *mcclient.ClientSession, id string, params *jsonutils.JSONDict) error {
+			_, err := resourceManager.PerformAction(session, id, action, params)
+			return err
 		}
-		_, err := resourceManager.PerformAction(session, id, action, params)
-		return err
 	}
-	err := requestFunc(r.session, id, r.defautParams)
+	err := requestFunc(r.session, id, r.params(name))
 	if err != nil {
-		clientErr, _ := err.(*httputils.JSONClientError)
-		return false, clientErr.Details
+		if clientErr, ok := err.(*httputils.JSONClientError); ok {
+			return false, clientErr.Details
+		}
+		return false, err.Error()
 	}
 	if len(r.operation.StatusSuccess) == 0 {
 		return true, ""
@@ -812,7 +1108,7 @@ func (r SAction) Apply(id string) (success bool, failReason string) {
 					continue
 				}
 				if len(events.Data) == 0 {
-					log.Errorf("These is no opslog about action '%s' for %s.%s: %s", fail.LogEvent, r.operation.Resource, id, err.Error())
+					log.Errorf("These is no opslog about action '%s' for %s.%s", fail.LogEvent, r.operation.Resource, id)
 					<-ticker.C
 					continue
 				}
@@ -0,0 +1,110 @@
+// Copyright 2019 Yunion
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package models
+
+import (
+	"strings"
+	"testing"
+
+	"yunion.io/x/jsonutils"
+
+	api "yunion.io/x/onecloud/pkg/apis/compute"
+)
+
+func TestResourceOperationMap(t *testing.T) {
+	for key, oper := range ResourceOperationMap {
+		if key != oper.key() {
+			t.Errorf("operation %s registered with key %s", oper.key(), key)
+		}
+		if _, ok := Modules[oper.Resource]; !ok {
+			t.Errorf("resource %s of operation %s not registered in Modules", oper.Resource, key)
+		}
+	}
+	for _, resourceType := range api.ST_RESOURCE_TYPES {
+		found := false
+		for _, oper := range ResourceOperationMap {
+			if string(oper.Resource) == resourceType {
+				found = true
+				break
+			}
+		}
+		if !found {
+			t.Errorf("resource type %s has no operation", resourceType)
+		}
+	}
+}
+
+func TestSActionParams(t *testing.T) {
+	defaults := jsonutils.NewDict()
+	defaults.Set("status", jsonutils.NewString("disabled"))
+	defaults.Set("description", jsonutils.NewString("by scheduled task"))
+
+	params := Action.ResourceOperation(LoadbalancerStart).DefaultParams(defaults).params("lb1")
+	if status, _ := params.GetString("status"); status != api.LB_STATUS_ENABLED {
+		t.Errorf("fixed params should not be overrided, got status %s", status)
+	}
+	if desc, _ := params.GetString("description"); desc != "by scheduled task" {
+		t.Errorf("want description from default params, got %s", desc)
+	}
+
+	params = Action.ResourceOperation(ServerSnapshot).params("vm1")
+	if name, _ := params.GetString("name"); !strings.HasPrefix(name, "vm1-") {
+		t.Errorf("want generated name with prefix vm1-, got %s", name)
+	}
+
+	defaults = jsonutils.NewDict()
+	defaults.Set("name", jsonutils.NewString("daily"))
+	params = Action.ResourceOperation(ServerSnapshot).DefaultParams(defaults).params("vm1")
+	if name, _ := params.GetString("name"); name != "daily" {
+		t.Errorf("name in default params should be kept, got %s", name)
+	}
+
+	if timeout := Action.ResourceOperation(ServerStart).timeout; timeout != Action.timeout {
+		t.Errorf("want default timeout %s, got %s", Action.timeout, timeout)
+	}
+	if timeout := Action.ResourceOperation(DiskResize).timeout; timeout != DiskResize.Timeout {
+		t.Errorf("want timeout %s, got %s", DiskResize.Timeout, timeout)
+	}
+}
+
+func TestSScheduledTaskListOptions(t *testing.T) {
+	st := &SScheduledTask{}
+	st.ProjectId = "p1"
+	st.DomainId = "d1"
+
+	st.LabelType = api.ST_LABEL_TAG
+	opts := st.listOptions([]string{"env=prod"})
+	if opts.Tenant != "p1" || len(opts.ProjectDomain) > 0 {
+		t.Errorf("tag labels should be limited to owner project, got tenant %q domain %q", opts.Tenant, opts.ProjectDomain)
+	}
+
+	st.LabelType = api.ST_LABEL_ID
+	opts = st.listOptions([]string{"id1", "id2"})
+	if opts.Tenant != "p1" {
+		t.Errorf("id labels should be limited to owner project, got tenant %q", opts.Tenant)
+	}
+	if len(opts.Filter) != 1 || opts.Filter[0] != "id.in(id1,id2)" {
+		t.Errorf("unexpected filter %v", opts.Filter)
+	}
+
+	st.LabelType = api.ST_LABEL_PROJECT
+	opts = st.listOptions([]string{"p1", "p2"})
+	if len(opts.Tenant) > 0 || opts.ProjectDomain != "d1" {
+		t.Errorf("project labels should be limited to owner domain, got tenant %q domain %q", opts.Tenant, opts.ProjectDomain)
+	}
+	if len(opts.Filter) != 1 || opts.Filter[0] != "tenant_id.in(p1,p2)" {
+		t.Errorf("unexpected filter %v", opts.Filter)
+	}
+}
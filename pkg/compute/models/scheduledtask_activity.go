@@ -55,6 +55,13 @@ type SScheduledTaskActivity struct {
 	StartTime       time.Time `list:"user"`
 	EndTime         time.Time `list:"user"`
 	Reason          string    `charset:"utf8" list:"user"`
+
+	// 执行时定时任务的资源类型和操作, 定时任务修改后仍可追溯
+	ResourceType string `width:"32" charset:"ascii" list:"user"`
+	Operation    string `width:"32" charset:"ascii" list:"user"`
+
+	// 每个资源的执行结果
+	Results *api.ScheduledTaskActivityResults `length:"medium" list:"user"`
 }
 
 func (sam *SScheduledTaskActivityManager) InitializeData() error {
@@ -160,6 +167,17 @@ func (sa *SScheduledTaskActivity) SetResult(status, reason string) error {
 	return err
 }
 
+func (sa *SScheduledTaskActivity) SetResults(status, reason string, results api.ScheduledTaskActivityResults) error {
+	_, err := db.Update(sa, func() error {
+		sa.Status = status
+		sa.Reason = reason
+		sa.EndTime = time.Now()
+		sa.Results = &results
+		return nil
+	})
+	return err
+}
+
 func (sa *SScheduledTaskActivity) Fail(reason string) error {
 	return sa.SetResult(api.ST_ACTIVITY_STATUS_FAILED, reason)
 }
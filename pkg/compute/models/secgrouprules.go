@@ -16,12 +16,10 @@ package models
 
 import (
 	"context"
-	"net"
 
 	"yunion.io/x/jsonutils"
 	"yunion.io/x/log"
 	"yunion.io/x/pkg/errors"
-	"yunion.io/x/pkg/util/regutils"
 	"yunion.io/x/pkg/util/secrules"
 	"yunion.io/x/pkg/util/stringutils"
 	"yunion.io/x/sqlchemy"
@@ -386,19 +384,7 @@ func (self *SSecurityGroupRule) toRule() (*secrules.SecurityRule, error) {
 		Protocol:    self.Protocol,
 		Description: self.Description,
 	}
-	if regutils.MatchCIDR(self.CIDR) {
-		_, rule.IPNet, _ = net.ParseCIDR(self.CIDR)
-	} else if regutils.MatchIPAddr(self.CIDR) {
-		rule.IPNet = &net.IPNet{
-			IP:   net.ParseIP(self.CIDR),
-			Mask: net.CIDRMask(32, 32),
-		}
-	} else {
-		rule.IPNet = &net.IPNet{
-			IP:   net.IPv4zero,
-			Mask: net.CIDRMask(0, 32),
-		}
-	}
+	rule.ParseCIDR(self.CIDR)
 
 	err := rule.ParsePorts(self.Ports)
 	if err != nil {
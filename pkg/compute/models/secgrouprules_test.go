@@ -0,0 +1,170 @@
+// Copyright 2019 Yunion
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package models
+
+import (
+	"testing"
+
+	"yunion.io/x/pkg/util/secrules"
+)
+
+func TestSSecurityGroupRule_StringIPv6(t *testing.T) {
+	cases := []struct {
+		cidr string
+		want string
+	}{
+		{"2001:db8::/32", "in:allow 2001:db8::/32 tcp 22"},
+		{"2001:db8::1", "in:allow 2001:db8::1 tcp 22"},
+		{"2001:db8::1/128", "in:allow 2001:db8::1 tcp 22"},
+		{"::/0", "in:allow ::/0 tcp 22"},
+		{"10.0.0.0/8", "in:allow 10.0.0.0/8 tcp 22"},
+		{"0.0.0.0/0", "in:allow tcp 22"},
+		{"", "in:allow tcp 22"},
+	}
+	for _, c := range cases {
+		rule := &SSecurityGroupRule{
+			Priority:  1,
+			Protocol:  secrules.PROTO_TCP,
+			Ports:     "22",
+			Direction: secrules.DIR_IN,
+			CIDR:      c.cidr,
+			Action:    string(secrules.SecurityRuleAllow),
+		}
+		s := rule.String()
+		if s != c.want {
+			t.Errorf("cidr %q: got %q, want %q", c.cidr, s, c.want)
+			continue
+		}
+		parsed, err := secrules.ParseSecurityRule(s)
+		if err != nil {
+			t.Errorf("parse %q: %v", s, err)
+			continue
+		}
+		if parsed.String() != s {
+			t.Errorf("parse %q: got %q", s, parsed.String())
+		}
+		want, _ := rule.toRule()
+		if parsed.IPNet.String() != want.IPNet.String() {
+			t.Errorf("parse %q: net %s, want %s", s, parsed.IPNet, want.IPNet)
+		}
+	}
+}
+
+func TestSecurityRuleSet_AllowListIPv6(t *testing.T) {
+	cases := []struct {
+		name  string
+		rules []string
+		want  []string
+	}{
+		{
+			name: "cut ipv6 network",
+			rules: []string{
+				"in:deny 2001:db8::/33 tcp 22",
+				"in:allow 2001:db8::/32 tcp 22",
+				"in:allow 10.0.0.0/8 tcp 22",
+			},
+			want: []string{
+				"in:allow 10.0.0.0/8 tcp 22",
+				"in:allow 2001:db8:8000::/33 tcp 22",
+			},
+		},
+		{
+			name: "cut ipv6 host",
+			rules: []string{
+				"in:deny 2001:db8::1 any",
+				"in:allow 2001:db8::/126 tcp 22",
+			},
+			want: []string{
+				"in:allow 2001:db8:: tcp 22",
+				"in:allow 2001:db8::2/127 tcp 22",
+			},
+		},
+		{
+			name: "ipv4 deny leaves ipv6 of any",
+			rules: []string{
+				"in:deny 0.0.0.0/1 any",
+				"in:allow tcp 22",
+			},
+			want: []string{
+				"in:allow 128.0.0.0/1 tcp 22",
+				"in:allow ::/0 tcp 22",
+			},
+		},
+		{
+			name: "ipv6 deny leaves ipv4 of any",
+			rules: []string{
+				"in:deny ::/0 any",
+				"in:allow tcp 22",
+			},
+			want: []string{
+				"in:allow 0.0.0.0/1 tcp 22",
+				"in:allow 128.0.0.0/1 tcp 22",
+			},
+		},
+		{
+			name: "deny any cuts ipv6",
+			rules: []string{
+				"in:deny any",
+				"in:allow 2001:db8::/32 tcp 22",
+			},
+			want: []string{},
+		},
+		{
+			name: "merge ipv6 networks",
+			rules: []string{
+				"in:allow 2001:db8::/33 tcp 80",
+				"in:allow 2001:db8:8000::/33 tcp 80",
+				"in:allow 10.0.0.0/8 tcp 80",
+			},
+			want: []string{
+				"in:allow 10.0.0.0/8 tcp 80",
+				"in:allow 2001:db8::/32 tcp 80",
+			},
+		},
+		{
+			name: "merge both families into any",
+			rules: []string{
+				"in:allow 0.0.0.0/1 tcp 80",
+				"in:allow 128.0.0.0/1 tcp 80",
+				"in:allow ::/0 tcp 80",
+			},
+			want: []string{
+				"in:allow tcp 80",
+			},
+		},
+	}
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			srs := secrules.SecurityRuleSet{}
+			for i, s := range c.rules {
+				rule := secrules.MustParseSecurityRule(s)
+				rule.Priority = 100 - i
+				srs = append(srs, *rule)
+			}
+			got := []string{}
+			for _, rule := range srs.AllowList() {
+				got = append(got, rule.String())
+			}
+			if len(got) != len(c.want) {
+				t.Fatalf("got %v, want %v", got, c.want)
+			}
+			for i := range got {
+				if got[i] != c.want[i] {
+					t.Fatalf("got %v, want %v", got, c.want)
+				}
+			}
+		})
+	}
+}
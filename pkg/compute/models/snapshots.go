@@ -59,6 +59,7 @@ type SSnapshot struct {
 	SManagedResourceBase
 	SCloudregionResourceBase `width:"36" charset:"ascii" nullable:"true" list:"user" create:"optional"`
 	db.SMultiArchResourceBase
+	SEncryptedResource
 
 	// 磁盘Id
 	DiskId string `width:"36" charset:"ascii" nullable:"true" create:"required" list:"user" index:"true"`
@@ -406,7 +407,9 @@ func (self *SSnapshot) CustomizeCreate(ctx context.Context, userCred mcclient.To
 	if err != nil {
 		return errors.Wrap(err, "DiskManager.FetchById")
 	}
-	ownerId = diskObj.(*SDisk).GetOwnerId()
+	disk := diskObj.(*SDisk)
+	ownerId = disk.GetOwnerId()
+	self.inheritEncryption(&disk.SEncryptedResource)
 	return self.SVirtualResourceBase.CustomizeCreate(ctx, userCred, ownerId, query, data)
 }
 
@@ -493,6 +496,15 @@ func (self *SSnapshotManager) AddRefCount(snapshotId string, count int) {
 	}
 }
 
+func (self *SSnapshotManager) FetchSnapshotById(snapshotId string) *SSnapshot {
+	snapshot, err := self.FetchById(snapshotId)
+	if err != nil {
+		log.Errorf("FetchById fail %s", err)
+		return nil
+	}
+	return snapshot.(*SSnapshot)
+}
+
 func (self *SSnapshotManager) GetDiskSnapshotsByCreate(diskId, createdBy string) []SSnapshot {
 	dest := make([]SSnapshot, 0)
 	q := self.Query().SubQuery()
@@ -576,6 +588,7 @@ func (self *SSnapshotManager) CreateSnapshot(ctx context.Context, owner mcclient
 	snapshot.Size = disk.DiskSize
 	snapshot.DiskType = disk.DiskType
 	snapshot.Location = location
+	snapshot.inheritEncryption(&disk.SEncryptedResource)
 	snapshot.CreatedBy = createdBy
 	snapshot.ManagerId = storage.ManagerId
 	if cloudregion := storage.GetRegion(); cloudregion != nil {
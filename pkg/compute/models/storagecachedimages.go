@@ -76,6 +76,8 @@ type SStoragecachedimage struct {
 	// 镜像状态
 	Status string `width:"32" charset:"ascii" nullable:"false" default:"init" list:"admin" update:"admin" create:"admin_required"`
 	Path   string `width:"256" charset:"utf8" nullable:"true" list:"admin" update:"admin" create:"admin_optional"`
+	// 缓存镜像格式
+	Format string `width:"16" charset:"ascii" nullable:"true" list:"admin" update:"admin" create:"admin_optional"`
 	// 上次下载时间
 	LastDownload time.Time `get:"admin"`
 	// 下载引用次数
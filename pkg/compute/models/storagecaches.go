@@ -638,6 +638,56 @@ func (self *SStoragecache) PerformCacheImage(ctx context.Context, userCred mccli
 	return nil, err
 }
 
+func (self *SStoragecache) AllowPerformReportCachedImages(ctx context.Context, userCred mcclient.TokenCredential, query jsonutils.JSONObject, data jsonutils.JSONObject) bool {
+	return db.IsAdminAllowPerform(userCred, self, "report-cached-images")
+}
+
+// 宿主机上报本地缓存的镜像
+func (self *SStoragecache) PerformReportCachedImages(ctx context.Context, userCred mcclient.TokenCredential, query jsonutils.JSONObject, input api.StoragecacheReportCachedImagesInput) (jsonutils.JSONObject, error) {
+	reported := make(map[string]bool)
+	for _, img := range input.Images {
+		if len(img.ImageId) == 0 {
+			continue
+		}
+		reported[img.ImageId] = true
+		if _, err := CachedimageManager.GetImageById(ctx, userCred, img.ImageId, false); err != nil {
+			log.Warningf("skip reported image %s of storagecache %s: %v", img.ImageId, self.Name, err)
+			continue
+		}
+		scimg := StoragecachedimageManager.Register(ctx, userCred, self.Id, img.ImageId, api.CACHED_IMAGE_STATUS_ACTIVE)
+		if scimg == nil {
+			return nil, httperrors.NewGeneralError(fmt.Errorf("fail to register image %s to storagecache %s", img.ImageId, self.Name))
+		}
+		if scimg.Status == api.CACHED_IMAGE_STATUS_DELETING {
+			continue
+		}
+		_, err := db.Update(scimg, func() error {
+			scimg.Status = api.CACHED_IMAGE_STATUS_ACTIVE
+			scimg.Path = img.Path
+			if len(img.Format) > 0 {
+				scimg.Format = img.Format
+			}
+			return nil
+		})
+		if err != nil {
+			return nil, errors.Wrapf(err, "update cached image %s", img.ImageId)
+		}
+	}
+	if input.Full {
+		scimgs, err := self.getCachedImages()
+		if err != nil {
+			return nil, errors.Wrap(err, "getCachedImages")
+		}
+		for i := range scimgs {
+			if reported[scimgs[i].CachedimageId] || scimgs[i].Status != api.CACHED_IMAGE_STATUS_ACTIVE {
+				continue
+			}
+			scimgs[i].SetStatus(userCred, api.CACHED_IMAGE_STATUS_UNKNOWN, "not reported by host")
+		}
+	}
+	return nil, nil
+}
+
 func (self *SStoragecache) SyncCloudImages(
 	ctx context.Context,
 	userCred mcclient.TokenCredential,
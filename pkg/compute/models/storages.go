@@ -1214,7 +1214,15 @@ func (self *SStorage) createDisk(ctx context.Context, name string, diskConfig *a
 	disk.BillingType = billingType
 	disk.BillingCycle = billingCycle
 
-	err := disk.GetModelManager().TableSpec().Insert(ctx, &disk)
+	err := disk.initDiskEncryption(ctx, diskConfig, ownerId)
+	if err != nil {
+		return nil, err
+	}
+	if disk.IsEncrypted() && !isEncryptSupportedStorage(self) {
+		return nil, httperrors.NewNotSupportedError("storage %s does not support encryption", self.StorageType)
+	}
+
+	err = disk.GetModelManager().TableSpec().Insert(ctx, &disk)
 	if err != nil {
 		return nil, err
 	}
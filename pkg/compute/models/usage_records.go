@@ -0,0 +1,729 @@
+// Copyright 2019 Yunion
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package models
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"time"
+
+	"yunion.io/x/jsonutils"
+	"yunion.io/x/log"
+	"yunion.io/x/pkg/errors"
+	"yunion.io/x/sqlchemy"
+
+	api "yunion.io/x/onecloud/pkg/apis/compute"
+	"yunion.io/x/onecloud/pkg/cloudcommon/db"
+	"yunion.io/x/onecloud/pkg/compute/options"
+	"yunion.io/x/onecloud/pkg/httperrors"
+	"yunion.io/x/onecloud/pkg/mcclient"
+	"yunion.io/x/onecloud/pkg/util/rbacutils"
+	"yunion.io/x/onecloud/pkg/util/stringutils2"
+)
+
+// +onecloud:swagger-gen-model-singular=usagerecord
+// +onecloud:swagger-gen-model-plural=usagerecords
+type SUsageRecordManager struct {
+	db.SStandaloneAnonResourceBaseManager
+	db.SProjectizedResourceBaseManager
+	SZoneResourceBaseManager
+}
+
+var UsageRecordManager *SUsageRecordManager
+
+func init() {
+	UsageRecordManager = &SUsageRecordManager{
+		SStandaloneAnonResourceBaseManager: db.NewStandaloneAnonResourceBaseManager(
+			SUsageRecord{},
+			"usagerecords_tbl",
+			"usagerecord",
+			"usagerecords",
+		),
+	}
+	UsageRecordManager.SetVirtualObject(UsageRecordManager)
+	UsageRecordManager.TableSpec().AddIndex(true, "resource_id", "start_time")
+}
+
+// SUsageRecord is the hourly usage of an on-premise resource owned by a project
+type SUsageRecord struct {
+	db.SStandaloneAnonResourceBase
+	db.SProjectizedResourceBase
+	SZoneResourceBase
+
+	// 资源类型
+	ResourceType string `width:"16" charset:"ascii" nullable:"false" list:"user" index:"true"`
+	// 资源ID
+	ResourceId string `width:"36" charset:"ascii" nullable:"false" list:"user" index:"true"`
+	// 资源名称
+	ResourceName string `width:"256" charset:"utf8" nullable:"false" list:"user"`
+	// 规格
+	Spec string `width:"64" charset:"utf8" nullable:"false" default:"" list:"user"`
+	// GPU所挂载的主机ID
+	GuestId string `width:"36" charset:"ascii" nullable:"true" list:"user"`
+	// 计量数量, 主机: 台, 磁盘和存储桶: GB, EIP: Mbps, GPU: 块
+	Amount float64 `nullable:"false" default:"0" list:"user"`
+
+	// 统计周期开始时间
+	StartTime time.Time `nullable:"false" list:"user" index:"true"`
+	// 统计周期结束时间
+	EndTime time.Time `nullable:"false" list:"user"`
+	// 统计周期内的使用时长, 单位秒
+	Duration int `nullable:"false" default:"0" list:"user"`
+
+	RateCardId string  `width:"36" charset:"ascii" nullable:"true" list:"user"`
+	Price      float64 `nullable:"false" default:"0" list:"user"`
+	Cost       float64 `nullable:"false" default:"0" list:"user"`
+	Currency   string  `width:"8" charset:"ascii" nullable:"true" list:"user"`
+}
+
+func (manager *SUsageRecordManager) AllowListItems(ctx context.Context, userCred mcclient.TokenCredential, query jsonutils.JSONObject) bool {
+	return db.IsAllowList(rbacutils.ScopeProject, userCred, manager)
+}
+
+func (manager *SUsageRecordManager) AllowCreateItem(ctx context.Context, userCred mcclient.TokenCredential, query jsonutils.JSONObject, data jsonutils.JSONObject) bool {
+	return false
+}
+
+func (self *SUsageRecord) AllowGetDetails(ctx context.Context, userCred mcclient.TokenCredential, query jsonutils.JSONObject) bool {
+	return db.IsAllowGet(rbacutils.ScopeProject, userCred, self)
+}
+
+func (self *SUsageRecord) AllowUpdateItem(ctx context.Context, userCred mcclient.TokenCredential) bool {
+	return false
+}
+
+func (self *SUsageRecord) AllowDeleteItem(ctx context.Context, userCred mcclient.TokenCredential, query jsonutils.JSONObject, data jsonutils.JSONObject) bool {
+	return false
+}
+
+func (manager *SUsageRecordManager) ResourceScope() rbacutils.TRbacScope {
+	return manager.SProjectizedResourceBaseManager.ResourceScope()
+}
+
+func (manager *SUsageRecordManager) FilterByOwner(q *sqlchemy.SQuery, owner mcclient.IIdentityProvider, scope rbacutils.TRbacScope) *sqlchemy.SQuery {
+	return manager.SProjectizedResourceBaseManager.FilterByOwner(q, owner, scope)
+}
+
+func (manager *SUsageRecordManager) FetchOwnerId(ctx context.Context, data jsonutils.JSONObject) (mcclient.IIdentityProvider, error) {
+	return manager.SProjectizedResourceBaseManager.FetchOwnerId(ctx, data)
+}
+
+func (self *SUsageRecord) GetOwnerId() mcclient.IIdentityProvider {
+	return self.SProjectizedResourceBase.GetOwnerId()
+}
+
+// 资源计量记录列表
+func (manager *SUsageRecordManager) ListItemFilter(
+	ctx context.Context,
+	q *sqlchemy.SQuery,
+	userCred mcclient.TokenCredential,
+	query api.UsageRecordListInput,
+) (*sqlchemy.SQuery, error) {
+	var err error
+	q, err = manager.SStandaloneAnonResourceBaseManager.ListItemFilter(ctx, q, userCred, query.StandaloneAnonResourceListInput)
+	if err != nil {
+		return nil, errors.Wrap(err, "SStandaloneAnonResourceBaseManager.ListItemFilter")
+	}
+	q, err = manager.SProjectizedResourceBaseManager.ListItemFilter(ctx, q, userCred, query.ProjectizedResourceListInput)
+	if err != nil {
+		return nil, errors.Wrap(err, "SProjectizedResourceBaseManager.ListItemFilter")
+	}
+	if len(query.ZoneId) > 0 || len(query.ZoneIds) > 0 || len(query.CloudregionId) > 0 {
+		q, err = manager.SZoneResourceBaseManager.ListItemFilter(ctx, q, userCred, query.ZonalFilterListInput)
+		if err != nil {
+			return nil, errors.Wrap(err, "SZoneResourceBaseManager.ListItemFilter")
+		}
+	}
+	if len(query.ResourceType) > 0 {
+		q = q.In("resource_type", query.ResourceType)
+	}
+	if len(query.ResourceId) > 0 {
+		q = q.In("resource_id", query.ResourceId)
+	}
+	if len(query.Spec) > 0 {
+		q = q.In("spec", query.Spec)
+	}
+	if !query.StartTime.IsZero() {
+		q = q.GE("start_time", query.StartTime)
+	}
+	if !query.EndTime.IsZero() {
+		q = q.LE("end_time", query.EndTime)
+	}
+	return q, nil
+}
+
+func (manager *SUsageRecordManager) OrderByExtraFields(
+	ctx context.Context,
+	q *sqlchemy.SQuery,
+	userCred mcclient.TokenCredential,
+	query api.UsageRecordListInput,
+) (*sqlchemy.SQuery, error) {
+	var err error
+	q, err = manager.SStandaloneAnonResourceBaseManager.OrderByExtraFields(ctx, q, userCred, query.StandaloneAnonResourceListInput)
+	if err != nil {
+		return nil, errors.Wrap(err, "SStandaloneAnonResourceBaseManager.OrderByExtraFields")
+	}
+	q, err = manager.SProjectizedResourceBaseManager.OrderByExtraFields(ctx, q, userCred, query.ProjectizedResourceListInput)
+	if err != nil {
+		return nil, errors.Wrap(err, "SProjectizedResourceBaseManager.OrderByExtraFields")
+	}
+	q, err = manager.SZoneResourceBaseManager.OrderByExtraFields(ctx, q, userCred, query.ZonalFilterListInput)
+	if err != nil {
+		return nil, errors.Wrap(err, "SZoneResourceBaseManager.OrderByExtraFields")
+	}
+	return q, nil
+}
+
+func (manager *SUsageRecordManager) QueryDistinctExtraField(q *sqlchemy.SQuery, field string) (*sqlchemy.SQuery, error) {
+	var err error
+	q, err = manager.SStandaloneAnonResourceBaseManager.QueryDistinctExtraField(q, field)
+	if err == nil {
+		return q, nil
+	}
+	q, err = manager.SProjectizedResourceBaseManager.QueryDistinctExtraField(q, field)
+	if err == nil {
+		return q, nil
+	}
+	q, err = manager.SZoneResourceBaseManager.QueryDistinctExtraField(q, field)
+	if err == nil {
+		return q, nil
+	}
+	return q, httperrors.ErrNotFound
+}
+
+func (manager *SUsageRecordManager) FetchCustomizeColumns(
+	ctx context.Context,
+	userCred mcclient.TokenCredential,
+	query jsonutils.JSONObject,
+	objs []interface{},
+	fields stringutils2.SSortedStrings,
+	isList bool,
+) []api.UsageRecordDetails {
+	rows := make([]api.UsageRecordDetails, len(objs))
+	stdRows := manager.SStandaloneAnonResourceBaseManager.FetchCustomizeColumns(ctx, userCred, query, objs, fields, isList)
+	projRows := manager.SProjectizedResourceBaseManager.FetchCustomizeColumns(ctx, userCred, query, objs, fields, isList)
+	zoneRows := manager.SZoneResourceBaseManager.FetchCustomizeColumns(ctx, userCred, query, objs, fields, isList)
+	for i := range rows {
+		rows[i] = api.UsageRecordDetails{
+			StandaloneAnonResourceDetails: stdRows[i],
+			ProjectizedResourceInfo:       projRows[i],
+			ZoneResourceInfo:              zoneRows[i],
+		}
+	}
+	return rows
+}
+
+func (manager *SUsageRecordManager) ListItemExportKeys(ctx context.Context, q *sqlchemy.SQuery, userCred mcclient.TokenCredential, keys stringutils2.SSortedStrings) (*sqlchemy.SQuery, error) {
+	q, err := manager.SStandaloneAnonResourceBaseManager.ListItemExportKeys(ctx, q, userCred, keys)
+	if err != nil {
+		return nil, errors.Wrap(err, "SStandaloneAnonResourceBaseManager.ListItemExportKeys")
+	}
+	q, err = manager.SProjectizedResourceBaseManager.ListItemExportKeys(ctx, q, userCred, keys)
+	if err != nil {
+		return nil, errors.Wrap(err, "SProjectizedResourceBaseManager.ListItemExportKeys")
+	}
+	if keys.ContainsAny(manager.SZoneResourceBaseManager.GetExportKeys()...) {
+		q, err = manager.SZoneResourceBaseManager.ListItemExportKeys(ctx, q, userCred, keys)
+		if err != nil {
+			return nil, errors.Wrap(err, "SZoneResourceBaseManager.ListItemExportKeys")
+		}
+	}
+	return q, nil
+}
+
+func (manager *SUsageRecordManager) AllowGetPropertyProjectCosts(ctx context.Context, userCred mcclient.TokenCredential, query jsonutils.JSONObject) bool {
+	return db.IsAllowGetSpec(rbacutils.ScopeProject, userCred, manager, "project-costs")
+}
+
+// 按项目汇总资源费用
+func (manager *SUsageRecordManager) GetPropertyProjectCosts(ctx context.Context, userCred mcclient.TokenCredential, query api.UsageRecordProjectCostInput) (api.UsageRecordProjectCostOutput, error) {
+	output := api.UsageRecordProjectCostOutput{
+		StartTime: query.StartTime,
+		EndTime:   query.EndTime,
+	}
+	if output.EndTime.IsZero() {
+		output.EndTime = time.Now().UTC()
+	}
+	if output.StartTime.IsZero() {
+		output.StartTime = time.Date(output.EndTime.Year(), output.EndTime.Month(), 1, 0, 0, 0, 0, time.UTC)
+	}
+	if !output.StartTime.Before(output.EndTime) {
+		return output, httperrors.NewInputParameterError("start_time must be before end_time")
+	}
+
+	ownerId, queryScope, err := db.FetchCheckQueryOwnerScope(ctx, userCred, jsonutils.Marshal(query), manager, rbacutils.ActionList, true)
+	if err != nil {
+		return output, httperrors.NewGeneralError(err)
+	}
+
+	q := manager.Query().GE("start_time", output.StartTime).LE("end_time", output.EndTime)
+	q = manager.FilterByOwner(q, ownerId, queryScope)
+	if len(query.ResourceType) > 0 {
+		q = q.In("resource_type", query.ResourceType)
+	}
+	if len(query.ProjectIds) > 0 {
+		q = q.In("tenant_id", query.ProjectIds)
+	}
+	sq := q.SubQuery()
+	sumQ := sq.Query(
+		sq.Field("tenant_id"),
+		sq.Field("resource_type"),
+		sq.Field("currency"),
+		sqlchemy.SUM("duration", sq.Field("duration")),
+		sqlchemy.SUM("cost", sq.Field("cost")),
+	).GroupBy(sq.Field("tenant_id"), sq.Field("resource_type"), sq.Field("currency"))
+
+	costs := []struct {
+		TenantId     string
+		ResourceType string
+		Currency     string
+		Duration     float64
+		Cost         float64
+	}{}
+	err = sumQ.All(&costs)
+	if err != nil {
+		return output, errors.Wrap(err, "sumQ.All")
+	}
+
+	projectIds := make([]string, len(costs))
+	for i := range costs {
+		projectIds[i] = costs[i].TenantId
+	}
+	projects := []struct {
+		Id     string
+		Name   string
+		Domain string
+	}{}
+	err = db.TenantCacheManager.GetTenantQuery("id", "name", "domain").In("id", projectIds).All(&projects)
+	if err != nil {
+		return output, errors.Wrap(err, "fetch projects")
+	}
+	projectMap := map[string]int{}
+	for i := range projects {
+		projectMap[projects[i].Id] = i
+	}
+	output.Data = make([]api.UsageRecordProjectCost, len(costs))
+	for i := range costs {
+		output.Data[i] = api.UsageRecordProjectCost{
+			ProjectId:    costs[i].TenantId,
+			ResourceType: costs[i].ResourceType,
+			UsageHours:   costs[i].Duration / 3600,
+			Cost:         costs[i].Cost,
+			Currency:     costs[i].Currency,
+		}
+		if idx, ok := projectMap[costs[i].TenantId]; ok {
+			output.Data[i].Project = projects[idx].Name
+			output.Data[i].ProjectDomain = projects[idx].Domain
+		}
+	}
+	return output, nil
+}
+
+// sUsageResource is a resource which was alive during a usage window
+type sUsageResource struct {
+	Id        string
+	Name      string
+	TenantId  string
+	DomainId  string
+	ZoneId    string
+	Spec      string
+	GuestId   string
+	CreatedAt time.Time
+	Deleted   bool
+	DeletedAt time.Time
+
+	InstanceType string
+	VcpuCount    int
+	VmemSize     int
+	DiskSize     int
+	Bandwidth    int
+	SizeBytes    int64
+
+	Amount float64
+}
+
+type usageResourceFetcher func(start, end time.Time) ([]sUsageResource, error)
+
+var usageResourceFetchers = map[string]usageResourceFetcher{
+	api.USAGE_RESOURCE_SERVER: fetchServerUsageResources,
+	api.USAGE_RESOURCE_DISK:   fetchDiskUsageResources,
+	api.USAGE_RESOURCE_EIP:    fetchEipUsageResources,
+	api.USAGE_RESOURCE_BUCKET: fetchBucketUsageResources,
+	api.USAGE_RESOURCE_GPU:    fetchGpuUsageResources,
+}
+
+// filterOnPremise keeps rows of on-premise resources, i.e. resources without a cloudprovider
+// or managed by a VMware cloudprovider
+func filterOnPremise(q *sqlchemy.SQuery, managerField sqlchemy.IQueryField) *sqlchemy.SQuery {
+	vmwares := CloudproviderManager.Query("id").Equals("provider", api.CLOUD_PROVIDER_VMWARE).SubQuery()
+	return q.Filter(sqlchemy.OR(
+		sqlchemy.IsNullOrEmpty(managerField),
+		sqlchemy.In(managerField, vmwares),
+	))
+}
+
+// filterAliveDuring keeps rows created before end and not deleted before start
+func filterAliveDuring(q *sqlchemy.SQuery, res *sqlchemy.SSubQuery, start, end time.Time) *sqlchemy.SQuery {
+	return q.Filter(sqlchemy.LT(res.Field("created_at"), end)).Filter(sqlchemy.OR(
+		sqlchemy.IsFalse(res.Field("deleted")),
+		sqlchemy.GT(res.Field("deleted_at"), start),
+	))
+}
+
+func fetchServerUsageResources(start, end time.Time) ([]sUsageResource, error) {
+	guests := GuestManager.RawQuery().SubQuery()
+	hosts := HostManager.RawQuery().SubQuery()
+	q := guests.Query(
+		guests.Field("id"),
+		guests.Field("name"),
+		guests.Field("tenant_id"),
+		guests.Field("domain_id"),
+		guests.Field("instance_type"),
+		guests.Field("vcpu_count"),
+		guests.Field("vmem_size"),
+		guests.Field("created_at"),
+		guests.Field("deleted"),
+		guests.Field("deleted_at"),
+		hosts.Field("zone_id"),
+	).Join(hosts, sqlchemy.Equals(guests.Field("host_id"), hosts.Field("id")))
+	q = filterOnPremise(q, hosts.Field("manager_id"))
+	q = filterAliveDuring(q, guests, start, end)
+
+	ret := []sUsageResource{}
+	err := q.All(&ret)
+	if err != nil {
+		return nil, errors.Wrap(err, "q.All")
+	}
+	for i := range ret {
+		ret[i].Amount = 1
+		ret[i].Spec = ret[i].InstanceType
+		if len(ret[i].Spec) == 0 {
+			ret[i].Spec = fmt.Sprintf("%dC%dM", ret[i].VcpuCount, ret[i].VmemSize)
+		}
+	}
+	return ret, nil
+}
+
+func fetchDiskUsageResources(start, end time.Time) ([]sUsageResource, error) {
+	disks := DiskManager.RawQuery().SubQuery()
+	storages := StorageManager.RawQuery().SubQuery()
+	q := disks.Query(
+		disks.Field("id"),
+		disks.Field("name"),
+		disks.Field("tenant_id"),
+		disks.Field("domain_id"),
+		disks.Field("disk_size"),
+		disks.Field("created_at"),
+		disks.Field("deleted"),
+		disks.Field("deleted_at"),
+		storages.Field("zone_id"),
+		storages.Field("storage_type").Label("spec"),
+	).Join(storages, sqlchemy.Equals(disks.Field("storage_id"), storages.Field("id")))
+	q = filterOnPremise(q, storages.Field("manager_id"))
+	q = filterAliveDuring(q, disks, start, end)
+
+	ret := []sUsageResource{}
+	err := q.All(&ret)
+	if err != nil {
+		return nil, errors.Wrap(err, "q.All")
+	}
+	for i := range ret {
+		ret[i].Amount = float64(ret[i].DiskSize) / 1024
+	}
+	return ret, nil
+}
+
+// eipUsageQuery selects the zone of eip through the wire of its network
+func eipUsageQuery(start, end time.Time) *sqlchemy.SQuery {
+	eips := ElasticipManager.RawQuery().SubQuery()
+	networks := NetworkManager.RawQuery().SubQuery()
+	wires := WireManager.RawQuery().SubQuery()
+	q := eips.Query(
+		eips.Field("id"),
+		eips.Field("name"),
+		eips.Field("tenant_id"),
+		eips.Field("domain_id"),
+		eips.Field("bandwidth"),
+		eips.Field("created_at"),
+		eips.Field("deleted"),
+		eips.Field("deleted_at"),
+		wires.Field("zone_id"),
+	).LeftJoin(networks, sqlchemy.Equals(eips.Field("network_id"), networks.Field("id"))).
+		LeftJoin(wires, sqlchemy.Equals(networks.Field("wire_id"), wires.Field("id")))
+	q = filterOnPremise(q, eips.Field("manager_id"))
+	return filterAliveDuring(q, eips, start, end)
+}
+
+func fetchEipUsageResources(start, end time.Time) ([]sUsageResource, error) {
+	q := eipUsageQuery(start, end)
+
+	ret := []sUsageResource{}
+	err := q.All(&ret)
+	if err != nil {
+		return nil, errors.Wrap(err, "q.All")
+	}
+	for i := range ret {
+		// eip without bandwidth limit is charged as one unit
+		ret[i].Amount = 1
+		if ret[i].Bandwidth > 0 {
+			ret[i].Amount = float64(ret[i].Bandwidth)
+		}
+	}
+	return ret, nil
+}
+
+func fetchBucketUsageResources(start, end time.Time) ([]sUsageResource, error) {
+	buckets := BucketManager.RawQuery().SubQuery()
+	providers := CloudproviderManager.Query("id").In("provider", []string{
+		api.CLOUD_PROVIDER_CEPH,
+		api.CLOUD_PROVIDER_XSKY,
+		api.CLOUD_PROVIDER_GENERICS3,
+	}).SubQuery()
+	q := buckets.Query(
+		buckets.Field("id"),
+		buckets.Field("name"),
+		buckets.Field("tenant_id"),
+		buckets.Field("domain_id"),
+		buckets.Field("size_bytes"),
+		buckets.Field("storage_class").Label("spec"),
+		buckets.Field("created_at"),
+		buckets.Field("deleted"),
+		buckets.Field("deleted_at"),
+	).Filter(sqlchemy.In(buckets.Field("manager_id"), providers))
+	q = filterAliveDuring(q, buckets, start, end)
+
+	ret := []sUsageResource{}
+	err := q.All(&ret)
+	if err != nil {
+		return nil, errors.Wrap(err, "q.All")
+	}
+	for i := range ret {
+		ret[i].Amount = float64(ret[i].SizeBytes) / 1024 / 1024 / 1024
+	}
+	return ret, nil
+}
+
+func fetchGpuUsageResources(start, end time.Time) ([]sUsageResource, error) {
+	devs := IsolatedDeviceManager.Query().SubQuery()
+	guests := GuestManager.RawQuery().SubQuery()
+	hosts := HostManager.RawQuery().SubQuery()
+	q := devs.Query(
+		devs.Field("id"),
+		devs.Field("name"),
+		devs.Field("model").Label("spec"),
+		guests.Field("id").Label("guest_id"),
+		guests.Field("tenant_id"),
+		guests.Field("domain_id"),
+		guests.Field("created_at"),
+		guests.Field("deleted"),
+		guests.Field("deleted_at"),
+		hosts.Field("zone_id"),
+	).Join(guests, sqlchemy.Equals(devs.Field("guest_id"), guests.Field("id"))).
+		Join(hosts, sqlchemy.Equals(devs.Field("host_id"), hosts.Field("id"))).
+		Filter(sqlchemy.In(devs.Field("dev_type"), api.VALID_GPU_TYPES))
+	q = filterOnPremise(q, hosts.Field("manager_id"))
+	q = filterAliveDuring(q, guests, start, end)
+
+	ret := []sUsageResource{}
+	err := q.All(&ret)
+	if err != nil {
+		return nil, errors.Wrap(err, "q.All")
+	}
+	for i := range ret {
+		ret[i].Amount = 1
+	}
+	detached, err := fetchDetachedGpuUsageResources(start, ret)
+	if err != nil {
+		return nil, errors.Wrap(err, "fetchDetachedGpuUsageResources")
+	}
+	return append(ret, detached...), nil
+}
+
+// fetchDetachedGpuUsageResources finds the gpus recorded in the previous hour whose guest
+// was deleted during [start, end). The gpu is detached from the guest on deletion, so the
+// final partial hour can only be found through the guest of the previous record.
+func fetchDetachedGpuUsageResources(start time.Time, attached []sUsageResource) ([]sUsageResource, error) {
+	attachedIds := make([]string, len(attached))
+	for i := range attached {
+		attachedIds[i] = attached[i].Id
+	}
+	q := UsageRecordManager.Query().Equals("resource_type", api.USAGE_RESOURCE_GPU).
+		Equals("start_time", start.Add(-time.Hour)).IsNotEmpty("guest_id")
+	if len(attachedIds) > 0 {
+		q = q.NotIn("resource_id", attachedIds)
+	}
+	records := []SUsageRecord{}
+	err := db.FetchModelObjects(UsageRecordManager, q, &records)
+	if err != nil {
+		return nil, errors.Wrap(err, "FetchModelObjects")
+	}
+	ret := []sUsageResource{}
+	for i := range records {
+		guest := struct {
+			CreatedAt time.Time
+			Deleted   bool
+			DeletedAt time.Time
+		}{}
+		err := GuestManager.RawQuery("created_at", "deleted", "deleted_at").Equals("id", records[i].GuestId).First(&guest)
+		if err != nil {
+			if errors.Cause(err) == sql.ErrNoRows {
+				continue
+			}
+			return nil, errors.Wrapf(err, "fetch guest %s", records[i].GuestId)
+		}
+		if !guest.Deleted || guest.DeletedAt.Before(start) {
+			continue
+		}
+		ret = append(ret, sUsageResource{
+			Id:        records[i].ResourceId,
+			Name:      records[i].ResourceName,
+			Spec:      records[i].Spec,
+			GuestId:   records[i].GuestId,
+			TenantId:  records[i].ProjectId,
+			DomainId:  records[i].DomainId,
+			ZoneId:    records[i].ZoneId,
+			CreatedAt: guest.CreatedAt,
+			Deleted:   true,
+			DeletedAt: guest.DeletedAt,
+			Amount:    records[i].Amount,
+		})
+	}
+	return ret, nil
+}
+
+// usageDuration returns the seconds a resource was alive inside [start, end)
+func usageDuration(res sUsageResource, start, end time.Time) int {
+	from, to := start, end
+	if res.CreatedAt.After(from) {
+		from = res.CreatedAt
+	}
+	if res.Deleted && !res.DeletedAt.IsZero() && res.DeletedAt.Before(to) {
+		to = res.DeletedAt
+	}
+	if !from.Before(to) {
+		return 0
+	}
+	return int(to.Sub(from) / time.Second)
+}
+
+// collectUsageRecords records the usage during [start, end). Resources already recorded are
+// skipped, so that collecting the same hour again completes a partially collected hour.
+func (manager *SUsageRecordManager) collectUsageRecords(ctx context.Context, start, end time.Time) error {
+	cards, err := RateCardManager.fetchEnabledRateCards()
+	if err != nil {
+		return errors.Wrap(err, "fetchEnabledRateCards")
+	}
+	recorded := []struct {
+		ResourceId string
+	}{}
+	err = manager.Query("resource_id").Equals("start_time", start).All(&recorded)
+	if err != nil {
+		return errors.Wrap(err, "fetch recorded resources")
+	}
+	recordedIds := make(map[string]bool, len(recorded))
+	for i := range recorded {
+		recordedIds[recorded[i].ResourceId] = true
+	}
+	var errs []error
+	for _, resType := range api.USAGE_RESOURCE_TYPES {
+		resources, err := usageResourceFetchers[resType](start, end)
+		if err != nil {
+			errs = append(errs, errors.Wrapf(err, "fetch %s usage resources", resType))
+			continue
+		}
+		for i := range resources {
+			if recordedIds[resources[i].Id] {
+				continue
+			}
+			duration := usageDuration(resources[i], start, end)
+			if duration <= 0 {
+				continue
+			}
+			record := &SUsageRecord{
+				ResourceType: resType,
+				ResourceId:   resources[i].Id,
+				ResourceName: resources[i].Name,
+				Spec:         resources[i].Spec,
+				GuestId:      resources[i].GuestId,
+				Amount:       resources[i].Amount,
+				StartTime:    start,
+				EndTime:      end,
+				Duration:     duration,
+			}
+			record.ProjectId = resources[i].TenantId
+			record.DomainId = resources[i].DomainId
+			record.ZoneId = resources[i].ZoneId
+			if card := matchRateCard(cards, resType, resources[i].ZoneId, resources[i].Spec); card != nil {
+				record.RateCardId = card.Id
+				record.Price = card.Price
+				record.Currency = card.Currency
+				record.Cost = card.Price * record.Amount * float64(duration) / 3600
+			}
+			record.SetModelManager(manager, record)
+			err = manager.TableSpec().Insert(ctx, record)
+			if err != nil {
+				errs = append(errs, errors.Wrapf(err, "insert usage record of %s %s", resType, resources[i].Id))
+				continue
+			}
+			recordedIds[resources[i].Id] = true
+		}
+	}
+	return errors.NewAggregate(errs)
+}
+
+// usageCollectStart returns the start of the first hour to collect, i.e. the latest recorded
+// hour which may be incomplete, bounded by the backfill limit
+func (manager *SUsageRecordManager) usageCollectStart(end time.Time) (time.Time, error) {
+	earliest := end.Add(-time.Duration(options.Options.UsageRecordBackfillHours) * time.Hour)
+	last := end.Add(-time.Hour)
+	if earliest.After(last) {
+		earliest = last
+	}
+	latest := SUsageRecord{}
+	err := manager.Query("start_time").Desc("start_time").First(&latest)
+	if err != nil {
+		if errors.Cause(err) == sql.ErrNoRows {
+			return last, nil
+		}
+		return last, errors.Wrap(err, "fetch latest usage record")
+	}
+	if latest.StartTime.Before(earliest) {
+		return earliest, nil
+	}
+	if latest.StartTime.After(last) {
+		return last, nil
+	}
+	return latest.StartTime, nil
+}
+
+// CollectUsageRecords records the usage of on-premise resources of the whole hours since the
+// latest recorded hour, which backfills the hours missed while region was down
+func (manager *SUsageRecordManager) CollectUsageRecords(ctx context.Context, userCred mcclient.TokenCredential, isStart bool) {
+	if !options.Options.EnableUsageRecord {
+		return
+	}
+	end := time.Now().UTC().Truncate(time.Hour)
+	start, err := manager.usageCollectStart(end)
+	if err != nil {
+		log.Errorf("get usage collect start: %v", err)
+	}
+	for ; start.Before(end); start = start.Add(time.Hour) {
+		err := manager.collectUsageRecords(ctx, start, start.Add(time.Hour))
+		if err != nil {
+			log.Errorf("collect usage records from %s to %s: %v", start, start.Add(time.Hour), err)
+		}
+	}
+}
@@ -0,0 +1,112 @@
+// Copyright 2019 Yunion
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package models
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	api "yunion.io/x/onecloud/pkg/apis/compute"
+)
+
+func TestUsageDuration(t *testing.T) {
+	start := time.Date(2021, 6, 1, 10, 0, 0, 0, time.UTC)
+	end := start.Add(time.Hour)
+	cases := []struct {
+		name string
+		res  sUsageResource
+		want int
+	}{
+		{
+			name: "alive the whole hour",
+			res:  sUsageResource{CreatedAt: start.Add(-time.Hour)},
+			want: 3600,
+		},
+		{
+			name: "created during the hour",
+			res:  sUsageResource{CreatedAt: start.Add(20 * time.Minute)},
+			want: 2400,
+		},
+		{
+			name: "deleted during the hour",
+			res:  sUsageResource{CreatedAt: start.Add(-time.Hour), Deleted: true, DeletedAt: start.Add(15 * time.Minute)},
+			want: 900,
+		},
+		{
+			name: "created and deleted during the hour",
+			res:  sUsageResource{CreatedAt: start.Add(10 * time.Minute), Deleted: true, DeletedAt: start.Add(40 * time.Minute)},
+			want: 1800,
+		},
+		{
+			name: "created after the hour",
+			res:  sUsageResource{CreatedAt: end.Add(time.Minute)},
+			want: 0,
+		},
+	}
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			got := usageDuration(c.res, start, end)
+			if got != c.want {
+				t.Errorf("want %d, got %d", c.want, got)
+			}
+		})
+	}
+}
+
+func TestMatchRateCard(t *testing.T) {
+	cards := []SRateCard{
+		{ResourceType: api.USAGE_RESOURCE_DISK, Price: 1},
+		{ResourceType: api.USAGE_RESOURCE_DISK, SZoneResourceBase: SZoneResourceBase{ZoneId: "zone1"}, Price: 2},
+		{ResourceType: api.USAGE_RESOURCE_DISK, Spec: "rbd", Price: 3},
+		{ResourceType: api.USAGE_RESOURCE_DISK, SZoneResourceBase: SZoneResourceBase{ZoneId: "zone1"}, Spec: "rbd", Price: 4},
+		{ResourceType: api.USAGE_RESOURCE_SERVER, Price: 5},
+	}
+	cases := []struct {
+		resType string
+		zoneId  string
+		spec    string
+		want    float64
+	}{
+		{api.USAGE_RESOURCE_DISK, "zone2", "local", 1},
+		{api.USAGE_RESOURCE_DISK, "zone1", "local", 2},
+		{api.USAGE_RESOURCE_DISK, "zone2", "rbd", 3},
+		{api.USAGE_RESOURCE_DISK, "zone1", "rbd", 4},
+		{api.USAGE_RESOURCE_SERVER, "zone1", "ecs.g1.c1m1", 5},
+		{api.USAGE_RESOURCE_GPU, "zone1", "", -1},
+	}
+	for _, c := range cases {
+		card := matchRateCard(cards, c.resType, c.zoneId, c.spec)
+		if card == nil {
+			if c.want >= 0 {
+				t.Errorf("%s %s %s: want price %f, got no card", c.resType, c.zoneId, c.spec, c.want)
+			}
+			continue
+		}
+		if card.Price != c.want {
+			t.Errorf("%s %s %s: want price %f, got %f", c.resType, c.zoneId, c.spec, c.want, card.Price)
+		}
+	}
+}
+
+func TestEipUsageQuery(t *testing.T) {
+	start := time.Date(2021, 6, 1, 10, 0, 0, 0, time.UTC)
+	sql := eipUsageQuery(start, start.Add(time.Hour)).String()
+	for _, want := range []string{"`zone_id`", "`network_id`", "`wire_id`"} {
+		if !strings.Contains(sql, want) {
+			t.Errorf("eip usage query should contain %s: %s", want, sql)
+		}
+	}
+}
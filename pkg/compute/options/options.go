@@ -160,6 +160,9 @@ type ComputeOptions struct {
 	SyncExtDiskSnapshotIntervalMinutes int  `help:"sync snapshot for external disk" default:"20"`
 	AutoReconcileBackupServers         bool `help:"auto reconcile backup servers" default:"false"`
 
+	EnableUsageRecord        bool `help:"Enable collecting hourly usage records of on-premise resources" default:"false"`
+	UsageRecordBackfillHours int  `help:"Max hours of usage records to backfill after region was down" default:"168"`
+
 	SCapabilityOptions
 	SASControllerOptions
 	common_options.CommonOptions
@@ -32,6 +32,8 @@ import (
 func InitHandlers(app *appsrv.Application) {
 	db.InitAllManagers()
 
+	db.AddOpenApiHandler("", app)
+
 	db.RegistUserCredCacheUpdater()
 
 	db.AddScopeResourceCountHandler("", app)
@@ -231,6 +233,11 @@ func InitHandlers(app *appsrv.Application) {
 		models.ElasticSearchManager,
 
 		models.KafkaManager,
+
+		models.CDNDomainManager,
+
+		models.RateCardManager,
+		models.UsageRecordManager,
 	} {
 		db.RegisterModelManager(manager)
 		handler := db.NewModelHandler(manager)
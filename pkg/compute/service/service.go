@@ -167,6 +167,8 @@ func StartService() {
 		cron.AddJobAtIntervalsWithStartRun("ScheduledTaskCheck", time.Duration(60)*time.Second, models.ScheduledTaskManager.Timer, true)
 
 		cron.AddJobEveryFewHour("CheckBillingResourceExpireAt", 1, 0, 0, models.CheckBillingResourceExpireAt, true)
+
+		cron.AddJobEveryFewHour("CollectUsageRecords", 1, 5, 0, models.UsageRecordManager.CollectUsageRecords, false)
 		go cron.Start2(ctx, electObj)
 
 		// init auto scaling controller
@@ -0,0 +1,118 @@
+// Copyright 2019 Yunion
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package tasks
+
+import (
+	"context"
+
+	"yunion.io/x/jsonutils"
+	"yunion.io/x/pkg/errors"
+
+	api "yunion.io/x/onecloud/pkg/apis/compute"
+	"yunion.io/x/onecloud/pkg/cloudcommon/db"
+	"yunion.io/x/onecloud/pkg/cloudcommon/db/taskman"
+	"yunion.io/x/onecloud/pkg/compute/models"
+	"yunion.io/x/onecloud/pkg/util/logclient"
+)
+
+type CDNDomainPurgeCacheTask struct {
+	taskman.STask
+}
+
+type CDNDomainPrefetchTask struct {
+	taskman.STask
+}
+
+func init() {
+	taskman.RegisterTask(CDNDomainPurgeCacheTask{})
+	taskman.RegisterTask(CDNDomainPrefetchTask{})
+}
+
+// 刷新或预热失败不影响域名本身, 恢复为操作前的状态
+func restoreCDNDomainStatus(task taskman.ITask, domain *models.SCDNDomain, reason string) {
+	status, _ := task.GetParams().GetString("origin_status")
+	if len(status) == 0 {
+		status = api.CDN_DOMAIN_STATUS_UNKNOWN
+	}
+	domain.SetStatus(task.GetUserCred(), status, reason)
+}
+
+func (self *CDNDomainPurgeCacheTask) taskFailed(ctx context.Context, domain *models.SCDNDomain, err error) {
+	restoreCDNDomainStatus(self, domain, err.Error())
+	db.OpsLog.LogEvent(domain, db.ACT_CDN_PURGE_CACHE_FAILED, err, self.UserCred)
+	logclient.AddActionLogWithStartable(self, domain, logclient.ACT_CDN_PURGE_CACHE, err, self.UserCred, false)
+	self.SetStageFailed(ctx, jsonutils.NewString(err.Error()))
+}
+
+func (self *CDNDomainPurgeCacheTask) OnInit(ctx context.Context, obj db.IStandaloneModel, data jsonutils.JSONObject) {
+	domain := obj.(*models.SCDNDomain)
+
+	input := api.CDNDomainPurgeCacheInput{}
+	err := self.GetParams().Unmarshal(&input)
+	if err != nil {
+		self.taskFailed(ctx, domain, errors.Wrapf(err, "Unmarshal"))
+		return
+	}
+
+	iDomain, err := domain.GetICDNDomain()
+	if err != nil {
+		self.taskFailed(ctx, domain, errors.Wrapf(err, "GetICDNDomain"))
+		return
+	}
+	err = iDomain.PurgeCache(input.Type, input.Urls)
+	if err != nil {
+		self.taskFailed(ctx, domain, errors.Wrapf(err, "PurgeCache"))
+		return
+	}
+
+	restoreCDNDomainStatus(self, domain, "")
+	db.OpsLog.LogEvent(domain, db.ACT_CDN_PURGE_CACHE, input, self.UserCred)
+	logclient.AddActionLogWithStartable(self, domain, logclient.ACT_CDN_PURGE_CACHE, input, self.UserCred, true)
+	self.SetStageComplete(ctx, nil)
+}
+
+func (self *CDNDomainPrefetchTask) taskFailed(ctx context.Context, domain *models.SCDNDomain, err error) {
+	restoreCDNDomainStatus(self, domain, err.Error())
+	db.OpsLog.LogEvent(domain, db.ACT_CDN_PREFETCH_FAILED, err, self.UserCred)
+	logclient.AddActionLogWithStartable(self, domain, logclient.ACT_CDN_PREFETCH, err, self.UserCred, false)
+	self.SetStageFailed(ctx, jsonutils.NewString(err.Error()))
+}
+
+func (self *CDNDomainPrefetchTask) OnInit(ctx context.Context, obj db.IStandaloneModel, data jsonutils.JSONObject) {
+	domain := obj.(*models.SCDNDomain)
+
+	input := api.CDNDomainPrefetchInput{}
+	err := self.GetParams().Unmarshal(&input)
+	if err != nil {
+		self.taskFailed(ctx, domain, errors.Wrapf(err, "Unmarshal"))
+		return
+	}
+
+	iDomain, err := domain.GetICDNDomain()
+	if err != nil {
+		self.taskFailed(ctx, domain, errors.Wrapf(err, "GetICDNDomain"))
+		return
+	}
+	err = iDomain.Prefetch(input.Urls)
+	if err != nil {
+		self.taskFailed(ctx, domain, errors.Wrapf(err, "Prefetch"))
+		return
+	}
+
+	restoreCDNDomainStatus(self, domain, "")
+	db.OpsLog.LogEvent(domain, db.ACT_CDN_PREFETCH, input, self.UserCred)
+	logclient.AddActionLogWithStartable(self, domain, logclient.ACT_CDN_PREFETCH, input, self.UserCred, true)
+	self.SetStageComplete(ctx, nil)
+}
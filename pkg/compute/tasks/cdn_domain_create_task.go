@@ -0,0 +1,79 @@
+// Copyright 2019 Yunion
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package tasks
+
+import (
+	"context"
+
+	"yunion.io/x/jsonutils"
+	"yunion.io/x/pkg/errors"
+
+	api "yunion.io/x/onecloud/pkg/apis/compute"
+	"yunion.io/x/onecloud/pkg/cloudcommon/db"
+	"yunion.io/x/onecloud/pkg/cloudcommon/db/taskman"
+	"yunion.io/x/onecloud/pkg/cloudprovider"
+	"yunion.io/x/onecloud/pkg/compute/models"
+	"yunion.io/x/onecloud/pkg/util/logclient"
+)
+
+type CDNDomainCreateTask struct {
+	taskman.STask
+}
+
+func init() {
+	taskman.RegisterTask(CDNDomainCreateTask{})
+}
+
+func (self *CDNDomainCreateTask) taskFailed(ctx context.Context, domain *models.SCDNDomain, err error) {
+	domain.SetStatus(self.UserCred, api.CDN_DOMAIN_STATUS_CREATE_FAILED, err.Error())
+	db.OpsLog.LogEvent(domain, db.ACT_ALLOCATE_FAIL, err, self.UserCred)
+	logclient.AddActionLogWithStartable(self, domain, logclient.ACT_ALLOCATE, err, self.UserCred, false)
+	self.SetStageFailed(ctx, jsonutils.NewString(err.Error()))
+}
+
+func (self *CDNDomainCreateTask) OnInit(ctx context.Context, obj db.IStandaloneModel, data jsonutils.JSONObject) {
+	domain := obj.(*models.SCDNDomain)
+
+	provider, err := domain.GetDriver()
+	if err != nil {
+		self.taskFailed(ctx, domain, errors.Wrapf(err, "GetDriver"))
+		return
+	}
+
+	opts := &cloudprovider.CdnCreateOptions{
+		Domain:      domain.Name,
+		Area:        domain.Area,
+		ServiceType: domain.ServiceType,
+	}
+	if domain.Origins != nil {
+		opts.Origins = *domain.Origins
+	}
+
+	iDomain, err := provider.CreateICloudCDNDomain(opts)
+	if err != nil {
+		self.taskFailed(ctx, domain, errors.Wrapf(err, "CreateICloudCDNDomain"))
+		return
+	}
+
+	err = domain.SyncWithCloudCDNDomain(ctx, self.GetUserCred(), iDomain)
+	if err != nil {
+		self.taskFailed(ctx, domain, errors.Wrapf(err, "SyncWithCloudCDNDomain"))
+		return
+	}
+	domain.SetStatus(self.GetUserCred(), iDomain.GetStatus(), "")
+
+	logclient.AddActionLogWithStartable(self, domain, logclient.ACT_ALLOCATE, nil, self.UserCred, true)
+	self.SetStageComplete(ctx, nil)
+}
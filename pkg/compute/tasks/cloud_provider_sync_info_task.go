@@ -102,6 +102,7 @@ func (self *CloudProviderSyncInfoTask) OnSyncCloudProviderPreInfoComplete(ctx co
 	taskman.LocalTaskRunWithWorkers(self, func() (jsonutils.JSONObject, error) {
 		provider.SyncCallSyncCloudproviderRegions(ctx, self.UserCred, syncRange)
 		provider.SyncCallSyncCloudproviderInterVpcNetwork(ctx, self.UserCred)
+		provider.SyncCallSyncCloudproviderCDNDomains(ctx, self.UserCred)
 		return nil, nil
 	}, syncLocalTaskWorkerMan)
 }
@@ -24,6 +24,7 @@ import (
 
 func InitHandlers(app *appsrv.Application) {
 	db.InitAllManagers()
+	db.AddOpenApiHandler("", app)
 	taskman.AddTaskHandler("", app)
 
 	for _, manager := range []db.IModelManager{
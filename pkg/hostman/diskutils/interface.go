@@ -32,17 +32,18 @@ type DiskParams struct {
 	Hypervisor string
 	DiskPath   string
 	VddkInfo   *apis.VDDKConInfo
+	EncryptKey string
 }
 
 func GetIDisk(params DiskParams, driver string) IDisk {
 	hypervisor := params.Hypervisor
 	switch hypervisor {
 	case comapi.HYPERVISOR_KVM:
-		return NewKVMGuestDisk(params.DiskPath, driver)
+		return NewEncryptedKVMGuestDisk(params.DiskPath, driver, params.EncryptKey)
 	case comapi.HYPERVISOR_ESXI:
 		return NewVDDKDisk(params.VddkInfo, params.DiskPath, driver)
 	default:
-		return NewKVMGuestDisk(params.DiskPath, driver)
+		return NewEncryptedKVMGuestDisk(params.DiskPath, driver, params.EncryptKey)
 	}
 }
 
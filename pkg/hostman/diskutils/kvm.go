@@ -29,19 +29,24 @@ type SKVMGuestDisk struct {
 }
 
 func NewKVMGuestDisk(imagePath, driver string) *SKVMGuestDisk {
+	return NewEncryptedKVMGuestDisk(imagePath, driver, "")
+}
+
+// NewEncryptedKVMGuestDisk opens an encrypted disk by the base64 encoded key
+func NewEncryptedKVMGuestDisk(imagePath, driver, encryptKey string) *SKVMGuestDisk {
 	return &SKVMGuestDisk{
-		deployer: newDeployer(imagePath, driver),
+		deployer: newDeployer(imagePath, driver, encryptKey),
 	}
 }
 
-func newDeployer(imagePath, driver string) IDeployer {
+func newDeployer(imagePath, driver, encryptKey string) IDeployer {
 	switch driver {
 	case consts.DEPLOY_DRIVER_NBD:
-		return nbd.NewNBDDriver(imagePath)
+		return nbd.NewEncryptedNBDDriver(imagePath, encryptKey)
 	case consts.DEPLOY_DRIVER_LIBGUESTFS:
-		return libguestfs.NewLibguestfsDriver(imagePath)
+		return libguestfs.NewEncryptedLibguestfsDriver(imagePath, encryptKey)
 	default:
-		return nbd.NewNBDDriver(imagePath)
+		return nbd.NewEncryptedNBDDriver(imagePath, encryptKey)
 	}
 
 }
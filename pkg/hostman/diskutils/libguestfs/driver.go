@@ -54,20 +54,28 @@ func init() {
 }
 
 type SLibguestfsDriver struct {
-	imagePath string
-	nbddev    string
-	diskLabel string
-	lvmParts  []string
-	fsmap     *sortedmap.SSortedMap
-	fish      *guestfish.Guestfish
-	device    string
+	imagePath  string
+	encryptKey string
+	nbddev     string
+	diskLabel  string
+	lvmParts   []string
+	fsmap      *sortedmap.SSortedMap
+	fish       *guestfish.Guestfish
+	device     string
 
 	parts []fsdriver.IDiskPartition
 }
 
 func NewLibguestfsDriver(imagePath string) *SLibguestfsDriver {
+	return NewEncryptedLibguestfsDriver(imagePath, "")
+}
+
+// NewEncryptedLibguestfsDriver adds the nbd device of the luks opened image
+// to guestfish, guestfish never sees the key
+func NewEncryptedLibguestfsDriver(imagePath, encryptKey string) *SLibguestfsDriver {
 	return &SLibguestfsDriver{
-		imagePath: imagePath,
+		imagePath:  imagePath,
+		encryptKey: encryptKey,
 	}
 }
 
@@ -84,7 +92,11 @@ func (d *SLibguestfsDriver) Connect() error {
 	}
 	log.Debugf("acquired device %s", d.nbddev)
 
-	err = nbd.QemuNbdConnect(d.imagePath, d.nbddev)
+	if len(d.encryptKey) > 0 {
+		err = nbd.QemuNbdConnectEncrypted(d.imagePath, d.encryptKey, d.nbddev)
+	} else {
+		err = nbd.QemuNbdConnect(d.imagePath, d.nbddev)
+	}
 	if err != nil {
 		return err
 	}
@@ -41,13 +41,21 @@ type NBDDriver struct {
 	lvms                  []*SKVMGuestLVMPartition
 	imageRootBackFilePath string
 	imagePath             string
+	encryptKey            string
 	acquiredLvm           bool
 	nbdDev                string
 }
 
 func NewNBDDriver(imagePath string) *NBDDriver {
+	return NewEncryptedNBDDriver(imagePath, "")
+}
+
+// NewEncryptedNBDDriver opens the encrypted layers of the image by the base64
+// encoded key, so that the nbd device exposes the plain guest disk
+func NewEncryptedNBDDriver(imagePath, encryptKey string) *NBDDriver {
 	return &NBDDriver{
 		imagePath:  imagePath,
+		encryptKey: encryptKey,
 		partitions: make([]fsdriver.IDiskPartition, 0),
 	}
 }
@@ -69,7 +77,11 @@ func (d *NBDDriver) Connect() error {
 	if len(d.nbdDev) == 0 {
 		return errors.Errorf("Cannot get nbd device")
 	}
-	if err := QemuNbdConnect(d.imagePath, d.nbdDev); err != nil {
+	if len(d.encryptKey) > 0 {
+		if err := QemuNbdConnectEncrypted(d.imagePath, d.encryptKey, d.nbdDev); err != nil {
+			return err
+		}
+	} else if err := QemuNbdConnect(d.imagePath, d.nbdDev); err != nil {
 		return err
 	}
 
@@ -263,21 +275,8 @@ func (d *NBDDriver) IsLVMPartition() bool {
 func QemuNbdConnect(imagePath, nbddev string) error {
 	var cmd []string
 	if strings.HasPrefix(imagePath, "rbd:") || getImageFormat(imagePath) == "raw" {
-		//qemu-nbd 连接ceph时 /etc/ceph/ceph.conf 必须存在
-		if strings.HasPrefix(imagePath, "rbd:") {
-			err := procutils.NewRemoteCommandAsFarAsPossible("mkdir", "-p", "/etc/ceph").Run()
-			if err != nil {
-				log.Errorf("Failed to mkdir /etc/ceph: %s", err)
-				return errors.Wrap(err, "Failed to mkdir /etc/ceph: %s")
-			}
-			err = procutils.NewRemoteCommandAsFarAsPossible("test", "-f", "/etc/ceph/ceph.conf").Run()
-			if err != nil {
-				err = procutils.NewRemoteCommandAsFarAsPossible("touch", "/etc/ceph/ceph.conf").Run()
-				if err != nil {
-					log.Errorf("failed to create /etc/ceph/ceph.conf: %s", err)
-					return errors.Wrap(err, "failed to create /etc/ceph/ceph.conf")
-				}
-			}
+		if err := ensureCephConf(imagePath); err != nil {
+			return err
 		}
 		cmd = []string{qemutils.GetQemuNbd(), "-c", nbddev, "-f", "raw", imagePath}
 	} else {
@@ -291,6 +290,52 @@ func QemuNbdConnect(imagePath, nbddev string) error {
 	return nil
 }
 
+// QemuNbdConnectEncrypted connects the luks opened image to nbddev, qemu-nbd
+// loads the key secret on startup, the key file is removed once connected
+func QemuNbdConnectEncrypted(imagePath, encryptKey, nbddev string) error {
+	if err := ensureCephConf(imagePath); err != nil {
+		return err
+	}
+	img, err := qemuimg.NewQemuImage(imagePath)
+	if err != nil {
+		return errors.Wrapf(err, "open image %s", imagePath)
+	}
+	img.SetEncryptKey(encryptKey)
+	args, cleanup, err := img.SecretImageArgs()
+	if err != nil {
+		return errors.Wrap(err, "SecretImageArgs")
+	}
+	defer cleanup()
+	args = append([]string{"-c", nbddev}, args...)
+	output, err := procutils.NewRemoteCommandAsFarAsPossible(qemutils.GetQemuNbd(), args...).Output()
+	if err != nil {
+		log.Errorf("qemu-nbd connect encrypted %s failed %s %s", imagePath, output, err.Error())
+		return errors.Wrapf(err, "qemu-nbd connect failed %s", output)
+	}
+	return nil
+}
+
+func ensureCephConf(imagePath string) error {
+	if !strings.HasPrefix(imagePath, "rbd:") {
+		return nil
+	}
+	//qemu-nbd 连接ceph时 /etc/ceph/ceph.conf 必须存在
+	err := procutils.NewRemoteCommandAsFarAsPossible("mkdir", "-p", "/etc/ceph").Run()
+	if err != nil {
+		log.Errorf("Failed to mkdir /etc/ceph: %s", err)
+		return errors.Wrap(err, "Failed to mkdir /etc/ceph: %s")
+	}
+	err = procutils.NewRemoteCommandAsFarAsPossible("test", "-f", "/etc/ceph/ceph.conf").Run()
+	if err != nil {
+		err = procutils.NewRemoteCommandAsFarAsPossible("touch", "/etc/ceph/ceph.conf").Run()
+		if err != nil {
+			log.Errorf("failed to create /etc/ceph/ceph.conf: %s", err)
+			return errors.Wrap(err, "failed to create /etc/ceph/ceph.conf")
+		}
+	}
+	return nil
+}
+
 func getImageFormat(imagePath string) string {
 	lines, err := procutils.NewRemoteCommandAsFarAsPossible(qemutils.GetQemuImg(), "info", imagePath).Output()
 	if err != nil {
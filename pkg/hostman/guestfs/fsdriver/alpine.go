@@ -0,0 +1,139 @@
+// Copyright 2019 Yunion
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package fsdriver
+
+import (
+	"fmt"
+	"path"
+	"strings"
+
+	"yunion.io/x/log"
+
+	"yunion.io/x/onecloud/pkg/apis"
+	"yunion.io/x/onecloud/pkg/cloudcommon/types"
+	deployapi "yunion.io/x/onecloud/pkg/hostman/hostdeployer/apis"
+	"yunion.io/x/onecloud/pkg/util/netutils2"
+	"yunion.io/x/onecloud/pkg/util/procutils"
+)
+
+// SAlpineRootFs deploys Alpine Linux, which shares the ifupdown
+// /etc/network/interfaces format with debian, but boots with openrc and
+// links against musl
+type SAlpineRootFs struct {
+	*sDebianLikeRootFs
+}
+
+func NewAlpineRootFs(part IDiskPartition) IRootFsDriver {
+	return &SAlpineRootFs{sDebianLikeRootFs: newDebianLikeRootFs(part)}
+}
+
+func (d *SAlpineRootFs) GetName() string {
+	return "Alpine"
+}
+
+func (d *SAlpineRootFs) String() string {
+	return "AlpineRootFs"
+}
+
+func (d *SAlpineRootFs) DistroName() string {
+	return d.GetName()
+}
+
+func (d *SAlpineRootFs) VersionFilePath() string {
+	return "/etc/alpine-release"
+}
+
+func (d *SAlpineRootFs) RootSignatures() []string {
+	return []string{d.VersionFilePath(), "/bin", "/etc", "/lib", "/usr"}
+}
+
+func (d *SAlpineRootFs) GetArch(rootFs IDiskPartition) string {
+	for _, f := range rootFs.ListDir("/lib", false) {
+		if !strings.HasPrefix(f, "ld-musl-") {
+			continue
+		}
+		switch {
+		case strings.Contains(f, "x86_64"):
+			return apis.OS_ARCH_X86_64
+		case strings.Contains(f, apis.OS_ARCH_AARCH64):
+			return apis.OS_ARCH_AARCH64
+		case strings.Contains(f, "armhf"), strings.Contains(f, "armv7"):
+			return apis.OS_ARCH_AARCH32
+		case strings.Contains(f, "x86"):
+			return apis.OS_ARCH_X86_32
+		}
+	}
+	return apis.OS_ARCH_X86_64
+}
+
+func (d *SAlpineRootFs) GetReleaseInfo(rootFs IDiskPartition) *deployapi.ReleaseInfo {
+	version, _ := rootFs.FileGetContents(d.VersionFilePath(), false)
+	return deployapi.NewReleaseInfo(d.DistroName(), strings.TrimSpace(string(version)), d.GetArch(rootFs))
+}
+
+// enableOpenrcService adds an init script to a runlevel
+func (d *SAlpineRootFs) enableOpenrcService(rootFs IDiskPartition, service, runlevel string) {
+	if !rootFs.Exists(path.Join("/etc/init.d", service), false) {
+		return
+	}
+	if rootFs.Exists(path.Join("/etc/runlevels", runlevel, service), false) {
+		return
+	}
+	err := procutils.NewCommand("chroot", rootFs.GetMountPath(), "rc-update", "add", service, runlevel).Run()
+	if err != nil {
+		log.Warningf("rc-update add %s %s: %v", service, runlevel, err)
+	}
+}
+
+func (d *SAlpineRootFs) DeployNetworkingScripts(rootFs IDiskPartition, nics []*types.SServerNic) error {
+	if err := d.sDebianLikeRootFs.DeployNetworkingScripts(rootFs, nics); err != nil {
+		return err
+	}
+	// busybox ifupdown ignores dns-nameservers, write resolv.conf directly
+	var resolv strings.Builder
+	domains := []string{}
+	for _, nic := range nics {
+		if !nic.Manual {
+			continue
+		}
+		for _, dns := range netutils2.GetNicDns(nic) {
+			for _, addr := range strings.Split(dns, ",") {
+				resolv.WriteString(fmt.Sprintf("nameserver %s\n", addr))
+			}
+		}
+		if len(nic.Domain) > 0 {
+			domains = append(domains, nic.Domain)
+		}
+	}
+	if resolv.Len() > 0 {
+		cont := resolv.String()
+		if len(domains) > 0 {
+			cont = fmt.Sprintf("search %s\n", strings.Join(domains, " ")) + cont
+		}
+		if err := rootFs.FilePutContents("/etc/resolv.conf", cont, false, false); err != nil {
+			return err
+		}
+	}
+	d.enableOpenrcService(rootFs, "networking", "boot")
+	return nil
+}
+
+func (d *SAlpineRootFs) DeployPublicKey(rootFs IDiskPartition, selUsr string, pubkeys *deployapi.SSHKeys) error {
+	if err := d.sDebianLikeRootFs.DeployPublicKey(rootFs, selUsr, pubkeys); err != nil {
+		return err
+	}
+	d.enableOpenrcService(rootFs, "sshd", "default")
+	return nil
+}
@@ -45,9 +45,11 @@ func Init(initPrivatePrefixes []string, cloudrootDir string) error {
 		NewCentosRootFs, NewFedoraRootFs, NewRhelRootFs,
 		NewDebianRootFs, NewCirrosRootFs, NewCirrosNewRootFs, NewUbuntuRootFs,
 		NewGentooRootFs, NewArchLinuxRootFs, NewOpenWrtRootFs, NewCoreOsRootFs,
-		NewOpenEulerRootFs,
+		NewFedoraCoreOsRootFs, NewFlatcarRootFs,
+		NewOpenEulerRootFs, NewSuseRootFs, NewAlpineRootFs,
 	}
 	rootfsDrivers = append(rootfsDrivers, linuxFsDrivers...)
+	rootfsDrivers = append(rootfsDrivers, NewFreeBSDRootFs)
 	rootfsDrivers = append(rootfsDrivers, NewMacOSRootFs)
 	rootfsDrivers = append(rootfsDrivers, NewEsxiRootFs)
 	rootfsDrivers = append(rootfsDrivers, NewWindowsRootFs)
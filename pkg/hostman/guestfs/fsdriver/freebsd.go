@@ -0,0 +1,438 @@
+// Copyright 2019 Yunion
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package fsdriver
+
+import (
+	"debug/elf"
+	"fmt"
+	"path"
+	"regexp"
+	"sort"
+	"strings"
+
+	"yunion.io/x/log"
+	"yunion.io/x/pkg/utils"
+
+	"yunion.io/x/onecloud/pkg/apis"
+	"yunion.io/x/onecloud/pkg/cloudcommon/types"
+	deployapi "yunion.io/x/onecloud/pkg/hostman/hostdeployer/apis"
+	"yunion.io/x/onecloud/pkg/util/fileutils2"
+	"yunion.io/x/onecloud/pkg/util/netutils2"
+	"yunion.io/x/onecloud/pkg/util/seclib2"
+)
+
+const (
+	freebsdRcConf        = "/etc/rc.conf"
+	freebsdFirstbootName = "cloudroot_firstboot"
+	freebsdFirstbootRc   = "/usr/local/etc/rc.d/" + freebsdFirstbootName
+	freebsdFirstboot     = "/firstboot"
+)
+
+// SFreeBSDRootFs deploys FreeBSD images on UFS or ZFS root. Binaries of the
+// guest can not run on the host, so settings go to rc.conf and what needs
+// the guest userland, such as password databases, is done by a rc script
+// run once at first boot
+type SFreeBSDRootFs struct {
+	*sGuestRootFsDriver
+
+	firstbootCmds []string
+}
+
+func NewFreeBSDRootFs(part IDiskPartition) IRootFsDriver {
+	return &SFreeBSDRootFs{sGuestRootFsDriver: newGuestRootFsDriver(part)}
+}
+
+func (d *SFreeBSDRootFs) GetName() string {
+	return "FreeBSD"
+}
+
+func (d *SFreeBSDRootFs) String() string {
+	return "FreeBSDRootFs"
+}
+
+func (d *SFreeBSDRootFs) GetOs() string {
+	return "FreeBSD"
+}
+
+func (d *SFreeBSDRootFs) RootSignatures() []string {
+	return []string{"/bin/freebsd-version", "/boot/kernel", "/etc"}
+}
+
+func (d *SFreeBSDRootFs) GetArch(rootFs IDiskPartition) string {
+	ldPath := rootFs.GetLocalPath("/libexec/ld-elf.so.1", false)
+	if len(ldPath) == 0 {
+		return apis.OS_ARCH_X86_64
+	}
+	f, err := elf.Open(ldPath)
+	if err != nil {
+		log.Errorf("open %s: %v", ldPath, err)
+		return apis.OS_ARCH_X86_64
+	}
+	defer f.Close()
+	switch f.Machine {
+	case elf.EM_AARCH64:
+		return apis.OS_ARCH_AARCH64
+	case elf.EM_386:
+		return apis.OS_ARCH_X86_32
+	default:
+		return apis.OS_ARCH_X86_64
+	}
+}
+
+var freebsdVersionRegexp = regexp.MustCompile(`USERLAND_VERSION="([^"]+)"`)
+
+func (d *SFreeBSDRootFs) GetReleaseInfo(rootFs IDiskPartition) *deployapi.ReleaseInfo {
+	var version string
+	cont, _ := rootFs.FileGetContents("/bin/freebsd-version", false)
+	if m := freebsdVersionRegexp.FindSubmatch(cont); len(m) > 1 {
+		// 13.2-RELEASE-p1 => 13.2
+		version = strings.Split(string(m[1]), "-")[0]
+	}
+	return deployapi.NewReleaseInfo(d.GetName(), version, d.GetArch(rootFs))
+}
+
+// setRcConf sets rc.conf variables, variables with an empty value and the
+// ones matching removePrefixes are removed
+func setRcConf(cont string, vals map[string]string, removePrefixes []string) string {
+	lines := []string{}
+	found := map[string]bool{}
+	for _, line := range strings.Split(strings.TrimRight(cont, "\n"), "\n") {
+		trimed := strings.TrimSpace(line)
+		pos := strings.Index(trimed, "=")
+		if pos <= 0 || strings.HasPrefix(trimed, "#") {
+			lines = append(lines, line)
+			continue
+		}
+		key := trimed[:pos]
+		if val, ok := vals[key]; ok {
+			if !found[key] && len(val) > 0 {
+				lines = append(lines, fmt.Sprintf(`%s="%s"`, key, val))
+			}
+			found[key] = true
+			continue
+		}
+		remove := false
+		for _, prefix := range removePrefixes {
+			if strings.HasPrefix(key, prefix) {
+				remove = true
+				break
+			}
+		}
+		if !remove {
+			lines = append(lines, line)
+		}
+	}
+	keys := []string{}
+	for k, v := range vals {
+		if !found[k] && len(v) > 0 {
+			keys = append(keys, k)
+		}
+	}
+	sort.Strings(keys)
+	for _, k := range keys {
+		lines = append(lines, fmt.Sprintf(`%s="%s"`, k, vals[k]))
+	}
+	return strings.Join(lines, "\n") + "\n"
+}
+
+func (d *SFreeBSDRootFs) updateRcConf(rootFs IDiskPartition, vals map[string]string, removePrefixes []string) error {
+	cont, _ := rootFs.FileGetContents(freebsdRcConf, false)
+	return rootFs.FilePutContents(freebsdRcConf, setRcConf(string(cont), vals, removePrefixes), false, false)
+}
+
+func (d *SFreeBSDRootFs) DeployHostname(rootFs IDiskPartition, hn, domain string) error {
+	return d.updateRcConf(rootFs, map[string]string{"hostname": getHostname(hn, domain)}, nil)
+}
+
+func (d *SFreeBSDRootFs) DeployHosts(rootFs IDiskPartition, hostname, domain string, ips []string) error {
+	var etcHosts = "/etc/hosts"
+	oldHostFile, _ := rootFs.FileGetContents(etcHosts, false)
+	hf := make(fileutils2.HostsFile, 0)
+	hf.Parse(string(oldHostFile))
+	hf.Add("127.0.0.1", "localhost")
+	for _, ip := range ips {
+		hf.Add(ip, getHostname(hostname, domain), hostname)
+	}
+	return rootFs.FilePutContents(etcHosts, hf.String(), false, false)
+}
+
+// freebsdNicDriverPrefix maps hypervisor nic models to FreeBSD driver names
+var freebsdNicDriverPrefix = map[string]string{
+	"virtio":  "vtnet",
+	"e1000":   "em",
+	"vmxnet3": "vmx",
+	"rtl8139": "re",
+}
+
+// getFreeBSDIfnames names nics the way FreeBSD probes them, numbered per
+// driver in pci order
+func getFreeBSDIfnames(nics []*types.SServerNic) map[int]string {
+	sorted := make([]*types.SServerNic, len(nics))
+	copy(sorted, nics)
+	sort.Slice(sorted, func(i, j int) bool { return sorted[i].Index < sorted[j].Index })
+	counter := map[string]int{}
+	ret := map[int]string{}
+	for _, nic := range sorted {
+		prefix, ok := freebsdNicDriverPrefix[nic.Driver]
+		if !ok {
+			prefix = freebsdNicDriverPrefix["virtio"]
+		}
+		ret[nic.Index] = fmt.Sprintf("%s%d", prefix, counter[prefix])
+		counter[prefix] += 1
+	}
+	return ret
+}
+
+func getFreeBSDNetworkConf(nics []*types.SServerNic) (map[string]string, []string, error) {
+	vals := map[string]string{}
+	dnss := []string{}
+	mainNic, err := getMainNic(nics)
+	if err != nil {
+		return nil, nil, err
+	}
+	var mainIp string
+	if mainNic != nil {
+		mainIp = mainNic.Ip
+	}
+	ifnames := getFreeBSDIfnames(nics)
+	routeNames := []string{}
+	for _, nic := range nics {
+		if len(nic.Mac) == 0 || len(nic.TeamWith) > 0 {
+			continue
+		}
+		ifname := ifnames[nic.Index]
+		var mtu string
+		if nic.Mtu > 0 {
+			mtu = fmt.Sprintf(" mtu %d", nic.Mtu)
+		}
+		isMain := len(mainIp) > 0 && nic.Ip == mainIp
+		if nic.Virtual {
+			vals["ifconfig_"+ifname] = fmt.Sprintf("inet %s netmask 255.255.255.255%s", netutils2.PSEUDO_VIP, mtu)
+		} else if nic.Manual {
+			vals["ifconfig_"+ifname] = fmt.Sprintf("inet %s netmask %s%s", nic.Ip, netutils2.Netlen2Mask(nic.Masklen), mtu)
+			if isMain && len(nic.Gateway) > 0 {
+				vals["defaultrouter"] = nic.Gateway
+			}
+			var routes = make([][]string, 0)
+			netutils2.AddNicRoutes(&routes, nic, mainIp, len(nics), privatePrefixes)
+			for _, r := range routes {
+				name := fmt.Sprintf("r%d", len(routeNames))
+				routeNames = append(routeNames, name)
+				vals["route_"+name] = fmt.Sprintf("-net %s %s", r[0], r[1])
+			}
+			for _, dns := range netutils2.GetNicDns(nic) {
+				dnss = append(dnss, strings.Split(dns, ",")...)
+			}
+		} else {
+			if len(mtu) > 0 {
+				vals["ifconfig_"+ifname] = "SYNCDHCP" + mtu
+			} else {
+				vals["ifconfig_"+ifname] = "SYNCDHCP"
+			}
+		}
+		// no DHCPv6 client in base system, ipv6 address is always static
+		if len(nic.Ip6) > 0 && !nic.Virtual {
+			vals[fmt.Sprintf("ifconfig_%s_ipv6", ifname)] = fmt.Sprintf("inet6 %s prefixlen %d", nic.Ip6, nic.Masklen6)
+			if isMain && len(nic.Gateway6) > 0 {
+				vals["ipv6_defaultrouter"] = nic.Gateway6
+			}
+			if len(nic.Dns6) > 0 && nic.Manual {
+				dnss = append(dnss, strings.Split(nic.Dns6, ",")...)
+			}
+		}
+	}
+	if len(routeNames) > 0 {
+		vals["static_routes"] = strings.Join(routeNames, " ")
+	}
+	return vals, dnss, nil
+}
+
+func (d *SFreeBSDRootFs) DeployNetworkingScripts(rootFs IDiskPartition, nics []*types.SServerNic) error {
+	vals, dnss, err := getFreeBSDNetworkConf(nics)
+	if err != nil {
+		return err
+	}
+	for _, key := range []string{"defaultrouter", "ipv6_defaultrouter", "static_routes"} {
+		if _, ok := vals[key]; !ok {
+			vals[key] = ""
+		}
+	}
+	if err := d.updateRcConf(rootFs, vals, []string{"ifconfig_", "route_"}); err != nil {
+		return err
+	}
+	if len(dnss) > 0 {
+		var resolv strings.Builder
+		for _, nic := range nics {
+			if nic.Manual && len(nic.Domain) > 0 {
+				resolv.WriteString(fmt.Sprintf("search %s\n", nic.Domain))
+				break
+			}
+		}
+		for _, dns := range dnss {
+			resolv.WriteString(fmt.Sprintf("nameserver %s\n", strings.TrimSpace(dns)))
+		}
+		return rootFs.FilePutContents("/etc/resolv.conf", resolv.String(), false, false)
+	}
+	return nil
+}
+
+func (d *SFreeBSDRootFs) GetLoginAccount(rootFs IDiskPartition, user string, defaultRootUser bool, windowsDefaultAdminUser bool) (string, error) {
+	if len(user) > 0 && user != ROOT_USER {
+		d.firstbootCmds = append(d.firstbootCmds,
+			fmt.Sprintf("id %s >/dev/null 2>&1 || pw useradd -n %s -m -G wheel -s /bin/sh", user, user))
+		return user, nil
+	}
+	return ROOT_USER, nil
+}
+
+func (d *SFreeBSDRootFs) DeployPublicKey(rootFs IDiskPartition, selUsr string, pubkeys *deployapi.SSHKeys) error {
+	if selUsr == ROOT_USER {
+		return DeployAuthorizedKeys(rootFs, "/root", pubkeys, false)
+	}
+	// home of new users only exists after first boot
+	keys := strings.TrimSpace(MergeAuthorizedKeys("", pubkeys))
+	if len(keys) == 0 {
+		return nil
+	}
+	home := path.Join("/home", selUsr)
+	d.firstbootCmds = append(d.firstbootCmds,
+		fmt.Sprintf("mkdir -p %s/.ssh", home),
+		fmt.Sprintf("cat >> %s/.ssh/authorized_keys <<'EOF'\n%s\nEOF", home, keys),
+		fmt.Sprintf("chown -R %s %s/.ssh", selUsr, home),
+		fmt.Sprintf("chmod 700 %s/.ssh && chmod 600 %s/.ssh/authorized_keys", home, home),
+	)
+	return nil
+}
+
+// ChangeUserPasswd sets a bcrypt hash, supported by FreeBSD crypt(3), at
+// first boot so that the password databases are rebuilt by pw
+func (d *SFreeBSDRootFs) ChangeUserPasswd(rootFs IDiskPartition, account, gid, publicKey, password string) (string, error) {
+	hash, err := seclib2.BcryptPassword(password)
+	if err != nil {
+		return "", fmt.Errorf("ChangeUserPasswd error: %v", err)
+	}
+	d.firstbootCmds = append(d.firstbootCmds, fmt.Sprintf("echo '%s' | pw usermod %s -H 0", hash, account))
+	if account == ROOT_USER {
+		if err := d.permitRootLogin(rootFs); err != nil {
+			return "", err
+		}
+	}
+	if len(publicKey) > 0 {
+		return seclib2.EncryptBase64(publicKey, password)
+	} else {
+		return utils.EncryptAESBase64(gid, password)
+	}
+}
+
+func (d *SFreeBSDRootFs) permitRootLogin(rootFs IDiskPartition) error {
+	sshdConfig := "/etc/ssh/sshd_config"
+	cont, err := rootFs.FileGetContents(sshdConfig, false)
+	if err != nil {
+		return nil
+	}
+	lines := strings.Split(string(cont), "\n")
+	for i, line := range lines {
+		if strings.HasPrefix(strings.TrimSpace(line), "PermitRootLogin") {
+			lines[i] = ""
+		}
+	}
+	newCont := strings.TrimRight(strings.Join(lines, "\n"), "\n") + "\nPermitRootLogin yes\n"
+	return rootFs.FilePutContents(sshdConfig, newCont, false, false)
+}
+
+func (d *SFreeBSDRootFs) DeployFiles(deploys []*deployapi.DeployContent) error {
+	return d.sGuestRootFsDriver.DeployFiles(deploys)
+}
+
+func (d *SFreeBSDRootFs) PrepareFsForTemplate(rootFs IDiskPartition) error {
+	if rootFs.Exists("/etc/ssh", false) {
+		for _, f := range rootFs.ListDir("/etc/ssh", false) {
+			if strings.HasSuffix(f, "_key") || strings.HasSuffix(f, "_key.pub") {
+				rootFs.Remove("/etc/ssh/"+f, false)
+			}
+		}
+	}
+	return d.CleanNetworkScripts(rootFs)
+}
+
+func (d *SFreeBSDRootFs) CleanNetworkScripts(rootFs IDiskPartition) error {
+	vals := map[string]string{"defaultrouter": "", "ipv6_defaultrouter": "", "static_routes": ""}
+	return d.updateRcConf(rootFs, vals, []string{"ifconfig_", "route_"})
+}
+
+func (d *SFreeBSDRootFs) DetectIsUEFISupport(rootFs IDiskPartition) bool {
+	return rootFs.Exists("/boot/loader.efi", false)
+}
+
+// IsResizeFsPartitionSupport is false as UFS and ZFS are grown by the guest,
+// growfs is enabled at first boot instead
+func (d *SFreeBSDRootFs) IsResizeFsPartitionSupport() bool {
+	return false
+}
+
+func (d *SFreeBSDRootFs) getFirstbootScript() string {
+	var cmds strings.Builder
+	for _, cmd := range d.firstbootCmds {
+		cmds.WriteString("\t")
+		cmds.WriteString(strings.Replace(cmd, "\n", "\n\t", -1))
+		cmds.WriteString("\n")
+	}
+	return fmt.Sprintf(`#!/bin/sh
+
+# PROVIDE: %[1]s
+# REQUIRE: FILESYSTEMS
+# BEFORE: LOGIN sshd
+# KEYWORD: firstboot
+
+. /etc/rc.subr
+
+name="%[1]s"
+start_cmd="%[1]s_start"
+stop_cmd=":"
+
+%[1]s_start()
+{
+%[2]s	rm -f %[3]s
+}
+
+load_rc_config $name
+run_rc_command "$1"
+`, freebsdFirstbootName, cmds.String(), freebsdFirstbootRc)
+}
+
+func (d *SFreeBSDRootFs) CommitChanges(rootFs IDiskPartition) error {
+	vals := map[string]string{"sshd_enable": "YES", "growfs_enable": "YES"}
+	if err := d.updateRcConf(rootFs, vals, nil); err != nil {
+		return err
+	}
+	if len(d.firstbootCmds) == 0 {
+		return nil
+	}
+	rcDir := path.Dir(freebsdFirstbootRc)
+	if !rootFs.Exists(rcDir, false) {
+		if err := rootFs.Mkdir(rcDir, 0755, false); err != nil {
+			return err
+		}
+	}
+	// the script removes itself, it is only written with sensitive commands
+	// readable by root
+	if err := rootFs.FilePutContents(freebsdFirstbootRc, d.getFirstbootScript(), false, false); err != nil {
+		return err
+	}
+	if err := rootFs.Chmod(freebsdFirstbootRc, 0700, false); err != nil {
+		return err
+	}
+	return rootFs.FilePutContents(freebsdFirstboot, "", false, false)
+}
@@ -0,0 +1,66 @@
+// Copyright 2019 Yunion
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package fsdriver
+
+import (
+	"testing"
+
+	"yunion.io/x/onecloud/pkg/cloudcommon/types"
+)
+
+func TestSetRcConf(t *testing.T) {
+	cont := "hostname=\"old\"\nifconfig_vtnet0=\"DHCP\"\nifconfig_vtnet1=\"DHCP\"\n# ifconfig_em0=\"DHCP\"\nsshd_enable=\"YES\"\n"
+	vals := map[string]string{
+		"hostname":        "new",
+		"ifconfig_vtnet0": "SYNCDHCP",
+		"defaultrouter":   "",
+	}
+	want := "hostname=\"new\"\nifconfig_vtnet0=\"SYNCDHCP\"\n# ifconfig_em0=\"DHCP\"\nsshd_enable=\"YES\"\n"
+	if got := setRcConf(cont, vals, []string{"ifconfig_"}); got != want {
+		t.Errorf("setRcConf() = %q, want %q", got, want)
+	}
+}
+
+func TestGetFreeBSDNetworkConf(t *testing.T) {
+	nics := []*types.SServerNic{
+		{
+			Index: 0, Driver: "virtio", Mac: "00:22:33:44:55:66", Manual: true,
+			Ip: "10.0.0.2", Masklen: 24, Gateway: "10.0.0.1", Dns: "114.114.114.114",
+			Ip6: "fd00::2", Masklen6: 64, Gateway6: "fd00::1",
+		},
+		{Index: 1, Driver: "e1000", Mac: "00:22:33:44:55:67", Ip: "192.168.0.2", Mtu: 1450},
+		{Index: 2, Driver: "virtio", Mac: "00:22:33:44:55:68", Ip: "192.168.1.2"},
+	}
+	vals, dnss, err := getFreeBSDNetworkConf(nics)
+	if err != nil {
+		t.Fatalf("getFreeBSDNetworkConf() error = %v", err)
+	}
+	want := map[string]string{
+		"ifconfig_vtnet0":      "inet 10.0.0.2 netmask 255.255.255.0",
+		"ifconfig_vtnet0_ipv6": "inet6 fd00::2 prefixlen 64",
+		"defaultrouter":        "10.0.0.1",
+		"ipv6_defaultrouter":   "fd00::1",
+		"ifconfig_em0":         "SYNCDHCP mtu 1450",
+		"ifconfig_vtnet1":      "SYNCDHCP",
+	}
+	for k, v := range want {
+		if vals[k] != v {
+			t.Errorf("getFreeBSDNetworkConf() %s = %q, want %q", k, vals[k], v)
+		}
+	}
+	if len(dnss) != 1 || dnss[0] != "114.114.114.114" {
+		t.Errorf("getFreeBSDNetworkConf() dns = %v", dnss)
+	}
+}
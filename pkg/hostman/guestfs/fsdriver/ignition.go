@@ -0,0 +1,186 @@
+// Copyright 2019 Yunion
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package fsdriver
+
+import (
+	"path"
+	"strings"
+
+	"yunion.io/x/log"
+	"yunion.io/x/pkg/utils"
+
+	"yunion.io/x/onecloud/pkg/apis"
+	"yunion.io/x/onecloud/pkg/cloudcommon/types"
+	deployapi "yunion.io/x/onecloud/pkg/hostman/hostdeployer/apis"
+	"yunion.io/x/onecloud/pkg/util/ignition"
+	"yunion.io/x/onecloud/pkg/util/seclib2"
+)
+
+// sIgnitionRootFs is the base of immutable distributions provisioned by
+// Ignition at first boot. Nothing is written into the image, hostname,
+// users, keys and networks are served as an Ignition config by the host
+// metadata service, see pkg/hostman/metadata.
+type sIgnitionRootFs struct {
+	*sGuestRootFsDriver
+}
+
+func newIgnitionRootFs(part IDiskPartition) *sIgnitionRootFs {
+	return &sIgnitionRootFs{sGuestRootFsDriver: newGuestRootFsDriver(part)}
+}
+
+func (d *sIgnitionRootFs) GetOs() string {
+	return "Linux"
+}
+
+func (d *sIgnitionRootFs) PrepareFsForTemplate(rootFs IDiskPartition) error {
+	return nil
+}
+
+func (d *sIgnitionRootFs) DeployHostname(rootFs IDiskPartition, hn, domain string) error {
+	return nil
+}
+
+func (d *sIgnitionRootFs) DeployHosts(rootFs IDiskPartition, hostname, domain string, ips []string) error {
+	return nil
+}
+
+func (d *sIgnitionRootFs) DeployNetworkingScripts(rootFs IDiskPartition, nics []*types.SServerNic) error {
+	return nil
+}
+
+func (d *sIgnitionRootFs) DeployPublicKey(rootFs IDiskPartition, selUsr string, pubkeys *deployapi.SSHKeys) error {
+	return nil
+}
+
+func (d *sIgnitionRootFs) DeployFiles(deploys []*deployapi.DeployContent) error {
+	if len(deploys) > 0 {
+		log.Warningf("deploy files is not supported by ignition based os, use Ignition or Butane user data instead")
+	}
+	return nil
+}
+
+func (d *sIgnitionRootFs) GetLoginAccount(rootFs IDiskPartition, user string, defaultRootUser bool, windowsDefaultAdminUser bool) (string, error) {
+	if len(user) > 0 && user != ROOT_USER {
+		return user, nil
+	}
+	return ignition.DEFAULT_USER, nil
+}
+
+// ChangeUserPasswd only returns the login key, the metadata service
+// recovers the password from it to set the user password hash
+func (d *sIgnitionRootFs) ChangeUserPasswd(rootFs IDiskPartition, account, gid, publicKey, password string) (string, error) {
+	if len(publicKey) > 0 {
+		return seclib2.EncryptBase64(publicKey, password)
+	} else {
+		return utils.EncryptAESBase64(gid, password)
+	}
+}
+
+// IsResizeFsPartitionSupport is false as root partition grows itself at
+// first boot
+func (d *sIgnitionRootFs) IsResizeFsPartitionSupport() bool {
+	return false
+}
+
+func parseOsRelease(cont string) map[string]string {
+	ret := map[string]string{}
+	for _, line := range strings.Split(cont, "\n") {
+		line = strings.TrimSpace(line)
+		pos := strings.Index(line, "=")
+		if pos <= 0 || strings.HasPrefix(line, "#") {
+			continue
+		}
+		ret[line[:pos]] = strings.Trim(line[pos+1:], `"'`)
+	}
+	return ret
+}
+
+func getIgnitionArch(rootFs IDiskPartition, libDir string) string {
+	if rootFs.Exists(path.Join(libDir, "ld-linux-aarch64.so.1"), false) {
+		return apis.OS_ARCH_AARCH64
+	}
+	return apis.OS_ARCH_X86_64
+}
+
+type SFedoraCoreOsRootFs struct {
+	*sIgnitionRootFs
+}
+
+func NewFedoraCoreOsRootFs(part IDiskPartition) IRootFsDriver {
+	return &SFedoraCoreOsRootFs{sIgnitionRootFs: newIgnitionRootFs(part)}
+}
+
+const fcosDeployDir = "/ostree/deploy/fedora-coreos/deploy"
+
+func (d *SFedoraCoreOsRootFs) GetName() string {
+	return "Fedora CoreOS"
+}
+
+func (d *SFedoraCoreOsRootFs) String() string {
+	return "FedoraCoreOsRootFs"
+}
+
+func (d *SFedoraCoreOsRootFs) RootSignatures() []string {
+	return []string{fcosDeployDir}
+}
+
+// getDeployRoot returns the root of the ostree deployment shipped in the image
+func (d *SFedoraCoreOsRootFs) getDeployRoot(rootFs IDiskPartition) string {
+	for _, dir := range rootFs.ListDir(fcosDeployDir, false) {
+		if strings.HasSuffix(dir, ".origin") {
+			continue
+		}
+		return path.Join(fcosDeployDir, dir)
+	}
+	return ""
+}
+
+func (d *SFedoraCoreOsRootFs) GetReleaseInfo(rootFs IDiskPartition) *deployapi.ReleaseInfo {
+	var version string
+	deployRoot := d.getDeployRoot(rootFs)
+	if len(deployRoot) > 0 {
+		rel, _ := rootFs.FileGetContents(path.Join(deployRoot, "usr/lib/os-release"), false)
+		version = parseOsRelease(string(rel))["VERSION_ID"]
+	}
+	return deployapi.NewReleaseInfo(d.GetName(), version, getIgnitionArch(rootFs, path.Join(deployRoot, "usr/lib")))
+}
+
+type SFlatcarRootFs struct {
+	*sIgnitionRootFs
+}
+
+// NewFlatcarRootFs detects the USR-A partition of Flatcar, the ROOT
+// partition is empty until first boot
+func NewFlatcarRootFs(part IDiskPartition) IRootFsDriver {
+	return &SFlatcarRootFs{sIgnitionRootFs: newIgnitionRootFs(part)}
+}
+
+func (d *SFlatcarRootFs) GetName() string {
+	return "Flatcar"
+}
+
+func (d *SFlatcarRootFs) String() string {
+	return "FlatcarRootFs"
+}
+
+func (d *SFlatcarRootFs) RootSignatures() []string {
+	return []string{"/share/flatcar", "/lib/os-release"}
+}
+
+func (d *SFlatcarRootFs) GetReleaseInfo(rootFs IDiskPartition) *deployapi.ReleaseInfo {
+	rel, _ := rootFs.FileGetContents("/lib/os-release", false)
+	version := parseOsRelease(string(rel))["VERSION_ID"]
+	return deployapi.NewReleaseInfo(d.GetName(), version, getIgnitionArch(rootFs, "/lib64"))
+}
@@ -641,6 +641,35 @@ func (d *sDebianLikeRootFs) deployNetplanConfigFile(rootFs IDiskPartition, nics
 	return nil
 }
 
+// getDebianIPv6Config returns the inet6 stanza of a dual stack nic.  Nics
+// not configured manually get their address by dhcpv6, the default route is
+// always set statically as router advertisements of the host agent do not
+// announce a default router
+func getDebianIPv6Config(nicDesc *types.SServerNic, isMain bool) string {
+	if len(nicDesc.Ip6) == 0 {
+		return ""
+	}
+	var cmds strings.Builder
+	if nicDesc.Manual {
+		cmds.WriteString(fmt.Sprintf("iface %s inet6 static\n", nicDesc.Name))
+		cmds.WriteString(fmt.Sprintf("    address %s\n", nicDesc.Ip6))
+		cmds.WriteString(fmt.Sprintf("    netmask %d\n", nicDesc.Masklen6))
+		if len(nicDesc.Gateway6) > 0 && isMain {
+			cmds.WriteString(fmt.Sprintf("    gateway %s\n", nicDesc.Gateway6))
+		}
+		if len(nicDesc.Dns6) > 0 {
+			cmds.WriteString(fmt.Sprintf("    dns-nameservers %s\n", strings.Replace(nicDesc.Dns6, ",", " ", -1)))
+		}
+	} else {
+		cmds.WriteString(fmt.Sprintf("iface %s inet6 dhcp\n", nicDesc.Name))
+		if len(nicDesc.Gateway6) > 0 && isMain {
+			cmds.WriteString(fmt.Sprintf("    up ip -6 route replace default via %s dev %s || true\n", nicDesc.Gateway6, nicDesc.Name))
+		}
+	}
+	cmds.WriteString("\n")
+	return cmds.String()
+}
+
 func (d *sDebianLikeRootFs) DeployNetworkingScripts(rootFs IDiskPartition, nics []*types.SServerNic) error {
 	if err := d.sLinuxRootFs.DeployNetworkingScripts(rootFs, nics); err != nil {
 		return err
@@ -718,12 +747,14 @@ func (d *sDebianLikeRootFs) DeployNetworkingScripts(rootFs IDiskPartition, nics
 				cmds.WriteString(getNicTeamingConfigCmds(nicDesc.TeamingSlaves))
 			}
 			cmds.WriteString("\n")
+			cmds.WriteString(getDebianIPv6Config(nicDesc, nicDesc.Ip == mainIp))
 		} else {
 			cmds.WriteString(fmt.Sprintf("iface %s inet dhcp\n", nicDesc.Name))
 			if len(nicDesc.TeamingSlaves) > 0 {
 				cmds.WriteString(getNicTeamingConfigCmds(nicDesc.TeamingSlaves))
 			}
 			cmds.WriteString("\n")
+			cmds.WriteString(getDebianIPv6Config(nicDesc, nicDesc.Ip == mainIp))
 		}
 	}
 
@@ -1036,6 +1067,30 @@ func (r *sRedhatLikeRootFs) enableBondingModule(rootFs IDiskPartition, bondNics
 	return rootFs.FilePutContents("/etc/modprobe.d/bonding.conf", content.String(), false, false)
 }
 
+func getRedhatIPv6Config(nicDesc *types.SServerNic, isMain bool) string {
+	if len(nicDesc.Ip6) == 0 {
+		return ""
+	}
+	var cmds strings.Builder
+	cmds.WriteString("IPV6INIT=yes\n")
+	cmds.WriteString("IPV6_AUTOCONF=no\n")
+	if nicDesc.Manual {
+		cmds.WriteString(fmt.Sprintf("IPV6ADDR=%s/%d\n", nicDesc.Ip6, nicDesc.Masklen6))
+		for i, dns := range strings.Split(nicDesc.Dns6, ",") {
+			if len(dns) > 0 {
+				// DNS1-2 may be taken by ipv4 servers
+				cmds.WriteString(fmt.Sprintf("DNS%d=%s\n", i+3, dns))
+			}
+		}
+	} else {
+		cmds.WriteString("DHCPV6C=yes\n")
+	}
+	if len(nicDesc.Gateway6) > 0 && isMain {
+		cmds.WriteString(fmt.Sprintf("IPV6_DEFAULTGW=%s\n", nicDesc.Gateway6))
+	}
+	return cmds.String()
+}
+
 func (r *sRedhatLikeRootFs) deployNetworkingScripts(rootFs IDiskPartition, nics []*types.SServerNic, relInfo *deployapi.ReleaseInfo) error {
 	if err := r.sLinuxRootFs.DeployNetworkingScripts(rootFs, nics); err != nil {
 		return err
@@ -1154,6 +1209,9 @@ func (r *sRedhatLikeRootFs) deployNetworkingScripts(rootFs IDiskPartition, nics
 		} else {
 			cmds.WriteString("BOOTPROTO=dhcp\n")
 		}
+		if nicDesc.TeamingMaster == nil && !nicDesc.Virtual {
+			cmds.WriteString(getRedhatIPv6Config(nicDesc, nicDesc.Ip == mainIp))
+		}
 		var fn = fmt.Sprintf("/etc/sysconfig/network-scripts/ifcfg-%s", nicDesc.Name)
 		log.Debugf("%s: %s", fn, cmds.String())
 		if err := rootFs.FilePutContents(fn, cmds.String(), false, false); err != nil {
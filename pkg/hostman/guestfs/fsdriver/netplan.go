@@ -121,5 +121,14 @@ func getNetplanEthernetConfig(nic *types.SServerNic, isBond bool) *netplan.Ether
 		nicConf = netplan.NewDHCP4EthernetConfig()
 	}
 
+	if len(nic.Ip6) > 0 && !nic.Virtual {
+		if nic.Manual {
+			nicConf.Addresses = append(nicConf.Addresses, fmt.Sprintf("%s/%d", nic.Ip6, nic.Masklen6))
+		} else {
+			nicConf.DHCP6 = true
+		}
+		nicConf.Gateway6 = nic.Gateway6
+	}
+
 	return nicConf
 }
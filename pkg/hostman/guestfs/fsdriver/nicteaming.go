@@ -105,10 +105,14 @@ func convertNicConfigs(nics []*types.SServerNic) ([]*types.SServerNic, []*types.
 		nnic.TeamingMaster = master
 		nnic.Ip = ""
 		nnic.Gateway = ""
+		nnic.Ip6 = ""
+		nnic.Gateway6 = ""
 		tnic.Name = fmt.Sprintf("%s%d", NetDevPrefix, tnic.Index)
 		tnic.TeamingMaster = master
 		tnic.Ip = ""
 		tnic.Gateway = ""
+		tnic.Ip6 = ""
+		tnic.Gateway6 = ""
 		master.Name = fmt.Sprintf("bond%d", len(bondNics))
 		master.TeamingSlaves = []*types.SServerNic{&nnic, &tnic}
 		master.Mac = ""
@@ -0,0 +1,408 @@
+// Copyright 2019 Yunion
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package fsdriver_test
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+	"sync"
+	"testing"
+
+	"yunion.io/x/pkg/utils"
+
+	"yunion.io/x/onecloud/pkg/cloudcommon/types"
+	"yunion.io/x/onecloud/pkg/hostman/guestfs"
+	"yunion.io/x/onecloud/pkg/hostman/guestfs/fsdriver"
+	"yunion.io/x/onecloud/pkg/hostman/guestfs/kvmpart"
+	"yunion.io/x/onecloud/pkg/util/seclib2"
+)
+
+// sFakePartition is a root filesystem laid out in a temporary directory,
+// passwords are recorded instead of running passwd in a chroot
+type sFakePartition struct {
+	*kvmpart.SLocalGuestFS
+
+	passwords map[string]string
+}
+
+func (p *sFakePartition) Passwd(account, password string, caseInsensitive bool) error {
+	p.passwords[account] = password
+	return nil
+}
+
+func (p *sFakePartition) GetPartDev() string               { return "/dev/fake1" }
+func (p *sFakePartition) IsMounted() bool                  { return true }
+func (p *sFakePartition) Mount() bool                      { return true }
+func (p *sFakePartition) MountPartReadOnly() bool          { return true }
+func (p *sFakePartition) Umount() error                    { return nil }
+func (p *sFakePartition) IsReadonly() bool                 { return false }
+func (p *sFakePartition) GetPhysicalPartitionType() string { return "mbr" }
+func (p *sFakePartition) Zerofree()                        {}
+
+var initDriversOnce sync.Once
+
+// newFakeRootfs creates the files of a root filesystem, a path ending with
+// a slash is created as a directory
+func newFakeRootfs(t *testing.T, files map[string]string) (*sFakePartition, func()) {
+	initDriversOnce.Do(func() {
+		if err := fsdriver.Init(nil, ""); err != nil {
+			t.Fatalf("fsdriver.Init: %v", err)
+		}
+	})
+	root, err := ioutil.TempDir("", "fake-rootfs")
+	if err != nil {
+		t.Fatalf("TempDir: %v", err)
+	}
+	for name, cont := range files {
+		local := filepath.Join(root, name)
+		if strings.HasSuffix(name, "/") {
+			err = os.MkdirAll(local, 0755)
+		} else if err = os.MkdirAll(filepath.Dir(local), 0755); err == nil {
+			err = ioutil.WriteFile(local, []byte(cont), 0644)
+		}
+		if err != nil {
+			os.RemoveAll(root)
+			t.Fatalf("create %s: %v", name, err)
+		}
+	}
+	part := &sFakePartition{
+		SLocalGuestFS: kvmpart.NewLocalGuestFS(root),
+		passwords:     map[string]string{},
+	}
+	return part, func() { os.RemoveAll(root) }
+}
+
+func readFakeFile(t *testing.T, part fsdriver.IDiskPartition, name string) string {
+	cont, err := part.FileGetContents(name, false)
+	if err != nil {
+		t.Fatalf("read %s: %v", name, err)
+	}
+	return string(cont)
+}
+
+var linuxDirs = map[string]string{
+	"/bin/":  "",
+	"/boot/": "",
+	"/etc/":  "",
+	"/lib/":  "",
+	"/usr/":  "",
+}
+
+func withFiles(base map[string]string, files map[string]string) map[string]string {
+	ret := map[string]string{}
+	for k, v := range base {
+		ret[k] = v
+	}
+	for k, v := range files {
+		ret[k] = v
+	}
+	return ret
+}
+
+func openSuseFiles() map[string]string {
+	return withFiles(linuxDirs, map[string]string{
+		"/etc/os-release":                     "NAME=\"openSUSE Leap\"\nVERSION_ID=\"15.5\"\nID=\"opensuse-leap\"\n",
+		"/etc/hostname":                       "localhost\n",
+		"/etc/sysconfig/network/ifcfg-lo":     "STARTMODE='nfsroot'\nBOOTPROTO='static'\n",
+		"/etc/sysconfig/network/ifcfg-eth1":   "BOOTPROTO='dhcp'\n",
+		"/etc/sysconfig/network/ifroute-eth1": "default 192.168.0.1 - eth1\n",
+		"/etc/sysconfig/network/config":       "NETCONFIG_DNS_POLICY=\"auto\"\nNETCONFIG_DNS_STATIC_SERVERS=\"\"\n",
+	})
+}
+
+func alpineFiles() map[string]string {
+	return withFiles(linuxDirs, map[string]string{
+		"/etc/alpine-release":            "3.18.4\n",
+		"/etc/hostname":                  "localhost\n",
+		"/etc/network/interfaces":        "auto lo\niface lo inet loopback\n",
+		"/lib/ld-musl-x86_64.so.1":       "",
+		"/etc/init.d/networking":         "",
+		"/etc/runlevels/boot/networking": "",
+		"/etc/init.d/sshd":               "",
+		"/etc/runlevels/default/sshd":    "",
+	})
+}
+
+func freebsdFiles() map[string]string {
+	return map[string]string{
+		"/bin/freebsd-version":  "#!/bin/sh\nUSERLAND_VERSION=\"13.2-RELEASE-p1\"\n",
+		"/boot/kernel/":         "",
+		"/lib/":                 "",
+		"/usr/":                 "",
+		"/etc/rc.conf":          "hostname=\"freebsd\"\nifconfig_em0=\"DHCP\"\nsshd_enable=\"NO\"\n",
+		"/etc/ssh/sshd_config":  "#PermitRootLogin no\nPermitRootLogin no\nUseDNS no\n",
+		"/etc/ssh/ssh_host_key": "",
+	}
+}
+
+func TestDetectRootFs(t *testing.T) {
+	cases := []struct {
+		name        string
+		files       map[string]string
+		wantDriver  string
+		wantDistro  string
+		wantVersion string
+		wantArch    string
+	}{
+		{
+			name:        "openSUSE",
+			files:       openSuseFiles(),
+			wantDriver:  "SUSE",
+			wantDistro:  "OpenSUSE",
+			wantVersion: "15.5",
+		},
+		{
+			name: "SLES",
+			files: withFiles(openSuseFiles(), map[string]string{
+				"/etc/os-release": "NAME=\"SLES\"\nVERSION_ID=\"15.4\"\nID=\"sles\"\n",
+			}),
+			wantDriver:  "SUSE",
+			wantDistro:  "SUSE",
+			wantVersion: "15.4",
+		},
+		{
+			// the sysconfig network directory of SUSE is also a signature of redhat
+			// like distributions, which are tested before SUSE
+			name: "SUSE with redhat-release is redhat like",
+			files: withFiles(openSuseFiles(), map[string]string{
+				"/etc/redhat-release": "CentOS Linux release 7.9.2009 (Core)\n",
+				"/etc/centos-release": "CentOS Linux release 7.9.2009 (Core)\n",
+			}),
+			wantDriver: "CentOS",
+		},
+		{
+			// alpine shares /etc/hostname and /etc/network/interfaces with debian,
+			// debian is tested first and requires /etc/debian_version
+			name:        "Alpine",
+			files:       alpineFiles(),
+			wantDriver:  "Alpine",
+			wantDistro:  "Alpine",
+			wantVersion: "3.18.4",
+			wantArch:    "x86_64",
+		},
+		{
+			name: "Alpine aarch64",
+			files: withFiles(alpineFiles(), map[string]string{
+				"/lib/ld-musl-x86_64.so.1":  "",
+				"/lib/ld-musl-aarch64.so.1": "",
+			}),
+			wantDriver: "Alpine",
+		},
+		{
+			name: "Debian is not Alpine",
+			files: withFiles(alpineFiles(), map[string]string{
+				"/etc/debian_version": "12.2\n",
+			}),
+			wantDriver: "Debian",
+		},
+		{
+			// FreeBSD is tested after all linux distributions
+			name:        "FreeBSD",
+			files:       freebsdFiles(),
+			wantDriver:  "FreeBSD",
+			wantDistro:  "FreeBSD",
+			wantVersion: "13.2",
+			wantArch:    "x86_64",
+		},
+	}
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			part, cleanup := newFakeRootfs(t, c.files)
+			defer cleanup()
+			driver := guestfs.DetectRootFs(part)
+			if driver == nil {
+				t.Fatalf("no rootfs driver detected")
+			}
+			if driver.GetName() != c.wantDriver {
+				t.Fatalf("detected %s, want %s", driver.GetName(), c.wantDriver)
+			}
+			if len(c.wantDistro) == 0 {
+				return
+			}
+			info := driver.GetReleaseInfo(part)
+			if info.Distro != c.wantDistro || info.Version != c.wantVersion {
+				t.Errorf("release %s %s, want %s %s", info.Distro, info.Version, c.wantDistro, c.wantVersion)
+			}
+			if len(c.wantArch) > 0 && info.Arch != c.wantArch {
+				t.Errorf("arch %s, want %s", info.Arch, c.wantArch)
+			}
+		})
+	}
+}
+
+func testNics() []*types.SServerNic {
+	return []*types.SServerNic{
+		{
+			Index: 0, Driver: "virtio", Mac: "00:22:33:44:55:66", Manual: true,
+			Ip: "10.0.0.2", Masklen: 24, Gateway: "10.0.0.1",
+			Dns: "114.114.114.114", Domain: "example.com",
+		},
+		{Index: 1, Driver: "virtio", Mac: "00:22:33:44:55:67", Ip: "192.168.0.2"},
+	}
+}
+
+func TestSuseDeployNetworkingScripts(t *testing.T) {
+	part, cleanup := newFakeRootfs(t, openSuseFiles())
+	defer cleanup()
+	driver := fsdriver.NewSuseRootFs(part)
+
+	if err := driver.DeployNetworkingScripts(part, testNics()); err != nil {
+		t.Fatalf("DeployNetworkingScripts: %v", err)
+	}
+	eth0 := readFakeFile(t, part, "/etc/sysconfig/network/ifcfg-eth0")
+	for _, line := range []string{"LLADDR='00:22:33:44:55:66'", "BOOTPROTO='static'", "IPADDR='10.0.0.2/24'"} {
+		if !strings.Contains(eth0, line+"\n") {
+			t.Errorf("ifcfg-eth0 has no %s:\n%s", line, eth0)
+		}
+	}
+	if eth1 := readFakeFile(t, part, "/etc/sysconfig/network/ifcfg-eth1"); !strings.Contains(eth1, "BOOTPROTO='dhcp4'\n") {
+		t.Errorf("ifcfg-eth1 should be dhcp:\n%s", eth1)
+	}
+	if part.Exists("/etc/sysconfig/network/ifroute-eth1", false) {
+		t.Errorf("stale ifroute-eth1 should be removed")
+	}
+	if !part.Exists("/etc/sysconfig/network/ifcfg-lo", false) {
+		t.Errorf("ifcfg-lo should be kept")
+	}
+	if routes := readFakeFile(t, part, "/etc/sysconfig/network/routes"); routes != "default 10.0.0.1 - eth0\n" {
+		t.Errorf("unexpected routes %q", routes)
+	}
+	config := readFakeFile(t, part, "/etc/sysconfig/network/config")
+	want := "NETCONFIG_DNS_POLICY=\"auto\"\nNETCONFIG_DNS_STATIC_SERVERS=\"114.114.114.114\"\nNETCONFIG_DNS_STATIC_SEARCHLIST=\"example.com\"\n"
+	if config != want {
+		t.Errorf("network config %q, want %q", config, want)
+	}
+
+	if err := driver.DeployHostname(part, "vm1", "example.com"); err != nil {
+		t.Fatalf("DeployHostname: %v", err)
+	}
+	if hn := readFakeFile(t, part, "/etc/hostname"); hn != "vm1" {
+		t.Errorf("hostname %q", hn)
+	}
+}
+
+func TestAlpineDeployNetworkingScripts(t *testing.T) {
+	part, cleanup := newFakeRootfs(t, alpineFiles())
+	defer cleanup()
+	driver := fsdriver.NewAlpineRootFs(part)
+
+	if err := driver.DeployNetworkingScripts(part, testNics()); err != nil {
+		t.Fatalf("DeployNetworkingScripts: %v", err)
+	}
+	interfaces := readFakeFile(t, part, "/etc/network/interfaces")
+	for _, line := range []string{"auto lo", "iface eth0 inet static", "    address 10.0.0.2", "    netmask 255.255.255.0", "    gateway 10.0.0.1", "iface eth1 inet dhcp"} {
+		if !strings.Contains(interfaces, line+"\n") {
+			t.Errorf("interfaces has no %q:\n%s", line, interfaces)
+		}
+	}
+	if resolv := readFakeFile(t, part, "/etc/resolv.conf"); resolv != "search example.com\nnameserver 114.114.114.114\n" {
+		t.Errorf("unexpected resolv.conf %q", resolv)
+	}
+}
+
+func TestFreeBSDDeployNetworkingScripts(t *testing.T) {
+	part, cleanup := newFakeRootfs(t, freebsdFiles())
+	defer cleanup()
+	driver := fsdriver.NewFreeBSDRootFs(part)
+
+	if err := driver.DeployHostname(part, "vm1", "example.com"); err != nil {
+		t.Fatalf("DeployHostname: %v", err)
+	}
+	if err := driver.DeployNetworkingScripts(part, testNics()); err != nil {
+		t.Fatalf("DeployNetworkingScripts: %v", err)
+	}
+	rcConf := readFakeFile(t, part, "/etc/rc.conf")
+	want := "hostname=\"vm1.example.com\"\nsshd_enable=\"NO\"\ndefaultrouter=\"10.0.0.1\"\nifconfig_vtnet0=\"inet 10.0.0.2 netmask 255.255.255.0\"\nifconfig_vtnet1=\"SYNCDHCP\"\n"
+	if rcConf != want {
+		t.Errorf("rc.conf %q, want %q", rcConf, want)
+	}
+	if resolv := readFakeFile(t, part, "/etc/resolv.conf"); resolv != "search example.com\nnameserver 114.114.114.114\n" {
+		t.Errorf("unexpected resolv.conf %q", resolv)
+	}
+}
+
+func TestLinuxChangeUserPasswd(t *testing.T) {
+	for name, files := range map[string]map[string]string{
+		"SUSE":   openSuseFiles(),
+		"Alpine": alpineFiles(),
+	} {
+		t.Run(name, func(t *testing.T) {
+			part, cleanup := newFakeRootfs(t, files)
+			defer cleanup()
+			driver := guestfs.DetectRootFs(part)
+			if driver == nil || driver.GetName() != name {
+				t.Fatalf("detected %v, want %s", driver, name)
+			}
+			secret, err := driver.ChangeUserPasswd(part, "root", "guest-id", "", "Passw0rd")
+			if err != nil {
+				t.Fatalf("ChangeUserPasswd: %v", err)
+			}
+			if part.passwords["root"] != "Passw0rd" {
+				t.Errorf("password of root not set: %v", part.passwords)
+			}
+			if passwd, err := utils.DescryptAESBase64("guest-id", secret); err != nil || passwd != "Passw0rd" {
+				t.Errorf("secret decrypted to %q %v", passwd, err)
+			}
+		})
+	}
+}
+
+func TestFreeBSDChangeUserPasswd(t *testing.T) {
+	part, cleanup := newFakeRootfs(t, freebsdFiles())
+	defer cleanup()
+	driver := fsdriver.NewFreeBSDRootFs(part)
+
+	user, err := driver.GetLoginAccount(part, "cloud", false, false)
+	if err != nil || user != "cloud" {
+		t.Fatalf("GetLoginAccount: %s %v", user, err)
+	}
+	if _, err := driver.ChangeUserPasswd(part, "root", "guest-id", "", "Passw0rd"); err != nil {
+		t.Fatalf("ChangeUserPasswd: %v", err)
+	}
+	if len(part.passwords) > 0 {
+		t.Errorf("passwords should be set at first boot, not with passwd: %v", part.passwords)
+	}
+	if err := driver.CommitChanges(part); err != nil {
+		t.Fatalf("CommitChanges: %v", err)
+	}
+
+	script := readFakeFile(t, part, "/usr/local/etc/rc.d/cloudroot_firstboot")
+	if !strings.Contains(script, "pw useradd -n cloud -m -G wheel -s /bin/sh") {
+		t.Errorf("firstboot script does not add the login user:\n%s", script)
+	}
+	m := regexp.MustCompile(`echo '([^']+)' \| pw usermod root -H 0`).FindStringSubmatch(script)
+	if len(m) < 2 {
+		t.Fatalf("firstboot script does not set the root password:\n%s", script)
+	}
+	if err := seclib2.BcryptVerifyPassword("Passw0rd", m[1]); err != nil {
+		t.Errorf("password hash does not verify: %v", err)
+	}
+	if fi := part.Stat("/usr/local/etc/rc.d/cloudroot_firstboot", false); fi == nil || fi.Mode().Perm() != 0700 {
+		t.Errorf("firstboot script should only be accessible by root: %v", fi)
+	}
+	if !part.Exists("/firstboot", false) {
+		t.Errorf("/firstboot should be created to run firstboot scripts")
+	}
+	sshdConfig := readFakeFile(t, part, "/etc/ssh/sshd_config")
+	if strings.Contains(sshdConfig, "\nPermitRootLogin no") || !strings.HasSuffix(sshdConfig, "PermitRootLogin yes\n") {
+		t.Errorf("root login should be permitted:\n%s", sshdConfig)
+	}
+	if rcConf := readFakeFile(t, part, "/etc/rc.conf"); !strings.Contains(rcConf, "sshd_enable=\"YES\"\n") || !strings.Contains(rcConf, "growfs_enable=\"YES\"\n") {
+		t.Errorf("sshd and growfs should be enabled:\n%s", rcConf)
+	}
+}
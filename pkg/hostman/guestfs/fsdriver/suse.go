@@ -0,0 +1,237 @@
+// Copyright 2019 Yunion
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package fsdriver
+
+import (
+	"fmt"
+	"path"
+	"strings"
+
+	"yunion.io/x/log"
+
+	"yunion.io/x/onecloud/pkg/cloudcommon/types"
+	deployapi "yunion.io/x/onecloud/pkg/hostman/hostdeployer/apis"
+	"yunion.io/x/onecloud/pkg/util/netutils2"
+)
+
+const (
+	suseNetworkDir    = "/etc/sysconfig/network"
+	suseNetworkConfig = "/etc/sysconfig/network/config"
+	suseRoutes        = "/etc/sysconfig/network/routes"
+)
+
+// SSuseRootFs deploys SLES and openSUSE images, whose networks are managed
+// by wicked from ifcfg files under /etc/sysconfig/network
+type SSuseRootFs struct {
+	*sLinuxRootFs
+}
+
+func NewSuseRootFs(part IDiskPartition) IRootFsDriver {
+	return &SSuseRootFs{sLinuxRootFs: newLinuxRootFs(part)}
+}
+
+func (d *SSuseRootFs) GetName() string {
+	return "SUSE"
+}
+
+func (d *SSuseRootFs) String() string {
+	return "SuseRootFs"
+}
+
+func (d *SSuseRootFs) RootSignatures() []string {
+	sig := d.sLinuxRootFs.RootSignatures()
+	return append([]string{"/etc/os-release", path.Join(suseNetworkDir, "ifcfg-lo")}, sig...)
+}
+
+func (d *SSuseRootFs) GetReleaseInfo(rootFs IDiskPartition) *deployapi.ReleaseInfo {
+	rel, _ := rootFs.FileGetContents("/etc/os-release", false)
+	osRel := parseOsRelease(string(rel))
+	distro := d.GetName()
+	if strings.HasPrefix(osRel["ID"], "opensuse") {
+		distro = "OpenSUSE"
+	}
+	return deployapi.NewReleaseInfo(distro, osRel["VERSION_ID"], d.GetArch(rootFs))
+}
+
+func (d *SSuseRootFs) DeployHostname(rootFs IDiskPartition, hn, domain string) error {
+	// SLE 11 reads /etc/HOSTNAME, later releases read /etc/hostname
+	if rootFs.Exists("/etc/HOSTNAME", false) {
+		if err := rootFs.FilePutContents("/etc/HOSTNAME", getHostname(hn, domain), false, false); err != nil {
+			return err
+		}
+	}
+	return rootFs.FilePutContents("/etc/hostname", hn, false, false)
+}
+
+func (d *SSuseRootFs) PrepareFsForTemplate(rootFs IDiskPartition) error {
+	if err := d.sLinuxRootFs.PrepareFsForTemplate(rootFs); err != nil {
+		return err
+	}
+	return d.CleanNetworkScripts(rootFs)
+}
+
+func (d *SSuseRootFs) CleanNetworkScripts(rootFs IDiskPartition) error {
+	for _, f := range rootFs.ListDir(suseNetworkDir, false) {
+		if (strings.HasPrefix(f, "ifcfg-") && f != "ifcfg-lo") || strings.HasPrefix(f, "ifroute-") {
+			rootFs.Remove(path.Join(suseNetworkDir, f), false)
+		}
+	}
+	rootFs.Remove(suseRoutes, false)
+	return nil
+}
+
+func quoteSysconfig(val string) string {
+	return "'" + strings.Replace(val, "'", "", -1) + "'"
+}
+
+func getSuseIfcfg(nicDesc *types.SServerNic, mainIp string, nicCnt int) (string, []string) {
+	var cmds strings.Builder
+	var routes []string
+	cmds.WriteString(fmt.Sprintf("NAME=%s\n", quoteSysconfig(nicDesc.Name)))
+	if nicDesc.Mtu > 0 {
+		cmds.WriteString(fmt.Sprintf("MTU='%d'\n", nicDesc.Mtu))
+	}
+	if len(nicDesc.Mac) > 0 {
+		cmds.WriteString(fmt.Sprintf("LLADDR='%s'\n", nicDesc.Mac))
+	}
+	if nicDesc.TeamingMaster != nil {
+		cmds.WriteString("STARTMODE='hotplug'\n")
+		cmds.WriteString("BOOTPROTO='none'\n")
+		return cmds.String(), nil
+	}
+	cmds.WriteString("STARTMODE='auto'\n")
+	if len(nicDesc.TeamingSlaves) > 0 {
+		cmds.WriteString("BONDING_MASTER='yes'\n")
+		cmds.WriteString("BONDING_MODULE_OPTS='mode=802.3ad miimon=100 lacp_rate=fast xmit_hash_policy=layer3+4'\n")
+		for i, slave := range nicDesc.TeamingSlaves {
+			cmds.WriteString(fmt.Sprintf("BONDING_SLAVE_%d='%s'\n", i, slave.Name))
+		}
+	}
+	isMain := len(mainIp) > 0 && nicDesc.Ip == mainIp
+	if nicDesc.Virtual {
+		cmds.WriteString("BOOTPROTO='static'\n")
+		cmds.WriteString(fmt.Sprintf("IPADDR='%s/32'\n", netutils2.PSEUDO_VIP))
+	} else if nicDesc.Manual {
+		cmds.WriteString("BOOTPROTO='static'\n")
+		cmds.WriteString(fmt.Sprintf("IPADDR='%s/%d'\n", nicDesc.Ip, nicDesc.Masklen))
+		if len(nicDesc.Ip6) > 0 {
+			cmds.WriteString(fmt.Sprintf("IPADDR_0='%s/%d'\n", nicDesc.Ip6, nicDesc.Masklen6))
+		}
+		if len(nicDesc.Gateway) > 0 && isMain {
+			routes = append(routes, fmt.Sprintf("default %s - %s", nicDesc.Gateway, nicDesc.Name))
+		}
+		var nicRoutes = make([][]string, 0)
+		netutils2.AddNicRoutes(&nicRoutes, nicDesc, mainIp, nicCnt, privatePrefixes)
+		for _, r := range nicRoutes {
+			routes = append(routes, fmt.Sprintf("%s %s - %s", r[0], r[1], nicDesc.Name))
+		}
+	} else if len(nicDesc.Ip6) > 0 {
+		cmds.WriteString("BOOTPROTO='dhcp'\n")
+	} else {
+		cmds.WriteString("BOOTPROTO='dhcp4'\n")
+	}
+	if len(nicDesc.Gateway6) > 0 && isMain {
+		routes = append(routes, fmt.Sprintf("default %s - %s", nicDesc.Gateway6, nicDesc.Name))
+	}
+	return cmds.String(), routes
+}
+
+// setSysconfigValues replaces KEY=value lines of a sysconfig style file,
+// keys missing from the file are appended
+func setSysconfigValues(cont string, vals map[string]string, keys []string) string {
+	lines := strings.Split(strings.TrimRight(cont, "\n"), "\n")
+	found := map[string]bool{}
+	for i, line := range lines {
+		trimed := strings.TrimSpace(line)
+		if strings.HasPrefix(trimed, "#") {
+			continue
+		}
+		pos := strings.Index(trimed, "=")
+		if pos <= 0 {
+			continue
+		}
+		key := trimed[:pos]
+		if val, ok := vals[key]; ok {
+			lines[i] = fmt.Sprintf("%s=%s", key, val)
+			found[key] = true
+		}
+	}
+	for _, key := range keys {
+		if !found[key] {
+			lines = append(lines, fmt.Sprintf("%s=%s", key, vals[key]))
+		}
+	}
+	return strings.Join(lines, "\n") + "\n"
+}
+
+func (d *SSuseRootFs) DeployNetworkingScripts(rootFs IDiskPartition, nics []*types.SServerNic) error {
+	if err := d.sLinuxRootFs.DeployNetworkingScripts(rootFs, nics); err != nil {
+		return err
+	}
+	if err := d.CleanNetworkScripts(rootFs); err != nil {
+		return err
+	}
+	allNics, _ := convertNicConfigs(nics)
+	mainNic, err := getMainNic(allNics)
+	if err != nil {
+		return err
+	}
+	var mainIp string
+	if mainNic != nil {
+		mainIp = mainNic.Ip
+	}
+	routes := []string{}
+	dnss := []string{}
+	domains := []string{}
+	for i := range allNics {
+		nicDesc := allNics[i]
+		cont, nicRoutes := getSuseIfcfg(nicDesc, mainIp, len(nics))
+		routes = append(routes, nicRoutes...)
+		if nicDesc.Manual && nicDesc.TeamingMaster == nil {
+			dnss = append(dnss, netutils2.GetNicDns(nicDesc)...)
+			if len(nicDesc.Dns6) > 0 {
+				dnss = append(dnss, nicDesc.Dns6)
+			}
+			if len(nicDesc.Domain) > 0 {
+				domains = append(domains, nicDesc.Domain)
+			}
+		}
+		fn := path.Join(suseNetworkDir, fmt.Sprintf("ifcfg-%s", nicDesc.Name))
+		log.Debugf("%s: %s", fn, cont)
+		if err := rootFs.FilePutContents(fn, cont, false, false); err != nil {
+			return err
+		}
+	}
+	if len(routes) > 0 {
+		if err := rootFs.FilePutContents(suseRoutes, strings.Join(routes, "\n")+"\n", false, false); err != nil {
+			return err
+		}
+	}
+	if len(dnss) > 0 && rootFs.Exists(suseNetworkConfig, false) {
+		cont, err := rootFs.FileGetContents(suseNetworkConfig, false)
+		if err != nil {
+			return err
+		}
+		vals := map[string]string{
+			"NETCONFIG_DNS_STATIC_SERVERS":    fmt.Sprintf(`"%s"`, strings.Replace(strings.Join(dnss, " "), ",", " ", -1)),
+			"NETCONFIG_DNS_STATIC_SEARCHLIST": fmt.Sprintf(`"%s"`, strings.Join(domains, " ")),
+		}
+		keys := []string{"NETCONFIG_DNS_STATIC_SERVERS", "NETCONFIG_DNS_STATIC_SEARCHLIST"}
+		if err := rootFs.FilePutContents(suseNetworkConfig, setSysconfigValues(string(cont), vals, keys), false, false); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,77 @@
+// Copyright 2019 Yunion
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package fsdriver
+
+import (
+	"testing"
+
+	"yunion.io/x/onecloud/pkg/cloudcommon/types"
+)
+
+func TestSetSysconfigValues(t *testing.T) {
+	cont := "# comment\nNETCONFIG_DNS_STATIC_SERVERS=\"\"\nNETCONFIG_DNS_POLICY=\"auto\"\n"
+	vals := map[string]string{
+		"NETCONFIG_DNS_STATIC_SERVERS":    `"8.8.8.8"`,
+		"NETCONFIG_DNS_STATIC_SEARCHLIST": `"example.com"`,
+	}
+	keys := []string{"NETCONFIG_DNS_STATIC_SERVERS", "NETCONFIG_DNS_STATIC_SEARCHLIST"}
+	want := "# comment\nNETCONFIG_DNS_STATIC_SERVERS=\"8.8.8.8\"\nNETCONFIG_DNS_POLICY=\"auto\"\nNETCONFIG_DNS_STATIC_SEARCHLIST=\"example.com\"\n"
+	if got := setSysconfigValues(cont, vals, keys); got != want {
+		t.Errorf("setSysconfigValues() = %q, want %q", got, want)
+	}
+}
+
+func TestGetSuseIfcfg(t *testing.T) {
+	tests := []struct {
+		name       string
+		nic        *types.SServerNic
+		mainIp     string
+		want       string
+		wantRoutes []string
+	}{
+		{
+			name: "dhcp",
+			nic:  &types.SServerNic{Name: "eth0", Mac: "00:22:33:44:55:66", Ip: "10.0.0.2"},
+			want: "NAME='eth0'\nLLADDR='00:22:33:44:55:66'\nSTARTMODE='auto'\nBOOTPROTO='dhcp4'\n",
+		},
+		{
+			name: "static dual stack",
+			nic: &types.SServerNic{
+				Name: "eth0", Mac: "00:22:33:44:55:66", Mtu: 1450, Manual: true,
+				Ip: "10.0.0.2", Masklen: 24, Gateway: "10.0.0.1",
+				Ip6: "fd00::2", Masklen6: 64, Gateway6: "fd00::1",
+			},
+			mainIp:     "10.0.0.2",
+			want:       "NAME='eth0'\nMTU='1450'\nLLADDR='00:22:33:44:55:66'\nSTARTMODE='auto'\nBOOTPROTO='static'\nIPADDR='10.0.0.2/24'\nIPADDR_0='fd00::2/64'\n",
+			wantRoutes: []string{"default 10.0.0.1 - eth0", "default fd00::1 - eth0"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, routes := getSuseIfcfg(tt.nic, tt.mainIp, 1)
+			if got != tt.want {
+				t.Errorf("getSuseIfcfg() = %q, want %q", got, tt.want)
+			}
+			if len(routes) != len(tt.wantRoutes) {
+				t.Fatalf("getSuseIfcfg() routes = %v, want %v", routes, tt.wantRoutes)
+			}
+			for i := range routes {
+				if routes[i] != tt.wantRoutes[i] {
+					t.Errorf("getSuseIfcfg() routes = %v, want %v", routes, tt.wantRoutes)
+				}
+			}
+		})
+	}
+}
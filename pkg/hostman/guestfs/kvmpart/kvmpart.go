@@ -40,6 +40,8 @@ type SKVMGuestDiskPartition struct {
 	readonly  bool
 	sourceDev string
 	IsLVMPart bool
+
+	zpool string
 }
 
 var _ fsdriver.IDiskPartition = &SKVMGuestDiskPartition{}
@@ -124,6 +126,13 @@ func (p *SKVMGuestDiskPartition) Mount() bool {
 	}
 
 	if p.IsReadonly() {
+		if p.fs == "ufs" {
+			// most kernels are built without CONFIG_UFS_FS_WRITE, mount
+			// falls back to read only and nothing could be deployed
+			log.Errorf("SKVMGuestDiskPartition ufs %s is mounted readonly, the kernel lacks ufs write support", p.partDev)
+			p.Umount()
+			return false
+		}
 		log.Errorf("SKVMGuestDiskPartition %s is readonly, try mount as ro", p.partDev)
 		p.Umount()
 		err = p.mount(true)
@@ -142,6 +151,9 @@ func (p *SKVMGuestDiskPartition) mount(readonly bool) error {
 	if output, err := procutils.NewCommand("mkdir", "-p", p.mountPath).Output(); err != nil {
 		return errors.Wrapf(err, "mkdir %s failed: %s", p.mountPath, output)
 	}
+	if p.fs == FS_ZFS_MEMBER {
+		return p.mountZfs(readonly)
+	}
 	var cmds = []string{"mount", "-t"}
 	var opt, fsType string
 	if readonly {
@@ -155,6 +167,13 @@ func (p *SKVMGuestDiskPartition) mount(readonly bool) error {
 		}
 	} else if fsType == "hfsplus" && !readonly {
 		opt = "force,rw"
+	} else if fsType == "ufs" {
+		// FreeBSD formats UFS2 since 5.0
+		if readonly {
+			opt = "ro,ufstype=ufs2"
+		} else {
+			opt = "rw,ufstype=ufs2"
+		}
 	}
 	cmds = append(cmds, fsType)
 	if len(opt) > 0 {
@@ -254,7 +273,11 @@ func (p *SKVMGuestDiskPartition) Umount() error {
 	var err error
 	for tries < 10 {
 		tries += 1
-		_, err = procutils.NewCommand("umount", p.mountPath).Output()
+		if p.fs == FS_ZFS_MEMBER {
+			err = p.exportZfs()
+		} else {
+			_, err = procutils.NewCommand("umount", p.mountPath).Output()
+		}
 		if err == nil {
 			if p.fs == "xfs" {
 				uuids := fileutils2.GetDevUuid(p.partDev)
@@ -257,12 +257,25 @@ func (f *SLocalGuestFS) userAdd(user, homeDir string, isSys bool) error {
 	if err := f.Mkdir(homeDir, 0755, false); err != nil {
 		return errors.Wrap(err, "Mkdir")
 	}
-	cmd := []string{"chroot", f.mountPath, "useradd", "-m", "-s", "/bin/bash", user}
-	if isSys {
-		cmd = append(cmd, "-r")
-	}
-	if len(homeDir) > 0 {
-		cmd = append(cmd, "-d", path.Join(homeDir, user))
+	var cmd []string
+	if !f.Exists("/usr/sbin/useradd", false) && !f.Exists("/sbin/useradd", false) && f.Exists("/bin/busybox", false) {
+		// busybox based distributions such as alpine only ship adduser
+		cmd = []string{"chroot", f.mountPath, "adduser", "-D", "-s", "/bin/sh"}
+		if isSys {
+			cmd = append(cmd, "-S")
+		}
+		if len(homeDir) > 0 {
+			cmd = append(cmd, "-h", path.Join(homeDir, user))
+		}
+		cmd = append(cmd, user)
+	} else {
+		cmd = []string{"chroot", f.mountPath, "useradd", "-m", "-s", "/bin/bash", user}
+		if isSys {
+			cmd = append(cmd, "-r")
+		}
+		if len(homeDir) > 0 {
+			cmd = append(cmd, "-d", path.Join(homeDir, user))
+		}
 	}
 	output, err := procutils.NewCommand(cmd[0], cmd[1:]...).Output()
 	if err != nil {
@@ -0,0 +1,138 @@
+// Copyright 2019 Yunion
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package kvmpart
+
+import (
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"yunion.io/x/log"
+	"yunion.io/x/pkg/errors"
+
+	"yunion.io/x/onecloud/pkg/util/procutils"
+)
+
+const FS_ZFS_MEMBER = "zfs_member"
+
+// getZfsPoolGuid returns the pool guid, blkid reports it as the UUID of
+// every member device. The on-disk pool name is not used because almost
+// every FreeBSD image names its pool zroot
+func (p *SKVMGuestDiskPartition) getZfsPoolGuid() string {
+	out, err := procutils.NewCommand("blkid", "-o", "value", "-s", "UUID", p.partDev).Output()
+	if err != nil {
+		log.Errorf("blkid uuid of %s: %s %s", p.partDev, err, out)
+		return ""
+	}
+	return strings.TrimSpace(string(out))
+}
+
+// zfsTempPoolName is the temporary name the pool is imported as, it is
+// derived from the mount path so that it is unique per attached device
+func (p *SKVMGuestDiskPartition) zfsTempPoolName() string {
+	return "guestfs" + filepath.Base(p.mountPath)
+}
+
+// zfsMountableDatasets parses `zfs list -H -o name,canmount,mountpoint`
+// and returns the datasets that would be mounted automatically, parents
+// before children
+func zfsMountableDatasets(output string) []string {
+	type sDataset struct {
+		name       string
+		mountpoint string
+	}
+	datasets := []sDataset{}
+	for _, line := range strings.Split(output, "\n") {
+		fields := strings.Split(strings.TrimSpace(line), "\t")
+		if len(fields) != 3 || fields[1] != "on" {
+			continue
+		}
+		if !strings.HasPrefix(fields[2], "/") {
+			// none or legacy
+			continue
+		}
+		datasets = append(datasets, sDataset{name: fields[0], mountpoint: fields[2]})
+	}
+	sort.SliceStable(datasets, func(i, j int) bool {
+		return strings.Count(datasets[i].mountpoint, "/") < strings.Count(datasets[j].mountpoint, "/")
+	})
+	ret := make([]string, len(datasets))
+	for i := range datasets {
+		ret[i] = datasets[i].name
+	}
+	return ret
+}
+
+// mountZfs imports the pool of the partition by guid under a temporary name
+// with mount path as altroot, then mounts the boot dataset, which is the root
+// filesystem of FreeBSD, or all the datasets of this pool if it has none
+func (p *SKVMGuestDiskPartition) mountZfs(readonly bool) error {
+	guid := p.getZfsPoolGuid()
+	if len(guid) == 0 {
+		return errors.Errorf("no zfs pool found on %s", p.partDev)
+	}
+	pool := p.zfsTempPoolName()
+	args := []string{"import", "-f", "-N", "-t", "-R", p.mountPath, "-d", p.partDev}
+	if readonly {
+		args = append(args, "-o", "readonly=on")
+	}
+	args = append(args, guid, pool)
+	if out, err := procutils.NewCommand("zpool", args...).Output(); err != nil {
+		return errors.Wrapf(err, "zpool import %s as %s: %s", guid, pool, out)
+	}
+	p.zpool = pool
+
+	bootfs, _ := procutils.NewCommand("zpool", "get", "-H", "-o", "value", "bootfs", pool).Output()
+	dataset := strings.TrimSpace(string(bootfs))
+	if len(dataset) > 0 && dataset != "-" {
+		// bootfs keeps the on-disk pool name
+		if idx := strings.Index(dataset, "/"); idx > 0 {
+			dataset = pool + dataset[idx:]
+		}
+		if out, err := procutils.NewCommand("zfs", "mount", dataset).Output(); err == nil {
+			return nil
+		} else {
+			log.Warningf("zfs mount %s: %s %s", dataset, err, out)
+		}
+	}
+	out, err := procutils.NewCommand("zfs", "list", "-H", "-r", "-o", "name,canmount,mountpoint", pool).Output()
+	if err != nil {
+		p.exportZfs()
+		return errors.Wrapf(err, "zfs list %s: %s", pool, out)
+	}
+	datasets := zfsMountableDatasets(string(out))
+	if len(datasets) == 0 {
+		p.exportZfs()
+		return errors.Errorf("no mountable dataset in zfs pool %s", guid)
+	}
+	for _, dataset := range datasets {
+		if out, err := procutils.NewCommand("zfs", "mount", dataset).Output(); err != nil {
+			p.exportZfs()
+			return errors.Wrapf(err, "zfs mount %s: %s", dataset, out)
+		}
+	}
+	return nil
+}
+
+func (p *SKVMGuestDiskPartition) exportZfs() error {
+	if len(p.zpool) == 0 {
+		return nil
+	}
+	if out, err := procutils.NewCommand("zpool", "export", p.zpool).Output(); err != nil {
+		return errors.Wrapf(err, "zpool export %s: %s", p.zpool, out)
+	}
+	p.zpool = ""
+	return nil
+}
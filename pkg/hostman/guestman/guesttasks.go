@@ -25,6 +25,7 @@ import (
 
 	"yunion.io/x/jsonutils"
 	"yunion.io/x/log"
+	"yunion.io/x/pkg/errors"
 	"yunion.io/x/pkg/utils"
 
 	"yunion.io/x/onecloud/pkg/appctx"
@@ -883,20 +884,76 @@ func (s *SGuestReloadDiskTask) getDiskOfDrive(d jsonutils.JSONObject) string {
 	if err != nil {
 		return ""
 	}
+	drive, _ := d.GetString("device")
 	if file == s.disk.GetPath() {
-		drive, _ := d.GetString("device")
+		return drive
+	}
+	// the file of an encrypted disk is a json description of its options
+	if diskIndex, ok := s.getDiskIndex(); ok && drive == fmt.Sprintf("drive_%d", diskIndex) {
 		return drive
 	}
 	return ""
 }
 
+func (s *SGuestReloadDiskTask) getDiskIndex() (int64, bool) {
+	disk := s.getDiskDesc()
+	if disk == nil {
+		return 0, false
+	}
+	diskIndex, _ := disk.Int("index")
+	return diskIndex, true
+}
+
+func (s *SGuestReloadDiskTask) getDiskDesc() jsonutils.JSONObject {
+	disks, _ := s.Desc.GetArray("disks")
+	for _, disk := range disks {
+		diskId, _ := disk.GetString("disk_id")
+		if diskId == s.disk.GetId() {
+			return disk
+		}
+	}
+	return nil
+}
+
+// getDiskEncryptSecretId returns the id of the secret object qemu opened the
+// encrypted disk with on startup
+func (s *SGuestReloadDiskTask) getDiskEncryptSecretId() (string, bool) {
+	disk := s.getDiskDesc()
+	if disk == nil || !disk.Contains("encrypt_key_id") {
+		return "", false
+	}
+	diskIndex, _ := disk.Int("index")
+	return s.getEncryptSecretId(diskIndex), true
+}
+
+// openDiskImage opens an image of the disk, the data key is attached when
+// the image is encrypted
+func (s *SGuestReloadDiskTask) openDiskImage(imagePath string) (*qemuimg.SQemuImage, error) {
+	img, err := qemuimg.NewQemuImage(imagePath)
+	if err != nil {
+		return nil, err
+	}
+	if img.Encryption {
+		info, err := hostutils.GetDiskEncryptInfo(s.ctx, s.disk.GetId())
+		if err != nil {
+			return nil, errors.Wrapf(err, "GetDiskEncryptInfo %s", s.disk.GetId())
+		}
+		img.SetEncryptKey(info.Key)
+	}
+	return img, nil
+}
+
 func (s *SGuestReloadDiskTask) startReloadDisk(device string) {
 	s.doReloadDisk(device, s.onReloadSucc)
 }
 
 func (s *SGuestReloadDiskTask) doReloadDisk(device string, callback func(string)) {
 	s.Monitor.SimpleCommand("stop", func(string) {
-		s.Monitor.ReloadDiskBlkdev(device, s.disk.GetPath(), callback)
+		if secretId, ok := s.getDiskEncryptSecretId(); ok {
+			s.Monitor.ReloadEncryptedDiskBlkdev(device, s.disk.GetPath(), secretId, callback)
+		} else {
+			s.Monitor.ReloadDiskBlkdev(device, s.disk.GetPath(), callback)
+		}
 	})
 }
 
@@ -1001,6 +1058,14 @@ func NewGuestSnapshotDeleteTask(
 func (s *SGuestSnapshotDeleteTask) Start() {
 	if err := s.doDiskConvert(); err != nil {
 		s.taskFailed(err.Error())
+		return
+	}
+	if _, ok := s.getDiskEncryptSecretId(); ok {
+		// the converted snapshot has the same content as the one qemu
+		// opened, qemu keeps the old chain until the guest restarts
+		// because an encrypted chain can't be reopened by reload blkdev
+		s.onResumeSucc("")
+		return
 	}
 	s.fetchDisksInfo(s.doReloadDisk)
 }
@@ -1008,13 +1073,14 @@ func (s *SGuestSnapshotDeleteTask) Start() {
 func (s *SGuestSnapshotDeleteTask) doDiskConvert() error {
 	snapshotDir := s.disk.GetSnapshotDir()
 	snapshotPath := path.Join(snapshotDir, s.convertSnapshot)
-	img, err := qemuimg.NewQemuImage(snapshotPath)
+	img, err := s.openDiskImage(snapshotPath)
 	if err != nil {
 		log.Errorln(err)
 		return err
 	}
 	convertedDisk := snapshotPath + ".tmp"
-	if err = img.Convert2Qcow2To(convertedDisk, true); err != nil {
+	// qemu-img can't compress an encrypted image
+	if err = img.Convert2Qcow2To(convertedDisk, !img.Encryption); err != nil {
 		log.Errorln(err)
 		if fileutils2.Exists(convertedDisk) {
 			os.Remove(convertedDisk)
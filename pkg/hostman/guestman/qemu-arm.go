@@ -85,6 +85,9 @@ func (s *SKVMGuestInstance) generateArmStartScript(data *jsonutils.JSONDict) (st
 	isolatedDevsParams := s.manager.GetHost().GetIsolatedDeviceManager().GetQemuParams(devAddrs)
 
 	for _, nic := range nics {
+		if isSRIOVNic(nic) {
+			continue
+		}
 		downscript := s.getNicDownScriptPath(nic)
 		ifname, _ := nic.GetString("ifname")
 		cmd += fmt.Sprintf("%s %s\n", downscript, ifname)
@@ -96,6 +99,12 @@ func (s *SKVMGuestInstance) generateArmStartScript(data *jsonutils.JSONDict) (st
 			mem, uuid, uuid)
 	}
 
+	sriovCmd, err := s.getSRIOVNicSetupScripts(nics, isolatedParams)
+	if err != nil {
+		return "", err
+	}
+	cmd += sriovCmd
+
 	cmd += "sleep 1\n"
 	cmd += fmt.Sprintf("echo %d > %s\n", vncPort, s.GetVncFilePath())
 
@@ -261,6 +270,10 @@ func (s *SKVMGuestInstance) generateArmStartScript(data *jsonutils.JSONDict) (st
 	}
 
 	for i := 0; i < len(nics); i++ {
+		if isSRIOVNic(nics[i]) {
+			// passthrough by isolated device
+			continue
+		}
 		if osname == OS_NAME_VMWARE {
 			nics[i].(*jsonutils.JSONDict).Set("driver", jsonutils.NewString("vmxnet3"))
 		}
@@ -45,6 +45,7 @@ import (
 	"yunion.io/x/onecloud/pkg/util/fileutils2"
 	"yunion.io/x/onecloud/pkg/util/netutils2"
 	"yunion.io/x/onecloud/pkg/util/procutils"
+	"yunion.io/x/onecloud/pkg/util/qemuimg"
 	"yunion.io/x/onecloud/pkg/util/timeutils2"
 	"yunion.io/x/onecloud/pkg/util/version"
 )
@@ -70,6 +71,9 @@ type SKVMGuestInstance struct {
 	startupTask *SGuestResumeTask
 	stopping    bool
 	syncMeta    *jsonutils.JSONDict
+
+	// block driver secret options of encrypted disks, keyed by disk index
+	encryptSecrets map[int64][]string
 }
 
 func NewKVMGuestInstance(id string, manager *SGuestManager) *SKVMGuestInstance {
@@ -242,6 +246,17 @@ func (s *SKVMGuestInstance) asyncScriptStart(ctx context.Context, params interfa
 
 	hostbridge.CleanDeletedPorts(options.HostOptions.BridgeDriver)
 
+	if err := s.prepareEncryptKeys(ctx); err != nil {
+		log.Errorf("prepare encrypt keys of %s: %s", s.GetName(), err)
+		if ctx != nil && len(appctx.AppContextTaskId(ctx)) >= 0 {
+			hostutils.TaskFailed(ctx, fmt.Sprintf("Async start server failed: %s", err))
+		}
+		s.SyncStatus("")
+		return nil, err
+	}
+	// qemu reads the secrets on startup, keys never stay on disk
+	defer s.cleanupEncryptKeys()
+
 	time.Sleep(100 * time.Millisecond)
 	var isStarted, tried = false, 0
 	var err error
@@ -300,6 +315,60 @@ func (s *SKVMGuestInstance) saveScripts(data *jsonutils.JSONDict) error {
 	return fileutils2.FilePutContents(s.GetStopScriptPath(), stopScript, false)
 }
 
+func (s *SKVMGuestInstance) getEncryptKeyPath(diskIndex int64) string {
+	return path.Join(s.HomeDir(), fmt.Sprintf("disk_%d.key", diskIndex))
+}
+
+func (s *SKVMGuestInstance) getEncryptSecretId(diskIndex int64) string {
+	return fmt.Sprintf("sec_%d", diskIndex)
+}
+
+// prepareEncryptKeys fetches the data keys of the disks with an encrypt key
+// id in the desc from region and writes them to files only readable by root
+// for qemu secret objects. A disk is either a qcow2 file with encrypted
+// layers or a luks volume on rbd, the drive format comes with the options.
+func (s *SKVMGuestInstance) prepareEncryptKeys(ctx context.Context) error {
+	s.encryptSecrets = map[int64][]string{}
+	disks, _ := s.Desc.GetArray("disks")
+	for _, disk := range disks {
+		keyId, _ := disk.GetString("encrypt_key_id")
+		if len(keyId) == 0 {
+			continue
+		}
+		diskId, _ := disk.GetString("disk_id")
+		diskPath, _ := disk.GetString("path")
+		diskIndex, _ := disk.Int("index")
+		img, err := qemuimg.NewQemuImage(diskPath)
+		if err != nil {
+			return errors.Wrapf(err, "open disk %s", diskPath)
+		}
+		if !img.Encryption {
+			return fmt.Errorf("disk %s with encrypt key %s is not encrypted", diskId, keyId)
+		}
+		info, err := hostutils.GetDiskEncryptInfo(ctx, diskId)
+		if err != nil {
+			return errors.Wrapf(err, "GetDiskEncryptInfo %s", diskId)
+		}
+		secretOpts, err := img.EncryptKeySecretOptions(s.getEncryptSecretId(diskIndex))
+		if err != nil {
+			return errors.Wrap(err, "EncryptKeySecretOptions")
+		}
+		if err := ioutil.WriteFile(s.getEncryptKeyPath(diskIndex), []byte(info.Key), 0600); err != nil {
+			return errors.Wrap(err, "write encrypt key")
+		}
+		s.encryptSecrets[diskIndex] = append([]string{"format=" + img.Format.String()}, secretOpts...)
+	}
+	return nil
+}
+
+func (s *SKVMGuestInstance) cleanupEncryptKeys() {
+	for diskIndex := range s.encryptSecrets {
+		if err := os.Remove(s.getEncryptKeyPath(diskIndex)); err != nil && !os.IsNotExist(err) {
+			log.Errorf("remove encrypt key of disk %d: %s", diskIndex, err)
+		}
+	}
+}
+
 func (s *SKVMGuestInstance) GetStartScriptPath() string {
 	return path.Join(s.HomeDir(), "startvm")
 }
@@ -27,6 +27,7 @@ import (
 	"yunion.io/x/log"
 	"yunion.io/x/pkg/utils"
 
+	api "yunion.io/x/onecloud/pkg/apis/compute"
 	"yunion.io/x/onecloud/pkg/hostman/options"
 	"yunion.io/x/onecloud/pkg/hostman/storageman"
 	"yunion.io/x/onecloud/pkg/util/fileutils2"
@@ -160,7 +161,12 @@ func (s *SKVMGuestInstance) getDriveDesc(disk jsonutils.JSONObject, format strin
 	cmd += fmt.Sprintf(" file=$DISK_%d", diskIndex)
 	cmd += ",if=none"
 	cmd += fmt.Sprintf(",id=drive_%d", diskIndex)
-	if len(format) == 0 || format == "qcow2" {
+	if secretOpts, ok := s.encryptSecrets[diskIndex]; ok {
+		// encrypted layers are opened by secret options of the format driver
+		for _, opt := range secretOpts {
+			cmd += "," + opt
+		}
+	} else if len(format) == 0 || format == "qcow2" {
 		// pass    # qemu will automatically detect image format
 	} else if format == "raw" {
 		cmd += ",format=raw"
@@ -374,6 +380,9 @@ func (s *SKVMGuestInstance) _generateStartScript(data *jsonutils.JSONDict) (stri
 	isolatedDevsParams := s.manager.GetHost().GetIsolatedDeviceManager().GetQemuParams(devAddrs)
 
 	for _, nic := range nics {
+		if isSRIOVNic(nic) {
+			continue
+		}
 		downscript := s.getNicDownScriptPath(nic)
 		ifname, _ := nic.GetString("ifnam")
 		cmd += fmt.Sprintf("%s %s\n", downscript, ifname)
@@ -385,6 +394,12 @@ func (s *SKVMGuestInstance) _generateStartScript(data *jsonutils.JSONDict) (stri
 			mem, uuid, uuid)
 	}
 
+	sriovCmd, err := s.getSRIOVNicSetupScripts(nics, isolatedParams)
+	if err != nil {
+		return "", err
+	}
+	cmd += sriovCmd
+
 	cmd += "sleep 1\n"
 	cmd += fmt.Sprintf("echo %d > %s\n", vncPort, s.GetVncFilePath())
 
@@ -600,6 +615,14 @@ function nic_mtu() {
 		cmd += " -device pvscsi,id=scsi"
 	}
 
+	for _, disk := range disks {
+		diskIndex, _ := disk.Int("index")
+		if _, ok := s.encryptSecrets[diskIndex]; ok {
+			cmd += fmt.Sprintf(" -object secret,id=%s,file=%s,format=base64",
+				s.getEncryptSecretId(diskIndex), s.getEncryptKeyPath(diskIndex))
+		}
+	}
+
 	for _, disk := range disks {
 		format, _ := disk.GetString("format")
 		cmd += s.getDriveDesc(disk, format)
@@ -629,6 +652,10 @@ function nic_mtu() {
 	}
 
 	for i := 0; i < len(nics); i++ {
+		if isSRIOVNic(nics[i]) {
+			// passthrough by isolated device
+			continue
+		}
 		if osname == OS_NAME_VMWARE {
 			nics[i].(*jsonutils.JSONDict).Set("driver", jsonutils.NewString("vmxnet3"))
 		}
@@ -729,6 +756,9 @@ func (s *SKVMGuestInstance) generateStopScript(data *jsonutils.JSONDict) string
 		cmd += "fi\n"
 	}
 	for _, nic := range nics {
+		if isSRIOVNic(nic) {
+			continue
+		}
 		ifname, _ := nic.GetString("ifname")
 		downscript := s.getNicDownScriptPath(nic)
 		cmd += fmt.Sprintf("%s %s\n", downscript, ifname)
@@ -736,6 +766,42 @@ func (s *SKVMGuestInstance) generateStopScript(data *jsonutils.JSONDict) string
 	return cmd
 }
 
+func isSRIOVNic(nic jsonutils.JSONObject) bool {
+	driver, _ := nic.GetString("driver")
+	return driver == api.NETWORK_DRIVER_VFIO
+}
+
+// getSRIOVNicSetupScripts configures mac and vlan of SR-IOV virtual functions bound to guest nics
+func (s *SKVMGuestInstance) getSRIOVNicSetupScripts(nics, isolatedDevices []jsonutils.JSONObject) (string, error) {
+	cmd := ""
+	for _, dev := range isolatedDevices {
+		devType, _ := dev.GetString("dev_type")
+		if devType != api.NIC_VF_TYPE {
+			continue
+		}
+		addr, _ := dev.GetString("addr")
+		index, _ := dev.Int("network_index")
+		var nic jsonutils.JSONObject
+		for i := range nics {
+			if nicIndex, _ := nics[i].Int("index"); nicIndex == index {
+				nic = nics[i]
+				break
+			}
+		}
+		if nic == nil {
+			return "", fmt.Errorf("not found nic of index %d for SR-IOV device %s", index, addr)
+		}
+		mac, _ := nic.GetString("mac")
+		vlan, _ := nic.Int("vlan")
+		setupCmd, err := s.manager.GetHost().GetIsolatedDeviceManager().GetSRIOVNicSetupCmd(addr, mac, int(vlan))
+		if err != nil {
+			return "", err
+		}
+		cmd += setupCmd
+	}
+	return cmd, nil
+}
+
 func (s *SKVMGuestInstance) presendArpForNic(nic jsonutils.JSONObject) {
 	ifname, _ := nic.GetString("ifname")
 	ifi, err := net.InterfaceByName(ifname)
@@ -40,6 +40,7 @@ import (
 	"yunion.io/x/onecloud/pkg/hostman/options"
 	"yunion.io/x/onecloud/pkg/hostman/storageman"
 	"yunion.io/x/onecloud/pkg/hostman/storageman/diskhandlers"
+	"yunion.io/x/onecloud/pkg/hostman/storageman/p2p"
 	"yunion.io/x/onecloud/pkg/hostman/storageman/storagehandler"
 	"yunion.io/x/onecloud/pkg/httperrors"
 	"yunion.io/x/onecloud/pkg/util/procutils"
@@ -109,6 +110,10 @@ func (host *SHostService) RunService() {
 	<-hostinfo.Instance().IsRegistered // wait host and guest init
 	host.initHandlers(app)
 
+	if err := p2p.Start(hostInstance.GetZoneName()); err != nil {
+		log.Errorf("start p2p image distribution: %v", err)
+	}
+
 	// Init Metadata handler
 	go metadata.Start(
 		app_common.InitApp(&options.HostOptions.BaseOptions, false),
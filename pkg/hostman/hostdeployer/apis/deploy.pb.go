@@ -947,6 +947,7 @@ type DeployParams struct {
 	GuestDesc            *GuestDesc   `protobuf:"bytes,2,opt,name=guest_desc,json=guestDesc,proto3" json:"guest_desc,omitempty"`
 	DeployInfo           *DeployInfo  `protobuf:"bytes,3,opt,name=deploy_info,json=deployInfo,proto3" json:"deploy_info,omitempty"`
 	VddkInfo             *VDDKConInfo `protobuf:"bytes,4,opt,name=vddk_info,json=vddkInfo,proto3" json:"vddk_info,omitempty"`
+	EncryptKey           string       `protobuf:"bytes,5,opt,name=encrypt_key,json=encryptKey,proto3" json:"encrypt_key,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}     `json:"-"`
 	XXX_unrecognized     []byte       `json:"-"`
 	XXX_sizecache        int32        `json:"-"`
@@ -1005,10 +1006,18 @@ func (m *DeployParams) GetVddkInfo() *VDDKConInfo {
 	return nil
 }
 
+func (m *DeployParams) GetEncryptKey() string {
+	if m != nil {
+		return m.EncryptKey
+	}
+	return ""
+}
+
 type ResizeFsParams struct {
 	DiskPath             string       `protobuf:"bytes,1,opt,name=disk_path,json=diskPath,proto3" json:"disk_path,omitempty"`
 	Hypervisor           string       `protobuf:"bytes,2,opt,name=hypervisor,proto3" json:"hypervisor,omitempty"`
 	VddkInfo             *VDDKConInfo `protobuf:"bytes,3,opt,name=vddk_info,json=vddkInfo,proto3" json:"vddk_info,omitempty"`
+	EncryptKey           string       `protobuf:"bytes,4,opt,name=encrypt_key,json=encryptKey,proto3" json:"encrypt_key,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}     `json:"-"`
 	XXX_unrecognized     []byte       `json:"-"`
 	XXX_sizecache        int32        `json:"-"`
@@ -1060,10 +1069,18 @@ func (m *ResizeFsParams) GetVddkInfo() *VDDKConInfo {
 	return nil
 }
 
+func (m *ResizeFsParams) GetEncryptKey() string {
+	if m != nil {
+		return m.EncryptKey
+	}
+	return ""
+}
+
 type FormatFsParams struct {
 	DiskPath             string   `protobuf:"bytes,1,opt,name=disk_path,json=diskPath,proto3" json:"disk_path,omitempty"`
 	FsFormat             string   `protobuf:"bytes,2,opt,name=fs_format,json=fsFormat,proto3" json:"fs_format,omitempty"`
 	Uuid                 string   `protobuf:"bytes,3,opt,name=uuid,proto3" json:"uuid,omitempty"`
+	EncryptKey           string   `protobuf:"bytes,4,opt,name=encrypt_key,json=encryptKey,proto3" json:"encrypt_key,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -1115,6 +1132,13 @@ func (m *FormatFsParams) GetUuid() string {
 	return ""
 }
 
+func (m *FormatFsParams) GetEncryptKey() string {
+	if m != nil {
+		return m.EncryptKey
+	}
+	return ""
+}
+
 type ReleaseInfo struct {
 	Distro               string   `protobuf:"bytes,1,opt,name=distro,proto3" json:"distro,omitempty"`
 	Version              string   `protobuf:"bytes,2,opt,name=version,proto3" json:"version,omitempty"`
@@ -1549,116 +1573,117 @@ func init() {
 func init() { proto.RegisterFile("deploy.proto", fileDescriptor_05f09e103004e384) }
 
 var fileDescriptor_05f09e103004e384 = []byte{
-	// 1735 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x57, 0x4f, 0x6f, 0x1c, 0x49,
-	0x15, 0xd7, 0xfc, 0x9f, 0x7e, 0xe3, 0xd8, 0x4e, 0xc5, 0xb1, 0x7b, 0x27, 0x9b, 0x5d, 0x6b, 0x10,
-	0xc8, 0x0a, 0x59, 0x4b, 0x38, 0xc0, 0x05, 0x21, 0x11, 0xd9, 0x9b, 0xdd, 0x51, 0xd8, 0xc5, 0x6a,
-	0x27, 0x70, 0xa3, 0x55, 0xee, 0xae, 0x99, 0x29, 0xdc, 0x5d, 0xd5, 0xea, 0xaa, 0x99, 0xc9, 0x20,
-	0xed, 0x37, 0xe2, 0x84, 0xc4, 0x95, 0x23, 0xdf, 0x01, 0x3e, 0x02, 0x5f, 0x80, 0x2b, 0x7a, 0xaf,
-	0xaa, 0x7b, 0x7a, 0xbc, 0x21, 0x9b, 0x0b, 0xa7, 0xae, 0xf7, 0xa7, 0xaa, 0xde, 0xdf, 0xdf, 0xab,
-	0x86, 0xbd, 0x54, 0x14, 0x99, 0xde, 0x9c, 0x17, 0xa5, 0xb6, 0x9a, 0x75, 0x79, 0x21, 0xcd, 0xe4,
-	0x5f, 0x2d, 0x08, 0xbe, 0x5a, 0x0a, 0x63, 0xaf, 0x84, 0x49, 0x18, 0x83, 0xae, 0xe2, 0xb9, 0x08,
-	0x5b, 0xa7, 0xad, 0xb3, 0x20, 0xa2, 0x35, 0xf2, 0x96, 0x4b, 0x99, 0x86, 0x6d, 0xc7, 0xc3, 0x35,
-	0x3b, 0x86, 0x7e, 0xaa, 0x73, 0x2e, 0x55, 0xd8, 0x21, 0xae, 0xa7, 0xd8, 0x53, 0xe8, 0x2a, 0x99,
-	0x98, 0xb0, 0x7b, 0xda, 0x39, 0x1b, 0x5d, 0x04, 0xe7, 0x78, 0xc5, 0xf9, 0xb7, 0x32, 0x89, 0x88,
-	0xcd, 0x9e, 0xc3, 0x1e, 0x7e, 0x63, 0x63, 0xb9, 0x4a, 0x6f, 0x37, 0x61, 0xef, 0xbe, 0xda, 0x08,
-	0xc5, 0x37, 0x4e, 0xca, 0x4e, 0xa1, 0x97, 0x4a, 0x73, 0x67, 0xc2, 0x3e, 0xa9, 0x81, 0x53, 0xbb,
-	0x92, 0xe6, 0x2e, 0x72, 0x02, 0xf6, 0x19, 0xc0, 0xd7, 0x9b, 0x42, 0x94, 0x2b, 0x69, 0x74, 0x19,
-	0x0e, 0xc8, 0x94, 0x06, 0x67, 0xf2, 0xcf, 0x0e, 0x74, 0x51, 0x9f, 0x9d, 0xc0, 0x00, 0x77, 0xc4,
-	0x32, 0xf5, 0xae, 0xf5, 0x91, 0x9c, 0x3a, 0x47, 0x4a, 0xb9, 0x12, 0xa5, 0x77, 0xcf, 0x53, 0xec,
-	0x29, 0x40, 0xc2, 0x93, 0x85, 0x88, 0x73, 0x9d, 0x0a, 0xef, 0x64, 0x40, 0x9c, 0x6f, 0x74, 0x2a,
-	0xd8, 0x27, 0x30, 0xe4, 0x52, 0x3b, 0x61, 0x97, 0x84, 0x03, 0x2e, 0x35, 0x89, 0x18, 0x74, 0x8d,
-	0xfc, 0xb3, 0x08, 0x7b, 0xa7, 0xad, 0xb3, 0x4e, 0x44, 0x6b, 0xf6, 0x39, 0x8c, 0xac, 0xc8, 0x8b,
-	0x8c, 0x5b, 0x81, 0x26, 0xf4, 0x9d, 0xa1, 0x15, 0x6b, 0x9a, 0xe2, 0x75, 0x32, 0xe7, 0x73, 0x11,
-	0x17, 0xdc, 0x2e, 0xbc, 0x23, 0x01, 0x71, 0xae, 0xb9, 0x5d, 0xa0, 0xd8, 0x58, 0x5d, 0xa2, 0x82,
-	0x4c, 0xc3, 0xa1, 0x13, 0x7b, 0xce, 0x34, 0x65, 0x9f, 0x42, 0x90, 0xcb, 0x79, 0xc9, 0xad, 0x54,
-	0xf3, 0x30, 0x38, 0x6d, 0x9d, 0x0d, 0xa3, 0x2d, 0x83, 0x3d, 0x83, 0x87, 0x96, 0x97, 0x73, 0x61,
-	0xe3, 0xc6, 0x19, 0x40, 0x67, 0x1c, 0x38, 0xc1, 0x4d, 0x7d, 0x12, 0x83, 0x2e, 0x59, 0x30, 0x72,
-	0xb9, 0xc6, 0x35, 0x86, 0x68, 0xa6, 0xcb, 0x9c, 0xdb, 0x70, 0xcf, 0x85, 0xc8, 0x51, 0xec, 0x08,
-	0x7a, 0x52, 0xa5, 0xe2, 0x5d, 0xf8, 0xe0, 0xb4, 0x75, 0xd6, 0x8b, 0x1c, 0xc1, 0x7e, 0x0c, 0xfb,
-	0xb9, 0x28, 0xe7, 0x22, 0x36, 0x8a, 0x17, 0x66, 0xa1, 0x6d, 0xb8, 0x4f, 0x06, 0x3d, 0x20, 0xee,
-	0x8d, 0x67, 0xb2, 0x7d, 0x68, 0xcf, 0x4c, 0x78, 0x40, 0x07, 0xb6, 0x67, 0x94, 0xc9, 0x5c, 0x2f,
-	0x95, 0x2d, 0xb4, 0x54, 0x36, 0x3c, 0x74, 0x01, 0xda, 0x72, 0xd8, 0x21, 0x74, 0x52, 0xb1, 0x0a,
-	0x1f, 0x92, 0x00, 0x97, 0x93, 0x7f, 0x77, 0xa1, 0xf3, 0xad, 0x4c, 0x50, 0x92, 0xf3, 0xc4, 0xa7,
-	0x15, 0x97, 0x78, 0xb6, 0x2c, 0x7c, 0x3e, 0xdb, 0xb2, 0x40, 0x0d, 0x25, 0xac, 0x4f, 0x22, 0x2e,
-	0xd9, 0x63, 0xe8, 0x2b, 0x61, 0x31, 0x0e, 0x2e, 0x79, 0x3d, 0x25, 0xec, 0x34, 0x65, 0x21, 0x0c,
-	0x56, 0xb2, 0xb4, 0x4b, 0x9e, 0x51, 0xf6, 0x86, 0x51, 0x45, 0xa2, 0x64, 0xce, 0xad, 0x58, 0xf3,
-	0x8d, 0x4f, 0x5e, 0x45, 0x92, 0x61, 0xca, 0xf8, 0x94, 0xe1, 0xb2, 0xd1, 0x1b, 0xc3, 0x9d, 0xde,
-	0x38, 0x86, 0x7e, 0xa9, 0x97, 0x56, 0x18, 0x4a, 0x51, 0x10, 0x79, 0x0a, 0xf9, 0x72, 0x46, 0x5d,
-	0xe7, 0x92, 0xe2, 0x29, 0xbc, 0x33, 0xe7, 0xe6, 0x2e, 0x13, 0x8a, 0xd2, 0xd1, 0x8b, 0x2a, 0xb2,
-	0x51, 0xb4, 0x7b, 0x3b, 0x45, 0x7b, 0x0c, 0xfd, 0xdb, 0x52, 0xa6, 0x73, 0x41, 0x29, 0x09, 0x22,
-	0x4f, 0x61, 0xf5, 0xaf, 0x65, 0x49, 0x79, 0xdf, 0x77, 0x02, 0x24, 0x5d, 0xba, 0x57, 0x19, 0x57,
-	0x94, 0x87, 0x5e, 0x44, 0x6b, 0x2c, 0x26, 0xa9, 0xac, 0x28, 0x67, 0x3c, 0x11, 0x3e, 0x11, 0x5b,
-	0x06, 0xc6, 0xf6, 0x76, 0x4d, 0x69, 0xe8, 0x45, 0xed, 0xdb, 0xf5, 0xb6, 0x08, 0x58, 0xb3, 0x08,
-	0x3e, 0x87, 0x91, 0x8f, 0x5c, 0x2c, 0x0b, 0x13, 0x3e, 0x3a, 0xed, 0x60, 0x3a, 0x3d, 0x6b, 0x5a,
-	0x18, 0x54, 0x10, 0xef, 0xac, 0x28, 0x95, 0xc8, 0xd0, 0xaa, 0x23, 0x97, 0xef, 0x8a, 0x35, 0x4d,
-	0xd9, 0x13, 0x08, 0xac, 0xe0, 0x79, 0xbc, 0x96, 0x76, 0x11, 0x3e, 0x26, 0xf1, 0x10, 0x19, 0x7f,
-	0x90, 0xae, 0x22, 0x73, 0xae, 0x30, 0x4d, 0xc7, 0x94, 0x26, 0x4f, 0x61, 0x57, 0x2a, 0x99, 0xc4,
-	0x76, 0x53, 0x88, 0xf0, 0xc4, 0xa5, 0x49, 0xc9, 0xe4, 0xcd, 0xa6, 0xa0, 0x10, 0x64, 0x52, 0xdd,
-	0xc5, 0xcb, 0x22, 0x0c, 0xdd, 0x1e, 0x24, 0xdf, 0x52, 0x71, 0xe4, 0x76, 0x19, 0x7e, 0x42, 0xdd,
-	0x8a, 0xcb, 0x1a, 0x03, 0xc7, 0x5b, 0x0c, 0x9c, 0xac, 0x61, 0xf4, 0xfb, 0xab, 0xab, 0xd7, 0x97,
-	0x5a, 0x4d, 0xd5, 0x4c, 0xa3, 0xca, 0x42, 0x1b, 0x5b, 0xc1, 0x24, 0xae, 0xa9, 0x75, 0x74, 0x69,
-	0xa9, 0xee, 0x7a, 0x11, 0xad, 0x09, 0x3a, 0x8d, 0x28, 0x7d, 0xe9, 0xd1, 0x1a, 0x8d, 0x2f, 0xb8,
-	0x31, 0xeb, 0xaa, 0xf6, 0x3c, 0x85, 0x91, 0x5c, 0xe5, 0xa5, 0x98, 0x51, 0xe9, 0x05, 0x91, 0x23,
-	0x26, 0xff, 0x69, 0x03, 0x5c, 0x11, 0x6a, 0xd3, 0xc5, 0xcf, 0x01, 0x8a, 0xe5, 0x6d, 0x26, 0x93,
-	0xf8, 0x4e, 0x6c, 0xe8, 0xfa, 0xd1, 0xc5, 0x03, 0x87, 0x8b, 0x37, 0x37, 0x5f, 0xbf, 0x16, 0x1b,
-	0x13, 0x05, 0x4e, 0xe1, 0xb5, 0xd8, 0xb0, 0x2f, 0x60, 0xe0, 0x10, 0xdf, 0x84, 0x6d, 0x82, 0xd0,
-	0x47, 0x1e, 0x42, 0x89, 0x79, 0xa9, 0x95, 0x15, 0xca, 0x46, 0x95, 0x0e, 0x1b, 0xc3, 0x90, 0x6c,
-	0xd1, 0x65, 0xea, 0x2d, 0xae, 0x69, 0x8c, 0x9f, 0x34, 0xb1, 0x54, 0xd2, 0x92, 0xd9, 0xc3, 0xa8,
-	0x2f, 0xcd, 0x54, 0x49, 0x8b, 0xd0, 0x24, 0x14, 0xbf, 0xcd, 0x44, 0x6c, 0xed, 0xc6, 0xb7, 0x4d,
-	0xe0, 0x38, 0x6f, 0xec, 0x06, 0xc1, 0x27, 0x15, 0x33, 0xbe, 0xcc, 0x6c, 0x5c, 0x6a, 0x6d, 0x63,
-	0x0a, 0x47, 0x9f, 0xb4, 0x0e, 0xbc, 0x20, 0xd2, 0xda, 0xbe, 0xc5, 0xc8, 0xfc, 0x0a, 0xc6, 0x6b,
-	0xa9, 0x52, 0xbd, 0x36, 0x71, 0xb5, 0x87, 0xa7, 0xb9, 0x54, 0x6e, 0xd3, 0x80, 0x36, 0x9d, 0x78,
-	0x8d, 0x2b, 0xa7, 0xf0, 0x12, 0xe5, 0xb4, 0xf9, 0x19, 0x3c, 0xf4, 0x76, 0x24, 0x99, 0x5e, 0xa6,
-	0xce, 0xd4, 0xa1, 0xbb, 0xc8, 0x09, 0x2e, 0x91, 0x4f, 0x36, 0xff, 0x08, 0x1e, 0x64, 0x7a, 0x2e,
-	0x55, 0xcc, 0x93, 0x04, 0x21, 0xc6, 0x37, 0xe4, 0x1e, 0x31, 0x5f, 0x3a, 0xde, 0xe4, 0x2f, 0x2d,
-	0x18, 0xf8, 0x98, 0xa2, 0x93, 0xf7, 0xc2, 0x1e, 0x34, 0xe3, 0x4c, 0x4e, 0x66, 0xc2, 0x8a, 0xb8,
-	0xa1, 0xe5, 0xf0, 0xe7, 0xc0, 0x09, 0xae, 0x6b, 0xdd, 0x33, 0x38, 0x74, 0x4e, 0x35, 0x54, 0x5d,
-	0xb0, 0xf7, 0x89, 0xbf, 0xd5, 0x7c, 0x0e, 0xac, 0x28, 0xf5, 0x9f, 0x44, 0x62, 0x9b, 0xba, 0xae,
-	0x68, 0x0e, 0xbd, 0xa4, 0xd6, 0x9e, 0xbc, 0x85, 0x07, 0x3b, 0x69, 0xad, 0xa1, 0xbc, 0xd5, 0x80,
-	0xf2, 0x10, 0x06, 0x89, 0x13, 0x7b, 0xf3, 0x2a, 0x12, 0xab, 0x92, 0x27, 0x56, 0xea, 0x7a, 0xa0,
-	0x3b, 0x6a, 0x32, 0x80, 0xde, 0x97, 0x79, 0x61, 0x37, 0x93, 0xbf, 0xb5, 0xe0, 0xb1, 0xbb, 0x80,
-	0x5e, 0x0b, 0xaf, 0x4c, 0x24, 0x4c, 0xa1, 0x95, 0x11, 0x84, 0x46, 0xd2, 0xd8, 0x52, 0x37, 0x46,
-	0xab, 0x2d, 0x35, 0xa1, 0xa9, 0x28, 0x0d, 0x9e, 0xe9, 0x2f, 0xf3, 0x24, 0x9a, 0xc6, 0xcb, 0x64,
-	0x51, 0xb5, 0x05, 0xae, 0xb1, 0xf8, 0x32, 0xae, 0xe6, 0x4b, 0x3e, 0xaf, 0x26, 0x6a, 0x4d, 0x23,
-	0xe8, 0x68, 0xe3, 0xfb, 0xa2, 0xad, 0x0d, 0x9e, 0x5c, 0x65, 0xce, 0xa3, 0xb1, 0x27, 0xb1, 0x9b,
-	0x31, 0x48, 0x1e, 0x8d, 0xef, 0xc4, 0x66, 0xf2, 0xf7, 0x16, 0xec, 0x39, 0xbb, 0xaf, 0x79, 0xc9,
-	0x73, 0x83, 0xc8, 0x42, 0x4f, 0x81, 0x46, 0x70, 0x86, 0xc8, 0xa0, 0x41, 0x7b, 0x0e, 0x30, 0x47,
-	0xf7, 0xe2, 0x54, 0x98, 0x84, 0xcc, 0x1e, 0x5d, 0x1c, 0xb8, 0xa6, 0xa9, 0x1f, 0x49, 0x51, 0x30,
-	0xaf, 0xdf, 0x4b, 0x3f, 0x83, 0x91, 0xeb, 0x9e, 0x58, 0xaa, 0x99, 0x26, 0x87, 0x46, 0x17, 0x87,
-	0xcd, 0x2e, 0xc3, 0xb6, 0x8d, 0x20, 0xdd, 0xb6, 0xf0, 0x39, 0x04, 0xab, 0x34, 0xbd, 0x73, 0x1b,
-	0xba, 0xb4, 0xe1, 0xa1, 0xdb, 0xd0, 0x40, 0x98, 0x68, 0x88, 0x3a, 0xb8, 0x9a, 0x7c, 0x07, 0xfb,
-	0x91, 0xc0, 0x57, 0xc4, 0x2b, 0xf3, 0x31, 0x1e, 0x7c, 0x06, 0xb0, 0xd8, 0x3e, 0x89, 0x5c, 0xe0,
-	0x1b, 0x9c, 0xdd, 0xeb, 0x3b, 0x3f, 0x7c, 0xfd, 0x1f, 0x61, 0xff, 0x15, 0xcd, 0xfb, 0x8f, 0xbb,
-	0xfe, 0x09, 0x04, 0x33, 0x13, 0xfb, 0xf7, 0x82, 0xbb, 0x7d, 0x38, 0x33, 0xee, 0x84, 0xfa, 0x25,
-	0xd9, 0xd9, 0xbe, 0x24, 0x27, 0x1a, 0x46, 0x91, 0xc8, 0x04, 0x37, 0x82, 0xa2, 0xf3, 0x7f, 0x2f,
-	0xa6, 0xc9, 0x37, 0xc0, 0x6e, 0xf8, 0x4a, 0xbc, 0xd1, 0x5f, 0x65, 0x5c, 0x25, 0xe2, 0x63, 0x9c,
-	0x1a, 0xc3, 0x30, 0xd1, 0x79, 0x51, 0x0a, 0x63, 0xe8, 0xf6, 0x61, 0x54, 0xd3, 0x13, 0x01, 0x47,
-	0xcd, 0xe3, 0xea, 0xae, 0x38, 0x81, 0x81, 0x36, 0x2e, 0xca, 0xde, 0x13, 0x6d, 0xc8, 0xc3, 0x9f,
-	0xc3, 0x5e, 0xe9, 0x1c, 0x76, 0xd2, 0x76, 0x33, 0x07, 0x8d, 0x50, 0x44, 0xa3, 0x72, 0x4b, 0x4c,
-	0x5e, 0xc0, 0xd1, 0x75, 0xa9, 0x6f, 0xc5, 0x14, 0xdf, 0x84, 0xc8, 0xb9, 0x2e, 0x79, 0xce, 0x3f,
-	0x6c, 0xf7, 0xe4, 0xaf, 0x6d, 0x08, 0xea, 0x0d, 0xec, 0xd9, 0xae, 0x45, 0xef, 0xbd, 0xb3, 0x32,
-	0xd2, 0x59, 0x4f, 0x83, 0xb4, 0x5d, 0x59, 0x4f, 0x73, 0xf4, 0x27, 0x70, 0x20, 0x4d, 0xbc, 0x14,
-	0x33, 0x19, 0x9b, 0x65, 0x41, 0x03, 0xaf, 0xe3, 0xde, 0x77, 0xd2, 0xbc, 0x15, 0x33, 0x79, 0xe3,
-	0x98, 0x08, 0x73, 0xd2, 0xc4, 0xd9, 0x2a, 0x8f, 0x0b, 0x5e, 0x5a, 0x49, 0xc8, 0xe2, 0x06, 0xc7,
-	0xbe, 0x34, 0xbf, 0x5d, 0xe5, 0xd7, 0x15, 0x17, 0x9f, 0x02, 0xd2, 0xc4, 0xa5, 0xe0, 0xa9, 0x56,
-	0x59, 0x35, 0x41, 0x40, 0x9a, 0xc8, 0x73, 0xd8, 0x2f, 0xe1, 0xa4, 0x58, 0x6c, 0x8c, 0x4c, 0x78,
-	0xb6, 0x3d, 0xcc, 0xd9, 0xe6, 0xba, 0xff, 0x71, 0x25, 0xae, 0x0f, 0x25, 0x53, 0x7f, 0x01, 0x27,
-	0x34, 0xb2, 0x8c, 0xe5, 0x59, 0x26, 0xd2, 0xe6, 0x5c, 0x70, 0xb3, 0xe4, 0x08, 0x47, 0x98, 0x97,
-	0xd6, 0xc3, 0x61, 0xf2, 0x53, 0xd8, 0xfb, 0xd2, 0xbc, 0x93, 0xf8, 0xdb, 0x40, 0xa1, 0xf8, 0x60,
-	0x84, 0xbf, 0x83, 0xe3, 0x4b, 0xad, 0x94, 0x48, 0x6c, 0xb5, 0xa7, 0xea, 0x92, 0x9d, 0x3e, 0x6b,
-	0xfd, 0x60, 0x9f, 0xb1, 0x17, 0x30, 0xe2, 0x49, 0x22, 0x8c, 0xa9, 0xaa, 0x02, 0xe7, 0x35, 0x73,
-	0x3b, 0x9a, 0xf6, 0x44, 0xe0, 0xd4, 0xa8, 0x2a, 0x2e, 0xe1, 0xa4, 0xbe, 0xd7, 0xdb, 0x21, 0xfd,
-	0x13, 0xe5, 0xac, 0xfa, 0x79, 0x6a, 0xfd, 0xcf, 0x93, 0x9c, 0xc2, 0xc5, 0x3f, 0x3a, 0x30, 0x72,
-	0x58, 0xf5, 0x72, 0x8e, 0xa3, 0xe0, 0x37, 0xd5, 0x24, 0xf1, 0x40, 0xcf, 0x58, 0x13, 0xcf, 0x9c,
-	0x7b, 0xe3, 0x27, 0x4d, 0xde, 0xfd, 0x89, 0xf0, 0x05, 0x0c, 0x2b, 0xc8, 0x62, 0x47, 0x55, 0x91,
-	0x35, 0x21, 0x6c, 0x3c, 0xf2, 0xe6, 0xe0, 0x68, 0x41, 0xf5, 0x0a, 0x62, 0x2a, 0xf5, 0x5d, 0xc8,
-	0xd9, 0x55, 0xbf, 0x82, 0xbd, 0x66, 0xc7, 0xb1, 0xd0, 0xbf, 0x7f, 0xbe, 0xd7, 0xd4, 0xe3, 0xf1,
-	0xf7, 0x25, 0xb5, 0x8d, 0xbf, 0x86, 0xfd, 0xdd, 0x86, 0x62, 0x5e, 0xfb, 0x7d, 0x6d, 0x36, 0xf6,
-	0x33, 0x60, 0xab, 0xfc, 0x3b, 0x38, 0xbc, 0x9f, 0x78, 0xf6, 0xa9, 0x53, 0x7a, 0x7f, 0x41, 0x8c,
-	0x9f, 0xee, 0x66, 0xe0, 0x7e, 0xbe, 0x5e, 0xc2, 0xa3, 0x2b, 0x69, 0x92, 0xfb, 0x67, 0x7e, 0x78,
-	0xd7, 0x4e, 0x60, 0x6e, 0xfb, 0xf4, 0x5f, 0xff, 0xe2, 0xbf, 0x01, 0x00, 0x00, 0xff, 0xff, 0x30,
-	0xc4, 0xb6, 0xf0, 0xe7, 0x0f, 0x00, 0x00,
+	// 1754 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x58, 0xdd, 0x6e, 0x1c, 0x49,
+	0x15, 0xd6, 0xfc, 0x4f, 0x9f, 0xf1, 0x5f, 0x2a, 0x8e, 0xdd, 0x3b, 0xd9, 0xec, 0x5a, 0x83, 0x40,
+	0x56, 0xc8, 0x5a, 0xc2, 0x01, 0x6e, 0x10, 0x12, 0x91, 0xbd, 0xd9, 0xb5, 0xc2, 0x2e, 0x56, 0x3b,
+	0x81, 0xcb, 0x56, 0xb9, 0xbb, 0x66, 0xa6, 0x70, 0x77, 0x55, 0xab, 0xab, 0x66, 0x26, 0x83, 0x84,
+	0x78, 0x13, 0x9e, 0x80, 0x2b, 0x24, 0x5e, 0x81, 0x77, 0x80, 0x1b, 0xee, 0x79, 0x01, 0x6e, 0xd1,
+	0x39, 0x55, 0xdd, 0xd3, 0xe3, 0x84, 0x6c, 0xb8, 0xd8, 0x2b, 0xd7, 0xf9, 0xce, 0xa9, 0xea, 0xaf,
+	0xce, 0x6f, 0x8d, 0x61, 0x27, 0x15, 0x45, 0xa6, 0xd7, 0x67, 0x45, 0xa9, 0xad, 0x66, 0x5d, 0x5e,
+	0x48, 0x33, 0xf9, 0x67, 0x0b, 0x82, 0xaf, 0x16, 0xc2, 0xd8, 0x4b, 0x61, 0x12, 0xc6, 0xa0, 0xab,
+	0x78, 0x2e, 0xc2, 0xd6, 0x49, 0xeb, 0x34, 0x88, 0x68, 0x8d, 0xd8, 0x62, 0x21, 0xd3, 0xb0, 0xed,
+	0x30, 0x5c, 0xb3, 0x23, 0xe8, 0xa7, 0x3a, 0xe7, 0x52, 0x85, 0x1d, 0x42, 0xbd, 0xc4, 0x9e, 0x40,
+	0x57, 0xc9, 0xc4, 0x84, 0xdd, 0x93, 0xce, 0xe9, 0xe8, 0x3c, 0x38, 0xc3, 0x4f, 0x9c, 0x7d, 0x2b,
+	0x93, 0x88, 0x60, 0xf6, 0x0c, 0x76, 0xf0, 0x6f, 0x6c, 0x2c, 0x57, 0xe9, 0xed, 0x3a, 0xec, 0xdd,
+	0x37, 0x1b, 0xa1, 0xfa, 0xc6, 0x69, 0xd9, 0x09, 0xf4, 0x52, 0x69, 0xee, 0x4c, 0xd8, 0x27, 0x33,
+	0x70, 0x66, 0x97, 0xd2, 0xdc, 0x45, 0x4e, 0xc1, 0x3e, 0x03, 0xf8, 0x7a, 0x5d, 0x88, 0x72, 0x29,
+	0x8d, 0x2e, 0xc3, 0x01, 0x51, 0x69, 0x20, 0x93, 0x7f, 0x74, 0xa0, 0x8b, 0xf6, 0xec, 0x18, 0x06,
+	0xb8, 0x23, 0x96, 0xa9, 0xbf, 0x5a, 0x1f, 0xc5, 0x2b, 0x77, 0x91, 0x52, 0x2e, 0x45, 0xe9, 0xaf,
+	0xe7, 0x25, 0xf6, 0x04, 0x20, 0xe1, 0xc9, 0x5c, 0xc4, 0xb9, 0x4e, 0x85, 0xbf, 0x64, 0x40, 0xc8,
+	0x37, 0x3a, 0x15, 0xec, 0x13, 0x18, 0x72, 0xa9, 0x9d, 0xb2, 0x4b, 0xca, 0x01, 0x97, 0x9a, 0x54,
+	0x0c, 0xba, 0x46, 0xfe, 0x41, 0x84, 0xbd, 0x93, 0xd6, 0x69, 0x27, 0xa2, 0x35, 0xfb, 0x1c, 0x46,
+	0x56, 0xe4, 0x45, 0xc6, 0xad, 0x40, 0x0a, 0x7d, 0x47, 0xb4, 0x82, 0xae, 0x52, 0xfc, 0x9c, 0xcc,
+	0xf9, 0x4c, 0xc4, 0x05, 0xb7, 0x73, 0x7f, 0x91, 0x80, 0x90, 0x6b, 0x6e, 0xe7, 0xa8, 0x36, 0x56,
+	0x97, 0x68, 0x20, 0xd3, 0x70, 0xe8, 0xd4, 0x1e, 0xb9, 0x4a, 0xd9, 0xa7, 0x10, 0xe4, 0x72, 0x56,
+	0x72, 0x2b, 0xd5, 0x2c, 0x0c, 0x4e, 0x5a, 0xa7, 0xc3, 0x68, 0x03, 0xb0, 0xa7, 0xf0, 0xc0, 0xf2,
+	0x72, 0x26, 0x6c, 0xdc, 0x38, 0x03, 0xe8, 0x8c, 0x7d, 0xa7, 0xb8, 0xa9, 0x4f, 0x62, 0xd0, 0x25,
+	0x06, 0x23, 0x17, 0x6b, 0x5c, 0xa3, 0x8b, 0xa6, 0xba, 0xcc, 0xb9, 0x0d, 0x77, 0x9c, 0x8b, 0x9c,
+	0xc4, 0x0e, 0xa1, 0x27, 0x55, 0x2a, 0xde, 0x86, 0xbb, 0x27, 0xad, 0xd3, 0x5e, 0xe4, 0x04, 0xf6,
+	0x43, 0xd8, 0xcb, 0x45, 0x39, 0x13, 0xb1, 0x51, 0xbc, 0x30, 0x73, 0x6d, 0xc3, 0x3d, 0x22, 0xb4,
+	0x4b, 0xe8, 0x8d, 0x07, 0xd9, 0x1e, 0xb4, 0xa7, 0x26, 0xdc, 0xa7, 0x03, 0xdb, 0x53, 0x8a, 0x64,
+	0xae, 0x17, 0xca, 0x16, 0x5a, 0x2a, 0x1b, 0x1e, 0x38, 0x07, 0x6d, 0x10, 0x76, 0x00, 0x9d, 0x54,
+	0x2c, 0xc3, 0x07, 0xa4, 0xc0, 0xe5, 0xe4, 0xdf, 0x5d, 0xe8, 0x7c, 0x2b, 0x13, 0xd4, 0xe4, 0x3c,
+	0xf1, 0x61, 0xc5, 0x25, 0x9e, 0x2d, 0x0b, 0x1f, 0xcf, 0xb6, 0x2c, 0xd0, 0x42, 0x09, 0xeb, 0x83,
+	0x88, 0x4b, 0xf6, 0x08, 0xfa, 0x4a, 0x58, 0xf4, 0x83, 0x0b, 0x5e, 0x4f, 0x09, 0x7b, 0x95, 0xb2,
+	0x10, 0x06, 0x4b, 0x59, 0xda, 0x05, 0xcf, 0x28, 0x7a, 0xc3, 0xa8, 0x12, 0x51, 0x33, 0xe3, 0x56,
+	0xac, 0xf8, 0xda, 0x07, 0xaf, 0x12, 0x89, 0x98, 0x32, 0x3e, 0x64, 0xb8, 0x6c, 0xd4, 0xc6, 0x70,
+	0xab, 0x36, 0x8e, 0xa0, 0x5f, 0xea, 0x85, 0x15, 0x86, 0x42, 0x14, 0x44, 0x5e, 0x42, 0x5c, 0x4e,
+	0xa9, 0xea, 0x5c, 0x50, 0xbc, 0x84, 0xdf, 0xcc, 0xb9, 0xb9, 0xcb, 0x84, 0xa2, 0x70, 0xf4, 0xa2,
+	0x4a, 0x6c, 0x24, 0xed, 0xce, 0x56, 0xd2, 0x1e, 0x41, 0xff, 0xb6, 0x94, 0xe9, 0x4c, 0x50, 0x48,
+	0x82, 0xc8, 0x4b, 0x98, 0xfd, 0x2b, 0x59, 0x52, 0xdc, 0xf7, 0x9c, 0x02, 0x45, 0x17, 0xee, 0x65,
+	0xc6, 0x15, 0xc5, 0xa1, 0x17, 0xd1, 0x1a, 0x93, 0x49, 0x2a, 0x2b, 0xca, 0x29, 0x4f, 0x84, 0x0f,
+	0xc4, 0x06, 0x40, 0xdf, 0xde, 0xae, 0x28, 0x0c, 0xbd, 0xa8, 0x7d, 0xbb, 0xda, 0x24, 0x01, 0x6b,
+	0x26, 0xc1, 0xe7, 0x30, 0xf2, 0x9e, 0x8b, 0x65, 0x61, 0xc2, 0x87, 0x27, 0x1d, 0x0c, 0xa7, 0x87,
+	0xae, 0x0a, 0x83, 0x06, 0xe2, 0xad, 0x15, 0xa5, 0x12, 0x19, 0xb2, 0x3a, 0x74, 0xf1, 0xae, 0xa0,
+	0xab, 0x94, 0x3d, 0x86, 0xc0, 0x0a, 0x9e, 0xc7, 0x2b, 0x69, 0xe7, 0xe1, 0x23, 0x52, 0x0f, 0x11,
+	0xf8, 0x9d, 0x74, 0x19, 0x99, 0x73, 0x85, 0x61, 0x3a, 0xa2, 0x30, 0x79, 0x09, 0xab, 0x52, 0xc9,
+	0x24, 0xb6, 0xeb, 0x42, 0x84, 0xc7, 0x2e, 0x4c, 0x4a, 0x26, 0xaf, 0xd7, 0x05, 0xb9, 0x20, 0x93,
+	0xea, 0x2e, 0x5e, 0x14, 0x61, 0xe8, 0xf6, 0xa0, 0xf8, 0x86, 0x92, 0x23, 0xb7, 0x8b, 0xf0, 0x13,
+	0xaa, 0x56, 0x5c, 0xd6, 0x3d, 0x70, 0xbc, 0xe9, 0x81, 0x93, 0x15, 0x8c, 0x7e, 0x7b, 0x79, 0xf9,
+	0xea, 0x42, 0xab, 0x2b, 0x35, 0xd5, 0x68, 0x32, 0xd7, 0xc6, 0x56, 0x6d, 0x12, 0xd7, 0x88, 0x15,
+	0xba, 0xb4, 0x94, 0x77, 0xbd, 0x88, 0xd6, 0x88, 0x2d, 0x8c, 0x28, 0x7d, 0xea, 0xd1, 0x1a, 0xc9,
+	0x17, 0xdc, 0x98, 0x55, 0x95, 0x7b, 0x5e, 0x42, 0x4f, 0x2e, 0xf3, 0x52, 0x4c, 0x29, 0xf5, 0x82,
+	0xc8, 0x09, 0x93, 0xff, 0xb4, 0x01, 0x2e, 0xa9, 0x6b, 0xd3, 0x87, 0x9f, 0x01, 0x14, 0x8b, 0xdb,
+	0x4c, 0x26, 0xf1, 0x9d, 0x58, 0xd3, 0xe7, 0x47, 0xe7, 0xbb, 0xae, 0x2f, 0xde, 0xdc, 0x7c, 0xfd,
+	0x4a, 0xac, 0x4d, 0x14, 0x38, 0x83, 0x57, 0x62, 0xcd, 0xbe, 0x80, 0x81, 0xeb, 0xf8, 0x26, 0x6c,
+	0x53, 0x0b, 0x7d, 0xe8, 0x5b, 0x28, 0x81, 0x17, 0x5a, 0x59, 0xa1, 0x6c, 0x54, 0xd9, 0xb0, 0x31,
+	0x0c, 0x89, 0x8b, 0x2e, 0x53, 0xcf, 0xb8, 0x96, 0xd1, 0x7f, 0xd2, 0xc4, 0x52, 0x49, 0x4b, 0xb4,
+	0x87, 0x51, 0x5f, 0x9a, 0x2b, 0x25, 0x2d, 0xb6, 0x26, 0xa1, 0xf8, 0x6d, 0x26, 0x62, 0x6b, 0xd7,
+	0xbe, 0x6c, 0x02, 0x87, 0xbc, 0xb6, 0x6b, 0x6c, 0x3e, 0xa9, 0x98, 0xf2, 0x45, 0x66, 0xe3, 0x52,
+	0x6b, 0x1b, 0x93, 0x3b, 0xfa, 0x64, 0xb5, 0xef, 0x15, 0x91, 0xd6, 0xf6, 0x0d, 0x7a, 0xe6, 0x17,
+	0x30, 0x5e, 0x49, 0x95, 0xea, 0x95, 0x89, 0xab, 0x3d, 0x3c, 0xcd, 0xa5, 0x72, 0x9b, 0x06, 0xb4,
+	0xe9, 0xd8, 0x5b, 0x5c, 0x3a, 0x83, 0x17, 0xa8, 0xa7, 0xcd, 0x4f, 0xe1, 0x81, 0xe7, 0x91, 0x64,
+	0x7a, 0x91, 0x3a, 0xaa, 0x43, 0xf7, 0x21, 0xa7, 0xb8, 0x40, 0x9c, 0x38, 0xff, 0x00, 0x76, 0x33,
+	0x3d, 0x93, 0x2a, 0xe6, 0x49, 0x82, 0x2d, 0xc6, 0x17, 0xe4, 0x0e, 0x81, 0x2f, 0x1c, 0x36, 0xf9,
+	0x4b, 0x0b, 0x06, 0xde, 0xa7, 0x78, 0xc9, 0x7b, 0x6e, 0x0f, 0x9a, 0x7e, 0xa6, 0x4b, 0x66, 0xc2,
+	0x8a, 0xb8, 0x61, 0xe5, 0xfa, 0xcf, 0xbe, 0x53, 0x5c, 0xd7, 0xb6, 0xa7, 0x70, 0xe0, 0x2e, 0xd5,
+	0x30, 0x75, 0xce, 0xde, 0x23, 0x7c, 0x63, 0xf9, 0x0c, 0x58, 0x51, 0xea, 0xdf, 0x8b, 0xc4, 0x36,
+	0x6d, 0x5d, 0xd2, 0x1c, 0x78, 0x4d, 0x6d, 0x3d, 0x79, 0x03, 0xbb, 0x5b, 0x61, 0xad, 0x5b, 0x79,
+	0xab, 0xd1, 0xca, 0x43, 0x18, 0x24, 0x4e, 0xed, 0xe9, 0x55, 0x22, 0x66, 0x25, 0x4f, 0xac, 0xd4,
+	0xf5, 0x40, 0x77, 0xd2, 0x64, 0x00, 0xbd, 0x2f, 0xf3, 0xc2, 0xae, 0x27, 0x7f, 0x6b, 0xc1, 0x23,
+	0xf7, 0x01, 0x7a, 0x2d, 0xbc, 0x34, 0x91, 0x30, 0x85, 0x56, 0x46, 0xe0, 0xd6, 0x54, 0x1a, 0x5b,
+	0xea, 0xc6, 0x68, 0xb5, 0xa5, 0xa6, 0x6e, 0x2a, 0x4a, 0x83, 0x67, 0xfa, 0x8f, 0x79, 0x11, 0xa9,
+	0xf1, 0x32, 0x99, 0x57, 0x65, 0x81, 0x6b, 0x4c, 0xbe, 0x8c, 0xab, 0xd9, 0x82, 0xcf, 0xaa, 0x89,
+	0x5a, 0xcb, 0xd8, 0x74, 0xb4, 0xf1, 0x75, 0xd1, 0xd6, 0x06, 0x4f, 0xae, 0x22, 0xe7, 0xbb, 0xb1,
+	0x17, 0xb1, 0x9a, 0xd1, 0x49, 0xbe, 0x1b, 0xdf, 0x89, 0xf5, 0xe4, 0x5f, 0x2d, 0xd8, 0x71, 0xbc,
+	0xaf, 0x79, 0xc9, 0x73, 0x83, 0x9d, 0x85, 0x9e, 0x02, 0x0d, 0xe7, 0x0c, 0x11, 0xa0, 0x41, 0x7b,
+	0x06, 0x30, 0xc3, 0xeb, 0xc5, 0xa9, 0x30, 0x09, 0xd1, 0x1e, 0x9d, 0xef, 0xbb, 0xa2, 0xa9, 0x1f,
+	0x49, 0x51, 0x30, 0xab, 0x96, 0xec, 0x27, 0x30, 0x72, 0xd5, 0x13, 0x4b, 0x35, 0xd5, 0x74, 0xa1,
+	0xd1, 0xf9, 0x41, 0xb3, 0xca, 0xb0, 0x6c, 0x23, 0x48, 0xeb, 0x35, 0x3b, 0x83, 0x60, 0x99, 0xa6,
+	0x77, 0x6e, 0x43, 0x97, 0x36, 0x3c, 0x70, 0x1b, 0x1a, 0x1d, 0x26, 0x1a, 0xa2, 0x0d, 0xd9, 0x63,
+	0xab, 0x54, 0x49, 0xb9, 0x2e, 0x2c, 0xc5, 0xbf, 0xe7, 0x5b, 0xa5, 0x83, 0x30, 0xf2, 0x7f, 0x6e,
+	0xc1, 0x5e, 0x24, 0xf0, 0x9d, 0xf1, 0xd2, 0x7c, 0xcc, 0x1d, 0x3f, 0x03, 0x98, 0x6f, 0x1e, 0x4d,
+	0x2e, 0x34, 0x0d, 0x64, 0x9b, 0x60, 0xe7, 0xff, 0x26, 0xd8, 0x7d, 0x87, 0xe0, 0x9f, 0x60, 0xef,
+	0x25, 0x3d, 0x19, 0x3e, 0x8e, 0xdf, 0x63, 0x08, 0xa6, 0x26, 0xf6, 0x4f, 0x0e, 0x47, 0x6f, 0x38,
+	0x35, 0xee, 0x84, 0xfa, 0x31, 0xda, 0x69, 0x3c, 0x46, 0xbf, 0x93, 0x80, 0x86, 0x51, 0x24, 0x32,
+	0xc1, 0x8d, 0x20, 0xc2, 0xdf, 0x7b, 0xc2, 0x4e, 0xbe, 0x01, 0x76, 0xc3, 0x97, 0xe2, 0xb5, 0xfe,
+	0x2a, 0xe3, 0x2a, 0x11, 0x1f, 0x73, 0xeb, 0x31, 0x0c, 0x13, 0x9d, 0x17, 0xa5, 0x30, 0x86, 0xbe,
+	0x3e, 0x8c, 0x6a, 0x79, 0x22, 0xe0, 0xb0, 0x79, 0x5c, 0x5d, 0x79, 0xc7, 0x30, 0xd0, 0xc6, 0xc5,
+	0xc9, 0xdf, 0x44, 0x1b, 0xba, 0xe1, 0x4f, 0x61, 0xa7, 0x74, 0x17, 0x76, 0xda, 0x76, 0x33, 0x8a,
+	0x0d, 0x57, 0x44, 0xa3, 0x72, 0x23, 0x4c, 0x9e, 0xc3, 0xe1, 0x75, 0xa9, 0x6f, 0xc5, 0x15, 0xbe,
+	0x3b, 0x11, 0xb9, 0x2e, 0x79, 0xce, 0x3f, 0xcc, 0x7b, 0xf2, 0xd7, 0x36, 0x04, 0xf5, 0x06, 0xf6,
+	0x74, 0x9b, 0xd1, 0x7b, 0xbf, 0x59, 0x91, 0x74, 0xec, 0x69, 0x58, 0xb7, 0x2b, 0xf6, 0x34, 0xab,
+	0x7f, 0x04, 0xfb, 0xd2, 0xc4, 0x0b, 0x31, 0x95, 0xb1, 0x59, 0x14, 0x34, 0x54, 0x3b, 0xee, 0x0d,
+	0x29, 0xcd, 0x1b, 0x31, 0x95, 0x37, 0x0e, 0xc4, 0x56, 0x2a, 0x4d, 0x9c, 0x2d, 0xf3, 0xb8, 0xe0,
+	0xa5, 0x95, 0xd4, 0xbd, 0xdc, 0x70, 0xda, 0x93, 0xe6, 0xd7, 0xcb, 0xfc, 0xba, 0x42, 0x31, 0x43,
+	0xa4, 0x89, 0x4b, 0xc1, 0x53, 0xad, 0xb2, 0x6a, 0x4a, 0x81, 0x34, 0x91, 0x47, 0xd8, 0xcf, 0xe1,
+	0xb8, 0x98, 0xaf, 0x8d, 0x4c, 0x78, 0xb6, 0x39, 0xcc, 0x71, 0x73, 0x1d, 0xe6, 0x51, 0xa5, 0xae,
+	0x0f, 0x25, 0xaa, 0x3f, 0x83, 0x63, 0x1a, 0x8b, 0xc6, 0xf2, 0x2c, 0x13, 0x69, 0x73, 0xf6, 0xb8,
+	0x79, 0x75, 0x88, 0x63, 0xd2, 0x6b, 0xeb, 0x01, 0x34, 0xf9, 0x31, 0xec, 0x7c, 0x69, 0xde, 0x4a,
+	0xfc, 0x69, 0x42, 0xae, 0xf8, 0xa0, 0x87, 0xff, 0x08, 0x47, 0x17, 0x5a, 0x29, 0x91, 0xd8, 0x6a,
+	0x4f, 0x55, 0x46, 0x5b, 0x95, 0xda, 0xfa, 0xee, 0x4a, 0x7d, 0x0e, 0x23, 0x9e, 0x24, 0xc2, 0x98,
+	0x2a, 0x2b, 0xf0, 0x4d, 0xc0, 0xdc, 0x8e, 0x26, 0x9f, 0x08, 0x9c, 0x19, 0x65, 0xc5, 0x05, 0x1c,
+	0xd7, 0xdf, 0xf5, 0x3c, 0xa4, 0x3b, 0x99, 0x9d, 0x56, 0x3f, 0xd0, 0x5a, 0xff, 0xf3, 0x24, 0x67,
+	0x70, 0xfe, 0xf7, 0x0e, 0x8c, 0x5c, 0x3f, 0x7c, 0x31, 0xc3, 0x71, 0xf3, 0xab, 0x6a, 0x5a, 0xf9,
+	0x61, 0xc2, 0x58, 0xb3, 0x67, 0xba, 0xeb, 0x8d, 0x1f, 0x37, 0xb1, 0xfb, 0x53, 0xe7, 0x0b, 0x18,
+	0x56, 0x4d, 0x8f, 0x1d, 0x56, 0x49, 0xd6, 0x6c, 0x82, 0xe3, 0x91, 0xa7, 0x83, 0xe3, 0x0b, 0xcd,
+	0xab, 0x1e, 0x54, 0x99, 0x6f, 0xf7, 0xa4, 0x6d, 0xf3, 0x4b, 0xd8, 0x69, 0x56, 0x1c, 0x0b, 0xfd,
+	0x1b, 0xeb, 0x9d, 0xa2, 0x1e, 0x8f, 0xdf, 0xd5, 0xd4, 0x1c, 0x7f, 0x09, 0x7b, 0xdb, 0x05, 0xc5,
+	0xbc, 0xf5, 0xfb, 0xca, 0x6c, 0xec, 0xe7, 0xcc, 0xc6, 0xf8, 0x37, 0x70, 0x70, 0x3f, 0xf0, 0xec,
+	0x53, 0x67, 0xf4, 0xfe, 0x84, 0x18, 0x3f, 0xd9, 0x8e, 0xc0, 0xfd, 0x78, 0xbd, 0x80, 0x87, 0x97,
+	0xd2, 0x24, 0xf7, 0xcf, 0xfc, 0xf0, 0xae, 0x2d, 0xc7, 0xdc, 0xf6, 0xe9, 0x7f, 0x07, 0xcf, 0xff,
+	0x3b, 0x00, 0xb1, 0x46, 0x30, 0x50, 0x4b, 0x10, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -67,6 +67,7 @@ func (*DeployerServer) DeployGuestFs(ctx context.Context, req *deployapi.DeployP
 		Hypervisor: req.GuestDesc.Hypervisor,
 		DiskPath:   req.DiskPath,
 		VddkInfo:   req.VddkInfo,
+		EncryptKey: req.EncryptKey,
 	}, DeployOption.ImageDeployDriver)
 	if len(req.GuestDesc.Hypervisor) == 0 {
 		req.GuestDesc.Hypervisor = comapi.HYPERVISOR_KVM
@@ -110,6 +111,7 @@ func (*DeployerServer) ResizeFs(ctx context.Context, req *deployapi.ResizeFsPara
 		Hypervisor: req.Hypervisor,
 		DiskPath:   req.DiskPath,
 		VddkInfo:   req.VddkInfo,
+		EncryptKey: req.EncryptKey,
 	}, DeployOption.ImageDeployDriver)
 	defer disk.Disconnect()
 	if err := disk.Connect(); err != nil {
@@ -143,7 +145,7 @@ func (*DeployerServer) ResizeFs(ctx context.Context, req *deployapi.ResizeFsPara
 
 func (*DeployerServer) FormatFs(ctx context.Context, req *deployapi.FormatFsParams) (*deployapi.Empty, error) {
 	log.Infof("********* Format fs on %s", req.DiskPath)
-	gd := diskutils.NewKVMGuestDisk(req.DiskPath, DeployOption.ImageDeployDriver)
+	gd := diskutils.NewEncryptedKVMGuestDisk(req.DiskPath, DeployOption.ImageDeployDriver, req.EncryptKey)
 	defer gd.Disconnect()
 	if err := gd.Connect(); err == nil {
 		if err := gd.MakePartition(req.FsFormat); err == nil {
@@ -0,0 +1,85 @@
+// Copyright 2019 Yunion
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package hostdhcp
+
+import (
+	"net"
+	"strings"
+	"time"
+
+	"github.com/google/gopacket/layers"
+
+	"yunion.io/x/log"
+
+	"yunion.io/x/onecloud/pkg/cloudcommon/types"
+	"yunion.io/x/onecloud/pkg/hostman/options"
+	"yunion.io/x/onecloud/pkg/util/dhcp"
+)
+
+func (s *SGuestDHCPServer) getConfig6(mac net.HardwareAddr) *dhcp.ResponseConfig6 {
+	_, guestNic := s.getGuestNic(mac.String())
+	if guestNic == nil {
+		return nil
+	}
+	var nicdesc = new(types.SServerNic)
+	if err := guestNic.Unmarshal(nicdesc); err != nil {
+		log.Errorln(err)
+		return nil
+	}
+	if len(nicdesc.Ip6) == 0 {
+		return nil
+	}
+
+	var (
+		leaseTime   = time.Duration(options.HostOptions.DhcpLeaseTime) * time.Second
+		renewalTime = time.Duration(options.HostOptions.DhcpRenewalTime) * time.Second
+	)
+	conf := &dhcp.ResponseConfig6{
+		ServerMac:         s.server6.HardwareAddr(),
+		ClientIP6:         net.ParseIP(nicdesc.Ip6),
+		PrefixLen:         nicdesc.Masklen6,
+		Domain:            nicdesc.Domain,
+		RenewalTime:       renewalTime,
+		RebindTime:        leaseTime / 8 * 7,
+		PreferredLifetime: leaseTime,
+		ValidLifetime:     leaseTime,
+		MTU:               nicdesc.Mtu,
+	}
+	for _, dns := range strings.Split(nicdesc.Dns6, ",") {
+		if ip := net.ParseIP(strings.TrimSpace(dns)); ip != nil {
+			conf.DNSServers = append(conf.DNSServers, ip)
+		}
+	}
+	return conf
+}
+
+func (s *SGuestDHCPServer) ServeDHCPv6(pkt *layers.DHCPv6, mac net.HardwareAddr) (*layers.DHCPv6, error) {
+	conf := s.getConfig6(mac)
+	if conf == nil {
+		return nil, nil
+	}
+	log.Infof("Make DHCPv6 %s Reply %s TO %s", pkt.MsgType, conf.ClientIP6, mac)
+	return dhcp.MakeDHCPv6ReplyPacket(pkt, conf)
+}
+
+// ServeRouterSolicit answers with an advertisement that is not a default
+// router, the gateway is not the host and is deployed into the guest
+func (s *SGuestDHCPServer) ServeRouterSolicit(mac net.HardwareAddr) (*layers.ICMPv6RouterAdvertisement, error) {
+	conf := s.getConfig6(mac)
+	if conf == nil {
+		return nil, nil
+	}
+	return dhcp.MakeRouterAdvertisement(conf), nil
+}
@@ -34,9 +34,10 @@ import (
 const DEFAULT_DHCP_CLIENT_PORT = 68
 
 type SGuestDHCPServer struct {
-	server *dhcp.DHCPServer
-	relay  *SDHCPRelay
-	conn   *dhcp.Conn
+	server  *dhcp.DHCPServer
+	server6 *dhcp.DHCPv6Server
+	relay   *SDHCPRelay
+	conn    *dhcp.Conn
 
 	iface string
 }
@@ -63,6 +64,13 @@ func NewGuestDHCPServer(iface string, port int, relay []string) (*SGuestDHCPServ
 		}
 	}
 
+	if options.HostOptions.EnableDhcp6 {
+		guestdhcp.server6, err = dhcp.NewDHCPv6Server(iface)
+		if err != nil {
+			return nil, err
+		}
+	}
+
 	guestdhcp.iface = iface
 	return guestdhcp, nil
 }
@@ -75,6 +83,14 @@ func (s *SGuestDHCPServer) Start(blocking bool) {
 			log.Errorf("DHCP serve error: %s", err)
 		}
 	}
+	if s.server6 != nil {
+		go func() {
+			err := s.server6.ListenAndServe(s)
+			if err != nil {
+				log.Errorf("DHCPv6 serve error: %s", err)
+			}
+		}()
+	}
 	if blocking {
 		serve()
 	} else {
@@ -143,13 +159,12 @@ func (s *SGuestDHCPServer) getGuestConfig(guestDesc, guestNic jsonutils.JSONObje
 	return conf
 }
 
-func (s *SGuestDHCPServer) getConfig(pkt dhcp.Packet) *dhcp.ResponseConfig {
+func (s *SGuestDHCPServer) getGuestNic(mac string) (jsonutils.JSONObject, jsonutils.JSONObject) {
 	if guestman.GuestDescGetter == nil {
-		return nil
+		return nil, nil
 	}
 
 	var (
-		mac         = pkt.CHAddr().String()
 		ip, port    = "", ""
 		isCandidate = false
 	)
@@ -158,6 +173,14 @@ func (s *SGuestDHCPServer) getConfig(pkt dhcp.Packet) *dhcp.ResponseConfig {
 		guestDesc, guestNic = guestman.GuestDescGetter.GetGuestNicDesc(mac, ip, port, s.iface, !isCandidate)
 	}
 	if guestNic != nil && !jsonutils.QueryBoolean(guestNic, "virtual", false) {
+		return guestDesc, guestNic
+	}
+	return nil, nil
+}
+
+func (s *SGuestDHCPServer) getConfig(pkt dhcp.Packet) *dhcp.ResponseConfig {
+	guestDesc, guestNic := s.getGuestNic(pkt.CHAddr().String())
+	if guestNic != nil {
 		return s.getGuestConfig(guestDesc, guestNic)
 	}
 	return nil
@@ -823,6 +823,15 @@ func (h *SHostInfo) GetMatchNic(bridge, iface, mac string) *SNIC {
 	return nil
 }
 
+func (h *SHostInfo) GetWireIdOfInterface(iface string) string {
+	for _, nic := range h.Nics {
+		if nic.Inter == iface {
+			return nic.WireId
+		}
+	}
+	return ""
+}
+
 func (h *SHostInfo) StartRegister(delay int, callback func()) {
 	if callback != nil {
 		h.registerCallback = callback
@@ -22,6 +22,7 @@ import (
 	"yunion.io/x/jsonutils"
 	"yunion.io/x/log"
 
+	api "yunion.io/x/onecloud/pkg/apis/compute"
 	"yunion.io/x/onecloud/pkg/appctx"
 	"yunion.io/x/onecloud/pkg/appsrv"
 	"yunion.io/x/onecloud/pkg/cloudcommon/workmanager"
@@ -129,6 +130,23 @@ func GetWireInfo(ctx context.Context, wireId string) (jsonutils.JSONObject, erro
 	return modules.Wires.Get(GetComputeSession(ctx), wireId, nil)
 }
 
+// GetDiskEncryptInfo fetches the data key of an encrypted disk, the key is
+// only kept in memory or in short lived files of the host
+func GetDiskEncryptInfo(ctx context.Context, diskId string) (*api.DiskEncryptInfo, error) {
+	ret, err := modules.Disks.GetSpecific(GetComputeSession(ctx), diskId, "encrypt-info", nil)
+	if err != nil {
+		return nil, err
+	}
+	info := &api.DiskEncryptInfo{}
+	if err := ret.Unmarshal(info); err != nil {
+		return nil, err
+	}
+	if len(info.Key) == 0 {
+		return nil, fmt.Errorf("disk %s is not encrypted", diskId)
+	}
+	return info, nil
+}
+
 func RemoteStoragecacheCacheImage(ctx context.Context, storagecacheId, imageId, status, spath string) (jsonutils.JSONObject, error) {
 	var query = jsonutils.NewDict()
 	query.Set("auto_create", jsonutils.JSONTrue)
@@ -139,6 +157,11 @@ func RemoteStoragecacheCacheImage(ctx context.Context, storagecacheId, imageId,
 		storagecacheId, imageId, query, params)
 }
 
+func RemoteStoragecacheReportCachedImages(ctx context.Context, storagecacheId string, input api.StoragecacheReportCachedImagesInput) (jsonutils.JSONObject, error) {
+	return modules.Storagecaches.PerformAction(GetComputeSession(ctx),
+		storagecacheId, "report-cached-images", jsonutils.Marshal(input))
+}
+
 func UpdateServerStatus(ctx context.Context, sid, status, reason string) (jsonutils.JSONObject, error) {
 	var stats = jsonutils.NewDict()
 	stats.Set("status", jsonutils.NewString(status))
@@ -71,6 +71,7 @@ type CloudDeviceInfo struct {
 type IHost interface {
 	GetHostId() string
 	GetSession() *mcclient.ClientSession
+	GetWireIdOfInterface(iface string) string
 }
 
 type IDevice interface {
@@ -107,7 +108,9 @@ func NewManager(host IHost) (*IsolatedDeviceManager, error) {
 }
 
 func (man *IsolatedDeviceManager) fillPCIDevices() error {
-	// only support gpu by now
+	if err := man.fillSRIOVNicDevices(); err != nil {
+		return err
+	}
 	gpus, err := getPassthroughGPUS()
 	if err != nil {
 		// ignore getPassthroughGPUS error on old machines without VGA devices
@@ -813,6 +816,7 @@ func getQemuParams(man *IsolatedDeviceManager, devAddrs []string) *QemuParams {
 	devCmds := []string{}
 	cpuCmd := DEFAULT_CPU_CMD
 	vgaCmd := DEFAULT_VGA_CMD
+	vfOnly := true
 	for idx, addr := range devAddrs {
 		dev := man.GetDeviceByAddr(addr)
 		if dev == nil {
@@ -820,6 +824,10 @@ func getQemuParams(man *IsolatedDeviceManager, devAddrs []string) *QemuParams {
 			continue
 		}
 		devCmds = append(devCmds, GetDeviceCmd(dev, idx))
+		if dev.GetDeviceType() == api.NIC_VF_TYPE {
+			continue
+		}
+		vfOnly = false
 		if dev.GetVGACmd() != vgaCmd && dev.GetDeviceType() == api.GPU_VGA_TYPE {
 			vgaCmd = dev.GetVGACmd()
 		}
@@ -827,6 +835,10 @@ func getQemuParams(man *IsolatedDeviceManager, devAddrs []string) *QemuParams {
 			cpuCmd = dev.GetCPUCmd()
 		}
 	}
+	if vfOnly {
+		// SR-IOV nics only, keep guest cpu and vga settings
+		cpuCmd, vgaCmd = "", ""
+	}
 	return &QemuParams{
 		Cpu:     cpuCmd,
 		Vga:     vgaCmd,
@@ -0,0 +1,299 @@
+// Copyright 2019 Yunion
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package isolated_device
+
+import (
+	"fmt"
+	"os"
+	"path"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+
+	"yunion.io/x/jsonutils"
+	"yunion.io/x/log"
+	"yunion.io/x/pkg/errors"
+
+	api "yunion.io/x/onecloud/pkg/apis/compute"
+	o "yunion.io/x/onecloud/pkg/hostman/options"
+	"yunion.io/x/onecloud/pkg/mcclient"
+	"yunion.io/x/onecloud/pkg/mcclient/modules"
+	"yunion.io/x/onecloud/pkg/util/fileutils2"
+	"yunion.io/x/onecloud/pkg/util/procutils"
+)
+
+var (
+	sysfsClassNetPath = "/sys/class/net"
+	sysfsPCIDevPath   = "/sys/bus/pci/devices"
+)
+
+type sSRIOVNicConf struct {
+	PfName string
+	NumVfs int
+	Wire   string
+}
+
+// parseSRIOVNicConf parse sriov nic option of `<ifname>/<num_vfs>[/<wire>]` format
+func parseSRIOVNicConf(conf string) (*sSRIOVNicConf, error) {
+	parts := strings.Split(conf, "/")
+	if len(parts) < 2 || len(parts) > 3 || len(parts[0]) == 0 {
+		return nil, fmt.Errorf("invalid sriov nic config %q", conf)
+	}
+	numVfs, err := strconv.Atoi(parts[1])
+	if err != nil || numVfs <= 0 {
+		return nil, fmt.Errorf("invalid sriov nic config %q: num_vfs must be positive integer", conf)
+	}
+	ret := &sSRIOVNicConf{
+		PfName: parts[0],
+		NumVfs: numVfs,
+	}
+	if len(parts) == 3 {
+		ret.Wire = parts[2]
+	}
+	return ret, nil
+}
+
+func getSRIOVDevicePath(pfName string, name string) string {
+	return path.Join(sysfsClassNetPath, pfName, "device", name)
+}
+
+func readSysfsInt(fpath string) (int, error) {
+	content, err := fileutils2.FileGetContents(fpath)
+	if err != nil {
+		return 0, err
+	}
+	return strconv.Atoi(strings.TrimSpace(content))
+}
+
+func getSRIOVTotalVfs(pfName string) (int, error) {
+	return readSysfsInt(getSRIOVDevicePath(pfName, "sriov_totalvfs"))
+}
+
+func getSRIOVNumVfs(pfName string) (int, error) {
+	return readSysfsInt(getSRIOVDevicePath(pfName, "sriov_numvfs"))
+}
+
+// setSRIOVNumVfs creates virtual functions of physical nic,
+// kernel requires resetting sriov_numvfs to 0 before changing it
+func setSRIOVNumVfs(pfName string, numVfs int) error {
+	cur, err := getSRIOVNumVfs(pfName)
+	if err != nil {
+		return errors.Wrap(err, "getSRIOVNumVfs")
+	}
+	if cur == numVfs {
+		return nil
+	}
+	fpath := getSRIOVDevicePath(pfName, "sriov_numvfs")
+	if cur > 0 {
+		if err := fileutils2.FilePutContents(fpath, "0", false); err != nil {
+			return errors.Wrap(err, "reset sriov_numvfs")
+		}
+	}
+	return fileutils2.FilePutContents(fpath, strconv.Itoa(numVfs), false)
+}
+
+type sSRIOVVirtfn struct {
+	Index int
+	// pci address of `Bus:Device.Function` format
+	Addr string
+}
+
+// getSRIOVVirtfns lists virtual functions of physical nic by virtfn* links
+// e.g. /sys/class/net/eth1/device/virtfn0 -> ../0000:3b:02.0
+func getSRIOVVirtfns(pfName string) ([]sSRIOVVirtfn, error) {
+	links, err := filepath.Glob(getSRIOVDevicePath(pfName, "virtfn*"))
+	if err != nil {
+		return nil, err
+	}
+	vfs := make([]sSRIOVVirtfn, 0, len(links))
+	for _, link := range links {
+		idx, err := strconv.Atoi(strings.TrimPrefix(path.Base(link), "virtfn"))
+		if err != nil {
+			continue
+		}
+		dest, err := os.Readlink(link)
+		if err != nil {
+			return nil, errors.Wrapf(err, "readlink %s", link)
+		}
+		addr := path.Base(dest)
+		if len(addr) == 12 {
+			// strip pci domain 0000:
+			addr = addr[5:]
+		}
+		vfs = append(vfs, sSRIOVVirtfn{Index: idx, Addr: addr})
+	}
+	sort.Slice(vfs, func(i, j int) bool { return vfs[i].Index < vfs[j].Index })
+	return vfs, nil
+}
+
+func (man *IsolatedDeviceManager) fillSRIOVNicDevices() error {
+	for _, opt := range o.HostOptions.SRIOVNics {
+		conf, err := parseSRIOVNicConf(opt)
+		if err != nil {
+			return err
+		}
+		devs, err := detectSRIOVNicDevices(man.host, conf)
+		if err != nil {
+			return errors.Wrapf(err, "detect sriov nic %s", conf.PfName)
+		}
+		for _, dev := range devs {
+			man.Devices = append(man.Devices, dev)
+			log.Infof("Add SR-IOV nic device: %s vf %d => %s", dev.pfName, dev.vfIndex, dev.GetAddr())
+		}
+	}
+	return nil
+}
+
+func detectSRIOVNicDevices(host IHost, conf *sSRIOVNicConf) ([]*sSRIOVNicDevice, error) {
+	total, err := getSRIOVTotalVfs(conf.PfName)
+	if err != nil {
+		return nil, errors.Wrap(err, "nic not SR-IOV capable")
+	}
+	if conf.NumVfs > total {
+		return nil, fmt.Errorf("num_vfs %d exceeds sriov_totalvfs %d", conf.NumVfs, total)
+	}
+	if err := setSRIOVNumVfs(conf.PfName, conf.NumVfs); err != nil {
+		return nil, errors.Wrap(err, "setSRIOVNumVfs")
+	}
+	vfs, err := getSRIOVVirtfns(conf.PfName)
+	if err != nil {
+		return nil, errors.Wrap(err, "getSRIOVVirtfns")
+	}
+	devs := make([]*sSRIOVNicDevice, 0, len(vfs))
+	for _, vf := range vfs {
+		pciDev, err := detectPCIDevByAddrWithoutIOMMUGroup(vf.Addr)
+		if err != nil {
+			return nil, errors.Wrapf(err, "detect vf %s", vf.Addr)
+		}
+		if err := bindVFIOPCIDriverOverride(pciDev); err != nil {
+			return nil, errors.Wrapf(err, "bind vf %s to vfio-pci", vf.Addr)
+		}
+		devs = append(devs, NewSRIOVNicDevice(host, pciDev, conf.PfName, vf.Index, conf.Wire))
+	}
+	return devs, nil
+}
+
+// bindVFIOPCIDriverOverride binds single device to vfio-pci,
+// new_id can not be used since all VFs share same vendor device id
+func bindVFIOPCIDriverOverride(d *PCIDevice) error {
+	if d.IsVFIOPCIDriverUsed() {
+		return nil
+	}
+	devPath := path.Join(sysfsPCIDevPath, fmt.Sprintf("0000:%s", d.Addr))
+	if err := fileutils2.FilePutContents(path.Join(devPath, "driver_override"), VFIO_PCI_KERNEL_DRIVER, false); err != nil {
+		return errors.Wrap(err, "driver_override")
+	}
+	if err := d.unbindDriver(); err != nil {
+		return err
+	}
+	return fileutils2.FilePutContents("/sys/bus/pci/drivers_probe", fmt.Sprintf("0000:%s", d.Addr), false)
+}
+
+type sSRIOVNicDevice struct {
+	*sBaseDevice
+
+	host    IHost
+	pfName  string
+	vfIndex int
+	wire    string
+}
+
+func NewSRIOVNicDevice(host IHost, dev *PCIDevice, pfName string, vfIndex int, wire string) *sSRIOVNicDevice {
+	nicDev := &sSRIOVNicDevice{
+		sBaseDevice: newBaseDevice(dev),
+		host:        host,
+		pfName:      pfName,
+		vfIndex:     vfIndex,
+		wire:        wire,
+	}
+	nicDev.devType = api.NIC_VF_TYPE
+	return nicDev
+}
+
+func (dev *sSRIOVNicDevice) GetDeviceType() string {
+	return api.NIC_VF_TYPE
+}
+
+func (dev *sSRIOVNicDevice) GetCPUCmd() string {
+	return ""
+}
+
+func (dev *sSRIOVNicDevice) GetVGACmd() string {
+	return ""
+}
+
+func (dev *sSRIOVNicDevice) CustomProbe() error {
+	if err := procutils.NewRemoteCommandAsFarAsPossible("modprobe", "vfio-pci").Run(); err != nil {
+		return fmt.Errorf("modprobe vfio-pci: %v", err)
+	}
+	if !dev.IsPassthroughAble() {
+		return fmt.Errorf("SR-IOV nic %s vf %d is not bound to %s", dev.pfName, dev.vfIndex, VFIO_PCI_KERNEL_DRIVER)
+	}
+	return nil
+}
+
+func (dev *sSRIOVNicDevice) getWireId() string {
+	if len(dev.wire) > 0 {
+		return dev.wire
+	}
+	return dev.host.GetWireIdOfInterface(dev.pfName)
+}
+
+func (dev *sSRIOVNicDevice) SyncDeviceInfo(session *mcclient.ClientSession, hostId string) error {
+	if len(dev.hostId) == 0 {
+		dev.hostId = hostId
+	}
+	wireId := dev.getWireId()
+	if len(wireId) == 0 {
+		return fmt.Errorf("not found wire of SR-IOV nic %s, specify it by sriov_nics option", dev.pfName)
+	}
+	data := dev.GetApiResourceData().(*jsonutils.JSONDict)
+	data.Set("wire_id", jsonutils.NewString(wireId))
+	if len(dev.GetCloudId()) != 0 {
+		log.Infof("Update %s isolated_device: %s", dev.GetCloudId(), data.String())
+		_, err := modules.IsolatedDevices.Update(session, dev.GetCloudId(), data)
+		return err
+	}
+	log.Infof("Create new isolated_device: %s", data.String())
+	ret, err := modules.IsolatedDevices.Create(session, data)
+	if err != nil {
+		return err
+	}
+	dev.cloudId, _ = ret.GetString("id")
+	return nil
+}
+
+// GetSetupCmd returns command configuring mac and vlan of virtual function on physical nic
+func (dev *sSRIOVNicDevice) GetSetupCmd(mac string, vlan int) string {
+	if vlan <= 1 {
+		// vlan 1 means untagged
+		vlan = 0
+	}
+	return fmt.Sprintf("ip link set dev %s vf %d mac %s vlan %d spoofchk on\n", dev.pfName, dev.vfIndex, mac, vlan)
+}
+
+// GetSRIOVNicSetupCmd returns setup command of SR-IOV nic device of addr
+func (man *IsolatedDeviceManager) GetSRIOVNicSetupCmd(addr string, mac string, vlan int) (string, error) {
+	dev := man.GetDeviceByAddr(addr)
+	if dev == nil {
+		return "", errors.Wrapf(errors.ErrNotFound, "isolated device %s", addr)
+	}
+	nicDev, ok := dev.(*sSRIOVNicDevice)
+	if !ok {
+		return "", fmt.Errorf("isolated device %s is not SR-IOV nic", addr)
+	}
+	return nicDev.GetSetupCmd(mac, vlan), nil
+}
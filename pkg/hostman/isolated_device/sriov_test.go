@@ -0,0 +1,128 @@
+// Copyright 2019 Yunion
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package isolated_device
+
+import (
+	"io/ioutil"
+	"os"
+	"path"
+	"reflect"
+	"testing"
+)
+
+func Test_parseSRIOVNicConf(t *testing.T) {
+	tests := []struct {
+		conf    string
+		want    *sSRIOVNicConf
+		wantErr bool
+	}{
+		{
+			conf: "eth1/8",
+			want: &sSRIOVNicConf{PfName: "eth1", NumVfs: 8},
+		},
+		{
+			conf: "eth1/4/wire-sriov",
+			want: &sSRIOVNicConf{PfName: "eth1", NumVfs: 4, Wire: "wire-sriov"},
+		},
+		{
+			conf:    "eth1",
+			wantErr: true,
+		},
+		{
+			conf:    "eth1/0",
+			wantErr: true,
+		},
+		{
+			conf:    "/8",
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		got, err := parseSRIOVNicConf(tt.conf)
+		if (err != nil) != tt.wantErr {
+			t.Errorf("parseSRIOVNicConf(%q) error = %v, wantErr %v", tt.conf, err, tt.wantErr)
+			continue
+		}
+		if !reflect.DeepEqual(got, tt.want) {
+			t.Errorf("parseSRIOVNicConf(%q) = %#v, want %#v", tt.conf, got, tt.want)
+		}
+	}
+}
+
+func Test_sriovSysfs(t *testing.T) {
+	root, err := ioutil.TempDir("", "sriov")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(root)
+	defer func(p string) { sysfsClassNetPath = p }(sysfsClassNetPath)
+	sysfsClassNetPath = root
+
+	devDir := path.Join(root, "eth1", "device")
+	if err := os.MkdirAll(devDir, 0755); err != nil {
+		t.Fatal(err)
+	}
+	for name, content := range map[string]string{
+		"sriov_totalvfs": "16\n",
+		"sriov_numvfs":   "0\n",
+	} {
+		if err := ioutil.WriteFile(path.Join(devDir, name), []byte(content), 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+	for name, dest := range map[string]string{
+		"virtfn10": "../0000:3b:03.2",
+		"virtfn2":  "../0000:3b:02.2",
+		"virtfn0":  "../0000:3b:02.0",
+	} {
+		if err := os.Symlink(dest, path.Join(devDir, name)); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	total, err := getSRIOVTotalVfs("eth1")
+	if err != nil || total != 16 {
+		t.Fatalf("getSRIOVTotalVfs = %d, %v", total, err)
+	}
+	if err := setSRIOVNumVfs("eth1", 8); err != nil {
+		t.Fatalf("setSRIOVNumVfs: %v", err)
+	}
+	if num, _ := getSRIOVNumVfs("eth1"); num != 8 {
+		t.Errorf("getSRIOVNumVfs = %d, want 8", num)
+	}
+
+	vfs, err := getSRIOVVirtfns("eth1")
+	if err != nil {
+		t.Fatalf("getSRIOVVirtfns: %v", err)
+	}
+	want := []sSRIOVVirtfn{
+		{Index: 0, Addr: "3b:02.0"},
+		{Index: 2, Addr: "3b:02.2"},
+		{Index: 10, Addr: "3b:03.2"},
+	}
+	if !reflect.DeepEqual(vfs, want) {
+		t.Errorf("getSRIOVVirtfns = %#v, want %#v", vfs, want)
+	}
+}
+
+func Test_sSRIOVNicDevice_GetSetupCmd(t *testing.T) {
+	dev := NewSRIOVNicDevice(nil, &PCIDevice{Addr: "3b:02.1"}, "eth1", 1, "")
+	if got, want := dev.GetSetupCmd("00:22:33:44:55:66", 1), "ip link set dev eth1 vf 1 mac 00:22:33:44:55:66 vlan 0 spoofchk on\n"; got != want {
+		t.Errorf("GetSetupCmd = %q, want %q", got, want)
+	}
+	if got, want := dev.GetSetupCmd("00:22:33:44:55:66", 100), "ip link set dev eth1 vf 1 mac 00:22:33:44:55:66 vlan 100 spoofchk on\n"; got != want {
+		t.Errorf("GetSetupCmd = %q, want %q", got, want)
+	}
+}
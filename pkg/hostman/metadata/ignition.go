@@ -0,0 +1,127 @@
+// Copyright 2019 Yunion
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package metadata
+
+import (
+	"encoding/base64"
+	"strings"
+
+	"yunion.io/x/jsonutils"
+	"yunion.io/x/log"
+	"yunion.io/x/pkg/errors"
+	"yunion.io/x/pkg/utils"
+
+	"yunion.io/x/onecloud/pkg/cloudcommon/types"
+	"yunion.io/x/onecloud/pkg/util/ignition"
+	"yunion.io/x/onecloud/pkg/util/seclib2"
+)
+
+// ignitionVariant tells whether the guest boots with Ignition, by the os
+// distribution detected on its image or by an Ignition/Butane user data
+func ignitionVariant(guestDesc jsonutils.JSONObject) string {
+	osDist, _ := guestDesc.GetString("metadata", "os_distribution")
+	osDist = strings.ToLower(osDist)
+	switch {
+	case strings.Contains(osDist, "flatcar"):
+		return ignition.VARIANT_FLATCAR
+	case strings.Contains(osDist, "fedora coreos"), strings.Contains(osDist, "fcos"):
+		return ignition.VARIANT_FCOS
+	}
+	userData := getIgnitionUserData(guestDesc)
+	if len(userData) > 0 {
+		if conf, err := ignition.ParseUserConfig(userData); err == nil {
+			if len(conf.Variant) > 0 {
+				return conf.Variant
+			}
+			return ignition.VARIANT_FCOS
+		}
+	}
+	return ""
+}
+
+// getIgnitionUserData returns user data in plain text, it may come either
+// decoded or base64 encoded in the guest desc
+func getIgnitionUserData(guestDesc jsonutils.JSONObject) string {
+	userData, _ := guestDesc.GetString("user_data")
+	if decoded, err := base64.StdEncoding.DecodeString(userData); err == nil {
+		return string(decoded)
+	}
+	return userData
+}
+
+// ignitionPasswordHash recovers the password generated at deploy time, it is
+// only stored recoverable by the guest id when no keypair is bound
+func ignitionPasswordHash(guestDesc jsonutils.JSONObject) string {
+	if guestDesc.Contains("pubkey") {
+		return ""
+	}
+	loginKey, _ := guestDesc.GetString("metadata", "login_key")
+	if len(loginKey) == 0 {
+		return ""
+	}
+	guestId, _ := guestDesc.GetString("uuid")
+	passwd, err := utils.DescryptAESBase64(guestId, loginKey)
+	if err != nil || len(passwd) == 0 {
+		return ""
+	}
+	hash, err := seclib2.BcryptPassword(passwd)
+	if err != nil {
+		log.Errorf("bcrypt password of %s: %v", guestId, err)
+		return ""
+	}
+	return hash
+}
+
+func getIgnitionConfig(guestDesc jsonutils.JSONObject, variant string) (*ignition.SConfig, error) {
+	conf := ignition.NewConfig()
+
+	name, _ := guestDesc.GetString("name")
+	domain, _ := guestDesc.GetString("domain")
+	if len(name) > 0 {
+		conf.SetHostname(name)
+	}
+
+	account, _ := guestDesc.GetString("metadata", "login_account")
+	if len(account) == 0 || account == "root" {
+		account = ignition.DEFAULT_USER
+	}
+	pubkeys := []string{}
+	if pubkey, _ := guestDesc.GetString("pubkey"); len(pubkey) > 0 {
+		pubkeys = append(pubkeys, strings.TrimSpace(pubkey))
+	}
+	var groups []string
+	if account != ignition.DEFAULT_USER {
+		groups = []string{"wheel"}
+	}
+	conf.AddUser(account, ignitionPasswordHash(guestDesc), pubkeys, groups)
+
+	nics := []*types.SServerNic{}
+	if nicsDesc, _ := guestDesc.Get("nics"); nicsDesc != nil {
+		if err := nicsDesc.Unmarshal(&nics); err != nil {
+			return nil, errors.Wrap(err, "unmarshal nics")
+		}
+	}
+	conf.AddNetworks(variant, nics, domain)
+
+	userData := getIgnitionUserData(guestDesc)
+	if len(userData) > 0 && ignition.IsUserConfig(userData) {
+		userConf, err := ignition.ParseUserConfig(userData)
+		if err != nil {
+			return nil, errors.Wrap(err, "parse user data")
+		}
+		conf.MergeConfig(userConf.Config)
+	}
+	return conf, nil
+}
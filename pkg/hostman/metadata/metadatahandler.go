@@ -80,6 +80,9 @@ func (s *Service) addHandler(app *appsrv.Application) {
 			prefix, `(latest|\d{4}-\d{2}-\d{2})`), s.userData)
 		app.AddHandler(method, fmt.Sprintf("%s/<version:%s>/meta-data",
 			prefix, `(latest|\d{4}-\d{2}-\d{2})`), s.metaData)
+		// path fetched by ignition on the openstack platform
+		app.AddHandler(method, fmt.Sprintf("%s/openstack/<version:%s>/user_data",
+			prefix, `(latest|\d{4}-\d{2}-\d{2})`), s.userData)
 	}
 }
 
@@ -94,6 +97,18 @@ func (s *Service) userData(ctx context.Context, w http.ResponseWriter, r *http.R
 		return
 	}
 
+	if variant := ignitionVariant(guestDesc); len(variant) > 0 {
+		conf, err := getIgnitionConfig(guestDesc, variant)
+		if err != nil {
+			guestId, _ := guestDesc.GetString("uuid")
+			log.Errorf("Generate ignition config of %s: %v", guestId, err)
+			hostutils.Response(ctx, w, httperrors.NewInputParameterError("invalid ignition user data: %v", err))
+			return
+		}
+		hostutils.Response(ctx, w, conf.String())
+		return
+	}
+
 	if !guestDesc.Contains("user_data") {
 		hostutils.Response(ctx, w, "")
 		return
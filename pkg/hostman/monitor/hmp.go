@@ -384,6 +384,10 @@ func (m *HmpMonitor) ReloadDiskBlkdev(device, path string, callback StringCallba
 	m.Query(fmt.Sprintf("reload_disk_snapshot_blkdev -n %s %s", device, path), callback)
 }
 
+func (m *HmpMonitor) ReloadEncryptedDiskBlkdev(device, path, secretId string, callback StringCallback) {
+	callback("Reload encrypted disk blkdev by hmp is not supported")
+}
+
 func (m *HmpMonitor) DriveMirror(callback StringCallback, drive, target, syncMode string, unmap, blockReplication bool) {
 	cmd := "drive_mirror -n"
 	if blockReplication {
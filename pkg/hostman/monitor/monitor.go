@@ -67,6 +67,7 @@ type Monitor interface {
 	GetMigrateStatus(callback StringCallback)
 
 	ReloadDiskBlkdev(device, path string, callback StringCallback)
+	ReloadEncryptedDiskBlkdev(device, path, secretId string, callback StringCallback)
 	SetVncPassword(proto, password string, callback StringCallback)
 	StartNbdServer(port int, exportAllDevice, writable bool, callback StringCallback)
 
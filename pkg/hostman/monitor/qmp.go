@@ -692,6 +692,47 @@ func (m *QmpMonitor) ReloadDiskBlkdev(device, path string, callback StringCallba
 	m.Query(cmd, cb)
 }
 
+// ReloadEncryptedDiskBlkdev opens the overlay at path by the secret object
+// secretId and puts it on top of the device, the qemu node of the device
+// becomes the backing of the overlay
+func (m *QmpMonitor) ReloadEncryptedDiskBlkdev(device, path, secretId string, callback StringCallback) {
+	var (
+		nodeName = fmt.Sprintf("%s-snap%d", device, time.Now().Unix())
+		addCmd   = &Command{
+			Execute: "blockdev-add",
+			Args: map[string]interface{}{
+				"driver":    "qcow2",
+				"node-name": nodeName,
+				"file": map[string]string{
+					"driver":   "file",
+					"filename": path,
+				},
+				"encrypt": map[string]string{
+					"format":     "luks",
+					"key-secret": secretId,
+				},
+				"backing": nil,
+			},
+		}
+		snapshotCmd = &Command{
+			Execute: "blockdev-snapshot",
+			Args: map[string]string{
+				"node":    device,
+				"overlay": nodeName,
+			},
+		}
+	)
+	m.Query(addCmd, func(res *Response) {
+		if err := m.actionResult(res); len(err) > 0 {
+			callback(err)
+			return
+		}
+		m.Query(snapshotCmd, func(res *Response) {
+			callback(m.actionResult(res))
+		})
+	})
+}
+
 func (m *QmpMonitor) DriveMirror(callback StringCallback, drive, target, syncMode string, unmap, blockReplication bool) {
 	var (
 		cb = func(res *Response) {
@@ -35,6 +35,13 @@ type SHostOptions struct {
 	ServersPath    string `help:"Path for virtual server configuration files" default:"/opt/cloud/workspace/servers"`
 	ImageCachePath string `help:"Path for storing image caches" default:"/opt/cloud/workspace/disks/image_cache"`
 	// ImageCacheLimit int    `help:"Maximal storage space for image caching, in GB" default:"20"`
+	EnableP2pImageDistribution bool `help:"Fetch images from hosts in the same zone via bittorrent before downloading from image service" default:"true"`
+	P2pListenPort              int  `help:"Listen port of bittorrent client for p2p image distribution" default:"6881"`
+	P2pZonePeersOnly           bool `help:"Only exchange image data with hosts in the same zone and image service" default:"true"`
+	P2pStallTimeoutSeconds     int  `help:"Fall back to image service if p2p transfer makes no progress in seconds" default:"60"`
+	ImageUploadRateLimit       int  `help:"Upload rate limit of image cache to peer hosts in MB/sec, 0 means unlimited" default:"0"`
+	ImageDownloadRateLimit     int  `help:"Download rate limit of image cache from peer hosts and image service in MB/sec, 0 means unlimited" default:"0"`
+
 	AgentTempPath  string `help:"Path for ESXi agent"`
 	AgentTempLimit int    `help:"Maximal storage space for ESXi agent, in GB" default:"10"`
 
@@ -79,6 +86,7 @@ type SHostOptions struct {
 	CheckSystemServices bool `help:"Check system services (ntpd, telegraf) on startup" default:"true"`
 
 	DhcpServerPort int    `help:"Host dhcp server bind port" default:"67"`
+	EnableDhcp6    bool   `help:"Serve router advertisement and dhcpv6 to guests of ipv6 networks" default:"false"`
 	DiskIsSsd      bool   `default:"false"`
 	FetcherfsPath  string `default:"/opt/yunion/fetchclient/bin/fetcherfs" help:"Fuse fetcherfs path"`
 
@@ -91,6 +99,8 @@ type SHostOptions struct {
 	SetVncPassword         bool `default:"true" help:"Auto set vnc password after monitor connected"`
 	UseBootVga             bool `default:"false" help:"Use boot VGA GPU for guest"`
 
+	SRIOVNics []string `help:"SR-IOV capable physical NICs to create virtual functions, in format of <ifname>/<num_vfs>[/<wire>], e.g. eth1/8"`
+
 	EnableCpuBinding         bool `default:"true" help:"Enable cpu binding and rebalance"`
 	EnableOpenflowController bool `default:"false"`
 
@@ -31,6 +31,7 @@ import (
 	"yunion.io/x/onecloud/pkg/hostman/hostutils"
 	"yunion.io/x/onecloud/pkg/hostman/hostutils/kubelet"
 	"yunion.io/x/onecloud/pkg/hostman/options"
+	"yunion.io/x/onecloud/pkg/hostman/storageman/p2p"
 	"yunion.io/x/onecloud/pkg/hostman/storageman/storageutils"
 	"yunion.io/x/onecloud/pkg/mcclient"
 	"yunion.io/x/onecloud/pkg/util/fileutils2"
@@ -299,13 +300,15 @@ func Init(host hostutils.IHost) error {
 	// lm := lockman.NewNoopLockManager()
 	lockman.Init(lm)
 
+	p2p.Init()
+
 	var err error
 	storageManager, err = NewStorageManager(host)
 	return err
 }
 
 func Stop() {
-	// pass do nothing
+	p2p.Stop()
 }
 
 func cleanDailyFiles(storagePath, subDir string, keepDay int) {
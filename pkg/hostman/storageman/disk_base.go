@@ -26,6 +26,8 @@ import (
 
 	deployapi "yunion.io/x/onecloud/pkg/hostman/hostdeployer/apis"
 	"yunion.io/x/onecloud/pkg/hostman/hostdeployer/deployclient"
+	"yunion.io/x/onecloud/pkg/hostman/hostutils"
+	"yunion.io/x/onecloud/pkg/util/qemuimg"
 )
 
 type IDisk interface {
@@ -51,7 +53,7 @@ type IDisk interface {
 
 	PrepareMigrate(liveMigrate bool) (string, error)
 	CreateFromUrl(ctx context.Context, url string, size int64) error
-	CreateFromTemplate(context.Context, string, string, int64) (jsonutils.JSONObject, error)
+	CreateFromTemplate(ctx context.Context, imageId string, format string, size int64, encryption bool) (jsonutils.JSONObject, error)
 	CreateFromSnapshotLocation(ctx context.Context, location string, size int64) error
 	CreateFromRbdSnapshot(ctx context.Context, snapshotId, srcDiskId, srcPool string) error
 	CreateFromImageFuse(ctx context.Context, url string, size int64) error
@@ -100,7 +102,7 @@ func (d *SBaseDisk) CreateFromUrl(ctx context.Context, url string, size int64) e
 	return fmt.Errorf("Not implemented")
 }
 
-func (d *SBaseDisk) CreateFromTemplate(context.Context, string, string, int64) (jsonutils.JSONObject, error) {
+func (d *SBaseDisk) CreateFromTemplate(context.Context, string, string, int64, bool) (jsonutils.JSONObject, error) {
 	return nil, fmt.Errorf("Not implemented")
 }
 
@@ -122,11 +124,16 @@ func (d *SBaseDisk) DeployGuestFs(diskPath string, guestDesc *jsonutils.JSONDict
 	if err != nil {
 		return nil, errors.Wrap(err, "guest desc to deploy desc")
 	}
+	encryptKey, err := d.getEncryptKey(diskPath)
+	if err != nil {
+		return nil, errors.Wrap(err, "get encrypt key")
+	}
 	ret, err := deployclient.GetDeployClient().DeployGuestFs(
 		context.Background(), &deployapi.DeployParams{
 			DiskPath:   diskPath,
 			GuestDesc:  deployGuestDesc,
 			DeployInfo: deployInfo,
+			EncryptKey: encryptKey,
 		},
 	)
 	if err != nil {
@@ -135,9 +142,47 @@ func (d *SBaseDisk) DeployGuestFs(diskPath string, guestDesc *jsonutils.JSONDict
 	return jsonutils.Marshal(ret), nil
 }
 
+func (d *SBaseDisk) setEncryptKey(ctx context.Context, img *qemuimg.SQemuImage) error {
+	info, err := hostutils.GetDiskEncryptInfo(ctx, d.Id)
+	if err != nil {
+		return errors.Wrap(err, "GetDiskEncryptInfo")
+	}
+	img.SetEncryptKey(info.Key)
+	return nil
+}
+
+// openImage opens the disk image, the key of an encrypted image is fetched
+// from region so that the image can be resized or converted
+func (d *SBaseDisk) openImage(ctx context.Context, path string) (*qemuimg.SQemuImage, error) {
+	img, err := qemuimg.NewQemuImage(path)
+	if err != nil {
+		return nil, err
+	}
+	if img.Encryption {
+		if err := d.setEncryptKey(ctx, img); err != nil {
+			return nil, err
+		}
+	}
+	return img, nil
+}
+
+// getEncryptKey returns the data key of the disk when the image at diskPath
+// is encrypted, the deployer opens the image through qemu by the key
+func (d *SBaseDisk) getEncryptKey(diskPath string) (string, error) {
+	img, err := d.openImage(context.Background(), diskPath)
+	if err != nil {
+		return "", errors.Wrapf(err, "open image %s", diskPath)
+	}
+	return img.EncryptKey, nil
+}
+
 func (d *SBaseDisk) ResizeFs(diskPath string) error {
-	_, err := deployclient.GetDeployClient().ResizeFs(
-		context.Background(), &deployapi.ResizeFsParams{DiskPath: diskPath})
+	encryptKey, err := d.getEncryptKey(diskPath)
+	if err != nil {
+		return errors.Wrap(err, "get encrypt key")
+	}
+	_, err = deployclient.GetDeployClient().ResizeFs(
+		context.Background(), &deployapi.ResizeFsParams{DiskPath: diskPath, EncryptKey: encryptKey})
 	return err
 }
 
@@ -151,12 +196,18 @@ func (d *SBaseDisk) GetSnapshotLocation() string {
 
 func (d *SBaseDisk) FormatFs(fsFormat, uuid, diskPath string) {
 	log.Infof("Make disk %s fs %s", uuid, fsFormat)
-	_, err := deployclient.GetDeployClient().FormatFs(
+	encryptKey, err := d.getEncryptKey(diskPath)
+	if err != nil {
+		log.Errorf("Format fs get encrypt key error: %s", err)
+		return
+	}
+	_, err = deployclient.GetDeployClient().FormatFs(
 		context.Background(),
 		&deployapi.FormatFsParams{
-			DiskPath: diskPath,
-			FsFormat: fsFormat,
-			Uuid:     uuid,
+			DiskPath:   diskPath,
+			FsFormat:   fsFormat,
+			Uuid:       uuid,
+			EncryptKey: encryptKey,
 		},
 	)
 	if err != nil {
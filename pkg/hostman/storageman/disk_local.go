@@ -143,7 +143,7 @@ func (d *SLocalDisk) Resize(ctx context.Context, params interface{}) (jsonutils.
 	}
 
 	sizeMb, _ := diskInfo.Int("size")
-	disk, err := qemuimg.NewQemuImage(d.GetPath())
+	disk, err := d.openImage(ctx, d.GetPath())
 	if err != nil {
 		log.Errorf("qemuimg.NewQemuImage %s fail: %s", d.GetPath(), err)
 		return nil, err
@@ -190,6 +190,11 @@ func (d *SLocalDisk) CreateFromImageFuse(ctx context.Context, url string, size i
 		}
 	}
 	if !newImg.IsValid() {
+		newImg, err = d.newImageOnBacking(ctx, d.getPath(), contentPath)
+		if err != nil {
+			log.Errorln(err)
+			return err
+		}
 		if err := newImg.CreateQcow2(0, false, contentPath); err != nil {
 			log.Errorln(err)
 			return err
@@ -199,9 +204,9 @@ func (d *SLocalDisk) CreateFromImageFuse(ctx context.Context, url string, size i
 	return nil
 }
 
-func (d *SLocalDisk) CreateFromTemplate(ctx context.Context, imageId, format string, size int64) (jsonutils.JSONObject, error) {
+func (d *SLocalDisk) CreateFromTemplate(ctx context.Context, imageId, format string, size int64, encryption bool) (jsonutils.JSONObject, error) {
 	var imageCacheManager = storageManager.LocalStorageImagecacheManager
-	ret, err := d.createFromTemplate(ctx, imageId, format, imageCacheManager)
+	ret, err := d.createFromTemplate(ctx, imageId, format, imageCacheManager, encryption)
 	if err != nil {
 		return nil, err
 	}
@@ -216,7 +221,7 @@ func (d *SLocalDisk) CreateFromTemplate(ctx context.Context, imageId, format str
 }
 
 func (d *SLocalDisk) createFromTemplate(
-	ctx context.Context, imageId, format string, imageCacheManager IImageCacheManger,
+	ctx context.Context, imageId, format string, imageCacheManager IImageCacheManger, encryption bool,
 ) (jsonutils.JSONObject, error) {
 	imageCache := imageCacheManager.AcquireImage(ctx, imageId, d.GetZoneName(), "", "", "")
 	if imageCache != nil {
@@ -236,6 +241,14 @@ func (d *SLocalDisk) createFromTemplate(
 			log.Errorln(err)
 			return nil, err
 		}
+		if encryption {
+			// the template stays shared and plain, blocks written by
+			// deploy and the guest go to the encrypted overlay
+			if err := d.setEncryptKey(ctx, newImg); err != nil {
+				log.Errorln(err)
+				return nil, err
+			}
+		}
 		if err := newImg.CreateQcow2(0, false, cacheImagePath); err != nil {
 			log.Errorln(err)
 			return nil, fmt.Errorf("Fail to create disk %s", d.Id)
@@ -272,6 +285,14 @@ func (d *SLocalDisk) CreateRaw(ctx context.Context, sizeMB int, diskFormat, fsFo
 		return nil, err
 	}
 
+	if encryption {
+		if err := d.setEncryptKey(ctx, img); err != nil {
+			return nil, err
+		}
+		// luks encryption is only supported by qcow2
+		diskFormat = "qcow2"
+	}
+
 	switch diskFormat {
 	case "qcow2":
 		err = img.CreateQcow2(sizeMB, false, back)
@@ -297,6 +318,25 @@ func (d *SLocalDisk) CreateRaw(ctx context.Context, sizeMB int, diskFormat, fsFo
 	return d.GetDiskDesc(), nil
 }
 
+// newImageOnBacking prepares a new image on top of backPath, an overlay of
+// an encrypted backing file is encrypted by the same key
+func (d *SLocalDisk) newImageOnBacking(ctx context.Context, path, backPath string) (*qemuimg.SQemuImage, error) {
+	img, err := qemuimg.NewQemuImage(path)
+	if err != nil {
+		return nil, err
+	}
+	back, err := qemuimg.NewQemuImage(backPath)
+	if err != nil {
+		return nil, err
+	}
+	if back.Encryption {
+		if err := d.setEncryptKey(ctx, img); err != nil {
+			return nil, err
+		}
+	}
+	return img, nil
+}
+
 func (d *SLocalDisk) GetDiskDesc() jsonutils.JSONObject {
 	qemuImg, err := qemuimg.NewQemuImage(d.getPath())
 	if err != nil {
@@ -355,7 +395,7 @@ func (d *SLocalDisk) CreateSnapshot(snapshotId string) error {
 		log.Errorf("mv %s to %s failed %s", d.getPath(), snapshotPath, output)
 		return errors.Wrapf(err, "mv %s to %s failed %s", d.getPath(), snapshotPath, output)
 	}
-	img, err := qemuimg.NewQemuImage(d.getPath())
+	img, err := d.newImageOnBacking(context.Background(), d.getPath(), snapshotPath)
 	if err != nil {
 		log.Errorln(err)
 		procutils.NewCommand("mv", "-f", snapshotPath, d.getPath()).Run()
@@ -384,7 +424,7 @@ func (d *SLocalDisk) DeleteSnapshot(snapshotId, convertSnapshot string, pendingD
 		if fileutils2.Exists(output) {
 			procutils.NewCommand("rm", "-f", output).Run()
 		}
-		img, err := qemuimg.NewQemuImage(convertSnapshotPath)
+		img, err := d.openImage(context.Background(), convertSnapshotPath)
 		if err != nil {
 			log.Errorln(err)
 			return err
@@ -434,7 +474,18 @@ func (d *SLocalDisk) PrepareSaveToGlance(ctx context.Context, params interface{}
 		return nil, err
 	}
 	backupPath := path.Join(destDir, fmt.Sprintf("%s.%s", d.Id, appctx.AppContextTaskId(ctx)))
-	if err := procutils.NewCommand("cp", "--sparse=always", "-f", d.GetPath(), backupPath).Run(); err != nil {
+	img, err := d.openImage(ctx, d.GetPath())
+	if err != nil {
+		return nil, err
+	}
+	if img.Encryption {
+		// images in glance are never encrypted
+		if err := img.DecryptTo(backupPath, qemuimg.QCOW2); err != nil {
+			log.Errorln(err)
+			procutils.NewCommand("rm", "-f", backupPath).Run()
+			return nil, err
+		}
+	} else if err := procutils.NewCommand("cp", "--sparse=always", "-f", d.GetPath(), backupPath).Run(); err != nil {
 		log.Errorln(err)
 		procutils.NewCommand("rm", "-f", backupPath).Run()
 		return nil, err
@@ -457,17 +508,17 @@ func (d *SLocalDisk) ResetFromSnapshot(ctx context.Context, params interface{})
 
 	snapshotDir := d.GetSnapshotDir()
 	snapshotPath := path.Join(snapshotDir, resetParams.SnapshotId)
-	return d.resetFromSnapshot(snapshotPath, outOfChain)
+	return d.resetFromSnapshot(ctx, snapshotPath, outOfChain)
 }
 
-func (d *SLocalDisk) resetFromSnapshot(snapshotPath string, outOfChain bool) (jsonutils.JSONObject, error) {
+func (d *SLocalDisk) resetFromSnapshot(ctx context.Context, snapshotPath string, outOfChain bool) (jsonutils.JSONObject, error) {
 	diskTmpPath := d.GetPath() + "_reset.tmp"
 	if output, err := procutils.NewCommand("mv", "-f", d.GetPath(), diskTmpPath).Output(); err != nil {
 		err = errors.Wrapf(err, "mv disk to tmp failed: %s", output)
 		return nil, err
 	}
 	if !outOfChain {
-		img, err := qemuimg.NewQemuImage(d.GetPath())
+		img, err := d.newImageOnBacking(ctx, d.GetPath(), snapshotPath)
 		if err != nil {
 			err = errors.Wrap(err, "new qemu img")
 			procutils.NewCommand("mv", "-f", diskTmpPath, d.GetPath()).Run()
@@ -504,7 +555,7 @@ func (d *SLocalDisk) CleanupSnapshots(ctx context.Context, params interface{}) (
 		snapId, _ := snapshotId.GetString()
 		snapshotPath := path.Join(snapshotDir, snapId)
 		output := snapshotPath + "_convert.tmp"
-		img, err := qemuimg.NewQemuImage(snapshotPath)
+		img, err := d.openImage(ctx, snapshotPath)
 		if err != nil {
 			log.Errorln(err)
 			return nil, err
@@ -38,9 +38,9 @@ func NewNasDisk(storage IStorage, id string) *SNasDisk {
 	return &SNasDisk{*NewLocalDisk(storage, id)}
 }
 
-func (d *SNasDisk) CreateFromTemplate(ctx context.Context, imageId, format string, size int64) (jsonutils.JSONObject, error) {
+func (d *SNasDisk) CreateFromTemplate(ctx context.Context, imageId, format string, size int64, encryption bool) (jsonutils.JSONObject, error) {
 	imageCacheManager := storageManager.GetStoragecacheById(d.Storage.GetStoragecacheId())
-	ret, err := d.SLocalDisk.createFromTemplate(ctx, imageId, format, imageCacheManager)
+	ret, err := d.SLocalDisk.createFromTemplate(ctx, imageId, format, imageCacheManager, encryption)
 	if err != nil {
 		return nil, err
 	}
@@ -70,6 +70,10 @@ func (d *SNasDisk) CreateFromSnapshotLocation(ctx context.Context, snapshotLocat
 			return err
 		}
 	}
+	newImg, err = d.newImageOnBacking(ctx, d.GetPath(), snapshotPath)
+	if err != nil {
+		return errors.Wrap(err, "new image from snapshot")
+	}
 	err = newImg.CreateQcow2(0, false, snapshotPath)
 	if err != nil {
 		return errors.Wrap(err, "create image from snapshot")
@@ -102,7 +106,7 @@ func (d *SNasDisk) ResetFromSnapshot(ctx context.Context, params interface{}) (j
 	}
 	snapshotPath := path.Join(d.Storage.GetPath(), location)
 	log.Infof("Snapshot path is %s", snapshotPath)
-	return d.resetFromSnapshot(snapshotPath, outOfChain)
+	return d.resetFromSnapshot(ctx, snapshotPath, outOfChain)
 }
 
 func (d *SNasDisk) GetSnapshotLocation() string {
@@ -30,6 +30,7 @@ import (
 	api "yunion.io/x/onecloud/pkg/apis/compute"
 	"yunion.io/x/onecloud/pkg/appctx"
 	"yunion.io/x/onecloud/pkg/hostman/hostutils"
+	"yunion.io/x/onecloud/pkg/util/qemuimg"
 )
 
 type SRBDDisk struct {
@@ -121,7 +122,16 @@ func (d *SRBDDisk) Resize(ctx context.Context, params interface{}) (jsonutils.JS
 	storageConf := d.Storage.GetStorageConf()
 	pool, _ := storageConf.GetString("pool")
 	sizeMb, _ := diskInfo.Int("size")
-	if err := storage.resizeImage(pool, d.Id, uint64(sizeMb)); err != nil {
+	img, err := d.openImage(ctx, d.GetPath())
+	if err != nil {
+		return nil, errors.Wrapf(err, "open image %s", d.GetPath())
+	}
+	if img.Encryption {
+		// luks driver grows the volume by the payload size plus the header
+		if err := img.Resize(int(sizeMb)); err != nil {
+			return nil, errors.Wrap(err, "resize luks volume")
+		}
+	} else if err := storage.resizeImage(pool, d.Id, uint64(sizeMb)); err != nil {
 		return nil, err
 	}
 
@@ -143,7 +153,19 @@ func (d *SRBDDisk) PrepareSaveToGlance(ctx context.Context, params interface{})
 	}
 	storage := d.Storage.(*SRbdStorage)
 	pool, _ := storage.GetStorageConf().GetString("pool")
-	if err := storage.cloneImage(ctx, pool, d.Id, imageCache.GetPath(), imageName); err != nil {
+	img, err := d.openImage(ctx, d.GetPath())
+	if err != nil {
+		return nil, errors.Wrapf(err, "open image %s", d.GetPath())
+	}
+	if img.Encryption {
+		// images in glance are never encrypted
+		backupPath := fmt.Sprintf("rbd:%s/%s%s", imageCache.GetPath(), imageName, storage.getStorageConfString())
+		if err := img.DecryptTo(backupPath, qemuimg.RAW); err != nil {
+			log.Errorf("decrypt image %s from pool %s to %s/%s error: %v", d.Id, pool, imageCache.GetPath(), imageName, err)
+			storage.deleteImage(imageCache.GetPath(), imageName)
+			return nil, err
+		}
+	} else if err := storage.cloneImage(ctx, pool, d.Id, imageCache.GetPath(), imageName); err != nil {
 		log.Errorf("clone image %s from pool %s to %s/%s error: %v", d.Id, pool, imageCache.GetPath(), imageName, err)
 		return nil, err
 	}
@@ -160,8 +182,8 @@ func (d *SRBDDisk) PrepareMigrate(liveMigrate bool) (string, error) {
 	return "", fmt.Errorf("Not support")
 }
 
-func (d *SRBDDisk) CreateFromTemplate(ctx context.Context, imageId string, format string, size int64) (jsonutils.JSONObject, error) {
-	ret, err := d.createFromTemplate(ctx, imageId, format)
+func (d *SRBDDisk) CreateFromTemplate(ctx context.Context, imageId string, format string, size int64, encryption bool) (jsonutils.JSONObject, error) {
+	ret, err := d.createFromTemplate(ctx, imageId, format, encryption)
 	if err != nil {
 		return nil, err
 	}
@@ -177,7 +199,7 @@ func (d *SRBDDisk) CreateFromTemplate(ctx context.Context, imageId string, forma
 	return ret, nil
 }
 
-func (d *SRBDDisk) createFromTemplate(ctx context.Context, imageId, format string) (jsonutils.JSONObject, error) {
+func (d *SRBDDisk) createFromTemplate(ctx context.Context, imageId, format string, encryption bool) (jsonutils.JSONObject, error) {
 	var imageCacheManager = storageManager.GetStoragecacheById(d.Storage.GetStoragecacheId())
 	if imageCacheManager == nil {
 		return nil, fmt.Errorf("failed to find image cache manger for storage %s", d.Storage.GetStorageName())
@@ -190,7 +212,21 @@ func (d *SRBDDisk) createFromTemplate(ctx context.Context, imageId, format strin
 	storage := d.Storage.(*SRbdStorage)
 	destPool, _ := storage.StorageConf.GetString("pool")
 	storage.deleteImage(destPool, d.Id) //重装系统时，需要删除以前的系统盘
-	if err := storage.cloneImage(ctx, imageCacheManager.GetPath(), imageCache.GetName(), destPool, d.Id); err != nil {
+	if encryption {
+		// a clone would share the plain blocks of the cached image, the
+		// encrypted disk is a full luks copy instead
+		cachePath := fmt.Sprintf("rbd:%s/%s%s", imageCacheManager.GetPath(), imageCache.GetName(), storage.getStorageConfString())
+		img, err := qemuimg.NewQemuImage(cachePath)
+		if err != nil {
+			return nil, errors.Wrapf(err, "open cached image %s", imageCache.GetName())
+		}
+		if err := d.setEncryptKey(ctx, img); err != nil {
+			return nil, err
+		}
+		if err := img.EncryptTo(d.GetPath(), qemuimg.LUKS); err != nil {
+			return nil, errors.Wrapf(err, "encrypt cached image %s", imageCache.GetName())
+		}
+	} else if err := storage.cloneImage(ctx, imageCacheManager.GetPath(), imageCache.GetName(), destPool, d.Id); err != nil {
 		return nil, err
 	}
 	return d.GetDiskDesc(), nil
@@ -203,7 +239,17 @@ func (d *SRBDDisk) CreateFromImageFuse(ctx context.Context, url string, size int
 func (d *SRBDDisk) CreateRaw(ctx context.Context, sizeMb int, diskFromat string, fsFormat string, encryption bool, diskId string, back string) (jsonutils.JSONObject, error) {
 	storage := d.Storage.(*SRbdStorage)
 	pool, _ := storage.StorageConf.GetString("pool")
-	if err := storage.createImage(pool, diskId, uint64(sizeMb)); err != nil {
+	if encryption {
+		// qemu creates the rbd image with the luks header in front of
+		// the payload, which is the same on-disk format as dm-crypt luks1
+		img := &qemuimg.SQemuImage{Path: d.GetPath(), IoLevel: qemuimg.IONiceNone}
+		if err := d.setEncryptKey(ctx, img); err != nil {
+			return nil, err
+		}
+		if err := img.CreateLuks(sizeMb); err != nil {
+			return nil, errors.Wrap(err, "create luks volume")
+		}
+	} else if err := storage.createImage(pool, diskId, uint64(sizeMb)); err != nil {
 		return nil, err
 	}
 
@@ -27,7 +27,9 @@ import (
 	"yunion.io/x/log"
 
 	"yunion.io/x/onecloud/pkg/apis"
+	api "yunion.io/x/onecloud/pkg/apis/compute"
 	"yunion.io/x/onecloud/pkg/hostman/hostutils"
+	"yunion.io/x/onecloud/pkg/hostman/storageman/p2p"
 	"yunion.io/x/onecloud/pkg/hostman/storageman/remotefile"
 	"yunion.io/x/onecloud/pkg/mcclient/auth"
 	"yunion.io/x/onecloud/pkg/mcclient/modules"
@@ -36,8 +38,9 @@ import (
 )
 
 const (
-	_TMP_SUFFIX_ = ".tmp"
-	_INF_SUFFIX_ = ".inf"
+	_TMP_SUFFIX_     = ".tmp"
+	_INF_SUFFIX_     = ".inf"
+	_TORRENT_SUFFIX_ = ".torrent"
 
 	CHECK_TIMEOUT = 3600 * time.Second
 )
@@ -94,17 +97,18 @@ func (l *SLocalImageCache) Load() bool {
 			if !img.IsValid() {
 				return false
 			}
-			chksum, err := fileutils2.MD5(imgPath)
+			chksum, sha256sum, err := fileutils2.MD5SHA256(imgPath)
 			if err != nil {
 				log.Errorln(err)
 				return false
 			}
 			desc = &remotefile.SImageDesc{
-				Format: string(img.Format),
-				Id:     l.imageId,
-				Chksum: chksum,
-				Path:   imgPath,
-				Size:   l.GetSize(),
+				Format:       string(img.Format),
+				Id:           l.imageId,
+				Chksum:       chksum,
+				Sha256Chksum: sha256sum,
+				Path:         imgPath,
+				Size:         l.GetSize(),
 			}
 			bdesc, err := json.Marshal(desc)
 			if err != nil {
@@ -130,6 +134,7 @@ func (l *SLocalImageCache) Load() bool {
 		}
 		if len(desc.Chksum) > 0 && len(desc.Id) > 0 && desc.Id == l.imageId {
 			l.Desc = desc
+			l.seed()
 			return true
 		}
 	}
@@ -175,28 +180,79 @@ func (l *SLocalImageCache) prepare(ctx context.Context, zone, srcUrl, format, pr
 		l.consumerCount++
 		return true, true
 	}
-	url, err := auth.GetServiceURL(apis.SERVICE_TYPE_IMAGE, "", zone, "")
+	url, err := l.getImageUrl(zone, format)
 	if err != nil {
 		log.Errorf("Failed to acquire image %s", err)
 		return false, true
 	}
+
+	l.remoteFile = remotefile.NewRemoteFile(ctx, url,
+		l.GetPath(), false, preChksum, -1, nil, l.GetTmpPath(), srcUrl)
+	l.remoteFile.SetDownloadRateLimiter(p2p.GetDownloadRateLimiter())
+	return false, false
+}
+
+func (l *SLocalImageCache) getImageUrl(zone, format string) (string, error) {
+	url, err := auth.GetServiceURL(apis.SERVICE_TYPE_IMAGE, "", zone, "")
+	if err != nil {
+		return "", err
+	}
 	url += fmt.Sprintf("/images/%s", l.imageId)
 	if len(format) == 0 {
 		format = "qcow2"
 	}
 	url += fmt.Sprintf("?format=%s&scope=system", format)
+	return url, nil
+}
 
-	l.remoteFile = remotefile.NewRemoteFile(ctx, url,
-		l.GetPath(), false, preChksum, -1, nil, l.GetTmpPath(), srcUrl)
-	return false, false
+// fetchByP2p downloads the image from hosts in the same zone, the result is
+// verified against the checksum provided by image service before use
+func (l *SLocalImageCache) fetchByP2p(ctx context.Context, zone, format string) bool {
+	if !p2p.IsEnabled() {
+		return false
+	}
+	url, err := l.getImageUrl(zone, format)
+	if err != nil {
+		log.Errorf("Failed to get image url %s", err)
+		return false
+	}
+	err = p2p.Fetch(ctx, l.imageId, url+"&torrent=true", l.GetTorrentPath(), l.GetTmpPath())
+	if err != nil {
+		log.Warningf("p2p fetch image %s failed, download from image service: %v", l.imageId, err)
+		return false
+	}
+	if !l.remoteFile.AdoptTmpFile() {
+		log.Errorf("image %s fetched by p2p mismatch checksum, download from image service", l.imageId)
+		p2p.Drop(l.imageId)
+		os.Remove(l.GetTorrentPath())
+		return false
+	}
+	return true
+}
+
+func (l *SLocalImageCache) seed() {
+	if !fileutils2.Exists(l.GetTorrentPath()) {
+		return
+	}
+	if err := p2p.Seed(l.imageId, l.GetTorrentPath(), l.GetPath()); err != nil {
+		log.Errorf("Fail to seed image %s: %v", l.imageId, err)
+	}
 }
 
 func (l *SLocalImageCache) fetch(ctx context.Context, zone, srcUrl, format string) bool {
 	if (fileutils2.Exists(l.GetPath()) && l.remoteFile.VerifyIntegrity()) ||
-		l.remoteFile.Fetch() {
+		l.fetchByP2p(ctx, zone, format) || l.remoteFile.Fetch() {
 		if len(l.Manager.GetId()) > 0 {
-			_, err := hostutils.RemoteStoragecacheCacheImage(ctx,
-				l.Manager.GetId(), l.imageId, "ready", l.GetPath())
+			image := api.StoragecacheCachedImage{
+				ImageId: l.imageId,
+				Path:    l.GetPath(),
+				Format:  format,
+			}
+			if desc := l.remoteFile.GetInfo(); desc != nil && len(desc.Format) > 0 {
+				image.Format = desc.Format
+			}
+			_, err := hostutils.RemoteStoragecacheReportCachedImages(ctx, l.Manager.GetId(),
+				api.StoragecacheReportCachedImagesInput{Images: []api.StoragecacheCachedImage{image}})
 			if err != nil {
 				log.Errorf("Fail to update host cached image: %s", err)
 			}
@@ -239,6 +295,12 @@ func (l *SLocalImageCache) fetch(ctx context.Context, zone, srcUrl, format strin
 }
 
 func (l *SLocalImageCache) Remove(ctx context.Context) error {
+	p2p.Drop(l.imageId)
+	if fileutils2.Exists(l.GetTorrentPath()) {
+		if err := syscall.Unlink(l.GetTorrentPath()); err != nil {
+			return err
+		}
+	}
 	if fileutils2.Exists(l.GetPath()) {
 		if err := syscall.Unlink(l.GetPath()); err != nil {
 			return err
@@ -278,6 +340,10 @@ func (l *SLocalImageCache) GetInfPath() string {
 	return l.GetPath() + _INF_SUFFIX_
 }
 
+func (l *SLocalImageCache) GetTorrentPath() string {
+	return l.GetPath() + _TORRENT_SUFFIX_
+}
+
 func (l *SLocalImageCache) GetSize() int64 {
 	if fi, err := os.Stat(l.GetPath()); err != nil {
 		log.Errorln(err)
@@ -21,8 +21,10 @@ import (
 	"os"
 
 	"yunion.io/x/jsonutils"
+	"yunion.io/x/log"
 	"yunion.io/x/pkg/util/regutils"
 
+	api "yunion.io/x/onecloud/pkg/apis/compute"
 	"yunion.io/x/onecloud/pkg/cloudcommon/db/lockman"
 	"yunion.io/x/onecloud/pkg/hostman/hostutils"
 	"yunion.io/x/onecloud/pkg/util/fileutils2"
@@ -66,6 +68,40 @@ func (c *SLocalImageCacheManager) loadCache(ctx context.Context) {
 	}
 }
 
+func (c *SLocalImageCacheManager) SetStoragecacheId(scid string) {
+	c.SBaseImageCacheManager.SetStoragecacheId(scid)
+	if len(scid) > 0 {
+		go c.reportCachedImages(context.Background())
+	}
+}
+
+// reportCachedImages reports all locally cached images to region, which
+// the scheduler relies on to place guests close to their images
+func (c *SLocalImageCacheManager) reportCachedImages(ctx context.Context) {
+	c.lock.LockRawObject(ctx, "LOCAL", "image-cache")
+	images := make([]api.StoragecacheCachedImage, 0, len(c.cachedImages))
+	for imageId, img := range c.cachedImages {
+		desc := img.GetDesc()
+		if desc == nil {
+			continue
+		}
+		images = append(images, api.StoragecacheCachedImage{
+			ImageId: imageId,
+			Path:    img.GetPath(),
+			Format:  desc.Format,
+		})
+	}
+	c.lock.ReleaseRawObject(ctx, "LOCAL", "image-cache")
+
+	input := api.StoragecacheReportCachedImagesInput{
+		Images: images,
+		Full:   true,
+	}
+	if _, err := hostutils.RemoteStoragecacheReportCachedImages(ctx, c.GetId(), input); err != nil {
+		log.Errorf("Fail to report cached images of %s: %s", c.cachePath, err)
+	}
+}
+
 func (c *SLocalImageCacheManager) LoadImageCache(imageId string) {
 	imageCache := NewLocalImageCache(imageId, c)
 	if imageCache.Load() {
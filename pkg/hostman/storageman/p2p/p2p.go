@@ -0,0 +1,340 @@
+// Copyright 2019 Yunion
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package p2p
+
+import (
+	"bytes"
+	"context"
+	"io/ioutil"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/anacrolix/torrent"
+	"github.com/anacrolix/torrent/metainfo"
+	"golang.org/x/time/rate"
+
+	"yunion.io/x/log"
+	"yunion.io/x/pkg/errors"
+
+	"yunion.io/x/onecloud/pkg/apis"
+	"yunion.io/x/onecloud/pkg/hostman/options"
+	"yunion.io/x/onecloud/pkg/mcclient/auth"
+	"yunion.io/x/onecloud/pkg/util/httputils"
+	"yunion.io/x/onecloud/pkg/util/torrentutils"
+)
+
+const (
+	ErrNotStarted = errors.Error("p2p image distribution not started")
+	ErrStalled    = errors.Error("p2p transfer stalled")
+	ErrDropped    = errors.Error("p2p transfer dropped")
+)
+
+type sSeedSource struct {
+	torrentPath string
+	path        string
+}
+
+type SP2pManager struct {
+	client *torrent.Client
+	filter *sZonePeerFilter
+	zone   string
+
+	lock     sync.Mutex
+	torrents map[string]*torrent.Torrent
+	pending  map[string]sSeedSource
+}
+
+var (
+	manager = &SP2pManager{
+		torrents: make(map[string]*torrent.Torrent),
+		pending:  make(map[string]sSeedSource),
+	}
+
+	uploadLimiter   *rate.Limiter
+	downloadLimiter *rate.Limiter
+)
+
+func newRateLimiter(mbps int) *rate.Limiter {
+	if mbps <= 0 {
+		return nil
+	}
+	bps := mbps * 1024 * 1024
+	return rate.NewLimiter(rate.Limit(bps), bps)
+}
+
+// Init prepares the rate limiters of image caching, it is called before
+// image caches are loaded
+func Init() {
+	uploadLimiter = newRateLimiter(options.HostOptions.ImageUploadRateLimit)
+	downloadLimiter = newRateLimiter(options.HostOptions.ImageDownloadRateLimit)
+}
+
+// GetDownloadRateLimiter returns the limiter shared by p2p transfer and
+// downloading from image service, nil means unlimited
+func GetDownloadRateLimiter() *rate.Limiter {
+	return downloadLimiter
+}
+
+// Start starts the bittorrent client once the host is registered, and
+// seeds the images cached before
+func Start(zone string) error {
+	if !options.HostOptions.EnableP2pImageDistribution {
+		return nil
+	}
+	manager.lock.Lock()
+	defer manager.lock.Unlock()
+
+	if manager.client != nil {
+		return nil
+	}
+
+	cfg := torrent.NewDefaultClientConfig()
+	cfg.ListenPort = options.HostOptions.P2pListenPort
+	cfg.NoDefaultPortForwarding = true
+	cfg.Seed = true
+	cfg.NoDHT = true
+	cfg.DisablePEX = true
+	cfg.NominalDialTimeout = 1 * time.Second
+	cfg.MinDialTimeout = 100 * time.Millisecond
+	if uploadLimiter != nil {
+		cfg.UploadRateLimiter = uploadLimiter
+	}
+	if downloadLimiter != nil {
+		cfg.DownloadRateLimiter = downloadLimiter
+	}
+	if options.HostOptions.P2pZonePeersOnly {
+		manager.filter = newZonePeerFilter(zone)
+		cfg.IPBlocklist = manager.filter
+	}
+
+	client, err := torrent.NewClient(cfg)
+	if err != nil {
+		return errors.Wrap(err, "torrent.NewClient")
+	}
+	manager.client = client
+	manager.zone = zone
+	if manager.filter != nil {
+		go manager.filter.start()
+	}
+
+	for imageId, src := range manager.pending {
+		if err := manager.addSeed(imageId, src.torrentPath, src.path); err != nil {
+			log.Errorf("seed image %s: %v", imageId, err)
+		}
+	}
+	manager.pending = make(map[string]sSeedSource)
+	log.Infof("P2P image distribution started at port %d", client.LocalPort())
+	return nil
+}
+
+func Stop() {
+	manager.lock.Lock()
+	defer manager.lock.Unlock()
+
+	if manager.client != nil {
+		manager.client.Close()
+		manager.client = nil
+		manager.torrents = make(map[string]*torrent.Torrent)
+	}
+}
+
+func IsEnabled() bool {
+	manager.lock.Lock()
+	defer manager.lock.Unlock()
+
+	return manager.client != nil
+}
+
+// IsSeeding tells whether the cached image is served to peer hosts
+func IsSeeding(imageId string) bool {
+	manager.lock.Lock()
+	defer manager.lock.Unlock()
+
+	t, ok := manager.torrents[imageId]
+	return ok && t.Seeding()
+}
+
+// Seed serves the cached image at path to peer hosts, seeds registered
+// before Start are added once the client starts
+func Seed(imageId, torrentPath, path string) error {
+	manager.lock.Lock()
+	defer manager.lock.Unlock()
+
+	if manager.client == nil {
+		if options.HostOptions.EnableP2pImageDistribution {
+			manager.pending[imageId] = sSeedSource{torrentPath: torrentPath, path: path}
+		}
+		return nil
+	}
+	return manager.addSeed(imageId, torrentPath, path)
+}
+
+// Drop stops serving the image to peer hosts and releases its file
+func Drop(imageId string) {
+	manager.lock.Lock()
+	defer manager.lock.Unlock()
+
+	manager.drop(imageId)
+}
+
+// Fetch downloads the image described by the torrent at torrentUrl into path
+// from peer hosts and the image service, and keeps seeding it afterwards.
+// The torrent is saved to torrentPath so that seeding resumes after restart.
+func Fetch(ctx context.Context, imageId, torrentUrl, torrentPath, path string) error {
+	if !IsEnabled() {
+		return ErrNotStarted
+	}
+	mi, err := fetchTorrent(ctx, torrentUrl, torrentPath)
+	if err != nil {
+		return errors.Wrap(err, "fetchTorrent")
+	}
+
+	manager.lock.Lock()
+	t, err := manager.addTorrent(imageId, mi, path, false)
+	manager.lock.Unlock()
+	if err != nil {
+		return errors.Wrap(err, "addTorrent")
+	}
+	t.DownloadAll()
+
+	if err := wait(ctx, t); err != nil {
+		Drop(imageId)
+		return err
+	}
+	return nil
+}
+
+func wait(ctx context.Context, t *torrent.Torrent) error {
+	select {
+	case <-t.GotInfo():
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+
+	var (
+		total        = t.Length()
+		last         = int64(-1)
+		lastProgress = time.Now()
+		lastLog      = time.Now()
+		stallTimeout = time.Duration(options.HostOptions.P2pStallTimeoutSeconds) * time.Second
+		ticker       = time.NewTicker(time.Second)
+	)
+	defer ticker.Stop()
+
+	for {
+		completed := t.BytesCompleted()
+		if completed >= total {
+			return nil
+		}
+		now := time.Now()
+		if completed > last {
+			last = completed
+			lastProgress = now
+		} else if now.Sub(lastProgress) > stallTimeout {
+			return errors.Wrapf(ErrStalled, "%d/%d bytes completed with %d active peers",
+				completed, total, t.Stats().ActivePeers)
+		}
+		if now.Sub(lastLog) > 10*time.Second {
+			log.Infof("p2p fetch %s %dM/%dM with %d active peers", t.Name(),
+				completed/1024/1024, total/1024/1024, t.Stats().ActivePeers)
+			lastLog = now
+		}
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-t.Closed():
+			return ErrDropped
+		case <-ticker.C:
+		}
+	}
+}
+
+func fetchTorrent(ctx context.Context, torrentUrl, torrentPath string) (*metainfo.MetaInfo, error) {
+	header := http.Header{}
+	header.Set("X-Auth-Token", auth.GetTokenString())
+	resp, err := httputils.Request(httputils.GetDefaultClient(), ctx, httputils.GET, torrentUrl, header, nil, false)
+	if err != nil {
+		return nil, errors.Wrapf(err, "request %s", torrentUrl)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode >= 300 {
+		return nil, errors.Errorf("request %s status %d", torrentUrl, resp.StatusCode)
+	}
+	data, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return nil, errors.Wrap(err, "read torrent")
+	}
+	mi, err := metainfo.Load(bytes.NewReader(data))
+	if err != nil {
+		return nil, errors.Wrap(err, "metainfo.Load")
+	}
+	if err := ioutil.WriteFile(torrentPath, data, 0644); err != nil {
+		return nil, errors.Wrapf(err, "save torrent %s", torrentPath)
+	}
+	return mi, nil
+}
+
+// getTrackers prefers the trackers of the zone, so that hosts only find
+// peers in the same zone, and falls back to those recorded in the torrent
+func (m *SP2pManager) getTrackers() [][]string {
+	urls, err := auth.GetServiceURLs(apis.SERVICE_TYPE_TORRENT_TRACKER, options.HostOptions.Region, m.zone, "")
+	if err != nil {
+		log.Warningf("no %s endpoints in zone %s: %v", apis.SERVICE_TYPE_TORRENT_TRACKER, m.zone, err)
+		return nil
+	}
+	ret := make([][]string, 0, len(urls))
+	for _, u := range urls {
+		ret = append(ret, []string{u})
+	}
+	return ret
+}
+
+func (m *SP2pManager) addTorrent(imageId string, mi *metainfo.MetaInfo, path string, complete bool) (*torrent.Torrent, error) {
+	m.drop(imageId)
+
+	spec := torrent.TorrentSpecFromMetaInfo(mi)
+	if trackers := m.getTrackers(); len(trackers) > 0 {
+		spec.Trackers = trackers
+	}
+	spec.Storage = torrentutils.NewSingleFileStorage(path, complete)
+	t, _, err := m.client.AddTorrentSpec(spec)
+	if err != nil {
+		return nil, errors.Wrap(err, "AddTorrentSpec")
+	}
+	m.torrents[imageId] = t
+	return t, nil
+}
+
+func (m *SP2pManager) addSeed(imageId, torrentPath, path string) error {
+	mi, err := metainfo.LoadFromFile(torrentPath)
+	if err != nil {
+		return errors.Wrapf(err, "load torrent %s", torrentPath)
+	}
+	t, err := m.addTorrent(imageId, mi, path, true)
+	if err != nil {
+		return err
+	}
+	t.DownloadAll()
+	return nil
+}
+
+func (m *SP2pManager) drop(imageId string) {
+	delete(m.pending, imageId)
+	if t, ok := m.torrents[imageId]; ok {
+		t.Drop()
+		delete(m.torrents, imageId)
+	}
+}
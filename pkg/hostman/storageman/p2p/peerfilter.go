@@ -0,0 +1,140 @@
+// Copyright 2019 Yunion
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package p2p
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"net/url"
+	"sync"
+	"time"
+
+	"github.com/anacrolix/torrent/iplist"
+
+	"yunion.io/x/jsonutils"
+	"yunion.io/x/log"
+	"yunion.io/x/pkg/errors"
+
+	"yunion.io/x/onecloud/pkg/apis"
+	"yunion.io/x/onecloud/pkg/hostman/hostutils"
+	"yunion.io/x/onecloud/pkg/hostman/options"
+	"yunion.io/x/onecloud/pkg/mcclient/auth"
+	"yunion.io/x/onecloud/pkg/mcclient/modules"
+)
+
+const (
+	peerFilterRefreshInterval = 5 * time.Minute
+)
+
+// sZonePeerFilter blocks peers other than the hosts in the same zone and
+// the image service, so that image data does not cross zones
+type sZonePeerFilter struct {
+	zone string
+
+	lock    sync.RWMutex
+	allowed map[string]bool
+}
+
+func newZonePeerFilter(zone string) *sZonePeerFilter {
+	return &sZonePeerFilter{
+		zone:    zone,
+		allowed: make(map[string]bool),
+	}
+}
+
+// Lookup reports whether ip is blocked. Nothing is blocked before the peers
+// of the zone are known.
+func (f *sZonePeerFilter) Lookup(ip net.IP) (iplist.Range, bool) {
+	f.lock.RLock()
+	defer f.lock.RUnlock()
+
+	if len(f.allowed) == 0 || f.allowed[ip.String()] {
+		return iplist.Range{}, false
+	}
+	return iplist.Range{
+		First:       ip,
+		Last:        ip,
+		Description: fmt.Sprintf("peer out of zone %s", f.zone),
+	}, true
+}
+
+func (f *sZonePeerFilter) NumRanges() int {
+	f.lock.RLock()
+	defer f.lock.RUnlock()
+
+	return len(f.allowed)
+}
+
+func (f *sZonePeerFilter) setAllowed(ips []string) {
+	allowed := make(map[string]bool, len(ips))
+	for _, ip := range ips {
+		if parsed := net.ParseIP(ip); parsed != nil {
+			allowed[parsed.String()] = true
+		}
+	}
+
+	f.lock.Lock()
+	defer f.lock.Unlock()
+	f.allowed = allowed
+}
+
+func (f *sZonePeerFilter) refresh(ctx context.Context) error {
+	params := jsonutils.NewDict()
+	params.Set("zone_id", jsonutils.NewString(f.zone))
+	params.Set("scope", jsonutils.NewString("system"))
+	params.Set("limit", jsonutils.NewInt(0))
+	params.Set("details", jsonutils.JSONFalse)
+	hosts, err := modules.Hosts.List(hostutils.GetComputeSession(ctx), params)
+	if err != nil {
+		return errors.Wrap(err, "list hosts")
+	}
+	ips := make([]string, 0, len(hosts.Data)+1)
+	for _, host := range hosts.Data {
+		if ip, _ := host.GetString("access_ip"); len(ip) > 0 {
+			ips = append(ips, ip)
+		}
+	}
+
+	// image service seeds every image it stores
+	urls, err := auth.GetServiceURLs(apis.SERVICE_TYPE_IMAGE, options.HostOptions.Region, "", "")
+	if err != nil {
+		return errors.Wrap(err, "get image service urls")
+	}
+	for _, u := range urls {
+		parsed, err := url.Parse(u)
+		if err != nil {
+			continue
+		}
+		addrs, err := net.LookupHost(parsed.Hostname())
+		if err != nil {
+			log.Warningf("lookup image service host %s: %v", parsed.Hostname(), err)
+			continue
+		}
+		ips = append(ips, addrs...)
+	}
+
+	f.setAllowed(ips)
+	return nil
+}
+
+func (f *sZonePeerFilter) start() {
+	for {
+		if err := f.refresh(context.Background()); err != nil {
+			log.Errorf("refresh peers of zone %s: %v", f.zone, err)
+		}
+		time.Sleep(peerFilterRefreshInterval)
+	}
+}
@@ -0,0 +1,45 @@
+// Copyright 2019 Yunion
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package p2p
+
+import (
+	"net"
+	"testing"
+)
+
+func TestZonePeerFilter(t *testing.T) {
+	f := newZonePeerFilter("zone0")
+	if _, blocked := f.Lookup(net.ParseIP("10.0.0.1")); blocked {
+		t.Errorf("nothing should be blocked before peers are known")
+	}
+
+	f.setAllowed([]string{"10.0.0.1", "10.0.0.2", "invalid"})
+	if n := f.NumRanges(); n != 2 {
+		t.Errorf("expect 2 allowed peers, got %d", n)
+	}
+	for ip, want := range map[string]bool{
+		"10.0.0.1": false,
+		"10.0.0.2": false,
+		"10.0.1.1": true,
+	} {
+		r, blocked := f.Lookup(net.ParseIP(ip))
+		if blocked != want {
+			t.Errorf("%s blocked %v, want %v", ip, blocked, want)
+		}
+		if blocked && !r.First.Equal(net.ParseIP(ip)) {
+			t.Errorf("%s blocked by range %s", ip, r)
+		}
+	}
+}
@@ -23,11 +23,14 @@ import (
 	"syscall"
 	"time"
 
+	"golang.org/x/time/rate"
+
 	"yunion.io/x/log"
 
 	"yunion.io/x/onecloud/pkg/mcclient/auth"
 	"yunion.io/x/onecloud/pkg/util/fileutils2"
 	"yunion.io/x/onecloud/pkg/util/httputils"
+	"yunion.io/x/onecloud/pkg/util/streamutils"
 )
 
 type SImageDesc struct {
@@ -37,6 +40,8 @@ type SImageDesc struct {
 	Chksum string `json:"chksum"`
 	Path   string `json:"path"`
 	Size   int64  `json:"size"`
+
+	Sha256Chksum string `json:"sha256_chksum"`
 }
 
 type SRemoteFile struct {
@@ -49,10 +54,12 @@ type SRemoteFile struct {
 	compress     bool
 	timeout      time.Duration
 	extraHeaders map[string]string
+	limiter      *rate.Limiter
 
-	chksum string
-	format string
-	name   string
+	chksum       string
+	sha256Chksum string
+	format       string
+	name         string
 }
 
 func NewRemoteFile(
@@ -80,6 +87,11 @@ func NewRemoteFile(
 	}
 }
 
+// SetDownloadRateLimiter limits the download speed, nil means unlimited
+func (r *SRemoteFile) SetDownloadRateLimiter(limiter *rate.Limiter) {
+	r.limiter = limiter
+}
+
 func (r *SRemoteFile) Fetch() bool {
 	if len(r.preChksum) > 0 {
 		log.Infof("Fetch remote file with precheck sum: %s", r.preChksum)
@@ -105,18 +117,36 @@ func (r *SRemoteFile) GetInfo() *SImageDesc {
 	}
 
 	return &SImageDesc{
-		Name:   r.name,
-		Format: r.format,
-		Chksum: r.chksum,
-		Path:   r.localPath,
-		Size:   fi.Size(),
+		Name:         r.name,
+		Format:       r.format,
+		Chksum:       r.chksum,
+		Sha256Chksum: r.sha256Chksum,
+		Path:         r.localPath,
+		Size:         fi.Size(),
 	}
 }
 
+// matchChecksum compares the sha256 checksum if the image service provides
+// one, otherwise falls back to the md5 checksum
+func (r *SRemoteFile) matchChecksum(localChksum, localSha256 string) bool {
+	if len(r.sha256Chksum) > 0 {
+		if r.sha256Chksum != localSha256 {
+			log.Errorf("remote sha256 checksum %s != local sha256 checksum %s", r.sha256Chksum, localSha256)
+			return false
+		}
+		return true
+	}
+	if r.chksum != localChksum {
+		log.Errorf("remote checksum %s != local checksum %s", r.chksum, localChksum)
+		return false
+	}
+	return true
+}
+
 func (r *SRemoteFile) VerifyIntegrity() bool {
-	localChksum, err := fileutils2.MD5(r.localPath)
+	localChksum, localSha256, err := fileutils2.MD5SHA256(r.localPath)
 	if err != nil {
-		log.Errorf("MD5SUM local file %s error: %v", r.localPath, err)
+		log.Errorf("checksum local file %s error: %v", r.localPath, err)
 		return false
 	}
 	if r.preChksum != "" {
@@ -126,7 +156,7 @@ func (r *SRemoteFile) VerifyIntegrity() bool {
 		}
 	}
 	if r.download(false, "") {
-		if localChksum == r.chksum {
+		if r.matchChecksum(localChksum, localSha256) {
 			log.Infof("Identical chksum, skip download")
 			return true
 		}
@@ -135,6 +165,32 @@ func (r *SRemoteFile) VerifyIntegrity() bool {
 	return r.fetch("")
 }
 
+// AdoptTmpFile verifies the file put to tmpPath by other means, e.g. p2p
+// transfer, against the checksum of remote file and moves it to localPath
+func (r *SRemoteFile) AdoptTmpFile() bool {
+	r.format = ""
+	r.chksum = ""
+	r.sha256Chksum = ""
+	if !r.download(false, "") {
+		return false
+	}
+	localChksum, localSha256, err := fileutils2.MD5SHA256(r.tmpPath)
+	if err != nil {
+		log.Errorf("TmpPath %s checksum error: %v", r.tmpPath, err)
+		return false
+	}
+	if !r.matchChecksum(localChksum, localSha256) {
+		return false
+	}
+	if r.localPath != r.tmpPath {
+		if err := syscall.Rename(r.tmpPath, r.localPath); err != nil {
+			log.Errorf("rename %s to %s: %v", r.tmpPath, r.localPath, err)
+			return false
+		}
+	}
+	return true
+}
+
 func (r *SRemoteFile) fetch(preChksum string) bool {
 	var (
 		fetchSucc = false
@@ -144,15 +200,15 @@ func (r *SRemoteFile) fetch(preChksum string) bool {
 	for !fetchSucc && retryCnt < 3 {
 		r.format = ""
 		r.chksum = ""
+		r.sha256Chksum = ""
 		fetchSucc = r.download(true, preChksum)
 		if fetchSucc {
-			if len(r.chksum) > 0 && fileutils2.Exists(r.tmpPath) {
-				if localChksum, err := fileutils2.MD5(r.tmpPath); err != nil {
-					log.Errorf("TmpPath %s MD5SUM error: %v", r.tmpPath, err)
-					fetchSucc = false
-				} else if r.chksum != localChksum {
-					log.Errorf("remote checksum %s != local checksum %s", r.chksum, localChksum)
+			if (len(r.chksum) > 0 || len(r.sha256Chksum) > 0) && fileutils2.Exists(r.tmpPath) {
+				if localChksum, localSha256, err := fileutils2.MD5SHA256(r.tmpPath); err != nil {
+					log.Errorf("TmpPath %s checksum error: %v", r.tmpPath, err)
 					fetchSucc = false
+				} else {
+					fetchSucc = r.matchChecksum(localChksum, localSha256)
 				}
 			}
 		}
@@ -235,10 +291,10 @@ func (r *SRemoteFile) downloadInternal(getData bool, preChksum string) bool {
 				}
 				defer fi.Close()
 
-				var reader = resp.Body
+				var reader = streamutils.NewRateLimitedReader(r.ctx, resp.Body, r.limiter)
 
 				if r.compress {
-					zlibRC, err := zlib.NewReader(resp.Body)
+					zlibRC, err := zlib.NewReader(reader)
 					if err != nil {
 						log.Errorf("New zlib Reader error: %s", err)
 						return false
@@ -290,6 +346,9 @@ func (r *SRemoteFile) setProperties(header http.Header) {
 	if chksum := header.Get("X-Image-Meta-Checksum"); len(chksum) > 0 {
 		r.chksum = chksum
 	}
+	if chksum := header.Get("X-Image-Meta-Sha256_checksum"); len(chksum) > 0 {
+		r.sha256Chksum = chksum
+	}
 	if format := header.Get("X-Image-Meta-Disk_format"); len(format) > 0 {
 		r.format = format
 	}
@@ -334,19 +334,24 @@ func (s *SBaseStorage) CreateRawDisk(ctx context.Context, disk IDisk, createPara
 	size, _ := createParams.DiskInfo.Int("size")
 	diskFromat, _ := createParams.DiskInfo.GetString("format")
 	fsFormat, _ := createParams.DiskInfo.GetString("fs_format")
-	encryption := jsonutils.QueryBoolean(createParams.DiskInfo, "encryption", false)
+	encryption := isDiskInfoEncrypted(createParams.DiskInfo)
 
 	return disk.CreateRaw(ctx, int(size), diskFromat, fsFormat, encryption, createParams.DiskId, "")
 }
 
+func isDiskInfoEncrypted(diskInfo jsonutils.JSONObject) bool {
+	return jsonutils.QueryBoolean(diskInfo, "encryption", false) || diskInfo.Contains("encrypt_key_id")
+}
+
 func (s *SBaseStorage) CreateDiskFromTemplate(ctx context.Context, disk IDisk, createParams *SDiskCreateByDiskinfo) (jsonutils.JSONObject, error) {
 	var (
 		imageId, _ = createParams.DiskInfo.GetString("image_id")
 		format     = "qcow2" // force qcow2
 		size, _    = createParams.DiskInfo.Int("size")
+		encryption = isDiskInfoEncrypted(createParams.DiskInfo)
 	)
 
-	return disk.CreateFromTemplate(ctx, imageId, format, size)
+	return disk.CreateFromTemplate(ctx, imageId, format, size, encryption)
 }
 
 func (s *SBaseStorage) CreateDiskFromSnpashot(ctx context.Context, disk IDisk, createParams *SDiskCreateByDiskinfo) (jsonutils.JSONObject, error) {
@@ -451,7 +451,9 @@ func (s *SLocalStorage) DestinationPrepareMigrate(
 		// create local disk
 		backingFile, _ := disksBackingFile.GetString(diskId)
 		size, _ := diskinfo.Int("size")
-		_, err := disk.CreateRaw(ctx, int(size), "qcow2", "", false, "", backingFile)
+		// the target qemu opens the encrypted disk by the secret of the
+		// guest desc, the mirrored blocks are written through the same key
+		_, err := disk.CreateRaw(ctx, int(size), "qcow2", "", isDiskInfoEncrypted(diskinfo), "", backingFile)
 		if err != nil {
 			log.Errorln(err)
 			return err
@@ -270,10 +270,9 @@ func (gi *SGuestImage) DoCancelPendingDelete(ctx context.Context, userCred mccli
 	if err != nil {
 		return err
 	}
-	_, err = db.Update(gi, func() error {
-		gi.Status = api.IMAGE_STATUS_ACTIVE
-		return nil
-	})
+	// the status follows the sub images, which may be unverified
+	gi.Status = api.IMAGE_STATUS_PENDING_DELETE
+	err = gi.checkStatus(ctx, userCred)
 	return errors.Wrap(err, "guest image cancel delete error")
 }
 
@@ -469,10 +468,11 @@ func (self *SGuestImage) genUpdateImage(ctx context.Context, userCred mcclient.T
 
 var checkStatus = map[string]int{
 	api.IMAGE_STATUS_ACTIVE:      1,
-	api.IMAGE_STATUS_QUEUED:      2,
-	api.IMAGE_STATUS_SAVING:      3,
-	api.IMAGE_STATUS_DEACTIVATED: 4,
-	api.IMAGE_STATUS_KILLED:      5,
+	api.IMAGE_STATUS_UNVERIFIED:  2,
+	api.IMAGE_STATUS_QUEUED:      3,
+	api.IMAGE_STATUS_SAVING:      4,
+	api.IMAGE_STATUS_DEACTIVATED: 5,
+	api.IMAGE_STATUS_KILLED:      6,
 }
 
 func (self *SGuestImage) checkStatus(ctx context.Context, userCred mcclient.TokenCredential) error {
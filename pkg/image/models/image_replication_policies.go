@@ -0,0 +1,381 @@
+// Copyright 2019 Yunion
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package models
+
+import (
+	"context"
+	"fmt"
+
+	"yunion.io/x/jsonutils"
+	"yunion.io/x/log"
+	"yunion.io/x/pkg/errors"
+	"yunion.io/x/pkg/tristate"
+	"yunion.io/x/pkg/utils"
+	"yunion.io/x/sqlchemy"
+
+	"yunion.io/x/onecloud/pkg/apis"
+	api "yunion.io/x/onecloud/pkg/apis/image"
+	"yunion.io/x/onecloud/pkg/cloudcommon/db"
+	"yunion.io/x/onecloud/pkg/httperrors"
+	"yunion.io/x/onecloud/pkg/image/options"
+	"yunion.io/x/onecloud/pkg/mcclient"
+	"yunion.io/x/onecloud/pkg/mcclient/auth"
+	"yunion.io/x/onecloud/pkg/util/stringutils2"
+)
+
+type SImageReplicationPolicyManager struct {
+	db.SStandaloneResourceBaseManager
+	db.SEnabledResourceBaseManager
+}
+
+var ImageReplicationPolicyManager *SImageReplicationPolicyManager
+
+func init() {
+	ImageReplicationPolicyManager = &SImageReplicationPolicyManager{
+		SStandaloneResourceBaseManager: db.NewStandaloneResourceBaseManager(
+			SImageReplicationPolicy{},
+			"image_replication_policies_tbl",
+			"image_replication_policy",
+			"image_replication_policies",
+		),
+	}
+	ImageReplicationPolicyManager.SetVirtualObject(ImageReplicationPolicyManager)
+}
+
+// SImageReplicationPolicy pushes the matching images of this region to
+// the glance of the target regions
+type SImageReplicationPolicy struct {
+	db.SStandaloneResourceBase
+	db.SEnabledResourceBase
+
+	// 复制的目标区域
+	TargetRegions *jsonutils.JSONArray `nullable:"false" list:"admin" create:"admin_required" update:"admin"`
+	// 按标签匹配镜像
+	Tags *jsonutils.JSONArray `nullable:"true" list:"admin" create:"admin_optional" update:"admin"`
+	// 按项目匹配镜像
+	ProjectIds *jsonutils.JSONArray `nullable:"true" list:"admin" create:"admin_optional" update:"admin"`
+}
+
+func (manager *SImageReplicationPolicyManager) AllowListItems(ctx context.Context, userCred mcclient.TokenCredential, query jsonutils.JSONObject) bool {
+	return db.IsAdminAllowList(userCred, manager)
+}
+
+func (manager *SImageReplicationPolicyManager) AllowCreateItem(ctx context.Context, userCred mcclient.TokenCredential, query jsonutils.JSONObject, data jsonutils.JSONObject) bool {
+	return db.IsAdminAllowCreate(userCred, manager)
+}
+
+func (policy *SImageReplicationPolicy) AllowGetDetails(ctx context.Context, userCred mcclient.TokenCredential, query jsonutils.JSONObject) bool {
+	return db.IsAdminAllowGet(userCred, policy)
+}
+
+func (policy *SImageReplicationPolicy) AllowUpdateItem(ctx context.Context, userCred mcclient.TokenCredential) bool {
+	return db.IsAdminAllowUpdate(userCred, policy)
+}
+
+func (policy *SImageReplicationPolicy) AllowDeleteItem(ctx context.Context, userCred mcclient.TokenCredential, query jsonutils.JSONObject, data jsonutils.JSONObject) bool {
+	return db.IsAdminAllowDelete(userCred, policy)
+}
+
+func validateReplicationTargetRegions(ctx context.Context, regions []string) error {
+	for _, region := range regions {
+		if region == options.Options.Region {
+			return httperrors.NewInputParameterError("target region %s is the local region", region)
+		}
+		s := auth.GetAdminSession(ctx, region, "")
+		_, err := s.GetServiceURL(api.SERVICE_TYPE, "")
+		if err != nil {
+			return httperrors.NewInputParameterError("no image service found in region %s: %s", region, err)
+		}
+	}
+	return nil
+}
+
+func validateReplicationProjects(ctx context.Context, projects []string) ([]string, error) {
+	projectIds := make([]string, 0, len(projects))
+	for _, project := range projects {
+		tenant, err := db.TenantCacheManager.FetchTenantByIdOrName(ctx, project)
+		if err != nil {
+			return nil, httperrors.NewResourceNotFoundError2("project", project)
+		}
+		if !utils.IsInStringArray(tenant.Id, projectIds) {
+			projectIds = append(projectIds, tenant.Id)
+		}
+	}
+	return projectIds, nil
+}
+
+func (manager *SImageReplicationPolicyManager) ValidateCreateData(ctx context.Context, userCred mcclient.TokenCredential, ownerId mcclient.IIdentityProvider, query jsonutils.JSONObject, input api.ImageReplicationPolicyCreateInput) (api.ImageReplicationPolicyCreateInput, error) {
+	var err error
+	input.StandaloneResourceCreateInput, err = manager.SStandaloneResourceBaseManager.ValidateCreateData(ctx, userCred, ownerId, query, input.StandaloneResourceCreateInput)
+	if err != nil {
+		return input, errors.Wrap(err, "SStandaloneResourceBaseManager.ValidateCreateData")
+	}
+	if len(input.TargetRegions) == 0 {
+		return input, httperrors.NewMissingParameterError("target_regions")
+	}
+	err = validateReplicationTargetRegions(ctx, input.TargetRegions)
+	if err != nil {
+		return input, err
+	}
+	input.ProjectIds, err = validateReplicationProjects(ctx, input.ProjectIds)
+	if err != nil {
+		return input, err
+	}
+	return input, nil
+}
+
+func (policy *SImageReplicationPolicy) CustomizeCreate(ctx context.Context, userCred mcclient.TokenCredential, ownerId mcclient.IIdentityProvider, query jsonutils.JSONObject, data jsonutils.JSONObject) error {
+	input := api.ImageReplicationPolicyCreateInput{}
+	data.Unmarshal(&input)
+	if input.Disabled != nil && *input.Disabled {
+		policy.Enabled = tristate.False
+	} else {
+		policy.Enabled = tristate.True
+	}
+	return policy.SStandaloneResourceBase.CustomizeCreate(ctx, userCred, ownerId, query, data)
+}
+
+func (policy *SImageReplicationPolicy) PostCreate(ctx context.Context, userCred mcclient.TokenCredential, ownerId mcclient.IIdentityProvider, query jsonutils.JSONObject, data jsonutils.JSONObject) {
+	policy.SStandaloneResourceBase.PostCreate(ctx, userCred, ownerId, query, data)
+	if policy.Enabled.IsTrue() {
+		policy.syncReplications(ctx, userCred)
+		ImageReplicationManager.startPendingReplications(ctx, userCred)
+	}
+}
+
+func (policy *SImageReplicationPolicy) ValidateUpdateData(ctx context.Context, userCred mcclient.TokenCredential, query jsonutils.JSONObject, input api.ImageReplicationPolicyUpdateInput) (api.ImageReplicationPolicyUpdateInput, error) {
+	var err error
+	input.StandaloneResourceBaseUpdateInput, err = policy.SStandaloneResourceBase.ValidateUpdateData(ctx, userCred, query, input.StandaloneResourceBaseUpdateInput)
+	if err != nil {
+		return input, errors.Wrap(err, "SStandaloneResourceBase.ValidateUpdateData")
+	}
+	if input.TargetRegions != nil {
+		if len(input.TargetRegions) == 0 {
+			return input, httperrors.NewInputParameterError("target_regions must not be empty")
+		}
+		err = validateReplicationTargetRegions(ctx, input.TargetRegions)
+		if err != nil {
+			return input, err
+		}
+	}
+	if input.ProjectIds != nil {
+		input.ProjectIds, err = validateReplicationProjects(ctx, input.ProjectIds)
+		if err != nil {
+			return input, err
+		}
+	}
+	return input, nil
+}
+
+// 镜像复制策略列表
+func (manager *SImageReplicationPolicyManager) ListItemFilter(
+	ctx context.Context,
+	q *sqlchemy.SQuery,
+	userCred mcclient.TokenCredential,
+	query api.ImageReplicationPolicyListInput,
+) (*sqlchemy.SQuery, error) {
+	var err error
+	q, err = manager.SStandaloneResourceBaseManager.ListItemFilter(ctx, q, userCred, query.StandaloneResourceListInput)
+	if err != nil {
+		return nil, errors.Wrap(err, "SStandaloneResourceBaseManager.ListItemFilter")
+	}
+	q, err = manager.SEnabledResourceBaseManager.ListItemFilter(ctx, q, userCred, query.EnabledResourceBaseListInput)
+	if err != nil {
+		return nil, errors.Wrap(err, "SEnabledResourceBaseManager.ListItemFilter")
+	}
+	if len(query.TargetRegion) > 0 {
+		q = q.Contains("target_regions", fmt.Sprintf("%q", query.TargetRegion))
+	}
+	return q, nil
+}
+
+func (manager *SImageReplicationPolicyManager) OrderByExtraFields(
+	ctx context.Context,
+	q *sqlchemy.SQuery,
+	userCred mcclient.TokenCredential,
+	query api.ImageReplicationPolicyListInput,
+) (*sqlchemy.SQuery, error) {
+	q, err := manager.SStandaloneResourceBaseManager.OrderByExtraFields(ctx, q, userCred, query.StandaloneResourceListInput)
+	if err != nil {
+		return nil, errors.Wrap(err, "SStandaloneResourceBaseManager.OrderByExtraFields")
+	}
+	return q, nil
+}
+
+func (manager *SImageReplicationPolicyManager) QueryDistinctExtraField(q *sqlchemy.SQuery, field string) (*sqlchemy.SQuery, error) {
+	q, err := manager.SStandaloneResourceBaseManager.QueryDistinctExtraField(q, field)
+	if err == nil {
+		return q, nil
+	}
+	return q, httperrors.ErrNotFound
+}
+
+func (manager *SImageReplicationPolicyManager) FetchCustomizeColumns(
+	ctx context.Context,
+	userCred mcclient.TokenCredential,
+	query jsonutils.JSONObject,
+	objs []interface{},
+	fields stringutils2.SSortedStrings,
+	isList bool,
+) []api.ImageReplicationPolicyDetails {
+	rows := make([]api.ImageReplicationPolicyDetails, len(objs))
+	stdRows := manager.SStandaloneResourceBaseManager.FetchCustomizeColumns(ctx, userCred, query, objs, fields, isList)
+	policyIds := make([]string, len(objs))
+	for i := range rows {
+		rows[i] = api.ImageReplicationPolicyDetails{
+			StandaloneResourceDetails: stdRows[i],
+		}
+		policyIds[i] = objs[i].(*SImageReplicationPolicy).Id
+	}
+	counts, err := ImageReplicationManager.fetchStatusCounts(policyIds)
+	if err != nil {
+		log.Errorf("fetchStatusCounts fail %s", err)
+		return rows
+	}
+	for i := range rows {
+		rows[i].ReplicationCount = counts[policyIds[i]]
+	}
+	return rows
+}
+
+func (policy *SImageReplicationPolicy) AllowPerformEnable(ctx context.Context, userCred mcclient.TokenCredential, query jsonutils.JSONObject, data jsonutils.JSONObject) bool {
+	return db.IsAdminAllowPerform(userCred, policy, "enable")
+}
+
+func (policy *SImageReplicationPolicy) PerformEnable(ctx context.Context, userCred mcclient.TokenCredential, query jsonutils.JSONObject, input apis.PerformEnableInput) (jsonutils.JSONObject, error) {
+	err := db.EnabledPerformEnable(policy, ctx, userCred, true)
+	if err != nil {
+		return nil, errors.Wrap(err, "EnabledPerformEnable")
+	}
+	policy.syncReplications(ctx, userCred)
+	ImageReplicationManager.startPendingReplications(ctx, userCred)
+	return nil, nil
+}
+
+func (policy *SImageReplicationPolicy) AllowPerformDisable(ctx context.Context, userCred mcclient.TokenCredential, query jsonutils.JSONObject, data jsonutils.JSONObject) bool {
+	return db.IsAdminAllowPerform(userCred, policy, "disable")
+}
+
+func (policy *SImageReplicationPolicy) PerformDisable(ctx context.Context, userCred mcclient.TokenCredential, query jsonutils.JSONObject, input apis.PerformDisableInput) (jsonutils.JSONObject, error) {
+	err := db.EnabledPerformEnable(policy, ctx, userCred, false)
+	if err != nil {
+		return nil, errors.Wrap(err, "EnabledPerformEnable")
+	}
+	return nil, nil
+}
+
+func (policy *SImageReplicationPolicy) AllowPerformSync(ctx context.Context, userCred mcclient.TokenCredential, query jsonutils.JSONObject, data jsonutils.JSONObject) bool {
+	return db.IsAdminAllowPerform(userCred, policy, "sync")
+}
+
+// 立即按策略同步需要复制的镜像
+func (policy *SImageReplicationPolicy) PerformSync(ctx context.Context, userCred mcclient.TokenCredential, query jsonutils.JSONObject, data jsonutils.JSONObject) (jsonutils.JSONObject, error) {
+	if !policy.Enabled.IsTrue() {
+		return nil, httperrors.NewInvalidStatusError("policy is disabled")
+	}
+	policy.syncReplications(ctx, userCred)
+	ImageReplicationManager.startPendingReplications(ctx, userCred)
+	return nil, nil
+}
+
+func (policy *SImageReplicationPolicy) GetTargetRegions() []string {
+	regions := []string{}
+	if policy.TargetRegions != nil {
+		policy.TargetRegions.Unmarshal(&regions)
+	}
+	return regions
+}
+
+func (policy *SImageReplicationPolicy) getTags() []apis.STag {
+	tags := []apis.STag{}
+	if policy.Tags != nil {
+		policy.Tags.Unmarshal(&tags)
+	}
+	return tags
+}
+
+func (policy *SImageReplicationPolicy) getProjectIds() []string {
+	projectIds := []string{}
+	if policy.ProjectIds != nil {
+		policy.ProjectIds.Unmarshal(&projectIds)
+	}
+	return projectIds
+}
+
+// matchedImagesQuery selects the active images by the tags and projects of the
+// policy, the images being parts of guest images are not replicated
+func (policy *SImageReplicationPolicy) matchedImagesQuery() *sqlchemy.SQuery {
+	q := ImageManager.Query().Equals("status", api.IMAGE_STATUS_ACTIVE).IsFalse("is_guest_image").IsFalse("pending_deleted")
+	q = ImageManager.SMetadataResourceBaseModelManager.ListItemFilter(ImageManager, q, apis.MetadataResourceListInput{Tags: policy.getTags()})
+	if projectIds := policy.getProjectIds(); len(projectIds) > 0 {
+		q = q.In("tenant_id", projectIds)
+	}
+	return q
+}
+
+// getMatchedImages returns the images to be replicated by the policy
+func (policy *SImageReplicationPolicy) getMatchedImages() ([]SImage, error) {
+	q := policy.matchedImagesQuery()
+	images := make([]SImage, 0)
+	err := db.FetchModelObjects(ImageManager, q, &images)
+	if err != nil {
+		return nil, errors.Wrap(err, "FetchModelObjects")
+	}
+	return images, nil
+}
+
+func (policy *SImageReplicationPolicy) syncReplications(ctx context.Context, userCred mcclient.TokenCredential) {
+	images, err := policy.getMatchedImages()
+	if err != nil {
+		log.Errorf("get matched images of replication policy %s fail %s", policy.Name, err)
+		return
+	}
+	for _, region := range policy.GetTargetRegions() {
+		for i := range images {
+			err := ImageReplicationManager.ensureReplication(ctx, userCred, policy, &images[i], region)
+			if err != nil {
+				log.Errorf("ensure replication of image %s to %s fail %s", images[i].Name, region, err)
+			}
+		}
+	}
+}
+
+func (manager *SImageReplicationPolicyManager) getEnabledPolicies() ([]SImageReplicationPolicy, error) {
+	q := manager.Query().IsTrue("enabled")
+	policies := make([]SImageReplicationPolicy, 0)
+	err := db.FetchModelObjects(manager, q, &policies)
+	if err != nil {
+		return nil, errors.Wrap(err, "FetchModelObjects")
+	}
+	return policies, nil
+}
+
+// SyncImageReplications replicates newly matched images of all enabled
+// policies and resumes the pending or failed replications
+func (manager *SImageReplicationPolicyManager) SyncImageReplications(ctx context.Context, userCred mcclient.TokenCredential, isStart bool) {
+	if isStart {
+		ImageReplicationManager.resetInterruptedReplications()
+	}
+	policies, err := manager.getEnabledPolicies()
+	if err != nil {
+		log.Errorf("getEnabledPolicies fail %s", err)
+		return
+	}
+	for i := range policies {
+		policies[i].syncReplications(ctx, userCred)
+	}
+	ImageReplicationManager.startPendingReplications(ctx, userCred)
+	ImageReplicationManager.verifyReplications(ctx, userCred)
+}
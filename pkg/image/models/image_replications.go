@@ -0,0 +1,725 @@
+// Copyright 2019 Yunion
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package models
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"strings"
+	"time"
+
+	"yunion.io/x/jsonutils"
+	"yunion.io/x/log"
+	"yunion.io/x/pkg/errors"
+	"yunion.io/x/sqlchemy"
+
+	api "yunion.io/x/onecloud/pkg/apis/image"
+	"yunion.io/x/onecloud/pkg/cloudcommon/db"
+	"yunion.io/x/onecloud/pkg/cloudcommon/db/taskman"
+	"yunion.io/x/onecloud/pkg/httperrors"
+	"yunion.io/x/onecloud/pkg/image/options"
+	"yunion.io/x/onecloud/pkg/mcclient"
+	"yunion.io/x/onecloud/pkg/mcclient/auth"
+	"yunion.io/x/onecloud/pkg/mcclient/modules"
+	"yunion.io/x/onecloud/pkg/util/httputils"
+	"yunion.io/x/onecloud/pkg/util/stringutils2"
+)
+
+type SImageReplicationManager struct {
+	db.SStatusStandaloneResourceBaseManager
+}
+
+var ImageReplicationManager *SImageReplicationManager
+
+func init() {
+	ImageReplicationManager = &SImageReplicationManager{
+		SStatusStandaloneResourceBaseManager: db.NewStatusStandaloneResourceBaseManager(
+			SImageReplication{},
+			"image_replications_tbl",
+			"image_replication",
+			"image_replications",
+		),
+	}
+	ImageReplicationManager.SetVirtualObject(ImageReplicationManager)
+	ImageReplicationManager.TableSpec().AddIndex(false, "image_id", "target_region")
+}
+
+// SImageReplication tracks the replication of an image to the glance of a target region
+//
+// A replication goes through the following steps, each of which is recorded so that
+// an interrupted replication resumes from where it stopped:
+//  1. create the target image without data, TargetImageId is saved
+//  2. upload the image data to the queued target image in chunks, the target
+//     image stays queued and reports the received size until the final chunk,
+//     so an interrupted upload resumes from the received size, UploadedSize
+//     records the progress
+//  3. verify the checksum of the active target image against the source image
+//     and record its subformats
+type SImageReplication struct {
+	db.SStatusStandaloneResourceBase
+
+	// 复制策略ID
+	PolicyId string `width:"36" charset:"ascii" nullable:"false" list:"admin" index:"true"`
+	// 源镜像ID
+	ImageId string `width:"36" charset:"ascii" nullable:"false" list:"admin"`
+	// 目标区域
+	TargetRegion string `width:"128" charset:"utf8" nullable:"false" list:"admin"`
+	// 目标镜像ID
+	TargetImageId string `width:"36" charset:"ascii" nullable:"true" list:"admin"`
+	// 已上传到目标镜像的大小
+	UploadedSize int64 `nullable:"false" default:"0" list:"admin"`
+
+	// 源镜像校验和
+	Checksum string `width:"32" charset:"ascii" nullable:"true" list:"admin"`
+	// 目标镜像校验和
+	TargetChecksum string `width:"32" charset:"ascii" nullable:"true" list:"admin"`
+	// 目标镜像的子格式
+	TargetSubformats *api.ImageReplicationSubformats `nullable:"true" list:"admin"`
+
+	// 已尝试次数
+	Attempts int `nullable:"false" default:"0" list:"admin"`
+	// 最近一次失败原因
+	LastError string `charset:"utf8" nullable:"true" list:"admin"`
+	// 开始校验时间
+	VerifyStartedAt time.Time `nullable:"true" list:"admin"`
+	// 复制完成时间
+	ReplicatedAt time.Time `nullable:"true" list:"admin"`
+}
+
+func (manager *SImageReplicationManager) AllowListItems(ctx context.Context, userCred mcclient.TokenCredential, query jsonutils.JSONObject) bool {
+	return db.IsAdminAllowList(userCred, manager)
+}
+
+func (manager *SImageReplicationManager) AllowCreateItem(ctx context.Context, userCred mcclient.TokenCredential, query jsonutils.JSONObject, data jsonutils.JSONObject) bool {
+	return false
+}
+
+func (replication *SImageReplication) AllowGetDetails(ctx context.Context, userCred mcclient.TokenCredential, query jsonutils.JSONObject) bool {
+	return db.IsAdminAllowGet(userCred, replication)
+}
+
+func (replication *SImageReplication) AllowUpdateItem(ctx context.Context, userCred mcclient.TokenCredential) bool {
+	return false
+}
+
+func (replication *SImageReplication) AllowDeleteItem(ctx context.Context, userCred mcclient.TokenCredential, query jsonutils.JSONObject, data jsonutils.JSONObject) bool {
+	return db.IsAdminAllowDelete(userCred, replication)
+}
+
+func (replication *SImageReplication) ValidateDeleteCondition(ctx context.Context) error {
+	if replication.Status == api.IMAGE_REPLICATION_STATUS_REPLICATING {
+		return httperrors.NewInvalidStatusError("cannot delete in status %s", replication.Status)
+	}
+	return replication.SStatusStandaloneResourceBase.ValidateDeleteCondition(ctx)
+}
+
+// 镜像复制记录列表
+func (manager *SImageReplicationManager) ListItemFilter(
+	ctx context.Context,
+	q *sqlchemy.SQuery,
+	userCred mcclient.TokenCredential,
+	query api.ImageReplicationListInput,
+) (*sqlchemy.SQuery, error) {
+	q, err := manager.SStatusStandaloneResourceBaseManager.ListItemFilter(ctx, q, userCred, query.StatusStandaloneResourceListInput)
+	if err != nil {
+		return nil, errors.Wrap(err, "SStatusStandaloneResourceBaseManager.ListItemFilter")
+	}
+	if len(query.PolicyId) > 0 {
+		policy, err := ImageReplicationPolicyManager.FetchByIdOrName(userCred, query.PolicyId)
+		if err != nil {
+			if errors.Cause(err) == sql.ErrNoRows {
+				return nil, httperrors.NewResourceNotFoundError2(ImageReplicationPolicyManager.Keyword(), query.PolicyId)
+			}
+			return nil, httperrors.NewGeneralError(err)
+		}
+		q = q.Equals("policy_id", policy.GetId())
+	}
+	if len(query.ImageId) > 0 {
+		image, err := ImageManager.FetchByIdOrName(userCred, query.ImageId)
+		if err != nil {
+			if errors.Cause(err) == sql.ErrNoRows {
+				return nil, httperrors.NewResourceNotFoundError2(ImageManager.Keyword(), query.ImageId)
+			}
+			return nil, httperrors.NewGeneralError(err)
+		}
+		q = q.Equals("image_id", image.GetId())
+	}
+	if len(query.TargetRegion) > 0 {
+		q = q.In("target_region", query.TargetRegion)
+	}
+	return q, nil
+}
+
+func (manager *SImageReplicationManager) OrderByExtraFields(
+	ctx context.Context,
+	q *sqlchemy.SQuery,
+	userCred mcclient.TokenCredential,
+	query api.ImageReplicationListInput,
+) (*sqlchemy.SQuery, error) {
+	q, err := manager.SStatusStandaloneResourceBaseManager.OrderByExtraFields(ctx, q, userCred, query.StatusStandaloneResourceListInput)
+	if err != nil {
+		return nil, errors.Wrap(err, "SStatusStandaloneResourceBaseManager.OrderByExtraFields")
+	}
+	return q, nil
+}
+
+func (manager *SImageReplicationManager) QueryDistinctExtraField(q *sqlchemy.SQuery, field string) (*sqlchemy.SQuery, error) {
+	q, err := manager.SStatusStandaloneResourceBaseManager.QueryDistinctExtraField(q, field)
+	if err == nil {
+		return q, nil
+	}
+	return q, httperrors.ErrNotFound
+}
+
+func (manager *SImageReplicationManager) FetchCustomizeColumns(
+	ctx context.Context,
+	userCred mcclient.TokenCredential,
+	query jsonutils.JSONObject,
+	objs []interface{},
+	fields stringutils2.SSortedStrings,
+	isList bool,
+) []api.ImageReplicationDetails {
+	rows := make([]api.ImageReplicationDetails, len(objs))
+	stdRows := manager.SStatusStandaloneResourceBaseManager.FetchCustomizeColumns(ctx, userCred, query, objs, fields, isList)
+	policyIds := make([]string, len(objs))
+	imageIds := make([]string, len(objs))
+	for i := range rows {
+		rows[i] = api.ImageReplicationDetails{
+			StatusStandaloneResourceDetails: stdRows[i],
+		}
+		replication := objs[i].(*SImageReplication)
+		policyIds[i] = replication.PolicyId
+		imageIds[i] = replication.ImageId
+	}
+	policies := make(map[string]SImageReplicationPolicy)
+	err := db.FetchStandaloneObjectsByIds(ImageReplicationPolicyManager, policyIds, &policies)
+	if err != nil {
+		log.Errorf("FetchStandaloneObjectsByIds fail %s", err)
+		return rows
+	}
+	images := make(map[string]SImage)
+	err = db.FetchStandaloneObjectsByIds(ImageManager, imageIds, &images)
+	if err != nil {
+		log.Errorf("FetchStandaloneObjectsByIds fail %s", err)
+		return rows
+	}
+	for i := range rows {
+		if policy, ok := policies[policyIds[i]]; ok {
+			rows[i].Policy = policy.Name
+		}
+		if image, ok := images[imageIds[i]]; ok {
+			rows[i].Image = image.Name
+		}
+	}
+	return rows
+}
+
+// fetchStatusCounts returns the number of replications in each status of the policies
+func (manager *SImageReplicationManager) fetchStatusCounts(policyIds []string) (map[string]map[string]int, error) {
+	ret := make(map[string]map[string]int)
+	if len(policyIds) == 0 {
+		return ret, nil
+	}
+	replications := manager.Query().SubQuery()
+	q := replications.Query(
+		replications.Field("policy_id"),
+		replications.Field("status"),
+		sqlchemy.COUNT("count"),
+	).In("policy_id", policyIds).GroupBy(replications.Field("policy_id"), replications.Field("status"))
+	counts := []struct {
+		PolicyId string
+		Status   string
+		Count    int
+	}{}
+	err := q.All(&counts)
+	if err != nil {
+		return nil, errors.Wrap(err, "q.All")
+	}
+	for _, cnt := range counts {
+		if _, ok := ret[cnt.PolicyId]; !ok {
+			ret[cnt.PolicyId] = make(map[string]int)
+		}
+		ret[cnt.PolicyId][cnt.Status] = cnt.Count
+	}
+	return ret, nil
+}
+
+func (manager *SImageReplicationManager) fetchReplication(imageId, region string) (*SImageReplication, error) {
+	q := manager.Query().Equals("image_id", imageId).Equals("target_region", region)
+	replication := &SImageReplication{}
+	replication.SetModelManager(manager, replication)
+	err := q.First(replication)
+	if err != nil {
+		return nil, err
+	}
+	return replication, nil
+}
+
+// ensureReplication creates the replication of the image to the region if not exists,
+// an image updated after its replication is replicated again
+func (manager *SImageReplicationManager) ensureReplication(ctx context.Context, userCred mcclient.TokenCredential, policy *SImageReplicationPolicy, image *SImage, region string) error {
+	replication, err := manager.fetchReplication(image.Id, region)
+	if err != nil && errors.Cause(err) != sql.ErrNoRows {
+		return errors.Wrap(err, "fetchReplication")
+	}
+	if replication != nil {
+		if replication.Checksum == image.Checksum || replication.Status == api.IMAGE_REPLICATION_STATUS_REPLICATING {
+			return nil
+		}
+		_, err := db.Update(replication, func() error {
+			replication.Checksum = image.Checksum
+			replication.Attempts = 0
+			replication.Status = api.IMAGE_REPLICATION_STATUS_PENDING
+			return nil
+		})
+		return err
+	}
+	replication = &SImageReplication{
+		PolicyId:     policy.Id,
+		ImageId:      image.Id,
+		TargetRegion: region,
+		Checksum:     image.Checksum,
+	}
+	replication.Name = fmt.Sprintf("%s-%s", image.Name, region)
+	replication.Status = api.IMAGE_REPLICATION_STATUS_PENDING
+	replication.SetModelManager(manager, replication)
+	err = manager.TableSpec().Insert(ctx, replication)
+	if err != nil {
+		return errors.Wrap(err, "Insert")
+	}
+	db.OpsLog.LogEvent(replication, db.ACT_CREATE, replication.GetShortDesc(ctx), userCred)
+	return nil
+}
+
+func (manager *SImageReplicationManager) fetchReplicationsByStatus(status []string) ([]SImageReplication, error) {
+	q := manager.Query().In("status", status)
+	replications := make([]SImageReplication, 0)
+	err := db.FetchModelObjects(manager, q, &replications)
+	if err != nil {
+		return nil, errors.Wrap(err, "FetchModelObjects")
+	}
+	return replications, nil
+}
+
+// resetInterruptedReplications marks the replications interrupted by a
+// restart as pending, they will resume from the last finished step
+func (manager *SImageReplicationManager) resetInterruptedReplications() {
+	replications, err := manager.fetchReplicationsByStatus([]string{api.IMAGE_REPLICATION_STATUS_REPLICATING})
+	if err != nil {
+		log.Errorf("fetchReplicationsByStatus fail %s", err)
+		return
+	}
+	for i := range replications {
+		replications[i].SetStatus(auth.AdminCredential(), api.IMAGE_REPLICATION_STATUS_PENDING, "resume interrupted replication")
+	}
+}
+
+func (manager *SImageReplicationManager) startPendingReplications(ctx context.Context, userCred mcclient.TokenCredential) {
+	replications, err := manager.fetchReplicationsByStatus([]string{api.IMAGE_REPLICATION_STATUS_PENDING, api.IMAGE_REPLICATION_STATUS_FAILED})
+	if err != nil {
+		log.Errorf("fetchReplicationsByStatus fail %s", err)
+		return
+	}
+	for i := range replications {
+		if replications[i].Status == api.IMAGE_REPLICATION_STATUS_FAILED && replications[i].Attempts >= options.Options.ImageReplicationMaxAttempts {
+			continue
+		}
+		err := replications[i].StartReplicateTask(ctx, userCred, "")
+		if err != nil {
+			log.Errorf("StartReplicateTask for %s fail %s", replications[i].Name, err)
+		}
+	}
+}
+
+func (manager *SImageReplicationManager) verifyReplications(ctx context.Context, userCred mcclient.TokenCredential) {
+	replications, err := manager.fetchReplicationsByStatus([]string{api.IMAGE_REPLICATION_STATUS_VERIFYING})
+	if err != nil {
+		log.Errorf("fetchReplicationsByStatus fail %s", err)
+		return
+	}
+	for i := range replications {
+		err := replications[i].verify(ctx, userCred)
+		if err != nil {
+			log.Errorf("verify replication %s fail %s", replications[i].Name, err)
+		}
+	}
+}
+
+func (replication *SImageReplication) GetImage() (*SImage, error) {
+	image, err := ImageManager.FetchById(replication.ImageId)
+	if err != nil {
+		return nil, errors.Wrapf(err, "ImageManager.FetchById(%s)", replication.ImageId)
+	}
+	return image.(*SImage), nil
+}
+
+func (replication *SImageReplication) getTargetSession(ctx context.Context) *mcclient.ClientSession {
+	return auth.GetAdminSession(ctx, replication.TargetRegion, "")
+}
+
+func (replication *SImageReplication) AllowPerformRetry(ctx context.Context, userCred mcclient.TokenCredential, query jsonutils.JSONObject, data jsonutils.JSONObject) bool {
+	return db.IsAdminAllowPerform(userCred, replication, "retry")
+}
+
+// 重新复制失败的镜像
+func (replication *SImageReplication) PerformRetry(ctx context.Context, userCred mcclient.TokenCredential, query jsonutils.JSONObject, data jsonutils.JSONObject) (jsonutils.JSONObject, error) {
+	if replication.Status != api.IMAGE_REPLICATION_STATUS_FAILED {
+		return nil, httperrors.NewInvalidStatusError("cannot retry in status %s", replication.Status)
+	}
+	_, err := db.Update(replication, func() error {
+		replication.Attempts = 0
+		return nil
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "db.Update")
+	}
+	return nil, replication.StartReplicateTask(ctx, userCred, "")
+}
+
+func (replication *SImageReplication) StartReplicateTask(ctx context.Context, userCred mcclient.TokenCredential, parentTaskId string) error {
+	replication.SetStatus(userCred, api.IMAGE_REPLICATION_STATUS_REPLICATING, "")
+	task, err := taskman.TaskManager.NewTask(ctx, "ImageReplicateTask", replication, userCred, nil, parentTaskId, "", nil)
+	if err != nil {
+		return errors.Wrap(err, "NewTask")
+	}
+	task.ScheduleRun(nil)
+	return nil
+}
+
+func isTargetImageNotFound(err error) bool {
+	return httputils.ErrorCode(err) == 404
+}
+
+// fetchTargetImage returns the target image created by previous attempts, a
+// target image which cannot be resumed is removed
+func (replication *SImageReplication) fetchTargetImage(s *mcclient.ClientSession, image *SImage) (jsonutils.JSONObject, error) {
+	if len(replication.TargetImageId) == 0 {
+		return nil, nil
+	}
+	target, err := modules.Images.GetById(s, replication.TargetImageId, nil)
+	if err != nil {
+		if isTargetImageNotFound(err) {
+			return nil, nil
+		}
+		return nil, errors.Wrap(err, "get target image")
+	}
+	status, _ := target.GetString("status")
+	checksum, _ := target.GetString("properties", api.IMAGE_REPLICATED_FROM_CHECKSUM)
+	// a queued target image resumes the upload from its received size, a
+	// saving one is left by an interrupted final chunk, as only one task
+	// replicates the image at a time, it is dropped with the stale ones
+	switch status {
+	case api.IMAGE_STATUS_QUEUED:
+		return target, nil
+	case api.IMAGE_STATUS_ACTIVE, api.IMAGE_STATUS_CONVERTING:
+		if checksum == image.Checksum {
+			return target, nil
+		}
+	}
+	err = replication.dropTargetImage(s)
+	if err != nil {
+		return nil, errors.Wrap(err, "dropTargetImage")
+	}
+	return nil, nil
+}
+
+// dropTargetImage deletes the target image so that the next attempt uploads
+// the data again
+func (replication *SImageReplication) dropTargetImage(s *mcclient.ClientSession) error {
+	if len(replication.TargetImageId) == 0 {
+		return nil
+	}
+	query := jsonutils.NewDict()
+	query.Set("override_pending_delete", jsonutils.JSONTrue)
+	_, err := modules.Images.Delete(s, replication.TargetImageId, query)
+	if err != nil && !isTargetImageNotFound(err) {
+		return errors.Wrapf(err, "delete target image %s", replication.TargetImageId)
+	}
+	_, err = db.Update(replication, func() error {
+		replication.TargetImageId = ""
+		replication.UploadedSize = 0
+		return nil
+	})
+	return err
+}
+
+func (replication *SImageReplication) getTargetImageParams(image *SImage) (*jsonutils.JSONDict, error) {
+	params := jsonutils.NewDict()
+	params.Set("name", jsonutils.NewString(image.Name))
+	params.Set("disk_format", jsonutils.NewString(image.DiskFormat))
+	params.Set("min_disk", jsonutils.NewInt(int64(image.MinDiskMB)))
+	params.Set("min_ram", jsonutils.NewInt(int64(image.MinRamMB)))
+	params.Set("is_data", jsonutils.NewBool(image.IsData.IsTrue()))
+	params.Set("project_id", jsonutils.NewString(image.ProjectId))
+	if len(image.Description) > 0 {
+		params.Set("description", jsonutils.NewString(image.Description))
+	}
+	props, err := ImagePropertyManager.GetProperties(image.Id)
+	if err != nil {
+		return nil, errors.Wrap(err, "GetProperties")
+	}
+	properties := jsonutils.Marshal(props).(*jsonutils.JSONDict)
+	properties.Set(api.IMAGE_REPLICATED_FROM_REGION, jsonutils.NewString(options.Options.Region))
+	properties.Set(api.IMAGE_REPLICATED_FROM_IMAGE, jsonutils.NewString(image.Id))
+	properties.Set(api.IMAGE_REPLICATED_FROM_CHECKSUM, jsonutils.NewString(image.Checksum))
+	params.Set("properties", properties)
+	return params, nil
+}
+
+// Replicate pushes the image to the target region, it resumes from the target
+// image left by an interrupted attempt and uploads the data in chunks from
+// the size the target image has received
+func (replication *SImageReplication) Replicate(ctx context.Context) error {
+	image, err := replication.GetImage()
+	if err != nil {
+		return errors.Wrap(err, "GetImage")
+	}
+	if image.Status != api.IMAGE_STATUS_ACTIVE {
+		return errors.Errorf("image %s in status %s", image.Name, image.Status)
+	}
+	_, err = db.Update(replication, func() error {
+		replication.Attempts += 1
+		replication.Checksum = image.Checksum
+		return nil
+	})
+	if err != nil {
+		return errors.Wrap(err, "db.Update")
+	}
+
+	s := replication.getTargetSession(ctx)
+	target, err := replication.fetchTargetImage(s, image)
+	if err != nil {
+		return errors.Wrap(err, "fetchTargetImage")
+	}
+	if target == nil {
+		params, err := replication.getTargetImageParams(image)
+		if err != nil {
+			return errors.Wrap(err, "getTargetImageParams")
+		}
+		target, err = modules.Images.Create(s, params)
+		if err != nil {
+			return errors.Wrap(err, "create target image")
+		}
+		targetId, _ := target.GetString("id")
+		_, err = db.Update(replication, func() error {
+			replication.TargetImageId = targetId
+			return nil
+		})
+		if err != nil {
+			return errors.Wrap(err, "db.Update")
+		}
+	}
+	if status, _ := target.GetString("status"); status != api.IMAGE_STATUS_QUEUED {
+		// data has been uploaded by a previous attempt
+		return nil
+	}
+
+	// the size of a queued target image is the data received so far
+	offset, _ := target.Int("size")
+	size, reader, err := GetImage(image.Location)
+	if err != nil {
+		return errors.Wrap(err, "GetImage")
+	}
+	defer reader.Close()
+	if offset > size {
+		err := replication.dropTargetImage(s)
+		if err != nil {
+			return errors.Wrap(err, "dropTargetImage")
+		}
+		return errors.Errorf("target image received %d bytes more than image size %d", offset, size)
+	}
+	err = skipImageData(reader, offset)
+	if err != nil {
+		return errors.Wrapf(err, "skip to offset %d", offset)
+	}
+	chunkSize := int64(options.Options.ImageReplicationChunkSizeMb) * 1024 * 1024
+	for {
+		n, final := nextReplicationChunk(offset, size, chunkSize)
+		var body io.Reader
+		if n > 0 {
+			body = io.LimitReader(reader, n)
+		}
+		_, err = modules.Images.UploadChunk(s, replication.TargetImageId, offset, body, n, final)
+		if err != nil {
+			return errors.Wrapf(err, "upload target image at offset %d", offset)
+		}
+		offset += n
+		_, err = db.Update(replication, func() error {
+			replication.UploadedSize = offset
+			return nil
+		})
+		if err != nil {
+			return errors.Wrap(err, "db.Update")
+		}
+		if final {
+			return nil
+		}
+	}
+}
+
+// nextReplicationChunk returns the length of the chunk to upload at offset
+// and whether it is the final one, the final chunk may be empty
+func nextReplicationChunk(offset, size, chunkSize int64) (int64, bool) {
+	if chunkSize <= 0 || offset+chunkSize >= size {
+		return size - offset, true
+	}
+	return chunkSize, false
+}
+
+func skipImageData(reader io.Reader, offset int64) error {
+	if offset == 0 {
+		return nil
+	}
+	if seeker, ok := reader.(io.Seeker); ok {
+		_, err := seeker.Seek(offset, io.SeekStart)
+		return err
+	}
+	_, err := io.CopyN(ioutil.Discard, reader, offset)
+	return err
+}
+
+func (replication *SImageReplication) OnReplicateSuccess(ctx context.Context, userCred mcclient.TokenCredential) {
+	db.Update(replication, func() error {
+		replication.LastError = ""
+		replication.VerifyStartedAt = time.Now().UTC()
+		return nil
+	})
+	replication.SetStatus(userCred, api.IMAGE_REPLICATION_STATUS_VERIFYING, "")
+}
+
+func (replication *SImageReplication) OnReplicateFailed(ctx context.Context, userCred mcclient.TokenCredential, reason string) {
+	db.Update(replication, func() error {
+		replication.LastError = reason
+		return nil
+	})
+	replication.SetStatus(userCred, api.IMAGE_REPLICATION_STATUS_FAILED, reason)
+}
+
+// sReplicationTarget is the state of a target image checked by verify
+type sReplicationTarget struct {
+	Status     string
+	Checksum   string
+	DiskFormat string
+	Subformats api.ImageReplicationSubformats
+}
+
+// checkTarget returns the status the replication moves to after checking the
+// target image against the checksum of the source image, and the reason of a
+// failure. The target converts the other subformats itself, which may not
+// reproduce our checksums, so only the subformat of the disk format is
+// compared and the others are waited for.
+func (replication *SImageReplication) checkTarget(target sReplicationTarget, checksum string, now time.Time) (string, string) {
+	wait := func(reason string) (string, string) {
+		timeout := time.Duration(options.Options.ImageReplicationVerifyTimeoutSeconds) * time.Second
+		if timeout > 0 && !replication.VerifyStartedAt.IsZero() && now.Sub(replication.VerifyStartedAt) > timeout {
+			return api.IMAGE_REPLICATION_STATUS_FAILED, fmt.Sprintf("%s for more than %s", reason, timeout)
+		}
+		return api.IMAGE_REPLICATION_STATUS_VERIFYING, ""
+	}
+	switch target.Status {
+	case api.IMAGE_STATUS_ACTIVE:
+	case api.IMAGE_STATUS_QUEUED, api.IMAGE_STATUS_SAVING, api.IMAGE_STATUS_CONVERTING, api.IMAGE_STATUS_UPDATING:
+		return wait(fmt.Sprintf("target image stays in status %s", target.Status))
+	default:
+		return api.IMAGE_REPLICATION_STATUS_FAILED, fmt.Sprintf("target image in status %s", target.Status)
+	}
+	if target.Checksum != checksum {
+		return api.IMAGE_REPLICATION_STATUS_FAILED, fmt.Sprintf("target image checksum %s, expect %s", target.Checksum, checksum)
+	}
+	for _, subformat := range target.Subformats {
+		if subformat.Format == target.DiskFormat && len(subformat.Checksum) > 0 && subformat.Checksum != checksum {
+			return api.IMAGE_REPLICATION_STATUS_FAILED, fmt.Sprintf("target subformat %s checksum %s, expect %s", subformat.Format, subformat.Checksum, checksum)
+		}
+		if strings.HasPrefix(subformat.Status, "convert") || subformat.Status == api.IMAGE_STATUS_QUEUED || subformat.Status == api.IMAGE_STATUS_SAVING {
+			return wait(fmt.Sprintf("target subformat %s stays in status %s", subformat.Format, subformat.Status))
+		}
+	}
+	return api.IMAGE_REPLICATION_STATUS_READY, ""
+}
+
+// verify checks whether the target image becomes active with the data of the
+// source image and records its checksum and subformats, a target image which
+// fails the check is dropped so that the retry uploads the data again
+func (replication *SImageReplication) verify(ctx context.Context, userCred mcclient.TokenCredential) error {
+	image, err := replication.GetImage()
+	if err != nil {
+		return errors.Wrap(err, "GetImage")
+	}
+	s := replication.getTargetSession(ctx)
+	ret, err := modules.Images.GetById(s, replication.TargetImageId, nil)
+	if err != nil {
+		if isTargetImageNotFound(err) {
+			replication.OnReplicateFailed(ctx, userCred, "target image not found")
+			return nil
+		}
+		return errors.Wrap(err, "get target image")
+	}
+	target := sReplicationTarget{}
+	target.Status, _ = ret.GetString("status")
+	target.Checksum, _ = ret.GetString("checksum")
+	target.DiskFormat, _ = ret.GetString("disk_format")
+	if target.Status == api.IMAGE_STATUS_ACTIVE {
+		subformats, err := modules.Images.GetSpecific(s, replication.TargetImageId, "subformats", nil)
+		if err != nil {
+			return errors.Wrap(err, "get target subformats")
+		}
+		err = subformats.Unmarshal(&target.Subformats)
+		if err != nil {
+			return errors.Wrap(err, "Unmarshal subformats")
+		}
+	}
+	status, reason := replication.checkTarget(target, image.Checksum, time.Now().UTC())
+	switch status {
+	case api.IMAGE_REPLICATION_STATUS_VERIFYING:
+		return nil
+	case api.IMAGE_REPLICATION_STATUS_FAILED:
+		err := replication.dropTargetImage(s)
+		if err != nil {
+			log.Errorf("drop target image of replication %s fail %s", replication.Name, err)
+		}
+		replication.OnReplicateFailed(ctx, userCred, reason)
+		return nil
+	}
+	_, err = db.Update(replication, func() error {
+		replication.TargetChecksum = target.Checksum
+		replication.TargetSubformats = &target.Subformats
+		replication.ReplicatedAt = time.Now().UTC()
+		return nil
+	})
+	if err != nil {
+		return errors.Wrap(err, "db.Update")
+	}
+	replication.SetStatus(userCred, api.IMAGE_REPLICATION_STATUS_READY, "")
+	return nil
+}
+
+func (manager *SImageReplicationManager) removeByImage(ctx context.Context, userCred mcclient.TokenCredential, imageId string) {
+	q := manager.Query().Equals("image_id", imageId)
+	replications := make([]SImageReplication, 0)
+	err := db.FetchModelObjects(manager, q, &replications)
+	if err != nil {
+		log.Errorf("FetchModelObjects fail %s", err)
+		return
+	}
+	for i := range replications {
+		err := replications[i].Delete(ctx, userCred)
+		if err != nil {
+			log.Errorf("delete replication %s fail %s", replications[i].Name, err)
+		}
+	}
+}
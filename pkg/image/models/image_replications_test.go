@@ -0,0 +1,182 @@
+// Copyright 2019 Yunion
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package models
+
+import (
+	"io"
+	"io/ioutil"
+	"strings"
+	"testing"
+	"time"
+
+	"yunion.io/x/jsonutils"
+
+	"yunion.io/x/onecloud/pkg/apis"
+	api "yunion.io/x/onecloud/pkg/apis/image"
+	"yunion.io/x/onecloud/pkg/image/options"
+)
+
+func TestImageReplicationPolicyMatchedImages(t *testing.T) {
+	policy := &SImageReplicationPolicy{}
+	sql := policy.matchedImagesQuery().String()
+	for _, want := range []string{"`status` = ", "`is_guest_image`", "`pending_deleted`"} {
+		if !strings.Contains(sql, want) {
+			t.Errorf("query without %s: %s", want, sql)
+		}
+	}
+	if strings.Contains(sql, "`tenant_id` IN") {
+		t.Errorf("query of policy without projects filters by project: %s", sql)
+	}
+
+	policy.ProjectIds = jsonutils.NewStringArray([]string{"p1", "p2"})
+	policy.Tags = jsonutils.Marshal([]apis.STag{{Key: "replicate", Value: "yes"}}).(*jsonutils.JSONArray)
+	sql = policy.matchedImagesQuery().String()
+	if !strings.Contains(sql, "`tenant_id` IN") {
+		t.Errorf("query does not filter by projects: %s", sql)
+	}
+	if !strings.Contains(sql, "metadata") {
+		t.Errorf("query does not filter by tags: %s", sql)
+	}
+}
+
+func TestImageReplicationCheckTarget(t *testing.T) {
+	defer func(timeout int) {
+		options.Options.ImageReplicationVerifyTimeoutSeconds = timeout
+	}(options.Options.ImageReplicationVerifyTimeoutSeconds)
+	options.Options.ImageReplicationVerifyTimeoutSeconds = 3600
+
+	now := time.Now().UTC()
+	replication := &SImageReplication{VerifyStartedAt: now.Add(-time.Minute)}
+	stuck := &SImageReplication{VerifyStartedAt: now.Add(-2 * time.Hour)}
+	cases := []struct {
+		name        string
+		replication *SImageReplication
+		target      sReplicationTarget
+		want        string
+	}{
+		{
+			name:        "saving",
+			replication: replication,
+			target:      sReplicationTarget{Status: api.IMAGE_STATUS_SAVING},
+			want:        api.IMAGE_REPLICATION_STATUS_VERIFYING,
+		},
+		{
+			name:        "saving timeout",
+			replication: stuck,
+			target:      sReplicationTarget{Status: api.IMAGE_STATUS_SAVING},
+			want:        api.IMAGE_REPLICATION_STATUS_FAILED,
+		},
+		{
+			name:        "killed",
+			replication: replication,
+			target:      sReplicationTarget{Status: api.IMAGE_STATUS_KILLED},
+			want:        api.IMAGE_REPLICATION_STATUS_FAILED,
+		},
+		{
+			name:        "checksum mismatch",
+			replication: replication,
+			target:      sReplicationTarget{Status: api.IMAGE_STATUS_ACTIVE, Checksum: "bad"},
+			want:        api.IMAGE_REPLICATION_STATUS_FAILED,
+		},
+		{
+			name:        "subformat checksum mismatch",
+			replication: replication,
+			target: sReplicationTarget{
+				Status:     api.IMAGE_STATUS_ACTIVE,
+				Checksum:   "sum",
+				DiskFormat: "qcow2",
+				Subformats: api.ImageReplicationSubformats{{Format: "qcow2", Checksum: "bad", Status: api.IMAGE_STATUS_ACTIVE}},
+			},
+			want: api.IMAGE_REPLICATION_STATUS_FAILED,
+		},
+		{
+			name:        "subformat converting",
+			replication: replication,
+			target: sReplicationTarget{
+				Status:     api.IMAGE_STATUS_ACTIVE,
+				Checksum:   "sum",
+				DiskFormat: "qcow2",
+				Subformats: api.ImageReplicationSubformats{
+					{Format: "qcow2", Checksum: "sum", Status: api.IMAGE_STATUS_ACTIVE},
+					{Format: "vmdk", Status: api.IMAGE_STATUS_CONVERTING},
+				},
+			},
+			want: api.IMAGE_REPLICATION_STATUS_VERIFYING,
+		},
+		{
+			name:        "ready",
+			replication: replication,
+			target: sReplicationTarget{
+				Status:     api.IMAGE_STATUS_ACTIVE,
+				Checksum:   "sum",
+				DiskFormat: "qcow2",
+				Subformats: api.ImageReplicationSubformats{
+					{Format: "qcow2", Checksum: "sum", Status: api.IMAGE_STATUS_ACTIVE},
+					{Format: "vmdk", Checksum: "other", Status: api.IMAGE_STATUS_ACTIVE},
+				},
+			},
+			want: api.IMAGE_REPLICATION_STATUS_READY,
+		},
+	}
+	for _, c := range cases {
+		status, reason := c.replication.checkTarget(c.target, "sum", now)
+		if status != c.want {
+			t.Errorf("%s: want %s, got %s (%s)", c.name, c.want, status, reason)
+		}
+		if status == api.IMAGE_REPLICATION_STATUS_FAILED && len(reason) == 0 {
+			t.Errorf("%s: failed without reason", c.name)
+		}
+	}
+}
+
+func TestNextReplicationChunk(t *testing.T) {
+	cases := []struct {
+		offset    int64
+		size      int64
+		chunkSize int64
+		want      int64
+		final     bool
+	}{
+		{0, 100, 30, 30, false},
+		{60, 100, 30, 30, false},
+		{90, 100, 30, 10, true},
+		{70, 100, 30, 30, true},
+		{100, 100, 30, 0, true},
+		{0, 100, 0, 100, true},
+		{40, 100, 0, 60, true},
+	}
+	for _, c := range cases {
+		n, final := nextReplicationChunk(c.offset, c.size, c.chunkSize)
+		if n != c.want || final != c.final {
+			t.Errorf("offset %d size %d chunk %d: got %d %v, want %d %v", c.offset, c.size, c.chunkSize, n, final, c.want, c.final)
+		}
+	}
+}
+
+func TestSkipImageData(t *testing.T) {
+	for _, reader := range []io.Reader{
+		strings.NewReader("0123456789"),
+		ioutil.NopCloser(strings.NewReader("0123456789")),
+	} {
+		err := skipImageData(reader, 4)
+		if err != nil {
+			t.Fatalf("skipImageData: %v", err)
+		}
+		rest, _ := ioutil.ReadAll(reader)
+		if string(rest) != "456789" {
+			t.Errorf("%T: rest %q", reader, rest)
+		}
+	}
+}
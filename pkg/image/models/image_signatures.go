@@ -0,0 +1,214 @@
+// Copyright 2019 Yunion
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package models
+
+import (
+	"context"
+	"encoding/hex"
+
+	"yunion.io/x/jsonutils"
+	"yunion.io/x/log"
+	"yunion.io/x/pkg/errors"
+	"yunion.io/x/sqlchemy"
+
+	api "yunion.io/x/onecloud/pkg/apis/image"
+	"yunion.io/x/onecloud/pkg/cloudcommon/db"
+	"yunion.io/x/onecloud/pkg/httperrors"
+	"yunion.io/x/onecloud/pkg/image/options"
+	"yunion.io/x/onecloud/pkg/mcclient"
+	"yunion.io/x/onecloud/pkg/util/logclient"
+	"yunion.io/x/onecloud/pkg/util/signutils"
+)
+
+func (self *SImage) getDigests(withBlake2b bool) (*signutils.SDigests, error) {
+	if !withBlake2b && len(self.Sha256Checksum) > 0 {
+		sum, err := hex.DecodeString(self.Sha256Checksum)
+		if err == nil {
+			return &signutils.SDigests{Sha256: sum}, nil
+		}
+	}
+	_, rc, err := GetImage(self.Location)
+	if err != nil {
+		return nil, errors.Wrap(err, "GetImage")
+	}
+	defer rc.Close()
+	return signutils.Digests(rc, withBlake2b)
+}
+
+func (self *SImage) setSignatureStatus(status string, keyId string) error {
+	if self.SignatureStatus == status && self.SigningKeyId == keyId {
+		return nil
+	}
+	_, err := db.Update(self, func() error {
+		self.SignatureStatus = status
+		self.SigningKeyId = keyId
+		return nil
+	})
+	return err
+}
+
+// verifySignature verifies the detached signature of the image with the
+// trusted signing keys of its domain
+func (self *SImage) verifySignature() error {
+	if len(self.Signature) == 0 {
+		self.setSignatureStatus(api.IMAGE_SIGNATURE_STATUS_UNSIGNED, "")
+		if options.Options.RequireImageSignature {
+			return errors.Wrap(httperrors.ErrInvalidStatus, "image is not signed")
+		}
+		return nil
+	}
+	keys, err := ImageSigningKeyManager.getTrustedKeys(self.DomainId)
+	if err != nil {
+		return errors.Wrap(err, "getTrustedKeys")
+	}
+	pubKeys := make([]*signutils.SPublicKey, 0, len(keys))
+	keyIds := make([]string, 0, len(keys))
+	withBlake2b := false
+	for i := range keys {
+		pubKey, err := signutils.ParsePublicKey(keys[i].PublicKey)
+		if err != nil {
+			log.Errorf("invalid signing key %s: %s", keys[i].Id, err)
+			continue
+		}
+		if pubKey.RequireBlake2b() {
+			withBlake2b = true
+		}
+		pubKeys = append(pubKeys, pubKey)
+		keyIds = append(keyIds, keys[i].Id)
+	}
+	if len(pubKeys) == 0 {
+		self.setSignatureStatus(api.IMAGE_SIGNATURE_STATUS_INVALID, "")
+		return errors.Wrap(httperrors.ErrInvalidStatus, "no trusted signing keys in the domain of the image")
+	}
+	digests, err := self.getDigests(withBlake2b)
+	if err != nil {
+		return errors.Wrap(err, "getDigests")
+	}
+	idx, err := signutils.VerifyWithKeys(pubKeys, digests, self.Signature)
+	if err != nil {
+		self.setSignatureStatus(api.IMAGE_SIGNATURE_STATUS_INVALID, "")
+		return errors.Wrap(err, "image signature does not verify with the trusted signing keys")
+	}
+	return self.setSignatureStatus(api.IMAGE_SIGNATURE_STATUS_VERIFIED, keyIds[idx])
+}
+
+// Activate marks the image active after its signature verifies, otherwise
+// the image is marked unverified and cannot be used to create guests
+func (self *SImage) Activate(ctx context.Context, userCred mcclient.TokenCredential, reason string) error {
+	err := self.verifySignature()
+	if err != nil {
+		self.SetStatus(userCred, api.IMAGE_STATUS_UNVERIFIED, err.Error())
+		logclient.AddActionLogWithContext(ctx, self, logclient.ACT_IMAGE_VERIFY, err.Error(), userCred, false)
+		return err
+	}
+	return self.SetStatus(userCred, api.IMAGE_STATUS_ACTIVE, reason)
+}
+
+func (self *SImage) AllowPerformSign(ctx context.Context, userCred mcclient.TokenCredential, query jsonutils.JSONObject, data jsonutils.JSONObject) bool {
+	return db.IsProjectAllowPerform(userCred, self, "sign")
+}
+
+// 设置镜像的分离签名, 签名需要由镜像所在域的可信公钥校验通过
+func (self *SImage) PerformSign(ctx context.Context, userCred mcclient.TokenCredential, query jsonutils.JSONObject, input api.ImagePerformSignInput) (jsonutils.JSONObject, error) {
+	if len(input.Signature) == 0 {
+		return nil, httperrors.NewMissingParameterError("signature")
+	}
+	switch self.Status {
+	case api.IMAGE_STATUS_ACTIVE, api.IMAGE_STATUS_UNVERIFIED:
+	default:
+		return nil, httperrors.NewInvalidStatusError("cannot sign image in status %s", self.Status)
+	}
+	_, err := db.Update(self, func() error {
+		self.Signature = input.Signature
+		return nil
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "db.Update")
+	}
+	db.OpsLog.LogEvent(self, db.ACT_UPDATE, "sign", userCred)
+	if self.Status == api.IMAGE_STATUS_ACTIVE {
+		err = self.Activate(ctx, userCred, "signature verified")
+		if err != nil {
+			return nil, httperrors.NewBadRequestError("%v", err)
+		}
+		return nil, nil
+	}
+	err = self.verifySignature()
+	if err != nil {
+		return nil, httperrors.NewBadRequestError("%v", err)
+	}
+	// unverified images have not been put to the storage
+	return nil, self.StartPutImageTask(ctx, userCred, "")
+}
+
+// activateUnverifiedImages retries the unverified images of the domain
+// after a trusted signing key is added or enabled
+func (manager *SImageManager) activateUnverifiedImages(ctx context.Context, userCred mcclient.TokenCredential, domainId string) {
+	q := manager.Query().Equals("domain_id", domainId).Equals("status", api.IMAGE_STATUS_UNVERIFIED).IsNotEmpty("signature")
+	images := make([]SImage, 0)
+	err := db.FetchModelObjects(manager, q, &images)
+	if err != nil {
+		log.Errorf("fetch unverified images fail %s", err)
+		return
+	}
+	for i := range images {
+		if images[i].verifySignature() == nil {
+			images[i].StartPutImageTask(ctx, userCred, "")
+		}
+	}
+}
+
+// reverifySignedImages verifies the images signed by a signing key again
+// after the key is disabled or deleted
+func (manager *SImageManager) reverifySignedImages(ctx context.Context, userCred mcclient.TokenCredential, keyId string) {
+	q := manager.Query().Equals("signing_key_id", keyId)
+	images := make([]SImage, 0)
+	err := db.FetchModelObjects(manager, q, &images)
+	if err != nil {
+		log.Errorf("fetch signed images fail %s", err)
+		return
+	}
+	for i := range images {
+		if images[i].Status != api.IMAGE_STATUS_ACTIVE {
+			images[i].setSignatureStatus(images[i].SignatureStatus, "")
+			continue
+		}
+		images[i].Activate(ctx, userCred, "signature verified")
+	}
+}
+
+func (manager *SImageManager) fetchSignedImageCounts(keyIds []string) (map[string]int, error) {
+	ret := make(map[string]int)
+	if len(keyIds) == 0 {
+		return ret, nil
+	}
+	images := manager.Query().SubQuery()
+	q := images.Query(
+		images.Field("signing_key_id"),
+		sqlchemy.COUNT("count"),
+	).In("signing_key_id", keyIds).GroupBy(images.Field("signing_key_id"))
+	counts := []struct {
+		SigningKeyId string
+		Count        int
+	}{}
+	err := q.All(&counts)
+	if err != nil {
+		return nil, errors.Wrap(err, "q.All")
+	}
+	for _, cnt := range counts {
+		ret[cnt.SigningKeyId] = cnt.Count
+	}
+	return ret, nil
+}
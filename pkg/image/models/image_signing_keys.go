@@ -0,0 +1,235 @@
+// Copyright 2019 Yunion
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package models
+
+import (
+	"context"
+
+	"yunion.io/x/jsonutils"
+	"yunion.io/x/log"
+	"yunion.io/x/pkg/errors"
+	"yunion.io/x/pkg/tristate"
+	"yunion.io/x/sqlchemy"
+
+	"yunion.io/x/onecloud/pkg/apis"
+	api "yunion.io/x/onecloud/pkg/apis/image"
+	"yunion.io/x/onecloud/pkg/cloudcommon/db"
+	"yunion.io/x/onecloud/pkg/httperrors"
+	"yunion.io/x/onecloud/pkg/mcclient"
+	"yunion.io/x/onecloud/pkg/util/signutils"
+	"yunion.io/x/onecloud/pkg/util/stringutils2"
+)
+
+type SImageSigningKeyManager struct {
+	db.SDomainLevelResourceBaseManager
+	db.SEnabledResourceBaseManager
+}
+
+var ImageSigningKeyManager *SImageSigningKeyManager
+
+func init() {
+	ImageSigningKeyManager = &SImageSigningKeyManager{
+		SDomainLevelResourceBaseManager: db.NewDomainLevelResourceBaseManager(
+			SImageSigningKey{},
+			"image_signing_keys_tbl",
+			"image_signing_key",
+			"image_signing_keys",
+		),
+	}
+	ImageSigningKeyManager.SetVirtualObject(ImageSigningKeyManager)
+}
+
+// SImageSigningKey is a trusted public key of a domain, the images of the
+// domain with a detached signature must verify with one of the enabled keys
+type SImageSigningKey struct {
+	db.SDomainLevelResourceBase
+	db.SEnabledResourceBase
+
+	// 公钥, PEM格式或者minisign格式
+	PublicKey string `type:"text" nullable:"false" list:"domain" create:"domain_required"`
+	// 公钥算法
+	Algorithm string `width:"16" charset:"ascii" nullable:"false" list:"domain" create:"domain_optional"`
+	// 公钥指纹
+	Fingerprint string `width:"64" charset:"ascii" nullable:"false" index:"true" list:"domain" create:"domain_optional"`
+}
+
+func (manager *SImageSigningKeyManager) ValidateCreateData(ctx context.Context, userCred mcclient.TokenCredential, ownerId mcclient.IIdentityProvider, query jsonutils.JSONObject, input api.ImageSigningKeyCreateInput) (api.ImageSigningKeyCreateInput, error) {
+	var err error
+	input.DomainLevelResourceCreateInput, err = manager.SDomainLevelResourceBaseManager.ValidateCreateData(ctx, userCred, ownerId, query, input.DomainLevelResourceCreateInput)
+	if err != nil {
+		return input, errors.Wrap(err, "SDomainLevelResourceBaseManager.ValidateCreateData")
+	}
+	if len(input.PublicKey) == 0 {
+		return input, httperrors.NewMissingParameterError("public_key")
+	}
+	key, err := signutils.ParsePublicKey(input.PublicKey)
+	if err != nil {
+		return input, httperrors.NewInputParameterError("invalid public_key: %s", err)
+	}
+	cnt, err := manager.Query().Equals("domain_id", ownerId.GetProjectDomainId()).Equals("fingerprint", key.Fingerprint).CountWithError()
+	if err != nil {
+		return input, errors.Wrap(err, "CountWithError")
+	}
+	if cnt > 0 {
+		return input, httperrors.NewDuplicateResourceError("public key %s already exists", key.Fingerprint)
+	}
+	input.Algorithm = key.Algorithm
+	input.Fingerprint = key.Fingerprint
+	return input, nil
+}
+
+func (key *SImageSigningKey) CustomizeCreate(ctx context.Context, userCred mcclient.TokenCredential, ownerId mcclient.IIdentityProvider, query jsonutils.JSONObject, data jsonutils.JSONObject) error {
+	input := api.ImageSigningKeyCreateInput{}
+	data.Unmarshal(&input)
+	if input.Disabled != nil && *input.Disabled {
+		key.Enabled = tristate.False
+	} else {
+		key.Enabled = tristate.True
+	}
+	return key.SDomainLevelResourceBase.CustomizeCreate(ctx, userCred, ownerId, query, data)
+}
+
+func (key *SImageSigningKey) PostCreate(ctx context.Context, userCred mcclient.TokenCredential, ownerId mcclient.IIdentityProvider, query jsonutils.JSONObject, data jsonutils.JSONObject) {
+	key.SDomainLevelResourceBase.PostCreate(ctx, userCred, ownerId, query, data)
+	if key.Enabled.IsTrue() {
+		ImageManager.activateUnverifiedImages(ctx, userCred, key.DomainId)
+	}
+}
+
+func (key *SImageSigningKey) ValidateUpdateData(ctx context.Context, userCred mcclient.TokenCredential, query jsonutils.JSONObject, input api.ImageSigningKeyUpdateInput) (api.ImageSigningKeyUpdateInput, error) {
+	var err error
+	input.DomainLevelResourceBaseUpdateInput, err = key.SDomainLevelResourceBase.ValidateUpdateData(ctx, userCred, query, input.DomainLevelResourceBaseUpdateInput)
+	if err != nil {
+		return input, errors.Wrap(err, "SDomainLevelResourceBase.ValidateUpdateData")
+	}
+	return input, nil
+}
+
+func (key *SImageSigningKey) PostDelete(ctx context.Context, userCred mcclient.TokenCredential) {
+	key.SDomainLevelResourceBase.PostDelete(ctx, userCred)
+	ImageManager.reverifySignedImages(ctx, userCred, key.Id)
+}
+
+func (key *SImageSigningKey) AllowPerformEnable(ctx context.Context, userCred mcclient.TokenCredential, query jsonutils.JSONObject, data jsonutils.JSONObject) bool {
+	return db.IsDomainAllowPerform(userCred, key, "enable")
+}
+
+func (key *SImageSigningKey) PerformEnable(ctx context.Context, userCred mcclient.TokenCredential, query jsonutils.JSONObject, input apis.PerformEnableInput) (jsonutils.JSONObject, error) {
+	err := db.EnabledPerformEnable(key, ctx, userCred, true)
+	if err != nil {
+		return nil, errors.Wrap(err, "EnabledPerformEnable")
+	}
+	ImageManager.activateUnverifiedImages(ctx, userCred, key.DomainId)
+	return nil, nil
+}
+
+func (key *SImageSigningKey) AllowPerformDisable(ctx context.Context, userCred mcclient.TokenCredential, query jsonutils.JSONObject, data jsonutils.JSONObject) bool {
+	return db.IsDomainAllowPerform(userCred, key, "disable")
+}
+
+// 禁用公钥后, 由此公钥校验的镜像需要重新校验签名
+func (key *SImageSigningKey) PerformDisable(ctx context.Context, userCred mcclient.TokenCredential, query jsonutils.JSONObject, input apis.PerformDisableInput) (jsonutils.JSONObject, error) {
+	err := db.EnabledPerformEnable(key, ctx, userCred, false)
+	if err != nil {
+		return nil, errors.Wrap(err, "EnabledPerformEnable")
+	}
+	ImageManager.reverifySignedImages(ctx, userCred, key.Id)
+	return nil, nil
+}
+
+// 镜像签名公钥列表
+func (manager *SImageSigningKeyManager) ListItemFilter(
+	ctx context.Context,
+	q *sqlchemy.SQuery,
+	userCred mcclient.TokenCredential,
+	query api.ImageSigningKeyListInput,
+) (*sqlchemy.SQuery, error) {
+	var err error
+	q, err = manager.SDomainLevelResourceBaseManager.ListItemFilter(ctx, q, userCred, query.DomainLevelResourceListInput)
+	if err != nil {
+		return nil, errors.Wrap(err, "SDomainLevelResourceBaseManager.ListItemFilter")
+	}
+	q, err = manager.SEnabledResourceBaseManager.ListItemFilter(ctx, q, userCred, query.EnabledResourceBaseListInput)
+	if err != nil {
+		return nil, errors.Wrap(err, "SEnabledResourceBaseManager.ListItemFilter")
+	}
+	if len(query.Algorithm) > 0 {
+		q = q.In("algorithm", query.Algorithm)
+	}
+	if len(query.Fingerprint) > 0 {
+		q = q.Equals("fingerprint", query.Fingerprint)
+	}
+	return q, nil
+}
+
+func (manager *SImageSigningKeyManager) OrderByExtraFields(
+	ctx context.Context,
+	q *sqlchemy.SQuery,
+	userCred mcclient.TokenCredential,
+	query api.ImageSigningKeyListInput,
+) (*sqlchemy.SQuery, error) {
+	q, err := manager.SDomainLevelResourceBaseManager.OrderByExtraFields(ctx, q, userCred, query.DomainLevelResourceListInput)
+	if err != nil {
+		return nil, errors.Wrap(err, "SDomainLevelResourceBaseManager.OrderByExtraFields")
+	}
+	return q, nil
+}
+
+func (manager *SImageSigningKeyManager) QueryDistinctExtraField(q *sqlchemy.SQuery, field string) (*sqlchemy.SQuery, error) {
+	q, err := manager.SDomainLevelResourceBaseManager.QueryDistinctExtraField(q, field)
+	if err == nil {
+		return q, nil
+	}
+	return q, httperrors.ErrNotFound
+}
+
+func (manager *SImageSigningKeyManager) FetchCustomizeColumns(
+	ctx context.Context,
+	userCred mcclient.TokenCredential,
+	query jsonutils.JSONObject,
+	objs []interface{},
+	fields stringutils2.SSortedStrings,
+	isList bool,
+) []api.ImageSigningKeyDetails {
+	rows := make([]api.ImageSigningKeyDetails, len(objs))
+	domainRows := manager.SDomainLevelResourceBaseManager.FetchCustomizeColumns(ctx, userCred, query, objs, fields, isList)
+	keyIds := make([]string, len(objs))
+	for i := range rows {
+		rows[i] = api.ImageSigningKeyDetails{
+			DomainLevelResourceDetails: domainRows[i],
+		}
+		keyIds[i] = objs[i].(*SImageSigningKey).Id
+	}
+	counts, err := ImageManager.fetchSignedImageCounts(keyIds)
+	if err != nil {
+		log.Errorf("fetchSignedImageCounts fail %s", err)
+		return rows
+	}
+	for i := range rows {
+		rows[i].SignedImageCount = counts[keyIds[i]]
+	}
+	return rows
+}
+
+// getTrustedKeys returns the enabled signing keys of the domain
+func (manager *SImageSigningKeyManager) getTrustedKeys(domainId string) ([]SImageSigningKey, error) {
+	q := manager.Query().Equals("domain_id", domainId).IsTrue("enabled")
+	keys := make([]SImageSigningKey, 0)
+	err := db.FetchModelObjects(manager, q, &keys)
+	if err != nil {
+		return nil, errors.Wrap(err, "FetchModelObjects")
+	}
+	return keys, nil
+}
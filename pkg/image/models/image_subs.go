@@ -64,6 +64,8 @@ type SImageSubformat struct {
 	FastHash string `width:"32" charset:"ascii" nullable:"true"`
 	Status   string `nullable:"false"`
 
+	Sha256Checksum string `width:"64" charset:"ascii" nullable:"true"`
+
 	TorrentSize     int64  `nullable:"true"`
 	TorrentLocation string `nullable:"true"`
 	TorrentChecksum string `width:"32" charset:"ascii" nullable:"true"`
@@ -143,9 +145,9 @@ func (self *SImageSubformat) Save(image *SImage) error {
 		log.Errorf("img.Clone fail %s", err)
 		return err
 	}
-	checksum, err := fileutils2.MD5(location)
+	checksum, sha256sum, err := fileutils2.MD5SHA256(location)
 	if err != nil {
-		log.Errorf("fileutils2.Md5 fail %s", err)
+		log.Errorf("fileutils2.MD5SHA256 fail %s", err)
 		return err
 	}
 	fastHash, err := fileutils2.FastCheckSum(location)
@@ -156,6 +158,7 @@ func (self *SImageSubformat) Save(image *SImage) error {
 	_, err = db.Update(self, func() error {
 		self.Location = fmt.Sprintf("%s%s", LocalFilePrefix, location)
 		self.Checksum = checksum
+		self.Sha256Checksum = sha256sum
 		self.FastHash = fastHash
 		self.Size = nimg.ActualSizeBytes
 		return nil
@@ -256,10 +259,11 @@ func (self *SImageSubformat) RemoveFiles() error {
 type SImageSubformatDetails struct {
 	Format string
 
-	Size     int64
-	Checksum string
-	FastHash string
-	Status   string
+	Size           int64
+	Checksum       string
+	Sha256Checksum string
+	FastHash       string
+	Status         string
 
 	TorrentSize     int64
 	TorrentChecksum string
@@ -274,6 +278,7 @@ func (self *SImageSubformat) GetDetails() SImageSubformatDetails {
 	details.Format = self.Format
 	details.Size = self.Size
 	details.Checksum = self.Checksum
+	details.Sha256Checksum = self.Sha256Checksum
 	details.FastHash = self.FastHash
 	details.Status = self.Status
 	details.TorrentSize = self.TorrentSize
@@ -289,11 +294,11 @@ func (self *SImageSubformat) GetDetails() SImageSubformatDetails {
 }
 
 func (self *SImageSubformat) isActive(useFast bool) bool {
-	return isActive(self.GetLocalLocation(), self.Size, self.Checksum, self.FastHash, useFast)
+	return isActive(self.GetLocalLocation(), self.Size, self.Checksum, self.Sha256Checksum, self.FastHash, useFast)
 }
 
 func (self *SImageSubformat) isTorrentActive() bool {
-	return isActive(self.getLocalTorrentLocation(), self.TorrentSize, self.TorrentChecksum, "", false)
+	return isActive(self.getLocalTorrentLocation(), self.TorrentSize, self.TorrentChecksum, "", "", false)
 }
 
 func (self *SImageSubformat) SetStatus(status string) error {
@@ -332,6 +337,20 @@ func (self *SImageSubformat) checkStatus(useFast bool) {
 					}
 				}
 			}
+			if len(self.Sha256Checksum) == 0 {
+				sha256sum, err := fileutils2.SHA256(self.GetLocalLocation())
+				if err != nil {
+					log.Errorf("checkStatus fileutils2.SHA256 fail %s", err)
+				} else {
+					_, err := db.Update(self, func() error {
+						self.Sha256Checksum = sha256sum
+						return nil
+					})
+					if err != nil {
+						log.Errorf("checkStatus save Sha256Checksum fail %s", err)
+					}
+				}
+			}
 		} else {
 			if self.Status != api.IMAGE_STATUS_QUEUED {
 				self.SetStatus(api.IMAGE_STATUS_QUEUED)
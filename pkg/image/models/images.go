@@ -48,6 +48,7 @@ import (
 	"yunion.io/x/onecloud/pkg/mcclient/modulebase"
 	"yunion.io/x/onecloud/pkg/mcclient/modules"
 	"yunion.io/x/onecloud/pkg/util/fileutils2"
+	"yunion.io/x/onecloud/pkg/util/httputils"
 	"yunion.io/x/onecloud/pkg/util/logclient"
 	"yunion.io/x/onecloud/pkg/util/procutils"
 	"yunion.io/x/onecloud/pkg/util/qemuimg"
@@ -141,6 +142,15 @@ type SImage struct {
 	// image copy from url, save origin checksum before probe
 	// 从镜像时长导入的镜像校验和
 	OssChecksum string `width:"32" charset:"ascii" nullable:"true" get:"user" list:"user"`
+
+	// SHA-256 校验和
+	Sha256Checksum string `width:"64" charset:"ascii" nullable:"true" get:"user" list:"user"`
+	// 镜像的分离签名, 签名可能有多行, 不通过header返回
+	Signature string `type:"text" nullable:"true" create:"optional"`
+	// 签名校验状态
+	SignatureStatus string `width:"16" charset:"ascii" nullable:"true" get:"user" list:"user"`
+	// 校验签名的公钥ID
+	SigningKeyId string `width:"36" charset:"ascii" nullable:"true" get:"user" list:"user"`
 }
 
 func (manager *SImageManager) CustomizeHandlerInfo(info *appsrv.SHandlerInfo) {
@@ -345,10 +355,12 @@ func (self *SImage) GetExtraDetailsHeaders(ctx context.Context, userCred mcclien
 				headers[fmt.Sprintf("%s%s", modules.IMAGE_META, "status")] = subimg.Status
 				headers[fmt.Sprintf("%s%s", modules.IMAGE_META, "size")] = fmt.Sprintf("%d", subimg.Size)
 				headers[fmt.Sprintf("%s%s", modules.IMAGE_META, "checksum")] = subimg.Checksum
+				headers[fmt.Sprintf("%s%s", modules.IMAGE_META, "sha256_checksum")] = subimg.Sha256Checksum
 			} else {
 				headers[fmt.Sprintf("%s%s", modules.IMAGE_META, "status")] = subimg.TorrentStatus
 				headers[fmt.Sprintf("%s%s", modules.IMAGE_META, "size")] = fmt.Sprintf("%d", subimg.TorrentSize)
 				headers[fmt.Sprintf("%s%s", modules.IMAGE_META, "checksum")] = subimg.TorrentChecksum
+				delete(headers, fmt.Sprintf("%s%s", modules.IMAGE_META, "sha256_checksum"))
 			}
 		}
 	}
@@ -497,7 +509,57 @@ func (self *SImage) SaveImageFromStream(reader io.Reader, calChecksum bool) erro
 		log.Errorf("saveImageFromStream fail %s", err)
 		return err
 	}
+	return self.saveImageInfo(localPath, sp, calChecksum)
+}
+
+// SaveImageChunk writes a chunk of the upload at offset, which must be the
+// size received so far. Data beyond the received size is left by an
+// interrupted chunk and is overwritten. The image is probed like a whole
+// upload after the final chunk.
+func (self *SImage) SaveImageChunk(reader io.Reader, offset int64, final bool, calChecksum bool) error {
+	if offset != self.Size {
+		return httperrors.NewConflictError("upload offset %d mismatch received size %d", offset, self.Size)
+	}
+	localPath := self.GetPath("")
+	fp, err := os.OpenFile(localPath, os.O_WRONLY|os.O_CREATE, 0644)
+	if err != nil {
+		return errors.Wrap(err, "open image file")
+	}
+	defer fp.Close()
+	err = fp.Truncate(offset)
+	if err != nil {
+		return errors.Wrap(err, "truncate")
+	}
+	_, err = fp.Seek(offset, io.SeekStart)
+	if err != nil {
+		return errors.Wrap(err, "seek")
+	}
+	written, err := io.Copy(fp, reader)
+	if err != nil {
+		return errors.Wrap(err, "write chunk")
+	}
+	err = fp.Sync()
+	if err != nil {
+		return errors.Wrap(err, "sync")
+	}
+	err = self.saveSize(offset + written)
+	if err != nil {
+		return err
+	}
+	if !final {
+		return nil
+	}
+	sp := &streamutils.SStreamProperty{Size: self.Size}
+	if calChecksum {
+		sp.CheckSum, sp.Sha256CheckSum, err = fileutils2.MD5SHA256(localPath)
+		if err != nil {
+			return errors.Wrap(err, "checksum")
+		}
+	}
+	return self.saveImageInfo(localPath, sp, calChecksum)
+}
 
+func (self *SImage) saveImageInfo(localPath string, sp *streamutils.SStreamProperty, calChecksum bool) error {
 	virtualSizeBytes := int64(0)
 	format := ""
 	img, err := qemuimg.NewQemuImage(localPath)
@@ -519,6 +581,7 @@ func (self *SImage) SaveImageFromStream(reader io.Reader, calChecksum bool) erro
 		self.Size = sp.Size
 		if calChecksum {
 			self.Checksum = sp.CheckSum
+			self.Sha256Checksum = sp.Sha256CheckSum
 			self.FastHash = fastChksum
 		}
 		self.Location = fmt.Sprintf("%s%s", LocalFilePrefix, localPath)
@@ -639,19 +702,11 @@ func (self *SImage) ValidateUpdateData(ctx context.Context, userCred mcclient.To
 			if self.IsData.IsTrue() {
 				isProbe = false
 			}
-			if appParams.Request.ContentLength > 0 {
-				self.SetStatus(userCred, api.IMAGE_STATUS_SAVING, "update start upload")
-				// If isProbe is true calculating checksum is not necessary wheng saving from stream,
-				// otherwise, it is needed.
-				err := self.SaveImageFromStream(appParams.Request.Body, !isProbe)
-				if err != nil {
-					self.OnSaveFailed(ctx, userCred, jsonutils.NewString(fmt.Sprintf("update upload failed %s", err)))
-					return nil, httperrors.NewGeneralError(err)
-				}
+			onUploaded := func() {
 				self.OnSaveSuccess(ctx, userCred, "update upload success")
 				if !isProbe {
 					// no probe
-					self.SetStatus(userCred, api.IMAGE_STATUS_ACTIVE, "data disk image upload success")
+					self.Activate(ctx, userCred, "data disk image upload success")
 				} else {
 					data.Remove("status")
 					// For guest image, DoConvertAfterProbe is not necessary.
@@ -661,6 +716,38 @@ func (self *SImage) ValidateUpdateData(ctx context.Context, userCred mcclient.To
 						self.ImageProbeAndCustomization(ctx, userCred, true)
 					}
 				}
+			}
+			if data.Contains(api.IMAGE_UPLOAD_OFFSET) {
+				offset, err := data.Int(api.IMAGE_UPLOAD_OFFSET)
+				if err != nil {
+					return nil, httperrors.NewInputParameterError("invalid %s", api.IMAGE_UPLOAD_OFFSET)
+				}
+				final := jsonutils.QueryBoolean(data, api.IMAGE_UPLOAD_FINAL, false)
+				data.Remove(api.IMAGE_UPLOAD_OFFSET)
+				data.Remove(api.IMAGE_UPLOAD_FINAL)
+				// a failed chunk keeps the image queued, the upload resumes
+				// from the received size
+				err = self.SaveImageChunk(appParams.Request.Body, offset, final, !isProbe)
+				if err != nil {
+					if httputils.ErrorCode(err) == http.StatusConflict {
+						return nil, err
+					}
+					return nil, httperrors.NewGeneralError(err)
+				}
+				if final {
+					self.SetStatus(userCred, api.IMAGE_STATUS_SAVING, "update chunked upload")
+					onUploaded()
+				}
+			} else if appParams.Request.ContentLength > 0 {
+				self.SetStatus(userCred, api.IMAGE_STATUS_SAVING, "update start upload")
+				// If isProbe is true calculating checksum is not necessary wheng saving from stream,
+				// otherwise, it is needed.
+				err := self.SaveImageFromStream(appParams.Request.Body, !isProbe)
+				if err != nil {
+					self.OnSaveFailed(ctx, userCred, jsonutils.NewString(fmt.Sprintf("update upload failed %s", err)))
+					return nil, httperrors.NewGeneralError(err)
+				}
+				onUploaded()
 			} else {
 				copyFrom := appParams.Request.Header.Get(modules.IMAGE_META_COPY_FROM)
 				if len(copyFrom) > 0 {
@@ -738,6 +825,7 @@ func (self *SImage) Delete(ctx context.Context, userCred mcclient.TokenCredentia
 }
 
 func (self *SImage) RealDelete(ctx context.Context, userCred mcclient.TokenCredential) error {
+	ImageReplicationManager.removeByImage(ctx, userCred, self.Id)
 	return self.SSharableVirtualResourceBase.Delete(ctx, userCred)
 }
 
@@ -890,11 +978,12 @@ func (self *SImage) DoCancelPendingDelete(ctx context.Context, userCred mcclient
 	if err != nil {
 		return err
 	}
-	_, err = db.Update(self, func() error {
-		self.Status = api.IMAGE_STATUS_ACTIVE
-		return nil
-	})
-	return err
+	// the signature is checked again, an image failed to verify stays unverified
+	err = self.Activate(ctx, userCred, "cancel delete")
+	if err != nil {
+		log.Warningf("image %s is not activated after cancel delete: %s", self.Id, err)
+	}
+	return nil
 }
 
 type SImageUsage struct {
@@ -1014,6 +1103,7 @@ func (self *SImage) NewSubformat(ctx context.Context, format qemuimg.TImageForma
 	if migrate {
 		subformat.Size = self.Size
 		subformat.Checksum = self.Checksum
+		subformat.Sha256Checksum = self.Sha256Checksum
 		subformat.FastHash = self.FastHash
 		subformat.Status = self.Status
 		subformat.Location = self.Location
@@ -1302,7 +1392,8 @@ func (manager *SImageManager) QueryDistinctExtraField(q *sqlchemy.SQuery, field
 	return q, httperrors.ErrNotFound
 }
 
-func isActive(localPath string, size int64, chksum string, fastHash string, useFastHash bool) bool {
+// isActive verifies the sha256 checksum if present, otherwise the md5 checksum
+func isActive(localPath string, size int64, chksum string, sha256sum string, fastHash string, useFastHash bool) bool {
 	if len(localPath) == 0 || !fileutils2.Exists(localPath) {
 		log.Errorf("invalid file: %s", localPath)
 		return false
@@ -1321,6 +1412,16 @@ func isActive(localPath string, size int64, chksum string, fastHash string, useF
 			log.Errorf("IsActive fastChecksum mismatch for %s", localPath)
 			return false
 		}
+	} else if len(sha256sum) > 0 {
+		localSum, err := fileutils2.SHA256(localPath)
+		if err != nil {
+			log.Errorf("IsActive sha256 fail %s for %s", err, localPath)
+			return false
+		}
+		if sha256sum != localSum {
+			log.Errorf("IsActive sha256 checksum mismatch: %s", localPath)
+			return false
+		}
 	} else {
 		md5sum, err := fileutils2.MD5(localPath)
 		if err != nil {
@@ -1347,7 +1448,7 @@ func (self *SImage) IsIso() (error, bool) {
 }
 
 func (self *SImage) isActive(useFast bool) bool {
-	return isActive(self.GetLocalLocation(), self.Size, self.Checksum, self.FastHash, useFast)
+	return isActive(self.GetLocalLocation(), self.Size, self.Checksum, self.Sha256Checksum, self.FastHash, useFast)
 }
 
 func (self *SImage) DoCheckStatus(ctx context.Context, userCred mcclient.TokenCredential, useFast bool) {
@@ -1356,9 +1457,6 @@ func (self *SImage) DoCheckStatus(ctx context.Context, userCred mcclient.TokenCr
 	}
 	if IsCheckStatusEnabled(self) {
 		if self.isActive(useFast) {
-			if self.Status != api.IMAGE_STATUS_ACTIVE {
-				self.SetStatus(userCred, api.IMAGE_STATUS_ACTIVE, "check active")
-			}
 			if len(self.FastHash) == 0 {
 				fastHash, err := fileutils2.FastCheckSum(self.GetLocalLocation())
 				if err != nil {
@@ -1373,6 +1471,23 @@ func (self *SImage) DoCheckStatus(ctx context.Context, userCred mcclient.TokenCr
 					}
 				}
 			}
+			if len(self.Sha256Checksum) == 0 {
+				sha256sum, err := fileutils2.SHA256(self.GetLocalLocation())
+				if err != nil {
+					log.Errorf("DoCheckStatus fileutils2.SHA256 fail %s", err)
+				} else {
+					_, err := db.Update(self, func() error {
+						self.Sha256Checksum = sha256sum
+						return nil
+					})
+					if err != nil {
+						log.Errorf("DoCheckStatus save Sha256Checksum fail %s", err)
+					}
+				}
+			}
+			if self.Status != api.IMAGE_STATUS_ACTIVE {
+				self.Activate(ctx, userCred, "check active")
+			}
 			img, err := qemuimg.NewQemuImage(self.GetLocalLocation())
 			if err == nil {
 				format := string(img.Format)
@@ -51,6 +51,13 @@ type SImageOptions struct {
 	S3BucketName       string `help:"s3 bucket name" default:"onecloud-images"`
 	S3MountPoint       string `help:"s3fs mount point" default:"/opt/cloud/workspace/data/glance/s3images"`
 	S3CheckImageStatus bool   `help:"Enable s3 check image status"`
+
+	ImageReplicationSyncIntervalSeconds  int `help:"interval in seconds to sync image replications to peer regions" default:"300"`
+	ImageReplicationMaxAttempts          int `help:"max attempts of a failed image replication before manual retry" default:"5"`
+	ImageReplicationVerifyTimeoutSeconds int `help:"a replication fails if its target image is not active within the given seconds after uploaded" default:"3600"`
+	ImageReplicationChunkSizeMb          int `help:"size in MB of the chunks uploaded to the target image, an interrupted upload resumes from the last uploaded chunk" default:"64"`
+
+	RequireImageSignature bool `help:"Images must be signed by a trusted signing key of their domain before becoming active" default:"false"`
 }
 
 var (
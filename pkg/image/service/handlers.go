@@ -31,6 +31,8 @@ const (
 func InitHandlers(app *appsrv.Application) {
 	db.InitAllManagers()
 
+	db.AddOpenApiHandler(API_VERSION, app)
+
 	// add version handler with API_VERSION prefix
 	app.AddDefaultHandler("GET", API_VERSION+"/version", appsrv.VersionHandler, "version")
 
@@ -71,6 +73,9 @@ func InitHandlers(app *appsrv.Application) {
 		models.ImageManager,
 
 		models.GuestImageManager,
+		models.ImageReplicationPolicyManager,
+		models.ImageReplicationManager,
+		models.ImageSigningKeyManager,
 	} {
 		db.RegisterModelManager(manager)
 		handler := db.NewModelHandler(manager)
@@ -116,6 +116,8 @@ func StartService() {
 		cron.AddJobAtIntervals("CalculateQuotaUsages", time.Duration(opts.CalculateQuotaUsageIntervalSeconds)*time.Second, models.QuotaManager.CalculateQuotaUsages)
 		cron.AddJobAtIntervals("CleanPendingDeleteGuestImages",
 			time.Duration(options.Options.PendingDeleteCheckSeconds)*time.Second, models.GuestImageManager.CleanPendingDeleteImages)
+		cron.AddJobAtIntervalsWithStartRun("SyncImageReplications",
+			time.Duration(options.Options.ImageReplicationSyncIntervalSeconds)*time.Second, models.ImageReplicationPolicyManager.SyncImageReplications, true)
 
 		cron.Start()
 	}
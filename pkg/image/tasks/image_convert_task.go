@@ -95,7 +95,11 @@ func (self *PutImageTask) OnInit(ctx context.Context, obj db.IStandaloneModel, d
 			}
 		}
 	}
-	image.SetStatus(self.UserCred, api.IMAGE_STATUS_ACTIVE, "save image to specific storage complete")
+	err := image.Activate(ctx, self.UserCred, "save image to specific storage complete")
+	if err != nil {
+		self.SetStageFailed(ctx, jsonutils.NewString(err.Error()))
+		return
+	}
 	if oldStatus != api.IMAGE_STATUS_ACTIVE {
 		kwargs := jsonutils.NewDict()
 		kwargs.Set("name", jsonutils.NewString(image.GetName()))
@@ -107,7 +107,7 @@ func (self *ImageProbeTask) updateImageMetadata(
 		return err
 	}
 
-	chksum, err := fileutils2.MD5(imagePath)
+	chksum, sha256sum, err := fileutils2.MD5SHA256(imagePath)
 	if err != nil {
 		return err
 	}
@@ -120,6 +120,7 @@ func (self *ImageProbeTask) updateImageMetadata(
 	_, err = db.Update(image, func() error {
 		image.Size = stat.Size()
 		image.Checksum = chksum
+		image.Sha256Checksum = sha256sum
 		image.FastHash = fastchksum
 		return nil
 	})
@@ -0,0 +1,54 @@
+// Copyright 2019 Yunion
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package tasks
+
+import (
+	"context"
+
+	"yunion.io/x/jsonutils"
+
+	"yunion.io/x/onecloud/pkg/cloudcommon/db"
+	"yunion.io/x/onecloud/pkg/cloudcommon/db/taskman"
+	"yunion.io/x/onecloud/pkg/image/models"
+)
+
+type ImageReplicateTask struct {
+	taskman.STask
+}
+
+func init() {
+	taskman.RegisterTask(ImageReplicateTask{})
+}
+
+func (self *ImageReplicateTask) OnInit(ctx context.Context, obj db.IStandaloneModel, data jsonutils.JSONObject) {
+	replication := obj.(*models.SImageReplication)
+
+	self.SetStage("OnReplicateComplete", nil)
+	taskman.LocalTaskRun(self, func() (jsonutils.JSONObject, error) {
+		return nil, replication.Replicate(ctx)
+	})
+}
+
+func (self *ImageReplicateTask) OnReplicateComplete(ctx context.Context, obj db.IStandaloneModel, data jsonutils.JSONObject) {
+	replication := obj.(*models.SImageReplication)
+	replication.OnReplicateSuccess(ctx, self.UserCred)
+	self.SetStageComplete(ctx, nil)
+}
+
+func (self *ImageReplicateTask) OnReplicateCompleteFailed(ctx context.Context, obj db.IStandaloneModel, err jsonutils.JSONObject) {
+	replication := obj.(*models.SImageReplication)
+	replication.OnReplicateFailed(ctx, self.UserCred, err.String())
+	self.SetStageFailed(ctx, err)
+}
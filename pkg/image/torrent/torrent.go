@@ -21,6 +21,7 @@ import (
 
 	"yunion.io/x/log"
 
+	"yunion.io/x/onecloud/pkg/apis"
 	identity_apis "yunion.io/x/onecloud/pkg/apis/identity"
 	"yunion.io/x/onecloud/pkg/appsrv"
 	"yunion.io/x/onecloud/pkg/image/options"
@@ -39,7 +40,7 @@ var (
 )
 
 const (
-	TORRENT_TRACKER_SERVICE = "torrent-tracker"
+	TORRENT_TRACKER_SERVICE = apis.SERVICE_TYPE_TORRENT_TRACKER
 )
 
 func init() {
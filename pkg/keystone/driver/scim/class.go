@@ -0,0 +1,74 @@
+// Copyright 2019 Yunion
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package scim
+
+import (
+	"context"
+
+	"yunion.io/x/jsonutils"
+	"yunion.io/x/pkg/errors"
+
+	api "yunion.io/x/onecloud/pkg/apis/identity"
+	"yunion.io/x/onecloud/pkg/httperrors"
+	"yunion.io/x/onecloud/pkg/keystone/driver"
+	"yunion.io/x/onecloud/pkg/mcclient"
+)
+
+type SSCIMDriverClass struct{}
+
+func (self *SSCIMDriverClass) IsSso() bool {
+	return false
+}
+
+func (self *SSCIMDriverClass) ForceSyncUser() bool {
+	return true
+}
+
+func (self *SSCIMDriverClass) GetDefaultIconUri(tmpName string) string {
+	return ""
+}
+
+func (self *SSCIMDriverClass) SingletonInstance() bool {
+	return false
+}
+
+func (self *SSCIMDriverClass) SyncMethod() string {
+	return api.IdentityProviderSyncPush
+}
+
+func (self *SSCIMDriverClass) NewDriver(idpId, idpName, template, targetDomainId string, conf api.TConfigs) (driver.IIdentityBackend, error) {
+	return NewSCIMDriver(idpId, idpName, template, targetDomainId, conf)
+}
+
+func (self *SSCIMDriverClass) Name() string {
+	return api.IdentityDriverSCIM
+}
+
+func (self *SSCIMDriverClass) ValidateConfig(ctx context.Context, userCred mcclient.TokenCredential, template string, tconf api.TConfigs, idpId, domainId string) (api.TConfigs, error) {
+	conf := api.SSCIMIdpConfigOptions{}
+	confJson := jsonutils.Marshal(tconf[api.IdentityDriverSCIM])
+	err := confJson.Unmarshal(&conf)
+	if err != nil {
+		return tconf, errors.Wrap(err, "unmarshal config")
+	}
+	if len(conf.BearerToken) < api.SCIMBearerTokenMinLength {
+		return tconf, errors.Wrapf(httperrors.ErrInputParameter, "bearer_token must be at least %d characters", api.SCIMBearerTokenMinLength)
+	}
+	return tconf, nil
+}
+
+func init() {
+	driver.RegisterDriverClass(&SSCIMDriverClass{})
+}
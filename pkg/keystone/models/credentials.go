@@ -16,11 +16,13 @@ package models
 
 import (
 	"context"
+	"crypto/subtle"
 	"database/sql"
 	"fmt"
 	"time"
 
 	"yunion.io/x/jsonutils"
+	"yunion.io/x/log"
 	"yunion.io/x/pkg/errors"
 	"yunion.io/x/pkg/gotypes"
 	"yunion.io/x/pkg/tristate"
@@ -29,10 +31,12 @@ import (
 	"yunion.io/x/onecloud/pkg/apis"
 	api "yunion.io/x/onecloud/pkg/apis/identity"
 	"yunion.io/x/onecloud/pkg/cloudcommon/db"
+	"yunion.io/x/onecloud/pkg/cloudcommon/db/lockman"
 	"yunion.io/x/onecloud/pkg/httperrors"
 	"yunion.io/x/onecloud/pkg/keystone/keys"
 	"yunion.io/x/onecloud/pkg/mcclient"
 	"yunion.io/x/onecloud/pkg/util/rbacutils"
+	"yunion.io/x/onecloud/pkg/util/seclib2"
 	"yunion.io/x/onecloud/pkg/util/stringutils2"
 )
 
@@ -171,6 +175,16 @@ func (self *SCredential) ValidateDeleteCondition(ctx context.Context) error {
 func (self *SCredential) ValidateUpdateData(ctx context.Context, userCred mcclient.TokenCredential, query jsonutils.JSONObject, input api.CredentialUpdateInput) (api.CredentialUpdateInput, error) {
 	var err error
 
+	if len(input.Blob) > 0 {
+		if self.Type != api.WEBAUTHN_CREDENTIAL_TYPE {
+			return input, httperrors.NewForbiddenError("blob of %s credential is readonly", self.Type)
+		}
+		_, err := jsonutils.ParseString(input.Blob)
+		if err != nil {
+			return input, httperrors.NewInputParameterError("invalid blob: %s", err)
+		}
+	}
+
 	input.StandaloneResourceBaseUpdateInput, err = self.SStandaloneResourceBase.ValidateUpdateData(ctx, userCred, query, input.StandaloneResourceBaseUpdateInput)
 	if err != nil {
 		return input, errors.Wrap(err, "SStandaloneResourceBase.ValidateUpdateData")
@@ -179,6 +193,112 @@ func (self *SCredential) ValidateUpdateData(ctx context.Context, userCred mcclie
 	return input, nil
 }
 
+func (self *SCredential) PostUpdate(ctx context.Context, userCred mcclient.TokenCredential, query jsonutils.JSONObject, data jsonutils.JSONObject) {
+	self.SStandaloneResourceBase.PostUpdate(ctx, userCred, query, data)
+
+	blob, _ := data.GetString("blob")
+	if len(blob) > 0 {
+		err := self.setBlob([]byte(blob))
+		if err != nil {
+			log.Errorf("update blob of credential %s fail %s", self.Id, err)
+		}
+	}
+}
+
+func (self *SCredential) setBlob(blob []byte) error {
+	blobEnc, err := keys.CredentialKeyManager.Encrypt(blob)
+	if err != nil {
+		return errors.Wrap(err, "Encrypt")
+	}
+	_, err = db.Update(self, func() error {
+		self.EncryptedBlob = string(blobEnc)
+		self.KeyHash = keys.CredentialKeyManager.PrimaryKeyHash()
+		return nil
+	})
+	return err
+}
+
+func (self *SCredential) AllowPerformConsumeRecoveryCode(ctx context.Context, userCred mcclient.TokenCredential, query jsonutils.JSONObject, data jsonutils.JSONObject) bool {
+	return db.IsAdminAllowPerform(userCred, self, "consume-recovery-code")
+}
+
+// 使用一次性恢复码, 恢复码使用后失效
+func (self *SCredential) PerformConsumeRecoveryCode(ctx context.Context, userCred mcclient.TokenCredential, query jsonutils.JSONObject, input api.CredentialConsumeRecoveryCodeInput) (jsonutils.JSONObject, error) {
+	if self.Type != api.RECOVERY_CODES_TYPE {
+		return nil, httperrors.NewUnsupportOperationError("not a %s credential", api.RECOVERY_CODES_TYPE)
+	}
+	if len(input.Code) == 0 {
+		return nil, httperrors.NewMissingParameterError("code")
+	}
+
+	lockman.LockObject(ctx, self)
+	defer lockman.ReleaseObject(ctx, self)
+
+	obj, err := CredentialManager.FetchById(self.Id)
+	if err != nil {
+		return nil, errors.Wrap(err, "FetchById")
+	}
+	cred := obj.(*SCredential)
+	blob := api.SRecoveryCodesBlob{}
+	blobJson, err := jsonutils.Parse(cred.getBlob())
+	if err != nil {
+		return nil, errors.Wrap(err, "jsonutils.Parse")
+	}
+	err = blobJson.Unmarshal(&blob)
+	if err != nil {
+		return nil, errors.Wrap(err, "blobJson.Unmarshal")
+	}
+	codes, ok := removeRecoveryCode(blob.Codes, seclib2.HashRecoveryCode(input.Code))
+	if !ok {
+		return nil, httperrors.NewInvalidCredentialError("invalid recovery code")
+	}
+	blob.Codes = codes
+	// the blob is replaced only if it is not changed since read, so that a
+	// code consumed by another keystone instance can not be used again
+	ok, err = cred.compareAndSetBlob([]byte(jsonutils.Marshal(&blob).String()))
+	if err != nil {
+		return nil, errors.Wrap(err, "compareAndSetBlob")
+	}
+	if !ok {
+		return nil, httperrors.NewConflictError("recovery codes changed concurrently")
+	}
+	return nil, nil
+}
+
+// removeRecoveryCode returns the code hashes without the given one and
+// whether it is found
+func removeRecoveryCode(codes []string, hash string) ([]string, bool) {
+	for i := range codes {
+		if subtle.ConstantTimeCompare([]byte(codes[i]), []byte(hash)) == 1 {
+			return append(append([]string{}, codes[:i]...), codes[i+1:]...), true
+		}
+	}
+	return codes, false
+}
+
+func compareAndSetBlobSql(table string) string {
+	return fmt.Sprintf("UPDATE `%s` SET `encrypted_blob` = ?, `key_hash` = ?, `updated_at` = ?, `update_version` = `update_version` + 1 WHERE `id` = ? AND `encrypted_blob` = ?", table)
+}
+
+// compareAndSetBlob replaces the blob if the encrypted blob in the database
+// is still the one loaded, returns false otherwise
+func (self *SCredential) compareAndSetBlob(blob []byte) (bool, error) {
+	blobEnc, err := keys.CredentialKeyManager.Encrypt(blob)
+	if err != nil {
+		return false, errors.Wrap(err, "Encrypt")
+	}
+	sqlStr := compareAndSetBlobSql(CredentialManager.TableSpec().Name())
+	result, err := sqlchemy.GetDB().Exec(sqlStr, string(blobEnc), keys.CredentialKeyManager.PrimaryKeyHash(), time.Now().UTC(), self.Id, self.EncryptedBlob)
+	if err != nil {
+		return false, errors.Wrap(err, "Exec")
+	}
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return false, errors.Wrap(err, "RowsAffected")
+	}
+	return affected > 0, nil
+}
+
 func (self *SCredential) GetExtraDetails(
 	ctx context.Context,
 	userCred mcclient.TokenCredential,
@@ -204,7 +324,12 @@ func (manager *SCredentialManager) FetchCustomizeColumns(
 		rows[i] = api.CredentialDetails{
 			StandaloneResourceDetails: stdRows[i],
 		}
-		rows[i] = credentialExtra(objs[i].(*SCredential), rows[i])
+		cred := objs[i].(*SCredential)
+		rows[i] = credentialExtra(cred, rows[i])
+		if cred.Type == api.ENCRYPT_KEY_TYPE && !db.IsAdminAllowGet(userCred, cred) {
+			// master keys are only revealed to services
+			rows[i].Blob = ""
+		}
 	}
 
 	return rows
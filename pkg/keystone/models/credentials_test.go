@@ -0,0 +1,49 @@
+// Copyright 2019 Yunion
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package models
+
+import (
+	"testing"
+
+	"yunion.io/x/onecloud/pkg/util/seclib2"
+)
+
+func TestRemoveRecoveryCode(t *testing.T) {
+	codes := []string{
+		seclib2.HashRecoveryCode("code-1"),
+		seclib2.HashRecoveryCode("code-2"),
+		seclib2.HashRecoveryCode("code-3"),
+	}
+	left, ok := removeRecoveryCode(codes, seclib2.HashRecoveryCode("code-2"))
+	if !ok {
+		t.Fatalf("code-2 should be found")
+	}
+	if len(left) != 2 || left[0] != codes[0] || left[1] != codes[2] {
+		t.Errorf("unexpected codes left %v", left)
+	}
+	if len(codes) != 3 || codes[1] != seclib2.HashRecoveryCode("code-2") {
+		t.Errorf("the original codes should not be changed")
+	}
+	if _, ok := removeRecoveryCode(left, seclib2.HashRecoveryCode("code-2")); ok {
+		t.Errorf("a consumed code should not be found again")
+	}
+}
+
+func TestCompareAndSetBlobSql(t *testing.T) {
+	want := "UPDATE `credential` SET `encrypted_blob` = ?, `key_hash` = ?, `updated_at` = ?, `update_version` = `update_version` + 1 WHERE `id` = ? AND `encrypted_blob` = ?"
+	if got := compareAndSetBlobSql("credential"); got != want {
+		t.Errorf("got %s want %s", got, want)
+	}
+}
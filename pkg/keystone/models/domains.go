@@ -23,6 +23,7 @@ import (
 	"yunion.io/x/log"
 	"yunion.io/x/pkg/errors"
 	"yunion.io/x/pkg/tristate"
+	"yunion.io/x/pkg/utils"
 	"yunion.io/x/sqlchemy"
 
 	api "yunion.io/x/onecloud/pkg/apis/identity"
@@ -66,6 +67,9 @@ type SDomain struct {
 
 	DomainId string `width:"64" charset:"ascii" default:"default" nullable:"false" index:"true"`
 	ParentId string `width:"64" charset:"ascii"`
+
+	// 要求域内用户使用的二次认证方式
+	MfaMethod string `width:"16" charset:"ascii" nullable:"true" list:"admin" update:"admin" create:"admin_optional"`
 }
 
 func (manager *SDomainManager) InitializeData() error {
@@ -339,6 +343,9 @@ func (domain *SDomain) ValidateUpdateData(ctx context.Context, userCred mcclient
 			}
 		}
 	}
+	if len(input.MfaMethod) > 0 && !utils.IsInStringArray(input.MfaMethod, api.MfaMethods) {
+		return input, httperrors.NewInputParameterError("invalid mfa_method %s, must be one of %s", input.MfaMethod, api.MfaMethods)
+	}
 	var err error
 	input.StandaloneResourceBaseUpdateInput, err = domain.SStandaloneResourceBase.ValidateUpdateData(ctx, userCred, query, input.StandaloneResourceBaseUpdateInput)
 	if err != nil {
@@ -524,6 +531,9 @@ func (manager *SDomainManager) ValidateCreateData(
 	query jsonutils.JSONObject,
 	input api.DomainCreateInput,
 ) (api.DomainCreateInput, error) {
+	if len(input.MfaMethod) > 0 && !utils.IsInStringArray(input.MfaMethod, api.MfaMethods) {
+		return input, httperrors.NewInputParameterError("invalid mfa_method %s, must be one of %s", input.MfaMethod, api.MfaMethods)
+	}
 	var err error
 
 	input.StandaloneResourceCreateInput, err = manager.SStandaloneResourceBaseManager.ValidateCreateData(ctx, userCred, ownerId, query, input.StandaloneResourceCreateInput)
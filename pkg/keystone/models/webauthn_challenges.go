@@ -0,0 +1,140 @@
+// Copyright 2019 Yunion
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package models
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"time"
+
+	"yunion.io/x/jsonutils"
+	"yunion.io/x/log"
+	"yunion.io/x/pkg/errors"
+	"yunion.io/x/sqlchemy"
+
+	api "yunion.io/x/onecloud/pkg/apis/identity"
+	"yunion.io/x/onecloud/pkg/cloudcommon/db"
+	"yunion.io/x/onecloud/pkg/httperrors"
+	"yunion.io/x/onecloud/pkg/mcclient"
+)
+
+// +onecloud:swagger-gen-ignore
+type SWebAuthnChallengeManager struct {
+	db.SModelBaseManager
+}
+
+var (
+	WebAuthnChallengeManager *SWebAuthnChallengeManager
+)
+
+func init() {
+	WebAuthnChallengeManager = &SWebAuthnChallengeManager{
+		SModelBaseManager: db.NewModelBaseManager(
+			SWebAuthnChallenge{},
+			"webauthn_challenge",
+			"webauthn_challenge",
+			"webauthn_challenges",
+		),
+	}
+	WebAuthnChallengeManager.SetVirtualObject(WebAuthnChallengeManager)
+}
+
+// SWebAuthnChallenge is a used challenge of a WebAuthn ceremony, kept until
+// the ceremony expires so that an assertion is accepted only once by any
+// of the apigateway instances
+type SWebAuthnChallenge struct {
+	db.SModelBase
+
+	// sha256 of the challenge
+	Id string `width:"64" charset:"ascii" nullable:"false" primary:"true"`
+
+	UserId    string    `width:"64" charset:"ascii" nullable:"false"`
+	ExpiresAt time.Time `nullable:"false" index:"true"`
+}
+
+func webAuthnChallengeId(challenge string) string {
+	sum := sha256.Sum256([]byte(challenge))
+	return hex.EncodeToString(sum[:])
+}
+
+func (manager *SWebAuthnChallengeManager) removeExpired() error {
+	sqlStr := fmt.Sprintf("DELETE FROM `%s` WHERE `expires_at` < ?", manager.TableSpec().Name())
+	_, err := sqlchemy.GetDB().Exec(sqlStr, time.Now().UTC())
+	return err
+}
+
+func (manager *SWebAuthnChallengeManager) isUsed(id string) (bool, error) {
+	cnt, err := manager.Query().Equals("id", id).CountWithError()
+	if err != nil {
+		return false, errors.Wrap(err, "CountWithError")
+	}
+	return cnt > 0, nil
+}
+
+// consume records the challenge used, it fails if the challenge is used
+// before, the primary key keeps concurrent instances from recording it twice
+func (manager *SWebAuthnChallengeManager) consume(ctx context.Context, userId string, challenge string, expires time.Time) error {
+	err := manager.removeExpired()
+	if err != nil {
+		log.Errorf("remove expired webauthn challenges fail %s", err)
+	}
+	id := webAuthnChallengeId(challenge)
+	used, err := manager.isUsed(id)
+	if err != nil {
+		return err
+	}
+	if used {
+		return httperrors.NewInvalidCredentialError("challenge already used")
+	}
+	record := &SWebAuthnChallenge{
+		Id:        id,
+		UserId:    userId,
+		ExpiresAt: expires,
+	}
+	record.SetModelManager(manager, record)
+	err = manager.TableSpec().Insert(ctx, record)
+	if err != nil {
+		if used, _ := manager.isUsed(id); used {
+			return httperrors.NewInvalidCredentialError("challenge already used")
+		}
+		return errors.Wrap(err, "Insert")
+	}
+	return nil
+}
+
+func (manager *SCredentialManager) AllowPerformConsumeWebauthnChallenge(ctx context.Context, userCred mcclient.TokenCredential, query jsonutils.JSONObject, data jsonutils.JSONObject) bool {
+	return db.IsAdminAllowClassPerform(userCred, manager, "consume-webauthn-challenge")
+}
+
+// 使用 WebAuthn 挑战码, 挑战码在过期前只能使用一次
+func (manager *SCredentialManager) PerformConsumeWebauthnChallenge(ctx context.Context, userCred mcclient.TokenCredential, query jsonutils.JSONObject, input api.CredentialConsumeWebAuthnChallengeInput) (jsonutils.JSONObject, error) {
+	if len(input.UserId) == 0 {
+		return nil, httperrors.NewMissingParameterError("user_id")
+	}
+	if len(input.Challenge) == 0 {
+		return nil, httperrors.NewMissingParameterError("challenge")
+	}
+	expires := time.Unix(input.Expires, 0).UTC()
+	if expires.Before(time.Now()) {
+		return nil, httperrors.NewInvalidCredentialError("challenge expired")
+	}
+	err := WebAuthnChallengeManager.consume(ctx, input.UserId, input.Challenge, expires)
+	if err != nil {
+		return nil, err
+	}
+	return nil, nil
+}
@@ -0,0 +1,380 @@
+// Copyright 2019 Yunion
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package scim
+
+import (
+	"context"
+	"database/sql"
+	"net/http"
+
+	"yunion.io/x/jsonutils"
+	"yunion.io/x/pkg/errors"
+	"yunion.io/x/sqlchemy"
+
+	api "yunion.io/x/onecloud/pkg/apis/identity"
+	"yunion.io/x/onecloud/pkg/cloudcommon/db"
+	"yunion.io/x/onecloud/pkg/keystone/models"
+	"yunion.io/x/onecloud/pkg/util/scimutils"
+	"yunion.io/x/onecloud/pkg/util/stringutils2"
+)
+
+type sGroupMember struct {
+	GroupId string
+	Id      string
+	Name    string
+}
+
+func fetchGroupMembers(groupIds []string) (map[string][]scimutils.SMultiValuedAttribute, error) {
+	ret := make(map[string][]scimutils.SMultiValuedAttribute)
+	if len(groupIds) == 0 {
+		return ret, nil
+	}
+	members := models.UsergroupManager.Query().SubQuery()
+	users := models.UserManager.Query().SubQuery()
+	q := members.Query(members.Field("group_id"), users.Field("id"), users.Field("name"))
+	q = q.Join(users, sqlchemy.Equals(members.Field("user_id"), users.Field("id")))
+	q = q.Filter(sqlchemy.In(members.Field("group_id"), groupIds))
+	rows := make([]sGroupMember, 0)
+	err := q.All(&rows)
+	if err != nil && errors.Cause(err) != sql.ErrNoRows {
+		return nil, errors.Wrap(err, "query group members")
+	}
+	for _, row := range rows {
+		ret[row.GroupId] = append(ret[row.GroupId], scimutils.SMultiValuedAttribute{
+			Value:   row.Id,
+			Display: row.Name,
+			Type:    scimutils.RESOURCE_TYPE_USER,
+		})
+	}
+	return ret, nil
+}
+
+func groupToScim(group *models.SGroup, externalId string, members []scimutils.SMultiValuedAttribute) scimutils.SGroup {
+	return scimutils.SGroup{
+		Schemas:     []string{scimutils.SCHEMA_GROUP},
+		Id:          group.Id,
+		ExternalId:  externalId,
+		DisplayName: group.Name,
+		Members:     members,
+		Meta: &scimutils.SMeta{
+			ResourceType: scimutils.RESOURCE_TYPE_GROUP,
+			Created:      group.CreatedAt,
+			LastModified: group.UpdatedAt,
+		},
+	}
+}
+
+func groupResponse(idp *models.SIdentityProvider, group *models.SGroup, query jsonutils.JSONObject) (jsonutils.JSONObject, error) {
+	extIds, err := fetchExternalIds(idp.Id, api.IdMappingEntityGroup, []string{group.Id})
+	if err != nil {
+		return nil, err
+	}
+	var members map[string][]scimutils.SMultiValuedAttribute
+	if !isAttributeExcluded(query, "members") {
+		members, err = fetchGroupMembers([]string{group.Id})
+		if err != nil {
+			return nil, err
+		}
+	}
+	return jsonutils.Marshal(groupToScim(group, extIds[group.Id], members[group.Id])), nil
+}
+
+func fetchGroup(idp *models.SIdentityProvider, id string) (*models.SGroup, error) {
+	obj, err := models.GroupManager.FetchById(id)
+	if err != nil {
+		if errors.Cause(err) == sql.ErrNoRows {
+			return nil, scimutils.NewNotFoundError("group %s not found", id)
+		}
+		return nil, errors.Wrap(err, "FetchById")
+	}
+	group := obj.(*models.SGroup)
+	if !group.LinkedWithIdp(idp.Id) {
+		return nil, scimutils.NewNotFoundError("group %s not found", id)
+	}
+	return group, nil
+}
+
+func fetchGroupInput(body jsonutils.JSONObject) (scimutils.SGroup, error) {
+	input := scimutils.SGroup{}
+	if body == nil {
+		return input, scimutils.NewBadRequestError(scimutils.ERROR_INVALID_SYNTAX, "empty request body")
+	}
+	err := body.Unmarshal(&input)
+	if err != nil {
+		return input, scimutils.NewBadRequestError(scimutils.ERROR_INVALID_SYNTAX, "invalid group: %s", err)
+	}
+	return input, validateGroupInput(input)
+}
+
+func validateGroupInput(input scimutils.SGroup) error {
+	if len(input.DisplayName) == 0 {
+		return scimutils.NewBadRequestError(scimutils.ERROR_INVALID_VALUE, "displayName is required")
+	}
+	return nil
+}
+
+// validateMembers makes sure that all members are users provisioned by
+// the same identity provider and returns the deduplicated user ids
+func validateMembers(idp *models.SIdentityProvider, members []scimutils.SMultiValuedAttribute) ([]string, error) {
+	userIds := stringutils2.NewSortedStrings(nil)
+	for _, member := range members {
+		if len(member.Type) > 0 && member.Type != scimutils.RESOURCE_TYPE_USER {
+			return nil, scimutils.NewBadRequestError(scimutils.ERROR_INVALID_VALUE, "unsupported member type %s", member.Type)
+		}
+		if len(member.Value) == 0 {
+			return nil, scimutils.NewBadRequestError(scimutils.ERROR_INVALID_VALUE, "empty member value")
+		}
+		if !userIds.Contains(member.Value) {
+			userIds = stringutils2.Append(userIds, member.Value)
+		}
+	}
+	if len(userIds) == 0 {
+		return []string{}, nil
+	}
+	q, _ := linkedQuery(models.UserManager.Query(), idp.Id, api.IdMappingEntityUser)
+	q = q.In("id", []string(userIds))
+	cnt, err := q.CountWithError()
+	if err != nil {
+		return nil, errors.Wrap(err, "CountWithError")
+	}
+	if cnt != len(userIds) {
+		return nil, scimutils.NewBadRequestError(scimutils.ERROR_INVALID_VALUE, "members contain unknown users")
+	}
+	return userIds, nil
+}
+
+func checkGroupName(domainId string, name string, groupId string) error {
+	q := models.GroupManager.Query().Equals("domain_id", domainId).Equals("name", name)
+	if len(groupId) > 0 {
+		q = q.NotEquals("id", groupId)
+	}
+	cnt, err := q.CountWithError()
+	if err != nil {
+		return errors.Wrap(err, "CountWithError")
+	}
+	if cnt > 0 {
+		return scimutils.NewConflictError("displayName %s already exists", name)
+	}
+	return nil
+}
+
+func listGroups(ctx context.Context, idp *models.SIdentityProvider, params map[string]string, query jsonutils.JSONObject, body jsonutils.JSONObject) (int, jsonutils.JSONObject, error) {
+	startIndex, count, err := fetchPagination(query)
+	if err != nil {
+		return 0, nil, err
+	}
+	q, idmaps := linkedQuery(models.GroupManager.Query(), idp.Id, api.IdMappingEntityGroup)
+	idField := q.Field("id")
+	memberColumn := func(op string, value interface{}) (sqlchemy.ICondition, error) {
+		str, ok := value.(string)
+		if op != scimutils.FILTER_OP_EQ || !ok {
+			return nil, scimutils.NewBadRequestError(scimutils.ERROR_INVALID_FILTER, "members only supports eq")
+		}
+		groupIds := models.UsergroupManager.Query("group_id").Equals("user_id", str).SubQuery()
+		return sqlchemy.In(idField, groupIds), nil
+	}
+	q, err = filterQuery(q, query, map[string]sColumn{
+		"id":                fieldColumn(q.Field("id")),
+		"externalid":        fieldColumn(idmaps.Field("local_id")),
+		"displayname":       fieldColumn(q.Field("name")),
+		"members":           memberColumn,
+		"members.value":     memberColumn,
+		"meta.created":      timeColumn(q.Field("created_at")),
+		"meta.lastmodified": timeColumn(q.Field("updated_at")),
+	})
+	if err != nil {
+		return 0, nil, err
+	}
+	total, err := q.CountWithError()
+	if err != nil {
+		return 0, nil, errors.Wrap(err, "CountWithError")
+	}
+	groups := make([]models.SGroup, 0)
+	if count > 0 {
+		q = q.Asc(q.Field("created_at"), q.Field("id")).Offset(startIndex - 1).Limit(count)
+		err = db.FetchModelObjects(models.GroupManager, q, &groups)
+		if err != nil {
+			return 0, nil, errors.Wrap(err, "FetchModelObjects")
+		}
+	}
+	groupIds := make([]string, len(groups))
+	for i := range groups {
+		groupIds[i] = groups[i].Id
+	}
+	extIds, err := fetchExternalIds(idp.Id, api.IdMappingEntityGroup, groupIds)
+	if err != nil {
+		return 0, nil, err
+	}
+	var members map[string][]scimutils.SMultiValuedAttribute
+	if !isAttributeExcluded(query, "members") {
+		members, err = fetchGroupMembers(groupIds)
+		if err != nil {
+			return 0, nil, err
+		}
+	}
+	resources := make([]jsonutils.JSONObject, len(groups))
+	for i := range groups {
+		resources[i] = jsonutils.Marshal(groupToScim(&groups[i], extIds[groups[i].Id], members[groups[i].Id]))
+	}
+	return http.StatusOK, listResponse(resources, total, startIndex), nil
+}
+
+func getGroup(ctx context.Context, idp *models.SIdentityProvider, params map[string]string, query jsonutils.JSONObject, body jsonutils.JSONObject) (int, jsonutils.JSONObject, error) {
+	group, err := fetchGroup(idp, params["<id>"])
+	if err != nil {
+		return 0, nil, err
+	}
+	ret, err := groupResponse(idp, group, query)
+	if err != nil {
+		return 0, nil, err
+	}
+	return http.StatusOK, ret, nil
+}
+
+func createGroup(ctx context.Context, idp *models.SIdentityProvider, params map[string]string, query jsonutils.JSONObject, body jsonutils.JSONObject) (int, jsonutils.JSONObject, error) {
+	input, err := fetchGroupInput(body)
+	if err != nil {
+		return 0, nil, err
+	}
+	userIds, err := validateMembers(idp, input.Members)
+	if err != nil {
+		return 0, nil, err
+	}
+	domain, err := getDomain(ctx, idp)
+	if err != nil {
+		return 0, nil, errors.Wrap(err, "getDomain")
+	}
+	extId := input.ExternalId
+	if len(extId) == 0 {
+		extId = input.DisplayName
+	}
+	groupId, err := models.IdmappingManager.FetchByIdpAndEntityId(ctx, idp.Id, extId, api.IdMappingEntityGroup)
+	if err == nil {
+		if obj, _ := models.GroupManager.FetchById(groupId); obj != nil {
+			return 0, nil, scimutils.NewConflictError("group %s already exists", extId)
+		}
+	} else if errors.Cause(err) != sql.ErrNoRows {
+		return 0, nil, errors.Wrap(err, "FetchByIdpAndEntityId")
+	}
+	err = checkGroupName(domain.Id, input.DisplayName, "")
+	if err != nil {
+		return 0, nil, err
+	}
+	group, err := models.GroupManager.RegisterExternalGroup(ctx, idp.Id, domain.Id, extId, input.DisplayName)
+	if err != nil {
+		return 0, nil, errors.Wrap(err, "RegisterExternalGroup")
+	}
+	db.OpsLog.LogEvent(group, db.ACT_CREATE, group.GetShortDesc(ctx), models.GetDefaultAdminCred())
+	models.UsergroupManager.SyncGroupUsers(ctx, models.GetDefaultAdminCred(), group.Id, userIds)
+	ret, err := groupResponse(idp, group, query)
+	if err != nil {
+		return 0, nil, err
+	}
+	return http.StatusCreated, ret, nil
+}
+
+func updateGroup(ctx context.Context, idp *models.SIdentityProvider, group *models.SGroup, input scimutils.SGroup, query jsonutils.JSONObject) (jsonutils.JSONObject, error) {
+	err := validateGroupInput(input)
+	if err != nil {
+		return nil, err
+	}
+	userIds, err := validateMembers(idp, input.Members)
+	if err != nil {
+		return nil, err
+	}
+	if input.DisplayName != group.Name {
+		err := checkGroupName(group.DomainId, input.DisplayName, group.Id)
+		if err != nil {
+			return nil, err
+		}
+		diff, err := db.Update(group, func() error {
+			group.Name = input.DisplayName
+			group.Displayname = input.DisplayName
+			return nil
+		})
+		if err != nil {
+			return nil, errors.Wrap(err, "Update")
+		}
+		db.OpsLog.LogEvent(group, db.ACT_UPDATE, diff, models.GetDefaultAdminCred())
+	}
+	models.UsergroupManager.SyncGroupUsers(ctx, models.GetDefaultAdminCred(), group.Id, userIds)
+	return groupResponse(idp, group, query)
+}
+
+func replaceGroup(ctx context.Context, idp *models.SIdentityProvider, params map[string]string, query jsonutils.JSONObject, body jsonutils.JSONObject) (int, jsonutils.JSONObject, error) {
+	group, err := fetchGroup(idp, params["<id>"])
+	if err != nil {
+		return 0, nil, err
+	}
+	input, err := fetchGroupInput(body)
+	if err != nil {
+		return 0, nil, err
+	}
+	ret, err := updateGroup(ctx, idp, group, input, query)
+	if err != nil {
+		return 0, nil, err
+	}
+	return http.StatusOK, ret, nil
+}
+
+func patchGroup(ctx context.Context, idp *models.SIdentityProvider, params map[string]string, query jsonutils.JSONObject, body jsonutils.JSONObject) (int, jsonutils.JSONObject, error) {
+	group, err := fetchGroup(idp, params["<id>"])
+	if err != nil {
+		return 0, nil, err
+	}
+	ops, err := fetchPatchOperations(body)
+	if err != nil {
+		return 0, nil, err
+	}
+	members, err := fetchGroupMembers([]string{group.Id})
+	if err != nil {
+		return 0, nil, err
+	}
+	res := jsonutils.Marshal(groupToScim(group, "", members[group.Id])).(*jsonutils.JSONDict)
+	err = scimutils.ApplyPatch(res, ops)
+	if err != nil {
+		return 0, nil, err
+	}
+	input := scimutils.SGroup{}
+	err = res.Unmarshal(&input)
+	if err != nil {
+		return 0, nil, scimutils.NewBadRequestError(scimutils.ERROR_INVALID_VALUE, "invalid patched group: %s", err)
+	}
+	ret, err := updateGroup(ctx, idp, group, input, query)
+	if err != nil {
+		return 0, nil, err
+	}
+	return http.StatusOK, ret, nil
+}
+
+func deleteGroup(ctx context.Context, idp *models.SIdentityProvider, params map[string]string, query jsonutils.JSONObject, body jsonutils.JSONObject) (int, jsonutils.JSONObject, error) {
+	group, err := fetchGroup(idp, params["<id>"])
+	if err != nil {
+		return 0, nil, err
+	}
+	err = group.UnlinkIdp(idp.Id)
+	if err != nil {
+		return 0, nil, errors.Wrap(err, "UnlinkIdp")
+	}
+	err = group.ValidateDeleteCondition(ctx)
+	if err != nil {
+		return 0, nil, err
+	}
+	err = group.Delete(ctx, models.GetDefaultAdminCred())
+	if err != nil {
+		return 0, nil, errors.Wrap(err, "Delete")
+	}
+	return http.StatusNoContent, nil, nil
+}
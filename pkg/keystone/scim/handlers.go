@@ -0,0 +1,213 @@
+// Copyright 2019 Yunion
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package scim
+
+import (
+	"context"
+	"crypto/subtle"
+	"net/http"
+	"strings"
+
+	"yunion.io/x/jsonutils"
+	"yunion.io/x/log"
+	"yunion.io/x/pkg/errors"
+
+	api "yunion.io/x/onecloud/pkg/apis/identity"
+	"yunion.io/x/onecloud/pkg/appctx"
+	"yunion.io/x/onecloud/pkg/appsrv"
+	"yunion.io/x/onecloud/pkg/keystone/models"
+	"yunion.io/x/onecloud/pkg/util/httputils"
+	"yunion.io/x/onecloud/pkg/util/scimutils"
+)
+
+const (
+	SCIM_PREFIX = "/scim/v2/<idp_id>"
+
+	// maximal number of resources returned in a list response
+	MAX_RESULTS = 200
+)
+
+type scimHandler func(ctx context.Context, idp *models.SIdentityProvider, params map[string]string, query jsonutils.JSONObject, body jsonutils.JSONObject) (int, jsonutils.JSONObject, error)
+
+// AddHandlers registers the SCIM 2.0 provisioning endpoints, each SCIM
+// identity provider has its own base url /scim/v2/<idp_id>
+func AddHandlers(app *appsrv.Application) {
+	for _, h := range []struct {
+		method  string
+		path    string
+		name    string
+		handler scimHandler
+	}{
+		{"GET", "/ServiceProviderConfig", "scim_service_provider_config", getServiceProviderConfig},
+		{"GET", "/ResourceTypes", "scim_resource_types", listResourceTypes},
+
+		{"GET", "/Users", "scim_list_users", listUsers},
+		{"POST", "/Users", "scim_create_user", createUser},
+		{"GET", "/Users/<id>", "scim_get_user", getUser},
+		{"PUT", "/Users/<id>", "scim_replace_user", replaceUser},
+		{"PATCH", "/Users/<id>", "scim_patch_user", patchUser},
+		{"DELETE", "/Users/<id>", "scim_delete_user", deleteUser},
+
+		{"GET", "/Groups", "scim_list_groups", listGroups},
+		{"POST", "/Groups", "scim_create_group", createGroup},
+		{"GET", "/Groups/<id>", "scim_get_group", getGroup},
+		{"PUT", "/Groups/<id>", "scim_replace_group", replaceGroup},
+		{"PATCH", "/Groups/<id>", "scim_patch_group", patchGroup},
+		{"DELETE", "/Groups/<id>", "scim_delete_group", deleteGroup},
+	} {
+		app.AddHandler2(h.method, SCIM_PREFIX+h.path, scimAuthenticate(h.handler), nil, h.name, nil)
+	}
+}
+
+func scimAuthenticate(handler scimHandler) func(ctx context.Context, w http.ResponseWriter, r *http.Request) {
+	return func(ctx context.Context, w http.ResponseWriter, r *http.Request) {
+		params := appctx.AppContextParams(ctx)
+		idp, err := authenticate(params["<idp_id>"], r)
+		if err != nil {
+			sendError(w, err)
+			return
+		}
+		query, err := jsonutils.ParseQueryString(r.URL.RawQuery)
+		if err != nil {
+			sendError(w, scimutils.NewBadRequestError(scimutils.ERROR_INVALID_SYNTAX, "invalid query string: %s", err))
+			return
+		}
+		var body jsonutils.JSONObject
+		if r.Method == "POST" || r.Method == "PUT" || r.Method == "PATCH" {
+			// SCIM clients send application/scim+json, which is not parsed
+			// by appsrv.FetchEnv
+			body, err = appsrv.FetchJSON(r)
+			if err != nil {
+				sendError(w, scimutils.NewBadRequestError(scimutils.ERROR_INVALID_SYNTAX, "invalid request body: %s", err))
+				return
+			}
+		}
+		status, result, err := handler(ctx, idp, params, query, body)
+		if err != nil {
+			log.Errorf("SCIM %s %s fail: %s", r.Method, r.URL.Path, err)
+			sendError(w, err)
+			return
+		}
+		send(w, status, result)
+	}
+}
+
+// authenticate verifies the bearer token of the request against the config
+// of the SCIM identity provider
+func authenticate(idpId string, r *http.Request) (*models.SIdentityProvider, error) {
+	unauthorized := scimutils.NewError(http.StatusUnauthorized, "", "invalid bearer token")
+	authHdr := r.Header.Get("Authorization")
+	if len(authHdr) <= len("Bearer ") || !strings.EqualFold(authHdr[:len("Bearer ")], "Bearer ") {
+		return nil, unauthorized
+	}
+	idp, err := models.IdentityProviderManager.FetchIdentityProviderById(idpId)
+	if err != nil || idp.Driver != api.IdentityDriverSCIM {
+		return nil, unauthorized
+	}
+	conf, err := models.GetConfigs(idp, true, nil, nil)
+	if err != nil {
+		return nil, errors.Wrap(err, "GetConfigs")
+	}
+	var token string
+	if val, ok := conf[api.IdentityDriverSCIM]["bearer_token"]; ok {
+		token, _ = val.GetString()
+	}
+	if len(token) == 0 || subtle.ConstantTimeCompare([]byte(token), []byte(authHdr[len("Bearer "):])) != 1 {
+		return nil, unauthorized
+	}
+	if !idp.GetEnabled() {
+		return nil, scimutils.NewError(http.StatusForbidden, "", "identity provider %s is disabled", idp.Name)
+	}
+	return idp, nil
+}
+
+func send(w http.ResponseWriter, status int, obj jsonutils.JSONObject) {
+	if obj == nil {
+		w.WriteHeader(status)
+		return
+	}
+	output := []byte(obj.String())
+	w.Header().Set("Content-Type", scimutils.CONTENT_TYPE+";charset=utf-8")
+	w.WriteHeader(status)
+	w.Write(output)
+}
+
+func sendError(w http.ResponseWriter, err error) {
+	var scimErr *scimutils.SError
+	switch e := errors.Cause(err).(type) {
+	case *scimutils.SError:
+		scimErr = e
+	case *httputils.JSONClientError:
+		scimErr = scimutils.NewError(e.Code, "", e.Details)
+	default:
+		scimErr = scimutils.NewError(http.StatusInternalServerError, "", err.Error())
+	}
+	send(w, scimErr.StatusCode(), jsonutils.Marshal(scimErr))
+}
+
+func getServiceProviderConfig(ctx context.Context, idp *models.SIdentityProvider, params map[string]string, query jsonutils.JSONObject, body jsonutils.JSONObject) (int, jsonutils.JSONObject, error) {
+	conf := scimutils.SServiceProviderConfig{
+		Schemas: []string{scimutils.SCHEMA_SERVICE_PROVIDER_CONFIG},
+		Patch:   scimutils.SSupported{Supported: true},
+		Filter: scimutils.SFilterSupported{
+			Supported:  true,
+			MaxResults: MAX_RESULTS,
+		},
+		AuthenticationSchemes: []scimutils.SAuthenticationScheme{
+			{
+				Type:        "oauthbearertoken",
+				Name:        "OAuth Bearer Token",
+				Description: "Authentication scheme using the OAuth Bearer Token Standard",
+				Primary:     true,
+			},
+		},
+		Meta: &scimutils.SMeta{
+			ResourceType: "ServiceProviderConfig",
+		},
+	}
+	return http.StatusOK, jsonutils.Marshal(conf), nil
+}
+
+func listResourceTypes(ctx context.Context, idp *models.SIdentityProvider, params map[string]string, query jsonutils.JSONObject, body jsonutils.JSONObject) (int, jsonutils.JSONObject, error) {
+	resources := []jsonutils.JSONObject{
+		jsonutils.Marshal(scimutils.SResourceType{
+			Schemas:  []string{scimutils.SCHEMA_RESOURCE_TYPE},
+			Id:       scimutils.RESOURCE_TYPE_USER,
+			Name:     scimutils.RESOURCE_TYPE_USER,
+			Endpoint: "/Users",
+			Schema:   scimutils.SCHEMA_USER,
+			Meta:     &scimutils.SMeta{ResourceType: "ResourceType"},
+		}),
+		jsonutils.Marshal(scimutils.SResourceType{
+			Schemas:  []string{scimutils.SCHEMA_RESOURCE_TYPE},
+			Id:       scimutils.RESOURCE_TYPE_GROUP,
+			Name:     scimutils.RESOURCE_TYPE_GROUP,
+			Endpoint: "/Groups",
+			Schema:   scimutils.SCHEMA_GROUP,
+			Meta:     &scimutils.SMeta{ResourceType: "ResourceType"},
+		}),
+	}
+	return http.StatusOK, listResponse(resources, len(resources), 1), nil
+}
+
+func listResponse(resources []jsonutils.JSONObject, total int, startIndex int) jsonutils.JSONObject {
+	return jsonutils.Marshal(scimutils.SListResponse{
+		Schemas:      []string{scimutils.SCHEMA_LIST_RESPONSE},
+		TotalResults: total,
+		StartIndex:   startIndex,
+		ItemsPerPage: len(resources),
+		Resources:    resources,
+	})
+}
@@ -0,0 +1,217 @@
+// Copyright 2019 Yunion
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package scim
+
+import (
+	"strconv"
+	"strings"
+
+	"yunion.io/x/jsonutils"
+	"yunion.io/x/pkg/util/timeutils"
+	"yunion.io/x/sqlchemy"
+
+	"yunion.io/x/onecloud/pkg/util/scimutils"
+)
+
+// sColumn builds the SQL condition of an attribute comparison
+type sColumn func(op string, value interface{}) (sqlchemy.ICondition, error)
+
+func fieldColumn(field sqlchemy.IQueryField) sColumn {
+	return func(op string, value interface{}) (sqlchemy.ICondition, error) {
+		if op == scimutils.FILTER_OP_PR {
+			return sqlchemy.IsNotEmpty(field), nil
+		}
+		if value == nil {
+			switch op {
+			case scimutils.FILTER_OP_EQ:
+				return sqlchemy.IsNullOrEmpty(field), nil
+			case scimutils.FILTER_OP_NE:
+				return sqlchemy.IsNotEmpty(field), nil
+			}
+			return nil, scimutils.NewBadRequestError(scimutils.ERROR_INVALID_FILTER, "null is not comparable by %s", op)
+		}
+		switch op {
+		case scimutils.FILTER_OP_EQ:
+			return sqlchemy.Equals(field, value), nil
+		case scimutils.FILTER_OP_NE:
+			return sqlchemy.NotEquals(field, value), nil
+		case scimutils.FILTER_OP_GT:
+			return sqlchemy.GT(field, value), nil
+		case scimutils.FILTER_OP_GE:
+			return sqlchemy.GE(field, value), nil
+		case scimutils.FILTER_OP_LT:
+			return sqlchemy.LT(field, value), nil
+		case scimutils.FILTER_OP_LE:
+			return sqlchemy.LE(field, value), nil
+		}
+		str, ok := value.(string)
+		if !ok {
+			return nil, scimutils.NewBadRequestError(scimutils.ERROR_INVALID_FILTER, "operator %s requires a string value", op)
+		}
+		switch op {
+		case scimutils.FILTER_OP_CO:
+			return sqlchemy.Contains(field, str), nil
+		case scimutils.FILTER_OP_SW:
+			return sqlchemy.Startswith(field, str), nil
+		case scimutils.FILTER_OP_EW:
+			return sqlchemy.Endswith(field, str), nil
+		}
+		return nil, scimutils.NewBadRequestError(scimutils.ERROR_INVALID_FILTER, "unsupported operator %s", op)
+	}
+}
+
+func timeColumn(field sqlchemy.IQueryField) sColumn {
+	col := fieldColumn(field)
+	return func(op string, value interface{}) (sqlchemy.ICondition, error) {
+		if str, ok := value.(string); ok {
+			tm, err := timeutils.ParseTimeStr(str)
+			if err != nil {
+				return nil, scimutils.NewBadRequestError(scimutils.ERROR_INVALID_FILTER, "invalid time %q", str)
+			}
+			value = tm
+		}
+		return col(op, value)
+	}
+}
+
+func boolColumn(field sqlchemy.IQueryField) sColumn {
+	return func(op string, value interface{}) (sqlchemy.ICondition, error) {
+		if op == scimutils.FILTER_OP_PR {
+			return sqlchemy.IsNotNull(field), nil
+		}
+		b, ok := value.(bool)
+		if !ok || (op != scimutils.FILTER_OP_EQ && op != scimutils.FILTER_OP_NE) {
+			return nil, scimutils.NewBadRequestError(scimutils.ERROR_INVALID_FILTER, "boolean attribute only supports eq and ne with true or false")
+		}
+		if b == (op == scimutils.FILTER_OP_EQ) {
+			return sqlchemy.IsTrue(field), nil
+		}
+		return sqlchemy.IsFalse(field), nil
+	}
+}
+
+// filterCondition translates a SCIM filter to SQL condition, columns maps
+// lower cased attribute paths to columns
+func filterCondition(filter scimutils.IFilter, columns map[string]sColumn) (sqlchemy.ICondition, error) {
+	switch f := filter.(type) {
+	case *scimutils.SAttrFilter:
+		col, ok := columns[strings.ToLower(f.Path)]
+		if !ok {
+			return nil, scimutils.NewBadRequestError(scimutils.ERROR_INVALID_FILTER, "filtering by %s is not supported", f.Path)
+		}
+		return col(f.Op, f.Value)
+	case *scimutils.SLogicalFilter:
+		left, err := filterCondition(f.Left, columns)
+		if err != nil {
+			return nil, err
+		}
+		right, err := filterCondition(f.Right, columns)
+		if err != nil {
+			return nil, err
+		}
+		if f.Op == scimutils.FILTER_OP_AND {
+			return sqlchemy.AND(left, right), nil
+		}
+		return sqlchemy.OR(left, right), nil
+	case *scimutils.SNotFilter:
+		cond, err := filterCondition(f.Filter, columns)
+		if err != nil {
+			return nil, err
+		}
+		return sqlchemy.NOT(cond), nil
+	case *scimutils.SValuePathFilter:
+		// emails[value eq "x"] is evaluated as emails.value eq "x"
+		prefix := strings.ToLower(f.Path) + "."
+		subColumns := make(map[string]sColumn)
+		for k, col := range columns {
+			if strings.HasPrefix(k, prefix) {
+				subColumns[k[len(prefix):]] = col
+			}
+		}
+		return filterCondition(f.Filter, subColumns)
+	}
+	return nil, scimutils.NewBadRequestError(scimutils.ERROR_INVALID_FILTER, "unsupported filter %s", filter)
+}
+
+func filterQuery(q *sqlchemy.SQuery, query jsonutils.JSONObject, columns map[string]sColumn) (*sqlchemy.SQuery, error) {
+	filterStr, _ := query.GetString("filter")
+	if len(strings.TrimSpace(filterStr)) == 0 {
+		return q, nil
+	}
+	filter, err := scimutils.ParseFilter(filterStr)
+	if err != nil {
+		return nil, err
+	}
+	cond, err := filterCondition(filter, columns)
+	if err != nil {
+		return nil, err
+	}
+	return q.Filter(cond), nil
+}
+
+// fetchPagination returns the 1-based startIndex and count of a list request
+func fetchPagination(query jsonutils.JSONObject) (int, int, error) {
+	startIndex, count := 1, MAX_RESULTS
+	for _, p := range []struct {
+		key string
+		val *int
+	}{
+		{"startIndex", &startIndex},
+		{"count", &count},
+	} {
+		str, _ := query.GetString(p.key)
+		if len(str) == 0 {
+			continue
+		}
+		v, err := strconv.Atoi(str)
+		if err != nil {
+			return 0, 0, scimutils.NewBadRequestError(scimutils.ERROR_INVALID_VALUE, "invalid %s %q", p.key, str)
+		}
+		*p.val = v
+	}
+	if startIndex < 1 {
+		startIndex = 1
+	}
+	if count < 0 {
+		count = 0
+	} else if count > MAX_RESULTS {
+		count = MAX_RESULTS
+	}
+	return startIndex, count, nil
+}
+
+// isAttributeExcluded tells whether attr is excluded from the response by
+// the attributes or excludedAttributes parameter
+func isAttributeExcluded(query jsonutils.JSONObject, attr string) bool {
+	contains := func(key string) (bool, bool) {
+		str, _ := query.GetString(key)
+		if len(str) == 0 {
+			return false, false
+		}
+		for _, a := range strings.Split(str, ",") {
+			if strings.EqualFold(scimutils.TrimSchema(strings.TrimSpace(a)), attr) {
+				return true, true
+			}
+		}
+		return false, true
+	}
+	if found, ok := contains("excludedAttributes"); ok && found {
+		return true
+	}
+	if found, ok := contains("attributes"); ok && !found {
+		return true
+	}
+	return false
+}
@@ -0,0 +1,127 @@
+// Copyright 2019 Yunion
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package scim
+
+import (
+	"regexp"
+	"testing"
+
+	"yunion.io/x/jsonutils"
+	"yunion.io/x/sqlchemy"
+
+	"yunion.io/x/onecloud/pkg/util/scimutils"
+)
+
+type sTestUser struct {
+	Id      string `width:"128" charset:"ascii" primary:"true"`
+	Name    string `width:"128" charset:"utf8"`
+	Email   string `width:"64" charset:"utf8"`
+	Enabled bool
+}
+
+// table aliases are generated, e.g. `t1`.`name`, strip them for comparison
+var aliasRegexp = regexp.MustCompile("`t[0-9]+`\\.")
+
+func TestFilterCondition(t *testing.T) {
+	q := sqlchemy.NewTableSpecFromStruct(sTestUser{}, "users_tbl").Query()
+	columns := map[string]sColumn{
+		"username":     fieldColumn(q.Field("name")),
+		"emails":       fieldColumn(q.Field("email")),
+		"emails.value": fieldColumn(q.Field("email")),
+		"active":       boolColumn(q.Field("enabled")),
+	}
+	cases := []struct {
+		filter string
+		want   string
+	}{
+		{`userName eq "alice"`, "`name` = ( ? )"},
+		{`userName sw "al" and active eq true`, "(`name` LIKE ( ? )) AND (`enabled` = 1)"},
+		{`emails[value ew "@example.com"]`, "`email` LIKE ( ? )"},
+		{`not (active eq false)`, "NOT (`enabled` = 0)"},
+	}
+	for _, c := range cases {
+		filter, err := scimutils.ParseFilter(c.filter)
+		if err != nil {
+			t.Fatalf("ParseFilter %s: %s", c.filter, err)
+		}
+		cond, err := filterCondition(filter, columns)
+		if err != nil {
+			t.Fatalf("filterCondition %s: %s", c.filter, err)
+		}
+		if got := aliasRegexp.ReplaceAllString(cond.WhereClause(), ""); got != c.want {
+			t.Errorf("filter %s: want %s got %s", c.filter, c.want, got)
+		}
+	}
+	for _, invalid := range []string{
+		`title eq "x"`,
+		`active co "t"`,
+		`userName co 1`,
+	} {
+		filter, err := scimutils.ParseFilter(invalid)
+		if err != nil {
+			t.Fatalf("ParseFilter %s: %s", invalid, err)
+		}
+		if _, err := filterCondition(filter, columns); err == nil {
+			t.Errorf("filter %s should fail", invalid)
+		}
+	}
+}
+
+func TestFetchPagination(t *testing.T) {
+	cases := []struct {
+		query      string
+		startIndex int
+		count      int
+	}{
+		{"", 1, MAX_RESULTS},
+		{"startIndex=0&count=10", 1, 10},
+		{"startIndex=21&count=1000", 21, MAX_RESULTS},
+		{"count=-1", 1, 0},
+	}
+	for _, c := range cases {
+		query, _ := jsonutils.ParseQueryString(c.query)
+		startIndex, count, err := fetchPagination(query)
+		if err != nil {
+			t.Fatalf("fetchPagination %s: %s", c.query, err)
+		}
+		if startIndex != c.startIndex || count != c.count {
+			t.Errorf("query %s: want %d,%d got %d,%d", c.query, c.startIndex, c.count, startIndex, count)
+		}
+	}
+	query, _ := jsonutils.ParseQueryString("count=abc")
+	if _, _, err := fetchPagination(query); err == nil {
+		t.Errorf("invalid count should fail")
+	}
+}
+
+func TestIsAttributeExcluded(t *testing.T) {
+	cases := []struct {
+		query string
+		attr  string
+		want  bool
+	}{
+		{"", "members", false},
+		{"excludedAttributes=members", "members", true},
+		{"excludedAttributes=urn:ietf:params:scim:schemas:core:2.0:Group:members", "members", true},
+		{"attributes=displayName,members", "members", false},
+		{"attributes=displayName", "members", true},
+	}
+	for _, c := range cases {
+		query, _ := jsonutils.ParseQueryString(c.query)
+		if got := isAttributeExcluded(query, c.attr); got != c.want {
+			t.Errorf("query %s attr %s: want %v got %v", c.query, c.attr, c.want, got)
+		}
+	}
+}
@@ -0,0 +1,413 @@
+// Copyright 2019 Yunion
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package scim
+
+import (
+	"context"
+	"database/sql"
+	"net/http"
+
+	"yunion.io/x/jsonutils"
+	"yunion.io/x/pkg/errors"
+	"yunion.io/x/pkg/tristate"
+	"yunion.io/x/sqlchemy"
+
+	api "yunion.io/x/onecloud/pkg/apis/identity"
+	"yunion.io/x/onecloud/pkg/cloudcommon/db"
+	"yunion.io/x/onecloud/pkg/keystone/models"
+	"yunion.io/x/onecloud/pkg/util/scimutils"
+)
+
+const (
+	maxEmailLength  = 64
+	maxMobileLength = 20
+)
+
+func getDomain(ctx context.Context, idp *models.SIdentityProvider) (*models.SDomain, error) {
+	return idp.GetSingleDomain(ctx, api.DefaultRemoteDomainId, idp.Name, idp.Driver+" provider "+idp.Name, true)
+}
+
+// fetchExternalIds returns the external ids, i.e. local_id of id_mappings,
+// of the entities linked with the identity provider
+func fetchExternalIds(idpId string, entityType string, ids []string) (map[string]string, error) {
+	ret := make(map[string]string)
+	if len(ids) == 0 {
+		return ret, nil
+	}
+	q := models.IdmappingManager.Query().Equals("domain_id", idpId).Equals("entity_type", entityType).In("public_id", ids)
+	idmaps := make([]models.SIdmapping, 0)
+	err := db.FetchModelObjects(models.IdmappingManager, q, &idmaps)
+	if err != nil {
+		return nil, errors.Wrap(err, "FetchModelObjects")
+	}
+	for i := range idmaps {
+		ret[idmaps[i].PublicId] = idmaps[i].IdpEntityId
+	}
+	return ret, nil
+}
+
+func linkedQuery(q *sqlchemy.SQuery, idpId string, entityType string) (*sqlchemy.SQuery, *sqlchemy.SSubQuery) {
+	idmaps := models.IdmappingManager.Query().Equals("domain_id", idpId).Equals("entity_type", entityType).SubQuery()
+	q = q.Join(idmaps, sqlchemy.Equals(q.Field("id"), idmaps.Field("public_id")))
+	return q, idmaps
+}
+
+type sUserGroup struct {
+	UserId string
+	Id     string
+	Name   string
+}
+
+func fetchUserGroups(userIds []string) (map[string][]scimutils.SMultiValuedAttribute, error) {
+	ret := make(map[string][]scimutils.SMultiValuedAttribute)
+	if len(userIds) == 0 {
+		return ret, nil
+	}
+	members := models.UsergroupManager.Query().SubQuery()
+	groups := models.GroupManager.Query().SubQuery()
+	q := members.Query(members.Field("user_id"), groups.Field("id"), groups.Field("name"))
+	q = q.Join(groups, sqlchemy.Equals(members.Field("group_id"), groups.Field("id")))
+	q = q.Filter(sqlchemy.In(members.Field("user_id"), userIds))
+	rows := make([]sUserGroup, 0)
+	err := q.All(&rows)
+	if err != nil && errors.Cause(err) != sql.ErrNoRows {
+		return nil, errors.Wrap(err, "query user groups")
+	}
+	for _, row := range rows {
+		ret[row.UserId] = append(ret[row.UserId], scimutils.SMultiValuedAttribute{
+			Value:   row.Id,
+			Display: row.Name,
+			Type:    "direct",
+		})
+	}
+	return ret, nil
+}
+
+func userToScim(user *models.SUser, externalId string, groups []scimutils.SMultiValuedAttribute) scimutils.SUser {
+	active := user.Enabled.IsTrue()
+	ret := scimutils.SUser{
+		Schemas:     []string{scimutils.SCHEMA_USER},
+		Id:          user.Id,
+		ExternalId:  externalId,
+		UserName:    user.Name,
+		DisplayName: user.Displayname,
+		Active:      &active,
+		Groups:      groups,
+		Meta: &scimutils.SMeta{
+			ResourceType: scimutils.RESOURCE_TYPE_USER,
+			Created:      user.CreatedAt,
+			LastModified: user.UpdatedAt,
+		},
+	}
+	if len(user.Displayname) > 0 {
+		ret.Name = &scimutils.SName{Formatted: user.Displayname}
+	}
+	if len(user.Email) > 0 {
+		ret.Emails = []scimutils.SMultiValuedAttribute{{Value: user.Email, Type: "work", Primary: true}}
+	}
+	if len(user.Mobile) > 0 {
+		ret.PhoneNumbers = []scimutils.SMultiValuedAttribute{{Value: user.Mobile, Type: "mobile", Primary: true}}
+	}
+	return ret
+}
+
+func userResponse(idp *models.SIdentityProvider, user *models.SUser) (jsonutils.JSONObject, error) {
+	extIds, err := fetchExternalIds(idp.Id, api.IdMappingEntityUser, []string{user.Id})
+	if err != nil {
+		return nil, err
+	}
+	groups, err := fetchUserGroups([]string{user.Id})
+	if err != nil {
+		return nil, err
+	}
+	return jsonutils.Marshal(userToScim(user, extIds[user.Id], groups[user.Id])), nil
+}
+
+func fetchUser(idp *models.SIdentityProvider, id string) (*models.SUser, error) {
+	obj, err := models.UserManager.FetchById(id)
+	if err != nil {
+		if errors.Cause(err) == sql.ErrNoRows {
+			return nil, scimutils.NewNotFoundError("user %s not found", id)
+		}
+		return nil, errors.Wrap(err, "FetchById")
+	}
+	user := obj.(*models.SUser)
+	if !user.LinkedWithIdp(idp.Id) {
+		return nil, scimutils.NewNotFoundError("user %s not found", id)
+	}
+	return user, nil
+}
+
+func fetchUserInput(body jsonutils.JSONObject) (scimutils.SUser, error) {
+	input := scimutils.SUser{}
+	if body == nil {
+		return input, scimutils.NewBadRequestError(scimutils.ERROR_INVALID_SYNTAX, "empty request body")
+	}
+	err := body.Unmarshal(&input)
+	if err != nil {
+		return input, scimutils.NewBadRequestError(scimutils.ERROR_INVALID_SYNTAX, "invalid user: %s", err)
+	}
+	return input, validateUserInput(input)
+}
+
+func validateUserInput(input scimutils.SUser) error {
+	if len(input.UserName) == 0 {
+		return scimutils.NewBadRequestError(scimutils.ERROR_INVALID_VALUE, "userName is required")
+	}
+	if len(scimutils.GetPrimaryValue(input.Emails, "work")) > maxEmailLength {
+		return scimutils.NewBadRequestError(scimutils.ERROR_INVALID_VALUE, "email longer than %d characters", maxEmailLength)
+	}
+	if len(scimutils.GetPrimaryValue(input.PhoneNumbers, "mobile")) > maxMobileLength {
+		return scimutils.NewBadRequestError(scimutils.ERROR_INVALID_VALUE, "phone number longer than %d characters", maxMobileLength)
+	}
+	return nil
+}
+
+func setUserAttributes(user *models.SUser, input scimutils.SUser) {
+	user.Displayname = input.GetDisplayName()
+	user.Email = scimutils.GetPrimaryValue(input.Emails, "work")
+	user.Mobile = scimutils.GetPrimaryValue(input.PhoneNumbers, "mobile")
+}
+
+func checkUserName(domainId string, name string, userId string) error {
+	q := models.UserManager.Query().Equals("domain_id", domainId).Equals("name", name)
+	if len(userId) > 0 {
+		q = q.NotEquals("id", userId)
+	}
+	cnt, err := q.CountWithError()
+	if err != nil {
+		return errors.Wrap(err, "CountWithError")
+	}
+	if cnt > 0 {
+		return scimutils.NewConflictError("userName %s already exists", name)
+	}
+	return nil
+}
+
+func listUsers(ctx context.Context, idp *models.SIdentityProvider, params map[string]string, query jsonutils.JSONObject, body jsonutils.JSONObject) (int, jsonutils.JSONObject, error) {
+	startIndex, count, err := fetchPagination(query)
+	if err != nil {
+		return 0, nil, err
+	}
+	q, idmaps := linkedQuery(models.UserManager.Query(), idp.Id, api.IdMappingEntityUser)
+	q, err = filterQuery(q, query, map[string]sColumn{
+		"id":                 fieldColumn(q.Field("id")),
+		"externalid":         fieldColumn(idmaps.Field("local_id")),
+		"username":           fieldColumn(q.Field("name")),
+		"displayname":        fieldColumn(q.Field("displayname")),
+		"name.formatted":     fieldColumn(q.Field("displayname")),
+		"emails":             fieldColumn(q.Field("email")),
+		"emails.value":       fieldColumn(q.Field("email")),
+		"phonenumbers":       fieldColumn(q.Field("mobile")),
+		"phonenumbers.value": fieldColumn(q.Field("mobile")),
+		"active":             boolColumn(q.Field("enabled")),
+		"meta.created":       timeColumn(q.Field("created_at")),
+		"meta.lastmodified":  timeColumn(q.Field("updated_at")),
+	})
+	if err != nil {
+		return 0, nil, err
+	}
+	total, err := q.CountWithError()
+	if err != nil {
+		return 0, nil, errors.Wrap(err, "CountWithError")
+	}
+	users := make([]models.SUser, 0)
+	if count > 0 {
+		q = q.Asc(q.Field("created_at"), q.Field("id")).Offset(startIndex - 1).Limit(count)
+		err = db.FetchModelObjects(models.UserManager, q, &users)
+		if err != nil {
+			return 0, nil, errors.Wrap(err, "FetchModelObjects")
+		}
+	}
+	userIds := make([]string, len(users))
+	for i := range users {
+		userIds[i] = users[i].Id
+	}
+	extIds, err := fetchExternalIds(idp.Id, api.IdMappingEntityUser, userIds)
+	if err != nil {
+		return 0, nil, err
+	}
+	var groups map[string][]scimutils.SMultiValuedAttribute
+	if !isAttributeExcluded(query, "groups") {
+		groups, err = fetchUserGroups(userIds)
+		if err != nil {
+			return 0, nil, err
+		}
+	}
+	resources := make([]jsonutils.JSONObject, len(users))
+	for i := range users {
+		resources[i] = jsonutils.Marshal(userToScim(&users[i], extIds[users[i].Id], groups[users[i].Id]))
+	}
+	return http.StatusOK, listResponse(resources, total, startIndex), nil
+}
+
+func getUser(ctx context.Context, idp *models.SIdentityProvider, params map[string]string, query jsonutils.JSONObject, body jsonutils.JSONObject) (int, jsonutils.JSONObject, error) {
+	user, err := fetchUser(idp, params["<id>"])
+	if err != nil {
+		return 0, nil, err
+	}
+	ret, err := userResponse(idp, user)
+	if err != nil {
+		return 0, nil, err
+	}
+	return http.StatusOK, ret, nil
+}
+
+func createUser(ctx context.Context, idp *models.SIdentityProvider, params map[string]string, query jsonutils.JSONObject, body jsonutils.JSONObject) (int, jsonutils.JSONObject, error) {
+	input, err := fetchUserInput(body)
+	if err != nil {
+		return 0, nil, err
+	}
+	domain, err := getDomain(ctx, idp)
+	if err != nil {
+		return 0, nil, errors.Wrap(err, "getDomain")
+	}
+	// the external id identifies the user in id_mappings, userName is used
+	// if the client does not provide one
+	extId := input.ExternalId
+	if len(extId) == 0 {
+		extId = input.UserName
+	}
+	userId, err := models.IdmappingManager.FetchByIdpAndEntityId(ctx, idp.Id, extId, api.IdMappingEntityUser)
+	if err == nil {
+		if obj, _ := models.UserManager.FetchById(userId); obj != nil {
+			return 0, nil, scimutils.NewConflictError("user %s already exists", extId)
+		}
+	} else if errors.Cause(err) != sql.ErrNoRows {
+		return 0, nil, errors.Wrap(err, "FetchByIdpAndEntityId")
+	}
+	err = checkUserName(domain.Id, input.UserName, "")
+	if err != nil {
+		return 0, nil, err
+	}
+	user, err := idp.SyncOrCreateUser(ctx, extId, input.UserName, domain.Id, input.IsActive(), func(user *models.SUser) {
+		setUserAttributes(user, input)
+	})
+	if err != nil {
+		return 0, nil, errors.Wrap(err, "SyncOrCreateUser")
+	}
+	db.OpsLog.LogEvent(user, db.ACT_CREATE, user.GetShortDesc(ctx), models.GetDefaultAdminCred())
+	ret, err := userResponse(idp, user)
+	if err != nil {
+		return 0, nil, err
+	}
+	return http.StatusCreated, ret, nil
+}
+
+func updateUser(ctx context.Context, idp *models.SIdentityProvider, user *models.SUser, input scimutils.SUser) (jsonutils.JSONObject, error) {
+	err := validateUserInput(input)
+	if err != nil {
+		return nil, err
+	}
+	if input.UserName != user.Name {
+		err := checkUserName(user.DomainId, input.UserName, user.Id)
+		if err != nil {
+			return nil, err
+		}
+	}
+	diff, err := db.Update(user, func() error {
+		user.Name = input.UserName
+		setUserAttributes(user, input)
+		user.Enabled = tristate.NewFromBool(input.IsActive())
+		return nil
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "Update")
+	}
+	db.OpsLog.LogEvent(user, db.ACT_UPDATE, diff, models.GetDefaultAdminCred())
+	return userResponse(idp, user)
+}
+
+func replaceUser(ctx context.Context, idp *models.SIdentityProvider, params map[string]string, query jsonutils.JSONObject, body jsonutils.JSONObject) (int, jsonutils.JSONObject, error) {
+	user, err := fetchUser(idp, params["<id>"])
+	if err != nil {
+		return 0, nil, err
+	}
+	input, err := fetchUserInput(body)
+	if err != nil {
+		return 0, nil, err
+	}
+	ret, err := updateUser(ctx, idp, user, input)
+	if err != nil {
+		return 0, nil, err
+	}
+	return http.StatusOK, ret, nil
+}
+
+func fetchPatchOperations(body jsonutils.JSONObject) ([]scimutils.SPatchOperation, error) {
+	if body == nil {
+		return nil, scimutils.NewBadRequestError(scimutils.ERROR_INVALID_SYNTAX, "empty request body")
+	}
+	input := scimutils.SPatchRequest{}
+	err := body.Unmarshal(&input)
+	if err != nil {
+		return nil, scimutils.NewBadRequestError(scimutils.ERROR_INVALID_SYNTAX, "invalid patch request: %s", err)
+	}
+	if len(input.Operations) == 0 {
+		return nil, scimutils.NewBadRequestError(scimutils.ERROR_INVALID_SYNTAX, "empty patch operations")
+	}
+	return input.Operations, nil
+}
+
+func patchUser(ctx context.Context, idp *models.SIdentityProvider, params map[string]string, query jsonutils.JSONObject, body jsonutils.JSONObject) (int, jsonutils.JSONObject, error) {
+	user, err := fetchUser(idp, params["<id>"])
+	if err != nil {
+		return 0, nil, err
+	}
+	ops, err := fetchPatchOperations(body)
+	if err != nil {
+		return 0, nil, err
+	}
+	res := jsonutils.Marshal(userToScim(user, "", nil)).(*jsonutils.JSONDict)
+	err = scimutils.ApplyPatch(res, ops)
+	if err != nil {
+		return 0, nil, err
+	}
+	input := scimutils.SUser{}
+	err = res.Unmarshal(&input)
+	if err != nil {
+		return 0, nil, scimutils.NewBadRequestError(scimutils.ERROR_INVALID_VALUE, "invalid patched user: %s", err)
+	}
+	ret, err := updateUser(ctx, idp, user, input)
+	if err != nil {
+		return 0, nil, err
+	}
+	return http.StatusOK, ret, nil
+}
+
+func deleteUser(ctx context.Context, idp *models.SIdentityProvider, params map[string]string, query jsonutils.JSONObject, body jsonutils.JSONObject) (int, jsonutils.JSONObject, error) {
+	user, err := fetchUser(idp, params["<id>"])
+	if err != nil {
+		return 0, nil, err
+	}
+	err = user.ValidatePurgeCondition(ctx)
+	if err != nil {
+		return 0, nil, err
+	}
+	// same as the LDAP sync, the user is unlinked before deletion
+	err = user.UnlinkIdp(idp.Id)
+	if err != nil {
+		return 0, nil, errors.Wrap(err, "UnlinkIdp")
+	}
+	err = user.ValidateDeleteCondition(ctx)
+	if err != nil {
+		return 0, nil, err
+	}
+	err = user.Delete(ctx, models.GetDefaultAdminCred())
+	if err != nil {
+		return 0, nil, errors.Wrap(err, "Delete")
+	}
+	return http.StatusNoContent, nil, nil
+}
@@ -22,6 +22,7 @@ import (
 	"yunion.io/x/onecloud/pkg/cloudcommon/db/taskman"
 	"yunion.io/x/onecloud/pkg/keystone/cronjobs"
 	"yunion.io/x/onecloud/pkg/keystone/models"
+	"yunion.io/x/onecloud/pkg/keystone/scim"
 	"yunion.io/x/onecloud/pkg/keystone/tokens"
 	"yunion.io/x/onecloud/pkg/keystone/usages"
 )
@@ -33,6 +34,8 @@ const (
 func InitHandlers(app *appsrv.Application) {
 	db.InitAllManagers()
 
+	db.AddOpenApiHandler(API_VERSION, app)
+
 	// add version handler with API_VERSION prefix
 	app.AddDefaultHandler("GET", API_VERSION+"/version", appsrv.VersionHandler, "version")
 	cronjobs.AddRefreshHandler(API_VERSION, app)
@@ -43,6 +46,7 @@ func InitHandlers(app *appsrv.Application) {
 	taskman.AddTaskHandler(API_VERSION, app)
 
 	tokens.AddHandler(app)
+	scim.AddHandlers(app)
 
 	for _, manager := range []db.IModelManager{
 		taskman.TaskManager,
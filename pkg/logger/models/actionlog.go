@@ -98,6 +98,15 @@ func (self *SActionlog) PostCreate(ctx context.Context, userCred mcclient.TokenC
 	}
 }
 
+func (manager *SActionlogManager) fetchLastId() (int64, error) {
+	action := SActionlog{}
+	err := manager.Query().Desc("id").First(&action)
+	if err != nil && errors.Cause(err) != sqlchemy.ErrEmptyQuery {
+		return 0, errors.Wrap(err, "query last actionlog")
+	}
+	return action.Id, nil
+}
+
 // 操作日志列表
 func (manager *SActionlogManager) ListItemFilter(
 	ctx context.Context,
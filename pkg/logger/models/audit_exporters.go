@@ -0,0 +1,548 @@
+// Copyright 2019 Yunion
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package models
+
+import (
+	"context"
+	"time"
+
+	"yunion.io/x/jsonutils"
+	"yunion.io/x/log"
+	"yunion.io/x/pkg/errors"
+	"yunion.io/x/pkg/tristate"
+	"yunion.io/x/pkg/utils"
+	"yunion.io/x/sqlchemy"
+
+	api "yunion.io/x/onecloud/pkg/apis/logger"
+	"yunion.io/x/onecloud/pkg/cloudcommon/db"
+	"yunion.io/x/onecloud/pkg/httperrors"
+	"yunion.io/x/onecloud/pkg/logger/options"
+	"yunion.io/x/onecloud/pkg/mcclient"
+	"yunion.io/x/onecloud/pkg/util/auditexport"
+	"yunion.io/x/onecloud/pkg/util/stringutils2"
+)
+
+type SAuditExporterManager struct {
+	db.SEnabledStatusStandaloneResourceBaseManager
+}
+
+var AuditExporterManager *SAuditExporterManager
+
+func init() {
+	AuditExporterManager = &SAuditExporterManager{
+		SEnabledStatusStandaloneResourceBaseManager: db.NewEnabledStatusStandaloneResourceBaseManager(
+			SAuditExporter{},
+			"audit_exporters_tbl",
+			"audit_exporter",
+			"audit_exporters",
+		),
+	}
+	AuditExporterManager.SetVirtualObject(AuditExporterManager)
+}
+
+// SAuditExporter streams actionlogs to a SIEM receiver, the id of the last
+// accepted actionlog is kept as the cursor so that exporting resumes after
+// it on restart
+type SAuditExporter struct {
+	db.SEnabledStatusStandaloneResourceBase
+
+	// 接收端类型
+	Type string `width:"16" charset:"ascii" nullable:"false" list:"admin" create:"admin_required"`
+	// 日志格式
+	Format string `width:"16" charset:"ascii" nullable:"false" default:"json" list:"admin" create:"admin_optional" update:"admin"`
+	// 接收端地址
+	Address string `width:"512" charset:"ascii" nullable:"false" list:"admin" create:"admin_required" update:"admin"`
+	// kafka topic
+	Topic string `width:"255" charset:"ascii" nullable:"true" list:"admin" create:"admin_optional" update:"admin"`
+
+	Tls                bool   `nullable:"false" default:"false" list:"admin" create:"admin_optional" update:"admin"`
+	InsecureSkipVerify bool   `nullable:"false" default:"false" list:"admin" create:"admin_optional" update:"admin"`
+	CaCert             string `charset:"ascii" nullable:"true" get:"admin" create:"admin_optional" update:"admin"`
+	// HTTP请求附带的header, 可能包含认证信息, 以ID为密钥加密保存, 不返回给用户
+	Headers string `charset:"ascii" nullable:"true"`
+
+	// 导出的资源类型, 为空表示所有资源类型
+	ObjType *jsonutils.JSONArray `nullable:"true" list:"admin" create:"admin_optional" update:"admin"`
+	// 导出的操作, 为空表示所有操作
+	Action *jsonutils.JSONArray `nullable:"true" list:"admin" create:"admin_optional" update:"admin"`
+	// 导出的服务, 为空表示所有服务
+	Service *jsonutils.JSONArray `nullable:"true" list:"admin" create:"admin_optional" update:"admin"`
+
+	// 最近导出的操作日志ID
+	LastActionlogId int64 `nullable:"false" default:"0" list:"admin"`
+	// 已导出的操作日志数量
+	ExportedCount int64 `nullable:"false" default:"0" list:"admin"`
+	// 最近一次导出时间
+	LastExportAt time.Time `nullable:"true" list:"admin"`
+	// 最近一次导出的错误信息
+	LastError string `charset:"utf8" nullable:"true" list:"admin"`
+}
+
+func (manager *SAuditExporterManager) AllowListItems(ctx context.Context, userCred mcclient.TokenCredential, query jsonutils.JSONObject) bool {
+	return db.IsAdminAllowList(userCred, manager)
+}
+
+func (manager *SAuditExporterManager) AllowCreateItem(ctx context.Context, userCred mcclient.TokenCredential, query jsonutils.JSONObject, data jsonutils.JSONObject) bool {
+	return db.IsAdminAllowCreate(userCred, manager)
+}
+
+func (exporter *SAuditExporter) AllowGetDetails(ctx context.Context, userCred mcclient.TokenCredential, query jsonutils.JSONObject) bool {
+	return db.IsAdminAllowGet(userCred, exporter)
+}
+
+func (exporter *SAuditExporter) AllowUpdateItem(ctx context.Context, userCred mcclient.TokenCredential) bool {
+	return db.IsAdminAllowUpdate(userCred, exporter)
+}
+
+func (exporter *SAuditExporter) AllowDeleteItem(ctx context.Context, userCred mcclient.TokenCredential, query jsonutils.JSONObject, data jsonutils.JSONObject) bool {
+	return db.IsAdminAllowDelete(userCred, exporter)
+}
+
+func (exporter *SAuditExporter) getConfig() auditexport.SConfig {
+	conf := auditexport.SConfig{
+		Type:               exporter.Type,
+		Address:            exporter.Address,
+		Topic:              exporter.Topic,
+		Tls:                exporter.Tls,
+		InsecureSkipVerify: exporter.InsecureSkipVerify,
+		CaCert:             exporter.CaCert,
+		Timeout:            time.Duration(options.Options.AuditExportTimeoutSeconds) * time.Second,
+	}
+	headers, err := exporter.getHeaders()
+	if err != nil {
+		log.Errorf("decrypt headers of audit exporter %s fail %s", exporter.Name, err)
+	}
+	conf.Headers = headers
+	return conf
+}
+
+// encryptHeaders encrypts the headers with the exporter id as the key
+func (exporter *SAuditExporter) encryptHeaders(headers map[string]string) (string, error) {
+	if len(headers) == 0 {
+		return "", nil
+	}
+	return utils.EncryptAESBase64(exporter.Id, jsonutils.Marshal(headers).String())
+}
+
+func (exporter *SAuditExporter) getHeaders() (map[string]string, error) {
+	if len(exporter.Headers) == 0 {
+		return nil, nil
+	}
+	str, err := utils.DescryptAESBase64(exporter.Id, exporter.Headers)
+	if err != nil {
+		return nil, errors.Wrap(err, "DescryptAESBase64")
+	}
+	obj, err := jsonutils.ParseString(str)
+	if err != nil {
+		return nil, errors.Wrap(err, "ParseString")
+	}
+	headers := make(map[string]string)
+	err = obj.Unmarshal(&headers)
+	if err != nil {
+		return nil, errors.Wrap(err, "Unmarshal")
+	}
+	return headers, nil
+}
+
+// maskedHeaders returns the header names only, the values may carry auth
+func (exporter *SAuditExporter) maskedHeaders() map[string]string {
+	headers, err := exporter.getHeaders()
+	if err != nil || len(headers) == 0 {
+		return nil
+	}
+	for k := range headers {
+		headers[k] = "******"
+	}
+	return headers
+}
+
+func (manager *SAuditExporterManager) ValidateCreateData(ctx context.Context, userCred mcclient.TokenCredential, ownerId mcclient.IIdentityProvider, query jsonutils.JSONObject, input api.AuditExporterCreateInput) (api.AuditExporterCreateInput, error) {
+	var err error
+	input.EnabledStatusStandaloneResourceCreateInput, err = manager.SEnabledStatusStandaloneResourceBaseManager.ValidateCreateData(ctx, userCred, ownerId, query, input.EnabledStatusStandaloneResourceCreateInput)
+	if err != nil {
+		return input, errors.Wrap(err, "SEnabledStatusStandaloneResourceBaseManager.ValidateCreateData")
+	}
+	if len(input.Format) == 0 {
+		input.Format = auditexport.FORMAT_JSON
+	}
+	if _, err := auditexport.NewFormatter(input.Format); err != nil {
+		return input, err
+	}
+	conf := auditexport.SConfig{
+		Type:    input.Type,
+		Address: input.Address,
+		Topic:   input.Topic,
+		CaCert:  input.CaCert,
+	}
+	if err := conf.Validate(); err != nil {
+		return input, err
+	}
+	switch input.StartFrom {
+	case "":
+		input.StartFrom = api.AUDIT_EXPORTER_START_LATEST
+	case api.AUDIT_EXPORTER_START_LATEST, api.AUDIT_EXPORTER_START_EARLIEST:
+	default:
+		return input, httperrors.NewInputParameterError("unknown start_from %q", input.StartFrom)
+	}
+	return input, nil
+}
+
+func (exporter *SAuditExporter) CustomizeCreate(ctx context.Context, userCred mcclient.TokenCredential, ownerId mcclient.IIdentityProvider, query jsonutils.JSONObject, data jsonutils.JSONObject) error {
+	err := exporter.SEnabledStatusStandaloneResourceBase.CustomizeCreate(ctx, userCred, ownerId, query, data)
+	if err != nil {
+		return err
+	}
+	input := api.AuditExporterCreateInput{}
+	data.Unmarshal(&input)
+	if input.Disabled != nil && *input.Disabled {
+		exporter.Enabled = tristate.False
+	} else {
+		exporter.Enabled = tristate.True
+	}
+	// headers are encrypted with the id, so the id is decided before insert
+	if len(exporter.Id) == 0 {
+		exporter.Id = db.DefaultUUIDGenerator()
+	}
+	exporter.Headers, err = exporter.encryptHeaders(input.Headers)
+	if err != nil {
+		return errors.Wrap(err, "encryptHeaders")
+	}
+	if input.StartFrom == api.AUDIT_EXPORTER_START_LATEST {
+		exporter.LastActionlogId, err = ActionLog.fetchLastId()
+		if err != nil {
+			return errors.Wrap(err, "fetchLastId")
+		}
+	}
+	exporter.Status = api.AUDIT_EXPORTER_STATUS_READY
+	return nil
+}
+
+func (exporter *SAuditExporter) ValidateUpdateData(ctx context.Context, userCred mcclient.TokenCredential, query jsonutils.JSONObject, input api.AuditExporterUpdateInput) (api.AuditExporterUpdateInput, error) {
+	var err error
+	input.EnabledStatusStandaloneResourceBaseUpdateInput, err = exporter.SEnabledStatusStandaloneResourceBase.ValidateUpdateData(ctx, userCred, query, input.EnabledStatusStandaloneResourceBaseUpdateInput)
+	if err != nil {
+		return input, errors.Wrap(err, "SEnabledStatusStandaloneResourceBase.ValidateUpdateData")
+	}
+	if len(input.Format) > 0 {
+		if _, err := auditexport.NewFormatter(input.Format); err != nil {
+			return input, err
+		}
+	}
+	conf := exporter.getConfig()
+	if len(input.Address) > 0 {
+		conf.Address = input.Address
+	}
+	if len(input.Topic) > 0 {
+		conf.Topic = input.Topic
+	}
+	if input.CaCert != nil {
+		conf.CaCert = *input.CaCert
+	}
+	if err := conf.Validate(); err != nil {
+		return input, err
+	}
+	return input, nil
+}
+
+func (exporter *SAuditExporter) PostUpdate(ctx context.Context, userCred mcclient.TokenCredential, query jsonutils.JSONObject, data jsonutils.JSONObject) {
+	exporter.SEnabledStatusStandaloneResourceBase.PostUpdate(ctx, userCred, query, data)
+	if !data.Contains("headers") {
+		return
+	}
+	input := api.AuditExporterUpdateInput{}
+	data.Unmarshal(&input)
+	headers, err := exporter.encryptHeaders(input.Headers)
+	if err != nil {
+		log.Errorf("encrypt headers of audit exporter %s fail %s", exporter.Name, err)
+		return
+	}
+	_, err = db.Update(exporter, func() error {
+		exporter.Headers = headers
+		return nil
+	})
+	if err != nil {
+		log.Errorf("update headers of audit exporter %s fail %s", exporter.Name, err)
+	}
+}
+
+// 操作日志导出列表
+func (manager *SAuditExporterManager) ListItemFilter(
+	ctx context.Context,
+	q *sqlchemy.SQuery,
+	userCred mcclient.TokenCredential,
+	query api.AuditExporterListInput,
+) (*sqlchemy.SQuery, error) {
+	var err error
+	q, err = manager.SEnabledStatusStandaloneResourceBaseManager.ListItemFilter(ctx, q, userCred, query.EnabledStatusStandaloneResourceListInput)
+	if err != nil {
+		return nil, errors.Wrap(err, "SEnabledStatusStandaloneResourceBaseManager.ListItemFilter")
+	}
+	if len(query.Type) > 0 {
+		q = q.In("type", query.Type)
+	}
+	if len(query.Format) > 0 {
+		q = q.In("format", query.Format)
+	}
+	return q, nil
+}
+
+func (manager *SAuditExporterManager) OrderByExtraFields(
+	ctx context.Context,
+	q *sqlchemy.SQuery,
+	userCred mcclient.TokenCredential,
+	query api.AuditExporterListInput,
+) (*sqlchemy.SQuery, error) {
+	q, err := manager.SEnabledStatusStandaloneResourceBaseManager.OrderByExtraFields(ctx, q, userCred, query.EnabledStatusStandaloneResourceListInput)
+	if err != nil {
+		return nil, errors.Wrap(err, "SEnabledStatusStandaloneResourceBaseManager.OrderByExtraFields")
+	}
+	return q, nil
+}
+
+func (manager *SAuditExporterManager) QueryDistinctExtraField(q *sqlchemy.SQuery, field string) (*sqlchemy.SQuery, error) {
+	q, err := manager.SEnabledStatusStandaloneResourceBaseManager.QueryDistinctExtraField(q, field)
+	if err == nil {
+		return q, nil
+	}
+	return q, httperrors.ErrNotFound
+}
+
+func (manager *SAuditExporterManager) FetchCustomizeColumns(
+	ctx context.Context,
+	userCred mcclient.TokenCredential,
+	query jsonutils.JSONObject,
+	objs []interface{},
+	fields stringutils2.SSortedStrings,
+	isList bool,
+) []api.AuditExporterDetails {
+	rows := make([]api.AuditExporterDetails, len(objs))
+	stdRows := manager.SEnabledStatusStandaloneResourceBaseManager.FetchCustomizeColumns(ctx, userCred, query, objs, fields, isList)
+	lastId, err := ActionLog.fetchLastId()
+	if err != nil {
+		log.Errorf("fetchLastId fail %s", err)
+	}
+	for i := range rows {
+		exporter := objs[i].(*SAuditExporter)
+		rows[i] = api.AuditExporterDetails{
+			EnabledStatusStandaloneResourceDetails: stdRows[i],
+			Headers:                                exporter.maskedHeaders(),
+		}
+		if pending := lastId - exporter.LastActionlogId; pending > 0 {
+			rows[i].PendingCount = pending
+		}
+	}
+	return rows
+}
+
+func (exporter *SAuditExporter) AllowPerformResetCursor(ctx context.Context, userCred mcclient.TokenCredential, query jsonutils.JSONObject, data jsonutils.JSONObject) bool {
+	return db.IsAdminAllowPerform(userCred, exporter, "reset-cursor")
+}
+
+// 重置导出位置, 从指定时间之后的操作日志重新导出
+func (exporter *SAuditExporter) PerformResetCursor(ctx context.Context, userCred mcclient.TokenCredential, query jsonutils.JSONObject, input api.AuditExporterResetCursorInput) (jsonutils.JSONObject, error) {
+	if input.Since.IsZero() {
+		return nil, httperrors.NewMissingParameterError("since")
+	}
+	action := SActionlog{}
+	err := ActionLog.Query().GE("ops_time", input.Since).Asc("id").First(&action)
+	if err != nil {
+		if errors.Cause(err) != sqlchemy.ErrEmptyQuery {
+			return nil, httperrors.NewGeneralError(err)
+		}
+		action.Id, err = ActionLog.fetchLastId()
+		if err != nil {
+			return nil, httperrors.NewGeneralError(err)
+		}
+		action.Id += 1
+	}
+	_, err = db.Update(exporter, func() error {
+		exporter.LastActionlogId = action.Id - 1
+		return nil
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "db.Update")
+	}
+	return nil, nil
+}
+
+// Match tells whether the actionlog passes the filters of the exporter
+func (exporter *SAuditExporter) Match(action *SActionlog) bool {
+	return jsonArrayContains(exporter.ObjType, action.ObjType) &&
+		jsonArrayContains(exporter.Action, action.Action) &&
+		jsonArrayContains(exporter.Service, action.Service)
+}
+
+func actionlogToAuditEvent(action *SActionlog) auditexport.SEvent {
+	return auditexport.SEvent{
+		Id:             action.Id,
+		Source:         ActionLog.Keyword(),
+		Service:        action.Service,
+		ObjType:        action.ObjType,
+		ObjId:          action.ObjId,
+		ObjName:        action.ObjName,
+		Action:         action.Action,
+		Notes:          action.Notes,
+		Success:        action.Success,
+		UserId:         action.UserId,
+		User:           action.User,
+		DomainId:       action.DomainId,
+		Domain:         action.Domain,
+		ProjectId:      action.ProjectId,
+		Project:        action.Project,
+		Roles:          action.Roles,
+		OwnerDomainId:  action.OwnerDomainId,
+		OwnerProjectId: action.OwnerProjectId,
+		OpsTime:        action.OpsTime,
+	}
+}
+
+// export sends the matching actionlogs after the cursor in batches, the
+// cursor only moves on after a batch is accepted by the receiver so that
+// every actionlog is exported at least once
+func (exporter *SAuditExporter) export(ctx context.Context, e *auditexport.SExporter) error {
+	batchSize := options.Options.AuditExportBatchSize
+	// actionlogs younger than the settle delay are left to the next round,
+	// an auto increment id may become visible after a larger one inserted
+	// by a concurrent transaction
+	settle := time.Duration(options.Options.AuditExportSettleSeconds) * time.Second
+	for {
+		q := ActionLog.Query().GT("id", exporter.LastActionlogId).LT("ops_time", time.Now().UTC().Add(-settle))
+		q = q.Asc("id").Limit(batchSize)
+		actions := make([]SActionlog, 0)
+		err := db.FetchModelObjects(ActionLog, q, &actions)
+		if err != nil {
+			return errors.Wrap(err, "FetchModelObjects")
+		}
+		if len(actions) == 0 {
+			return nil
+		}
+		events := make([]auditexport.SEvent, 0, len(actions))
+		for i := range actions {
+			if exporter.Match(&actions[i]) {
+				events = append(events, actionlogToAuditEvent(&actions[i]))
+			}
+		}
+		err = e.Export(ctx, events)
+		if err != nil {
+			return err
+		}
+		lastId := actions[len(actions)-1].Id
+		_, err = db.Update(exporter, func() error {
+			exporter.LastActionlogId = lastId
+			exporter.ExportedCount += int64(len(events))
+			if len(events) > 0 {
+				exporter.LastExportAt = time.Now().UTC()
+			}
+			exporter.Status = api.AUDIT_EXPORTER_STATUS_READY
+			exporter.LastError = ""
+			return nil
+		})
+		if err != nil {
+			return errors.Wrap(err, "db.Update")
+		}
+		if len(actions) < batchSize {
+			return nil
+		}
+	}
+}
+
+func (exporter *SAuditExporter) markError(msg string) {
+	if exporter.Status == api.AUDIT_EXPORTER_STATUS_ERROR && exporter.LastError == msg {
+		return
+	}
+	_, err := db.Update(exporter, func() error {
+		exporter.Status = api.AUDIT_EXPORTER_STATUS_ERROR
+		exporter.LastError = msg
+		return nil
+	})
+	if err != nil {
+		log.Errorf("update audit exporter %s fail %s", exporter.Name, err)
+	}
+}
+
+type sRunningAuditExporter struct {
+	// fingerprint of the receiver settings, the connection is rebuilt
+	// after they are updated
+	fingerprint string
+	exporter    *auditexport.SExporter
+}
+
+func (exporter *SAuditExporter) fingerprint() string {
+	return exporter.Format + "|" + jsonutils.Marshal(exporter.getConfig()).String()
+}
+
+func (manager *SAuditExporterManager) fetchEnabledExporters() ([]SAuditExporter, error) {
+	q := manager.Query().IsTrue("enabled")
+	exporters := make([]SAuditExporter, 0)
+	err := db.FetchModelObjects(manager, q, &exporters)
+	if err != nil {
+		return nil, errors.Wrap(err, "FetchModelObjects")
+	}
+	return exporters, nil
+}
+
+func (manager *SAuditExporterManager) exportAll(ctx context.Context, running map[string]*sRunningAuditExporter) {
+	exporters, err := manager.fetchEnabledExporters()
+	if err != nil {
+		log.Errorf("fetchEnabledExporters fail %s", err)
+		return
+	}
+	enabled := make(map[string]bool)
+	for i := range exporters {
+		exporter := &exporters[i]
+		enabled[exporter.Id] = true
+		fingerprint := exporter.fingerprint()
+		r, ok := running[exporter.Id]
+		if ok && r.fingerprint != fingerprint {
+			r.exporter.Close()
+			ok = false
+		}
+		if !ok {
+			e, err := auditexport.NewExporter(exporter.getConfig(), exporter.Format)
+			if err != nil {
+				delete(running, exporter.Id)
+				exporter.markError(err.Error())
+				continue
+			}
+			r = &sRunningAuditExporter{fingerprint: fingerprint, exporter: e}
+			running[exporter.Id] = r
+		}
+		err := exporter.export(ctx, r.exporter)
+		if err != nil {
+			log.Warningf("export actionlogs to %s fail: %s", exporter.Name, err)
+			exporter.markError(err.Error())
+			// reconnect on next round
+			r.exporter.Close()
+			delete(running, exporter.Id)
+		}
+	}
+	for id, r := range running {
+		if !enabled[id] {
+			r.exporter.Close()
+			delete(running, id)
+		}
+	}
+}
+
+func StartAuditExportWorker() {
+	go func() {
+		running := make(map[string]*sRunningAuditExporter)
+		ticker := time.NewTicker(time.Duration(options.Options.AuditExportIntervalSeconds) * time.Second)
+		defer ticker.Stop()
+		for range ticker.C {
+			AuditExporterManager.exportAll(context.Background(), running)
+		}
+	}()
+}
@@ -0,0 +1,71 @@
+// Copyright 2019 Yunion
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package models
+
+import (
+	"strings"
+	"testing"
+
+	"yunion.io/x/jsonutils"
+)
+
+func TestAuditExporterMatch(t *testing.T) {
+	exporter := &SAuditExporter{
+		ObjType: jsonutils.NewStringArray([]string{"server", "disk"}),
+		Service: jsonutils.NewStringArray([]string{"compute"}),
+	}
+	cases := []struct {
+		objType string
+		action  string
+		service string
+		want    bool
+	}{
+		{"server", "delete", "compute", true},
+		{"disk", "create", "compute", true},
+		{"user", "create", "compute", false},
+		{"server", "delete", "image", false},
+	}
+	for _, c := range cases {
+		action := &SActionlog{Service: c.service}
+		action.ObjType = c.objType
+		action.Action = c.action
+		if got := exporter.Match(action); got != c.want {
+			t.Errorf("%s %s of %s: want %v, got %v", c.action, c.objType, c.service, c.want, got)
+		}
+	}
+}
+
+func TestAuditExporterHeaders(t *testing.T) {
+	exporter := &SAuditExporter{}
+	exporter.Id = "exporter-id"
+	enc, err := exporter.encryptHeaders(map[string]string{"Authorization": "Bearer token"})
+	if err != nil {
+		t.Fatalf("encryptHeaders fail %s", err)
+	}
+	if strings.Contains(enc, "Bearer") {
+		t.Errorf("headers should not be stored in plaintext: %s", enc)
+	}
+	exporter.Headers = enc
+	if auth := exporter.getConfig().Headers["Authorization"]; auth != "Bearer token" {
+		t.Errorf("want decrypted header, got %q", auth)
+	}
+	if auth := exporter.maskedHeaders()["Authorization"]; auth != "******" {
+		t.Errorf("want masked header, got %q", auth)
+	}
+
+	if enc, _ := exporter.encryptHeaders(nil); enc != "" {
+		t.Errorf("empty headers should be stored empty, got %q", enc)
+	}
+}
@@ -0,0 +1,578 @@
+// Copyright 2019 Yunion
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package models
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"database/sql"
+	"encoding/hex"
+	"fmt"
+	"io/ioutil"
+	"net"
+	"net/http"
+	"strconv"
+	"syscall"
+	"time"
+
+	"yunion.io/x/jsonutils"
+	"yunion.io/x/log"
+	"yunion.io/x/pkg/errors"
+	"yunion.io/x/sqlchemy"
+
+	api "yunion.io/x/onecloud/pkg/apis/logger"
+	"yunion.io/x/onecloud/pkg/cloudcommon/db"
+	"yunion.io/x/onecloud/pkg/httperrors"
+	"yunion.io/x/onecloud/pkg/logger/options"
+	"yunion.io/x/onecloud/pkg/mcclient"
+	"yunion.io/x/onecloud/pkg/util/httputils"
+	"yunion.io/x/onecloud/pkg/util/rbacutils"
+	"yunion.io/x/onecloud/pkg/util/stringutils2"
+)
+
+type SWebhookDeliveryManager struct {
+	db.SStandaloneAnonResourceBaseManager
+	db.SProjectizedResourceBaseManager
+	db.SStatusResourceBaseManager
+}
+
+var WebhookDeliveryManager *SWebhookDeliveryManager
+
+func init() {
+	WebhookDeliveryManager = &SWebhookDeliveryManager{
+		SStandaloneAnonResourceBaseManager: db.NewStandaloneAnonResourceBaseManager(
+			SWebhookDelivery{},
+			"webhook_deliveries_tbl",
+			"webhook_delivery",
+			"webhook_deliveries",
+		),
+	}
+	WebhookDeliveryManager.SetVirtualObject(WebhookDeliveryManager)
+}
+
+// SWebhookDelivery is a delivery of an actionlog or a resource event to a
+// webhook subscription
+type SWebhookDelivery struct {
+	db.SStandaloneAnonResourceBase
+	db.SProjectizedResourceBase
+	db.SStatusResourceBase
+
+	// 订阅ID
+	SubscriptionId string `width:"36" charset:"ascii" nullable:"false" list:"user" index:"true"`
+	// 事件来源, actionlog或opslog
+	Source string `width:"16" charset:"ascii" nullable:"false" default:"actionlog" list:"user"`
+	// 产生事件的服务
+	Service string `width:"32" charset:"utf8" nullable:"true" list:"user"`
+	// 操作日志ID
+	ActionlogId int64 `nullable:"false" list:"user"`
+	// 资源事件在其服务中的ID
+	OpslogId int64 `nullable:"false" default:"0" list:"user"`
+
+	ObjType string `width:"40" charset:"ascii" nullable:"false" list:"user"`
+	ObjId   string `width:"128" charset:"ascii" nullable:"false" list:"user" index:"true"`
+	Action  string `width:"32" charset:"utf8" nullable:"false" list:"user"`
+
+	// 投递的内容
+	Payload string `charset:"utf8" nullable:"false" get:"user"`
+
+	// 已投递次数
+	Attempts int `nullable:"false" default:"0" list:"user"`
+	// 下次投递时间
+	NextAttemptAt time.Time `nullable:"true" list:"user" index:"true"`
+	// 最近一次投递时间
+	LastAttemptAt time.Time `nullable:"true" list:"user"`
+	// 最近一次投递的HTTP状态码
+	ResponseCode int `nullable:"false" default:"0" list:"user"`
+	// 最近一次投递的错误信息
+	LastError string `charset:"utf8" nullable:"true" list:"user"`
+}
+
+func (manager *SWebhookDeliveryManager) AllowListItems(ctx context.Context, userCred mcclient.TokenCredential, query jsonutils.JSONObject) bool {
+	return db.IsAllowList(rbacutils.ScopeProject, userCred, manager)
+}
+
+func (manager *SWebhookDeliveryManager) AllowCreateItem(ctx context.Context, userCred mcclient.TokenCredential, query jsonutils.JSONObject, data jsonutils.JSONObject) bool {
+	return false
+}
+
+func (delivery *SWebhookDelivery) AllowGetDetails(ctx context.Context, userCred mcclient.TokenCredential, query jsonutils.JSONObject) bool {
+	return db.IsAllowGet(rbacutils.ScopeProject, userCred, delivery)
+}
+
+func (delivery *SWebhookDelivery) AllowUpdateItem(ctx context.Context, userCred mcclient.TokenCredential) bool {
+	return false
+}
+
+func (delivery *SWebhookDelivery) AllowDeleteItem(ctx context.Context, userCred mcclient.TokenCredential, query jsonutils.JSONObject, data jsonutils.JSONObject) bool {
+	return db.IsAllowDelete(rbacutils.ScopeProject, userCred, delivery)
+}
+
+func (manager *SWebhookDeliveryManager) ResourceScope() rbacutils.TRbacScope {
+	return manager.SProjectizedResourceBaseManager.ResourceScope()
+}
+
+func (manager *SWebhookDeliveryManager) FilterByOwner(q *sqlchemy.SQuery, owner mcclient.IIdentityProvider, scope rbacutils.TRbacScope) *sqlchemy.SQuery {
+	return manager.SProjectizedResourceBaseManager.FilterByOwner(q, owner, scope)
+}
+
+func (manager *SWebhookDeliveryManager) FetchOwnerId(ctx context.Context, data jsonutils.JSONObject) (mcclient.IIdentityProvider, error) {
+	return manager.SProjectizedResourceBaseManager.FetchOwnerId(ctx, data)
+}
+
+func (delivery *SWebhookDelivery) GetOwnerId() mcclient.IIdentityProvider {
+	return delivery.SProjectizedResourceBase.GetOwnerId()
+}
+
+// Webhook投递记录列表, 以status=dead过滤即为死信列表
+func (manager *SWebhookDeliveryManager) ListItemFilter(
+	ctx context.Context,
+	q *sqlchemy.SQuery,
+	userCred mcclient.TokenCredential,
+	query api.WebhookDeliveryListInput,
+) (*sqlchemy.SQuery, error) {
+	var err error
+	q, err = manager.SStandaloneAnonResourceBaseManager.ListItemFilter(ctx, q, userCred, query.StandaloneAnonResourceListInput)
+	if err != nil {
+		return nil, errors.Wrap(err, "SStandaloneAnonResourceBaseManager.ListItemFilter")
+	}
+	q, err = manager.SProjectizedResourceBaseManager.ListItemFilter(ctx, q, userCred, query.ProjectizedResourceListInput)
+	if err != nil {
+		return nil, errors.Wrap(err, "SProjectizedResourceBaseManager.ListItemFilter")
+	}
+	q, err = manager.SStatusResourceBaseManager.ListItemFilter(ctx, q, userCred, query.StatusResourceBaseListInput)
+	if err != nil {
+		return nil, errors.Wrap(err, "SStatusResourceBaseManager.ListItemFilter")
+	}
+	if len(query.SubscriptionId) > 0 {
+		sub, err := WebhookSubscriptionManager.FetchByIdOrName(userCred, query.SubscriptionId)
+		if err != nil {
+			if errors.Cause(err) == sqlchemy.ErrEmptyQuery {
+				return nil, httperrors.NewResourceNotFoundError2(WebhookSubscriptionManager.Keyword(), query.SubscriptionId)
+			}
+			return nil, httperrors.NewGeneralError(err)
+		}
+		q = q.Equals("subscription_id", sub.GetId())
+	}
+	if len(query.ObjType) > 0 {
+		q = q.In("obj_type", query.ObjType)
+	}
+	if len(query.ObjId) > 0 {
+		q = q.In("obj_id", query.ObjId)
+	}
+	if len(query.Action) > 0 {
+		q = q.In("action", query.Action)
+	}
+	if len(query.Source) > 0 {
+		q = q.In("source", query.Source)
+	}
+	return q, nil
+}
+
+func (manager *SWebhookDeliveryManager) OrderByExtraFields(
+	ctx context.Context,
+	q *sqlchemy.SQuery,
+	userCred mcclient.TokenCredential,
+	query api.WebhookDeliveryListInput,
+) (*sqlchemy.SQuery, error) {
+	var err error
+	q, err = manager.SStandaloneAnonResourceBaseManager.OrderByExtraFields(ctx, q, userCred, query.StandaloneAnonResourceListInput)
+	if err != nil {
+		return nil, errors.Wrap(err, "SStandaloneAnonResourceBaseManager.OrderByExtraFields")
+	}
+	q, err = manager.SProjectizedResourceBaseManager.OrderByExtraFields(ctx, q, userCred, query.ProjectizedResourceListInput)
+	if err != nil {
+		return nil, errors.Wrap(err, "SProjectizedResourceBaseManager.OrderByExtraFields")
+	}
+	return q, nil
+}
+
+func (manager *SWebhookDeliveryManager) QueryDistinctExtraField(q *sqlchemy.SQuery, field string) (*sqlchemy.SQuery, error) {
+	var err error
+	q, err = manager.SStandaloneAnonResourceBaseManager.QueryDistinctExtraField(q, field)
+	if err == nil {
+		return q, nil
+	}
+	q, err = manager.SProjectizedResourceBaseManager.QueryDistinctExtraField(q, field)
+	if err == nil {
+		return q, nil
+	}
+	return q, httperrors.ErrNotFound
+}
+
+func (manager *SWebhookDeliveryManager) FetchCustomizeColumns(
+	ctx context.Context,
+	userCred mcclient.TokenCredential,
+	query jsonutils.JSONObject,
+	objs []interface{},
+	fields stringutils2.SSortedStrings,
+	isList bool,
+) []api.WebhookDeliveryDetails {
+	rows := make([]api.WebhookDeliveryDetails, len(objs))
+	stdRows := manager.SStandaloneAnonResourceBaseManager.FetchCustomizeColumns(ctx, userCred, query, objs, fields, isList)
+	projRows := manager.SProjectizedResourceBaseManager.FetchCustomizeColumns(ctx, userCred, query, objs, fields, isList)
+	subIds := make([]string, len(objs))
+	for i := range rows {
+		rows[i] = api.WebhookDeliveryDetails{
+			StandaloneAnonResourceDetails: stdRows[i],
+			ProjectizedResourceInfo:       projRows[i],
+		}
+		subIds[i] = objs[i].(*SWebhookDelivery).SubscriptionId
+	}
+	subs := make(map[string]SWebhookSubscription)
+	err := db.FetchStandaloneObjectsByIds(WebhookSubscriptionManager, subIds, &subs)
+	if err != nil {
+		log.Errorf("FetchStandaloneObjectsByIds fail %s", err)
+		return rows
+	}
+	for i := range rows {
+		if sub, ok := subs[subIds[i]]; ok {
+			rows[i].Subscription = sub.Name
+		}
+	}
+	return rows
+}
+
+func (manager *SWebhookDeliveryManager) ListItemExportKeys(ctx context.Context, q *sqlchemy.SQuery, userCred mcclient.TokenCredential, keys stringutils2.SSortedStrings) (*sqlchemy.SQuery, error) {
+	q, err := manager.SStandaloneAnonResourceBaseManager.ListItemExportKeys(ctx, q, userCred, keys)
+	if err != nil {
+		return nil, errors.Wrap(err, "SStandaloneAnonResourceBaseManager.ListItemExportKeys")
+	}
+	q, err = manager.SProjectizedResourceBaseManager.ListItemExportKeys(ctx, q, userCred, keys)
+	if err != nil {
+		return nil, errors.Wrap(err, "SProjectizedResourceBaseManager.ListItemExportKeys")
+	}
+	return q, nil
+}
+
+// fetchStatusCounts returns the number of deliveries in each status of the subscriptions
+func (manager *SWebhookDeliveryManager) fetchStatusCounts(subIds []string) (map[string]map[string]int, error) {
+	ret := make(map[string]map[string]int)
+	if len(subIds) == 0 {
+		return ret, nil
+	}
+	deliveries := manager.Query().SubQuery()
+	q := deliveries.Query(
+		deliveries.Field("subscription_id"),
+		deliveries.Field("status"),
+		sqlchemy.COUNT("count"),
+	).In("subscription_id", subIds).GroupBy(deliveries.Field("subscription_id"), deliveries.Field("status"))
+	counts := []struct {
+		SubscriptionId string
+		Status         string
+		Count          int
+	}{}
+	err := q.All(&counts)
+	if err != nil {
+		return nil, errors.Wrap(err, "q.All")
+	}
+	for _, cnt := range counts {
+		if _, ok := ret[cnt.SubscriptionId]; !ok {
+			ret[cnt.SubscriptionId] = make(map[string]int)
+		}
+		ret[cnt.SubscriptionId][cnt.Status] = cnt.Count
+	}
+	return ret, nil
+}
+
+func newWebhookEvent(sub *SWebhookSubscription, source string, action *SActionlog) api.WebhookEvent {
+	event := api.WebhookEvent{
+		SubscriptionId: sub.Id,
+		Source:         source,
+		ObjType:        action.ObjType,
+		ObjId:          action.ObjId,
+		ObjName:        action.ObjName,
+		Action:         action.Action,
+		Success:        action.Success,
+		Service:        action.Service,
+		UserId:         action.UserId,
+		User:           action.User,
+		OwnerProjectId: action.OwnerProjectId,
+		OwnerDomainId:  action.OwnerDomainId,
+		OpsTime:        action.OpsTime,
+	}
+	notes, err := jsonutils.ParseString(action.Notes)
+	if err != nil {
+		notes = jsonutils.NewString(action.Notes)
+	}
+	event.Notes = notes
+	return event
+}
+
+// webhookDeliveryId derives the id of the delivery of an event to a
+// subscription, so that an event dispatched again after a failure does not
+// queue a second delivery to the subscriptions that already have one
+func webhookDeliveryId(subId string, source string, service string, eventId int64) string {
+	sum := sha256.Sum256([]byte(fmt.Sprintf("%s/%s/%s/%d", subId, source, service, eventId)))
+	h := hex.EncodeToString(sum[:16])
+	return fmt.Sprintf("%s-%s-%s-%s-%s", h[:8], h[8:12], h[12:16], h[16:20], h[20:])
+}
+
+func (manager *SWebhookDeliveryManager) deliveryExists(id string) (bool, error) {
+	cnt, err := manager.RawQuery().Equals("id", id).CountWithError()
+	if err != nil {
+		return false, errors.Wrap(err, "CountWithError")
+	}
+	return cnt > 0, nil
+}
+
+// createDelivery queues the delivery of the event to the subscription, it
+// does nothing if the delivery has been queued before
+func (manager *SWebhookDeliveryManager) createDelivery(ctx context.Context, sub *SWebhookSubscription, source string, action *SActionlog) error {
+	id := webhookDeliveryId(sub.Id, source, action.Service, action.Id)
+	exists, err := manager.deliveryExists(id)
+	if err != nil {
+		return err
+	}
+	if exists {
+		return nil
+	}
+	event := newWebhookEvent(sub, source, action)
+	event.DeliveryId = id
+	delivery := &SWebhookDelivery{
+		SubscriptionId: sub.Id,
+		Source:         source,
+		Service:        action.Service,
+		ObjType:        action.ObjType,
+		ObjId:          action.ObjId,
+		Action:         action.Action,
+		Payload:        jsonutils.Marshal(event).String(),
+		NextAttemptAt:  time.Now().UTC(),
+	}
+	delivery.Id = id
+	if source == api.WEBHOOK_SOURCE_OPSLOG {
+		delivery.OpslogId = action.Id
+	} else {
+		delivery.ActionlogId = action.Id
+	}
+	delivery.ProjectId = sub.ProjectId
+	delivery.DomainId = sub.DomainId
+	delivery.Status = api.WEBHOOK_DELIVERY_STATUS_PENDING
+	delivery.SetModelManager(manager, delivery)
+	err = manager.TableSpec().Insert(ctx, delivery)
+	if err != nil {
+		// another logger replica may have queued it in the meantime
+		if exists, _ := manager.deliveryExists(id); exists {
+			return nil
+		}
+		return errors.Wrap(err, "Insert")
+	}
+	return nil
+}
+
+// SignWebhookPayload returns the hex encoded HMAC-SHA256 of "<timestamp>.<payload>",
+// the receiver recomputes it with the shared secret to verify the request
+func SignWebhookPayload(secret string, timestamp int64, payload []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(strconv.FormatInt(timestamp, 10)))
+	mac.Write([]byte("."))
+	mac.Write(payload)
+	return hex.EncodeToString(mac.Sum(nil))
+}
+
+// webhookRetryBackoff returns the interval before the next attempt after
+// the given number of failed attempts, doubling from base up to max
+func webhookRetryBackoff(attempts int, base, max time.Duration) time.Duration {
+	backoff := base
+	for i := 1; i < attempts && backoff < max; i++ {
+		backoff *= 2
+	}
+	if backoff > max {
+		backoff = max
+	}
+	return backoff
+}
+
+func (delivery *SWebhookDelivery) AllowPerformRedeliver(ctx context.Context, userCred mcclient.TokenCredential, query jsonutils.JSONObject, data jsonutils.JSONObject) bool {
+	return db.IsAllowPerform(rbacutils.ScopeProject, userCred, delivery, "redeliver")
+}
+
+// 重新投递, 投递次数清零
+func (delivery *SWebhookDelivery) PerformRedeliver(ctx context.Context, userCred mcclient.TokenCredential, query jsonutils.JSONObject, data jsonutils.JSONObject) (jsonutils.JSONObject, error) {
+	if delivery.Status == api.WEBHOOK_DELIVERY_STATUS_PENDING {
+		return nil, nil
+	}
+	_, err := db.Update(delivery, func() error {
+		delivery.Status = api.WEBHOOK_DELIVERY_STATUS_PENDING
+		delivery.Attempts = 0
+		delivery.NextAttemptAt = time.Now().UTC()
+		return nil
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "db.Update")
+	}
+	triggerWebhookDelivery()
+	return nil, nil
+}
+
+func (delivery *SWebhookDelivery) markAttempt(status string, code int, msg string, next time.Time) error {
+	_, err := db.Update(delivery, func() error {
+		delivery.Status = status
+		delivery.Attempts += 1
+		delivery.LastAttemptAt = time.Now().UTC()
+		delivery.ResponseCode = code
+		delivery.LastError = msg
+		delivery.NextAttemptAt = next
+		return nil
+	})
+	return err
+}
+
+func (delivery *SWebhookDelivery) post(ctx context.Context, client *http.Client, sub *SWebhookSubscription) (int, error) {
+	secret, err := sub.getSecret()
+	if err != nil {
+		return 0, errors.Wrap(err, "getSecret")
+	}
+	payload := []byte(delivery.Payload)
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, sub.Url, bytes.NewReader(payload))
+	if err != nil {
+		return 0, errors.Wrap(err, "NewRequest")
+	}
+	timestamp := time.Now().Unix()
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set(api.WEBHOOK_HEADER_EVENT, fmt.Sprintf("%s.%s", delivery.ObjType, delivery.Action))
+	req.Header.Set(api.WEBHOOK_HEADER_DELIVERY, delivery.Id)
+	req.Header.Set(api.WEBHOOK_HEADER_TIMESTAMP, strconv.FormatInt(timestamp, 10))
+	if len(secret) > 0 {
+		req.Header.Set(api.WEBHOOK_HEADER_SIGNATURE, "sha256="+SignWebhookPayload(secret, timestamp, payload))
+	}
+	resp, err := client.Do(req)
+	if err != nil {
+		return 0, err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		body, _ := ioutil.ReadAll(http.MaxBytesReader(nil, resp.Body, 1024))
+		return resp.StatusCode, fmt.Errorf("unexpected status %s: %s", resp.Status, body)
+	}
+	return resp.StatusCode, nil
+}
+
+// attempt posts the delivery once and returns the resulting status, the
+// response code, the error message and the time of the next attempt
+func (delivery *SWebhookDelivery) attempt(ctx context.Context, client *http.Client, sub *SWebhookSubscription) (string, int, string, time.Time) {
+	code, err := delivery.post(ctx, client, sub)
+	if err == nil {
+		return api.WEBHOOK_DELIVERY_STATUS_SUCCESS, code, "", time.Time{}
+	}
+	log.Warningf("deliver webhook %s to %s fail: %s", delivery.Id, sub.Url, err)
+	if delivery.Attempts+1 > sub.MaxRetries {
+		return api.WEBHOOK_DELIVERY_STATUS_DEAD, code, err.Error(), time.Time{}
+	}
+	backoff := webhookRetryBackoff(delivery.Attempts+1,
+		time.Duration(options.Options.WebhookRetryBaseSeconds)*time.Second,
+		time.Duration(options.Options.WebhookRetryMaxSeconds)*time.Second,
+	)
+	return api.WEBHOOK_DELIVERY_STATUS_RETRYING, code, err.Error(), time.Now().UTC().Add(backoff)
+}
+
+func (delivery *SWebhookDelivery) deliver(ctx context.Context, client *http.Client) error {
+	subObj, err := WebhookSubscriptionManager.FetchById(delivery.SubscriptionId)
+	if err != nil {
+		if errors.Cause(err) == sql.ErrNoRows {
+			return delivery.markAttempt(api.WEBHOOK_DELIVERY_STATUS_DEAD, 0, "subscription deleted", time.Time{})
+		}
+		return errors.Wrapf(err, "fetch webhook subscription %s", delivery.SubscriptionId)
+	}
+	status, code, msg, next := delivery.attempt(ctx, client, subObj.(*SWebhookSubscription))
+	return delivery.markAttempt(status, code, msg, next)
+}
+
+// newWebhookClient returns the http client of deliveries, the address is
+// checked again when connecting as the host may resolve to another address
+// than the one validated on creation
+func newWebhookClient(timeout time.Duration) *http.Client {
+	dialer := &net.Dialer{
+		Timeout: timeout,
+		Control: func(network, address string, c syscall.RawConn) error {
+			host, _, err := net.SplitHostPort(address)
+			if err != nil {
+				return err
+			}
+			ip := net.ParseIP(host)
+			if ip == nil {
+				return fmt.Errorf("invalid address %s", address)
+			}
+			return checkWebhookAddress(ip)
+		},
+	}
+	tr := httputils.GetTransport(true)
+	// a proxy would connect to the address on our behalf without the check
+	tr.Proxy = nil
+	tr.DialContext = dialer.DialContext
+	return &http.Client{
+		Transport: tr,
+		Timeout:   timeout,
+	}
+}
+
+func (manager *SWebhookDeliveryManager) fetchDueDeliveries(limit int) ([]SWebhookDelivery, error) {
+	q := manager.Query().In("status", []string{api.WEBHOOK_DELIVERY_STATUS_PENDING, api.WEBHOOK_DELIVERY_STATUS_RETRYING})
+	q = q.LE("next_attempt_at", time.Now().UTC()).Asc("next_attempt_at").Limit(limit)
+	deliveries := make([]SWebhookDelivery, 0)
+	err := db.FetchModelObjects(manager, q, &deliveries)
+	if err != nil {
+		return nil, errors.Wrap(err, "FetchModelObjects")
+	}
+	return deliveries, nil
+}
+
+func (manager *SWebhookDeliveryManager) deliverDue(ctx context.Context) {
+	client := newWebhookClient(time.Duration(options.Options.WebhookDeliveryTimeoutSeconds) * time.Second)
+	for {
+		deliveries, err := manager.fetchDueDeliveries(100)
+		if err != nil {
+			log.Errorf("fetchDueDeliveries fail %s", err)
+			return
+		}
+		for i := range deliveries {
+			// a delivery that can not be settled stays due, leave it to
+			// the next round instead of fetching it again at once
+			err := deliveries[i].deliver(ctx, client)
+			if err != nil {
+				log.Errorf("deliver webhook %s fail %s", deliveries[i].Id, err)
+				return
+			}
+		}
+		if len(deliveries) < 100 {
+			return
+		}
+	}
+}
+
+var webhookDeliveryTrigger = make(chan struct{}, 1)
+
+func triggerWebhookDelivery() {
+	select {
+	case webhookDeliveryTrigger <- struct{}{}:
+	default:
+	}
+}
+
+func StartWebhookDeliveryWorker() {
+	go func() {
+		ticker := time.NewTicker(time.Duration(options.Options.WebhookDeliveryIntervalSeconds) * time.Second)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ticker.C:
+			case <-webhookDeliveryTrigger:
+			}
+			dispatchEvents(context.Background())
+			WebhookDeliveryManager.deliverDue(context.Background())
+		}
+	}()
+}
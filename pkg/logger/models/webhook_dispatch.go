@@ -0,0 +1,314 @@
+// Copyright 2019 Yunion
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package models
+
+import (
+	"context"
+	"sort"
+	"strings"
+	"time"
+
+	"yunion.io/x/jsonutils"
+	"yunion.io/x/log"
+	"yunion.io/x/pkg/errors"
+	"yunion.io/x/sqlchemy"
+
+	api "yunion.io/x/onecloud/pkg/apis/logger"
+	"yunion.io/x/onecloud/pkg/cloudcommon/db"
+	"yunion.io/x/onecloud/pkg/logger/options"
+	"yunion.io/x/onecloud/pkg/mcclient"
+	"yunion.io/x/onecloud/pkg/mcclient/auth"
+	"yunion.io/x/onecloud/pkg/mcclient/modulebase"
+	"yunion.io/x/onecloud/pkg/mcclient/modules"
+)
+
+const (
+	webhookDispatchBatchSize = 200
+	// events younger than the settle delay are left to the next round, an
+	// auto increment id may become visible after a larger one inserted by a
+	// concurrent transaction
+	webhookDispatchSettleDelay = 2 * time.Second
+	// opslogs are listed since a while before the cursor, those of the same
+	// time are told apart by their ids
+	webhookOpslogOverlap = time.Minute
+)
+
+type SWebhookCursorManager struct {
+	db.SModelBaseManager
+}
+
+var WebhookCursorManager *SWebhookCursorManager
+
+func init() {
+	WebhookCursorManager = &SWebhookCursorManager{
+		SModelBaseManager: db.NewModelBaseManager(
+			SWebhookCursor{},
+			"webhook_cursors_tbl",
+			"webhook_cursor",
+			"webhook_cursors",
+		),
+	}
+	WebhookCursorManager.SetVirtualObject(WebhookCursorManager)
+}
+
+// SWebhookCursor records the last event of a source queued for delivery,
+// dispatching resumes after it on restart
+type SWebhookCursor struct {
+	db.SModelBase
+
+	// 事件来源, actionlog或opslog-<service>
+	Id string `width:"64" charset:"ascii" primary:"true"`
+
+	// 最近分发的事件ID
+	LastId int64 `nullable:"false" default:"0"`
+	// 最近分发的事件时间
+	LastOpsTime time.Time `nullable:"true"`
+
+	UpdatedAt time.Time `nullable:"true"`
+}
+
+func (manager *SWebhookCursorManager) fetchCursor(id string) (*SWebhookCursor, error) {
+	cursor := &SWebhookCursor{}
+	err := manager.Query().Equals("id", id).First(cursor)
+	if err != nil {
+		if errors.Cause(err) == sqlchemy.ErrEmptyQuery {
+			return nil, nil
+		}
+		return nil, errors.Wrap(err, "query cursor")
+	}
+	return cursor, nil
+}
+
+func (manager *SWebhookCursorManager) saveCursor(ctx context.Context, id string, lastId int64, lastOpsTime time.Time) error {
+	cursor := &SWebhookCursor{
+		Id:          id,
+		LastId:      lastId,
+		LastOpsTime: lastOpsTime,
+		UpdatedAt:   time.Now().UTC(),
+	}
+	cursor.SetModelManager(manager, cursor)
+	err := manager.TableSpec().InsertOrUpdate(ctx, cursor)
+	if err != nil && errors.Cause(err) != sqlchemy.ErrUnexpectRowCount {
+		return err
+	}
+	return nil
+}
+
+// dispatchEvent queues a delivery of the event for every matching subscription,
+// on failure the event is dispatched again in the next round, in which the
+// subscriptions with a queued delivery are skipped
+func dispatchEvent(ctx context.Context, subs []SWebhookSubscription, source string, action *SActionlog) error {
+	var errs []error
+	for i := range subs {
+		if !subs[i].Match(source, action) {
+			continue
+		}
+		err := WebhookDeliveryManager.createDelivery(ctx, &subs[i], source, action)
+		if err != nil {
+			errs = append(errs, errors.Wrapf(err, "create delivery of %s %d for subscription %s", source, action.Id, subs[i].Id))
+		}
+	}
+	return errors.NewAggregate(errs)
+}
+
+// dispatchActionlogs queues the deliveries of the actionlogs after the cursor,
+// a new cursor starts from the actionlogs created after it
+func dispatchActionlogs(ctx context.Context, subs []SWebhookSubscription) error {
+	cursorId := api.WEBHOOK_SOURCE_ACTIONLOG
+	cursor, err := WebhookCursorManager.fetchCursor(cursorId)
+	if err != nil {
+		return errors.Wrap(err, "fetchCursor")
+	}
+	if cursor == nil {
+		lastId, err := ActionLog.fetchLastId()
+		if err != nil {
+			return errors.Wrap(err, "fetchLastId")
+		}
+		return WebhookCursorManager.saveCursor(ctx, cursorId, lastId, time.Time{})
+	}
+	lastId := cursor.LastId
+	for {
+		q := ActionLog.Query().GT("id", lastId).LT("ops_time", time.Now().UTC().Add(-webhookDispatchSettleDelay))
+		q = q.Asc("id").Limit(webhookDispatchBatchSize)
+		actions := make([]SActionlog, 0)
+		err := db.FetchModelObjects(ActionLog, q, &actions)
+		if err != nil {
+			return errors.Wrap(err, "FetchModelObjects")
+		}
+		if len(actions) == 0 {
+			return nil
+		}
+		for i := range actions {
+			err = dispatchEvent(ctx, subs, api.WEBHOOK_SOURCE_ACTIONLOG, &actions[i])
+			if err != nil {
+				break
+			}
+			lastId = actions[i].Id
+		}
+		if lastId > cursor.LastId {
+			saveErr := WebhookCursorManager.saveCursor(ctx, cursorId, lastId, time.Time{})
+			if saveErr != nil {
+				return errors.Wrap(saveErr, "saveCursor")
+			}
+			cursor.LastId = lastId
+		}
+		if err != nil {
+			return err
+		}
+		if len(actions) < webhookDispatchBatchSize {
+			return nil
+		}
+	}
+}
+
+func getOpslogModule(service string) *modulebase.ResourceManager {
+	switch service {
+	case "compute":
+		return &modules.Logs
+	case "image":
+		return &modules.ImageLogs
+	case "identity":
+		return &modules.IdentityLogs
+	}
+	return nil
+}
+
+func opslogToActionlog(service string, obj jsonutils.JSONObject) SActionlog {
+	action := SActionlog{Service: service}
+	action.Id, _ = obj.Int("id")
+	action.ObjType, _ = obj.GetString("obj_type")
+	action.ObjId, _ = obj.GetString("obj_id")
+	action.ObjName, _ = obj.GetString("obj_name")
+	action.Action, _ = obj.GetString("action")
+	action.Notes, _ = obj.GetString("notes")
+	action.UserId, _ = obj.GetString("user_id")
+	action.User, _ = obj.GetString("user")
+	action.OwnerProjectId, _ = obj.GetString("owner_tenant_id")
+	action.OwnerDomainId, _ = obj.GetString("owner_domain_id")
+	action.OpsTime, _ = obj.GetTime("ops_time")
+	action.Success = !strings.HasSuffix(action.Action, "_fail")
+	return action
+}
+
+// fetchOpslogs lists the opslogs of the service after the cursor in the
+// order of their ids, the list api returns the latest ones first
+func fetchOpslogs(s *mcclient.ClientSession, service string, module *modulebase.ResourceManager, cursor *SWebhookCursor) ([]SActionlog, error) {
+	params := jsonutils.NewDict()
+	params.Set("scope", jsonutils.NewString("system"))
+	params.Set("limit", jsonutils.NewInt(webhookDispatchBatchSize))
+	if !cursor.LastOpsTime.IsZero() {
+		params.Set("since", jsonutils.NewTimeString(cursor.LastOpsTime.Add(-webhookOpslogOverlap)))
+	}
+	settled := time.Now().UTC().Add(-webhookDispatchSettleDelay)
+	opslogs := make([]SActionlog, 0)
+	for {
+		result, err := module.List(s, params)
+		if err != nil {
+			return nil, errors.Wrap(err, "List")
+		}
+		reached := false
+		for i := range result.Data {
+			opslog := opslogToActionlog(service, result.Data[i])
+			if opslog.Id <= cursor.LastId {
+				reached = true
+				break
+			}
+			if opslog.OpsTime.Before(settled) {
+				opslogs = append(opslogs, opslog)
+			}
+		}
+		if reached || len(result.NextMarker) == 0 {
+			break
+		}
+		params.Set("paging_marker", jsonutils.NewString(result.NextMarker))
+	}
+	// the unsettled ones are the latest, those kept are contiguous
+	sort.Slice(opslogs, func(i, j int) bool {
+		return opslogs[i].Id < opslogs[j].Id
+	})
+	return opslogs, nil
+}
+
+// dispatchOpslogs queues the deliveries of the resource events recorded by
+// the service after the cursor, a new cursor starts from the latest one
+func dispatchOpslogs(ctx context.Context, s *mcclient.ClientSession, service string, subs []SWebhookSubscription) error {
+	module := getOpslogModule(service)
+	if module == nil {
+		return errors.Wrapf(errors.ErrNotSupported, "opslogs of service %s", service)
+	}
+	cursorId := api.WEBHOOK_SOURCE_OPSLOG + "-" + service
+	cursor, err := WebhookCursorManager.fetchCursor(cursorId)
+	if err != nil {
+		return errors.Wrap(err, "fetchCursor")
+	}
+	if cursor == nil {
+		params := jsonutils.NewDict()
+		params.Set("scope", jsonutils.NewString("system"))
+		params.Set("limit", jsonutils.NewInt(1))
+		result, err := module.List(s, params)
+		if err != nil {
+			return errors.Wrap(err, "List")
+		}
+		latest := SActionlog{}
+		if len(result.Data) > 0 {
+			latest = opslogToActionlog(service, result.Data[0])
+		}
+		return WebhookCursorManager.saveCursor(ctx, cursorId, latest.Id, latest.OpsTime)
+	}
+	opslogs, err := fetchOpslogs(s, service, module, cursor)
+	if err != nil {
+		return errors.Wrap(err, "fetchOpslogs")
+	}
+	var last *SActionlog
+	for i := range opslogs {
+		err = dispatchEvent(ctx, subs, api.WEBHOOK_SOURCE_OPSLOG, &opslogs[i])
+		if err != nil {
+			break
+		}
+		last = &opslogs[i]
+	}
+	if last != nil {
+		saveErr := WebhookCursorManager.saveCursor(ctx, cursorId, last.Id, last.OpsTime)
+		if saveErr != nil {
+			return errors.Wrap(saveErr, "saveCursor")
+		}
+	}
+	return err
+}
+
+// dispatchEvents queues the deliveries of the new actionlogs and resource
+// events, it runs in the delivery worker instead of the creation of
+// actionlogs so that a slow subscription query does not hold up the api
+func dispatchEvents(ctx context.Context) {
+	subs, err := WebhookSubscriptionManager.fetchEnabledSubscriptions()
+	if err != nil {
+		log.Errorf("fetchEnabledSubscriptions fail %s", err)
+		return
+	}
+	err = dispatchActionlogs(ctx, subs)
+	if err != nil {
+		log.Errorf("dispatch actionlogs to webhooks fail %s", err)
+	}
+	if len(options.Options.WebhookOpslogServices) == 0 {
+		return
+	}
+	s := auth.GetAdminSession(ctx, options.Options.Region, "")
+	for _, service := range options.Options.WebhookOpslogServices {
+		err := dispatchOpslogs(ctx, s, service, subs)
+		if err != nil {
+			log.Errorf("dispatch opslogs of %s to webhooks fail %s", service, err)
+		}
+	}
+}
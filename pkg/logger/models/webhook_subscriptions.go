@@ -0,0 +1,421 @@
+// Copyright 2019 Yunion
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package models
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"net/url"
+
+	"yunion.io/x/jsonutils"
+	"yunion.io/x/log"
+	"yunion.io/x/pkg/errors"
+	"yunion.io/x/pkg/tristate"
+	"yunion.io/x/pkg/utils"
+	"yunion.io/x/sqlchemy"
+
+	"yunion.io/x/onecloud/pkg/apis"
+	api "yunion.io/x/onecloud/pkg/apis/logger"
+	"yunion.io/x/onecloud/pkg/cloudcommon/db"
+	"yunion.io/x/onecloud/pkg/httperrors"
+	"yunion.io/x/onecloud/pkg/logger/options"
+	"yunion.io/x/onecloud/pkg/mcclient"
+	"yunion.io/x/onecloud/pkg/util/stringutils2"
+)
+
+type SWebhookSubscriptionManager struct {
+	db.SVirtualResourceBaseManager
+	db.SEnabledResourceBaseManager
+}
+
+var WebhookSubscriptionManager *SWebhookSubscriptionManager
+
+func init() {
+	WebhookSubscriptionManager = &SWebhookSubscriptionManager{
+		SVirtualResourceBaseManager: db.NewVirtualResourceBaseManager(
+			SWebhookSubscription{},
+			"webhook_subscriptions_tbl",
+			"webhook_subscription",
+			"webhook_subscriptions",
+		),
+	}
+	WebhookSubscriptionManager.SetVirtualObject(WebhookSubscriptionManager)
+}
+
+// SWebhookSubscription delivers the actionlogs and resource events matching
+// its filter to an HTTP endpoint
+type SWebhookSubscription struct {
+	db.SVirtualResourceBase
+	db.SEnabledResourceBase
+
+	// 接收事件的地址
+	Url string `width:"512" charset:"ascii" nullable:"false" list:"user" create:"required" update:"user"`
+	// 签名密钥, 加密保存
+	Secret string `width:"512" charset:"ascii" nullable:"true"`
+
+	// 订阅的资源类型, 为空表示所有资源类型
+	ObjType *jsonutils.JSONArray `nullable:"true" list:"user" create:"optional" update:"user"`
+	// 订阅的操作, 为空表示所有操作
+	Action *jsonutils.JSONArray `nullable:"true" list:"user" create:"optional" update:"user"`
+	// 订阅的事件来源, 为空表示所有来源
+	Source *jsonutils.JSONArray `nullable:"true" list:"user" create:"optional" update:"user"`
+
+	// 订阅的事件范围
+	ResourceScope string `width:"16" charset:"ascii" nullable:"false" default:"project" list:"user" create:"optional"`
+
+	// 最大重试次数
+	MaxRetries int `nullable:"false" default:"8" list:"user" create:"optional" update:"user"`
+}
+
+var webhookPrivateNets []*net.IPNet
+
+func init() {
+	for _, cidr := range []string{
+		"10.0.0.0/8",
+		"172.16.0.0/12",
+		"192.168.0.0/16",
+		// carrier grade NAT
+		"100.64.0.0/10",
+		// unique local address
+		"fc00::/7",
+	} {
+		_, ipnet, _ := net.ParseCIDR(cidr)
+		webhookPrivateNets = append(webhookPrivateNets, ipnet)
+	}
+}
+
+// checkWebhookAddress refuses the loopback, link-local and private addresses
+// unless they are allowed by the admin, so that a subscription can not be
+// used to reach the services inside the cloud
+func checkWebhookAddress(ip net.IP) error {
+	if options.Options.WebhookAllowPrivateAddress {
+		return nil
+	}
+	if ip.IsLoopback() || ip.IsLinkLocalUnicast() || ip.IsUnspecified() || ip.IsMulticast() {
+		return fmt.Errorf("address %s is not allowed", ip)
+	}
+	for _, ipnet := range webhookPrivateNets {
+		if ipnet.Contains(ip) {
+			return fmt.Errorf("private address %s is not allowed", ip)
+		}
+	}
+	return nil
+}
+
+func validateWebhookUrl(u string) error {
+	parsed, err := url.Parse(u)
+	if err != nil {
+		return httperrors.NewInputParameterError("invalid url %q: %s", u, err)
+	}
+	if parsed.Scheme != "http" && parsed.Scheme != "https" {
+		return httperrors.NewInputParameterError("unsupported url scheme %q", parsed.Scheme)
+	}
+	if len(parsed.Host) == 0 {
+		return httperrors.NewInputParameterError("missing host of url %q", u)
+	}
+	host := parsed.Hostname()
+	ips := []net.IP{}
+	if ip := net.ParseIP(host); ip != nil {
+		ips = append(ips, ip)
+	} else {
+		// a host that can not be resolved yet is accepted, the address
+		// is checked again on every delivery
+		ips, _ = net.LookupIP(host)
+	}
+	for _, ip := range ips {
+		err := checkWebhookAddress(ip)
+		if err != nil {
+			return httperrors.NewInputParameterError("invalid host of url %q: %s", u, err)
+		}
+	}
+	return nil
+}
+
+func (manager *SWebhookSubscriptionManager) ValidateCreateData(ctx context.Context, userCred mcclient.TokenCredential, ownerId mcclient.IIdentityProvider, query jsonutils.JSONObject, input api.WebhookSubscriptionCreateInput) (api.WebhookSubscriptionCreateInput, error) {
+	var err error
+	input.VirtualResourceCreateInput, err = manager.SVirtualResourceBaseManager.ValidateCreateData(ctx, userCred, ownerId, query, input.VirtualResourceCreateInput)
+	if err != nil {
+		return input, errors.Wrap(err, "SVirtualResourceBaseManager.ValidateCreateData")
+	}
+	if len(input.Url) == 0 {
+		return input, httperrors.NewMissingParameterError("url")
+	}
+	err = validateWebhookUrl(input.Url)
+	if err != nil {
+		return input, err
+	}
+	if len(input.Secret) > 256 {
+		return input, httperrors.NewInputParameterError("secret is longer than 256 characters")
+	}
+	err = validateWebhookSources(input.Source)
+	if err != nil {
+		return input, err
+	}
+	switch input.ResourceScope {
+	case "":
+		input.ResourceScope = api.WEBHOOK_SCOPE_PROJECT
+	case api.WEBHOOK_SCOPE_PROJECT:
+	case api.WEBHOOK_SCOPE_DOMAIN:
+		if !db.IsDomainAllowCreate(userCred, manager) {
+			return input, httperrors.NewForbiddenError("not allow to subscribe events of domain")
+		}
+	case api.WEBHOOK_SCOPE_SYSTEM:
+		if !db.IsAdminAllowCreate(userCred, manager) {
+			return input, httperrors.NewForbiddenError("not allow to subscribe events of system")
+		}
+	default:
+		return input, httperrors.NewInputParameterError("unknown resource_scope %q", input.ResourceScope)
+	}
+	if input.MaxRetries == nil {
+		maxRetries := api.WEBHOOK_DEFAULT_MAX_RETRIES
+		input.MaxRetries = &maxRetries
+	} else if *input.MaxRetries < 0 {
+		return input, httperrors.NewInputParameterError("max_retries must not be negative")
+	}
+	return input, nil
+}
+
+func (sub *SWebhookSubscription) CustomizeCreate(ctx context.Context, userCred mcclient.TokenCredential, ownerId mcclient.IIdentityProvider, query jsonutils.JSONObject, data jsonutils.JSONObject) error {
+	err := sub.SVirtualResourceBase.CustomizeCreate(ctx, userCred, ownerId, query, data)
+	if err != nil {
+		return err
+	}
+	input := api.WebhookSubscriptionCreateInput{}
+	data.Unmarshal(&input)
+	if input.Disabled != nil && *input.Disabled {
+		sub.Enabled = tristate.False
+	} else {
+		sub.Enabled = tristate.True
+	}
+	sub.Status = api.WEBHOOK_SUBSCRIPTION_STATUS_READY
+	return nil
+}
+
+func (sub *SWebhookSubscription) PostCreate(ctx context.Context, userCred mcclient.TokenCredential, ownerId mcclient.IIdentityProvider, query jsonutils.JSONObject, data jsonutils.JSONObject) {
+	sub.SVirtualResourceBase.PostCreate(ctx, userCred, ownerId, query, data)
+	secret, _ := data.GetString("secret")
+	if len(secret) > 0 {
+		err := sub.saveSecret(secret)
+		if err != nil {
+			log.Errorf("save secret of webhook subscription %s fail %s", sub.Name, err)
+		}
+	}
+}
+
+// saveSecret keeps the secret encrypted with the subscription id as the key
+func (sub *SWebhookSubscription) saveSecret(secret string) error {
+	sec := ""
+	if len(secret) > 0 {
+		var err error
+		sec, err = utils.EncryptAESBase64(sub.Id, secret)
+		if err != nil {
+			return errors.Wrap(err, "EncryptAESBase64")
+		}
+	}
+	_, err := db.Update(sub, func() error {
+		sub.Secret = sec
+		return nil
+	})
+	return err
+}
+
+func (sub *SWebhookSubscription) getSecret() (string, error) {
+	if len(sub.Secret) == 0 {
+		return "", nil
+	}
+	return utils.DescryptAESBase64(sub.Id, sub.Secret)
+}
+
+func validateWebhookSources(sources []string) error {
+	for _, source := range sources {
+		if source != api.WEBHOOK_SOURCE_ACTIONLOG && source != api.WEBHOOK_SOURCE_OPSLOG {
+			return httperrors.NewInputParameterError("unknown source %q", source)
+		}
+	}
+	return nil
+}
+
+func (sub *SWebhookSubscription) ValidateUpdateData(ctx context.Context, userCred mcclient.TokenCredential, query jsonutils.JSONObject, input api.WebhookSubscriptionUpdateInput) (api.WebhookSubscriptionUpdateInput, error) {
+	var err error
+	input.VirtualResourceBaseUpdateInput, err = sub.SVirtualResourceBase.ValidateUpdateData(ctx, userCred, query, input.VirtualResourceBaseUpdateInput)
+	if err != nil {
+		return input, errors.Wrap(err, "SVirtualResourceBase.ValidateUpdateData")
+	}
+	if len(input.Url) > 0 {
+		err = validateWebhookUrl(input.Url)
+		if err != nil {
+			return input, err
+		}
+	}
+	if input.Secret != nil && len(*input.Secret) > 256 {
+		return input, httperrors.NewInputParameterError("secret is longer than 256 characters")
+	}
+	err = validateWebhookSources(input.Source)
+	if err != nil {
+		return input, err
+	}
+	if input.MaxRetries != nil && *input.MaxRetries < 0 {
+		return input, httperrors.NewInputParameterError("max_retries must not be negative")
+	}
+	return input, nil
+}
+
+func (sub *SWebhookSubscription) PostUpdate(ctx context.Context, userCred mcclient.TokenCredential, query jsonutils.JSONObject, data jsonutils.JSONObject) {
+	sub.SVirtualResourceBase.PostUpdate(ctx, userCred, query, data)
+	if data.Contains("secret") {
+		secret, _ := data.GetString("secret")
+		err := sub.saveSecret(secret)
+		if err != nil {
+			log.Errorf("save secret of webhook subscription %s fail %s", sub.Name, err)
+		}
+	}
+}
+
+// Webhook订阅列表
+func (manager *SWebhookSubscriptionManager) ListItemFilter(
+	ctx context.Context,
+	q *sqlchemy.SQuery,
+	userCred mcclient.TokenCredential,
+	query api.WebhookSubscriptionListInput,
+) (*sqlchemy.SQuery, error) {
+	var err error
+	q, err = manager.SVirtualResourceBaseManager.ListItemFilter(ctx, q, userCred, query.VirtualResourceListInput)
+	if err != nil {
+		return nil, errors.Wrap(err, "SVirtualResourceBaseManager.ListItemFilter")
+	}
+	q, err = manager.SEnabledResourceBaseManager.ListItemFilter(ctx, q, userCred, query.EnabledResourceBaseListInput)
+	if err != nil {
+		return nil, errors.Wrap(err, "SEnabledResourceBaseManager.ListItemFilter")
+	}
+	if len(query.ResourceScope) > 0 {
+		q = q.In("resource_scope", query.ResourceScope)
+	}
+	return q, nil
+}
+
+func (manager *SWebhookSubscriptionManager) OrderByExtraFields(
+	ctx context.Context,
+	q *sqlchemy.SQuery,
+	userCred mcclient.TokenCredential,
+	query api.WebhookSubscriptionListInput,
+) (*sqlchemy.SQuery, error) {
+	q, err := manager.SVirtualResourceBaseManager.OrderByExtraFields(ctx, q, userCred, query.VirtualResourceListInput)
+	if err != nil {
+		return nil, errors.Wrap(err, "SVirtualResourceBaseManager.OrderByExtraFields")
+	}
+	return q, nil
+}
+
+func (manager *SWebhookSubscriptionManager) QueryDistinctExtraField(q *sqlchemy.SQuery, field string) (*sqlchemy.SQuery, error) {
+	q, err := manager.SVirtualResourceBaseManager.QueryDistinctExtraField(q, field)
+	if err == nil {
+		return q, nil
+	}
+	return q, httperrors.ErrNotFound
+}
+
+func (manager *SWebhookSubscriptionManager) FetchCustomizeColumns(
+	ctx context.Context,
+	userCred mcclient.TokenCredential,
+	query jsonutils.JSONObject,
+	objs []interface{},
+	fields stringutils2.SSortedStrings,
+	isList bool,
+) []api.WebhookSubscriptionDetails {
+	rows := make([]api.WebhookSubscriptionDetails, len(objs))
+	virtRows := manager.SVirtualResourceBaseManager.FetchCustomizeColumns(ctx, userCred, query, objs, fields, isList)
+	subIds := make([]string, len(objs))
+	for i := range rows {
+		rows[i] = api.WebhookSubscriptionDetails{
+			VirtualResourceDetails: virtRows[i],
+		}
+		subIds[i] = objs[i].(*SWebhookSubscription).Id
+	}
+	counts, err := WebhookDeliveryManager.fetchStatusCounts(subIds)
+	if err != nil {
+		log.Errorf("fetchStatusCounts fail %s", err)
+		return rows
+	}
+	for i := range rows {
+		cnt := counts[subIds[i]]
+		rows[i].PendingCount = cnt[api.WEBHOOK_DELIVERY_STATUS_PENDING] + cnt[api.WEBHOOK_DELIVERY_STATUS_RETRYING]
+		rows[i].DeadCount = cnt[api.WEBHOOK_DELIVERY_STATUS_DEAD]
+	}
+	return rows
+}
+
+func (sub *SWebhookSubscription) AllowPerformEnable(ctx context.Context, userCred mcclient.TokenCredential, query jsonutils.JSONObject) bool {
+	return sub.IsOwner(userCred) || db.IsAdminAllowPerform(userCred, sub, "enable")
+}
+
+func (sub *SWebhookSubscription) PerformEnable(ctx context.Context, userCred mcclient.TokenCredential, query jsonutils.JSONObject, input apis.PerformEnableInput) (jsonutils.JSONObject, error) {
+	err := db.EnabledPerformEnable(sub, ctx, userCred, true)
+	if err != nil {
+		return nil, errors.Wrap(err, "EnabledPerformEnable")
+	}
+	return nil, nil
+}
+
+func (sub *SWebhookSubscription) AllowPerformDisable(ctx context.Context, userCred mcclient.TokenCredential, query jsonutils.JSONObject) bool {
+	return sub.IsOwner(userCred) || db.IsAdminAllowPerform(userCred, sub, "disable")
+}
+
+func (sub *SWebhookSubscription) PerformDisable(ctx context.Context, userCred mcclient.TokenCredential, query jsonutils.JSONObject, input apis.PerformDisableInput) (jsonutils.JSONObject, error) {
+	err := db.EnabledPerformEnable(sub, ctx, userCred, false)
+	if err != nil {
+		return nil, errors.Wrap(err, "EnabledPerformEnable")
+	}
+	return nil, nil
+}
+
+func jsonArrayContains(arr *jsonutils.JSONArray, val string) bool {
+	if arr == nil || arr.Length() == 0 {
+		return true
+	}
+	return utils.IsInStringArray(val, arr.GetStringArray())
+}
+
+// Match tells whether the event of the given source should be delivered to
+// the subscription
+func (sub *SWebhookSubscription) Match(source string, action *SActionlog) bool {
+	if !sub.Enabled.Bool() {
+		return false
+	}
+	if !jsonArrayContains(sub.Source, source) {
+		return false
+	}
+	if !jsonArrayContains(sub.ObjType, action.ObjType) || !jsonArrayContains(sub.Action, action.Action) {
+		return false
+	}
+	switch sub.ResourceScope {
+	case api.WEBHOOK_SCOPE_SYSTEM:
+		return true
+	case api.WEBHOOK_SCOPE_DOMAIN:
+		return sub.DomainId == action.OwnerDomainId
+	default:
+		return sub.ProjectId == action.OwnerProjectId
+	}
+}
+
+func (manager *SWebhookSubscriptionManager) fetchEnabledSubscriptions() ([]SWebhookSubscription, error) {
+	q := manager.Query().IsTrue("enabled")
+	subs := make([]SWebhookSubscription, 0)
+	err := db.FetchModelObjects(manager, q, &subs)
+	if err != nil {
+		return nil, errors.Wrap(err, "FetchModelObjects")
+	}
+	return subs, nil
+}
@@ -0,0 +1,274 @@
+// Copyright 2019 Yunion
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package models
+
+import (
+	"context"
+	"encoding/json"
+	"io/ioutil"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"yunion.io/x/jsonutils"
+	"yunion.io/x/pkg/tristate"
+	"yunion.io/x/pkg/utils"
+
+	api "yunion.io/x/onecloud/pkg/apis/logger"
+	"yunion.io/x/onecloud/pkg/logger/options"
+)
+
+func TestSignWebhookPayload(t *testing.T) {
+	sig := SignWebhookPayload("secret", 1600000000, []byte(`{"action":"create"}`))
+	if len(sig) != 64 {
+		t.Fatalf("want hex encoded sha256, got %q", sig)
+	}
+	if sig != SignWebhookPayload("secret", 1600000000, []byte(`{"action":"create"}`)) {
+		t.Errorf("signature is not deterministic")
+	}
+	if sig == SignWebhookPayload("secret", 1600000001, []byte(`{"action":"create"}`)) {
+		t.Errorf("signature does not cover timestamp")
+	}
+	if sig == SignWebhookPayload("other", 1600000000, []byte(`{"action":"create"}`)) {
+		t.Errorf("signature does not depend on secret")
+	}
+}
+
+func TestWebhookRetryBackoff(t *testing.T) {
+	base := 30 * time.Second
+	max := 10 * time.Minute
+	cases := []struct {
+		attempts int
+		want     time.Duration
+	}{
+		{1, 30 * time.Second},
+		{2, time.Minute},
+		{3, 2 * time.Minute},
+		{5, 8 * time.Minute},
+		{6, max},
+		{100, max},
+	}
+	for _, c := range cases {
+		if got := webhookRetryBackoff(c.attempts, base, max); got != c.want {
+			t.Errorf("attempts %d: want %s, got %s", c.attempts, c.want, got)
+		}
+	}
+}
+
+func TestWebhookDeliveryId(t *testing.T) {
+	id := webhookDeliveryId("sub1", api.WEBHOOK_SOURCE_OPSLOG, "compute", 42)
+	if len(id) != 36 || strings.Count(id, "-") != 4 {
+		t.Fatalf("want an uuid shaped id, got %s", id)
+	}
+	if id != webhookDeliveryId("sub1", api.WEBHOOK_SOURCE_OPSLOG, "compute", 42) {
+		t.Errorf("want the same id for the same event and subscription")
+	}
+	for _, other := range []string{
+		webhookDeliveryId("sub2", api.WEBHOOK_SOURCE_OPSLOG, "compute", 42),
+		webhookDeliveryId("sub1", api.WEBHOOK_SOURCE_ACTIONLOG, "compute", 42),
+		webhookDeliveryId("sub1", api.WEBHOOK_SOURCE_OPSLOG, "image", 42),
+		webhookDeliveryId("sub1", api.WEBHOOK_SOURCE_OPSLOG, "compute", 43),
+	} {
+		if other == id {
+			t.Errorf("want different ids for different deliveries, got %s", other)
+		}
+	}
+}
+
+func TestCheckWebhookAddress(t *testing.T) {
+	defer func(allow bool) {
+		options.Options.WebhookAllowPrivateAddress = allow
+	}(options.Options.WebhookAllowPrivateAddress)
+
+	options.Options.WebhookAllowPrivateAddress = false
+	for _, addr := range []string{"127.0.0.1", "::1", "169.254.169.254", "10.1.2.3", "172.20.0.1", "192.168.1.1", "100.64.0.1", "fd00::1", "0.0.0.0"} {
+		if err := checkWebhookAddress(net.ParseIP(addr)); err == nil {
+			t.Errorf("%s should be refused", addr)
+		}
+	}
+	for _, addr := range []string{"8.8.8.8", "2001:4860:4860::8888"} {
+		if err := checkWebhookAddress(net.ParseIP(addr)); err != nil {
+			t.Errorf("%s should be allowed: %s", addr, err)
+		}
+	}
+	if err := validateWebhookUrl("http://169.254.169.254/latest/meta-data"); err == nil {
+		t.Errorf("metadata url should be refused")
+	}
+
+	options.Options.WebhookAllowPrivateAddress = true
+	if err := checkWebhookAddress(net.ParseIP("127.0.0.1")); err != nil {
+		t.Errorf("loopback should be allowed by option: %s", err)
+	}
+}
+
+func TestWebhookSubscriptionMatch(t *testing.T) {
+	sub := &SWebhookSubscription{
+		ObjType:       jsonutils.NewStringArray([]string{"server"}),
+		Source:        jsonutils.NewStringArray([]string{api.WEBHOOK_SOURCE_OPSLOG}),
+		ResourceScope: api.WEBHOOK_SCOPE_PROJECT,
+	}
+	sub.Enabled = tristate.True
+	sub.ProjectId = "p1"
+	action := &SActionlog{}
+	action.ObjType = "server"
+	action.Action = "create"
+	action.OwnerProjectId = "p1"
+	if !sub.Match(api.WEBHOOK_SOURCE_OPSLOG, action) {
+		t.Errorf("opslog should match")
+	}
+	if sub.Match(api.WEBHOOK_SOURCE_ACTIONLOG, action) {
+		t.Errorf("actionlog should not match source filter")
+	}
+	action.OwnerProjectId = "p2"
+	if sub.Match(api.WEBHOOK_SOURCE_OPSLOG, action) {
+		t.Errorf("event of other project should not match")
+	}
+}
+
+func TestOpslogToActionlog(t *testing.T) {
+	obj := jsonutils.Marshal(map[string]interface{}{
+		"id":              42,
+		"obj_type":        "image",
+		"obj_id":          "img-1",
+		"action":          "delete_fail",
+		"owner_tenant_id": "p1",
+		"ops_time":        "2020-01-02T03:04:05.000000Z",
+	})
+	action := opslogToActionlog("image", obj)
+	if action.Id != 42 || action.ObjType != "image" || action.OwnerProjectId != "p1" || action.Service != "image" {
+		t.Errorf("unexpected actionlog %#v", action)
+	}
+	if action.Success {
+		t.Errorf("failed action should not succeed")
+	}
+	if action.OpsTime.IsZero() {
+		t.Errorf("ops_time not parsed")
+	}
+}
+
+func newTestDelivery(t *testing.T, url string, secret string) (*SWebhookSubscription, *SWebhookDelivery) {
+	sub := &SWebhookSubscription{Url: url, MaxRetries: 2}
+	sub.Id = "sub-1"
+	if len(secret) > 0 {
+		sec, err := utils.EncryptAESBase64(sub.Id, secret)
+		if err != nil {
+			t.Fatalf("EncryptAESBase64: %s", err)
+		}
+		sub.Secret = sec
+	}
+	action := &SActionlog{Success: true, Service: "compute"}
+	action.Id = 7
+	action.ObjType = "server"
+	action.ObjId = "srv-1"
+	action.Action = "create"
+	action.Notes = `{"name":"vm"}`
+	event := newWebhookEvent(sub, api.WEBHOOK_SOURCE_ACTIONLOG, action)
+	event.DeliveryId = "delivery-1"
+	delivery := &SWebhookDelivery{
+		SubscriptionId: sub.Id,
+		ActionlogId:    action.Id,
+		ObjType:        action.ObjType,
+		ObjId:          action.ObjId,
+		Action:         action.Action,
+		Payload:        jsonutils.Marshal(event).String(),
+	}
+	delivery.Id = event.DeliveryId
+	return sub, delivery
+}
+
+func TestWebhookDeliverActionlog(t *testing.T) {
+	defer func(allow bool) {
+		options.Options.WebhookAllowPrivateAddress = allow
+	}(options.Options.WebhookAllowPrivateAddress)
+	options.Options.WebhookAllowPrivateAddress = true
+
+	received := make(chan api.WebhookEvent, 1)
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := ioutil.ReadAll(r.Body)
+		ts, _ := strconv.ParseInt(r.Header.Get(api.WEBHOOK_HEADER_TIMESTAMP), 10, 64)
+		want := "sha256=" + SignWebhookPayload("secret", ts, body)
+		if r.Header.Get(api.WEBHOOK_HEADER_SIGNATURE) != want {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		if r.Header.Get(api.WEBHOOK_HEADER_EVENT) != "server.create" || r.Header.Get(api.WEBHOOK_HEADER_DELIVERY) != "delivery-1" {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		event := api.WebhookEvent{}
+		json.Unmarshal(body, &event)
+		received <- event
+	}))
+	defer srv.Close()
+
+	sub, delivery := newTestDelivery(t, srv.URL, "secret")
+	status, code, msg, _ := delivery.attempt(context.Background(), newWebhookClient(5*time.Second), sub)
+	if status != api.WEBHOOK_DELIVERY_STATUS_SUCCESS || code != http.StatusOK {
+		t.Fatalf("want success, got %s %d %s", status, code, msg)
+	}
+	event := <-received
+	if event.ObjId != "srv-1" || event.Source != api.WEBHOOK_SOURCE_ACTIONLOG || event.SubscriptionId != "sub-1" {
+		t.Errorf("unexpected event %#v", event)
+	}
+}
+
+func TestWebhookDeliverRetryAndDeadLetter(t *testing.T) {
+	defer func(allow bool, base, max int) {
+		options.Options.WebhookAllowPrivateAddress = allow
+		options.Options.WebhookRetryBaseSeconds = base
+		options.Options.WebhookRetryMaxSeconds = max
+	}(options.Options.WebhookAllowPrivateAddress, options.Options.WebhookRetryBaseSeconds, options.Options.WebhookRetryMaxSeconds)
+	options.Options.WebhookAllowPrivateAddress = true
+	options.Options.WebhookRetryBaseSeconds = 30
+	options.Options.WebhookRetryMaxSeconds = 3600
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}))
+	defer srv.Close()
+
+	sub, delivery := newTestDelivery(t, srv.URL, "")
+	client := newWebhookClient(5 * time.Second)
+
+	delivery.Attempts = 1
+	status, code, _, next := delivery.attempt(context.Background(), client, sub)
+	if status != api.WEBHOOK_DELIVERY_STATUS_RETRYING || code != http.StatusServiceUnavailable {
+		t.Fatalf("want retrying, got %s %d", status, code)
+	}
+	if backoff := time.Until(next); backoff < 50*time.Second || backoff > time.Minute {
+		t.Errorf("want a backoff of 1 minute, got %s", backoff)
+	}
+
+	delivery.Attempts = sub.MaxRetries
+	status, _, msg, next := delivery.attempt(context.Background(), client, sub)
+	if status != api.WEBHOOK_DELIVERY_STATUS_DEAD || !next.IsZero() {
+		t.Fatalf("want dead, got %s %s", status, next)
+	}
+	if !strings.Contains(msg, "503") {
+		t.Errorf("want the response status in the error, got %q", msg)
+	}
+
+	// the address is checked again when connecting
+	options.Options.WebhookAllowPrivateAddress = false
+	delivery.Attempts = 0
+	status, _, msg, _ = delivery.attempt(context.Background(), newWebhookClient(5*time.Second), sub)
+	if status != api.WEBHOOK_DELIVERY_STATUS_RETRYING || !strings.Contains(msg, "not allowed") {
+		t.Errorf("want the loopback address refused, got %s %q", status, msg)
+	}
+}
@@ -22,6 +22,18 @@ type SLoggerOptions struct {
 	common_options.CommonOptions
 
 	common_options.DBOptions
+
+	WebhookDeliveryIntervalSeconds int      `help:"interval in seconds to scan pending webhook deliveries" default:"10"`
+	WebhookDeliveryTimeoutSeconds  int      `help:"timeout in seconds of a webhook delivery request" default:"10"`
+	WebhookRetryBaseSeconds        int      `help:"initial backoff in seconds of webhook delivery retries" default:"30"`
+	WebhookRetryMaxSeconds         int      `help:"maximal backoff in seconds of webhook delivery retries" default:"3600"`
+	WebhookAllowPrivateAddress     bool     `help:"allow webhooks to be delivered to loopback, link-local and private addresses"`
+	WebhookOpslogServices          []string `help:"services whose resource events are delivered to webhook subscriptions" default:"compute,image,identity"`
+
+	AuditExportIntervalSeconds int `help:"interval in seconds to export new actionlogs to audit receivers" default:"5"`
+	AuditExportBatchSize       int `help:"maximal number of actionlogs sent to audit receivers at once" default:"200"`
+	AuditExportTimeoutSeconds  int `help:"timeout in seconds of sending actionlogs to audit receivers" default:"10"`
+	AuditExportSettleSeconds   int `help:"actionlogs are exported after they are created for the given seconds, so that concurrently inserted ones are not skipped" default:"2"`
 }
 
 var (
@@ -20,7 +20,9 @@ import (
 )
 
 var (
-	loggerSystemResources = []string{}
+	loggerSystemResources = []string{
+		"audit_exporters",
+	}
 	loggerDomainResources = []string{}
 	loggerUserResources   = []string{}
 )
@@ -24,10 +24,13 @@ import (
 func initHandlers(app *appsrv.Application) {
 	db.InitAllManagers()
 
+	db.AddOpenApiHandler("", app)
+
 	for _, manager := range []db.IModelManager{
 		db.UserCacheManager,
 		db.TenantCacheManager,
 		db.DistinctFieldManager,
+		models.WebhookCursorManager,
 	} {
 		db.RegisterModelManager(manager)
 	}
@@ -35,6 +38,9 @@ func initHandlers(app *appsrv.Application) {
 	for _, manager := range []db.IModelManager{
 		models.ActionLog,
 		models.BaremetalEventManager,
+		models.WebhookSubscriptionManager,
+		models.WebhookDeliveryManager,
+		models.AuditExporterManager,
 	} {
 		db.RegisterModelManager(manager)
 		handler := db.NewModelHandler(manager)
@@ -53,6 +53,8 @@ func StartService() {
 	defer cloudcommon.CloseDB()
 
 	models.StartNotifyToWebsocketWorker()
+	models.StartWebhookDeliveryWorker()
+	models.StartAuditExportWorker()
 
 	app_common.ServeForever(app, baseOpts)
 }
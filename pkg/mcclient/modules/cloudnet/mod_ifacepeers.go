@@ -0,0 +1,50 @@
+// Copyright 2019 Yunion
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cloudnet
+
+import (
+	"yunion.io/x/onecloud/pkg/mcclient/modulebase"
+)
+
+type IfacePeerManager struct {
+	modulebase.ResourceManager
+}
+
+var (
+	IfacePeers IfacePeerManager
+)
+
+func init() {
+	IfacePeers = IfacePeerManager{
+		NewCloudnetManager(
+			"ifacepeer",
+			"ifacepeers",
+			[]string{
+				"id",
+				"name",
+				"router_id",
+				"peer_router_id",
+				"endpoint",
+				"allowed_ips",
+				"status",
+				"last_handshake",
+				"transfer_rx",
+				"transfer_tx",
+			},
+			[]string{},
+		),
+	}
+	registerV2(&IfacePeers)
+}
@@ -36,7 +36,7 @@ func init() {
 
 func NewAlertRecordManager() *SAlertRecordManager {
 	man := NewMonitorV2Manager("alertrecord", "alertrecords",
-		[]string{"id", "alert_name", "res_type", "level", "state", "res_num", "eval_data"},
+		[]string{"id", "alert_name", "res_type", "level", "state", "res_num", "eval_data", "ack_state", "assignee"},
 		[]string{})
 	return &SAlertRecordManager{
 		ResourceManager: &man,
@@ -30,6 +30,7 @@ import (
 	"yunion.io/x/onecloud/pkg/httperrors"
 	"yunion.io/x/onecloud/pkg/mcclient"
 	"yunion.io/x/onecloud/pkg/mcclient/modulebase"
+	"yunion.io/x/onecloud/pkg/util/seclib2"
 )
 
 type SCredentialManager struct {
@@ -43,6 +44,10 @@ const (
 	TOTP_TYPE             = api.TOTP_TYPE
 	RECOVERY_SECRETS_TYPE = api.RECOVERY_SECRETS_TYPE
 	OIDC_CREDENTIAL_TYPE  = api.OIDC_CREDENTIAL_TYPE
+	ENCRYPT_KEY_TYPE      = api.ENCRYPT_KEY_TYPE
+
+	WEBAUTHN_CREDENTIAL_TYPE = api.WEBAUTHN_CREDENTIAL_TYPE
+	RECOVERY_CODES_TYPE      = api.RECOVERY_CODES_TYPE
 )
 
 type STotpSecret struct {
@@ -67,6 +72,17 @@ type SRecoverySecretSet struct {
 	Timestamp int64
 }
 
+type SWebAuthnCredential struct {
+	Id   string `json:"id"`
+	Name string `json:"name"`
+	api.SWebAuthnCredentialBlob
+}
+
+type SRecoveryCodes struct {
+	Id string `json:"id"`
+	api.SRecoveryCodesBlob
+}
+
 type SOpenIDConnectCredential struct {
 	ClientId string `json:"client_id"`
 	// Secret      string `json:"secret"`
@@ -74,6 +90,14 @@ type SOpenIDConnectCredential struct {
 	api.SAccessKeySecretBlob
 }
 
+type SEncryptKeySecret struct {
+	KeyId     string    `json:"key_id"`
+	KeyName   string    `json:"key_name"`
+	ProjectId string    `json:"project_id"`
+	TimeStamp time.Time `json:"time_stamp"`
+	api.SEncryptKeySecretBlob
+}
+
 func (manager *SCredentialManager) fetchCredentials(s *mcclient.ClientSession, secType string, uid string, pid string) ([]jsonutils.JSONObject, error) {
 	query := jsonutils.NewDict()
 	query.Add(jsonutils.NewString(secType), "type")
@@ -101,6 +125,14 @@ func (manager *SCredentialManager) FetchRecoverySecrets(s *mcclient.ClientSessio
 	return manager.fetchCredentials(s, RECOVERY_SECRETS_TYPE, uid, "")
 }
 
+func (manager *SCredentialManager) FetchWebAuthnCredentials(s *mcclient.ClientSession, uid string) ([]jsonutils.JSONObject, error) {
+	return manager.fetchCredentials(s, WEBAUTHN_CREDENTIAL_TYPE, uid, "")
+}
+
+func (manager *SCredentialManager) FetchRecoveryCodes(s *mcclient.ClientSession, uid string) ([]jsonutils.JSONObject, error) {
+	return manager.fetchCredentials(s, RECOVERY_CODES_TYPE, uid, "")
+}
+
 func (manager *SCredentialManager) FetchOIDCSecrets(s *mcclient.ClientSession, uid string, pid string) ([]jsonutils.JSONObject, error) {
 	return manager.fetchCredentials(s, OIDC_CREDENTIAL_TYPE, uid, pid)
 }
@@ -155,6 +187,50 @@ func (manager *SCredentialManager) GetRecoverySecrets(s *mcclient.ClientSession,
 	return latestQ.Questions, nil
 }
 
+func (manager *SCredentialManager) GetWebAuthnCredentials(s *mcclient.ClientSession, uid string) ([]SWebAuthnCredential, error) {
+	secrets, err := manager.FetchWebAuthnCredentials(s, uid)
+	if err != nil {
+		return nil, err
+	}
+	ret := make([]SWebAuthnCredential, 0, len(secrets))
+	for i := range secrets {
+		blobStr, _ := secrets[i].GetString("blob")
+		blobJson, _ := jsonutils.ParseString(blobStr)
+		if blobJson != nil {
+			cred := SWebAuthnCredential{}
+			blobJson.Unmarshal(&cred.SWebAuthnCredentialBlob)
+			cred.Id, _ = secrets[i].GetString("id")
+			cred.Name, _ = secrets[i].GetString("name")
+			ret = append(ret, cred)
+		}
+	}
+	return ret, nil
+}
+
+func (manager *SCredentialManager) GetRecoveryCodes(s *mcclient.ClientSession, uid string) (*SRecoveryCodes, error) {
+	secrets, err := manager.FetchRecoveryCodes(s, uid)
+	if err != nil {
+		return nil, err
+	}
+	var latest *SRecoveryCodes
+	for i := range secrets {
+		blobStr, _ := secrets[i].GetString("blob")
+		blobJson, _ := jsonutils.ParseString(blobStr)
+		if blobJson != nil {
+			curr := SRecoveryCodes{}
+			blobJson.Unmarshal(&curr.SRecoveryCodesBlob)
+			curr.Id, _ = secrets[i].GetString("id")
+			if latest == nil || curr.Timestamp > latest.Timestamp {
+				latest = &curr
+			}
+		}
+	}
+	if latest == nil {
+		return nil, httperrors.NewNotFoundError("no recovery codes for %s", uid)
+	}
+	return latest, nil
+}
+
 func DecodeAccessKeySecret(secret jsonutils.JSONObject) (SAccessKeySecret, error) {
 	curr := SAccessKeySecret{}
 	blobStr, err := secret.GetString("blob")
@@ -237,6 +313,97 @@ func (manager *SCredentialManager) GetOIDCSecret(s *mcclient.ClientSession, uid
 	return oidcCreds, nil
 }
 
+func DecodeEncryptKey(secret jsonutils.JSONObject) (SEncryptKeySecret, error) {
+	curr := SEncryptKeySecret{}
+	secType, _ := secret.GetString("type")
+	if secType != ENCRYPT_KEY_TYPE {
+		return curr, errors.Wrapf(httperrors.ErrInvalidFormat, "credential type %s is not %s", secType, ENCRYPT_KEY_TYPE)
+	}
+	// blob is empty unless fetched by an admin session
+	blobStr, _ := secret.GetString("blob")
+	if len(blobStr) > 0 {
+		blobJson, err := jsonutils.ParseString(blobStr)
+		if err != nil {
+			return curr, errors.Wrap(err, "jsonutils.ParseString")
+		}
+		err = blobJson.Unmarshal(&curr.SEncryptKeySecretBlob)
+		if err != nil {
+			return curr, errors.Wrap(err, "blobJson.Unmarshal")
+		}
+	}
+	var err error
+	curr.KeyId, err = secret.GetString("id")
+	if err != nil {
+		return curr, errors.Wrap(err, "secret.GetString('id')")
+	}
+	curr.KeyName, _ = secret.GetString("name")
+	curr.ProjectId, _ = secret.GetString("project_id")
+	curr.TimeStamp, _ = secret.GetTime("created_at")
+	return curr, nil
+}
+
+// GetEncryptKey fetches an encryption master key by id or name, the blob is
+// only visible to admin sessions
+func (manager *SCredentialManager) GetEncryptKey(s *mcclient.ClientSession, kid string) (SEncryptKeySecret, error) {
+	secret, err := manager.Get(s, kid, nil)
+	if err != nil {
+		return SEncryptKeySecret{}, errors.Wrap(err, "Get")
+	}
+	return DecodeEncryptKey(secret)
+}
+
+func (manager *SCredentialManager) GetEncryptKeys(s *mcclient.ClientSession, pid string) ([]SEncryptKeySecret, error) {
+	query := jsonutils.NewDict()
+	query.Add(jsonutils.NewString(ENCRYPT_KEY_TYPE), "type")
+	query.Add(jsonutils.NewString("system"), "scope")
+	if len(pid) > 0 {
+		query.Add(jsonutils.NewString(pid), "project_id")
+	}
+	results, err := manager.List(s, query)
+	if err != nil {
+		return nil, err
+	}
+	keys := make([]SEncryptKeySecret, 0)
+	for i := range results.Data {
+		curr, err := DecodeEncryptKey(results.Data[i])
+		if err != nil {
+			return nil, errors.Wrap(err, "DecodeEncryptKey")
+		}
+		keys = append(keys, curr)
+	}
+	return keys, nil
+}
+
+func (manager *SCredentialManager) CreateEncryptKey(s *mcclient.ClientSession, pid string, name string) (SEncryptKeySecret, error) {
+	key := SEncryptKeySecret{}
+	masterKey, err := seclib2.GenerateDataKey()
+	if err != nil {
+		return key, errors.Wrap(err, "GenerateDataKey")
+	}
+	key.Alg = api.ENCRYPT_KEY_ALG_AES_256
+	key.Key = base64.StdEncoding.EncodeToString(masterKey)
+	blobJson := jsonutils.Marshal(&key.SEncryptKeySecretBlob)
+	params := jsonutils.NewDict()
+	if len(name) == 0 {
+		name = fmt.Sprintf("enc-key-%s-%d", pid, time.Now().Unix())
+	}
+	if len(pid) > 0 {
+		params.Add(jsonutils.NewString(pid), "project_id")
+	}
+	params.Add(jsonutils.NewString(ENCRYPT_KEY_TYPE), "type")
+	params.Add(jsonutils.NewString(blobJson.String()), "blob")
+	params.Add(jsonutils.NewString(name), "name")
+	result, err := manager.Create(s, params)
+	if err != nil {
+		return key, err
+	}
+	key.KeyName = name
+	key.KeyId, _ = result.GetString("id")
+	key.ProjectId, _ = result.GetString("project_id")
+	key.TimeStamp, _ = result.GetTime("created_at")
+	return key, nil
+}
+
 func (manager *SCredentialManager) DoCreateAccessKeySecret(s *mcclient.ClientSession, params jsonutils.JSONObject) (jsonutils.JSONObject, error) {
 	key, err := manager.CreateAccessKeySecret(s, "", "", time.Time{})
 	if err != nil {
@@ -373,6 +540,86 @@ func (manager *SCredentialManager) SaveRecoverySecrets(s *mcclient.ClientSession
 	return nil
 }
 
+func (manager *SCredentialManager) CreateWebAuthnCredential(s *mcclient.ClientSession, uid string, name string, blob api.SWebAuthnCredentialBlob) (string, error) {
+	blob.Timestamp = time.Now().Unix()
+	params := jsonutils.NewDict()
+	params.Add(jsonutils.NewString(DEFAULT_PROJECT), "project_id")
+	params.Add(jsonutils.NewString(WEBAUTHN_CREDENTIAL_TYPE), "type")
+	params.Add(jsonutils.NewString(uid), "user_id")
+	params.Add(jsonutils.NewString(name), "name")
+	params.Add(jsonutils.NewString(jsonutils.Marshal(&blob).String()), "blob")
+	result, err := manager.Create(s, params)
+	if err != nil {
+		return "", err
+	}
+	return result.GetString("id")
+}
+
+func (manager *SCredentialManager) UpdateWebAuthnSignCount(s *mcclient.ClientSession, cred SWebAuthnCredential, signCount int64) error {
+	blob := cred.SWebAuthnCredentialBlob
+	blob.SignCount = signCount
+	params := jsonutils.NewDict()
+	params.Add(jsonutils.NewString(jsonutils.Marshal(&blob).String()), "blob")
+	_, err := manager.Update(s, cred.Id, params)
+	return err
+}
+
+// CreateRecoveryCodes replaces the recovery codes of a user with new ones,
+// only the hashes are saved and the codes are returned to show to the user
+func (manager *SCredentialManager) CreateRecoveryCodes(s *mcclient.ClientSession, uid string) ([]string, error) {
+	codes, err := seclib2.GenerateRecoveryCodes(api.RecoveryCodeCount)
+	if err != nil {
+		return nil, errors.Wrap(err, "GenerateRecoveryCodes")
+	}
+	err = manager.RemoveRecoveryCodes(s, uid)
+	if err != nil {
+		return nil, err
+	}
+	blob := api.SRecoveryCodesBlob{
+		Codes:     make([]string, len(codes)),
+		Timestamp: time.Now().Unix(),
+	}
+	for i := range codes {
+		blob.Codes[i] = seclib2.HashRecoveryCode(codes[i])
+	}
+	params := jsonutils.NewDict()
+	params.Add(jsonutils.NewString(DEFAULT_PROJECT), "project_id")
+	params.Add(jsonutils.NewString(RECOVERY_CODES_TYPE), "type")
+	params.Add(jsonutils.NewString(uid), "user_id")
+	params.Add(jsonutils.NewString(jsonutils.Marshal(&blob).String()), "blob")
+	_, err = manager.Create(s, params)
+	if err != nil {
+		return nil, err
+	}
+	return codes, nil
+}
+
+// ConsumeRecoveryCode checks a recovery code of a user and invalidates it,
+// keystone consumes the code so that it is used only once
+func (manager *SCredentialManager) ConsumeRecoveryCode(s *mcclient.ClientSession, uid string, code string) error {
+	codes, err := manager.GetRecoveryCodes(s, uid)
+	if err != nil {
+		return err
+	}
+	input := api.CredentialConsumeRecoveryCodeInput{
+		Code: code,
+	}
+	_, err = manager.PerformAction(s, codes.Id, "consume-recovery-code", jsonutils.Marshal(&input))
+	return err
+}
+
+// ConsumeWebAuthnChallenge records the challenge of a finished WebAuthn
+// ceremony in keystone, it fails if the challenge has been used
+func (manager *SCredentialManager) ConsumeWebAuthnChallenge(s *mcclient.ClientSession, uid string, challenge string, expires int64) error {
+	input := api.CredentialConsumeWebAuthnChallengeInput{
+		UserId:    uid,
+		Challenge: challenge,
+		Expires:   expires,
+	}
+	_, err := manager.PerformClassAction(s, "consume-webauthn-challenge", jsonutils.Marshal(&input))
+	return err
+}
+
 func (manager *SCredentialManager) removeCredentials(s *mcclient.ClientSession, secType string, uid string, pid string) error {
 	secrets, err := manager.fetchCredentials(s, secType, uid, pid)
 	if err != nil {
@@ -402,6 +649,28 @@ func (manager *SCredentialManager) RemoveRecoverySecrets(s *mcclient.ClientSessi
 	return manager.removeCredentials(s, RECOVERY_SECRETS_TYPE, uid, "")
 }
 
+func (manager *SCredentialManager) RemoveWebAuthnCredential(s *mcclient.ClientSession, uid string, id string) error {
+	creds, err := manager.GetWebAuthnCredentials(s, uid)
+	if err != nil {
+		return err
+	}
+	for i := range creds {
+		if creds[i].Id == id {
+			_, err := manager.Delete(s, id, nil)
+			return err
+		}
+	}
+	return httperrors.NewResourceNotFoundError2(WEBAUTHN_CREDENTIAL_TYPE, id)
+}
+
+func (manager *SCredentialManager) RemoveWebAuthnCredentials(s *mcclient.ClientSession, uid string) error {
+	return manager.removeCredentials(s, WEBAUTHN_CREDENTIAL_TYPE, uid, "")
+}
+
+func (manager *SCredentialManager) RemoveRecoveryCodes(s *mcclient.ClientSession, uid string) error {
+	return manager.removeCredentials(s, RECOVERY_CODES_TYPE, uid, "")
+}
+
 func (manager *SCredentialManager) RemoveOIDCSecrets(s *mcclient.ClientSession, uid string, pid string) error {
 	return manager.removeCredentials(s, OIDC_CREDENTIAL_TYPE, uid, pid)
 }
@@ -0,0 +1,35 @@
+// Copyright 2019 Yunion
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package modules
+
+import "yunion.io/x/onecloud/pkg/mcclient/modulebase"
+
+var (
+	ImageReplicationPolicies modulebase.ResourceManager
+	ImageReplications        modulebase.ResourceManager
+)
+
+func init() {
+	ImageReplicationPolicies = NewImageManager("image_replication_policy", "image_replication_policies",
+		[]string{"id", "name", "enabled", "target_regions", "tags", "project_ids", "replication_count"},
+		[]string{})
+	register(&ImageReplicationPolicies)
+
+	ImageReplications = NewImageManager("image_replication", "image_replications",
+		[]string{"id", "name", "status", "policy", "image_id", "image", "target_region", "target_image_id",
+			"checksum", "target_checksum", "attempts", "last_error", "replicated_at"},
+		[]string{"policy_id", "target_subformats"})
+	register(&ImageReplications)
+}
@@ -429,6 +429,27 @@ func (this *ImageManager) Upload(s *mcclient.ClientSession, params jsonutils.JSO
 	return this._create(s, params, body, size)
 }
 
+// UploadChunk uploads the data at offset of a queued image, offset must be
+// the size the image has received so far
+func (this *ImageManager) UploadChunk(s *mcclient.ClientSession, id string, offset int64, body io.Reader, size int64, final bool) (jsonutils.JSONObject, error) {
+	params := jsonutils.NewDict()
+	params.Set("upload_offset", jsonutils.NewString(fmt.Sprintf("%d", offset)))
+	params.Set("upload_final", jsonutils.NewBool(final))
+	headers, err := setImageMeta(params)
+	if err != nil {
+		return nil, err
+	}
+	headers.Add("Content-Type", "application/octet-stream")
+	headers.Add("Content-Length", fmt.Sprintf("%d", size))
+	path := fmt.Sprintf("/%s/%s", this.URLPath(), url.PathEscape(id))
+	resp, err := modulebase.RawRequest(this.ResourceManager, s, "PUT", path, headers, body)
+	_, json, err := s.ParseJSONResponse("", resp, err)
+	if err != nil {
+		return nil, err
+	}
+	return json.Get("image")
+}
+
 func (this *ImageManager) _create(s *mcclient.ClientSession, params jsonutils.JSONObject, body io.Reader, size int64) (jsonutils.JSONObject, error) {
 	/*format, _ := params.GetString("disk-format")
 	if len(format) == 0 {
@@ -584,7 +605,7 @@ func init() {
 			"Notes", "OS_arch", "Preference",
 			"OS_Codename", "Description",
 			"Checksum", "Tenant_Id", "Tenant",
-			"is_guest_image",
+			"is_guest_image", "Sha256_checksum", "Signature_status",
 		},
 		[]string{"Owner", "Owner_name"})}
 	register(&Images)
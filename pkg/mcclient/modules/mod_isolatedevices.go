@@ -25,7 +25,8 @@ func init() {
 		[]string{"ID", "Dev_type",
 			"Model", "Addr", "Vendor_device_id",
 			"Host_id", "Host",
-			"Guest_id", "Guest", "Guest_status"},
+			"Guest_id", "Guest", "Guest_status",
+			"Wire_id", "Network_index"},
 		[]string{})
 	registerCompute(&IsolatedDevices)
 }
@@ -23,10 +23,10 @@ var (
 
 func init() {
 	ScheduledTask = NewComputeManager("scheduledtask", "scheduledtasks",
-		[]string{"ID", "Name", "Scheduled_Type", "Timer", "Cycle_Timer", "Resource_Type", "Operation", "Label_Type", "Labels", "Timer_Desc"}, []string{},
+		[]string{"ID", "Name", "Scheduled_Type", "Timer", "Cycle_Timer", "Resource_Type", "Operation", "Operation_Params", "Label_Type", "Labels", "Timer_Desc"}, []string{},
 	)
 	ScheduledTaskActivity = NewComputeManager("scheudledtaskactivity", "scheduledtaskactivities",
-		[]string{"ID", "Status", "Scheduled_Task_Id", "Start_Time", "End_Time", "Reason"}, []string{},
+		[]string{"ID", "Status", "Scheduled_Task_Id", "Resource_Type", "Operation", "Start_Time", "End_Time", "Reason", "Results"}, []string{},
 	)
 	registerCompute(&ScheduledTask)
 	registerCompute(&ScheduledTaskActivity)
@@ -24,7 +24,7 @@ func init() {
 	Storagecachedimages = NewJointComputeManager("storagecachedimage",
 		"storagecachedimages",
 		[]string{"Storagecache_ID", "Storagecache",
-			"Cachedimage_ID", "Image", "Size", "Status", "Path", "Reference", "Storages"},
+			"Cachedimage_ID", "Image", "Size", "Status", "Path", "Format", "Reference", "Storages"},
 		[]string{},
 		&Storagecaches,
 		&Cachedimages)
@@ -0,0 +1,36 @@
+// Copyright 2019 Yunion
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package modules
+
+import "yunion.io/x/onecloud/pkg/mcclient/modulebase"
+
+var (
+	RateCards    modulebase.ResourceManager
+	UsageRecords modulebase.ResourceManager
+)
+
+func init() {
+	RateCards = NewComputeManager("ratecard", "ratecards",
+		[]string{"ID", "Name", "Enabled", "Status", "Resource_Type", "Zone_Id", "Zone", "Spec", "Price", "Currency"},
+		[]string{})
+
+	UsageRecords = NewComputeManager("usagerecord", "usagerecords",
+		[]string{"ID", "Resource_Type", "Resource_Id", "Resource_Name", "Spec", "Amount", "Zone", "Tenant",
+			"Start_Time", "End_Time", "Duration", "Price", "Cost", "Currency"},
+		[]string{})
+
+	registerCompute(&RateCards)
+	registerCompute(&UsageRecords)
+}
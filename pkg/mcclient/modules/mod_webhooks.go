@@ -0,0 +1,34 @@
+// Copyright 2019 Yunion
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package modules
+
+import "yunion.io/x/onecloud/pkg/mcclient/modulebase"
+
+var (
+	WebhookSubscriptions modulebase.ResourceManager
+	WebhookDeliveries    modulebase.ResourceManager
+)
+
+func init() {
+	WebhookSubscriptions = NewActionManager("webhook_subscription", "webhook_subscriptions",
+		[]string{"id", "name", "url", "obj_type", "action", "resource_scope", "max_retries", "enabled", "status", "pending_count", "dead_count", "tenant", "domain"},
+		[]string{})
+	register(&WebhookSubscriptions)
+
+	WebhookDeliveries = NewActionManager("webhook_delivery", "webhook_deliveries",
+		[]string{"id", "subscription_id", "subscription", "obj_type", "obj_id", "action", "status", "attempts", "next_attempt_at", "last_attempt_at", "response_code", "last_error"},
+		[]string{"actionlog_id", "tenant", "domain"})
+	register(&WebhookDeliveries)
+}
@@ -294,6 +294,18 @@ func (opts *SZStackCloudAccountCreateOptions) Params() (jsonutils.JSONObject, er
 	return params, nil
 }
 
+type SOvirtCloudAccountCreateOptions struct {
+	SCloudAccountCreateBaseOptions
+	SUserPasswordCredential
+	AuthURL string `help:"oVirt engine url, e.g. https://engine.example.com/ovirt-engine/api" positional:"true" json:"auth_url"`
+}
+
+func (opts *SOvirtCloudAccountCreateOptions) Params() (jsonutils.JSONObject, error) {
+	params := jsonutils.Marshal(opts)
+	params.(*jsonutils.JSONDict).Add(jsonutils.NewString("oVirt"), "provider")
+	return params, nil
+}
+
 type SS3CloudAccountCreateOptions struct {
 	SCloudAccountCreateBaseOptions
 	SAccessKeyCredential
@@ -454,6 +466,15 @@ func (opts *SZStackCloudAccountUpdateCredentialOptions) Params() (jsonutils.JSON
 	return jsonutils.Marshal(opts), nil
 }
 
+type SOvirtCloudAccountUpdateCredentialOptions struct {
+	SCloudAccountIdOptions
+	SUserPasswordCredential
+}
+
+func (opts *SOvirtCloudAccountUpdateCredentialOptions) Params() (jsonutils.JSONObject, error) {
+	return jsonutils.Marshal(opts), nil
+}
+
 type SS3CloudAccountUpdateCredentialOptions struct {
 	SCloudAccountIdOptions
 	SAccessKeyCredential
@@ -764,6 +785,14 @@ func (opts *SZStackCloudAccountUpdateOptions) Params() (jsonutils.JSONObject, er
 	return jsonutils.Marshal(opts), nil
 }
 
+type SOvirtCloudAccountUpdateOptions struct {
+	SCloudAccountUpdateBaseOptions
+}
+
+func (opts *SOvirtCloudAccountUpdateOptions) Params() (jsonutils.JSONObject, error) {
+	return jsonutils.Marshal(opts), nil
+}
+
 type SS3CloudAccountUpdateOptions struct {
 	SCloudAccountUpdateBaseOptions
 }
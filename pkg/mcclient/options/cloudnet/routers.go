@@ -118,3 +118,9 @@ type RouterActionUnregisterIfnameOptions struct {
 type RouterActionRealizeOptions struct {
 	ID string `json:"-"`
 }
+
+type RouterActionRotateWgKeysOptions struct {
+	ID string `json:"-"`
+
+	Ifname string `help:"rotate only this wireguard iface"`
+}
@@ -27,9 +27,9 @@ type ForwardCreateOptions struct {
 	ProxyAgentId    string
 	BindPortReq     *int
 
-	Type       string `choices:"local|remote" required:"true"`
-	RemoteAddr string `required:"true"`
-	RemotePort int    `required:"true"`
+	Type       string `choices:"local|remote|dynamic" required:"true"`
+	RemoteAddr string `help:"required for local and remote forward"`
+	RemotePort int    `json:",omitzero" help:"required for local and remote forward"`
 
 	LastSeenTimeout int `json:",omitzero"`
 
@@ -79,7 +79,7 @@ type ForwardUpdateOptions struct {
 	ProxyEndpointId string
 	ProxyAgentId    string
 
-	Type          string `choices:"local|remote"`
+	Type          string `choices:"local|remote|dynamic"`
 	RemoteAddr    string
 	RemotePortReq *int
 	BindPortReq   *int
@@ -107,7 +107,7 @@ type ForwardListOptions struct {
 	ProxyEndpointId string
 	ProxyAgentId    string
 
-	Type        string `choices:"local|remote"`
+	Type        string `choices:"local|remote|dynamic"`
 	RemoteAddr  string
 	RemotePort  *int
 	BindPortReq *int
@@ -24,7 +24,7 @@ type ProxyMatchCreateOptions struct {
 	NAME string
 
 	ProxyEndpointId string `required:"true"`
-	MatchScope      string `required:"true" choices:"vpc|network"`
+	MatchScope      string `required:"true" choices:"vpc|network|cidr|domain"`
 	MatchValue      string `required:"true"`
 }
 
@@ -41,7 +41,7 @@ type ProxyMatchUpdateOptions struct {
 	Name string
 
 	ProxyEndpointId string
-	MatchScope      string `choices:"vpc|network"`
+	MatchScope      string `choices:"vpc|network|cidr|domain"`
 	MatchValue      string
 }
 
@@ -61,7 +61,7 @@ type ProxyMatchListOptions struct {
 	options.BaseListOptions
 
 	ProxyEndpointId string
-	MatchScope      string `choices:"vpc|network"`
+	MatchScope      string `choices:"vpc|network|cidr|domain"`
 	MatchValue      string
 }
 
@@ -0,0 +1,133 @@
+// Copyright 2019 Yunion
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package compute
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"yunion.io/x/jsonutils"
+
+	"yunion.io/x/onecloud/pkg/mcclient/options"
+)
+
+type CDNDomainListOptions struct {
+	options.BaseListOptions
+
+	Area        []string `help:"filter by area" choices:"mainland|overseas|global"`
+	ServiceType []string `help:"filter by service type" choices:"web|download|media"`
+}
+
+func (opts *CDNDomainListOptions) Params() (jsonutils.JSONObject, error) {
+	return options.ListStructToParams(opts)
+}
+
+type CDNDomainIdOption struct {
+	ID string `help:"CDN domain Id or name"`
+}
+
+func (opts *CDNDomainIdOption) GetId() string {
+	return opts.ID
+}
+
+func (opts *CDNDomainIdOption) Params() (jsonutils.JSONObject, error) {
+	return nil, nil
+}
+
+type CDNDomainCreateOptions struct {
+	NAME        string   `help:"Accelerated domain name, e.g. www.example.com"`
+	Manager     string   `help:"Cloud provider Id or name" required:"true"`
+	Area        string   `help:"Acceleration area" choices:"mainland|overseas|global"`
+	ServiceType string   `help:"Service type" choices:"web|download|media"`
+	Origin      []string `help:"Origin, format: type=domain|ip|bucket,origin=<addr>[,port=<port>][,priority=<priority>][,server_name=<host>]" required:"true"`
+	Desc        string   `help:"Description" metavar:"<DESCRIPTION>"`
+}
+
+func parseCDNOrigin(str string) (jsonutils.JSONObject, error) {
+	origin := jsonutils.NewDict()
+	for _, kv := range strings.Split(str, ",") {
+		parts := strings.SplitN(kv, "=", 2)
+		if len(parts) != 2 {
+			return nil, fmt.Errorf("invalid origin option %q", kv)
+		}
+		key, value := strings.TrimSpace(parts[0]), strings.TrimSpace(parts[1])
+		switch key {
+		case "type", "origin", "server_name":
+			origin.Set(key, jsonutils.NewString(value))
+		case "port", "priority":
+			v, err := strconv.Atoi(value)
+			if err != nil {
+				return nil, fmt.Errorf("invalid origin %s %q", key, value)
+			}
+			origin.Set(key, jsonutils.NewInt(int64(v)))
+		default:
+			return nil, fmt.Errorf("unknown origin option %q", key)
+		}
+	}
+	if !origin.Contains("origin") {
+		return nil, fmt.Errorf("missing origin in %q", str)
+	}
+	return origin, nil
+}
+
+func (opts *CDNDomainCreateOptions) Params() (jsonutils.JSONObject, error) {
+	params := jsonutils.NewDict()
+	params.Set("name", jsonutils.NewString(opts.NAME))
+	params.Set("cloudprovider_id", jsonutils.NewString(opts.Manager))
+	if len(opts.Area) > 0 {
+		params.Set("area", jsonutils.NewString(opts.Area))
+	}
+	if len(opts.ServiceType) > 0 {
+		params.Set("service_type", jsonutils.NewString(opts.ServiceType))
+	}
+	if len(opts.Desc) > 0 {
+		params.Set("description", jsonutils.NewString(opts.Desc))
+	}
+	origins := jsonutils.NewArray()
+	for _, str := range opts.Origin {
+		origin, err := parseCDNOrigin(str)
+		if err != nil {
+			return nil, err
+		}
+		origins.Add(origin)
+	}
+	params.Set("origins", origins)
+	return params, nil
+}
+
+type CDNDomainPurgeCacheOptions struct {
+	CDNDomainIdOption
+	Type string   `help:"Purge type" choices:"file|directory" default:"file"`
+	URL  []string `help:"Urls or paths to purge, e.g. /index.html or http://www.example.com/static/"`
+}
+
+func (opts *CDNDomainPurgeCacheOptions) Params() (jsonutils.JSONObject, error) {
+	params := jsonutils.NewDict()
+	params.Set("type", jsonutils.NewString(opts.Type))
+	params.Set("urls", jsonutils.NewStringArray(opts.URL))
+	return params, nil
+}
+
+type CDNDomainPrefetchOptions struct {
+	CDNDomainIdOption
+	URL []string `help:"Urls or paths to prefetch, e.g. /download/app.apk"`
+}
+
+func (opts *CDNDomainPrefetchOptions) Params() (jsonutils.JSONObject, error) {
+	params := jsonutils.NewDict()
+	params.Set("urls", jsonutils.NewStringArray(opts.URL))
+	return params, nil
+}
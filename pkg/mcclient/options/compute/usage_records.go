@@ -0,0 +1,89 @@
+// Copyright 2019 Yunion
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package compute
+
+import (
+	"yunion.io/x/jsonutils"
+
+	"yunion.io/x/onecloud/pkg/mcclient/options"
+)
+
+type RateCardListOptions struct {
+	options.BaseListOptions
+
+	ResourceType []string `help:"filter by resource type" choices:"server|disk|eip|bucket|gpu"`
+	Spec         []string `help:"filter by spec"`
+	Zone         string   `help:"filter by zone"`
+}
+
+func (opts *RateCardListOptions) Params() (jsonutils.JSONObject, error) {
+	return options.ListStructToParams(opts)
+}
+
+type RateCardCreateOptions struct {
+	options.BaseCreateOptions
+
+	RESOURCE_TYPE string  `help:"resource type" choices:"server|disk|eip|bucket|gpu" json:"resource_type"`
+	PRICE         float64 `help:"price per unit per hour, unit of server is instance, disk and bucket is GB, eip is Mbps, gpu is device" json:"price"`
+	Zone          string  `help:"zone of rate card, empty for all zones" json:"zone_id"`
+	Spec          string  `help:"server sku, disk storage type, bucket storage class or gpu model, empty for all specs" json:"spec"`
+	Currency      string  `help:"currency of price, default CNY" json:"currency"`
+}
+
+func (opts *RateCardCreateOptions) Params() (jsonutils.JSONObject, error) {
+	return options.StructToParams(opts)
+}
+
+type RateCardUpdateOptions struct {
+	options.BaseUpdateOptions
+
+	Price    *float64 `help:"price per unit per hour"`
+	Currency string   `help:"currency of price"`
+}
+
+func (opts *RateCardUpdateOptions) Params() (jsonutils.JSONObject, error) {
+	return options.StructToParams(opts)
+}
+
+type UsageRecordListOptions struct {
+	options.BaseListOptions
+
+	ResourceType []string `help:"filter by resource type" choices:"server|disk|eip|bucket|gpu"`
+	ResourceId   []string `help:"filter by resource id"`
+	Zone         string   `help:"filter by zone"`
+	StartTime    string   `help:"filter records start after this time, e.g. 2021-06-01T00:00:00Z"`
+	EndTime      string   `help:"filter records end before this time, e.g. 2021-07-01T00:00:00Z"`
+}
+
+func (opts *UsageRecordListOptions) Params() (jsonutils.JSONObject, error) {
+	return options.ListStructToParams(opts)
+}
+
+type UsageRecordProjectCostOptions struct {
+	options.BaseListOptions
+
+	ResourceType []string `help:"filter by resource type" choices:"server|disk|eip|bucket|gpu"`
+	ProjectIds   []string `help:"filter by project ids"`
+	StartTime    string   `help:"start of the period, default the first day of this month, e.g. 2021-06-01T00:00:00Z"`
+	EndTime      string   `help:"end of the period, default now, e.g. 2021-07-01T00:00:00Z"`
+}
+
+func (opts *UsageRecordProjectCostOptions) Params() (jsonutils.JSONObject, error) {
+	return options.ListStructToParams(opts)
+}
+
+func (opts *UsageRecordProjectCostOptions) Property() string {
+	return "project-costs"
+}
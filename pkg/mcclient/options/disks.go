@@ -34,6 +34,7 @@ type DiskCreateOptions struct {
 	Backend    string   `help:"Backend of this disk"`
 	Schedtag   []string `help:"Schedule policy, key = aggregate name, value = require|exclude|prefer|avoid" metavar:"<KEY:VALUE>"`
 	TaskNotify bool     `help:"Setup task notify"`
+	EncryptKey string   `help:"ID or name of the encryption master key"`
 }
 
 func (o DiskCreateOptions) Params() (*api.DiskCreateInput, error) {
@@ -44,6 +45,9 @@ func (o DiskCreateOptions) Params() (*api.DiskCreateInput, error) {
 	if len(o.Backend) > 0 {
 		config.Backend = o.Backend
 	}
+	if len(o.EncryptKey) > 0 {
+		config.EncryptKeyId = o.EncryptKey
+	}
 	for _, desc := range o.Schedtag {
 		tag, err := cmdline.ParseSchedtagConfig(desc)
 		if err != nil {
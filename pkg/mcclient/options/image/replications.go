@@ -0,0 +1,115 @@
+// Copyright 2019 Yunion
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package image
+
+import (
+	"strings"
+
+	"yunion.io/x/jsonutils"
+
+	"yunion.io/x/onecloud/pkg/apis"
+	"yunion.io/x/onecloud/pkg/mcclient/options"
+)
+
+func parseReplicationTags(tags []string) []apis.STag {
+	ret := make([]apis.STag, 0, len(tags))
+	for _, tag := range tags {
+		parts := strings.SplitN(tag, "=", 2)
+		stag := apis.STag{Key: parts[0]}
+		if len(parts) == 2 {
+			stag.Value = parts[1]
+		}
+		ret = append(ret, stag)
+	}
+	return ret
+}
+
+type ImageReplicationPolicyListOptions struct {
+	options.BaseListOptions
+
+	TargetRegion string `help:"filter by target region"`
+}
+
+func (o *ImageReplicationPolicyListOptions) Params() (jsonutils.JSONObject, error) {
+	return options.ListStructToParams(o)
+}
+
+type ImageReplicationPolicyCreateOptions struct {
+	NAME string
+
+	TargetRegion []string `help:"target region to replicate images to" json:"target_regions"`
+	ImageTag     []string `help:"replicate images with the tag, eg: user:golden=true" json:"-"`
+	Project      []string `help:"replicate images of the project" json:"project_ids"`
+	Disabled     bool     `help:"create the policy disabled"`
+}
+
+func (o *ImageReplicationPolicyCreateOptions) Params() (jsonutils.JSONObject, error) {
+	params, err := options.StructToParams(o)
+	if err != nil {
+		return nil, err
+	}
+	if len(o.ImageTag) > 0 {
+		params.Set("tags", jsonutils.Marshal(parseReplicationTags(o.ImageTag)))
+	}
+	return params, nil
+}
+
+type ImageReplicationPolicyIdOptions struct {
+	ID string `help:"ID or Name of image replication policy" json:"-"`
+}
+
+func (o *ImageReplicationPolicyIdOptions) GetId() string {
+	return o.ID
+}
+
+func (o *ImageReplicationPolicyIdOptions) Params() (jsonutils.JSONObject, error) {
+	return nil, nil
+}
+
+type ImageReplicationPolicyUpdateOptions struct {
+	ImageReplicationPolicyIdOptions
+
+	Name         string
+	TargetRegion []string `help:"target region to replicate images to" json:"target_regions"`
+	ImageTag     []string `help:"replicate images with the tag, eg: user:golden=true" json:"-"`
+	Project      []string `help:"replicate images of the project" json:"project_ids"`
+}
+
+func (o *ImageReplicationPolicyUpdateOptions) Params() (jsonutils.JSONObject, error) {
+	params, err := options.StructToParams(o)
+	if err != nil {
+		return nil, err
+	}
+	if len(o.ImageTag) > 0 {
+		params.Set("tags", jsonutils.Marshal(parseReplicationTags(o.ImageTag)))
+	}
+	return params, nil
+}
+
+type ImageReplicationListOptions struct {
+	options.BaseListOptions
+
+	PolicyId     string   `help:"ID or Name of image replication policy"`
+	ImageId      string   `help:"ID or Name of source image"`
+	TargetRegion []string `help:"filter by target region"`
+}
+
+func (o *ImageReplicationListOptions) Params() (jsonutils.JSONObject, error) {
+	return options.ListStructToParams(o)
+}
+
+type ImageReplicationIdOptions struct {
+	options.BaseIdOptions
+}
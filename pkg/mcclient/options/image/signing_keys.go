@@ -0,0 +1,99 @@
+// Copyright 2019 Yunion
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package image
+
+import (
+	"io/ioutil"
+	"strings"
+
+	"yunion.io/x/jsonutils"
+	"yunion.io/x/pkg/errors"
+
+	"yunion.io/x/onecloud/pkg/mcclient/options"
+)
+
+type ImageSigningKeyListOptions struct {
+	options.BaseListOptions
+
+	Algorithm   []string `help:"filter by key algorithm" choices:"ecdsa|rsa|minisign"`
+	Fingerprint string   `help:"filter by sha256 fingerprint of public key"`
+}
+
+func (o *ImageSigningKeyListOptions) Params() (jsonutils.JSONObject, error) {
+	return options.ListStructToParams(o)
+}
+
+type ImageSigningKeyCreateOptions struct {
+	NAME string
+
+	PUBLIC_KEY string `help:"path to PEM encoded ecdsa/rsa public key or minisign public key file" json:"-"`
+	Domain     string `help:"domain the key is trusted in" json:"project_domain_id"`
+	Disabled   bool   `help:"create the key disabled"`
+}
+
+func (o *ImageSigningKeyCreateOptions) Params() (jsonutils.JSONObject, error) {
+	params, err := options.StructToParams(o)
+	if err != nil {
+		return nil, err
+	}
+	content, err := ioutil.ReadFile(o.PUBLIC_KEY)
+	if err != nil {
+		return nil, errors.Wrapf(err, "read public key %s", o.PUBLIC_KEY)
+	}
+	params.Set("public_key", jsonutils.NewString(string(content)))
+	return params, nil
+}
+
+type ImageSigningKeyIdOptions struct {
+	ID string `help:"ID or Name of image signing key" json:"-"`
+}
+
+func (o *ImageSigningKeyIdOptions) GetId() string {
+	return o.ID
+}
+
+func (o *ImageSigningKeyIdOptions) Params() (jsonutils.JSONObject, error) {
+	return nil, nil
+}
+
+type ImageSigningKeyUpdateOptions struct {
+	ImageSigningKeyIdOptions
+
+	Name        string
+	Description string
+}
+
+func (o *ImageSigningKeyUpdateOptions) Params() (jsonutils.JSONObject, error) {
+	return options.StructToParams(o)
+}
+
+type ImageSignOptions struct {
+	ID        string `help:"ID or Name of image" json:"-"`
+	SIGNATURE string `help:"path to detached signature file of the image, base64 ecdsa/rsa signature or minisign .minisig" json:"-"`
+}
+
+func (o *ImageSignOptions) GetId() string {
+	return o.ID
+}
+
+func (o *ImageSignOptions) Params() (jsonutils.JSONObject, error) {
+	content, err := ioutil.ReadFile(o.SIGNATURE)
+	if err != nil {
+		return nil, errors.Wrapf(err, "read signature %s", o.SIGNATURE)
+	}
+	params := jsonutils.NewDict()
+	params.Set("signature", jsonutils.NewString(strings.TrimSpace(string(content))))
+	return params, nil
+}
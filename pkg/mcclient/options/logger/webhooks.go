@@ -0,0 +1,93 @@
+// Copyright 2019 Yunion
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package logger
+
+import (
+	"yunion.io/x/jsonutils"
+
+	"yunion.io/x/onecloud/pkg/mcclient/options"
+)
+
+type WebhookSubscriptionListOptions struct {
+	options.BaseListOptions
+
+	ResourceScope []string `help:"filter by resource scope" choices:"system|domain|project"`
+}
+
+func (o *WebhookSubscriptionListOptions) Params() (jsonutils.JSONObject, error) {
+	return options.ListStructToParams(o)
+}
+
+type WebhookSubscriptionCreateOptions struct {
+	NAME string
+	URL  string `help:"http or https url receiving the events"`
+
+	Secret        string   `help:"secret to sign the payload with HMAC-SHA256"`
+	ObjType       []string `help:"resource types to subscribe, all types if not specified"`
+	Action        []string `help:"actions to subscribe, all actions if not specified"`
+	Source        []string `help:"event sources to subscribe, all sources if not specified" choices:"actionlog|opslog"`
+	ResourceScope string   `help:"scope of resources to subscribe" choices:"system|domain|project"`
+	MaxRetries    *int     `help:"max delivery attempts before dead-lettering"`
+}
+
+func (o *WebhookSubscriptionCreateOptions) Params() (jsonutils.JSONObject, error) {
+	return options.StructToParams(o)
+}
+
+type WebhookSubscriptionIdOptions struct {
+	ID string `help:"ID or Name of webhook subscription" json:"-"`
+}
+
+func (o *WebhookSubscriptionIdOptions) GetId() string {
+	return o.ID
+}
+
+func (o *WebhookSubscriptionIdOptions) Params() (jsonutils.JSONObject, error) {
+	return nil, nil
+}
+
+type WebhookSubscriptionUpdateOptions struct {
+	WebhookSubscriptionIdOptions
+
+	Name       string
+	Url        string
+	Secret     string
+	ObjType    []string
+	Action     []string
+	Source     []string `choices:"actionlog|opslog"`
+	MaxRetries *int
+}
+
+func (o *WebhookSubscriptionUpdateOptions) Params() (jsonutils.JSONObject, error) {
+	return options.StructToParams(o)
+}
+
+type WebhookDeliveryListOptions struct {
+	options.BaseListOptions
+
+	SubscriptionId string   `help:"ID or Name of webhook subscription"`
+	ObjType        []string `help:"filter by resource type"`
+	ObjId          []string `help:"filter by resource id"`
+	Action         []string `help:"filter by action"`
+	Source         []string `help:"filter by event source" choices:"actionlog|opslog"`
+}
+
+func (o *WebhookDeliveryListOptions) Params() (jsonutils.JSONObject, error) {
+	return options.ListStructToParams(o)
+}
+
+type WebhookDeliveryIdOptions struct {
+	options.BaseIdOptions
+}
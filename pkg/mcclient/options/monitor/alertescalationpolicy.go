@@ -0,0 +1,125 @@
+// Copyright 2019 Yunion
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package monitor
+
+import (
+	"fmt"
+	"strings"
+
+	"yunion.io/x/jsonutils"
+
+	"yunion.io/x/onecloud/pkg/apis"
+	"yunion.io/x/onecloud/pkg/apis/monitor"
+	"yunion.io/x/onecloud/pkg/mcclient/options"
+)
+
+type AlertEscalationPolicyListOptions struct {
+	options.BaseListOptions
+}
+
+func (o *AlertEscalationPolicyListOptions) Params() (jsonutils.JSONObject, error) {
+	return options.ListStructToParams(o)
+}
+
+type AlertEscalationPolicyShowOptions struct {
+	ID string `help:"ID or name of escalation policy" json:"-"`
+}
+
+func (o *AlertEscalationPolicyShowOptions) Params() (jsonutils.JSONObject, error) {
+	return options.StructToParams(o)
+}
+
+func (o *AlertEscalationPolicyShowOptions) GetId() string {
+	return o.ID
+}
+
+type AlertEscalationPolicyDeleteOptions struct {
+	AlertEscalationPolicyShowOptions
+}
+
+func parseAlertEscalationTiers(tiers []string) ([]monitor.AlertEscalationTier, error) {
+	ret := make([]monitor.AlertEscalationTier, 0, len(tiers))
+	for _, desc := range tiers {
+		segs := strings.Split(desc, ",")
+		tier := monitor.AlertEscalationTier{Interval: segs[0]}
+		for _, seg := range segs[1:] {
+			kv := strings.SplitN(seg, "=", 2)
+			if len(kv) != 2 {
+				return nil, fmt.Errorf("invalid tier item %q of %q", seg, desc)
+			}
+			switch kv[0] {
+			case "user":
+				tier.UserIds = append(tier.UserIds, kv[1])
+			case "group":
+				tier.GroupIds = append(tier.GroupIds, kv[1])
+			case "robot":
+				tier.RobotIds = append(tier.RobotIds, kv[1])
+			case "channel":
+				tier.Channel = kv[1]
+			default:
+				return nil, fmt.Errorf("unknown tier item %q of %q", kv[0], desc)
+			}
+		}
+		ret = append(ret, tier)
+	}
+	return ret, nil
+}
+
+type AlertEscalationPolicyCreateOptions struct {
+	apis.ScopedResourceCreateInput
+
+	NAME string   `help:"Name of escalation policy"`
+	Desc string   `help:"Description" json:"description"`
+	Tier []string `help:"escalation tier, format: <interval>[,user=<id>][,group=<id>][,robot=<id>][,channel=<channel>], e.g. 30m,group=ops,robot=webhook1" required:"true" json:"-"`
+}
+
+func (o *AlertEscalationPolicyCreateOptions) Params() (jsonutils.JSONObject, error) {
+	params, err := options.StructToParams(o)
+	if err != nil {
+		return nil, err
+	}
+	tiers, err := parseAlertEscalationTiers(o.Tier)
+	if err != nil {
+		return nil, err
+	}
+	params.Set("tiers", jsonutils.Marshal(tiers))
+	return params, nil
+}
+
+type AlertEscalationPolicyUpdateOptions struct {
+	ID   string   `help:"ID or name of escalation policy" json:"-"`
+	Name string   `help:"New name of escalation policy"`
+	Desc string   `help:"Description" json:"description"`
+	Tier []string `help:"escalation tier, format: <interval>[,user=<id>][,group=<id>][,robot=<id>][,channel=<channel>], e.g. 30m,group=ops,robot=webhook1" json:"-"`
+}
+
+func (o *AlertEscalationPolicyUpdateOptions) GetId() string {
+	return o.ID
+}
+
+func (o *AlertEscalationPolicyUpdateOptions) Params() (jsonutils.JSONObject, error) {
+	params, err := options.StructToParams(o)
+	if err != nil {
+		return nil, err
+	}
+	if len(o.Tier) > 0 {
+		tiers, err := parseAlertEscalationTiers(o.Tier)
+		if err != nil {
+			return nil, err
+		}
+		params.Set("tiers", jsonutils.Marshal(tiers))
+	}
+	return params, nil
+}
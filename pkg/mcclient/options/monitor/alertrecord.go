@@ -33,6 +33,8 @@ type AlertRecordListOptions struct {
 	ResTypes []string `json:"res_types"`
 	ResName  string   `json:"res_name"`
 	Alerting bool     `json:"alerting"`
+	AckState string   `help:"acknowledgement state" choices:"unacknowledged|acknowledged|resolved"`
+	Assignee string   `help:"ID or name of assignee"`
 }
 
 func (o *AlertRecordListOptions) Params() (jsonutils.JSONObject, error) {
@@ -127,3 +129,25 @@ func (o *AlertRecordShieldCreateOptions) Params() (jsonutils.JSONObject, error)
 	params.Add(jsonutils.NewTimeString(endTime), "end_time")
 	return params, nil
 }
+
+type AlertRecordAckOptions struct {
+	ID      string `help:"ID of alert record" json:"-"`
+	Comment string `help:"comment of the action"`
+}
+
+func (o *AlertRecordAckOptions) Params() (jsonutils.JSONObject, error) {
+	return options.StructToParams(o)
+}
+
+func (o *AlertRecordAckOptions) GetId() string {
+	return o.ID
+}
+
+type AlertRecordAssignOptions struct {
+	AlertRecordAckOptions
+	ASSIGNEE string `help:"ID or name of user to handle the alert"`
+}
+
+func (o *AlertRecordAssignOptions) Params() (jsonutils.JSONObject, error) {
+	return options.StructToParams(o)
+}
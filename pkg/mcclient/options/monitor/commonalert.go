@@ -58,6 +58,27 @@ func (o *CommonAlertUpdateOptions) GetId() string {
 	return o.ID
 }
 
+type CommonAlertSetEscalationPolicyOptions struct {
+	ID               string `help:"ID of alart " json:"-"`
+	EscalationPolicy string `help:"ID or name of escalation policy" json:"escalation_policy_id"`
+	Clear            bool   `help:"detach escalation policy from alert" json:"-"`
+}
+
+func (o *CommonAlertSetEscalationPolicyOptions) Params() (jsonutils.JSONObject, error) {
+	params, err := options.StructToParams(o)
+	if err != nil {
+		return nil, err
+	}
+	if o.Clear {
+		params.Set("escalation_policy_id", jsonutils.NewString(""))
+	}
+	return params, nil
+}
+
+func (o *CommonAlertSetEscalationPolicyOptions) GetId() string {
+	return o.ID
+}
+
 type CommonAlertDeleteOptions struct {
 	ID    []string `help:"ID of alart"`
 	Force bool     `help:"force to delete alert"`
@@ -65,6 +65,11 @@ type NetworkUpdateOptions struct {
 	Dns         string `help:"IP of DNS server"`
 	Domain      string `help:"Domain"`
 	Dhcp        string `help:"DHCP server IP"`
+	StartIp6    string `help:"Start of IPv6 address range"`
+	EndIp6      string `help:"End of IPv6 address range"`
+	NetMask6    int64  `help:"Length of IPv6 prefix"`
+	Gateway6    string `help:"IPv6 gateway"`
+	Dns6        string `help:"IPv6 DNS server"`
 	VlanId      int64  `help:"Vlan ID" default:"1"`
 	ExternalId  string `help:"External ID"`
 	AllocPolicy string `help:"Address allocation policy" choices:"none|stepdown|stepup|random"`
@@ -112,6 +117,21 @@ func (opts *NetworkUpdateOptions) Params() (jsonutils.JSONObject, error) {
 			params.Add(jsonutils.NewString(opts.Dhcp), "guest_dhcp")
 		}
 	}
+	if len(opts.StartIp6) > 0 {
+		params.Add(jsonutils.NewString(opts.StartIp6), "guest_ip6_start")
+	}
+	if len(opts.EndIp6) > 0 {
+		params.Add(jsonutils.NewString(opts.EndIp6), "guest_ip6_end")
+	}
+	if opts.NetMask6 > 0 {
+		params.Add(jsonutils.NewInt(opts.NetMask6), "guest_ip6_mask")
+	}
+	if len(opts.Gateway6) > 0 {
+		params.Add(jsonutils.NewString(opts.Gateway6), "guest_gateway6")
+	}
+	if len(opts.Dns6) > 0 {
+		params.Add(jsonutils.NewString(opts.Dns6), "guest_dns6")
+	}
 	if opts.VlanId > 0 {
 		params.Add(jsonutils.NewInt(opts.VlanId), "vlan_id")
 	}
@@ -20,6 +20,8 @@ type ProxySettingCreateOptions struct {
 	HTTPProxy  string
 	HTTPSProxy string
 	NoProxy    string
+
+	CloudproxyForwardId string `help:"connect through the dynamic forward of cloudproxy"`
 }
 
 type ProxySettingGetOptions struct {
@@ -33,6 +35,8 @@ type ProxySettingUpdateOptions struct {
 	HTTPProxy  string
 	HTTPSProxy string
 	NoProxy    string
+
+	CloudproxyForwardId string `help:"connect through the dynamic forward of cloudproxy"`
 }
 
 type ProxySettingDeleteOptions struct {
@@ -0,0 +1,327 @@
+// Copyright 2019 Yunion
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package gen
+
+import (
+	"bytes"
+	"fmt"
+	"go/format"
+	"path"
+	"reflect"
+	"sort"
+	"strings"
+	"text/template"
+
+	"yunion.io/x/pkg/errors"
+	"yunion.io/x/pkg/utils"
+
+	"yunion.io/x/onecloud/pkg/cloudcommon/db"
+)
+
+const (
+	ModulesPkgPath = "yunion.io/x/onecloud/pkg/mcclient/modules"
+	TypedPkgPath   = "yunion.io/x/onecloud/pkg/mcclient/typed"
+	ApisPkgPath    = "yunion.io/x/onecloud/pkg/apis"
+	JsonutilsPath  = "yunion.io/x/jsonutils"
+
+	onecloudPkgPrefix = "yunion.io/x/onecloud/"
+)
+
+// SGenerator generates the typed clients of a service into package PkgName
+type SGenerator struct {
+	// PkgName is the name of the generated package, e.g. compute
+	PkgName string
+	// ApiPkgPath is the apis package of the service, which is imported as api
+	ApiPkgPath string
+	// Header is put at the top of the generated file, e.g. the license
+	Header string
+
+	imports map[string]string
+}
+
+type resourceClient struct {
+	Module    string
+	Type      string
+	Accessor  string
+	Keyword   string
+	Details   string
+	List      string
+	Create    string
+	HasCreate bool
+	Update    string
+	HasUpdate bool
+	Actions   []actionMethod
+}
+
+type actionMethod struct {
+	FuncName string
+	Action   string
+	Input    string
+	Output   string
+}
+
+// Generate renders the typed clients of the model managers described by
+// specs, the managers without a module in modules or without typed details
+// are skipped
+func (g *SGenerator) Generate(specs []db.SModelApiSpec, modules map[string]SModuleVar) ([]byte, error) {
+	g.imports = map[string]string{
+		TypedPkgPath:   "typed",
+		ModulesPkgPath: "modules",
+	}
+	clients := make([]resourceClient, 0)
+	for _, spec := range specs {
+		mod, ok := modules[spec.KeywordPlural]
+		if !ok {
+			continue
+		}
+		details, ok := g.typeName(spec.Details)
+		if !ok {
+			continue
+		}
+		client := resourceClient{
+			Module:   "modules." + mod.Name,
+			Type:     utils.Kebab2Camel(spec.Keyword, "_") + "Client",
+			Accessor: utils.Kebab2Camel(spec.KeywordPlural, "_"),
+			Keyword:  spec.Keyword,
+			Details:  details,
+			List:     g.inputTypeName(spec.ListInput),
+		}
+		if !mod.IsPointer {
+			client.Module = "&" + client.Module
+		}
+		if spec.CreateInput != nil {
+			client.HasCreate = true
+			client.Create = g.inputTypeName(spec.CreateInput)
+		}
+		if spec.UpdateInput != nil {
+			client.HasUpdate = true
+			client.Update = g.inputTypeName(spec.UpdateInput)
+		}
+		for _, action := range spec.Actions {
+			client.Actions = append(client.Actions, actionMethod{
+				FuncName: action.FuncName,
+				Action:   action.Action,
+				Input:    g.inputTypeName(action.Input),
+				Output:   g.inputTypeName(action.Output),
+			})
+		}
+		clients = append(clients, client)
+	}
+	if len(clients) == 0 {
+		return nil, errors.Errorf("no typed client of package %s to generate", g.PkgName)
+	}
+
+	var buf bytes.Buffer
+	err := clientTemplate.Execute(&buf, map[string]interface{}{
+		"Header":  g.Header,
+		"Package": g.PkgName,
+		"Imports": g.importLines(),
+		"Clients": clients,
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "execute template")
+	}
+	output, err := format.Source(buf.Bytes())
+	if err != nil {
+		return nil, errors.Wrapf(err, "format generated code:\n%s", buf.String())
+	}
+	return output, nil
+}
+
+// inputTypeName renders the type of an argument, falling back to
+// jsonutils.JSONObject for the types that are not declared in pkg/apis
+func (g *SGenerator) inputTypeName(t reflect.Type) string {
+	if name, ok := g.typeName(t); ok {
+		return name
+	}
+	return g.importAs(JsonutilsPath) + ".JSONObject"
+}
+
+// typeName renders t if it only refers to the builtin types and the types
+// of pkg/apis and jsonutils
+func (g *SGenerator) typeName(t reflect.Type) (string, bool) {
+	if t == nil {
+		return "", false
+	}
+	if len(t.Name()) > 0 {
+		pkgPath := t.PkgPath()
+		if len(pkgPath) == 0 {
+			return t.Name(), true
+		}
+		if pkgPath != JsonutilsPath && pkgPath != ApisPkgPath && !strings.HasPrefix(pkgPath, ApisPkgPath+"/") {
+			return "", false
+		}
+		if !isExported(t.Name()) {
+			return "", false
+		}
+		return g.importAs(pkgPath) + "." + t.Name(), true
+	}
+	switch t.Kind() {
+	case reflect.Ptr:
+		elem, ok := g.typeName(t.Elem())
+		return "*" + elem, ok
+	case reflect.Slice:
+		elem, ok := g.typeName(t.Elem())
+		return "[]" + elem, ok
+	case reflect.Map:
+		key, ok := g.typeName(t.Key())
+		if !ok {
+			return "", false
+		}
+		elem, ok := g.typeName(t.Elem())
+		return fmt.Sprintf("map[%s]%s", key, elem), ok
+	}
+	return "", false
+}
+
+func isExported(name string) bool {
+	return len(name) > 0 && strings.ToUpper(name[:1]) == name[:1]
+}
+
+func (g *SGenerator) importAs(pkgPath string) string {
+	if alias, ok := g.imports[pkgPath]; ok {
+		return alias
+	}
+	alias := path.Base(pkgPath)
+	if pkgPath == g.ApiPkgPath {
+		alias = "api"
+	}
+	used := make(map[string]bool, len(g.imports))
+	for _, a := range g.imports {
+		used[a] = true
+	}
+	for i := 2; used[alias]; i++ {
+		alias = fmt.Sprintf("%s%d", path.Base(pkgPath), i)
+	}
+	g.imports[pkgPath] = alias
+	return alias
+}
+
+// importLines groups the imports as goimports does with the local prefix
+// yunion.io/x/onecloud
+func (g *SGenerator) importLines() [][]string {
+	groups := [][]string{{`"context"`}, {}, {}}
+	for pkgPath, alias := range g.imports {
+		line := fmt.Sprintf("%q", pkgPath)
+		if alias != path.Base(pkgPath) {
+			line = alias + " " + line
+		}
+		if strings.HasPrefix(pkgPath, onecloudPkgPrefix) {
+			groups[2] = append(groups[2], line)
+		} else {
+			groups[1] = append(groups[1], line)
+		}
+	}
+	ret := make([][]string, 0, len(groups))
+	for _, group := range groups {
+		if len(group) == 0 {
+			continue
+		}
+		sort.Slice(group, func(i, j int) bool {
+			return importPath(group[i]) < importPath(group[j])
+		})
+		ret = append(ret, group)
+	}
+	return ret
+}
+
+func importPath(line string) string {
+	return line[strings.Index(line, `"`):]
+}
+
+var clientTemplate = template.Must(template.New("client").Parse(`{{.Header}}
+// Code generated by typed-client-gen. DO NOT EDIT.
+
+package {{.Package}}
+
+import (
+{{- range $i, $group := .Imports}}
+{{if $i}}
+{{end}}
+{{- range $group}}
+	{{.}}
+{{- end}}
+{{- end}}
+)
+
+// Client groups the typed clients of the resources of package {{.Package}}
+type Client struct {
+	*typed.Client
+}
+
+func NewClient(c *typed.Client) *Client {
+	return &Client{Client: c}
+}
+{{range .Clients}}
+func (c *Client) {{.Accessor}}() *{{.Type}} {
+	return &{{.Type}}{Client: c.Client}
+}
+{{end}}
+{{- range $c := .Clients}}
+// {{$c.Type}} is the typed client of {{$c.Module}}
+type {{$c.Type}} struct {
+	*typed.Client
+}
+
+func (c *{{$c.Type}}) List(ctx context.Context, input {{$c.List}}) ([]{{$c.Details}}, int, error) {
+	ret := make([]{{$c.Details}}, 0)
+	total, err := typed.List({{$c.Module}}, c.Session(ctx), input, &ret)
+	if err != nil {
+		return nil, 0, err
+	}
+	return ret, total, nil
+}
+
+func (c *{{$c.Type}}) Get(ctx context.Context, id string) (*{{$c.Details}}, error) {
+	ret := new({{$c.Details}})
+	if err := typed.Get({{$c.Module}}, c.Session(ctx), id, ret); err != nil {
+		return nil, err
+	}
+	return ret, nil
+}
+{{if $c.HasCreate}}
+func (c *{{$c.Type}}) Create(ctx context.Context, input {{$c.Create}}) (*{{$c.Details}}, error) {
+	ret := new({{$c.Details}})
+	if err := typed.Create({{$c.Module}}, c.Session(ctx), input, ret); err != nil {
+		return nil, err
+	}
+	return ret, nil
+}
+{{end}}
+{{- if $c.HasUpdate}}
+func (c *{{$c.Type}}) Update(ctx context.Context, id string, input {{$c.Update}}) (*{{$c.Details}}, error) {
+	ret := new({{$c.Details}})
+	if err := typed.Update({{$c.Module}}, c.Session(ctx), id, input, ret); err != nil {
+		return nil, err
+	}
+	return ret, nil
+}
+{{end}}
+func (c *{{$c.Type}}) Delete(ctx context.Context, id string) (*{{$c.Details}}, error) {
+	ret := new({{$c.Details}})
+	if err := typed.Delete({{$c.Module}}, c.Session(ctx), id, ret); err != nil {
+		return nil, err
+	}
+	return ret, nil
+}
+{{range $c.Actions}}
+// {{.FuncName}} performs action {{.Action}} on {{$c.Keyword}} id
+func (c *{{$c.Type}}) {{.FuncName}}(ctx context.Context, id string, input {{.Input}}) ({{.Output}}, error) {
+	var ret {{.Output}}
+	err := typed.Perform({{$c.Module}}, c.Session(ctx), id, "{{.Action}}", input, &ret)
+	return ret, err
+}
+{{end}}
+{{- end}}`))
@@ -0,0 +1,143 @@
+// Copyright 2019 Yunion
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package gen
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+
+	"yunion.io/x/jsonutils"
+
+	"yunion.io/x/onecloud/pkg/apis/compute"
+	"yunion.io/x/onecloud/pkg/cloudcommon/db"
+)
+
+const testModulesSrc = `package modules
+
+type ServerManager struct {
+	modulebase.ResourceManager
+}
+
+var (
+	Servers ServerManager
+	Disks   modulebase.ResourceManager
+	Images  modulebase.ResourceManager
+	Records *SRecordManager
+)
+
+func init() {
+	Servers = ServerManager{NewComputeManager("server", "servers",
+		[]string{"ID"}, []string{})}
+	Disks = NewComputeManager("disk", "disks", []string{}, []string{})
+	Images = NewImageManager("image", "images", []string{}, []string{})
+	Records = NewRecordManager()
+}
+
+func NewRecordManager() *SRecordManager {
+	man := NewComputeManager("record", "records", []string{}, []string{})
+	return &SRecordManager{ResourceManager: &man}
+}
+`
+
+func TestParseModuleVars(t *testing.T) {
+	dir, err := ioutil.TempDir("", "modules")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	if err := ioutil.WriteFile(filepath.Join(dir, "mod.go"), []byte(testModulesSrc), 0644); err != nil {
+		t.Fatal(err)
+	}
+	vars, err := ParseModuleVars(dir, "NewComputeManager")
+	if err != nil {
+		t.Fatalf("ParseModuleVars: %v", err)
+	}
+	want := map[string]SModuleVar{
+		"servers": {Name: "Servers", Keyword: "server", KeywordPlural: "servers"},
+		"disks":   {Name: "Disks", Keyword: "disk", KeywordPlural: "disks"},
+		"records": {Name: "Records", Keyword: "record", KeywordPlural: "records", IsPointer: true},
+	}
+	if !reflect.DeepEqual(vars, want) {
+		t.Errorf("got %#v, want %#v", vars, want)
+	}
+}
+
+func TestGenerate(t *testing.T) {
+	specs := []db.SModelApiSpec{
+		{
+			Keyword:       "server",
+			KeywordPlural: "servers",
+			ListInput:     reflect.TypeOf(compute.ServerListInput{}),
+			CreateInput:   reflect.TypeOf(compute.ServerCreateInput{}),
+			UpdateInput:   reflect.TypeOf(&jsonutils.JSONDict{}),
+			Details:       reflect.TypeOf(compute.ServerDetails{}),
+			Actions: []db.SModelActionSpec{
+				{
+					Action:   "resume",
+					FuncName: "PerformResume",
+					Input:    reflect.TypeOf(compute.ServerResumeInput{}),
+					Output:   reflect.TypeOf((*jsonutils.JSONObject)(nil)).Elem(),
+				},
+			},
+		},
+		{
+			// details of a type out of pkg/apis is skipped
+			Keyword:       "disk",
+			KeywordPlural: "disks",
+			Details:       reflect.TypeOf(db.SModelApiSpec{}),
+		},
+		{
+			// no module
+			Keyword:       "host",
+			KeywordPlural: "hosts",
+			Details:       reflect.TypeOf(compute.HostDetails{}),
+		},
+	}
+	modules := map[string]SModuleVar{
+		"servers": {Name: "Servers", Keyword: "server", KeywordPlural: "servers"},
+		"disks":   {Name: "Disks", Keyword: "disk", KeywordPlural: "disks"},
+	}
+	g := &SGenerator{
+		PkgName:    "compute",
+		ApiPkgPath: ApisPkgPath + "/compute",
+	}
+	output, err := g.Generate(specs, modules)
+	if err != nil {
+		t.Fatalf("Generate: %v", err)
+	}
+	code := string(output)
+	for _, want := range []string{
+		`api "yunion.io/x/onecloud/pkg/apis/compute"`,
+		`func (c *Client) Servers() *ServerClient`,
+		`func (c *ServerClient) List(ctx context.Context, input api.ServerListInput) ([]api.ServerDetails, int, error)`,
+		`func (c *ServerClient) Create(ctx context.Context, input api.ServerCreateInput) (*api.ServerDetails, error)`,
+		`func (c *ServerClient) Update(ctx context.Context, id string, input *jsonutils.JSONDict) (*api.ServerDetails, error)`,
+		`func (c *ServerClient) PerformResume(ctx context.Context, id string, input api.ServerResumeInput) (jsonutils.JSONObject, error)`,
+		`typed.Perform(&modules.Servers, c.Session(ctx), id, "resume", input, &ret)`,
+	} {
+		if !strings.Contains(code, want) {
+			t.Errorf("generated code does not contain %q:\n%s", want, code)
+		}
+	}
+	for _, unwanted := range []string{"DiskClient", "HostClient"} {
+		if strings.Contains(code, unwanted) {
+			t.Errorf("generated code should not contain %s", unwanted)
+		}
+	}
+}
@@ -0,0 +1,159 @@
+// Copyright 2019 Yunion
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package gen
+
+import (
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"strconv"
+	"strings"
+
+	"yunion.io/x/pkg/errors"
+)
+
+// SModuleVar is a resource manager variable of pkg/mcclient/modules
+type SModuleVar struct {
+	Name          string
+	Keyword       string
+	KeywordPlural string
+	// IsPointer tells whether the variable itself implements
+	// modulebase.Manager or its address does
+	IsPointer bool
+}
+
+// ParseModuleVars scans the go files of dir, which is usually
+// pkg/mcclient/modules, for the resource managers created by constructor,
+// e.g. NewComputeManager. Variables assigned in the forms of
+//
+//	Disks = NewComputeManager("disk", "disks", ...)
+//	Servers = ServerManager{NewComputeManager("server", "servers", ...)}
+//	AlertRecordManager = NewAlertRecordManager()
+//
+// are recognized, the result is keyed by the plural keyword.
+func ParseModuleVars(dir string, constructor string) (map[string]SModuleVar, error) {
+	fset := token.NewFileSet()
+	pkgs, err := parser.ParseDir(fset, dir, nil, 0)
+	if err != nil {
+		return nil, errors.Wrapf(err, "parse %s", dir)
+	}
+	ret := make(map[string]SModuleVar)
+	for name, pkg := range pkgs {
+		if strings.HasSuffix(name, "_test") {
+			continue
+		}
+		funcs := make(map[string]*ast.FuncDecl)
+		for _, file := range pkg.Files {
+			for _, decl := range file.Decls {
+				if fn, ok := decl.(*ast.FuncDecl); ok && fn.Recv == nil {
+					funcs[fn.Name.Name] = fn
+				}
+			}
+		}
+		for _, file := range pkg.Files {
+			ast.Inspect(file, func(n ast.Node) bool {
+				assign, ok := n.(*ast.AssignStmt)
+				if !ok || assign.Tok != token.ASSIGN || len(assign.Lhs) != 1 || len(assign.Rhs) != 1 {
+					return true
+				}
+				ident, ok := assign.Lhs[0].(*ast.Ident)
+				if !ok || !ast.IsExported(ident.Name) {
+					return true
+				}
+				v, ok := parseModuleVar(assign.Rhs[0], constructor, funcs)
+				if !ok {
+					return true
+				}
+				v.Name = ident.Name
+				if _, exist := ret[v.KeywordPlural]; !exist {
+					ret[v.KeywordPlural] = v
+				}
+				return true
+			})
+		}
+	}
+	return ret, nil
+}
+
+func parseModuleVar(expr ast.Expr, constructor string, funcs map[string]*ast.FuncDecl) (SModuleVar, bool) {
+	switch e := expr.(type) {
+	case *ast.CallExpr:
+		if v, ok := parseConstructorCall(e, constructor); ok {
+			return v, true
+		}
+		// a wrapper like NewAlertRecordManager() returning a pointer
+		fnIdent, ok := e.Fun.(*ast.Ident)
+		if !ok || len(e.Args) > 0 {
+			return SModuleVar{}, false
+		}
+		fn, ok := funcs[fnIdent.Name]
+		if !ok || fn.Type.Results == nil || len(fn.Type.Results.List) != 1 || fn.Body == nil {
+			return SModuleVar{}, false
+		}
+		var ret SModuleVar
+		found := false
+		ast.Inspect(fn.Body, func(n ast.Node) bool {
+			if call, ok := n.(*ast.CallExpr); ok && !found {
+				ret, found = parseConstructorCall(call, constructor)
+			}
+			return !found
+		})
+		if !found {
+			return SModuleVar{}, false
+		}
+		_, ret.IsPointer = fn.Type.Results.List[0].Type.(*ast.StarExpr)
+		return ret, true
+	case *ast.CompositeLit:
+		if len(e.Elts) == 0 {
+			return SModuleVar{}, false
+		}
+		elt := e.Elts[0]
+		if kv, ok := elt.(*ast.KeyValueExpr); ok {
+			elt = kv.Value
+		}
+		if call, ok := elt.(*ast.CallExpr); ok {
+			return parseConstructorCall(call, constructor)
+		}
+	}
+	return SModuleVar{}, false
+}
+
+func parseConstructorCall(call *ast.CallExpr, constructor string) (SModuleVar, bool) {
+	fnIdent, ok := call.Fun.(*ast.Ident)
+	if !ok || fnIdent.Name != constructor || len(call.Args) < 2 {
+		return SModuleVar{}, false
+	}
+	keyword, ok := stringLiteral(call.Args[0])
+	if !ok {
+		return SModuleVar{}, false
+	}
+	plural, ok := stringLiteral(call.Args[1])
+	if !ok {
+		return SModuleVar{}, false
+	}
+	return SModuleVar{Keyword: keyword, KeywordPlural: plural}, true
+}
+
+func stringLiteral(expr ast.Expr) (string, bool) {
+	lit, ok := expr.(*ast.BasicLit)
+	if !ok || lit.Kind != token.STRING {
+		return "", false
+	}
+	str, err := strconv.Unquote(lit.Value)
+	if err != nil {
+		return "", false
+	}
+	return str, true
+}
@@ -0,0 +1,154 @@
+// Copyright 2019 Yunion
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package typed is the runtime of the generated typed clients, which wrap
+// the resource managers of pkg/mcclient/modules with the input and details
+// structs of pkg/apis. The clients of a service are generated by
+// cmd/typed-client-gen into a sub package, e.g. pkg/mcclient/typed/compute.
+package typed
+
+import (
+	"context"
+	"reflect"
+
+	"yunion.io/x/jsonutils"
+	"yunion.io/x/pkg/errors"
+
+	"yunion.io/x/onecloud/pkg/mcclient"
+	"yunion.io/x/onecloud/pkg/mcclient/auth"
+	"yunion.io/x/onecloud/pkg/mcclient/modulebase"
+)
+
+// Client creates a client session for each request, so that the context
+// passed to the typed methods is carried by the underlying http requests
+type Client struct {
+	getSession func(ctx context.Context) *mcclient.ClientSession
+}
+
+// NewClient returns a client which sends requests to the endpoints of
+// region with token
+func NewClient(cli *mcclient.Client, token mcclient.TokenCredential, region, endpointType string) *Client {
+	return &Client{
+		getSession: func(ctx context.Context) *mcclient.ClientSession {
+			return cli.NewSession(ctx, region, "", endpointType, token, "")
+		},
+	}
+}
+
+// NewAuthClient returns a client which gets sessions from the auth
+// manager, it is meant to be used by services that have called auth.Init
+func NewAuthClient(token mcclient.TokenCredential, region string) *Client {
+	return &Client{
+		getSession: func(ctx context.Context) *mcclient.ClientSession {
+			return auth.GetSession(ctx, token, region, "")
+		},
+	}
+}
+
+// NewSessionClient returns a client that always uses session s, the
+// context of the typed methods is ignored
+func NewSessionClient(s *mcclient.ClientSession) *Client {
+	return &Client{
+		getSession: func(ctx context.Context) *mcclient.ClientSession {
+			return s
+		},
+	}
+}
+
+func (c *Client) Session(ctx context.Context) *mcclient.ClientSession {
+	return c.getSession(ctx)
+}
+
+// Params converts a typed input to the params of a request
+func Params(input interface{}) jsonutils.JSONObject {
+	if rv := reflect.ValueOf(input); rv.Kind() == reflect.Ptr && rv.IsNil() {
+		return nil
+	}
+	switch v := input.(type) {
+	case nil:
+		return nil
+	case jsonutils.JSONObject:
+		return v
+	}
+	return jsonutils.Marshal(input)
+}
+
+// List lists the resources of man and unmarshals them into out, which is a
+// pointer to a slice, the total count of the resources is returned
+func List(man modulebase.Manager, s *mcclient.ClientSession, input interface{}, out interface{}) (int, error) {
+	result, err := man.List(s, Params(input))
+	if err != nil {
+		return 0, err
+	}
+	if err := jsonutils.NewArray(result.Data...).Unmarshal(out); err != nil {
+		return 0, errors.Wrapf(err, "unmarshal %s", man.KeyString())
+	}
+	return result.Total, nil
+}
+
+func Get(man modulebase.Manager, s *mcclient.ClientSession, id string, out interface{}) error {
+	obj, err := man.Get(s, id, nil)
+	if err != nil {
+		return err
+	}
+	return unmarshal(man, obj, out)
+}
+
+func Create(man modulebase.Manager, s *mcclient.ClientSession, input interface{}, out interface{}) error {
+	obj, err := man.Create(s, Params(input))
+	if err != nil {
+		return err
+	}
+	return unmarshal(man, obj, out)
+}
+
+func Update(man modulebase.Manager, s *mcclient.ClientSession, id string, input interface{}, out interface{}) error {
+	obj, err := man.Update(s, id, Params(input))
+	if err != nil {
+		return err
+	}
+	return unmarshal(man, obj, out)
+}
+
+func Delete(man modulebase.Manager, s *mcclient.ClientSession, id string, out interface{}) error {
+	obj, err := man.Delete(s, id, nil)
+	if err != nil {
+		return err
+	}
+	return unmarshal(man, obj, out)
+}
+
+// Perform performs action on resource id, out is left untouched if the
+// action returns nothing
+func Perform(man modulebase.Manager, s *mcclient.ClientSession, id string, action string, input interface{}, out interface{}) error {
+	obj, err := man.PerformAction(s, id, action, Params(input))
+	if err != nil {
+		return err
+	}
+	return unmarshal(man, obj, out)
+}
+
+func unmarshal(man modulebase.Manager, obj jsonutils.JSONObject, out interface{}) error {
+	if obj == nil || out == nil {
+		return nil
+	}
+	if ret, ok := out.(*jsonutils.JSONObject); ok {
+		*ret = obj
+		return nil
+	}
+	if err := obj.Unmarshal(out); err != nil {
+		return errors.Wrapf(err, "unmarshal %s", man.GetKeyword())
+	}
+	return nil
+}
@@ -0,0 +1,56 @@
+// Copyright 2019 Yunion
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package typed
+
+import (
+	"testing"
+
+	"yunion.io/x/jsonutils"
+)
+
+func TestParams(t *testing.T) {
+	type sInput struct {
+		Name  string `json:"name"`
+		Limit *int   `json:"limit"`
+	}
+	var nilDict *jsonutils.JSONDict
+	dict := jsonutils.NewDict()
+	dict.Set("name", jsonutils.NewString("test"))
+	cases := []struct {
+		name  string
+		input interface{}
+		want  string
+	}{
+		{"nil", nil, ""},
+		{"nil pointer", nilDict, ""},
+		{"json object", dict, `{"name":"test"}`},
+		{"struct", sInput{Name: "test"}, `{"name":"test"}`},
+		{"struct pointer", &sInput{Name: "test"}, `{"name":"test"}`},
+	}
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			got := Params(c.input)
+			if c.want == "" {
+				if got != nil {
+					t.Errorf("want nil, got %s", got)
+				}
+				return
+			}
+			if got == nil || got.String() != c.want {
+				t.Errorf("want %s, got %v", c.want, got)
+			}
+		})
+	}
+}
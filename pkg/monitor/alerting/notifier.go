@@ -107,6 +107,7 @@ func (n *notificationService) getNeededNotifiers(nIds []string, evalCtx *EvalCon
 
 	var result notifierStateSlice
 	shouldNotify := false
+	suppressed := n.isSuppressedByAck(evalCtx)
 	for _, obj := range notis {
 		not, err := InitNotifier(NotificationConfig{
 			Ctx:                   evalCtx.Ctx,
@@ -136,7 +137,7 @@ func (n *notificationService) getNeededNotifiers(nIds []string, evalCtx *EvalCon
 			}
 		}
 
-		if not.ShouldNotify(evalCtx.Ctx, evalCtx, state) {
+		if !suppressed && not.ShouldNotify(evalCtx.Ctx, evalCtx, state) {
 			shouldNotify = true
 			result = append(result, &notifierState{
 				notifier: not,
@@ -158,6 +159,20 @@ func (n *notificationService) getNeededNotifiers(nIds []string, evalCtx *EvalCon
 	return result, nil
 }
 
+// isSuppressedByAck checks whether the repeated notifications of a still
+// firing alert should be suppressed because it has been acknowledged.
+func (n *notificationService) isSuppressedByAck(evalCtx *EvalContext) bool {
+	if evalCtx.Rule.State != monitor.AlertStateAlerting || evalCtx.PrevAlertState != monitor.AlertStateAlerting {
+		return false
+	}
+	acked, err := models.AlertRecordManager.IsAlertAcknowledged(evalCtx.Rule.Id)
+	if err != nil {
+		log.Errorf("check alert %s acknowledged: %v", evalCtx.Rule.Id, err)
+		return false
+	}
+	return acked
+}
+
 func (n *notificationService) createAlertRecordWhenNotify(evalCtx *EvalContext, shouldNotify bool) {
 	var matches []*monitor.EvalMatch
 	if evalCtx.Firing {
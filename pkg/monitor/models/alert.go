@@ -139,6 +139,8 @@ type SAlert struct {
 	StateChanges        int                  `default:"0" nullable:"false" list:"user"`
 	CustomizeConfig     jsonutils.JSONObject `list:"user" create:"optional" update:"user"`
 	ResType             string               `width:"32" list:"user" update:"user"`
+	// EscalationPolicyId is the policy used to re-notify when alerting records stay unacknowledged
+	EscalationPolicyId string `width:"36" charset:"ascii" list:"user" create:"optional" update:"user"`
 }
 
 func (alert *SAlert) IsEnable() bool {
@@ -0,0 +1,367 @@
+// Copyright 2019 Yunion
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package models
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"time"
+
+	"yunion.io/x/jsonutils"
+	"yunion.io/x/log"
+	"yunion.io/x/pkg/errors"
+	"yunion.io/x/sqlchemy"
+
+	"yunion.io/x/onecloud/pkg/apis/monitor"
+	"yunion.io/x/onecloud/pkg/cloudcommon/db"
+	"yunion.io/x/onecloud/pkg/cloudcommon/notifyclient"
+	"yunion.io/x/onecloud/pkg/httperrors"
+	"yunion.io/x/onecloud/pkg/mcclient"
+	"yunion.io/x/onecloud/pkg/mcclient/modules/notify"
+	"yunion.io/x/onecloud/pkg/util/stringutils2"
+)
+
+var (
+	AlertEscalationPolicyManager *SAlertEscalationPolicyManager
+)
+
+type SAlertEscalationPolicyManager struct {
+	db.SEnabledResourceBaseManager
+	db.SStatusStandaloneResourceBaseManager
+	SMonitorScopedResourceManager
+}
+
+func init() {
+	AlertEscalationPolicyManager = &SAlertEscalationPolicyManager{
+		SStatusStandaloneResourceBaseManager: db.NewStatusStandaloneResourceBaseManager(
+			SAlertEscalationPolicy{},
+			"alertescalationpolicy_tbl",
+			"alertescalationpolicy",
+			"alertescalationpolicies",
+		),
+	}
+
+	AlertEscalationPolicyManager.SetVirtualObject(AlertEscalationPolicyManager)
+}
+
+// SAlertEscalationPolicy re-notifies the next tier of recipients when an
+// alerting record has not been acknowledged for the tier's interval.
+type SAlertEscalationPolicy struct {
+	db.SEnabledResourceBase
+	db.SStatusStandaloneResourceBase
+	SMonitorScopedResource
+
+	// Tiers is a list of monitor.AlertEscalationTier sorted by interval
+	Tiers jsonutils.JSONObject `nullable:"false" list:"user" create:"required" update:"user"`
+}
+
+func (manager *SAlertEscalationPolicyManager) ListItemExportKeys(ctx context.Context, q *sqlchemy.SQuery, userCred mcclient.TokenCredential, keys stringutils2.SSortedStrings) (*sqlchemy.SQuery, error) {
+	q, err := manager.SStatusStandaloneResourceBaseManager.ListItemExportKeys(ctx, q, userCred, keys)
+	if err != nil {
+		return nil, errors.Wrap(err, "SStatusStandaloneResourceBaseManager.ListItemExportKeys")
+	}
+	q, err = manager.SScopedResourceBaseManager.ListItemExportKeys(ctx, q, userCred, keys)
+	if err != nil {
+		return nil, errors.Wrap(err, "SScopedResourceBaseManager.ListItemExportKeys")
+	}
+	return q, nil
+}
+
+func (manager *SAlertEscalationPolicyManager) ListItemFilter(
+	ctx context.Context, q *sqlchemy.SQuery,
+	userCred mcclient.TokenCredential,
+	query monitor.AlertEscalationPolicyListInput,
+) (*sqlchemy.SQuery, error) {
+	var err error
+
+	q, err = manager.SStatusStandaloneResourceBaseManager.ListItemFilter(ctx, q, userCred, query.StatusStandaloneResourceListInput)
+	if err != nil {
+		return nil, errors.Wrap(err, "SStatusStandaloneResourceBaseManager.ListItemFilter")
+	}
+	q, err = manager.SEnabledResourceBaseManager.ListItemFilter(ctx, q, userCred,
+		query.EnabledResourceBaseListInput)
+	if err != nil {
+		return nil, errors.Wrap(err, "SEnabledResourceBaseManager.ListItemFilter")
+	}
+	q, err = manager.SScopedResourceBaseManager.ListItemFilter(ctx, q, userCred,
+		query.ScopedResourceBaseListInput)
+	if err != nil {
+		return nil, errors.Wrap(err, "SScopedResourceBaseManager.ListItemFilter")
+	}
+	return q, nil
+}
+
+func (manager *SAlertEscalationPolicyManager) OrderByExtraFields(
+	ctx context.Context,
+	q *sqlchemy.SQuery,
+	userCred mcclient.TokenCredential,
+	input monitor.AlertEscalationPolicyListInput,
+) (*sqlchemy.SQuery, error) {
+	var err error
+
+	q, err = manager.SStatusStandaloneResourceBaseManager.OrderByExtraFields(ctx, q, userCred, input.StatusStandaloneResourceListInput)
+	if err != nil {
+		return nil, errors.Wrap(err, "SStatusStandaloneResourceBaseManager.OrderByExtraFields")
+	}
+	q, err = manager.SScopedResourceBaseManager.OrderByExtraFields(ctx, q, userCred, input.ScopedResourceBaseListInput)
+	if err != nil {
+		return nil, errors.Wrap(err, "SScopedResourceBaseManager.OrderByExtraFields")
+	}
+	return q, nil
+}
+
+func (manager *SAlertEscalationPolicyManager) FetchCustomizeColumns(
+	ctx context.Context,
+	userCred mcclient.TokenCredential,
+	query jsonutils.JSONObject,
+	objs []interface{},
+	fields stringutils2.SSortedStrings,
+	isList bool,
+) []monitor.AlertEscalationPolicyDetails {
+	rows := make([]monitor.AlertEscalationPolicyDetails, len(objs))
+	stdRows := manager.SStatusStandaloneResourceBaseManager.FetchCustomizeColumns(ctx, userCred, query, objs, fields, isList)
+	scopedRows := manager.SScopedResourceBaseManager.FetchCustomizeColumns(ctx, userCred, query, objs, fields, isList)
+	for i := range rows {
+		rows[i] = monitor.AlertEscalationPolicyDetails{
+			StatusStandaloneResourceDetails: stdRows[i],
+			ScopedResourceBaseInfo:          scopedRows[i],
+		}
+		rows[i].AlertCount, _ = objs[i].(*SAlertEscalationPolicy).getAlertCount()
+	}
+	return rows
+}
+
+func (manager *SAlertEscalationPolicyManager) ValidateCreateData(
+	ctx context.Context, userCred mcclient.TokenCredential,
+	ownerId mcclient.IIdentityProvider, query jsonutils.JSONObject,
+	data monitor.AlertEscalationPolicyCreateInput,
+) (monitor.AlertEscalationPolicyCreateInput, error) {
+	tiers, err := validateEscalationTiers(data.Tiers)
+	if err != nil {
+		return data, err
+	}
+	data.Tiers = tiers
+	data.StandaloneResourceCreateInput, err = manager.SStandaloneResourceBaseManager.ValidateCreateData(ctx, userCred, ownerId, query, data.StandaloneResourceCreateInput)
+	if err != nil {
+		return data, errors.Wrap(err, "SStandaloneResourceBaseManager.ValidateCreateData")
+	}
+	return data, nil
+}
+
+func (policy *SAlertEscalationPolicy) CustomizeCreate(
+	ctx context.Context, userCred mcclient.TokenCredential,
+	ownerId mcclient.IIdentityProvider,
+	query jsonutils.JSONObject,
+	data jsonutils.JSONObject,
+) error {
+	policy.SetEnabled(true)
+	policy.Status = monitor.ALERT_STATUS_READY
+	return policy.SMonitorScopedResource.CustomizeCreate(ctx, userCred, ownerId, query, data)
+}
+
+func (policy *SAlertEscalationPolicy) ValidateUpdateData(
+	ctx context.Context, userCred mcclient.TokenCredential,
+	query jsonutils.JSONObject,
+	input monitor.AlertEscalationPolicyUpdateInput,
+) (monitor.AlertEscalationPolicyUpdateInput, error) {
+	var err error
+	if input.Tiers != nil {
+		input.Tiers, err = validateEscalationTiers(input.Tiers)
+		if err != nil {
+			return input, err
+		}
+	}
+	input.StandaloneResourceBaseUpdateInput, err = policy.SStandaloneResourceBase.ValidateUpdateData(ctx, userCred, query, input.StandaloneResourceBaseUpdateInput)
+	if err != nil {
+		return input, errors.Wrap(err, "SStandaloneResourceBase.ValidateUpdateData")
+	}
+	return input, nil
+}
+
+func (policy *SAlertEscalationPolicy) ValidateDeleteCondition(ctx context.Context) error {
+	cnt, err := policy.getAlertCount()
+	if err != nil {
+		return errors.Wrap(err, "getAlertCount")
+	}
+	if cnt > 0 {
+		return httperrors.NewNotEmptyError("escalation policy is used by %d alerts", cnt)
+	}
+	return policy.SStatusStandaloneResourceBase.ValidateDeleteCondition(ctx)
+}
+
+func (policy *SAlertEscalationPolicy) getAlertCount() (int, error) {
+	return CommonAlertManager.Query().Equals("escalation_policy_id", policy.Id).CountWithError()
+}
+
+func (policy *SAlertEscalationPolicy) GetTiers() ([]monitor.AlertEscalationTier, error) {
+	tiers := make([]monitor.AlertEscalationTier, 0)
+	if policy.Tiers == nil {
+		return tiers, nil
+	}
+	if err := policy.Tiers.Unmarshal(&tiers); err != nil {
+		return nil, errors.Wrap(err, "unmarshal tiers")
+	}
+	return tiers, nil
+}
+
+func validateEscalationTiers(tiers []monitor.AlertEscalationTier) ([]monitor.AlertEscalationTier, error) {
+	if len(tiers) == 0 {
+		return nil, httperrors.NewMissingParameterError("tiers")
+	}
+	intervals := make(map[string]time.Duration, len(tiers))
+	for i, tier := range tiers {
+		interval, err := time.ParseDuration(tier.Interval)
+		if err != nil {
+			return nil, httperrors.NewInputParameterError("Invalid interval format of tier %d: %s", i, tier.Interval)
+		}
+		if interval <= 0 {
+			return nil, httperrors.NewInputParameterError("interval of tier %d must be positive", i)
+		}
+		if len(tier.UserIds)+len(tier.GroupIds)+len(tier.RobotIds) == 0 {
+			return nil, httperrors.NewInputParameterError("tier %d has no user_ids, group_ids or robot_ids", i)
+		}
+		intervals[tier.Interval] = interval
+	}
+	sort.SliceStable(tiers, func(i, j int) bool {
+		return intervals[tiers[i].Interval] < intervals[tiers[j].Interval]
+	})
+	for i := 1; i < len(tiers); i++ {
+		if intervals[tiers[i].Interval] == intervals[tiers[i-1].Interval] {
+			return nil, httperrors.NewDuplicateNameError("interval", tiers[i].Interval)
+		}
+	}
+	return tiers, nil
+}
+
+// getDueEscalationTiers returns the indexes of tiers after level whose
+// interval has elapsed since the alert started firing.
+func getDueEscalationTiers(tiers []monitor.AlertEscalationTier, level int, elapsed time.Duration) []int {
+	ret := make([]int, 0)
+	for i := level; i < len(tiers); i++ {
+		interval, err := time.ParseDuration(tiers[i].Interval)
+		if err != nil || interval > elapsed {
+			break
+		}
+		ret = append(ret, i)
+	}
+	return ret
+}
+
+func notifyEscalationTier(ctx context.Context, tier monitor.AlertEscalationTier, priority notify.TNotifyPriority, data jsonutils.JSONObject) {
+	if len(tier.UserIds) > 0 {
+		notifyEscalationRecipients(ctx, tier.UserIds, false, tier.Channel, priority, data)
+	}
+	if len(tier.GroupIds) > 0 {
+		notifyEscalationRecipients(ctx, tier.GroupIds, true, tier.Channel, priority, data)
+	}
+	if len(tier.RobotIds) > 0 {
+		if err := notifyclient.NotifyRobotWithCtx(ctx, tier.RobotIds, priority, "DEFAULT", data); err != nil {
+			log.Errorf("escalation notify robots %v: %v", tier.RobotIds, err)
+		}
+	}
+}
+
+func notifyEscalationRecipients(ctx context.Context, ids []string, isGroup bool, channel string, priority notify.TNotifyPriority, data jsonutils.JSONObject) {
+	if len(channel) > 0 {
+		notifyclient.RawNotifyWithCtx(ctx, ids, isGroup, notify.TNotifyChannel(channel), priority, "DEFAULT", data)
+		return
+	}
+	if err := notifyclient.NotifyAllWithoutRobotWithCtx(ctx, ids, isGroup, priority, "DEFAULT", data); err != nil {
+		log.Errorf("escalation notify %v: %v", ids, err)
+	}
+}
+
+func getAlertNotifyPriority(level string) (notify.TNotifyPriority, string) {
+	switch level {
+	case "important":
+		return notify.NotifyPriorityImportant, "重要"
+	case "fatal", "critical":
+		return notify.NotifyPriorityCritical, "致命"
+	}
+	return notify.NotifyPriorityNormal, "普通"
+}
+
+// EscalateUnacknowledgedRecords notifies the next tiers of the escalation
+// policy for every alert whose latest alerting record is still unacknowledged.
+func (man *SAlertRecordManager) EscalateUnacknowledgedRecords(ctx context.Context, userCred mcclient.TokenCredential, isStart bool) {
+	alertingQuery := man.getAlertingRecordQuery().SubQuery()
+	q := man.Query().Equals("ack_state", monitor.ALERT_RECORD_ACK_STATE_UNACKED)
+	q = q.Join(alertingQuery, sqlchemy.Equals(q.Field("alert_id"), alertingQuery.Field("alert_id"))).Filter(
+		sqlchemy.Equals(q.Field("created_at"), alertingQuery.Field("max_created_at")))
+	records := make([]SAlertRecord, 0)
+	if err := db.FetchModelObjects(man, q, &records); err != nil {
+		log.Errorf("fetch unacknowledged alerting records: %v", err)
+		return
+	}
+	for i := range records {
+		if err := records[i].escalate(ctx, userCred); err != nil {
+			log.Errorf("escalate alert record %s: %v", records[i].GetId(), err)
+		}
+	}
+}
+
+func (record *SAlertRecord) escalate(ctx context.Context, userCred mcclient.TokenCredential) error {
+	alert, err := CommonAlertManager.GetAlert(record.AlertId)
+	if err != nil {
+		return errors.Wrapf(err, "GetAlert %s", record.AlertId)
+	}
+	if len(alert.EscalationPolicyId) == 0 {
+		return nil
+	}
+	obj, err := AlertEscalationPolicyManager.FetchById(alert.EscalationPolicyId)
+	if err != nil {
+		return errors.Wrapf(err, "fetch escalation policy %s", alert.EscalationPolicyId)
+	}
+	policy := obj.(*SAlertEscalationPolicy)
+	if !policy.GetEnabled() {
+		return nil
+	}
+	tiers, err := policy.GetTiers()
+	if err != nil {
+		return err
+	}
+	due := getDueEscalationTiers(tiers, record.EscalationLevel, time.Since(alert.LastStateChange))
+	if len(due) == 0 {
+		return nil
+	}
+
+	priority, level := getAlertNotifyPriority(record.Level)
+	matches, _ := record.GetEvalData()
+	config := monitor.NotificationTemplateConfig{
+		Title:       fmt.Sprintf("[%s][未确认] %s", level, alert.GetName()),
+		Name:        alert.GetName(),
+		Matches:     matches,
+		StartTime:   alert.LastStateChange.Format("2006-01-02 15:04:05"),
+		Description: fmt.Sprintf("报警已持续 %s 未确认, 升级通知", time.Since(alert.LastStateChange).Round(time.Second)),
+		Priority:    string(priority),
+		Level:       level,
+	}
+	data := jsonutils.Marshal(&config)
+	for _, idx := range due {
+		notifyEscalationTier(ctx, tiers[idx], priority, data)
+	}
+
+	_, err = db.Update(record, func() error {
+		record.EscalationLevel = due[len(due)-1] + 1
+		record.LastEscalatedAt = time.Now()
+		return nil
+	})
+	if err != nil {
+		return errors.Wrap(err, "update escalation level")
+	}
+	db.OpsLog.LogEvent(record, db.ACT_ALERT_ESCALATE, fmt.Sprintf("escalate to tier %d of policy %s", record.EscalationLevel, policy.GetName()), userCred)
+	return nil
+}
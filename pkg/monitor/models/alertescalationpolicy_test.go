@@ -0,0 +1,79 @@
+// Copyright 2019 Yunion
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package models
+
+import (
+	"reflect"
+	"testing"
+	"time"
+
+	"yunion.io/x/onecloud/pkg/apis/monitor"
+)
+
+func TestValidateEscalationTiers(t *testing.T) {
+	tiers, err := validateEscalationTiers([]monitor.AlertEscalationTier{
+		{Interval: "1h", GroupIds: []string{"ops"}},
+		{Interval: "15m", UserIds: []string{"oncall"}},
+		{Interval: "2h", RobotIds: []string{"webhook"}},
+	})
+	if err != nil {
+		t.Fatalf("validateEscalationTiers: %v", err)
+	}
+	got := []string{tiers[0].Interval, tiers[1].Interval, tiers[2].Interval}
+	if want := []string{"15m", "1h", "2h"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("tiers sorted as %v, want %v", got, want)
+	}
+
+	invalids := map[string][]monitor.AlertEscalationTier{
+		"empty":        {},
+		"bad interval": {{Interval: "soon", UserIds: []string{"oncall"}}},
+		"no recipient": {{Interval: "15m"}},
+		"duplicated": {
+			{Interval: "15m", UserIds: []string{"oncall"}},
+			{Interval: "900s", GroupIds: []string{"ops"}},
+		},
+	}
+	for name, tiers := range invalids {
+		if _, err := validateEscalationTiers(tiers); err == nil {
+			t.Errorf("%s: expect error", name)
+		}
+	}
+}
+
+func TestGetDueEscalationTiers(t *testing.T) {
+	tiers := []monitor.AlertEscalationTier{
+		{Interval: "15m"},
+		{Interval: "1h"},
+		{Interval: "2h"},
+	}
+	cases := []struct {
+		level   int
+		elapsed time.Duration
+		want    []int
+	}{
+		{0, 10 * time.Minute, []int{}},
+		{0, 20 * time.Minute, []int{0}},
+		{0, 90 * time.Minute, []int{0, 1}},
+		{1, 90 * time.Minute, []int{1}},
+		{2, 90 * time.Minute, []int{}},
+		{3, 5 * time.Hour, []int{}},
+	}
+	for _, c := range cases {
+		got := getDueEscalationTiers(tiers, c.level, c.elapsed)
+		if !reflect.DeepEqual(got, c.want) {
+			t.Errorf("level %d elapsed %s: got %v, want %v", c.level, c.elapsed, got, c.want)
+		}
+	}
+}
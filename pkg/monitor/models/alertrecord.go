@@ -57,6 +57,15 @@ type SAlertRecord struct {
 	EvalData  jsonutils.JSONObject `list:"user" update:"user"`
 	AlertRule jsonutils.JSONObject `list:"user" update:"user"`
 	ResType   string               `width:"36" list:"user" update:"user"`
+
+	AckState   string    `width:"36" charset:"ascii" nullable:"false" default:"unacknowledged" list:"user"`
+	AssigneeId string    `width:"64" charset:"ascii" list:"user"`
+	AckedBy    string    `width:"64" charset:"ascii" list:"user"`
+	AckedAt    time.Time `list:"user"`
+	ResolvedAt time.Time `list:"user"`
+	// EscalationLevel is the number of escalation tiers already notified
+	EscalationLevel int       `nullable:"false" default:"0" list:"user"`
+	LastEscalatedAt time.Time `list:"user"`
 }
 
 func init() {
@@ -129,6 +138,19 @@ func (manager *SAlertRecordManager) ListItemFilter(
 	if len(query.ResType) != 0 {
 		q.Filter(sqlchemy.Equals(q.Field("res_type"), query.ResType))
 	}
+	if len(query.AckState) != 0 {
+		q.Filter(sqlchemy.Equals(q.Field("ack_state"), query.AckState))
+	}
+	if len(query.Assignee) != 0 {
+		assignee, err := db.UserCacheManager.FetchUserByIdOrName(ctx, query.Assignee)
+		if err != nil {
+			if errors.Cause(err) == sql.ErrNoRows {
+				return nil, httperrors.NewResourceNotFoundError2("user", query.Assignee)
+			}
+			return nil, errors.Wrap(err, "FetchUserByIdOrName")
+		}
+		q.Filter(sqlchemy.Equals(q.Field("assignee_id"), assignee.Id))
+	}
 	return q, nil
 }
 
@@ -235,6 +257,16 @@ func (record *SAlertRecord) GetMoreDetails(out monitor.AlertRecordDetails) (moni
 	}
 	commonAlert, _ := CommonAlertManager.GetAlert(record.AlertId)
 	out.AlertName = commonAlert.GetName()
+	if len(record.AssigneeId) > 0 {
+		if user, err := db.UserCacheManager.FetchUserById(context.Background(), record.AssigneeId); err == nil {
+			out.Assignee = user.Name
+		}
+	}
+	if len(record.AckedBy) > 0 {
+		if user, err := db.UserCacheManager.FetchUserById(context.Background(), record.AckedBy); err == nil {
+			out.AckedByName = user.Name
+		}
+	}
 	return out, nil
 }
 
@@ -272,6 +304,11 @@ func (record *SAlertRecord) CustomizeCreate(
 	if err != nil {
 		return err
 	}
+	record.AckState = monitor.ALERT_RECORD_ACK_STATE_UNACKED
+	if record.GetState() == monitor.AlertStateOK {
+		record.AckState = monitor.ALERT_RECORD_ACK_STATE_RESOLVED
+		record.ResolvedAt = time.Now()
+	}
 	obj, err := db.NewModelObject(AlertRecordManager)
 	if err != nil {
 		return errors.Wrapf(err, "NewModelObject %s", AlertRecordManager.Keyword())
@@ -291,9 +328,130 @@ func (record *SAlertRecord) CustomizeCreate(
 			return errors.Wrap(err, "unionEvalMatch error")
 		}
 	}
+	if latestRecord.GetState() == monitor.AlertStateAlerting && record.GetState() == monitor.AlertStateAlerting {
+		record.inheritAckState(latestRecord)
+	}
 	return nil
 }
 
+// inheritAckState keeps the acknowledgement and escalation progress of an
+// alert that is still firing, so that repeated records of the same alert
+// are not escalated or notified again once someone has taken it.
+func (record *SAlertRecord) inheritAckState(latestRecord *SAlertRecord) {
+	record.AckState = latestRecord.AckState
+	record.AssigneeId = latestRecord.AssigneeId
+	record.AckedBy = latestRecord.AckedBy
+	record.AckedAt = latestRecord.AckedAt
+	record.ResolvedAt = latestRecord.ResolvedAt
+	record.EscalationLevel = latestRecord.EscalationLevel
+	record.LastEscalatedAt = latestRecord.LastEscalatedAt
+}
+
+// IsAlertAcknowledged reports whether the latest record of a firing alert has
+// been acknowledged or resolved by someone.
+func (man *SAlertRecordManager) IsAlertAcknowledged(alertId string) (bool, error) {
+	obj, err := db.NewModelObject(man)
+	if err != nil {
+		return false, errors.Wrapf(err, "NewModelObject %s", man.Keyword())
+	}
+	q := man.Query().Equals("alert_id", alertId).Desc("created_at")
+	if err := q.First(obj); err != nil {
+		if errors.Cause(err) == sql.ErrNoRows {
+			return false, nil
+		}
+		return false, errors.Wrapf(err, "get latest alertrecord by alertId: %s", alertId)
+	}
+	record := obj.(*SAlertRecord)
+	if record.GetState() != monitor.AlertStateAlerting {
+		return false, nil
+	}
+	return record.AckState != monitor.ALERT_RECORD_ACK_STATE_UNACKED, nil
+}
+
+func (record *SAlertRecord) AllowPerformAck(ctx context.Context, userCred mcclient.TokenCredential, query jsonutils.JSONObject, input monitor.AlertRecordAckInput) bool {
+	return db.IsProjectAllowPerform(userCred, record, "ack")
+}
+
+func (record *SAlertRecord) PerformAck(ctx context.Context, userCred mcclient.TokenCredential, query jsonutils.JSONObject, input monitor.AlertRecordAckInput) (jsonutils.JSONObject, error) {
+	if record.AckState == monitor.ALERT_RECORD_ACK_STATE_RESOLVED {
+		return nil, httperrors.NewInvalidStatusError("alert record %s is already resolved", record.GetName())
+	}
+	_, err := db.Update(record, func() error {
+		record.AckState = monitor.ALERT_RECORD_ACK_STATE_ACKED
+		record.AckedBy = userCred.GetUserId()
+		record.AckedAt = time.Now()
+		return nil
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "update ack state")
+	}
+	db.OpsLog.LogEvent(record, db.ACT_ALERT_ACK, input.Comment, userCred)
+	return nil, nil
+}
+
+func (record *SAlertRecord) AllowPerformAssign(ctx context.Context, userCred mcclient.TokenCredential, query jsonutils.JSONObject, input monitor.AlertRecordAssignInput) bool {
+	return db.IsProjectAllowPerform(userCred, record, "assign")
+}
+
+// PerformAssign hands the alert to a user, which also acknowledges it.
+func (record *SAlertRecord) PerformAssign(ctx context.Context, userCred mcclient.TokenCredential, query jsonutils.JSONObject, input monitor.AlertRecordAssignInput) (jsonutils.JSONObject, error) {
+	if len(input.Assignee) == 0 {
+		return nil, httperrors.NewMissingParameterError("assignee")
+	}
+	if record.AckState == monitor.ALERT_RECORD_ACK_STATE_RESOLVED {
+		return nil, httperrors.NewInvalidStatusError("alert record %s is already resolved", record.GetName())
+	}
+	assignee, err := db.UserCacheManager.FetchUserByIdOrName(ctx, input.Assignee)
+	if err != nil {
+		if errors.Cause(err) == sql.ErrNoRows {
+			return nil, httperrors.NewResourceNotFoundError2("user", input.Assignee)
+		}
+		return nil, errors.Wrap(err, "FetchUserByIdOrName")
+	}
+	_, err = db.Update(record, func() error {
+		record.AssigneeId = assignee.Id
+		if record.AckState == monitor.ALERT_RECORD_ACK_STATE_UNACKED {
+			record.AckState = monitor.ALERT_RECORD_ACK_STATE_ACKED
+			record.AckedBy = userCred.GetUserId()
+			record.AckedAt = time.Now()
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "update assignee")
+	}
+	notes := jsonutils.NewDict()
+	notes.Set("assignee", jsonutils.NewString(assignee.Name))
+	if len(input.Comment) > 0 {
+		notes.Set("comment", jsonutils.NewString(input.Comment))
+	}
+	db.OpsLog.LogEvent(record, db.ACT_ALERT_ASSIGN, notes, userCred)
+	return nil, nil
+}
+
+func (record *SAlertRecord) AllowPerformResolve(ctx context.Context, userCred mcclient.TokenCredential, query jsonutils.JSONObject, input monitor.AlertRecordResolveInput) bool {
+	return db.IsProjectAllowPerform(userCred, record, "resolve")
+}
+
+// PerformResolve marks the alert as handled, repeated notifications of the
+// alert are suppressed until it recovers.
+func (record *SAlertRecord) PerformResolve(ctx context.Context, userCred mcclient.TokenCredential, query jsonutils.JSONObject, input monitor.AlertRecordResolveInput) (jsonutils.JSONObject, error) {
+	_, err := db.Update(record, func() error {
+		if len(record.AckedBy) == 0 {
+			record.AckedBy = userCred.GetUserId()
+			record.AckedAt = time.Now()
+		}
+		record.AckState = monitor.ALERT_RECORD_ACK_STATE_RESOLVED
+		record.ResolvedAt = time.Now()
+		return nil
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "update resolve state")
+	}
+	db.OpsLog.LogEvent(record, db.ACT_ALERT_RESOLVE, input.Comment, userCred)
+	return nil, nil
+}
+
 func (record *SAlertRecord) unionEvalMatch(alertingRecord *SAlertRecord) error {
 	matches, err := record.GetEvalData()
 	if err != nil {
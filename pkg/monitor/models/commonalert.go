@@ -16,6 +16,7 @@ package models
 
 import (
 	"context"
+	"database/sql"
 	"fmt"
 	"strconv"
 	"strings"
@@ -208,6 +209,17 @@ func (man *SCommonAlertManager) ValidateCreateData(
 		return data, errors.Wrap(err, "metric query error")
 	}
 
+	if len(data.EscalationPolicyId) > 0 {
+		policy, err := db.FetchByIdOrName(AlertEscalationPolicyManager, userCred, data.EscalationPolicyId)
+		if err != nil {
+			if errors.Cause(err) == sql.ErrNoRows {
+				return data, httperrors.NewResourceNotFoundError2(AlertEscalationPolicyManager.Keyword(), data.EscalationPolicyId)
+			}
+			return data, errors.Wrap(err, "fetch escalation policy")
+		}
+		data.EscalationPolicyId = policy.GetId()
+	}
+
 	name, err := man.genName(ctx, ownerId, data.Name)
 	if err != nil {
 		return data, err
@@ -625,6 +637,11 @@ func (alert *SCommonAlert) GetMoreDetails(ctx context.Context, out monitor.Commo
 	}
 	out.Channel = channel.List()
 	out.Status = alert.GetStatus()
+	if len(alert.EscalationPolicyId) > 0 {
+		if policy, err := AlertEscalationPolicyManager.FetchById(alert.EscalationPolicyId); err == nil {
+			out.EscalationPolicy = policy.GetName()
+		}
+	}
 	out.AlertType = alert.getAlertType()
 	if alert.Frequency < 60 {
 		out.Period = fmt.Sprintf("%ds", alert.Frequency)
@@ -876,6 +893,20 @@ func (alert *SCommonAlert) ValidateUpdateData(
 		return data, errors.Wrap(err, "SStandaloneResourceBase.ValidateUpdateData")
 	}
 	updataInput := new(monitor.CommonAlertUpdateInput)
+	if data.Contains("escalation_policy_id") {
+		policyId, _ := data.GetString("escalation_policy_id")
+		if len(policyId) > 0 {
+			policy, err := db.FetchByIdOrName(AlertEscalationPolicyManager, userCred, policyId)
+			if err != nil {
+				if errors.Cause(err) == sql.ErrNoRows {
+					return data, httperrors.NewResourceNotFoundError2(AlertEscalationPolicyManager.Keyword(), policyId)
+				}
+				return data, errors.Wrap(err, "fetch escalation policy")
+			}
+			policyId = policy.GetId()
+		}
+		data.Set("escalation_policy_id", jsonutils.NewString(policyId))
+	}
 	if period, _ := data.GetString("period"); len(period) > 0 {
 		if _, err := time.ParseDuration(period); err != nil {
 			return data, httperrors.NewInputParameterError("Invalid period format: %s", period)
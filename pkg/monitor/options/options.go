@@ -30,6 +30,7 @@ type AlerterOptions struct {
 	InitScopeSuggestConfigIntervalSeconds          int   `help:"internal to init scope suggest configs" default:"900"`
 	InitAlertResourceAdminRoleUsersIntervalSeconds int   `help:"internal to init alert resource admin role users " default:"3600"`
 	MonitorResourceSyncIntervalSeconds             int   `help:"internal to sync monitor resource,unit: h " default:"1"`
+	AlertEscalationIntervalSeconds                 int   `help:"interval to escalate unacknowledged alert records" default:"60"`
 }
 
 var (
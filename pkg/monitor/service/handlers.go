@@ -36,6 +36,8 @@ import (
 func InitHandlers(app *appsrv.Application) {
 	db.InitAllManagers()
 
+	db.AddOpenApiHandler("", app)
+
 	db.RegisterModelManager(db.TenantCacheManager)
 	db.RegisterModelManager(db.UserCacheManager)
 	db.RegistUserCredCacheUpdater()
@@ -64,6 +66,7 @@ func InitHandlers(app *appsrv.Application) {
 		models.AlertPanelManager,
 		models.MonitorResourceManager,
 		models.AlertRecordShieldManager,
+		models.AlertEscalationPolicyManager,
 	} {
 		db.RegisterModelManager(manager)
 		handler := db.NewModelHandler(manager)
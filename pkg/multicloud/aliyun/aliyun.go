@@ -675,6 +675,7 @@ func (region *SAliyunClient) GetCapabilities() []string {
 		cloudprovider.CLOUD_CAPABILITY_MONGO_DB,
 		cloudprovider.CLOUD_CAPABILITY_ES,
 		cloudprovider.CLOUD_CAPABILITY_KAFKA,
+		cloudprovider.CLOUD_CAPABILITY_CDN,
 	}
 	return caps
 }
@@ -15,9 +15,18 @@
 package aliyun
 
 import (
+	"fmt"
+	"strconv"
+	"strings"
 	"time"
 
 	"github.com/pkg/errors"
+
+	"yunion.io/x/jsonutils"
+
+	api "yunion.io/x/onecloud/pkg/apis/compute"
+	"yunion.io/x/onecloud/pkg/cloudprovider"
+	"yunion.io/x/onecloud/pkg/multicloud"
 )
 
 type SCdnDomainNames struct {
@@ -53,18 +62,18 @@ type SCdnSources struct {
 	Source []SCdnSource `json:"Source"`
 }
 type SCdnPageData struct {
-	Cname           string     `json:"Cname"`
-	Description     string     `json:"Description"`
-	CdnType         string     `json:"CdnType"`
-	ResourceGroupID string     `json:"ResourceGroupId"`
-	DomainStatus    string     `json:"DomainStatus"`
-	SslProtocol     string     `json:"SslProtocol"`
-	DomainName      string     `json:"DomainName"`
-	Coverage        string     `json:"Coverage"`
-	Sources         SCdnSource `json:"Sources"`
-	GmtModified     string     `json:"GmtModified"`
-	Sandbox         string     `json:"Sandbox"`
-	GmtCreated      time.Time  `json:"GmtCreated"`
+	Cname           string      `json:"Cname"`
+	Description     string      `json:"Description"`
+	CdnType         string      `json:"CdnType"`
+	ResourceGroupID string      `json:"ResourceGroupId"`
+	DomainStatus    string      `json:"DomainStatus"`
+	SslProtocol     string      `json:"SslProtocol"`
+	DomainName      string      `json:"DomainName"`
+	Coverage        string      `json:"Coverage"`
+	Sources         SCdnSources `json:"Sources"`
+	GmtModified     string      `json:"GmtModified"`
+	Sandbox         string      `json:"Sandbox"`
+	GmtCreated      time.Time   `json:"GmtCreated"`
 }
 type SCdnDomains struct {
 	PageData []SCdnPageData `json:"PageData"`
@@ -102,3 +111,260 @@ func (client *SAliyunClient) DescribeUserDomains(domain string) (SCdnDomains, er
 	}
 	return sproducts, nil
 }
+
+type SCdnDomain struct {
+	multicloud.SVirtualResourceBase
+	multicloud.AliyunTags
+
+	client *SAliyunClient
+
+	Cname           string
+	Description     string
+	CdnType         string
+	ResourceGroupId string
+	DomainStatus    string
+	DomainName      string
+	Coverage        string
+	Sources         SCdnSources
+	GmtCreated      time.Time
+}
+
+func (self *SCdnDomain) GetId() string {
+	return self.DomainName
+}
+
+func (self *SCdnDomain) GetName() string {
+	return self.DomainName
+}
+
+func (self *SCdnDomain) GetGlobalId() string {
+	return self.DomainName
+}
+
+func (self *SCdnDomain) GetStatus() string {
+	if status := toAPICdnStatus(self.DomainStatus); len(status) > 0 {
+		return status
+	}
+	return api.CDN_DOMAIN_STATUS_UNKNOWN
+}
+
+func (self *SCdnDomain) GetProjectId() string {
+	return self.ResourceGroupId
+}
+
+func (self *SCdnDomain) GetArea() string {
+	return toAPICdnArea(self.Coverage)
+}
+
+func (self *SCdnDomain) GetServiceType() string {
+	switch self.CdnType {
+	case "video":
+		return api.CDN_SERVICE_TYPE_MEDIA
+	default:
+		return self.CdnType
+	}
+}
+
+func (self *SCdnDomain) GetCname() string {
+	return self.Cname
+}
+
+func (self *SCdnDomain) GetOrigins() *cloudprovider.SCdnOrigins {
+	ret := cloudprovider.SCdnOrigins{}
+	for _, source := range self.Sources.Source {
+		priority, _ := strconv.Atoi(source.Priority)
+		origin := cloudprovider.SCdnOrigin{
+			Type:     api.CDN_DOMAIN_ORIGIN_TYPE_DOMAIN,
+			Origin:   source.Content,
+			Port:     source.Port,
+			Priority: priority,
+		}
+		switch source.Type {
+		case "ipaddr":
+			origin.Type = api.CDN_DOMAIN_ORIGIN_TYPE_IP
+		case "oss":
+			origin.Type = api.CDN_DOMAIN_ORIGIN_TYPE_BUCKET
+		}
+		ret = append(ret, origin)
+	}
+	return &ret
+}
+
+func (self *SCdnDomain) Refresh() error {
+	domain, err := self.client.GetCdnDomain(self.DomainName)
+	if err != nil {
+		return errors.Wrapf(err, "GetCdnDomain(%s)", self.DomainName)
+	}
+	return jsonutils.Update(self, domain)
+}
+
+func (self *SCdnDomain) Delete() error {
+	return self.client.DeleteCdnDomain(self.DomainName)
+}
+
+func (self *SCdnDomain) PurgeCache(objectType string, paths []string) error {
+	return self.client.RefreshObjectCaches(objectType, paths)
+}
+
+func (self *SCdnDomain) Prefetch(urls []string) error {
+	return self.client.PushObjectCache(urls)
+}
+
+func (client *SAliyunClient) DescribeCdnDomains(domain string, pageNumber, pageSize int) ([]SCdnDomain, int, error) {
+	params := map[string]string{
+		"PageNumber": fmt.Sprintf("%d", pageNumber),
+		"PageSize":   fmt.Sprintf("%d", pageSize),
+	}
+	if len(domain) > 0 {
+		params["DomainName"] = domain
+		params["DomainSearchType"] = "full_match"
+	}
+	resp, err := client.cdnRequest("DescribeUserDomains", params)
+	if err != nil {
+		return nil, 0, errors.Wrap(err, "DescribeUserDomains")
+	}
+	domains := []SCdnDomain{}
+	err = resp.Unmarshal(&domains, "Domains", "PageData")
+	if err != nil {
+		return nil, 0, errors.Wrap(err, "resp.Unmarshal")
+	}
+	total, _ := resp.Int("TotalCount")
+	return domains, int(total), nil
+}
+
+func (client *SAliyunClient) GetCdnDomains() ([]SCdnDomain, error) {
+	domains := []SCdnDomain{}
+	for {
+		part, total, err := client.DescribeCdnDomains("", len(domains)/50+1, 50)
+		if err != nil {
+			return nil, errors.Wrap(err, "DescribeCdnDomains")
+		}
+		domains = append(domains, part...)
+		if len(domains) >= total || len(part) == 0 {
+			break
+		}
+	}
+	return domains, nil
+}
+
+func (client *SAliyunClient) GetCdnDomain(domain string) (*SCdnDomain, error) {
+	domains, _, err := client.DescribeCdnDomains(domain, 1, 50)
+	if err != nil {
+		return nil, errors.Wrap(err, "DescribeCdnDomains")
+	}
+	for i := range domains {
+		if domains[i].DomainName == domain {
+			domains[i].client = client
+			return &domains[i], nil
+		}
+	}
+	return nil, errors.Wrap(cloudprovider.ErrNotFound, domain)
+}
+
+func (client *SAliyunClient) GetICloudCDNDomains() ([]cloudprovider.ICloudCDNDomain, error) {
+	domains, err := client.GetCdnDomains()
+	if err != nil {
+		return nil, errors.Wrap(err, "GetCdnDomains")
+	}
+	ret := []cloudprovider.ICloudCDNDomain{}
+	for i := range domains {
+		domains[i].client = client
+		ret = append(ret, &domains[i])
+	}
+	return ret, nil
+}
+
+func (client *SAliyunClient) GetICloudCDNDomainByName(name string) (cloudprovider.ICloudCDNDomain, error) {
+	return client.GetCdnDomain(name)
+}
+
+func (client *SAliyunClient) CreateICloudCDNDomain(opts *cloudprovider.CdnCreateOptions) (cloudprovider.ICloudCDNDomain, error) {
+	err := client.AddCdnDomain(opts)
+	if err != nil {
+		return nil, errors.Wrap(err, "AddCdnDomain")
+	}
+	return client.GetCdnDomain(opts.Domain)
+}
+
+func (client *SAliyunClient) AddCdnDomain(opts *cloudprovider.CdnCreateOptions) error {
+	params := map[string]string{
+		"DomainName": opts.Domain,
+		"CdnType":    "web",
+		"Scope":      "domestic",
+	}
+	switch opts.ServiceType {
+	case api.CDN_SERVICE_TYPE_DOWNLOAD:
+		params["CdnType"] = "download"
+	case api.CDN_SERVICE_TYPE_MEDIA:
+		params["CdnType"] = "video"
+	}
+	switch opts.Area {
+	case api.CDN_DOMAIN_AREA_OVERSEAS:
+		params["Scope"] = "overseas"
+	case api.CDN_DOMAIN_AREA_GLOBAL:
+		params["Scope"] = "global"
+	}
+	sources := []map[string]interface{}{}
+	for _, origin := range opts.Origins {
+		source := map[string]interface{}{
+			"content":  origin.Origin,
+			"type":     "domain",
+			"priority": "20",
+			"port":     80,
+			"weight":   "10",
+		}
+		switch origin.Type {
+		case api.CDN_DOMAIN_ORIGIN_TYPE_IP:
+			source["type"] = "ipaddr"
+		case api.CDN_DOMAIN_ORIGIN_TYPE_BUCKET:
+			source["type"] = "oss"
+		}
+		if origin.Port > 0 {
+			source["port"] = origin.Port
+		}
+		if origin.Priority > 0 {
+			source["priority"] = fmt.Sprintf("%d", origin.Priority)
+		}
+		sources = append(sources, source)
+	}
+	params["Sources"] = jsonutils.Marshal(sources).String()
+	_, err := client.cdnRequest("AddCdnDomain", params)
+	if err != nil {
+		return errors.Wrapf(err, "AddCdnDomain %s", opts.Domain)
+	}
+	return nil
+}
+
+func (client *SAliyunClient) DeleteCdnDomain(domain string) error {
+	_, err := client.cdnRequest("DeleteCdnDomain", map[string]string{"DomainName": domain})
+	if err != nil {
+		return errors.Wrapf(err, "DeleteCdnDomain %s", domain)
+	}
+	return nil
+}
+
+func (client *SAliyunClient) RefreshObjectCaches(objectType string, paths []string) error {
+	params := map[string]string{
+		"ObjectPath": strings.Join(paths, "\n"),
+		"ObjectType": "File",
+	}
+	if objectType == api.CDN_PURGE_TYPE_DIRECTORY {
+		params["ObjectType"] = "Directory"
+	}
+	_, err := client.cdnRequest("RefreshObjectCaches", params)
+	if err != nil {
+		return errors.Wrap(err, "RefreshObjectCaches")
+	}
+	return nil
+}
+
+func (client *SAliyunClient) PushObjectCache(urls []string) error {
+	params := map[string]string{
+		"ObjectPath": strings.Join(urls, "\n"),
+	}
+	_, err := client.cdnRequest("PushObjectCache", params)
+	if err != nil {
+		return errors.Wrap(err, "PushObjectCache")
+	}
+	return nil
+}
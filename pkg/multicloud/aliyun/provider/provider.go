@@ -414,6 +414,18 @@ func (self *SAliyunProvider) CreateICloudDnsZone(opts *cloudprovider.SDnsZoneCre
 	}
 }
 
+func (self *SAliyunProvider) GetICloudCDNDomains() ([]cloudprovider.ICloudCDNDomain, error) {
+	return self.client.GetICloudCDNDomains()
+}
+
+func (self *SAliyunProvider) GetICloudCDNDomainByName(name string) (cloudprovider.ICloudCDNDomain, error) {
+	return self.client.GetICloudCDNDomainByName(name)
+}
+
+func (self *SAliyunProvider) CreateICloudCDNDomain(opts *cloudprovider.CdnCreateOptions) (cloudprovider.ICloudCDNDomain, error) {
+	return self.client.CreateICloudCDNDomain(opts)
+}
+
 func (self *SAliyunProvider) GetICloudInterVpcNetworks() ([]cloudprovider.ICloudInterVpcNetwork, error) {
 	scens, err := self.client.GetAllCens()
 	if err != nil {
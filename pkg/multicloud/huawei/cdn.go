@@ -0,0 +1,332 @@
+// Copyright 2019 Yunion
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package huawei
+
+import (
+	"fmt"
+	"time"
+
+	"yunion.io/x/jsonutils"
+	"yunion.io/x/pkg/errors"
+
+	api "yunion.io/x/onecloud/pkg/apis/compute"
+	"yunion.io/x/onecloud/pkg/cloudprovider"
+	"yunion.io/x/onecloud/pkg/multicloud"
+)
+
+type SCdnSource struct {
+	DomainId      string
+	IpOrDomain    string
+	OriginType    string
+	ActiveStandby int
+}
+
+// https://support.huaweicloud.com/api-cdn/cdn_02_0082.html
+type SCdnDomain struct {
+	multicloud.SVirtualResourceBase
+	multicloud.HuaweiTags
+
+	client *SHuaweiClient
+
+	Id                  string
+	DomainName          string
+	BusinessType        string
+	DomainStatus        string
+	Cname               string
+	Sources             []SCdnSource
+	ServiceArea         string
+	EnterpriseProjectId string
+}
+
+func (self *SCdnDomain) GetId() string {
+	return self.Id
+}
+
+func (self *SCdnDomain) GetName() string {
+	return self.DomainName
+}
+
+func (self *SCdnDomain) GetGlobalId() string {
+	return self.DomainName
+}
+
+func (self *SCdnDomain) GetStatus() string {
+	switch self.DomainStatus {
+	case "online":
+		return api.CDN_DOMAIN_STATUS_ONLINE
+	case "offline":
+		return api.CDN_DOMAIN_STATUS_OFFLINE
+	case "configuring", "checking", "deleting":
+		return api.CDN_DOMAIN_STATUS_PROCESSING
+	case "configure_failed", "check_failed":
+		return api.CDN_DOMAIN_STATUS_REJECTED
+	default:
+		return api.CDN_DOMAIN_STATUS_UNKNOWN
+	}
+}
+
+func (self *SCdnDomain) GetProjectId() string {
+	return self.EnterpriseProjectId
+}
+
+func (self *SCdnDomain) GetArea() string {
+	switch self.ServiceArea {
+	case "mainland_china":
+		return api.CDN_DOMAIN_AREA_MAINLAND
+	case "outside_mainland_china":
+		return api.CDN_DOMAIN_AREA_OVERSEAS
+	case "global":
+		return api.CDN_DOMAIN_AREA_GLOBAL
+	default:
+		return ""
+	}
+}
+
+func (self *SCdnDomain) GetServiceType() string {
+	switch self.BusinessType {
+	case "download":
+		return api.CDN_SERVICE_TYPE_DOWNLOAD
+	case "video":
+		return api.CDN_SERVICE_TYPE_MEDIA
+	default:
+		return api.CDN_SERVICE_TYPE_WEB
+	}
+}
+
+func (self *SCdnDomain) GetCname() string {
+	return self.Cname
+}
+
+func (self *SCdnDomain) GetOrigins() *cloudprovider.SCdnOrigins {
+	ret := cloudprovider.SCdnOrigins{}
+	for _, source := range self.Sources {
+		origin := cloudprovider.SCdnOrigin{
+			Type:     api.CDN_DOMAIN_ORIGIN_TYPE_DOMAIN,
+			Origin:   source.IpOrDomain,
+			Priority: 2,
+		}
+		switch source.OriginType {
+		case "ipaddr":
+			origin.Type = api.CDN_DOMAIN_ORIGIN_TYPE_IP
+		case "obs_bucket":
+			origin.Type = api.CDN_DOMAIN_ORIGIN_TYPE_BUCKET
+		}
+		// 主源站优先级更高
+		if source.ActiveStandby == 1 {
+			origin.Priority = 1
+		}
+		ret = append(ret, origin)
+	}
+	return &ret
+}
+
+func (self *SCdnDomain) Refresh() error {
+	domain, err := self.client.GetCdnDomain(self.DomainName)
+	if err != nil {
+		return errors.Wrapf(err, "GetCdnDomain(%s)", self.DomainName)
+	}
+	return jsonutils.Update(self, domain)
+}
+
+// 华为云需要先停用加速域名才能删除
+func (self *SCdnDomain) Delete() error {
+	if self.DomainStatus != "offline" {
+		err := self.client.DisableCdnDomain(self.Id)
+		if err != nil {
+			return errors.Wrap(err, "DisableCdnDomain")
+		}
+		err = cloudprovider.WaitStatus(self, api.CDN_DOMAIN_STATUS_OFFLINE, 10*time.Second, 10*time.Minute)
+		if err != nil {
+			return errors.Wrap(err, "wait cdn domain offline")
+		}
+	}
+	return self.client.DeleteCdnDomain(self.Id)
+}
+
+func (self *SCdnDomain) PurgeCache(objectType string, paths []string) error {
+	return self.client.CreateCdnRefreshTask(objectType, paths)
+}
+
+func (self *SCdnDomain) Prefetch(urls []string) error {
+	return self.client.CreateCdnPreheatingTask(urls)
+}
+
+func (self *SHuaweiClient) GetCdnDomains(domainName string) ([]SCdnDomain, error) {
+	client, err := self.newGeneralAPIClient()
+	if err != nil {
+		return nil, errors.Wrap(err, "newGeneralAPIClient")
+	}
+	pageSize := 1000
+	queries := map[string]string{
+		"page_size":             fmt.Sprintf("%d", pageSize),
+		"enterprise_project_id": "ALL",
+	}
+	if len(domainName) > 0 {
+		queries["domain_name"] = domainName
+	}
+	domains := []SCdnDomain{}
+	for pageNumber := 1; ; pageNumber++ {
+		queries["page_number"] = fmt.Sprintf("%d", pageNumber)
+		part := []SCdnDomain{}
+		err = doListAll(client.CdnDomains.List, queries, &part)
+		if err != nil {
+			return nil, errors.Wrap(err, "CdnDomains.List")
+		}
+		domains = append(domains, part...)
+		if len(part) < pageSize {
+			break
+		}
+	}
+	for i := range domains {
+		domains[i].client = self
+	}
+	return domains, nil
+}
+
+func (self *SHuaweiClient) GetCdnDomain(domainName string) (*SCdnDomain, error) {
+	domains, err := self.GetCdnDomains(domainName)
+	if err != nil {
+		return nil, err
+	}
+	for i := range domains {
+		if domains[i].DomainName == domainName {
+			return &domains[i], nil
+		}
+	}
+	return nil, errors.Wrap(cloudprovider.ErrNotFound, domainName)
+}
+
+func (self *SHuaweiClient) GetICloudCDNDomains() ([]cloudprovider.ICloudCDNDomain, error) {
+	domains, err := self.GetCdnDomains("")
+	if err != nil {
+		return nil, errors.Wrap(err, "GetCdnDomains")
+	}
+	ret := []cloudprovider.ICloudCDNDomain{}
+	for i := range domains {
+		ret = append(ret, &domains[i])
+	}
+	return ret, nil
+}
+
+func (self *SHuaweiClient) GetICloudCDNDomainByName(name string) (cloudprovider.ICloudCDNDomain, error) {
+	return self.GetCdnDomain(name)
+}
+
+func (self *SHuaweiClient) CreateICloudCDNDomain(opts *cloudprovider.CdnCreateOptions) (cloudprovider.ICloudCDNDomain, error) {
+	client, err := self.newGeneralAPIClient()
+	if err != nil {
+		return nil, errors.Wrap(err, "newGeneralAPIClient")
+	}
+	businessType := "web"
+	switch opts.ServiceType {
+	case api.CDN_SERVICE_TYPE_DOWNLOAD:
+		businessType = "download"
+	case api.CDN_SERVICE_TYPE_MEDIA:
+		businessType = "video"
+	}
+	serviceArea := "mainland_china"
+	switch opts.Area {
+	case api.CDN_DOMAIN_AREA_OVERSEAS:
+		serviceArea = "outside_mainland_china"
+	case api.CDN_DOMAIN_AREA_GLOBAL:
+		serviceArea = "global"
+	}
+	sources := []map[string]interface{}{}
+	for i, origin := range opts.Origins {
+		originType := "domain"
+		switch origin.Type {
+		case api.CDN_DOMAIN_ORIGIN_TYPE_IP:
+			originType = "ipaddr"
+		case api.CDN_DOMAIN_ORIGIN_TYPE_BUCKET:
+			originType = "obs_bucket"
+		}
+		activeStandby := 0
+		if i == 0 {
+			activeStandby = 1
+		}
+		sources = append(sources, map[string]interface{}{
+			"ip_or_domain":   origin.Origin,
+			"origin_type":    originType,
+			"active_standby": activeStandby,
+		})
+	}
+	params := map[string]interface{}{
+		"domain": map[string]interface{}{
+			"domain_name":   opts.Domain,
+			"business_type": businessType,
+			"service_area":  serviceArea,
+			"sources":       sources,
+		},
+	}
+	_, err = client.CdnDomains.Create(jsonutils.Marshal(params))
+	if err != nil {
+		return nil, errors.Wrap(err, "CdnDomains.Create")
+	}
+	return self.GetCdnDomain(opts.Domain)
+}
+
+func (self *SHuaweiClient) DisableCdnDomain(id string) error {
+	client, err := self.newGeneralAPIClient()
+	if err != nil {
+		return errors.Wrap(err, "newGeneralAPIClient")
+	}
+	return client.CdnDomains.Disable(id)
+}
+
+func (self *SHuaweiClient) DeleteCdnDomain(id string) error {
+	client, err := self.newGeneralAPIClient()
+	if err != nil {
+		return errors.Wrap(err, "newGeneralAPIClient")
+	}
+	return DoDelete(client.CdnDomains.Delete, id, nil, nil)
+}
+
+func (self *SHuaweiClient) CreateCdnRefreshTask(objectType string, urls []string) error {
+	client, err := self.newGeneralAPIClient()
+	if err != nil {
+		return errors.Wrap(err, "newGeneralAPIClient")
+	}
+	if objectType != api.CDN_PURGE_TYPE_DIRECTORY {
+		objectType = api.CDN_PURGE_TYPE_FILE
+	}
+	params := map[string]interface{}{
+		"refreshTask": map[string]interface{}{
+			"type": objectType,
+			"urls": urls,
+		},
+	}
+	_, err = client.CdnRefreshTasks.Create(jsonutils.Marshal(params))
+	if err != nil {
+		return errors.Wrap(err, "CdnRefreshTasks.Create")
+	}
+	return nil
+}
+
+func (self *SHuaweiClient) CreateCdnPreheatingTask(urls []string) error {
+	client, err := self.newGeneralAPIClient()
+	if err != nil {
+		return errors.Wrap(err, "newGeneralAPIClient")
+	}
+	params := map[string]interface{}{
+		"preheatingTask": map[string]interface{}{
+			"urls": urls,
+		},
+	}
+	_, err = client.CdnPreheatingTasks.Create(jsonutils.Marshal(params))
+	if err != nil {
+		return errors.Wrap(err, "CdnPreheatingTasks.Create")
+	}
+	return nil
+}
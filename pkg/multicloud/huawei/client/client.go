@@ -90,6 +90,9 @@ type Client struct {
 	SAMLProviders        *modules.SAMLProviderManager
 	SAMLProviderMappings *modules.SAMLProviderMappingManager
 	SfsTurbos            *modules.SfsTurboManager
+	CdnDomains           *modules.SCdnDomainManager
+	CdnRefreshTasks      *modules.SCdnTaskManager
+	CdnPreheatingTasks   *modules.SCdnTaskManager
 }
 
 func (self *Client) SetHttpClient(httpClient *http.Client) {
@@ -148,6 +151,9 @@ func (self *Client) SetHttpClient(httpClient *http.Client) {
 	self.SAMLProviders.SetHttpClient(httpClient)
 	self.SAMLProviderMappings.SetHttpClient(httpClient)
 	self.SfsTurbos.SetHttpClient(httpClient)
+	self.CdnDomains.SetHttpClient(httpClient)
+	self.CdnRefreshTasks.SetHttpClient(httpClient)
+	self.CdnPreheatingTasks.SetHttpClient(httpClient)
 }
 
 func (self *Client) InitWithOptions(regionId, domainId, projectId string, credential auth.Credential) error {
@@ -240,6 +246,9 @@ func (self *Client) initManagers() {
 		self.SAMLProviderMappings = modules.NewSAMLProviderMappingManager(self.signer, self.debug)
 		self.SAMLProviderMappings.SetDomainId(self.domainId)
 		self.SfsTurbos = modules.NewSfsTurboManager(self.regionId, self.projectId, self.signer, self.debug)
+		self.CdnDomains = modules.NewCdnDomainManager(self.signer, self.debug)
+		self.CdnRefreshTasks = modules.NewCdnTaskManager("refreshtasks", self.signer, self.debug)
+		self.CdnPreheatingTasks = modules.NewCdnTaskManager("preheatingtasks", self.signer, self.debug)
 	}
 
 	self.init = true
@@ -51,6 +51,7 @@ const (
 	ServiceNameCTS  ServiceNameType = "cts"  // 云审计服务
 	ServiceNameCES  ServiceNameType = "ces"  // 监控服务 CloudEye
 	ServiceNameEPS  ServiceNameType = "eps"  // 企业项目
+	ServiceNameCDN  ServiceNameType = "cdn"  // 内容分发网络
 
 	ServiceNameSFSTurbo ServiceNameType = "sfs-turbo" // 文件系统
 )
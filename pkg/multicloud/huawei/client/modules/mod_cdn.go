@@ -0,0 +1,74 @@
+// Copyright 2019 Yunion
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package modules
+
+import (
+	"yunion.io/x/jsonutils"
+
+	"yunion.io/x/onecloud/pkg/multicloud/huawei/client/auth"
+	"yunion.io/x/onecloud/pkg/multicloud/huawei/client/responses"
+)
+
+// https://support.huaweicloud.com/api-cdn/cdn_02_0082.html
+// CDN为全局服务, Endpoint为 cdn.myhuaweicloud.com
+type SCdnDomainManager struct {
+	SResourceManager
+}
+
+func NewCdnDomainManager(signer auth.Signer, debug bool) *SCdnDomainManager {
+	return &SCdnDomainManager{SResourceManager: SResourceManager{
+		SBaseManager:  NewBaseManager(signer, debug),
+		ServiceName:   ServiceNameCDN,
+		Region:        "",
+		ProjectId:     "",
+		version:       "v1.0",
+		Keyword:       "domain",
+		KeywordPlural: "domains",
+
+		ResourceKeyword: "cdn/domains",
+	}}
+}
+
+type SCdnTaskManager struct {
+	SResourceManager
+}
+
+// 刷新任务 cdn/refreshtasks, 预热任务 cdn/preheatingtasks
+func NewCdnTaskManager(resource string, signer auth.Signer, debug bool) *SCdnTaskManager {
+	return &SCdnTaskManager{SResourceManager: SResourceManager{
+		SBaseManager:  NewBaseManager(signer, debug),
+		ServiceName:   ServiceNameCDN,
+		Region:        "",
+		ProjectId:     "",
+		version:       "v1.0",
+		Keyword:       "",
+		KeywordPlural: "tasks",
+
+		ResourceKeyword: "cdn/" + resource,
+	}}
+}
+
+func (self *SCdnDomainManager) List(querys map[string]string) (*responses.ListResult, error) {
+	return self.ListInContextWithSpec(nil, "", querys, self.KeywordPlural)
+}
+
+func (self *SCdnDomainManager) Disable(id string) error {
+	_, err := self.UpdateInContextWithSpec(nil, id, "disable", nil, "")
+	return err
+}
+
+func (self *SCdnTaskManager) Create(params jsonutils.JSONObject) (jsonutils.JSONObject, error) {
+	return self.CreateInContextWithSpec(nil, "", params, "")
+}
@@ -507,11 +507,12 @@ func (self *SHuaweiClient) GetCapabilities() []string {
 		cloudprovider.CLOUD_CAPABILITY_NAT,
 		cloudprovider.CLOUD_CAPABILITY_NAS,
 	}
-	// huawei objectstore is shared across projects(subscriptions)
+	// huawei objectstore and cdn are shared across projects(subscriptions)
 	// to avoid multiple project access the same bucket
-	// only main project is allow to access objectstore bucket
+	// only main project is allow to access objectstore bucket and cdn domains
 	if self.isMainProject {
 		caps = append(caps, cloudprovider.CLOUD_CAPABILITY_OBJECTSTORE)
+		caps = append(caps, cloudprovider.CLOUD_CAPABILITY_CDN)
 	}
 	return caps
 }
@@ -289,6 +289,18 @@ func (self *SHuaweiProvider) GetIClouduserByName(name string) (cloudprovider.ICl
 	return self.client.GetIClouduserByName(name)
 }
 
+func (self *SHuaweiProvider) GetICloudCDNDomains() ([]cloudprovider.ICloudCDNDomain, error) {
+	return self.client.GetICloudCDNDomains()
+}
+
+func (self *SHuaweiProvider) GetICloudCDNDomainByName(name string) (cloudprovider.ICloudCDNDomain, error) {
+	return self.client.GetICloudCDNDomainByName(name)
+}
+
+func (self *SHuaweiProvider) CreateICloudCDNDomain(opts *cloudprovider.CdnCreateOptions) (cloudprovider.ICloudCDNDomain, error) {
+	return self.client.CreateICloudCDNDomain(opts)
+}
+
 func (self *SHuaweiProvider) GetSamlEntityId() string {
 	return cloudprovider.SAML_ENTITY_ID_HUAWEI_CLOUD
 }
@@ -0,0 +1,42 @@
+// Copyright 2019 Yunion
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package shell
+
+import (
+	"yunion.io/x/onecloud/pkg/multicloud/huawei"
+	"yunion.io/x/onecloud/pkg/util/shellutils"
+)
+
+func init() {
+	type CdnDomainListOptions struct {
+		Domain string
+	}
+	shellutils.R(&CdnDomainListOptions{}, "cdn-domain-list", "List cdn domains", func(cli *huawei.SRegion, args *CdnDomainListOptions) error {
+		domains, err := cli.GetClient().GetCdnDomains(args.Domain)
+		if err != nil {
+			return err
+		}
+		printList(domains, 0, 0, 0, nil)
+		return nil
+	})
+
+	type CdnDomainPurgeOptions struct {
+		Type string   `choices:"file|directory" default:"file"`
+		URL  []string `help:"full urls of cached objects"`
+	}
+	shellutils.R(&CdnDomainPurgeOptions{}, "cdn-domain-purge", "Purge cdn cache", func(cli *huawei.SRegion, args *CdnDomainPurgeOptions) error {
+		return cli.GetClient().CreateCdnRefreshTask(args.Type, args.URL)
+	})
+}
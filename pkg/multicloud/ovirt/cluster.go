@@ -0,0 +1,40 @@
+// Copyright 2019 Yunion
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ovirt
+
+// SCluster groups hosts of a data center, it is not exposed as a resource
+// but is needed to find the data center of hosts, vms and templates
+type SCluster struct {
+	SOvirtBase
+	DataCenter SLink `json:"data_center"`
+}
+
+func (region *SRegion) GetClusters() ([]SCluster, error) {
+	clusters := []SCluster{}
+	return clusters, region.client.list("clusters", nil, "cluster", &clusters)
+}
+
+// getClusterZoneIds maps cluster ids to data center ids
+func (region *SRegion) getClusterZoneIds() (map[string]string, error) {
+	clusters, err := region.GetClusters()
+	if err != nil {
+		return nil, err
+	}
+	ret := map[string]string{}
+	for _, cluster := range clusters {
+		ret[cluster.Id] = cluster.DataCenter.Id
+	}
+	return ret, nil
+}
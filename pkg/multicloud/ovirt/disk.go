@@ -0,0 +1,342 @@
+// Copyright 2019 Yunion
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ovirt
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"time"
+
+	"yunion.io/x/jsonutils"
+	"yunion.io/x/pkg/errors"
+
+	api "yunion.io/x/onecloud/pkg/apis/compute"
+	"yunion.io/x/onecloud/pkg/cloudprovider"
+	"yunion.io/x/onecloud/pkg/multicloud"
+)
+
+type SDisk struct {
+	multicloud.SDisk
+	multicloud.OvirtTags
+
+	region     *SRegion
+	attachment *SDiskAttachment
+
+	SOvirtBase
+	Alias           string `json:"alias"`
+	ProvisionedSize int64  `json:"provisioned_size"`
+	ActualSize      int64  `json:"actual_size"`
+	Format          string `json:"format"`
+	Sparse          bool   `json:"sparse"`
+	Shareable       bool   `json:"shareable"`
+	Status          string `json:"status"`
+	StorageType     string `json:"storage_type"`
+	ContentType     string `json:"content_type"`
+	StorageDomains  struct {
+		StorageDomain []SLink `json:"storage_domain"`
+	} `json:"storage_domains"`
+}
+
+func (region *SRegion) GetDisk(diskId string) (*SDisk, error) {
+	disk := &SDisk{region: region}
+	err := region.client.get("disks/"+diskId, nil, disk)
+	if err != nil {
+		return nil, err
+	}
+	return disk, nil
+}
+
+// getDiskAttachments returns disk attachments of all vms indexed by disk id,
+// oVirt does not tell which vm a disk belongs to from the disk itself
+func (region *SRegion) getDiskAttachments() (map[string]SDiskAttachment, error) {
+	instances := []SInstance{}
+	params := url.Values{}
+	params.Set("follow", "disk_attachments")
+	err := region.client.list("vms", params, "vm", &instances)
+	if err != nil {
+		return nil, errors.Wrap(err, "list vms")
+	}
+	ret := map[string]SDiskAttachment{}
+	for i := range instances {
+		for _, attachment := range instances[i].DiskAttachments.DiskAttachment {
+			attachment.Vm = SLink{Id: instances[i].Id}
+			ret[attachment.Disk.Id] = attachment
+		}
+	}
+	return ret, nil
+}
+
+func (region *SRegion) getDiskWithAttachment(diskId string) (*SDisk, error) {
+	disk, err := region.GetDisk(diskId)
+	if err != nil {
+		return nil, err
+	}
+	attachments, err := region.getDiskAttachments()
+	if err != nil {
+		return nil, err
+	}
+	if attachment, ok := attachments[diskId]; ok {
+		disk.attachment = &attachment
+	}
+	return disk, nil
+}
+
+func newDiskParams(name, desc, storageId string, sizeGb int) map[string]interface{} {
+	return map[string]interface{}{
+		"name":             name,
+		"alias":            name,
+		"description":      desc,
+		"format":           "cow",
+		"sparse":           true,
+		"provisioned_size": int64(sizeGb) * 1024 * 1024 * 1024,
+		"storage_domains": map[string]interface{}{
+			"storage_domain": []map[string]string{{"id": storageId}},
+		},
+	}
+}
+
+func (region *SRegion) CreateDisk(storageId string, conf *cloudprovider.DiskCreateConfig) (*SDisk, error) {
+	params := map[string]interface{}{
+		"disk": newDiskParams(conf.Name, conf.Desc, storageId, conf.SizeGb),
+	}
+	resp, err := region.client.post("disks", nil, jsonutils.Marshal(params))
+	if err != nil {
+		return nil, errors.Wrap(err, "create disk")
+	}
+	disk := &SDisk{region: region}
+	err = resp.Unmarshal(disk)
+	if err != nil {
+		return nil, errors.Wrap(err, "Unmarshal")
+	}
+	return disk, nil
+}
+
+// ResizeDisk extends a disk to sizeByte, disks attached to a vm are
+// extended through the attachment so that the guest gets notified
+func (region *SRegion) ResizeDisk(instanceId, diskId string, sizeByte int64) error {
+	params := map[string]interface{}{
+		"provisioned_size": sizeByte,
+	}
+	if len(instanceId) > 0 {
+		params = map[string]interface{}{"disk": params}
+		_, err := region.client.put(fmt.Sprintf("vms/%s/diskattachments/%s", instanceId, diskId), jsonutils.Marshal(params))
+		return err
+	}
+	_, err := region.client.put("disks/"+diskId, jsonutils.Marshal(params))
+	return err
+}
+
+func (disk *SDisk) getInstanceId() string {
+	if disk.attachment != nil {
+		return disk.attachment.Vm.Id
+	}
+	return ""
+}
+
+func (disk *SDisk) GetId() string {
+	return disk.Id
+}
+
+func (disk *SDisk) GetName() string {
+	if len(disk.Alias) > 0 {
+		return disk.Alias
+	}
+	return disk.Name
+}
+
+func (disk *SDisk) GetGlobalId() string {
+	return disk.Id
+}
+
+func (disk *SDisk) IsEmulated() bool {
+	return false
+}
+
+func (disk *SDisk) GetStatus() string {
+	switch disk.Status {
+	case "ok":
+		return api.DISK_READY
+	case "locked":
+		return api.DISK_ALLOCATING
+	case "illegal":
+		return api.DISK_ALLOC_FAILED
+	default:
+		return api.DISK_UNKNOWN
+	}
+}
+
+func (disk *SDisk) Refresh() error {
+	new, err := disk.region.GetDisk(disk.Id)
+	if err != nil {
+		return err
+	}
+	return jsonutils.Update(disk, new)
+}
+
+func (disk *SDisk) GetIStorage() (cloudprovider.ICloudStorage, error) {
+	storageId := disk.GetIStorageId()
+	if len(storageId) == 0 {
+		return nil, errors.Wrapf(cloudprovider.ErrNotFound, "storage of disk %s", disk.Id)
+	}
+	return disk.region.GetStorage(storageId)
+}
+
+func (disk *SDisk) GetIStorageId() string {
+	if len(disk.StorageDomains.StorageDomain) > 0 {
+		return disk.StorageDomains.StorageDomain[0].Id
+	}
+	return ""
+}
+
+func (disk *SDisk) GetDiskFormat() string {
+	if disk.Format == "cow" {
+		return "qcow2"
+	}
+	return "raw"
+}
+
+func (disk *SDisk) GetDiskSizeMB() int {
+	return int(disk.ProvisionedSize / 1024 / 1024)
+}
+
+func (disk *SDisk) GetIsAutoDelete() bool {
+	return disk.GetDiskType() == api.DISK_TYPE_SYS
+}
+
+func (disk *SDisk) GetTemplateId() string {
+	return ""
+}
+
+func (disk *SDisk) GetDiskType() string {
+	if disk.attachment != nil && disk.attachment.Bootable {
+		return api.DISK_TYPE_SYS
+	}
+	return api.DISK_TYPE_DATA
+}
+
+func (disk *SDisk) GetFsFormat() string {
+	return ""
+}
+
+func (disk *SDisk) GetIsNonPersistent() bool {
+	return false
+}
+
+func (disk *SDisk) GetDriver() string {
+	if disk.attachment == nil {
+		return "scsi"
+	}
+	switch disk.attachment.Interface {
+	case "virtio":
+		return "virtio"
+	case "ide":
+		return "ide"
+	case "sata":
+		return "sata"
+	default:
+		return "scsi"
+	}
+}
+
+func (disk *SDisk) GetCacheMode() string {
+	return "none"
+}
+
+func (disk *SDisk) GetMountpoint() string {
+	if disk.attachment != nil {
+		return disk.attachment.LogicalName
+	}
+	return ""
+}
+
+func (disk *SDisk) GetAccessPath() string {
+	return ""
+}
+
+func (disk *SDisk) GetBillingType() string {
+	return ""
+}
+
+func (disk *SDisk) GetCreatedAt() time.Time {
+	return time.Time{}
+}
+
+func (disk *SDisk) GetExpiredAt() time.Time {
+	return time.Time{}
+}
+
+func (disk *SDisk) GetProjectId() string {
+	return ""
+}
+
+func (disk *SDisk) Delete(ctx context.Context) error {
+	err := disk.region.client.delete("disks/"+disk.Id, nil)
+	if err != nil {
+		if errors.Cause(err) == cloudprovider.ErrNotFound {
+			return nil
+		}
+		return err
+	}
+	return cloudprovider.WaitDeleted(disk, 5*time.Second, 5*time.Minute)
+}
+
+func (disk *SDisk) Resize(ctx context.Context, sizeMb int64) error {
+	err := disk.region.ResizeDisk(disk.getInstanceId(), disk.Id, sizeMb*1024*1024)
+	if err != nil {
+		return errors.Wrap(err, "ResizeDisk")
+	}
+	return cloudprovider.WaitStatus(disk, api.DISK_READY, 5*time.Second, 10*time.Minute)
+}
+
+func (disk *SDisk) CreateISnapshot(ctx context.Context, name, desc string) (cloudprovider.ICloudSnapshot, error) {
+	instanceId := disk.getInstanceId()
+	if len(instanceId) == 0 {
+		return nil, errors.Wrap(cloudprovider.ErrNotSupported, "snapshot of disk not attached to any vm")
+	}
+	return disk.region.CreateSnapshot(instanceId, disk.Id, name, desc)
+}
+
+func (disk *SDisk) GetISnapshots() ([]cloudprovider.ICloudSnapshot, error) {
+	isnapshots := []cloudprovider.ICloudSnapshot{}
+	instanceId := disk.getInstanceId()
+	if len(instanceId) == 0 {
+		return isnapshots, nil
+	}
+	snapshots, err := disk.region.GetSnapshots(instanceId, disk.Id)
+	if err != nil {
+		return nil, err
+	}
+	for i := 0; i < len(snapshots); i++ {
+		isnapshots = append(isnapshots, &snapshots[i])
+	}
+	return isnapshots, nil
+}
+
+func (disk *SDisk) Reset(ctx context.Context, snapshotId string) (string, error) {
+	snapshot, err := disk.region.GetSnapshot(snapshotId)
+	if err != nil {
+		return "", errors.Wrapf(err, "GetSnapshot %s", snapshotId)
+	}
+	err = snapshot.restore()
+	if err != nil {
+		return "", err
+	}
+	return disk.Id, nil
+}
+
+func (disk *SDisk) Rebuild(ctx context.Context) error {
+	return cloudprovider.ErrNotSupported
+}
@@ -0,0 +1,348 @@
+// Copyright 2019 Yunion
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ovirt
+
+import (
+	"fmt"
+	"net"
+
+	"yunion.io/x/jsonutils"
+	"yunion.io/x/log"
+	"yunion.io/x/pkg/errors"
+
+	api "yunion.io/x/onecloud/pkg/apis/compute"
+	"yunion.io/x/onecloud/pkg/cloudprovider"
+	"yunion.io/x/onecloud/pkg/multicloud"
+)
+
+type SCpuTopology struct {
+	Cores   int `json:"cores"`
+	Sockets int `json:"sockets"`
+	Threads int `json:"threads"`
+}
+
+func (t SCpuTopology) count() int {
+	count := 1
+	for _, n := range []int{t.Cores, t.Sockets, t.Threads} {
+		if n > 0 {
+			count *= n
+		}
+	}
+	return count
+}
+
+type SHostNic struct {
+	SOvirtBase
+	Mac struct {
+		Address string `json:"address"`
+	} `json:"mac"`
+	Ip struct {
+		Address string `json:"address"`
+		Netmask string `json:"netmask"`
+		Gateway string `json:"gateway"`
+	} `json:"ip"`
+}
+
+type SHost struct {
+	multicloud.SHostBase
+	multicloud.OvirtTags
+	zone *SZone
+
+	SOvirtBase
+	Address string `json:"address"`
+	Status  string `json:"status"`
+	Type    string `json:"type"`
+	Memory  int64  `json:"memory"`
+	Cpu     struct {
+		Name     string       `json:"name"`
+		Speed    int          `json:"speed"`
+		Topology SCpuTopology `json:"topology"`
+	} `json:"cpu"`
+	HardwareInformation struct {
+		Manufacturer string `json:"manufacturer"`
+		ProductName  string `json:"product_name"`
+		SerialNumber string `json:"serial_number"`
+		Uuid         string `json:"uuid"`
+	} `json:"hardware_information"`
+	Version struct {
+		FullVersion string `json:"full_version"`
+	} `json:"version"`
+	Cluster SLink `json:"cluster"`
+}
+
+// GetHosts returns hosts of a data center, or all hosts if zoneId is empty
+func (region *SRegion) GetHosts(zoneId string) ([]SHost, error) {
+	hosts := []SHost{}
+	err := region.client.list("hosts", nil, "host", &hosts)
+	if err != nil {
+		return nil, errors.Wrap(err, "list hosts")
+	}
+	clusterZones, err := region.getClusterZoneIds()
+	if err != nil {
+		return nil, errors.Wrap(err, "getClusterZoneIds")
+	}
+	zones, err := region.GetZones()
+	if err != nil {
+		return nil, errors.Wrap(err, "GetZones")
+	}
+	ret := []SHost{}
+	for i := range hosts {
+		for j := range zones {
+			if zones[j].Id == clusterZones[hosts[i].Cluster.Id] {
+				hosts[i].zone = &zones[j]
+				break
+			}
+		}
+		if hosts[i].zone == nil {
+			log.Warningf("no data center found for host %s of cluster %s", hosts[i].Name, hosts[i].Cluster.Id)
+			continue
+		}
+		if len(zoneId) == 0 || hosts[i].zone.Id == zoneId {
+			ret = append(ret, hosts[i])
+		}
+	}
+	return ret, nil
+}
+
+func (region *SRegion) GetHost(hostId string) (*SHost, error) {
+	host := &SHost{}
+	err := region.client.get("hosts/"+hostId, nil, host)
+	if err != nil {
+		return nil, err
+	}
+	clusterZones, err := region.getClusterZoneIds()
+	if err != nil {
+		return nil, errors.Wrap(err, "getClusterZoneIds")
+	}
+	host.zone, err = region.GetZone(clusterZones[host.Cluster.Id])
+	if err != nil {
+		return nil, errors.Wrapf(err, "GetZone for host %s", host.Name)
+	}
+	return host, nil
+}
+
+func (host *SHost) GetHostNics() ([]SHostNic, error) {
+	nics := []SHostNic{}
+	return nics, host.zone.region.client.list(fmt.Sprintf("hosts/%s/nics", host.Id), nil, "host_nic", &nics)
+}
+
+func (host *SHost) GetId() string {
+	return host.Id
+}
+
+func (host *SHost) GetName() string {
+	return host.Name
+}
+
+func (host *SHost) GetGlobalId() string {
+	return host.Id
+}
+
+func (host *SHost) IsEmulated() bool {
+	return false
+}
+
+func (host *SHost) GetStatus() string {
+	if host.Status == "up" {
+		return api.HOST_STATUS_RUNNING
+	}
+	return api.HOST_STATUS_UNKNOWN
+}
+
+func (host *SHost) Refresh() error {
+	new, err := host.zone.region.GetHost(host.Id)
+	if err != nil {
+		return err
+	}
+	return jsonutils.Update(host, new)
+}
+
+func (host *SHost) GetIWires() ([]cloudprovider.ICloudWire, error) {
+	wires, err := host.zone.region.GetWires(host.zone.Id, host.Cluster.Id)
+	if err != nil {
+		return nil, err
+	}
+	iwires := []cloudprovider.ICloudWire{}
+	for i := 0; i < len(wires); i++ {
+		iwires = append(iwires, &wires[i])
+	}
+	return iwires, nil
+}
+
+// GetIStorages returns storage domains of the data center, they are shared
+// by all hosts of it
+func (host *SHost) GetIStorages() ([]cloudprovider.ICloudStorage, error) {
+	return host.zone.GetIStorages()
+}
+
+func (host *SHost) GetIStorageById(id string) (cloudprovider.ICloudStorage, error) {
+	return host.zone.GetIStorageById(id)
+}
+
+func (host *SHost) GetIVMs() ([]cloudprovider.ICloudVM, error) {
+	instances, err := host.zone.region.GetInstances(host.Id)
+	if err != nil {
+		return nil, err
+	}
+	ivms := []cloudprovider.ICloudVM{}
+	for i := 0; i < len(instances); i++ {
+		instances[i].host = host
+		ivms = append(ivms, &instances[i])
+	}
+	return ivms, nil
+}
+
+func (host *SHost) GetIVMById(id string) (cloudprovider.ICloudVM, error) {
+	instance, err := host.zone.region.GetInstance(id)
+	if err != nil {
+		return nil, err
+	}
+	if instance.host.Id != host.Id {
+		return nil, cloudprovider.ErrNotFound
+	}
+	return instance, nil
+}
+
+func (host *SHost) GetEnabled() bool {
+	return host.Status != "maintenance"
+}
+
+func (host *SHost) GetHostStatus() string {
+	if host.Status == "up" {
+		return api.HOST_ONLINE
+	}
+	return api.HOST_OFFLINE
+}
+
+func (host *SHost) getAccessNic() *SHostNic {
+	nics, err := host.GetHostNics()
+	if err != nil {
+		log.Errorf("get nics of host %s: %v", host.Name, err)
+		return nil
+	}
+	addrs := []string{host.Address}
+	if net.ParseIP(host.Address) == nil {
+		if resolved, err := net.LookupHost(host.Address); err == nil {
+			addrs = resolved
+		}
+	}
+	for i := range nics {
+		for _, addr := range addrs {
+			if nics[i].Ip.Address == addr {
+				return &nics[i]
+			}
+		}
+	}
+	for i := range nics {
+		if len(nics[i].Ip.Address) > 0 {
+			return &nics[i]
+		}
+	}
+	return nil
+}
+
+func (host *SHost) GetAccessIp() string {
+	if net.ParseIP(host.Address) != nil {
+		return host.Address
+	}
+	if nic := host.getAccessNic(); nic != nil {
+		return nic.Ip.Address
+	}
+	return ""
+}
+
+func (host *SHost) GetAccessMac() string {
+	if nic := host.getAccessNic(); nic != nil {
+		return nic.Mac.Address
+	}
+	return ""
+}
+
+func (host *SHost) GetSysInfo() jsonutils.JSONObject {
+	info := jsonutils.NewDict()
+	info.Add(jsonutils.NewString(CLOUD_PROVIDER_OVIRT), "manufacture")
+	if len(host.HardwareInformation.Manufacturer) > 0 {
+		info.Add(jsonutils.NewString(host.HardwareInformation.Manufacturer), "manufacture")
+	}
+	if len(host.HardwareInformation.ProductName) > 0 {
+		info.Add(jsonutils.NewString(host.HardwareInformation.ProductName), "model")
+	}
+	return info
+}
+
+func (host *SHost) GetSN() string {
+	return host.HardwareInformation.SerialNumber
+}
+
+func (host *SHost) GetCpuCount() int {
+	return host.Cpu.Topology.count()
+}
+
+func (host *SHost) GetNodeCount() int8 {
+	return int8(host.Cpu.Topology.Sockets)
+}
+
+func (host *SHost) GetCpuDesc() string {
+	return host.Cpu.Name
+}
+
+func (host *SHost) GetCpuMhz() int {
+	return host.Cpu.Speed
+}
+
+func (host *SHost) GetMemSizeMB() int {
+	return int(host.Memory / 1024 / 1024)
+}
+
+func (host *SHost) GetStorageSizeMB() int {
+	storages, err := host.zone.region.GetStorages(host.zone.Id)
+	if err != nil {
+		log.Errorf("get storages of host %s: %v", host.Name, err)
+		return 0
+	}
+	size := int64(0)
+	for i := range storages {
+		size += storages[i].GetCapacityMB()
+	}
+	return int(size)
+}
+
+func (host *SHost) GetStorageType() string {
+	return api.DISK_TYPE_HYBRID
+}
+
+func (host *SHost) GetHostType() string {
+	return api.HOST_TYPE_OVIRT
+}
+
+func (host *SHost) GetIsMaintenance() bool {
+	return host.Status == "maintenance" || host.Status == "preparing_for_maintenance"
+}
+
+func (host *SHost) GetVersion() string {
+	return host.Version.FullVersion
+}
+
+func (host *SHost) GetIHostNics() ([]cloudprovider.ICloudHostNetInterface, error) {
+	return nil, cloudprovider.ErrNotSupported
+}
+
+func (host *SHost) CreateVM(desc *cloudprovider.SManagedVMCreateConfig) (cloudprovider.ICloudVM, error) {
+	instance, err := host.zone.region.CreateInstance(host, desc)
+	if err != nil {
+		return nil, errors.Wrap(err, "CreateInstance")
+	}
+	return instance, nil
+}
@@ -0,0 +1,262 @@
+// Copyright 2019 Yunion
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ovirt
+
+import (
+	"context"
+	"net/url"
+	"strings"
+	"time"
+
+	"yunion.io/x/jsonutils"
+	"yunion.io/x/log"
+	"yunion.io/x/pkg/errors"
+
+	api "yunion.io/x/onecloud/pkg/apis/compute"
+	"yunion.io/x/onecloud/pkg/cloudprovider"
+	"yunion.io/x/onecloud/pkg/multicloud"
+	"yunion.io/x/onecloud/pkg/util/imagetools"
+)
+
+const (
+	// 每个 oVirt 环境都有的空白模板, 不含磁盘, 不能用于创建虚拟机
+	OVIRT_BLANK_TEMPLATE_ID = "00000000-0000-0000-0000-000000000000"
+)
+
+// STemplate 将 oVirt 模板作为镜像同步, 虚拟机均由模板克隆创建
+type STemplate struct {
+	multicloud.SImageBase
+	multicloud.OvirtTags
+	region       *SRegion
+	storageCache *SStoragecache
+
+	// normalized image info
+	imgInfo  *imagetools.ImageInfo
+	bootDisk *SDisk
+
+	SOvirtBase
+	Status       string `json:"status"`
+	Type         string `json:"type"`
+	Memory       int64  `json:"memory"`
+	CreationTime int64  `json:"creation_time"`
+	Cluster      SLink  `json:"cluster"`
+	Os           struct {
+		Type string `json:"type"`
+	} `json:"os"`
+	Bios struct {
+		Type string `json:"type"`
+	} `json:"bios"`
+	Cpu struct {
+		Architecture string `json:"architecture"`
+	} `json:"cpu"`
+	DiskAttachments struct {
+		DiskAttachment []SDiskAttachment `json:"disk_attachment"`
+	} `json:"disk_attachments"`
+}
+
+// GetTemplates returns templates usable in the data center, or all templates
+// if zoneId is empty
+func (region *SRegion) GetTemplates(zoneId string) ([]STemplate, error) {
+	templates := []STemplate{}
+	params := url.Values{}
+	params.Set("follow", "disk_attachments")
+	err := region.client.list("templates", params, "template", &templates)
+	if err != nil {
+		return nil, errors.Wrap(err, "list templates")
+	}
+	clusterZoneIds, err := region.getClusterZoneIds()
+	if err != nil {
+		return nil, err
+	}
+	ret := []STemplate{}
+	for i := range templates {
+		if templates[i].Id == OVIRT_BLANK_TEMPLATE_ID {
+			continue
+		}
+		if len(zoneId) > 0 && clusterZoneIds[templates[i].Cluster.Id] != zoneId {
+			continue
+		}
+		templates[i].region = region
+		ret = append(ret, templates[i])
+	}
+	return ret, nil
+}
+
+func (region *SRegion) GetTemplate(templateId string) (*STemplate, error) {
+	template := &STemplate{region: region}
+	params := url.Values{}
+	params.Set("follow", "disk_attachments")
+	err := region.client.get("templates/"+templateId, params, template)
+	if err != nil {
+		return nil, err
+	}
+	return template, nil
+}
+
+// getTemplateDiskIds returns ids of disks belonging to templates, they are
+// stored on storage domains like the disks of vms
+func (region *SRegion) getTemplateDiskIds() (map[string]bool, error) {
+	templates, err := region.GetTemplates("")
+	if err != nil {
+		return nil, err
+	}
+	ret := map[string]bool{}
+	for i := range templates {
+		for _, attachment := range templates[i].DiskAttachments.DiskAttachment {
+			ret[attachment.Disk.Id] = true
+		}
+	}
+	return ret, nil
+}
+
+func (template *STemplate) getBootDisk() (*SDisk, error) {
+	if template.bootDisk != nil {
+		return template.bootDisk, nil
+	}
+	for i, attachment := range template.DiskAttachments.DiskAttachment {
+		if !attachment.Bootable {
+			continue
+		}
+		disk := &SDisk{region: template.region}
+		err := template.region.client.get("disks/"+attachment.Disk.Id, nil, disk)
+		if err != nil {
+			return nil, errors.Wrapf(err, "get disk %s", attachment.Disk.Id)
+		}
+		disk.attachment = &template.DiskAttachments.DiskAttachment[i]
+		template.bootDisk = disk
+		return disk, nil
+	}
+	return nil, errors.Wrapf(cloudprovider.ErrNotFound, "no bootable disk in template %s", template.Name)
+}
+
+func (template *STemplate) GetId() string {
+	return template.Id
+}
+
+func (template *STemplate) GetName() string {
+	return template.Name
+}
+
+func (template *STemplate) GetGlobalId() string {
+	return template.Id
+}
+
+func (template *STemplate) IsEmulated() bool {
+	return false
+}
+
+func (template *STemplate) Delete(ctx context.Context) error {
+	return cloudprovider.ErrNotSupported
+}
+
+func (template *STemplate) GetIStoragecache() cloudprovider.ICloudStoragecache {
+	return template.storageCache
+}
+
+func (template *STemplate) GetStatus() string {
+	switch template.Status {
+	case "ok":
+		return api.CACHED_IMAGE_STATUS_ACTIVE
+	case "locked":
+		return api.CACHED_IMAGE_STATUS_CACHING
+	default:
+		return api.CACHED_IMAGE_STATUS_CACHE_FAILED
+	}
+}
+
+func (template *STemplate) GetImageStatus() string {
+	switch template.Status {
+	case "ok":
+		return cloudprovider.IMAGE_STATUS_ACTIVE
+	case "locked":
+		return cloudprovider.IMAGE_STATUS_QUEUED
+	default:
+		return cloudprovider.IMAGE_STATUS_KILLED
+	}
+}
+
+func (template *STemplate) Refresh() error {
+	new, err := template.region.GetTemplate(template.Id)
+	if err != nil {
+		return err
+	}
+	template.bootDisk = nil
+	return jsonutils.Update(template, new)
+}
+
+func (template *STemplate) GetImageType() cloudprovider.TImageType {
+	return cloudprovider.ImageTypeSystem
+}
+
+func (template *STemplate) GetSizeByte() int64 {
+	disk, err := template.getBootDisk()
+	if err != nil {
+		log.Errorf("template %s: %v", template.Name, err)
+		return 0
+	}
+	return disk.ProvisionedSize
+}
+
+func (template *STemplate) getNormalizedImageInfo() *imagetools.ImageInfo {
+	if template.imgInfo == nil {
+		osType := ""
+		if strings.HasPrefix(strings.ToLower(template.Os.Type), "windows") {
+			osType = "windows"
+		}
+		imgInfo := imagetools.NormalizeImageInfo(template.Name, template.Cpu.Architecture, osType, template.Os.Type, "")
+		template.imgInfo = &imgInfo
+	}
+	return template.imgInfo
+}
+
+func (template *STemplate) GetOsType() string {
+	return template.getNormalizedImageInfo().OsType
+}
+
+func (template *STemplate) GetOsDist() string {
+	return template.getNormalizedImageInfo().OsDistro
+}
+
+func (template *STemplate) GetOsVersion() string {
+	return template.getNormalizedImageInfo().OsVersion
+}
+
+func (template *STemplate) GetOsArch() string {
+	return template.getNormalizedImageInfo().OsArch
+}
+
+func (template *STemplate) GetMinOsDiskSizeGb() int {
+	return int(template.GetSizeByte() / 1024 / 1024 / 1024)
+}
+
+func (template *STemplate) GetMinRamSizeMb() int {
+	return int(template.Memory / 1024 / 1024)
+}
+
+func (template *STemplate) GetImageFormat() string {
+	disk, err := template.getBootDisk()
+	if err != nil {
+		return "qcow2"
+	}
+	return disk.GetDiskFormat()
+}
+
+func (template *STemplate) GetCreatedAt() time.Time {
+	return msToTime(template.CreationTime)
+}
+
+func (template *STemplate) UEFI() bool {
+	return strings.Contains(template.Bios.Type, "ovmf")
+}
@@ -0,0 +1,770 @@
+// Copyright 2019 Yunion
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ovirt
+
+import (
+	"context"
+	"encoding/base64"
+	"fmt"
+	"net/url"
+	"sort"
+	"strings"
+	"time"
+
+	"yunion.io/x/jsonutils"
+	"yunion.io/x/log"
+	"yunion.io/x/pkg/errors"
+	"yunion.io/x/pkg/util/osprofile"
+
+	api "yunion.io/x/onecloud/pkg/apis/compute"
+	"yunion.io/x/onecloud/pkg/cloudprovider"
+	"yunion.io/x/onecloud/pkg/multicloud"
+	"yunion.io/x/onecloud/pkg/util/billing"
+)
+
+const (
+	// 同步虚拟机时一并获取磁盘挂载和网卡信息, 需要 oVirt 4.2 及以上版本
+	VM_FOLLOW = "disk_attachments,nics.reported_devices"
+)
+
+type SDiskAttachment struct {
+	Id          string `json:"id"`
+	Active      bool   `json:"active"`
+	Bootable    bool   `json:"bootable"`
+	Interface   string `json:"interface"`
+	LogicalName string `json:"logical_name"`
+	Disk        SLink  `json:"disk"`
+	Vm          SLink  `json:"vm"`
+}
+
+type SInstance struct {
+	multicloud.SInstanceBase
+	multicloud.OvirtTags
+	host *SHost
+
+	SOvirtBase
+	Status     string `json:"status"`
+	StopReason string `json:"stop_reason"`
+	Type       string `json:"type"`
+	Memory     int64  `json:"memory"`
+	Cpu        struct {
+		Architecture string       `json:"architecture"`
+		Topology     SCpuTopology `json:"topology"`
+	} `json:"cpu"`
+	Os struct {
+		Type string `json:"type"`
+		Boot struct {
+			Devices struct {
+				Device []string `json:"device"`
+			} `json:"devices"`
+		} `json:"boot"`
+	} `json:"os"`
+	Bios struct {
+		Type string `json:"type"`
+	} `json:"bios"`
+	Display struct {
+		Type string `json:"type"`
+	} `json:"display"`
+	GuestOperatingSystem struct {
+		Distribution string `json:"distribution"`
+		Family       string `json:"family"`
+		Version      struct {
+			FullVersion string `json:"full_version"`
+		} `json:"version"`
+	} `json:"guest_operating_system"`
+	Initialization struct {
+		HostName     string `json:"host_name"`
+		CustomScript string `json:"custom_script"`
+	} `json:"initialization"`
+	PlacementPolicy struct {
+		Hosts struct {
+			Host []SLink `json:"host"`
+		} `json:"hosts"`
+	} `json:"placement_policy"`
+	Host         SLink `json:"host"`
+	Cluster      SLink `json:"cluster"`
+	Template     SLink `json:"template"`
+	CreationTime int64 `json:"creation_time"`
+
+	DiskAttachments struct {
+		DiskAttachment []SDiskAttachment `json:"disk_attachment"`
+	} `json:"disk_attachments"`
+	Nics struct {
+		Nic []SInstanceNic `json:"nic"`
+	} `json:"nics"`
+}
+
+// getHostId returns the host a vm belongs to, vms which are not running are
+// not placed on any host, they are put on the first pinned host or the first
+// host of their cluster so that they stay on the same host between syncs
+func (instance *SInstance) getHostId(clusterHosts map[string][]string) string {
+	if len(instance.Host.Id) > 0 {
+		return instance.Host.Id
+	}
+	if len(instance.PlacementPolicy.Hosts.Host) > 0 {
+		return instance.PlacementPolicy.Hosts.Host[0].Id
+	}
+	if hostIds := clusterHosts[instance.Cluster.Id]; len(hostIds) > 0 {
+		return hostIds[0]
+	}
+	return ""
+}
+
+// placeInstances fills host of instances and drops the ones whose host is
+// unknown
+func (region *SRegion) placeInstances(instances []SInstance) ([]SInstance, error) {
+	hosts, err := region.GetHosts("")
+	if err != nil {
+		return nil, errors.Wrap(err, "GetHosts")
+	}
+	hostMap := map[string]*SHost{}
+	clusterHosts := map[string][]string{}
+	for i := range hosts {
+		hostMap[hosts[i].Id] = &hosts[i]
+		clusterHosts[hosts[i].Cluster.Id] = append(clusterHosts[hosts[i].Cluster.Id], hosts[i].Id)
+	}
+	for _, ids := range clusterHosts {
+		sort.Strings(ids)
+	}
+	ret := []SInstance{}
+	for i := range instances {
+		host, ok := hostMap[instances[i].getHostId(clusterHosts)]
+		if !ok {
+			log.Warningf("no host found for vm %s(%s)", instances[i].Name, instances[i].Id)
+			continue
+		}
+		instances[i].host = host
+		ret = append(ret, instances[i])
+	}
+	return ret, nil
+}
+
+// GetInstances returns vms of a host, or all vms if hostId is empty
+func (region *SRegion) GetInstances(hostId string) ([]SInstance, error) {
+	instances := []SInstance{}
+	params := url.Values{}
+	params.Set("follow", VM_FOLLOW)
+	err := region.client.list("vms", params, "vm", &instances)
+	if err != nil {
+		return nil, errors.Wrap(err, "list vms")
+	}
+	instances, err = region.placeInstances(instances)
+	if err != nil {
+		return nil, err
+	}
+	if len(hostId) == 0 {
+		return instances, nil
+	}
+	ret := []SInstance{}
+	for i := range instances {
+		if instances[i].host.Id == hostId {
+			ret = append(ret, instances[i])
+		}
+	}
+	return ret, nil
+}
+
+func (region *SRegion) GetInstance(instanceId string) (*SInstance, error) {
+	instance := SInstance{}
+	params := url.Values{}
+	params.Set("follow", VM_FOLLOW)
+	err := region.client.get("vms/"+instanceId, params, &instance)
+	if err != nil {
+		return nil, err
+	}
+	instances, err := region.placeInstances([]SInstance{instance})
+	if err != nil {
+		return nil, err
+	}
+	if len(instances) == 0 {
+		return nil, errors.Wrapf(cloudprovider.ErrNotFound, "host of vm %s", instanceId)
+	}
+	return &instances[0], nil
+}
+
+func (instance *SInstance) getResource() string {
+	return "vms/" + instance.Id
+}
+
+func (instance *SInstance) GetId() string {
+	return instance.Id
+}
+
+func (instance *SInstance) GetName() string {
+	return instance.Name
+}
+
+func (instance *SInstance) GetGlobalId() string {
+	return instance.Id
+}
+
+func (instance *SInstance) IsEmulated() bool {
+	return false
+}
+
+func (instance *SInstance) GetStatus() string {
+	switch instance.Status {
+	case "up":
+		return api.VM_RUNNING
+	case "down":
+		return api.VM_READY
+	case "powering_up", "wait_for_launch", "reboot_in_progress", "restoring_state":
+		return api.VM_STARTING
+	case "powering_down", "saving_state":
+		return api.VM_STOPPING
+	case "suspended", "paused":
+		return api.VM_SUSPEND
+	case "migrating":
+		return api.VM_MIGRATING
+	case "image_locked":
+		return api.VM_DEPLOYING
+	default:
+		return api.VM_UNKNOWN
+	}
+}
+
+func (instance *SInstance) Refresh() error {
+	new, err := instance.host.zone.region.GetInstance(instance.Id)
+	if err != nil {
+		return err
+	}
+	return jsonutils.Update(instance, new)
+}
+
+func (instance *SInstance) GetError() error {
+	return nil
+}
+
+func (instance *SInstance) GetHypervisor() string {
+	return api.HYPERVISOR_OVIRT
+}
+
+func (instance *SInstance) GetIHost() cloudprovider.ICloudHost {
+	return instance.host
+}
+
+func (instance *SInstance) GetIHostId() string {
+	return instance.host.Id
+}
+
+func (instance *SInstance) GetInstanceType() string {
+	return ""
+}
+
+func (instance *SInstance) GetCreatedAt() time.Time {
+	return msToTime(instance.CreationTime)
+}
+
+func (instance *SInstance) GetIDisks() ([]cloudprovider.ICloudDisk, error) {
+	attachments := instance.DiskAttachments.DiskAttachment
+	// 系统盘排在最前
+	sort.SliceStable(attachments, func(i, j int) bool {
+		return attachments[i].Bootable && !attachments[j].Bootable
+	})
+	idisks := []cloudprovider.ICloudDisk{}
+	for i := range attachments {
+		disk, err := instance.host.zone.region.GetDisk(attachments[i].Disk.Id)
+		if err != nil {
+			return nil, errors.Wrapf(err, "GetDisk %s", attachments[i].Disk.Id)
+		}
+		disk.attachment = &attachments[i]
+		idisks = append(idisks, disk)
+	}
+	return idisks, nil
+}
+
+func (instance *SInstance) GetINics() ([]cloudprovider.ICloudNic, error) {
+	inics := []cloudprovider.ICloudNic{}
+	for i := range instance.Nics.Nic {
+		instance.Nics.Nic[i].instance = instance
+		inics = append(inics, &instance.Nics.Nic[i])
+	}
+	return inics, nil
+}
+
+func (instance *SInstance) GetIEIP() (cloudprovider.ICloudEIP, error) {
+	return nil, nil
+}
+
+func (instance *SInstance) GetVcpuCount() int {
+	return instance.Cpu.Topology.count()
+}
+
+func (instance *SInstance) GetVmemSizeMB() int {
+	return int(instance.Memory / 1024 / 1024)
+}
+
+func (instance *SInstance) GetBootOrder() string {
+	order := ""
+	for _, dev := range instance.Os.Boot.Devices.Device {
+		switch dev {
+		case "hd":
+			order += "c"
+		case "cdrom":
+			order += "d"
+		case "network":
+			order += "n"
+		}
+	}
+	if len(order) == 0 {
+		return "cdn"
+	}
+	return order
+}
+
+func (instance *SInstance) GetVga() string {
+	if instance.Display.Type == "spice" {
+		return "qxl"
+	}
+	return "std"
+}
+
+func (instance *SInstance) GetVdi() string {
+	if instance.Display.Type == "spice" {
+		return "spice"
+	}
+	return "vnc"
+}
+
+func (instance *SInstance) GetOSType() string {
+	osType := strings.ToLower(instance.Os.Type)
+	if strings.HasPrefix(osType, "windows") || strings.ToLower(instance.GuestOperatingSystem.Family) == "windows" {
+		return osprofile.OS_TYPE_WINDOWS
+	}
+	return osprofile.OS_TYPE_LINUX
+}
+
+func (instance *SInstance) GetOSName() string {
+	if len(instance.GuestOperatingSystem.Distribution) > 0 {
+		return strings.TrimSpace(fmt.Sprintf("%s %s", instance.GuestOperatingSystem.Distribution, instance.GuestOperatingSystem.Version.FullVersion))
+	}
+	return instance.Os.Type
+}
+
+func (instance *SInstance) GetBios() string {
+	if strings.Contains(instance.Bios.Type, "ovmf") {
+		return "UEFI"
+	}
+	return "BIOS"
+}
+
+func (instance *SInstance) GetMachine() string {
+	if strings.HasPrefix(instance.Bios.Type, "q35") {
+		return "q35"
+	}
+	return "pc"
+}
+
+func (instance *SInstance) GetSecurityGroupIds() ([]string, error) {
+	return []string{}, nil
+}
+
+func (instance *SInstance) AssignSecurityGroup(secgroupId string) error {
+	return cloudprovider.ErrNotSupported
+}
+
+func (instance *SInstance) SetSecurityGroups(secgroupIds []string) error {
+	return cloudprovider.ErrNotSupported
+}
+
+func (instance *SInstance) isWindows() bool {
+	return instance.GetOSType() == osprofile.OS_TYPE_WINDOWS
+}
+
+func (instance *SInstance) StartVM(ctx context.Context) error {
+	if instance.Status == "up" {
+		return nil
+	}
+	body := jsonutils.NewDict()
+	// 通过 cloud-init/sysprep 注入初始化信息
+	if len(instance.Initialization.HostName) > 0 || len(instance.Initialization.CustomScript) > 0 {
+		if instance.isWindows() {
+			body.Set("use_sysprep", jsonutils.JSONTrue)
+		} else {
+			body.Set("use_cloud_init", jsonutils.JSONTrue)
+		}
+	}
+	err := instance.host.zone.region.client.action(instance.getResource(), "start", body)
+	if err != nil {
+		return errors.Wrap(err, "start")
+	}
+	return cloudprovider.WaitStatus(instance, api.VM_RUNNING, 5*time.Second, 5*time.Minute)
+}
+
+func (instance *SInstance) StopVM(ctx context.Context, opts *cloudprovider.ServerStopOptions) error {
+	if instance.Status == "down" {
+		return nil
+	}
+	action := "shutdown"
+	if opts.IsForce {
+		action = "stop"
+	}
+	err := instance.host.zone.region.client.action(instance.getResource(), action, nil)
+	if err != nil {
+		return errors.Wrap(err, action)
+	}
+	return cloudprovider.WaitStatus(instance, api.VM_READY, 5*time.Second, 5*time.Minute)
+}
+
+// DeleteVM removes the vm with its system disk, data disks are detached
+// before so that they are kept as the other platforms do
+func (instance *SInstance) DeleteVM(ctx context.Context) error {
+	for _, attachment := range instance.DiskAttachments.DiskAttachment {
+		if attachment.Bootable {
+			continue
+		}
+		err := instance.host.zone.region.DetachDisk(instance.Id, attachment.Disk.Id)
+		if err != nil {
+			return errors.Wrapf(err, "DetachDisk %s", attachment.Disk.Id)
+		}
+	}
+	err := instance.host.zone.region.client.delete(instance.getResource(), nil)
+	if err != nil {
+		return errors.Wrap(err, "delete")
+	}
+	return cloudprovider.WaitDeleted(instance, 5*time.Second, 5*time.Minute)
+}
+
+func (instance *SInstance) update(params map[string]interface{}) error {
+	_, err := instance.host.zone.region.client.put(instance.getResource(), jsonutils.Marshal(params))
+	return err
+}
+
+func (instance *SInstance) UpdateVM(ctx context.Context, name string) error {
+	return instance.update(map[string]interface{}{"name": name})
+}
+
+// UpdateUserData saves cloud-init custom script of the vm, it takes effect
+// on the next start
+func (instance *SInstance) UpdateUserData(userData string) error {
+	return instance.update(map[string]interface{}{
+		"initialization": map[string]string{
+			"custom_script": decodeUserData(userData),
+		},
+	})
+}
+
+// DeployVM saves login info into initialization of the vm, it is applied by
+// cloud-init or sysprep on the next start
+func (instance *SInstance) DeployVM(ctx context.Context, name string, username string, password string, publicKey string, deleteKeypair bool, description string) error {
+	initialization := map[string]interface{}{}
+	if len(name) > 0 {
+		initialization["host_name"] = name
+	}
+	if len(username) > 0 {
+		initialization["user_name"] = username
+	}
+	if len(password) > 0 {
+		initialization["root_password"] = password
+	}
+	if len(publicKey) > 0 {
+		initialization["authorized_ssh_keys"] = publicKey
+	} else if deleteKeypair {
+		initialization["authorized_ssh_keys"] = ""
+	}
+	params := map[string]interface{}{
+		"initialization": initialization,
+	}
+	if len(description) > 0 {
+		params["description"] = description
+	}
+	return instance.update(params)
+}
+
+func (instance *SInstance) RebuildRoot(ctx context.Context, config *cloudprovider.SManagedVMRebuildRootConfig) (string, error) {
+	return "", cloudprovider.ErrNotSupported
+}
+
+func (instance *SInstance) ChangeConfig(ctx context.Context, config *cloudprovider.SManagedVMChangeConfig) error {
+	params := map[string]interface{}{}
+	if config.Cpu > 0 && config.Cpu != instance.GetVcpuCount() {
+		params["cpu"] = map[string]interface{}{
+			"topology": SCpuTopology{Sockets: config.Cpu, Cores: 1, Threads: 1},
+		}
+	}
+	if config.MemoryMB > 0 && config.MemoryMB != instance.GetVmemSizeMB() {
+		memory := int64(config.MemoryMB) * 1024 * 1024
+		params["memory"] = memory
+		// guaranteed 不能大于内存, max 不能小于内存, 否则修改内存时会失败
+		params["memory_policy"] = map[string]int64{
+			"guaranteed": memory,
+			"max":        memory * 4,
+		}
+	}
+	if len(params) == 0 {
+		return nil
+	}
+	return instance.update(params)
+}
+
+type SGraphicsConsole struct {
+	Id       string `json:"id"`
+	Protocol string `json:"protocol"`
+	Address  string `json:"address"`
+	Port     int    `json:"port"`
+	TlsPort  int    `json:"tls_port"`
+}
+
+func (instance *SInstance) GetVNCInfo() (jsonutils.JSONObject, error) {
+	consoles := []SGraphicsConsole{}
+	params := url.Values{}
+	params.Set("current", "true")
+	resource := fmt.Sprintf("%s/graphicsconsoles", instance.getResource())
+	err := instance.host.zone.region.client.list(resource, params, "graphics_console", &consoles)
+	if err != nil {
+		return nil, errors.Wrap(err, "list graphics consoles")
+	}
+	var console *SGraphicsConsole
+	for i := range consoles {
+		if consoles[i].Protocol == "vnc" {
+			console = &consoles[i]
+			break
+		}
+	}
+	if console == nil {
+		return nil, errors.Wrapf(cloudprovider.ErrNotFound, "no vnc console of vm %s", instance.Name)
+	}
+	resp, err := instance.host.zone.region.client.post(fmt.Sprintf("%s/%s/ticket", resource, console.Id), nil, jsonutils.NewDict())
+	if err != nil {
+		return nil, errors.Wrap(err, "ticket")
+	}
+	ticket, err := resp.GetString("ticket", "value")
+	if err != nil {
+		return nil, errors.Wrap(err, "ticket value")
+	}
+	ret := jsonutils.NewDict()
+	ret.Add(jsonutils.NewString(console.Address), "host")
+	ret.Add(jsonutils.NewInt(int64(console.Port)), "port")
+	ret.Add(jsonutils.NewString("vnc"), "protocol")
+	ret.Add(jsonutils.NewString(ticket), "password")
+	ret.Add(jsonutils.NewString(instance.Id), "instance_id")
+	return ret, nil
+}
+
+func (instance *SInstance) AttachDisk(ctx context.Context, diskId string) error {
+	return instance.host.zone.region.AttachDisk(instance.Id, diskId)
+}
+
+func (instance *SInstance) DetachDisk(ctx context.Context, diskId string) error {
+	return instance.host.zone.region.DetachDisk(instance.Id, diskId)
+}
+
+func (instance *SInstance) CreateDisk(ctx context.Context, sizeMb int, uuid string, driver string) error {
+	return cloudprovider.ErrNotSupported
+}
+
+func (instance *SInstance) GetProjectId() string {
+	return ""
+}
+
+func (instance *SInstance) Renew(bc billing.SBillingCycle) error {
+	return cloudprovider.ErrNotSupported
+}
+
+func (region *SRegion) AttachDisk(instanceId, diskId string) error {
+	params := map[string]interface{}{
+		"disk":      map[string]string{"id": diskId},
+		"interface": "virtio_scsi",
+		"bootable":  false,
+		"active":    true,
+	}
+	_, err := region.client.post(fmt.Sprintf("vms/%s/diskattachments", instanceId), nil, jsonutils.Marshal(params))
+	return err
+}
+
+func (region *SRegion) DetachDisk(instanceId, diskId string) error {
+	params := url.Values{}
+	params.Set("detach_only", "true")
+	err := region.client.delete(fmt.Sprintf("vms/%s/diskattachments/%s", instanceId, diskId), params)
+	if errors.Cause(err) == cloudprovider.ErrNotFound {
+		return nil
+	}
+	return err
+}
+
+// decodeUserData turns user data of the compute service into the custom
+// script of cloud-init, which oVirt merges into the cloud-config it generates
+func decodeUserData(userData string) string {
+	if data, err := base64.StdEncoding.DecodeString(userData); err == nil {
+		return string(data)
+	}
+	return userData
+}
+
+func (region *SRegion) CreateInstance(host *SHost, desc *cloudprovider.SManagedVMCreateConfig) (*SInstance, error) {
+	if len(desc.ExternalImageId) == 0 {
+		return nil, errors.Wrap(cloudprovider.ErrNotSupported, "create vm without template")
+	}
+	template, err := region.GetTemplate(desc.ExternalImageId)
+	if err != nil {
+		return nil, errors.Wrapf(err, "GetTemplate %s", desc.ExternalImageId)
+	}
+	memory := int64(desc.MemoryMB) * 1024 * 1024
+	params := map[string]interface{}{
+		"name":        desc.Name,
+		"description": desc.Description,
+		"cluster":     map[string]string{"id": host.Cluster.Id},
+		"template":    map[string]string{"id": template.Id},
+		"memory":      memory,
+		"memory_policy": map[string]int64{
+			"guaranteed": memory,
+			"max":        memory * 4,
+		},
+		"cpu": map[string]interface{}{
+			"topology": SCpuTopology{Sockets: desc.Cpu, Cores: 1, Threads: 1},
+		},
+		"placement_policy": map[string]interface{}{
+			"hosts":    map[string]interface{}{"host": []map[string]string{{"id": host.Id}}},
+			"affinity": "migratable",
+		},
+	}
+	initialization := map[string]interface{}{"host_name": desc.Name}
+	if strings.ToLower(desc.OsType) == strings.ToLower(osprofile.OS_TYPE_WINDOWS) {
+		initialization["user_name"] = desc.Account
+		initialization["root_password"] = desc.Password
+	} else if len(desc.UserData) > 0 {
+		initialization["custom_script"] = decodeUserData(desc.UserData)
+	}
+	params["initialization"] = initialization
+
+	// 系统盘克隆到指定存储上
+	sysDisk, err := template.getBootDisk()
+	if err != nil {
+		return nil, errors.Wrap(err, "template boot disk")
+	}
+	if len(desc.SysDisk.StorageExternalId) > 0 {
+		params["disk_attachments"] = map[string]interface{}{
+			"disk_attachment": []map[string]interface{}{
+				{
+					"disk": map[string]interface{}{
+						"id":     sysDisk.Id,
+						"format": "cow",
+						"storage_domains": map[string]interface{}{
+							"storage_domain": []map[string]string{{"id": desc.SysDisk.StorageExternalId}},
+						},
+					},
+				},
+			},
+		}
+	}
+	query := url.Values{}
+	query.Set("clone", "true")
+	resp, err := region.client.post("vms", query, jsonutils.Marshal(params))
+	if err != nil {
+		return nil, errors.Wrap(err, "create vm")
+	}
+	instanceId, err := resp.GetString("id")
+	if err != nil {
+		return nil, errors.Wrap(err, "vm id")
+	}
+	instance, err := region.waitInstanceCreated(instanceId)
+	if err != nil {
+		return nil, err
+	}
+	cleanup := func() {
+		if err := region.client.delete(instance.getResource(), nil); err != nil {
+			log.Errorf("remove vm %s: %v", instance.Name, err)
+		}
+	}
+
+	sysSize := int64(desc.SysDisk.SizeGB) * 1024 * 1024 * 1024
+	for _, attachment := range instance.DiskAttachments.DiskAttachment {
+		if attachment.Bootable && sysSize > sysDisk.ProvisionedSize {
+			err = region.ResizeDisk(instance.Id, attachment.Disk.Id, sysSize)
+			if err != nil {
+				log.Warningf("resize system disk of vm %s: %v", instance.Name, err)
+			}
+		}
+	}
+	if err := region.setInstanceNic(instance, desc.ExternalNetworkId); err != nil {
+		cleanup()
+		return nil, errors.Wrap(err, "setInstanceNic")
+	}
+	for i, disk := range desc.DataDisks {
+		name := disk.Name
+		if len(name) == 0 {
+			name = fmt.Sprintf("%s-disk%d", desc.Name, i+1)
+		}
+		params := map[string]interface{}{
+			"disk":      newDiskParams(name, "", disk.StorageExternalId, disk.SizeGB),
+			"interface": "virtio_scsi",
+			"bootable":  false,
+			"active":    true,
+		}
+		_, err := region.client.post(fmt.Sprintf("vms/%s/diskattachments", instance.Id), nil, jsonutils.Marshal(params))
+		if err != nil {
+			cleanup()
+			return nil, errors.Wrapf(err, "create data disk %s", name)
+		}
+	}
+	instance, err = region.waitInstanceCreated(instance.Id)
+	if err != nil {
+		return nil, err
+	}
+	instance.host = host
+	if err := instance.StartVM(context.Background()); err != nil {
+		return nil, errors.Wrap(err, "StartVM")
+	}
+	return instance, nil
+}
+
+// waitInstanceCreated waits until the disks of the vm are unlocked
+func (region *SRegion) waitInstanceCreated(instanceId string) (*SInstance, error) {
+	var instance *SInstance
+	err := cloudprovider.Wait(5*time.Second, 30*time.Minute, func() (bool, error) {
+		var err error
+		instance, err = region.GetInstance(instanceId)
+		if err != nil {
+			return false, err
+		}
+		if instance.Status == "image_locked" {
+			return false, nil
+		}
+		for _, attachment := range instance.DiskAttachments.DiskAttachment {
+			disk, err := region.GetDisk(attachment.Disk.Id)
+			if err != nil {
+				return false, err
+			}
+			if disk.Status == "locked" {
+				return false, nil
+			}
+		}
+		return true, nil
+	})
+	if err != nil {
+		return nil, errors.Wrapf(err, "wait vm %s created", instanceId)
+	}
+	return instance, nil
+}
+
+// setInstanceNic connects the first nic of the vm to the vnic profile, the
+// nic comes from the template or is added if the template has none
+func (region *SRegion) setInstanceNic(instance *SInstance, profileId string) error {
+	if len(profileId) == 0 {
+		return nil
+	}
+	params := map[string]interface{}{
+		"vnic_profile": map[string]string{"id": profileId},
+	}
+	resource := fmt.Sprintf("%s/nics", instance.getResource())
+	if len(instance.Nics.Nic) > 0 {
+		_, err := region.client.put(fmt.Sprintf("%s/%s", resource, instance.Nics.Nic[0].Id), jsonutils.Marshal(params))
+		return err
+	}
+	params["name"] = "nic1"
+	params["interface"] = "virtio"
+	_, err := region.client.post(resource, nil, jsonutils.Marshal(params))
+	return err
+}
@@ -0,0 +1,97 @@
+// Copyright 2019 Yunion
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ovirt
+
+import (
+	"net"
+
+	"yunion.io/x/log"
+
+	"yunion.io/x/onecloud/pkg/cloudprovider"
+)
+
+type SInstanceNic struct {
+	instance *SInstance
+
+	SOvirtBase
+	Interface string `json:"interface"`
+	Plugged   bool   `json:"plugged"`
+	Linked    bool   `json:"linked"`
+	Mac       struct {
+		Address string `json:"address"`
+	} `json:"mac"`
+	VnicProfile     SLink `json:"vnic_profile"`
+	ReportedDevices struct {
+		ReportedDevice []struct {
+			Ips struct {
+				Ip []struct {
+					Address string `json:"address"`
+					Version string `json:"version"`
+				} `json:"ip"`
+			} `json:"ips"`
+		} `json:"reported_device"`
+	} `json:"reported_devices"`
+
+	cloudprovider.DummyICloudNic
+}
+
+func (nic *SInstanceNic) GetId() string {
+	return nic.Id
+}
+
+// GetIP returns the first ipv4 address reported by the guest agent, oVirt
+// does not manage the addresses of vms itself
+func (nic *SInstanceNic) GetIP() string {
+	for _, dev := range nic.ReportedDevices.ReportedDevice {
+		for _, ip := range dev.Ips.Ip {
+			if ip.Version != "v4" {
+				continue
+			}
+			if addr := net.ParseIP(ip.Address); addr != nil && !addr.IsLoopback() && !addr.IsLinkLocalUnicast() {
+				return ip.Address
+			}
+		}
+	}
+	return ""
+}
+
+func (nic *SInstanceNic) GetMAC() string {
+	return nic.Mac.Address
+}
+
+func (nic *SInstanceNic) GetDriver() string {
+	switch nic.Interface {
+	case "e1000", "rtl8139":
+		return nic.Interface
+	default:
+		return "virtio"
+	}
+}
+
+func (nic *SInstanceNic) InClassicNetwork() bool {
+	return false
+}
+
+func (nic *SInstanceNic) GetINetwork() cloudprovider.ICloudNetwork {
+	if len(nic.VnicProfile.Id) == 0 {
+		return nil
+	}
+	network, err := nic.instance.host.zone.region.GetNetwork(nic.VnicProfile.Id)
+	if err != nil {
+		log.Errorf("failed to find vnic profile %s of nic %s: %v", nic.VnicProfile.Id, nic.Name, err)
+		return nil
+	}
+	return network
+}
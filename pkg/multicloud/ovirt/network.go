@@ -0,0 +1,122 @@
+// Copyright 2019 Yunion
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ovirt
+
+import (
+	"yunion.io/x/pkg/errors"
+
+	api "yunion.io/x/onecloud/pkg/apis/compute"
+	"yunion.io/x/onecloud/pkg/cloudprovider"
+	"yunion.io/x/onecloud/pkg/multicloud"
+	"yunion.io/x/onecloud/pkg/util/rbacutils"
+)
+
+const (
+	// oVirt 不管理虚拟机地址, 网络覆盖整个 ipv4 地址空间以便同步任意上报的地址
+	OVIRT_NETWORK_IP_START = "0.0.0.1"
+	OVIRT_NETWORK_IP_END   = "255.255.255.254"
+)
+
+// SNetwork 对应逻辑网络下的 vNIC 配置文件, 虚拟机网卡通过它接入网络
+type SNetwork struct {
+	multicloud.SResourceBase
+	multicloud.OvirtTags
+	wire *SWire
+
+	SOvirtBase
+	PassThrough struct {
+		Mode string `json:"mode"`
+	} `json:"pass_through"`
+	PortMirroring bool  `json:"port_mirroring"`
+	Network       SLink `json:"network"`
+}
+
+func (region *SRegion) GetNetwork(networkId string) (*SNetwork, error) {
+	network := &SNetwork{}
+	err := region.client.get("vnicprofiles/"+networkId, nil, network)
+	if err != nil {
+		return nil, err
+	}
+	network.wire, err = region.GetWire(network.Network.Id)
+	if err != nil {
+		return nil, errors.Wrapf(err, "GetWire %s", network.Network.Id)
+	}
+	return network, nil
+}
+
+func (network *SNetwork) GetId() string {
+	return network.Id
+}
+
+func (network *SNetwork) GetName() string {
+	return network.Name
+}
+
+func (network *SNetwork) GetGlobalId() string {
+	return network.Id
+}
+
+func (network *SNetwork) GetStatus() string {
+	return api.NETWORK_STATUS_AVAILABLE
+}
+
+func (network *SNetwork) Refresh() error {
+	new, err := network.wire.zone.region.GetNetwork(network.Id)
+	if err != nil {
+		return err
+	}
+	network.SOvirtBase = new.SOvirtBase
+	return nil
+}
+
+func (network *SNetwork) GetIWire() cloudprovider.ICloudWire {
+	return network.wire
+}
+
+func (network *SNetwork) GetIpStart() string {
+	return OVIRT_NETWORK_IP_START
+}
+
+func (network *SNetwork) GetIpEnd() string {
+	return OVIRT_NETWORK_IP_END
+}
+
+func (network *SNetwork) GetIpMask() int8 {
+	return 0
+}
+
+func (network *SNetwork) GetGateway() string {
+	return ""
+}
+
+func (network *SNetwork) GetServerType() string {
+	return api.NETWORK_TYPE_GUEST
+}
+
+func (network *SNetwork) GetPublicScope() rbacutils.TRbacScope {
+	return rbacutils.ScopeSystem
+}
+
+func (network *SNetwork) Delete() error {
+	return cloudprovider.ErrNotSupported
+}
+
+func (network *SNetwork) GetAllocTimeoutSeconds() int {
+	return 120 // 2 minutes
+}
+
+func (network *SNetwork) GetProjectId() string {
+	return ""
+}
@@ -0,0 +1,281 @@
+// Copyright 2019 Yunion
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ovirt
+
+import (
+	"context"
+	"encoding/base64"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"yunion.io/x/jsonutils"
+	"yunion.io/x/pkg/errors"
+
+	api "yunion.io/x/onecloud/pkg/apis/compute"
+	"yunion.io/x/onecloud/pkg/cloudprovider"
+	"yunion.io/x/onecloud/pkg/util/httputils"
+)
+
+const (
+	CLOUD_PROVIDER_OVIRT = api.CLOUD_PROVIDER_OVIRT
+	OVIRT_DEFAULT_REGION = "oVirt"
+	OVIRT_API_VERSION    = "4"
+
+	// 用户未指定认证域时使用内置域
+	OVIRT_DEFAULT_AUTHZ = "internal"
+)
+
+type OvirtClientConfig struct {
+	cpcfg cloudprovider.ProviderConfig
+
+	authURL  string
+	username string
+	password string
+
+	debug bool
+}
+
+// NewOvirtClientConfig accepts either the engine address or the full api
+// url, e.g. https://engine.example.com/ovirt-engine/api
+func NewOvirtClientConfig(authURL, username, password string) *OvirtClientConfig {
+	if !strings.Contains(username, "@") {
+		username = fmt.Sprintf("%s@%s", username, OVIRT_DEFAULT_AUTHZ)
+	}
+	cfg := &OvirtClientConfig{
+		authURL:  normalizeApiURL(authURL),
+		username: username,
+		password: password,
+	}
+	return cfg
+}
+
+func (cfg *OvirtClientConfig) CloudproviderConfig(cpcfg cloudprovider.ProviderConfig) *OvirtClientConfig {
+	cfg.cpcfg = cpcfg
+	return cfg
+}
+
+func (cfg *OvirtClientConfig) Debug(debug bool) *OvirtClientConfig {
+	cfg.debug = debug
+	return cfg
+}
+
+func normalizeApiURL(authURL string) string {
+	authURL = strings.TrimSuffix(authURL, "/")
+	switch {
+	case strings.HasSuffix(authURL, "/api"):
+		return authURL
+	case strings.HasSuffix(authURL, "/ovirt-engine"):
+		return authURL + "/api"
+	default:
+		return authURL + "/ovirt-engine/api"
+	}
+}
+
+type SOvirtClient struct {
+	*OvirtClientConfig
+
+	httpClient *http.Client
+
+	version string
+
+	iregions []cloudprovider.ICloudRegion
+}
+
+type SProductInfo struct {
+	Name    string `json:"name"`
+	Vendor  string `json:"vendor"`
+	Version struct {
+		FullVersion string `json:"full_version"`
+		Major       int    `json:"major"`
+		Minor       int    `json:"minor"`
+	} `json:"version"`
+}
+
+func NewOvirtClient(cfg *OvirtClientConfig) (*SOvirtClient, error) {
+	cli := &SOvirtClient{
+		OvirtClientConfig: cfg,
+		httpClient:        cfg.cpcfg.AdaptiveTimeoutHttpClient(),
+	}
+	if err := cli.connect(); err != nil {
+		return nil, err
+	}
+	cli.iregions = []cloudprovider.ICloudRegion{&SRegion{client: cli, Name: OVIRT_DEFAULT_REGION}}
+	return cli, nil
+}
+
+func (cli *SOvirtClient) connect() error {
+	resp, err := cli.jsonRequest(context.Background(), httputils.GET, cli.authURL, nil)
+	if err != nil {
+		return errors.Wrap(err, "connect")
+	}
+	info := SProductInfo{}
+	if err := resp.Unmarshal(&info, "product_info"); err != nil {
+		return errors.Wrap(err, "unmarshal product_info")
+	}
+	if info.Version.Major > 0 && info.Version.Major < 4 {
+		return errors.Errorf("unsupported oVirt version %s, at least 4.0 is required", info.Version.FullVersion)
+	}
+	cli.version = info.Version.FullVersion
+	return nil
+}
+
+func (cli *SOvirtClient) GetVersion() string {
+	return cli.version
+}
+
+func (cli *SOvirtClient) GetCloudRegionExternalIdPrefix() string {
+	return fmt.Sprintf("%s/%s", CLOUD_PROVIDER_OVIRT, cli.cpcfg.Id)
+}
+
+func (cli *SOvirtClient) GetSubAccounts() ([]cloudprovider.SSubAccount, error) {
+	subAccount := cloudprovider.SSubAccount{
+		Account:      cli.username,
+		Name:         cli.cpcfg.Name,
+		HealthStatus: api.CLOUD_PROVIDER_HEALTH_NORMAL,
+	}
+	return []cloudprovider.SSubAccount{subAccount}, nil
+}
+
+func (cli *SOvirtClient) GetIRegions() []cloudprovider.ICloudRegion {
+	return cli.iregions
+}
+
+func (cli *SOvirtClient) GetIRegionById(id string) (cloudprovider.ICloudRegion, error) {
+	for i := 0; i < len(cli.iregions); i++ {
+		if cli.iregions[i].GetGlobalId() == id {
+			return cli.iregions[i], nil
+		}
+	}
+	return nil, cloudprovider.ErrNotFound
+}
+
+func (cli *SOvirtClient) GetRegion(regionId string) *SRegion {
+	for i := 0; i < len(cli.iregions); i++ {
+		if cli.iregions[i].GetId() == regionId {
+			return cli.iregions[i].(*SRegion)
+		}
+	}
+	return nil
+}
+
+func (cli *SOvirtClient) GetIProjects() ([]cloudprovider.ICloudProject, error) {
+	return nil, cloudprovider.ErrNotImplemented
+}
+
+func (cli *SOvirtClient) GetCapabilities() []string {
+	caps := []string{
+		cloudprovider.CLOUD_CAPABILITY_COMPUTE,
+		cloudprovider.CLOUD_CAPABILITY_NETWORK,
+	}
+	return caps
+}
+
+func (cli *SOvirtClient) getURL(resource string, params url.Values) string {
+	requestURL := fmt.Sprintf("%s/%s", cli.authURL, strings.TrimPrefix(resource, "/"))
+	if len(params) > 0 {
+		requestURL += "?" + params.Encode()
+	}
+	return requestURL
+}
+
+func (cli *SOvirtClient) jsonRequest(ctx context.Context, method httputils.THttpMethod, requestURL string, body jsonutils.JSONObject) (jsonutils.JSONObject, error) {
+	header := http.Header{}
+	header.Set("Accept", "application/json")
+	header.Set("Content-Type", "application/json")
+	header.Set("Version", OVIRT_API_VERSION)
+	auth := base64.StdEncoding.EncodeToString([]byte(cli.username + ":" + cli.password))
+	header.Set("Authorization", "Basic "+auth)
+	_, resp, err := httputils.JSONRequest(cli.httpClient, ctx, method, requestURL, header, body, cli.debug)
+	if err != nil {
+		if e, ok := err.(*httputils.JSONClientError); ok && e.Code == 404 {
+			return nil, errors.Wrapf(cloudprovider.ErrNotFound, "%s %s", method, requestURL)
+		}
+		return nil, errors.Wrapf(err, "%s %s", method, requestURL)
+	}
+	if resp == nil {
+		resp = jsonutils.NewDict()
+	}
+	return resp, nil
+}
+
+// list fetches a collection, oVirt wraps the items of a collection with the
+// singular name of the resource, e.g. {"vm": [...]} for /vms, and omits it
+// when the collection is empty
+func (cli *SOvirtClient) list(resource string, params url.Values, key string, retVal interface{}) error {
+	resp, err := cli.jsonRequest(context.Background(), httputils.GET, cli.getURL(resource, params), nil)
+	if err != nil {
+		return err
+	}
+	if !resp.Contains(key) {
+		return nil
+	}
+	return resp.Unmarshal(retVal, key)
+}
+
+func (cli *SOvirtClient) get(resource string, params url.Values, retVal interface{}) error {
+	resp, err := cli.jsonRequest(context.Background(), httputils.GET, cli.getURL(resource, params), nil)
+	if err != nil {
+		return err
+	}
+	return resp.Unmarshal(retVal)
+}
+
+func (cli *SOvirtClient) post(resource string, params url.Values, body jsonutils.JSONObject) (jsonutils.JSONObject, error) {
+	return cli.jsonRequest(context.Background(), httputils.POST, cli.getURL(resource, params), body)
+}
+
+func (cli *SOvirtClient) put(resource string, body jsonutils.JSONObject) (jsonutils.JSONObject, error) {
+	return cli.jsonRequest(context.Background(), httputils.PUT, cli.getURL(resource, nil), body)
+}
+
+func (cli *SOvirtClient) delete(resource string, params url.Values) error {
+	_, err := cli.jsonRequest(context.Background(), httputils.DELETE, cli.getURL(resource, params), nil)
+	return err
+}
+
+// action invokes an action of a resource, e.g. POST /vms/{id}/start, the
+// request is made synchronous so that failures are reported here
+func (cli *SOvirtClient) action(resource, action string, body *jsonutils.JSONDict) error {
+	if body == nil {
+		body = jsonutils.NewDict()
+	}
+	body.Set("async", jsonutils.JSONFalse)
+	_, err := cli.post(fmt.Sprintf("%s/%s", resource, action), nil, body)
+	return err
+}
+
+type SLink struct {
+	Id   string `json:"id"`
+	Href string `json:"href"`
+}
+
+type SOvirtBase struct {
+	Id          string `json:"id"`
+	Name        string `json:"name"`
+	Description string `json:"description"`
+	Comment     string `json:"comment"`
+	Href        string `json:"href"`
+}
+
+// oVirt reports timestamps as milliseconds since epoch
+func msToTime(ms int64) time.Time {
+	if ms <= 0 {
+		return time.Time{}
+	}
+	return time.Unix(0, ms*int64(time.Millisecond))
+}
@@ -0,0 +1,80 @@
+// Copyright 2019 Yunion
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ovirt
+
+import (
+	"testing"
+)
+
+func TestNormalizeApiURL(t *testing.T) {
+	cases := []struct {
+		in   string
+		want string
+	}{
+		{"https://engine.example.com", "https://engine.example.com/ovirt-engine/api"},
+		{"https://engine.example.com/", "https://engine.example.com/ovirt-engine/api"},
+		{"https://engine.example.com/ovirt-engine", "https://engine.example.com/ovirt-engine/api"},
+		{"https://engine.example.com/ovirt-engine/api/", "https://engine.example.com/ovirt-engine/api"},
+	}
+	for _, c := range cases {
+		if got := normalizeApiURL(c.in); got != c.want {
+			t.Errorf("normalizeApiURL(%q) = %q, want %q", c.in, got, c.want)
+		}
+	}
+}
+
+func TestNewOvirtClientConfigUsername(t *testing.T) {
+	if cfg := NewOvirtClientConfig("https://engine", "admin", ""); cfg.username != "admin@internal" {
+		t.Errorf("username = %q, want admin@internal", cfg.username)
+	}
+	if cfg := NewOvirtClientConfig("https://engine", "admin@example.com", ""); cfg.username != "admin@example.com" {
+		t.Errorf("username = %q, want admin@example.com", cfg.username)
+	}
+}
+
+func TestInstanceGetHostId(t *testing.T) {
+	clusterHosts := map[string][]string{"c1": {"h1", "h2"}}
+
+	running := SInstance{Host: SLink{Id: "h2"}, Cluster: SLink{Id: "c1"}}
+	if got := running.getHostId(clusterHosts); got != "h2" {
+		t.Errorf("running vm host = %q, want h2", got)
+	}
+
+	pinned := SInstance{Cluster: SLink{Id: "c1"}}
+	pinned.PlacementPolicy.Hosts.Host = []SLink{{Id: "h2"}}
+	if got := pinned.getHostId(clusterHosts); got != "h2" {
+		t.Errorf("pinned vm host = %q, want h2", got)
+	}
+
+	stopped := SInstance{Cluster: SLink{Id: "c1"}}
+	if got := stopped.getHostId(clusterHosts); got != "h1" {
+		t.Errorf("stopped vm host = %q, want h1", got)
+	}
+
+	orphan := SInstance{Cluster: SLink{Id: "c2"}}
+	if got := orphan.getHostId(clusterHosts); got != "" {
+		t.Errorf("orphan vm host = %q, want empty", got)
+	}
+}
+
+func TestParseSnapshotId(t *testing.T) {
+	vmId, snapshotId, diskId, err := parseSnapshotId("vm/snap/disk")
+	if err != nil || vmId != "vm" || snapshotId != "snap" || diskId != "disk" {
+		t.Errorf("parseSnapshotId = %q %q %q %v", vmId, snapshotId, diskId, err)
+	}
+	if _, _, _, err := parseSnapshotId("snap"); err == nil {
+		t.Errorf("parseSnapshotId of invalid id should fail")
+	}
+}
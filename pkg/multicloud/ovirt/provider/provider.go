@@ -0,0 +1,158 @@
+// Copyright 2019 Yunion
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package provider
+
+import (
+	"context"
+
+	"yunion.io/x/jsonutils"
+	"yunion.io/x/pkg/errors"
+
+	api "yunion.io/x/onecloud/pkg/apis/compute"
+	"yunion.io/x/onecloud/pkg/cloudprovider"
+	"yunion.io/x/onecloud/pkg/httperrors"
+	"yunion.io/x/onecloud/pkg/mcclient"
+	"yunion.io/x/onecloud/pkg/multicloud/ovirt"
+)
+
+type SOvirtProviderFactory struct {
+	cloudprovider.SPrivateCloudBaseProviderFactory
+}
+
+func (self *SOvirtProviderFactory) GetId() string {
+	return ovirt.CLOUD_PROVIDER_OVIRT
+}
+
+func (self *SOvirtProviderFactory) GetName() string {
+	return ovirt.CLOUD_PROVIDER_OVIRT
+}
+
+func (self *SOvirtProviderFactory) ValidateCreateCloudaccountData(ctx context.Context, userCred mcclient.TokenCredential, input cloudprovider.SCloudaccountCredential) (cloudprovider.SCloudaccount, error) {
+	output := cloudprovider.SCloudaccount{}
+	if len(input.AuthUrl) == 0 {
+		return output, errors.Wrap(httperrors.ErrMissingParameter, "auth_url")
+	}
+	if len(input.Username) == 0 {
+		return output, errors.Wrap(httperrors.ErrMissingParameter, "username")
+	}
+	if len(input.Password) == 0 {
+		return output, errors.Wrap(httperrors.ErrMissingParameter, "password")
+	}
+	output.AccessUrl = input.AuthUrl
+	output.Account = input.Username
+	output.Secret = input.Password
+	return output, nil
+}
+
+func (self *SOvirtProviderFactory) ValidateUpdateCloudaccountCredential(ctx context.Context, userCred mcclient.TokenCredential, input cloudprovider.SCloudaccountCredential, cloudaccount string) (cloudprovider.SCloudaccount, error) {
+	output := cloudprovider.SCloudaccount{}
+	if len(input.Username) == 0 {
+		return output, errors.Wrap(httperrors.ErrMissingParameter, "username")
+	}
+	if len(input.Password) == 0 {
+		return output, errors.Wrap(httperrors.ErrMissingParameter, "password")
+	}
+	output.Account = input.Username
+	output.Secret = input.Password
+	return output, nil
+}
+
+func (self *SOvirtProviderFactory) GetProvider(cfg cloudprovider.ProviderConfig) (cloudprovider.ICloudProvider, error) {
+	client, err := ovirt.NewOvirtClient(
+		ovirt.NewOvirtClientConfig(
+			cfg.URL, cfg.Account, cfg.Secret,
+		).CloudproviderConfig(cfg),
+	)
+	if err != nil {
+		return nil, err
+	}
+	return &SOvirtProvider{
+		SBaseProvider: cloudprovider.NewBaseProvider(self),
+		client:        client,
+	}, nil
+}
+
+func (self *SOvirtProviderFactory) GetClientRC(info cloudprovider.SProviderInfo) (map[string]string, error) {
+	return map[string]string{
+		"OVIRT_AUTH_URL":  info.Url,
+		"OVIRT_USERNAME":  info.Account,
+		"OVIRT_PASSWORD":  info.Secret,
+		"OVIRT_REGION_ID": ovirt.OVIRT_DEFAULT_REGION,
+	}, nil
+}
+
+func init() {
+	factory := SOvirtProviderFactory{}
+	cloudprovider.RegisterFactory(&factory)
+}
+
+type SOvirtProvider struct {
+	cloudprovider.SBaseProvider
+	client *ovirt.SOvirtClient
+}
+
+func (self *SOvirtProvider) GetVersion() string {
+	return self.client.GetVersion()
+}
+
+func (self *SOvirtProvider) GetSysInfo() (jsonutils.JSONObject, error) {
+	info := jsonutils.NewDict()
+	info.Add(jsonutils.NewString(self.client.GetVersion()), "version")
+	return info, nil
+}
+
+func (self *SOvirtProvider) GetSubAccounts() ([]cloudprovider.SSubAccount, error) {
+	return self.client.GetSubAccounts()
+}
+
+func (self *SOvirtProvider) GetAccountId() string {
+	return ""
+}
+
+func (self *SOvirtProvider) GetIRegions() []cloudprovider.ICloudRegion {
+	return self.client.GetIRegions()
+}
+
+func (self *SOvirtProvider) GetIRegionById(extId string) (cloudprovider.ICloudRegion, error) {
+	return self.client.GetIRegionById(extId)
+}
+
+func (self *SOvirtProvider) GetBalance() (float64, string, error) {
+	return 0.0, api.CLOUD_PROVIDER_HEALTH_UNKNOWN, cloudprovider.ErrNotSupported
+}
+
+func (self *SOvirtProvider) GetCloudRegionExternalIdPrefix() string {
+	return self.client.GetCloudRegionExternalIdPrefix()
+}
+
+func (self *SOvirtProvider) GetIProjects() ([]cloudprovider.ICloudProject, error) {
+	return self.client.GetIProjects()
+}
+
+func (self *SOvirtProvider) GetStorageClasses(regionId string) []string {
+	return nil
+}
+
+func (self *SOvirtProvider) GetBucketCannedAcls(regionId string) []string {
+	return nil
+}
+
+func (self *SOvirtProvider) GetObjectCannedAcls(regionId string) []string {
+	return nil
+}
+
+func (self *SOvirtProvider) GetCapabilities() []string {
+	return self.client.GetCapabilities()
+}
@@ -0,0 +1,283 @@
+// Copyright 2019 Yunion
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ovirt
+
+import (
+	"fmt"
+
+	"yunion.io/x/pkg/errors"
+
+	api "yunion.io/x/onecloud/pkg/apis/compute"
+	"yunion.io/x/onecloud/pkg/cloudprovider"
+	"yunion.io/x/onecloud/pkg/multicloud"
+)
+
+// SRegion is the whole engine, oVirt has no concept of region
+type SRegion struct {
+	multicloud.SRegion
+	multicloud.SNoObjectStorageRegion
+
+	client *SOvirtClient
+
+	Name string
+
+	izones []cloudprovider.ICloudZone
+}
+
+func (region *SRegion) GetClient() *SOvirtClient {
+	return region.client
+}
+
+func (region *SRegion) GetId() string {
+	return region.Name
+}
+
+func (region *SRegion) GetName() string {
+	return region.client.cpcfg.Name
+}
+
+func (region *SRegion) GetI18n() cloudprovider.SModelI18nTable {
+	table := cloudprovider.SModelI18nTable{}
+	table["name"] = cloudprovider.NewSModelI18nEntry(region.GetName()).CN(region.GetName())
+	return table
+}
+
+func (region *SRegion) GetGlobalId() string {
+	return fmt.Sprintf("%s/%s", CLOUD_PROVIDER_OVIRT, region.client.cpcfg.Id)
+}
+
+func (region *SRegion) IsEmulated() bool {
+	return false
+}
+
+func (region *SRegion) GetProvider() string {
+	return CLOUD_PROVIDER_OVIRT
+}
+
+func (region *SRegion) GetCloudEnv() string {
+	return ""
+}
+
+func (region *SRegion) GetGeographicInfo() cloudprovider.SGeographicInfo {
+	return cloudprovider.SGeographicInfo{}
+}
+
+func (region *SRegion) GetStatus() string {
+	return api.CLOUD_REGION_STATUS_INSERVER
+}
+
+func (region *SRegion) Refresh() error {
+	return nil
+}
+
+func (region *SRegion) GetIZones() ([]cloudprovider.ICloudZone, error) {
+	if region.izones == nil {
+		zones, err := region.GetZones()
+		if err != nil {
+			return nil, errors.Wrap(err, "GetZones")
+		}
+		region.izones = []cloudprovider.ICloudZone{}
+		for i := 0; i < len(zones); i++ {
+			region.izones = append(region.izones, &zones[i])
+		}
+	}
+	return region.izones, nil
+}
+
+func (region *SRegion) GetIZoneById(id string) (cloudprovider.ICloudZone, error) {
+	izones, err := region.GetIZones()
+	if err != nil {
+		return nil, err
+	}
+	for i := 0; i < len(izones); i++ {
+		if izones[i].GetGlobalId() == id {
+			return izones[i], nil
+		}
+	}
+	return nil, cloudprovider.ErrNotFound
+}
+
+func (region *SRegion) GetVpc() *SVpc {
+	return &SVpc{region: region}
+}
+
+func (region *SRegion) GetIVpcs() ([]cloudprovider.ICloudVpc, error) {
+	return []cloudprovider.ICloudVpc{region.GetVpc()}, nil
+}
+
+func (region *SRegion) GetIVpcById(id string) (cloudprovider.ICloudVpc, error) {
+	vpc := region.GetVpc()
+	if vpc.GetGlobalId() != id {
+		return nil, cloudprovider.ErrNotFound
+	}
+	return vpc, nil
+}
+
+func (region *SRegion) CreateIVpc(name string, desc string, cidr string) (cloudprovider.ICloudVpc, error) {
+	return nil, cloudprovider.ErrNotSupported
+}
+
+func (region *SRegion) GetIHosts() ([]cloudprovider.ICloudHost, error) {
+	hosts, err := region.GetHosts("")
+	if err != nil {
+		return nil, err
+	}
+	ihosts := []cloudprovider.ICloudHost{}
+	for i := 0; i < len(hosts); i++ {
+		ihosts = append(ihosts, &hosts[i])
+	}
+	return ihosts, nil
+}
+
+func (region *SRegion) GetIHostById(id string) (cloudprovider.ICloudHost, error) {
+	return region.GetHost(id)
+}
+
+func (region *SRegion) GetIStorages() ([]cloudprovider.ICloudStorage, error) {
+	zones, err := region.GetZones()
+	if err != nil {
+		return nil, err
+	}
+	istorages := []cloudprovider.ICloudStorage{}
+	for i := 0; i < len(zones); i++ {
+		storages, err := zones[i].GetIStorages()
+		if err != nil {
+			return nil, err
+		}
+		istorages = append(istorages, storages...)
+	}
+	return istorages, nil
+}
+
+func (region *SRegion) GetIStorageById(id string) (cloudprovider.ICloudStorage, error) {
+	return region.GetStorage(id)
+}
+
+func (region *SRegion) GetIStoragecaches() ([]cloudprovider.ICloudStoragecache, error) {
+	zones, err := region.GetZones()
+	if err != nil {
+		return nil, err
+	}
+	icaches := []cloudprovider.ICloudStoragecache{}
+	for i := 0; i < len(zones); i++ {
+		icaches = append(icaches, zones[i].getStoragecache())
+	}
+	return icaches, nil
+}
+
+func (region *SRegion) GetIStoragecacheById(id string) (cloudprovider.ICloudStoragecache, error) {
+	caches, err := region.GetIStoragecaches()
+	if err != nil {
+		return nil, err
+	}
+	for i := 0; i < len(caches); i++ {
+		if caches[i].GetGlobalId() == id {
+			return caches[i], nil
+		}
+	}
+	return nil, cloudprovider.ErrNotFound
+}
+
+func (region *SRegion) GetIVMById(id string) (cloudprovider.ICloudVM, error) {
+	return region.GetInstance(id)
+}
+
+func (region *SRegion) GetIDiskById(id string) (cloudprovider.ICloudDisk, error) {
+	return region.GetDisk(id)
+}
+
+func (region *SRegion) GetISnapshots() ([]cloudprovider.ICloudSnapshot, error) {
+	snapshots, err := region.GetSnapshots("", "")
+	if err != nil {
+		return nil, err
+	}
+	isnapshots := []cloudprovider.ICloudSnapshot{}
+	for i := 0; i < len(snapshots); i++ {
+		isnapshots = append(isnapshots, &snapshots[i])
+	}
+	return isnapshots, nil
+}
+
+func (region *SRegion) GetISnapshotById(id string) (cloudprovider.ICloudSnapshot, error) {
+	return region.GetSnapshot(id)
+}
+
+func (region *SRegion) GetIEips() ([]cloudprovider.ICloudEIP, error) {
+	return []cloudprovider.ICloudEIP{}, nil
+}
+
+func (region *SRegion) GetIEipById(id string) (cloudprovider.ICloudEIP, error) {
+	return nil, cloudprovider.ErrNotFound
+}
+
+func (region *SRegion) CreateEIP(eip *cloudprovider.SEip) (cloudprovider.ICloudEIP, error) {
+	return nil, cloudprovider.ErrNotSupported
+}
+
+func (region *SRegion) GetISecurityGroupById(secgroupId string) (cloudprovider.ICloudSecurityGroup, error) {
+	return nil, cloudprovider.ErrNotSupported
+}
+
+func (region *SRegion) GetISecurityGroupByName(opts *cloudprovider.SecurityGroupFilterOptions) (cloudprovider.ICloudSecurityGroup, error) {
+	return nil, cloudprovider.ErrNotSupported
+}
+
+func (region *SRegion) CreateISecurityGroup(conf *cloudprovider.SecurityGroupCreateInput) (cloudprovider.ICloudSecurityGroup, error) {
+	return nil, cloudprovider.ErrNotSupported
+}
+
+func (region *SRegion) GetILoadBalancers() ([]cloudprovider.ICloudLoadbalancer, error) {
+	return nil, cloudprovider.ErrNotImplemented
+}
+
+func (region *SRegion) GetILoadBalancerAcls() ([]cloudprovider.ICloudLoadbalancerAcl, error) {
+	return nil, cloudprovider.ErrNotImplemented
+}
+
+func (region *SRegion) GetILoadBalancerCertificates() ([]cloudprovider.ICloudLoadbalancerCertificate, error) {
+	return nil, cloudprovider.ErrNotImplemented
+}
+
+func (region *SRegion) GetILoadBalancerBackendGroups() ([]cloudprovider.ICloudLoadbalancerBackendGroup, error) {
+	return nil, cloudprovider.ErrNotImplemented
+}
+
+func (region *SRegion) GetILoadBalancerById(loadbalancerId string) (cloudprovider.ICloudLoadbalancer, error) {
+	return nil, cloudprovider.ErrNotImplemented
+}
+
+func (region *SRegion) GetILoadBalancerAclById(aclId string) (cloudprovider.ICloudLoadbalancerAcl, error) {
+	return nil, cloudprovider.ErrNotImplemented
+}
+
+func (region *SRegion) GetILoadBalancerCertificateById(certId string) (cloudprovider.ICloudLoadbalancerCertificate, error) {
+	return nil, cloudprovider.ErrNotImplemented
+}
+
+func (region *SRegion) CreateILoadBalancer(loadbalancer *cloudprovider.SLoadbalancer) (cloudprovider.ICloudLoadbalancer, error) {
+	return nil, cloudprovider.ErrNotImplemented
+}
+
+func (region *SRegion) CreateILoadBalancerAcl(acl *cloudprovider.SLoadbalancerAccessControlList) (cloudprovider.ICloudLoadbalancerAcl, error) {
+	return nil, cloudprovider.ErrNotImplemented
+}
+
+func (region *SRegion) CreateILoadBalancerCertificate(cert *cloudprovider.SLoadbalancerCertificate) (cloudprovider.ICloudLoadbalancerCertificate, error) {
+	return nil, cloudprovider.ErrNotImplemented
+}
+
+func (region *SRegion) GetCapabilities() []string {
+	return region.client.GetCapabilities()
+}
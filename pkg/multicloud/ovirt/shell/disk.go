@@ -0,0 +1,59 @@
+// Copyright 2019 Yunion
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package shell
+
+import (
+	"yunion.io/x/onecloud/pkg/cloudprovider"
+	"yunion.io/x/onecloud/pkg/multicloud/ovirt"
+	"yunion.io/x/onecloud/pkg/util/shellutils"
+)
+
+func init() {
+	type DiskIdOptions struct {
+		ID string
+	}
+	shellutils.R(&DiskIdOptions{}, "disk-show", "Show disk", func(cli *ovirt.SRegion, args *DiskIdOptions) error {
+		disk, err := cli.GetDisk(args.ID)
+		if err != nil {
+			return err
+		}
+		printObject(disk)
+		return nil
+	})
+
+	type DiskCreateOptions struct {
+		STORAGE string `help:"Storage domain id"`
+		NAME    string
+		SIZE_GB int
+		Desc    string
+	}
+	shellutils.R(&DiskCreateOptions{}, "disk-create", "Create disk", func(cli *ovirt.SRegion, args *DiskCreateOptions) error {
+		disk, err := cli.CreateDisk(args.STORAGE, &cloudprovider.DiskCreateConfig{Name: args.NAME, SizeGb: args.SIZE_GB, Desc: args.Desc})
+		if err != nil {
+			return err
+		}
+		printObject(disk)
+		return nil
+	})
+
+	type DiskResizeOptions struct {
+		ID         string
+		SIZE_GB    int64
+		InstanceId string `help:"Id of vm the disk attached to"`
+	}
+	shellutils.R(&DiskResizeOptions{}, "disk-resize", "Resize disk", func(cli *ovirt.SRegion, args *DiskResizeOptions) error {
+		return cli.ResizeDisk(args.InstanceId, args.ID, args.SIZE_GB*1024*1024*1024)
+	})
+}
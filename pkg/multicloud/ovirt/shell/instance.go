@@ -0,0 +1,105 @@
+// Copyright 2019 Yunion
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package shell
+
+import (
+	"context"
+
+	"yunion.io/x/onecloud/pkg/cloudprovider"
+	"yunion.io/x/onecloud/pkg/multicloud/ovirt"
+	"yunion.io/x/onecloud/pkg/util/shellutils"
+)
+
+func init() {
+	type InstanceListOptions struct {
+		HostId string
+	}
+	shellutils.R(&InstanceListOptions{}, "instance-list", "List instances", func(cli *ovirt.SRegion, args *InstanceListOptions) error {
+		instances, err := cli.GetInstances(args.HostId)
+		if err != nil {
+			return err
+		}
+		printList(instances, len(instances), 0, 0, []string{})
+		return nil
+	})
+
+	type InstanceOperation struct {
+		ID string
+	}
+
+	shellutils.R(&InstanceOperation{}, "instance-show", "Show instance", func(cli *ovirt.SRegion, args *InstanceOperation) error {
+		instance, err := cli.GetInstance(args.ID)
+		if err != nil {
+			return err
+		}
+		printObject(instance)
+		return nil
+	})
+
+	shellutils.R(&InstanceOperation{}, "instance-start", "Start instance", func(cli *ovirt.SRegion, args *InstanceOperation) error {
+		instance, err := cli.GetInstance(args.ID)
+		if err != nil {
+			return err
+		}
+		return instance.StartVM(context.Background())
+	})
+
+	shellutils.R(&InstanceOperation{}, "instance-delete", "Delete instance", func(cli *ovirt.SRegion, args *InstanceOperation) error {
+		instance, err := cli.GetInstance(args.ID)
+		if err != nil {
+			return err
+		}
+		return instance.DeleteVM(context.Background())
+	})
+
+	shellutils.R(&InstanceOperation{}, "instance-vnc", "Show instance vnc console info", func(cli *ovirt.SRegion, args *InstanceOperation) error {
+		instance, err := cli.GetInstance(args.ID)
+		if err != nil {
+			return err
+		}
+		info, err := instance.GetVNCInfo()
+		if err != nil {
+			return err
+		}
+		printObject(info)
+		return nil
+	})
+
+	type InstanceStopOption struct {
+		ID      string
+		IsForce bool
+	}
+
+	shellutils.R(&InstanceStopOption{}, "instance-stop", "Stop instance", func(cli *ovirt.SRegion, args *InstanceStopOption) error {
+		instance, err := cli.GetInstance(args.ID)
+		if err != nil {
+			return err
+		}
+		return instance.StopVM(context.Background(), &cloudprovider.ServerStopOptions{IsForce: args.IsForce})
+	})
+
+	type InstanceDiskOption struct {
+		ID   string
+		DISK string
+	}
+
+	shellutils.R(&InstanceDiskOption{}, "instance-attach-disk", "Attach disk to instance", func(cli *ovirt.SRegion, args *InstanceDiskOption) error {
+		return cli.AttachDisk(args.ID, args.DISK)
+	})
+
+	shellutils.R(&InstanceDiskOption{}, "instance-detach-disk", "Detach disk from instance", func(cli *ovirt.SRegion, args *InstanceDiskOption) error {
+		return cli.DetachDisk(args.ID, args.DISK)
+	})
+}
@@ -0,0 +1,63 @@
+// Copyright 2019 Yunion
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package shell
+
+import (
+	"yunion.io/x/onecloud/pkg/multicloud/ovirt"
+	"yunion.io/x/onecloud/pkg/util/shellutils"
+)
+
+func init() {
+	type WireListOptions struct {
+		ZoneId    string `help:"Data center id"`
+		ClusterId string `help:"Cluster id"`
+	}
+	shellutils.R(&WireListOptions{}, "wire-list", "List vm networks", func(cli *ovirt.SRegion, args *WireListOptions) error {
+		wires, err := cli.GetWires(args.ZoneId, args.ClusterId)
+		if err != nil {
+			return err
+		}
+		printList(wires, 0, 0, 0, []string{})
+		return nil
+	})
+
+	type NetworkListOptions struct {
+		WIRE string `help:"Vm network id"`
+	}
+	shellutils.R(&NetworkListOptions{}, "network-list", "List vnic profiles of vm network", func(cli *ovirt.SRegion, args *NetworkListOptions) error {
+		wire, err := cli.GetWire(args.WIRE)
+		if err != nil {
+			return err
+		}
+		networks, err := wire.GetNetworks()
+		if err != nil {
+			return err
+		}
+		printList(networks, 0, 0, 0, []string{})
+		return nil
+	})
+
+	type NetworkIdOptions struct {
+		ID string
+	}
+	shellutils.R(&NetworkIdOptions{}, "network-show", "Show vnic profile", func(cli *ovirt.SRegion, args *NetworkIdOptions) error {
+		network, err := cli.GetNetwork(args.ID)
+		if err != nil {
+			return err
+		}
+		printObject(network)
+		return nil
+	})
+}
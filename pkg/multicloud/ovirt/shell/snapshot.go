@@ -0,0 +1,61 @@
+// Copyright 2019 Yunion
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package shell
+
+import (
+	"yunion.io/x/onecloud/pkg/multicloud/ovirt"
+	"yunion.io/x/onecloud/pkg/util/shellutils"
+)
+
+func init() {
+	type SnapshotListOptions struct {
+		InstanceId string `help:"Vm id"`
+		DiskId     string `help:"Disk id"`
+	}
+	shellutils.R(&SnapshotListOptions{}, "snapshot-list", "List disk snapshots", func(cli *ovirt.SRegion, args *SnapshotListOptions) error {
+		snapshots, err := cli.GetSnapshots(args.InstanceId, args.DiskId)
+		if err != nil {
+			return err
+		}
+		printList(snapshots, 0, 0, 0, []string{})
+		return nil
+	})
+
+	type SnapshotCreateOptions struct {
+		INSTANCE string `help:"Vm id"`
+		DISK     string `help:"Disk id"`
+		NAME     string
+		Desc     string
+	}
+	shellutils.R(&SnapshotCreateOptions{}, "snapshot-create", "Create disk snapshot", func(cli *ovirt.SRegion, args *SnapshotCreateOptions) error {
+		snapshot, err := cli.CreateSnapshot(args.INSTANCE, args.DISK, args.NAME, args.Desc)
+		if err != nil {
+			return err
+		}
+		printObject(snapshot)
+		return nil
+	})
+
+	type SnapshotIdOptions struct {
+		ID string `help:"Snapshot id in the form of <vm id>/<snapshot id>/<disk id>"`
+	}
+	shellutils.R(&SnapshotIdOptions{}, "snapshot-delete", "Delete disk snapshot", func(cli *ovirt.SRegion, args *SnapshotIdOptions) error {
+		snapshot, err := cli.GetSnapshot(args.ID)
+		if err != nil {
+			return err
+		}
+		return snapshot.Delete()
+	})
+}
@@ -0,0 +1,59 @@
+// Copyright 2019 Yunion
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package shell
+
+import (
+	"yunion.io/x/onecloud/pkg/multicloud/ovirt"
+	"yunion.io/x/onecloud/pkg/util/shellutils"
+)
+
+func init() {
+	type StorageListOptions struct {
+		ZONE string `help:"Data center id"`
+	}
+	shellutils.R(&StorageListOptions{}, "storage-list", "List storage domains", func(cli *ovirt.SRegion, args *StorageListOptions) error {
+		storages, err := cli.GetStorages(args.ZONE)
+		if err != nil {
+			return err
+		}
+		printList(storages, 0, 0, 0, []string{})
+		return nil
+	})
+
+	type StorageIdOptions struct {
+		ID string
+	}
+	shellutils.R(&StorageIdOptions{}, "storage-show", "Show storage domain", func(cli *ovirt.SRegion, args *StorageIdOptions) error {
+		storage, err := cli.GetStorage(args.ID)
+		if err != nil {
+			return err
+		}
+		printObject(storage)
+		return nil
+	})
+
+	shellutils.R(&StorageIdOptions{}, "storage-disk-list", "List disks in storage domain", func(cli *ovirt.SRegion, args *StorageIdOptions) error {
+		storage, err := cli.GetStorage(args.ID)
+		if err != nil {
+			return err
+		}
+		disks, err := storage.GetDisks()
+		if err != nil {
+			return err
+		}
+		printList(disks, 0, 0, 0, []string{})
+		return nil
+	})
+}
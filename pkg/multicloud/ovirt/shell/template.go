@@ -0,0 +1,46 @@
+// Copyright 2019 Yunion
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package shell
+
+import (
+	"yunion.io/x/onecloud/pkg/multicloud/ovirt"
+	"yunion.io/x/onecloud/pkg/util/shellutils"
+)
+
+func init() {
+	type TemplateListOptions struct {
+		ZoneId string `help:"Data center id"`
+	}
+	shellutils.R(&TemplateListOptions{}, "template-list", "List templates", func(cli *ovirt.SRegion, args *TemplateListOptions) error {
+		templates, err := cli.GetTemplates(args.ZoneId)
+		if err != nil {
+			return err
+		}
+		printList(templates, 0, 0, 0, []string{})
+		return nil
+	})
+
+	type TemplateIdOptions struct {
+		ID string
+	}
+	shellutils.R(&TemplateIdOptions{}, "template-show", "Show template", func(cli *ovirt.SRegion, args *TemplateIdOptions) error {
+		template, err := cli.GetTemplate(args.ID)
+		if err != nil {
+			return err
+		}
+		printObject(template)
+		return nil
+	})
+}
@@ -0,0 +1,44 @@
+// Copyright 2019 Yunion
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package shell
+
+import (
+	"yunion.io/x/onecloud/pkg/multicloud/ovirt"
+	"yunion.io/x/onecloud/pkg/util/shellutils"
+)
+
+func init() {
+	type ZoneListOptions struct {
+	}
+	shellutils.R(&ZoneListOptions{}, "zone-list", "List data centers", func(cli *ovirt.SRegion, args *ZoneListOptions) error {
+		zones, err := cli.GetZones()
+		if err != nil {
+			return err
+		}
+		printList(zones, 0, 0, 0, []string{})
+		return nil
+	})
+
+	type ClusterListOptions struct {
+	}
+	shellutils.R(&ClusterListOptions{}, "cluster-list", "List clusters", func(cli *ovirt.SRegion, args *ClusterListOptions) error {
+		clusters, err := cli.GetClusters()
+		if err != nil {
+			return err
+		}
+		printList(clusters, 0, 0, 0, []string{})
+		return nil
+	})
+}
@@ -0,0 +1,244 @@
+// Copyright 2019 Yunion
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ovirt
+
+import (
+	"fmt"
+	"net/url"
+	"strings"
+	"time"
+
+	"yunion.io/x/jsonutils"
+	"yunion.io/x/pkg/errors"
+
+	api "yunion.io/x/onecloud/pkg/apis/compute"
+	"yunion.io/x/onecloud/pkg/cloudprovider"
+	"yunion.io/x/onecloud/pkg/multicloud"
+)
+
+// SSnapshot oVirt 快照属于虚拟机, 这里按磁盘拆分为磁盘快照,
+// 全局 id 为 <vm id>/<snapshot id>/<disk id>
+type SSnapshot struct {
+	multicloud.SResourceBase
+	multicloud.OvirtTags
+	region *SRegion
+	disk   *SDisk
+
+	SOvirtBase
+	Date           int64  `json:"date"`
+	SnapshotStatus string `json:"snapshot_status"`
+	SnapshotType   string `json:"snapshot_type"`
+	PersistMemory  bool   `json:"persist_memorystate"`
+	Vm             SLink  `json:"vm"`
+	Disks          struct {
+		Disk []SDisk `json:"disk"`
+	} `json:"disks"`
+}
+
+func parseSnapshotId(id string) (string, string, string, error) {
+	parts := strings.Split(id, "/")
+	if len(parts) != 3 {
+		return "", "", "", errors.Wrapf(cloudprovider.ErrNotFound, "invalid snapshot id %s", id)
+	}
+	return parts[0], parts[1], parts[2], nil
+}
+
+// splitSnapshot turns a vm snapshot into disk snapshots
+func (region *SRegion) splitSnapshot(instanceId string, snapshot SSnapshot, diskId string) []SSnapshot {
+	ret := []SSnapshot{}
+	for i := range snapshot.Disks.Disk {
+		if len(diskId) > 0 && snapshot.Disks.Disk[i].Id != diskId {
+			continue
+		}
+		s := snapshot
+		s.region = region
+		s.Vm = SLink{Id: instanceId}
+		s.disk = &snapshot.Disks.Disk[i]
+		ret = append(ret, s)
+	}
+	return ret
+}
+
+// GetSnapshots returns disk snapshots of a vm, or of all vms if instanceId
+// is empty, optionally filtered by diskId
+func (region *SRegion) GetSnapshots(instanceId, diskId string) ([]SSnapshot, error) {
+	instanceIds := []string{instanceId}
+	if len(instanceId) == 0 {
+		instances := []SInstance{}
+		err := region.client.list("vms", nil, "vm", &instances)
+		if err != nil {
+			return nil, errors.Wrap(err, "list vms")
+		}
+		instanceIds = []string{}
+		for i := range instances {
+			instanceIds = append(instanceIds, instances[i].Id)
+		}
+	}
+	params := url.Values{}
+	params.Set("follow", "disks")
+	ret := []SSnapshot{}
+	for _, id := range instanceIds {
+		snapshots := []SSnapshot{}
+		err := region.client.list(fmt.Sprintf("vms/%s/snapshots", id), params, "snapshot", &snapshots)
+		if err != nil {
+			return nil, errors.Wrapf(err, "list snapshots of vm %s", id)
+		}
+		for i := range snapshots {
+			// active 为虚拟机当前状态, 不是真正的快照
+			if snapshots[i].SnapshotType != "regular" {
+				continue
+			}
+			ret = append(ret, region.splitSnapshot(id, snapshots[i], diskId)...)
+		}
+	}
+	return ret, nil
+}
+
+func (region *SRegion) GetSnapshot(id string) (*SSnapshot, error) {
+	instanceId, snapshotId, diskId, err := parseSnapshotId(id)
+	if err != nil {
+		return nil, err
+	}
+	snapshot := SSnapshot{}
+	params := url.Values{}
+	params.Set("follow", "disks")
+	err = region.client.get(fmt.Sprintf("vms/%s/snapshots/%s", instanceId, snapshotId), params, &snapshot)
+	if err != nil {
+		return nil, err
+	}
+	snapshots := region.splitSnapshot(instanceId, snapshot, diskId)
+	if len(snapshots) == 0 {
+		return nil, errors.Wrapf(cloudprovider.ErrNotFound, "disk %s in snapshot %s", diskId, snapshotId)
+	}
+	return &snapshots[0], nil
+}
+
+func (region *SRegion) CreateSnapshot(instanceId, diskId, name, desc string) (*SSnapshot, error) {
+	description := name
+	if len(desc) > 0 {
+		description = fmt.Sprintf("%s: %s", name, desc)
+	}
+	params := map[string]interface{}{
+		"description":         description,
+		"persist_memorystate": false,
+		"disk_attachments": map[string]interface{}{
+			"disk_attachment": []map[string]interface{}{
+				{"disk": map[string]string{"id": diskId}},
+			},
+		},
+	}
+	resp, err := region.client.post(fmt.Sprintf("vms/%s/snapshots", instanceId), nil, jsonutils.Marshal(params))
+	if err != nil {
+		return nil, errors.Wrap(err, "create snapshot")
+	}
+	snapshotId, err := resp.GetString("id")
+	if err != nil {
+		return nil, errors.Wrap(err, "snapshot id")
+	}
+	snapshot, err := region.GetSnapshot(fmt.Sprintf("%s/%s/%s", instanceId, snapshotId, diskId))
+	if err != nil {
+		return nil, err
+	}
+	err = cloudprovider.WaitStatus(snapshot, api.SNAPSHOT_READY, 5*time.Second, 30*time.Minute)
+	if err != nil {
+		return nil, errors.Wrap(err, "wait snapshot ready")
+	}
+	return snapshot, nil
+}
+
+func (snapshot *SSnapshot) getResource() string {
+	return fmt.Sprintf("vms/%s/snapshots/%s", snapshot.Vm.Id, snapshot.Id)
+}
+
+func (snapshot *SSnapshot) GetId() string {
+	return fmt.Sprintf("%s/%s/%s", snapshot.Vm.Id, snapshot.Id, snapshot.disk.Id)
+}
+
+func (snapshot *SSnapshot) GetName() string {
+	return snapshot.Description
+}
+
+func (snapshot *SSnapshot) GetGlobalId() string {
+	return snapshot.GetId()
+}
+
+func (snapshot *SSnapshot) GetStatus() string {
+	switch snapshot.SnapshotStatus {
+	case "ok":
+		return api.SNAPSHOT_READY
+	case "locked":
+		return api.SNAPSHOT_CREATING
+	default:
+		return api.SNAPSHOT_UNKNOWN
+	}
+}
+
+func (snapshot *SSnapshot) Refresh() error {
+	new, err := snapshot.region.GetSnapshot(snapshot.GetId())
+	if err != nil {
+		return err
+	}
+	return jsonutils.Update(snapshot, new)
+}
+
+func (snapshot *SSnapshot) IsEmulated() bool {
+	return false
+}
+
+func (snapshot *SSnapshot) GetSizeMb() int32 {
+	return int32(snapshot.disk.ProvisionedSize / 1024 / 1024)
+}
+
+func (snapshot *SSnapshot) GetDiskId() string {
+	return snapshot.disk.Id
+}
+
+func (snapshot *SSnapshot) GetDiskType() string {
+	disk, err := snapshot.region.getDiskWithAttachment(snapshot.disk.Id)
+	if err != nil {
+		return api.DISK_TYPE_DATA
+	}
+	return disk.GetDiskType()
+}
+
+func (snapshot *SSnapshot) GetProjectId() string {
+	return ""
+}
+
+// Delete removes the vm snapshot, snapshots covering other disks are kept
+// untouched since they can not be removed for a single disk
+func (snapshot *SSnapshot) Delete() error {
+	if len(snapshot.Disks.Disk) > 1 {
+		return errors.Wrapf(cloudprovider.ErrNotSupported, "snapshot %s covers %d disks", snapshot.Id, len(snapshot.Disks.Disk))
+	}
+	err := snapshot.region.client.delete(snapshot.getResource(), nil)
+	if err != nil {
+		if errors.Cause(err) == cloudprovider.ErrNotFound {
+			return nil
+		}
+		return err
+	}
+	return cloudprovider.WaitDeleted(snapshot, 5*time.Second, 10*time.Minute)
+}
+
+// restore reverts the disk to the snapshot, the vm must be stopped
+func (snapshot *SSnapshot) restore() error {
+	params := jsonutils.Marshal(map[string]interface{}{
+		"disks": map[string]interface{}{
+			"disk": []map[string]string{{"id": snapshot.disk.Id}},
+		},
+	}).(*jsonutils.JSONDict)
+	return snapshot.region.client.action(snapshot.getResource(), "restore", params)
+}
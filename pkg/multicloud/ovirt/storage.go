@@ -0,0 +1,241 @@
+// Copyright 2019 Yunion
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ovirt
+
+import (
+	"fmt"
+	"net/url"
+	"time"
+
+	"yunion.io/x/jsonutils"
+	"yunion.io/x/pkg/errors"
+
+	api "yunion.io/x/onecloud/pkg/apis/compute"
+	"yunion.io/x/onecloud/pkg/cloudprovider"
+	"yunion.io/x/onecloud/pkg/multicloud"
+)
+
+// SStorage 对应数据中心内的数据存储域
+type SStorage struct {
+	multicloud.SStorageBase
+	multicloud.OvirtTags
+	zone *SZone
+
+	SOvirtBase
+	Type      string `json:"type"`
+	Status    string `json:"status"`
+	Master    bool   `json:"master"`
+	Available int64  `json:"available"`
+	Used      int64  `json:"used"`
+	Committed int64  `json:"committed"`
+	Storage   struct {
+		Type    string `json:"type"`
+		Address string `json:"address"`
+		Path    string `json:"path"`
+	} `json:"storage"`
+	DataCenters struct {
+		DataCenter []SLink `json:"data_center"`
+	} `json:"data_centers"`
+}
+
+// GetStorages returns data storage domains attached to the data center, the
+// status of a storage domain is only reported in the scope of a data center
+func (region *SRegion) GetStorages(zoneId string) ([]SStorage, error) {
+	zone, err := region.GetZone(zoneId)
+	if err != nil {
+		return nil, errors.Wrapf(err, "GetZone %s", zoneId)
+	}
+	storages := []SStorage{}
+	err = region.client.list(fmt.Sprintf("datacenters/%s/storagedomains", zoneId), nil, "storage_domain", &storages)
+	if err != nil {
+		return nil, errors.Wrap(err, "list storage domains")
+	}
+	ret := []SStorage{}
+	for i := range storages {
+		if storages[i].Type != "data" {
+			continue
+		}
+		storages[i].zone = zone
+		ret = append(ret, storages[i])
+	}
+	return ret, nil
+}
+
+func (region *SRegion) GetStorage(storageId string) (*SStorage, error) {
+	storage := &SStorage{}
+	err := region.client.get("storagedomains/"+storageId, nil, storage)
+	if err != nil {
+		return nil, err
+	}
+	if storage.Type != "data" || len(storage.DataCenters.DataCenter) == 0 {
+		return nil, errors.Wrapf(cloudprovider.ErrNotFound, "storage domain %s is not an attached data domain", storageId)
+	}
+	zoneId := storage.DataCenters.DataCenter[0].Id
+	storage.zone, err = region.GetZone(zoneId)
+	if err != nil {
+		return nil, errors.Wrapf(err, "GetZone %s", zoneId)
+	}
+	err = region.client.get(fmt.Sprintf("datacenters/%s/storagedomains/%s", zoneId, storageId), nil, storage)
+	if err != nil {
+		return nil, err
+	}
+	return storage, nil
+}
+
+func (storage *SStorage) GetId() string {
+	return storage.Id
+}
+
+func (storage *SStorage) GetName() string {
+	return storage.Name
+}
+
+func (storage *SStorage) GetGlobalId() string {
+	return storage.Id
+}
+
+func (storage *SStorage) IsEmulated() bool {
+	return false
+}
+
+func (storage *SStorage) GetStatus() string {
+	if storage.Status == "active" {
+		return api.STORAGE_ONLINE
+	}
+	return api.STORAGE_OFFLINE
+}
+
+func (storage *SStorage) Refresh() error {
+	new, err := storage.zone.region.GetStorage(storage.Id)
+	if err != nil {
+		return err
+	}
+	return jsonutils.Update(storage, new)
+}
+
+func (storage *SStorage) GetIZone() cloudprovider.ICloudZone {
+	return storage.zone
+}
+
+func (storage *SStorage) GetIStoragecache() cloudprovider.ICloudStoragecache {
+	return storage.zone.getStoragecache()
+}
+
+// GetDisks returns disks of vms stored on the storage domain, disks of
+// templates are synced as images
+func (storage *SStorage) GetDisks() ([]SDisk, error) {
+	disks := []SDisk{}
+	err := storage.zone.region.client.list(fmt.Sprintf("storagedomains/%s/disks", storage.Id), url.Values{}, "disk", &disks)
+	if err != nil {
+		return nil, errors.Wrap(err, "list disks")
+	}
+	templateDiskIds, err := storage.zone.region.getTemplateDiskIds()
+	if err != nil {
+		return nil, err
+	}
+	attachments, err := storage.zone.region.getDiskAttachments()
+	if err != nil {
+		return nil, err
+	}
+	ret := []SDisk{}
+	for i := range disks {
+		if templateDiskIds[disks[i].Id] {
+			continue
+		}
+		// 4.2 之前的版本不返回 content_type
+		if len(disks[i].ContentType) > 0 && disks[i].ContentType != "data" {
+			continue
+		}
+		disks[i].region = storage.zone.region
+		if attachment, ok := attachments[disks[i].Id]; ok {
+			disks[i].attachment = &attachment
+		}
+		ret = append(ret, disks[i])
+	}
+	return ret, nil
+}
+
+func (storage *SStorage) GetIDisks() ([]cloudprovider.ICloudDisk, error) {
+	disks, err := storage.GetDisks()
+	if err != nil {
+		return nil, err
+	}
+	idisks := []cloudprovider.ICloudDisk{}
+	for i := 0; i < len(disks); i++ {
+		idisks = append(idisks, &disks[i])
+	}
+	return idisks, nil
+}
+
+func (storage *SStorage) GetIDiskById(diskId string) (cloudprovider.ICloudDisk, error) {
+	disk, err := storage.zone.region.getDiskWithAttachment(diskId)
+	if err != nil {
+		return nil, err
+	}
+	if disk.GetIStorageId() != storage.Id {
+		return nil, errors.Wrapf(cloudprovider.ErrNotFound, "disk %s not in storage %s", diskId, storage.Id)
+	}
+	return disk, nil
+}
+
+func (storage *SStorage) CreateIDisk(conf *cloudprovider.DiskCreateConfig) (cloudprovider.ICloudDisk, error) {
+	disk, err := storage.zone.region.CreateDisk(storage.Id, conf)
+	if err != nil {
+		return nil, err
+	}
+	err = cloudprovider.WaitStatus(disk, api.DISK_READY, 5*time.Second, 10*time.Minute)
+	if err != nil {
+		return nil, errors.Wrap(err, "wait disk ready")
+	}
+	return disk, nil
+}
+
+// GetStorageType returns the storage type of the domain, such as nfs, iscsi,
+// fcp, glusterfs, localfs or posixfs
+func (storage *SStorage) GetStorageType() string {
+	return storage.Storage.Type
+}
+
+func (storage *SStorage) GetMediumType() string {
+	return api.DISK_TYPE_ROTATE
+}
+
+func (storage *SStorage) GetCapacityMB() int64 {
+	return (storage.Available + storage.Used) / 1024 / 1024
+}
+
+func (storage *SStorage) GetCapacityUsedMB() int64 {
+	return storage.Used / 1024 / 1024
+}
+
+func (storage *SStorage) GetStorageConf() jsonutils.JSONObject {
+	conf := jsonutils.NewDict()
+	return conf
+}
+
+func (storage *SStorage) GetEnabled() bool {
+	return storage.Status != "maintenance"
+}
+
+func (storage *SStorage) GetMountPoint() string {
+	if len(storage.Storage.Address) > 0 {
+		return fmt.Sprintf("%s:%s", storage.Storage.Address, storage.Storage.Path)
+	}
+	return storage.Storage.Path
+}
+
+func (storage *SStorage) IsSysDiskStore() bool {
+	return true
+}
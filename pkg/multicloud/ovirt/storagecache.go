@@ -0,0 +1,106 @@
+// Copyright 2019 Yunion
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ovirt
+
+import (
+	"context"
+	"fmt"
+
+	"yunion.io/x/jsonutils"
+
+	"yunion.io/x/onecloud/pkg/cloudprovider"
+	"yunion.io/x/onecloud/pkg/mcclient"
+	"yunion.io/x/onecloud/pkg/multicloud"
+)
+
+// SStoragecache 每个数据中心一个, 缓存的镜像即该数据中心可用的模板
+type SStoragecache struct {
+	multicloud.SResourceBase
+	multicloud.OvirtTags
+	zone *SZone
+}
+
+func (scache *SStoragecache) GetId() string {
+	return fmt.Sprintf("%s-%s/%s", scache.zone.region.client.cpcfg.Id, scache.zone.region.GetId(), scache.zone.Id)
+}
+
+func (scache *SStoragecache) GetName() string {
+	return fmt.Sprintf("%s-%s/%s", scache.zone.region.client.cpcfg.Name, scache.zone.region.GetId(), scache.zone.Name)
+}
+
+func (scache *SStoragecache) GetStatus() string {
+	return "available"
+}
+
+func (scache *SStoragecache) Refresh() error {
+	return nil
+}
+
+func (scache *SStoragecache) GetGlobalId() string {
+	return scache.GetId()
+}
+
+func (scache *SStoragecache) IsEmulated() bool {
+	return false
+}
+
+func (scache *SStoragecache) GetICustomizedCloudImages() ([]cloudprovider.ICloudImage, error) {
+	return nil, cloudprovider.ErrNotImplemented
+}
+
+func (scache *SStoragecache) GetICloudImages() ([]cloudprovider.ICloudImage, error) {
+	templates, err := scache.zone.region.GetTemplates(scache.zone.Id)
+	if err != nil {
+		return nil, err
+	}
+	ret := []cloudprovider.ICloudImage{}
+	for i := 0; i < len(templates); i++ {
+		templates[i].storageCache = scache
+		ret = append(ret, &templates[i])
+	}
+	return ret, nil
+}
+
+func (scache *SStoragecache) GetIImageById(extId string) (cloudprovider.ICloudImage, error) {
+	template, err := scache.zone.region.GetTemplate(extId)
+	if err != nil {
+		return nil, err
+	}
+	template.storageCache = scache
+	return template, nil
+}
+
+func (scache *SStoragecache) GetPath() string {
+	return ""
+}
+
+// UploadImage is not supported, images have to be imported as templates in
+// oVirt, for instance from the glance provider of the engine
+func (scache *SStoragecache) UploadImage(ctx context.Context, userCred mcclient.TokenCredential, image *cloudprovider.SImageCreateOption, isForce bool) (string, error) {
+	if len(image.ExternalId) > 0 {
+		if _, err := scache.zone.region.GetTemplate(image.ExternalId); err == nil {
+			return image.ExternalId, nil
+		}
+	}
+	return "", cloudprovider.ErrNotSupported
+}
+
+func (scache *SStoragecache) CreateIImage(snapshotId, imageName, osType, imageDesc string) (cloudprovider.ICloudImage, error) {
+	return nil, cloudprovider.ErrNotImplemented
+}
+
+func (scache *SStoragecache) DownloadImage(userCred mcclient.TokenCredential, imageId string, extId string, path string) (jsonutils.JSONObject, error) {
+	return nil, cloudprovider.ErrNotImplemented
+}
@@ -0,0 +1,170 @@
+// Copyright 2019 Yunion
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ovirt
+
+import (
+	"fmt"
+	"net/url"
+
+	"yunion.io/x/pkg/errors"
+	"yunion.io/x/pkg/utils"
+
+	api "yunion.io/x/onecloud/pkg/apis/compute"
+	"yunion.io/x/onecloud/pkg/cloudprovider"
+	"yunion.io/x/onecloud/pkg/multicloud"
+)
+
+// SWire 对应 oVirt 数据中心内用于虚拟机的逻辑网络
+type SWire struct {
+	multicloud.SResourceBase
+	multicloud.OvirtTags
+	zone *SZone
+
+	SOvirtBase
+	Mtu        int   `json:"mtu"`
+	DataCenter SLink `json:"data_center"`
+	Usages     struct {
+		Usage []string `json:"usage"`
+	} `json:"usages"`
+	Vlan struct {
+		Id int `json:"id"`
+	} `json:"vlan"`
+}
+
+// GetWires returns vm networks of the data center, or the networks attached
+// to the cluster if clusterId is given
+func (region *SRegion) GetWires(zoneId, clusterId string) ([]SWire, error) {
+	wires := []SWire{}
+	resource := "networks"
+	if len(clusterId) > 0 {
+		resource = fmt.Sprintf("clusters/%s/networks", clusterId)
+	}
+	err := region.client.list(resource, nil, "network", &wires)
+	if err != nil {
+		return nil, errors.Wrapf(err, "list %s", resource)
+	}
+	zones, err := region.GetZones()
+	if err != nil {
+		return nil, err
+	}
+	zoneMap := map[string]*SZone{}
+	for i := range zones {
+		zoneMap[zones[i].Id] = &zones[i]
+	}
+	ret := []SWire{}
+	for i := range wires {
+		if !wires[i].isVmNetwork() {
+			continue
+		}
+		if len(zoneId) > 0 && wires[i].DataCenter.Id != zoneId {
+			continue
+		}
+		zone, ok := zoneMap[wires[i].DataCenter.Id]
+		if !ok {
+			continue
+		}
+		wires[i].zone = zone
+		ret = append(ret, wires[i])
+	}
+	return ret, nil
+}
+
+func (region *SRegion) GetWire(wireId string) (*SWire, error) {
+	wire := &SWire{}
+	err := region.client.get("networks/"+wireId, nil, wire)
+	if err != nil {
+		return nil, err
+	}
+	if !wire.isVmNetwork() {
+		return nil, errors.Wrapf(cloudprovider.ErrNotFound, "network %s is not a vm network", wireId)
+	}
+	wire.zone, err = region.GetZone(wire.DataCenter.Id)
+	if err != nil {
+		return nil, errors.Wrapf(err, "GetZone %s", wire.DataCenter.Id)
+	}
+	return wire, nil
+}
+
+func (wire *SWire) isVmNetwork() bool {
+	// 未返回 usages 的旧版本默认为虚拟机网络
+	return len(wire.Usages.Usage) == 0 || utils.IsInStringArray("vm", wire.Usages.Usage)
+}
+
+func (wire *SWire) GetId() string {
+	return wire.Id
+}
+
+func (wire *SWire) GetName() string {
+	return wire.Name
+}
+
+func (wire *SWire) GetGlobalId() string {
+	return wire.Id
+}
+
+func (wire *SWire) GetStatus() string {
+	return api.WIRE_STATUS_AVAILABLE
+}
+
+func (wire *SWire) GetIVpc() cloudprovider.ICloudVpc {
+	return wire.zone.region.GetVpc()
+}
+
+func (wire *SWire) GetIZone() cloudprovider.ICloudZone {
+	return wire.zone
+}
+
+func (wire *SWire) GetBandwidth() int {
+	return 10000
+}
+
+func (wire *SWire) GetINetworks() ([]cloudprovider.ICloudNetwork, error) {
+	networks, err := wire.GetNetworks()
+	if err != nil {
+		return nil, err
+	}
+	inetworks := []cloudprovider.ICloudNetwork{}
+	for i := 0; i < len(networks); i++ {
+		inetworks = append(inetworks, &networks[i])
+	}
+	return inetworks, nil
+}
+
+func (wire *SWire) GetINetworkById(netid string) (cloudprovider.ICloudNetwork, error) {
+	network, err := wire.zone.region.GetNetwork(netid)
+	if err != nil {
+		return nil, err
+	}
+	if network.wire.Id != wire.Id {
+		return nil, errors.Wrapf(cloudprovider.ErrNotFound, "vnic profile %s", netid)
+	}
+	return network, nil
+}
+
+func (wire *SWire) CreateINetwork(opts *cloudprovider.SNetworkCreateOptions) (cloudprovider.ICloudNetwork, error) {
+	return nil, cloudprovider.ErrNotSupported
+}
+
+func (wire *SWire) GetNetworks() ([]SNetwork, error) {
+	networks := []SNetwork{}
+	err := wire.zone.region.client.list(fmt.Sprintf("networks/%s/vnicprofiles", wire.Id), url.Values{}, "vnic_profile", &networks)
+	if err != nil {
+		return nil, errors.Wrap(err, "list vnic profiles")
+	}
+	for i := range networks {
+		networks[i].wire = wire
+	}
+	return networks, nil
+}
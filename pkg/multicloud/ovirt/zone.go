@@ -0,0 +1,149 @@
+// Copyright 2019 Yunion
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ovirt
+
+import (
+	api "yunion.io/x/onecloud/pkg/apis/compute"
+	"yunion.io/x/onecloud/pkg/cloudprovider"
+	"yunion.io/x/onecloud/pkg/multicloud"
+)
+
+// SZone is a data center of oVirt
+type SZone struct {
+	multicloud.SResourceBase
+	multicloud.OvirtTags
+	region *SRegion
+
+	SOvirtBase
+	Status        string `json:"status"`
+	Local         bool   `json:"local"`
+	StorageFormat string `json:"storage_format"`
+}
+
+func (region *SRegion) GetZones() ([]SZone, error) {
+	zones := []SZone{}
+	err := region.client.list("datacenters", nil, "data_center", &zones)
+	if err != nil {
+		return nil, err
+	}
+	for i := 0; i < len(zones); i++ {
+		zones[i].region = region
+	}
+	return zones, nil
+}
+
+func (region *SRegion) GetZone(zoneId string) (*SZone, error) {
+	zone := &SZone{region: region}
+	err := region.client.get("datacenters/"+zoneId, nil, zone)
+	if err != nil {
+		return nil, err
+	}
+	return zone, nil
+}
+
+func (zone *SZone) GetId() string {
+	return zone.Id
+}
+
+func (zone *SZone) GetName() string {
+	return zone.Name
+}
+
+func (zone *SZone) GetI18n() cloudprovider.SModelI18nTable {
+	table := cloudprovider.SModelI18nTable{}
+	table["name"] = cloudprovider.NewSModelI18nEntry(zone.GetName()).CN(zone.GetName())
+	return table
+}
+
+func (zone *SZone) GetGlobalId() string {
+	return zone.Id
+}
+
+func (zone *SZone) IsEmulated() bool {
+	return false
+}
+
+func (zone *SZone) GetStatus() string {
+	switch zone.Status {
+	case "up", "contend":
+		return api.ZONE_ENABLE
+	default:
+		return api.ZONE_DISABLE
+	}
+}
+
+func (zone *SZone) Refresh() error {
+	new, err := zone.region.GetZone(zone.Id)
+	if err != nil {
+		return err
+	}
+	zone.Status = new.Status
+	zone.Name = new.Name
+	return nil
+}
+
+func (zone *SZone) GetIRegion() cloudprovider.ICloudRegion {
+	return zone.region
+}
+
+func (zone *SZone) GetIHosts() ([]cloudprovider.ICloudHost, error) {
+	hosts, err := zone.region.GetHosts(zone.Id)
+	if err != nil {
+		return nil, err
+	}
+	ihosts := []cloudprovider.ICloudHost{}
+	for i := 0; i < len(hosts); i++ {
+		ihosts = append(ihosts, &hosts[i])
+	}
+	return ihosts, nil
+}
+
+func (zone *SZone) GetIHostById(id string) (cloudprovider.ICloudHost, error) {
+	host, err := zone.region.GetHost(id)
+	if err != nil {
+		return nil, err
+	}
+	if host.zone.Id != zone.Id {
+		return nil, cloudprovider.ErrNotFound
+	}
+	return host, nil
+}
+
+func (zone *SZone) GetIStorages() ([]cloudprovider.ICloudStorage, error) {
+	storages, err := zone.region.GetStorages(zone.Id)
+	if err != nil {
+		return nil, err
+	}
+	istorages := []cloudprovider.ICloudStorage{}
+	for i := 0; i < len(storages); i++ {
+		istorages = append(istorages, &storages[i])
+	}
+	return istorages, nil
+}
+
+func (zone *SZone) GetIStorageById(id string) (cloudprovider.ICloudStorage, error) {
+	storage, err := zone.region.GetStorage(id)
+	if err != nil {
+		return nil, err
+	}
+	if storage.zone.Id != zone.Id {
+		return nil, cloudprovider.ErrNotFound
+	}
+	return storage, nil
+}
+
+func (zone *SZone) getStoragecache() *SStoragecache {
+	return &SStoragecache{zone: zone}
+}
@@ -17,9 +17,15 @@ package qcloud
 import (
 	"fmt"
 	"strconv"
+	"strings"
+	"time"
 
 	"yunion.io/x/jsonutils"
 	"yunion.io/x/pkg/errors"
+
+	api "yunion.io/x/onecloud/pkg/apis/compute"
+	"yunion.io/x/onecloud/pkg/cloudprovider"
+	"yunion.io/x/onecloud/pkg/multicloud"
 )
 
 type SCdnOrigin struct {
@@ -33,6 +39,11 @@ type SCdnOrigin struct {
 	BackupServerName   interface{}   `json:"BackupServerName"`
 }
 type SCdnDomain struct {
+	multicloud.SVirtualResourceBase
+	multicloud.QcloudTags
+
+	client *SQcloudClient
+
 	Area        string     `json:"Area"`
 	Cname       string     `json:"Cname"`
 	CreateTime  string     `json:"CreateTime"`
@@ -121,3 +132,219 @@ func (client *SQcloudClient) DescribeAllCdnDomains(domains, origins []string, do
 	}
 	return cdnDomains, nil
 }
+
+func (self *SCdnDomain) GetId() string {
+	return self.Domain
+}
+
+func (self *SCdnDomain) GetName() string {
+	return self.Domain
+}
+
+func (self *SCdnDomain) GetGlobalId() string {
+	return self.Domain
+}
+
+func (self *SCdnDomain) GetStatus() string {
+	if status := toAPICdnStatus(self.Status); len(status) > 0 {
+		return status
+	}
+	return api.CDN_DOMAIN_STATUS_UNKNOWN
+}
+
+func (self *SCdnDomain) GetProjectId() string {
+	return strconv.Itoa(self.ProjectID)
+}
+
+func (self *SCdnDomain) GetArea() string {
+	return toAPICdnArea(self.Area)
+}
+
+func (self *SCdnDomain) GetServiceType() string {
+	return self.ServiceType
+}
+
+func (self *SCdnDomain) GetCname() string {
+	return self.Cname
+}
+
+func (self *SCdnDomain) GetOrigins() *cloudprovider.SCdnOrigins {
+	ret := cloudprovider.SCdnOrigins{}
+	for i, origin := range self.Origin.Origins {
+		cdnOrigin := cloudprovider.SCdnOrigin{
+			Type:       api.CDN_DOMAIN_ORIGIN_TYPE_DOMAIN,
+			Origin:     origin,
+			Priority:   i + 1,
+			ServerName: self.Origin.ServerName,
+		}
+		switch self.Origin.OriginType {
+		case "cos":
+			cdnOrigin.Type = api.CDN_DOMAIN_ORIGIN_TYPE_BUCKET
+		case "ip", "ipv6", "ip_ipv6":
+			cdnOrigin.Type = api.CDN_DOMAIN_ORIGIN_TYPE_IP
+		}
+		// 源站格式为 host:port[:weight]
+		if parts := strings.Split(origin, ":"); len(parts) > 1 && cdnOrigin.Type != api.CDN_DOMAIN_ORIGIN_TYPE_BUCKET {
+			if port, err := strconv.Atoi(parts[1]); err == nil {
+				cdnOrigin.Origin = parts[0]
+				cdnOrigin.Port = port
+			}
+		}
+		ret = append(ret, cdnOrigin)
+	}
+	return &ret
+}
+
+func (self *SCdnDomain) Refresh() error {
+	domain, err := self.client.GetCdnDomain(self.Domain)
+	if err != nil {
+		return errors.Wrapf(err, "GetCdnDomain(%s)", self.Domain)
+	}
+	return jsonutils.Update(self, domain)
+}
+
+// 腾讯云需要先停用加速域名才能删除
+func (self *SCdnDomain) Delete() error {
+	if self.Status != "offline" {
+		err := self.client.StopCdnDomain(self.Domain)
+		if err != nil {
+			return errors.Wrap(err, "StopCdnDomain")
+		}
+		err = cloudprovider.WaitStatus(self, api.CDN_DOMAIN_STATUS_OFFLINE, 10*time.Second, 10*time.Minute)
+		if err != nil {
+			return errors.Wrap(err, "wait cdn domain offline")
+		}
+	}
+	return self.client.DeleteCdnDomain(self.Domain)
+}
+
+func (self *SCdnDomain) PurgeCache(objectType string, paths []string) error {
+	if objectType == api.CDN_PURGE_TYPE_DIRECTORY {
+		return self.client.PurgePathCache(paths)
+	}
+	return self.client.PurgeUrlsCache(paths)
+}
+
+func (self *SCdnDomain) Prefetch(urls []string) error {
+	return self.client.PushUrlsCache(urls)
+}
+
+func (client *SQcloudClient) GetCdnDomain(domain string) (*SCdnDomain, error) {
+	domains, _, err := client.DescribeCdnDomains([]string{domain}, nil, "", 0, 1)
+	if err != nil {
+		return nil, errors.Wrap(err, "DescribeCdnDomains")
+	}
+	for i := range domains {
+		if domains[i].Domain == domain {
+			domains[i].client = client
+			return &domains[i], nil
+		}
+	}
+	return nil, errors.Wrap(cloudprovider.ErrNotFound, domain)
+}
+
+func (client *SQcloudClient) GetICloudCDNDomains() ([]cloudprovider.ICloudCDNDomain, error) {
+	domains, err := client.DescribeAllCdnDomains(nil, nil, "")
+	if err != nil {
+		return nil, errors.Wrap(err, "DescribeAllCdnDomains")
+	}
+	ret := []cloudprovider.ICloudCDNDomain{}
+	for i := range domains {
+		domains[i].client = client
+		ret = append(ret, &domains[i])
+	}
+	return ret, nil
+}
+
+func (client *SQcloudClient) GetICloudCDNDomainByName(name string) (cloudprovider.ICloudCDNDomain, error) {
+	return client.GetCdnDomain(name)
+}
+
+func (client *SQcloudClient) CreateICloudCDNDomain(opts *cloudprovider.CdnCreateOptions) (cloudprovider.ICloudCDNDomain, error) {
+	params := map[string]string{
+		"Domain":      opts.Domain,
+		"ServiceType": api.CDN_SERVICE_TYPE_WEB,
+		"Area":        api.CDN_DOMAIN_AREA_MAINLAND,
+	}
+	if len(opts.ServiceType) > 0 {
+		params["ServiceType"] = opts.ServiceType
+	}
+	if len(opts.Area) > 0 {
+		params["Area"] = opts.Area
+	}
+	for i, origin := range opts.Origins {
+		switch origin.Type {
+		case api.CDN_DOMAIN_ORIGIN_TYPE_BUCKET:
+			params["Origin.OriginType"] = "cos"
+		case api.CDN_DOMAIN_ORIGIN_TYPE_IP:
+			params["Origin.OriginType"] = "ip"
+		default:
+			params["Origin.OriginType"] = "domain"
+		}
+		value := origin.Origin
+		if origin.Port > 0 {
+			value = fmt.Sprintf("%s:%d", value, origin.Port)
+		}
+		params[fmt.Sprintf("Origin.Origins.%d", i)] = value
+		if len(origin.ServerName) > 0 {
+			params["Origin.ServerName"] = origin.ServerName
+		}
+	}
+	_, err := client.cdnRequest("AddCdnDomain", params)
+	if err != nil {
+		return nil, errors.Wrapf(err, "AddCdnDomain %s", jsonutils.Marshal(params).String())
+	}
+	return client.GetCdnDomain(opts.Domain)
+}
+
+func (client *SQcloudClient) StopCdnDomain(domain string) error {
+	_, err := client.cdnRequest("StopCdnDomain", map[string]string{"Domain": domain})
+	if err != nil {
+		return errors.Wrapf(err, "StopCdnDomain %s", domain)
+	}
+	return nil
+}
+
+func (client *SQcloudClient) DeleteCdnDomain(domain string) error {
+	_, err := client.cdnRequest("DeleteCdnDomain", map[string]string{"Domain": domain})
+	if err != nil {
+		return errors.Wrapf(err, "DeleteCdnDomain %s", domain)
+	}
+	return nil
+}
+
+func (client *SQcloudClient) PurgeUrlsCache(urls []string) error {
+	params := map[string]string{}
+	for i := range urls {
+		params[fmt.Sprintf("Urls.%d", i)] = urls[i]
+	}
+	_, err := client.cdnRequest("PurgeUrlsCache", params)
+	if err != nil {
+		return errors.Wrap(err, "PurgeUrlsCache")
+	}
+	return nil
+}
+
+func (client *SQcloudClient) PurgePathCache(paths []string) error {
+	params := map[string]string{"FlushType": "flush"}
+	for i := range paths {
+		params[fmt.Sprintf("Paths.%d", i)] = paths[i]
+	}
+	_, err := client.cdnRequest("PurgePathCache", params)
+	if err != nil {
+		return errors.Wrap(err, "PurgePathCache")
+	}
+	return nil
+}
+
+func (client *SQcloudClient) PushUrlsCache(urls []string) error {
+	params := map[string]string{}
+	for i := range urls {
+		params[fmt.Sprintf("Urls.%d", i)] = urls[i]
+	}
+	_, err := client.cdnRequest("PushUrlsCache", params)
+	if err != nil {
+		return errors.Wrap(err, "PushUrlsCache")
+	}
+	return nil
+}
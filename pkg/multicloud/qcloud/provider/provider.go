@@ -374,6 +374,18 @@ func (self *SQcloudProvider) GetSamlEntityId() string {
 	return cloudprovider.SAML_ENTITY_ID_QCLOUD
 }
 
+func (self *SQcloudProvider) GetICloudCDNDomains() ([]cloudprovider.ICloudCDNDomain, error) {
+	return self.client.GetICloudCDNDomains()
+}
+
+func (self *SQcloudProvider) GetICloudCDNDomainByName(name string) (cloudprovider.ICloudCDNDomain, error) {
+	return self.client.GetICloudCDNDomainByName(name)
+}
+
+func (self *SQcloudProvider) CreateICloudCDNDomain(opts *cloudprovider.CdnCreateOptions) (cloudprovider.ICloudCDNDomain, error) {
+	return self.client.CreateICloudCDNDomain(opts)
+}
+
 func (self *SQcloudProvider) GetICloudDnsZones() ([]cloudprovider.ICloudDnsZone, error) {
 	return self.client.GetICloudDnsZones()
 }
@@ -1094,6 +1094,7 @@ func (self *SQcloudClient) GetCapabilities() []string {
 		cloudprovider.CLOUD_CAPABILITY_MONGO_DB,
 		cloudprovider.CLOUD_CAPABILITY_ES,
 		cloudprovider.CLOUD_CAPABILITY_KAFKA,
+		cloudprovider.CLOUD_CAPABILITY_CDN,
 	}
 	return caps
 }
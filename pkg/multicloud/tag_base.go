@@ -351,3 +351,18 @@ func (self *ZStackTags) GetSysTags() map[string]string {
 func (self *ZStackTags) SetTags(tags map[string]string, replace bool) error {
 	return errors.Wrap(cloudprovider.ErrNotImplemented, "SetTags")
 }
+
+type OvirtTags struct {
+}
+
+func (self *OvirtTags) GetTags() (map[string]string, error) {
+	return nil, errors.Wrapf(cloudprovider.ErrNotImplemented, "GetTags")
+}
+
+func (self *OvirtTags) GetSysTags() map[string]string {
+	return nil
+}
+
+func (self *OvirtTags) SetTags(tags map[string]string, replace bool) error {
+	return errors.Wrap(cloudprovider.ErrNotImplemented, "SetTags")
+}
@@ -30,6 +30,8 @@ const (
 func InitHandlers(app *appsrv.Application) {
 	db.InitAllManagers()
 
+	db.AddOpenApiHandler(API_VERSION, app)
+
 	db.RegistUserCredCacheUpdater()
 
 	db.AddScopeResourceCountHandler(API_VERSION, app)
@@ -17,6 +17,8 @@ package predicates
 import (
 	"fmt"
 
+	computeapi "yunion.io/x/onecloud/pkg/apis/compute"
+	"yunion.io/x/onecloud/pkg/scheduler/api"
 	"yunion.io/x/onecloud/pkg/scheduler/core"
 )
 
@@ -36,15 +38,49 @@ func (f *IsolatedDevicePredicate) Clone() core.FitPredicate {
 
 func (f *IsolatedDevicePredicate) PreExecute(u *core.Unit, cs []core.Candidater) (bool, error) {
 	data := u.SchedData()
-	if len(data.IsolatedDevices) == 0 {
+	if len(data.IsolatedDevices) == 0 && len(getSRIOVNicRequests(data.Networks)) == 0 {
 		return false, nil
 	}
 	return true, nil
 }
 
+// getSRIOVNicRequests returns implicit SR-IOV VF requests of passthrough networks
+func getSRIOVNicRequests(nets []*computeapi.NetworkConfig) []*computeapi.IsolatedDeviceConfig {
+	devs := make([]*computeapi.IsolatedDeviceConfig, 0)
+	for _, net := range nets {
+		if net.Driver != computeapi.NETWORK_DRIVER_VFIO {
+			continue
+		}
+		devs = append(devs, &computeapi.IsolatedDeviceConfig{
+			DevType: computeapi.NIC_VF_TYPE,
+		})
+	}
+	return devs
+}
+
+// getSRIOVNicWireId returns the wire of a passthrough network, empty if the network
+// is not specified or not found on the candidate
+func getSRIOVNicWireId(net *computeapi.NetworkConfig, networks []*api.CandidateNetwork) string {
+	if len(net.Wire) > 0 {
+		return net.Wire
+	}
+	if len(net.Network) == 0 {
+		return ""
+	}
+	for _, n := range networks {
+		if n.SNetwork != nil && (n.Id == net.Network || n.Name == net.Network) {
+			return n.WireId
+		}
+	}
+	return ""
+}
+
 func (f *IsolatedDevicePredicate) Execute(u *core.Unit, c core.Candidater) (bool, []core.PredicateFailureReason, error) {
 	h := NewPredicateHelper(f, u, c)
-	reqIsoDevs := u.SchedData().IsolatedDevices
+	data := u.SchedData()
+	reqIsoDevs := make([]*computeapi.IsolatedDeviceConfig, 0, len(data.IsolatedDevices))
+	reqIsoDevs = append(reqIsoDevs, data.IsolatedDevices...)
+	reqIsoDevs = append(reqIsoDevs, getSRIOVNicRequests(data.Networks)...)
 	getter := c.Getter()
 	minCapacity := int64(0xFFFFFFFF)
 
@@ -97,6 +133,33 @@ func (f *IsolatedDevicePredicate) Execute(u *core.Unit, c core.Candidater) (bool
 		}
 	}
 
+	// check SR-IOV VFs on the wire of each passthrough network
+	wireRequest := make(map[string]int, 0)
+	for _, net := range data.Networks {
+		if net.Driver != computeapi.NETWORK_DRIVER_VFIO {
+			continue
+		}
+		if wireId := getSRIOVNicWireId(net, getter.Networks()); len(wireId) > 0 {
+			wireRequest[wireId] += 1
+		}
+	}
+	for wireId, reqCount := range wireRequest {
+		freeCount := 0
+		for _, dev := range getter.UnusedIsolatedDevicesByType(computeapi.NIC_VF_TYPE) {
+			if dev.WireID == wireId {
+				freeCount++
+			}
+		}
+		if freeCount < reqCount {
+			h.Exclude(fmt.Sprintf("SR-IOV VF on wire %q not enough, request: %d, hostFree: %d", wireId, reqCount, freeCount))
+			return h.GetResult()
+		}
+		cap := freeCount / reqCount
+		if int64(cap) < minCapacity {
+			minCapacity = int64(cap)
+		}
+	}
+
 	// check host device by model
 	devVendorModelRequest := make(map[string]int, 0)
 	for _, dev := range reqIsoDevs {
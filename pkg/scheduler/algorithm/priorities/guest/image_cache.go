@@ -0,0 +1,127 @@
+// Copyright 2019 Yunion
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package guest
+
+import (
+	"yunion.io/x/log"
+	"yunion.io/x/pkg/utils"
+
+	"yunion.io/x/onecloud/pkg/apis/compute"
+	schedapi "yunion.io/x/onecloud/pkg/apis/scheduler"
+	"yunion.io/x/onecloud/pkg/cloudcommon/db"
+	"yunion.io/x/onecloud/pkg/compute/models"
+	"yunion.io/x/onecloud/pkg/scheduler/algorithm/priorities"
+	"yunion.io/x/onecloud/pkg/scheduler/core"
+	"yunion.io/x/onecloud/pkg/scheduler/core/score"
+	"yunion.io/x/onecloud/pkg/scheduler/options"
+)
+
+// ImageCachePriority prefers the hosts which already have the image of
+// the guest's system disk in their storage caches, so that creating the
+// guest does not need to download the image
+type ImageCachePriority struct {
+	priorities.BasePriority
+
+	format string
+	// storagecache id => formats of the image cached
+	cachedFormats map[string][]string
+}
+
+func (p *ImageCachePriority) Name() string {
+	return "guest_image_cache"
+}
+
+func (p *ImageCachePriority) Clone() core.Priority {
+	return &ImageCachePriority{}
+}
+
+func (p *ImageCachePriority) PreExecute(u *core.Unit, cs []core.Candidater) (bool, []core.PredicateFailureReason, error) {
+	data := u.SchedData()
+	if data.Hypervisor != compute.HYPERVISOR_KVM || len(data.Disks) == 0 {
+		return false, nil, nil
+	}
+	imageId := data.Disks[0].ImageId
+	if len(imageId) == 0 {
+		return false, nil, nil
+	}
+
+	q := models.StoragecachedimageManager.Query()
+	q = q.Equals("cachedimage_id", imageId).Equals("status", compute.CACHED_IMAGE_STATUS_ACTIVE)
+	cachedImages := make([]models.SStoragecachedimage, 0)
+	if err := db.FetchModelObjects(models.StoragecachedimageManager, q, &cachedImages); err != nil {
+		// image cache locality is only an optimization, never fail the schedule
+		log.Errorf("Fetch storagecachedimages of image %s: %v", imageId, err)
+		return false, nil, nil
+	}
+
+	p.format = data.Disks[0].Format
+	p.cachedFormats = make(map[string][]string)
+	for i := range cachedImages {
+		sci := cachedImages[i]
+		p.cachedFormats[sci.StoragecacheId] = append(p.cachedFormats[sci.StoragecacheId], sci.Format)
+	}
+	return true, nil, nil
+}
+
+func (p *ImageCachePriority) Map(u *core.Unit, c core.Candidater) (core.HostPriority, error) {
+	h := priorities.NewPriorityHelper(p, u, c)
+
+	storagecacheIds := make([]string, 0)
+	for _, s := range c.Getter().Storages() {
+		if s.SStorage != nil && len(s.StoragecacheId) > 0 {
+			storagecacheIds = append(storagecacheIds, s.StoragecacheId)
+		}
+	}
+	status := getImageCacheStatus(p.cachedFormats, storagecacheIds, p.format)
+	u.SetFiltedData(c.IndexKey(), p.Name(), map[string]interface{}{
+		schedapi.IMAGE_CACHE_DATA_KEY: status,
+	})
+
+	if options.GetOptions().ImageCacheLowerUncachedOnly {
+		switch status {
+		case schedapi.IMAGE_CACHE_STATUS_UNCACHED:
+			h.SetAvoidScore(int(score.MaxScore))
+		case schedapi.IMAGE_CACHE_STATUS_CACHED_OTHER_FORMAT:
+			h.SetAvoidScore(int(score.MidScore))
+		}
+	} else {
+		switch status {
+		case schedapi.IMAGE_CACHE_STATUS_CACHED:
+			h.SetPreferScore(int(score.MaxScore))
+		case schedapi.IMAGE_CACHE_STATUS_CACHED_OTHER_FORMAT:
+			h.SetPreferScore(int(score.MidScore))
+		}
+	}
+
+	return h.GetResult()
+}
+
+// getImageCacheStatus tells whether the image is cached in any of the
+// storage caches, cached image without format recorded is considered to
+// match any format
+func getImageCacheStatus(cachedFormats map[string][]string, storagecacheIds []string, format string) string {
+	status := schedapi.IMAGE_CACHE_STATUS_UNCACHED
+	for _, id := range storagecacheIds {
+		formats, ok := cachedFormats[id]
+		if !ok {
+			continue
+		}
+		if len(format) == 0 || utils.IsInStringArray(format, formats) || utils.IsInStringArray("", formats) {
+			return schedapi.IMAGE_CACHE_STATUS_CACHED
+		}
+		status = schedapi.IMAGE_CACHE_STATUS_CACHED_OTHER_FORMAT
+	}
+	return status
+}
@@ -0,0 +1,49 @@
+// Copyright 2019 Yunion
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package guest
+
+import (
+	"testing"
+
+	schedapi "yunion.io/x/onecloud/pkg/apis/scheduler"
+)
+
+func TestGetImageCacheStatus(t *testing.T) {
+	cachedFormats := map[string][]string{
+		"sc-qcow2":   {"qcow2"},
+		"sc-raw":     {"raw"},
+		"sc-unknown": {""},
+	}
+	cases := []struct {
+		name            string
+		storagecacheIds []string
+		format          string
+		want            string
+	}{
+		{"no storagecache", nil, "qcow2", schedapi.IMAGE_CACHE_STATUS_UNCACHED},
+		{"not cached", []string{"sc-other"}, "qcow2", schedapi.IMAGE_CACHE_STATUS_UNCACHED},
+		{"cached", []string{"sc-qcow2"}, "qcow2", schedapi.IMAGE_CACHE_STATUS_CACHED},
+		{"any format", []string{"sc-raw"}, "", schedapi.IMAGE_CACHE_STATUS_CACHED},
+		{"other format", []string{"sc-raw"}, "qcow2", schedapi.IMAGE_CACHE_STATUS_CACHED_OTHER_FORMAT},
+		{"format not recorded", []string{"sc-unknown"}, "qcow2", schedapi.IMAGE_CACHE_STATUS_CACHED},
+		{"prefer matched format", []string{"sc-raw", "sc-qcow2"}, "qcow2", schedapi.IMAGE_CACHE_STATUS_CACHED},
+	}
+	for _, c := range cases {
+		got := getImageCacheStatus(cachedFormats, c.storagecacheIds, c.format)
+		if got != c.want {
+			t.Errorf("%s: want %s, got %s", c.name, c.want, got)
+		}
+	}
+}
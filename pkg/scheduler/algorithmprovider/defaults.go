@@ -58,5 +58,6 @@ func defaultPriorities() sets.String {
 		factory.RegisterPriority("guest-lowload", &priorityguest.LowLoadPriority{}, 1),
 		factory.RegisterPriority("guest-creating", &priorityguest.CreatingPriority{}, 1),
 		factory.RegisterPriority("guest-capacity", &priorityguest.CapacityPriority{}, 1),
+		factory.RegisterPriority("guest-image-cache", &priorityguest.ImageCachePriority{}, 1),
 	)
 }
@@ -459,6 +459,7 @@ func (h *BaseHostDesc) fillIsolatedDevices(b *baseBuilder, host *computemodels.S
 			Model:          devModel.Model,
 			Addr:           devModel.Addr,
 			VendorDeviceID: devModel.VendorDeviceId,
+			WireID:         devModel.WireId,
 		}
 		devs[index] = dev
 	}
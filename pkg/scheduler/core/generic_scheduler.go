@@ -28,6 +28,7 @@ import (
 	utiltrace "yunion.io/x/pkg/util/trace"
 	"yunion.io/x/pkg/util/workqueue"
 
+	schedapi "yunion.io/x/onecloud/pkg/apis/scheduler"
 	o "yunion.io/x/onecloud/pkg/scheduler/options"
 )
 
@@ -185,6 +186,9 @@ func newSchedResultByCtx(u *Unit, count int64, c Candidater) *SchedResultItem {
 		AllocatedResource: u.GetAllocatedResource(id),
 		SchedData:         u.SchedData(),
 	}
+	if status, ok := r.Data[schedapi.IMAGE_CACHE_DATA_KEY].(string); ok {
+		r.ImageCache = status
+	}
 
 	if showDetails {
 		r.CapacityDetails = GetCapacities(u, id)
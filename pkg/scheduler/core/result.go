@@ -42,6 +42,7 @@ type SchedResultItem struct {
 
 	CapacityDetails map[string]int64 `json:"capacity_details"`
 	ScoreDetails    string           `json:"score_details"`
+	ImageCache      string           `json:"image_cache,omitempty"`
 
 	Candidater Candidater `json:"-"`
 
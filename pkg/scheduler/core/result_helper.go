@@ -156,8 +156,13 @@ func transToSchedForecastResult(result *SchedResultItemList) *api.SchedForecastR
 		output     = transToSchedResult(result, schedData)
 		readyCount int64
 	)
+	imageCaches := make(map[string]string)
+	for _, item := range result.Data {
+		imageCaches[item.ID] = item.ImageCache
+	}
 	for _, candi := range output.Candidates {
 		if len(candi.Error) == 0 {
+			candi.ImageCache = imageCaches[candi.HostId]
 			readyCount++
 			ret.Candidates = append(ret.Candidates, candi)
 			continue
@@ -241,6 +241,8 @@ type IsolatedDeviceDesc struct {
 	Model          string
 	Addr           string
 	VendorDeviceID string
+	// WireID is the wire of the physical function of a SR-IOV VF
+	WireID string
 }
 
 func (i *IsolatedDeviceDesc) VendorID() string {
@@ -41,6 +41,9 @@ type SchedOptions struct {
 	SchedulerHistoryLimit       int    `help:"Scheduler history items' limitations" default:"1000"`
 	SchedulerHistoryCleanPeriod string `help:"Scheduler history cleanup period" default:"60s"`
 
+	// priority options
+	ImageCacheLowerUncachedOnly bool `help:"Only lower the priority of hosts without the guest image cached instead of preferring hosts with it" default:"false"`
+
 	// parallelization options
 	HostBuildParallelizeSize int `help:"Number of host description build parallelization" default:"14"`
 	PredicateParallelizeSize int `help:"Number of execute predicates parallelization" default:"14"`
@@ -0,0 +1,56 @@
+// Copyright 2019 Yunion
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package auditexport
+
+import (
+	"strconv"
+	"time"
+)
+
+// SEvent is an audited operation, either an actionlog of the logger service
+// or an opslog of any other service
+type SEvent struct {
+	// Id increases monotonically in the source table, exporters keep the
+	// id of the last exported event as the cursor
+	Id      int64  `json:"id"`
+	Source  string `json:"source"`
+	Service string `json:"service"`
+
+	ObjType string `json:"obj_type"`
+	ObjId   string `json:"obj_id"`
+	ObjName string `json:"obj_name"`
+	Action  string `json:"action"`
+	Notes   string `json:"notes"`
+	Success bool   `json:"success"`
+
+	UserId    string `json:"user_id"`
+	User      string `json:"user"`
+	DomainId  string `json:"domain_id"`
+	Domain    string `json:"domain"`
+	ProjectId string `json:"tenant_id"`
+	Project   string `json:"tenant"`
+	Roles     string `json:"roles"`
+
+	OwnerDomainId  string `json:"owner_domain_id"`
+	OwnerProjectId string `json:"owner_tenant_id"`
+
+	OpsTime time.Time `json:"ops_time"`
+}
+
+// Key returns the identity of the event, which stays the same when an event
+// is exported again so that the receiver can deduplicate
+func (ev *SEvent) Key() string {
+	return ev.Source + "/" + strconv.FormatInt(ev.Id, 10)
+}
@@ -0,0 +1,283 @@
+// Copyright 2019 Yunion
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package auditexport
+
+import (
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+
+	"yunion.io/x/jsonutils"
+	"yunion.io/x/pkg/util/version"
+	"yunion.io/x/pkg/utils"
+
+	"yunion.io/x/onecloud/pkg/httperrors"
+)
+
+const (
+	FORMAT_RFC5424 = "rfc5424"
+	FORMAT_CEF     = "cef"
+	FORMAT_LEEF    = "leef"
+	FORMAT_JSON    = "json"
+
+	VENDOR  = "Yunion"
+	PRODUCT = "OneCloud"
+
+	// log audit facility of RFC5424
+	SYSLOG_FACILITY = 13
+	// SD-ID of the structured data, 32473 is the enterprise number reserved
+	// for documentation by RFC5612
+	SYSLOG_SD_ID = "onecloud@32473"
+
+	syslogSeverityNotice  = 5
+	syslogSeverityWarning = 4
+
+	cefSeverityLow  = 3
+	cefSeverityHigh = 7
+)
+
+var Formats = []string{
+	FORMAT_RFC5424,
+	FORMAT_CEF,
+	FORMAT_LEEF,
+	FORMAT_JSON,
+}
+
+// SFormatter serializes events into one of the formats understood by SIEMs
+type SFormatter struct {
+	Name     string
+	Hostname string
+	Version  string
+}
+
+func NewFormatter(format string) (*SFormatter, error) {
+	if !utils.IsInStringArray(format, Formats) {
+		return nil, httperrors.NewInputParameterError("unsupported format %q, must be one of %s", format, strings.Join(Formats, ","))
+	}
+	hostname, _ := os.Hostname()
+	return &SFormatter{
+		Name:     format,
+		Hostname: hostname,
+		Version:  version.GetShortString(),
+	}, nil
+}
+
+// Format returns the event serialized in the format of the formatter
+func (f *SFormatter) Format(ev *SEvent) []byte {
+	switch f.Name {
+	case FORMAT_RFC5424:
+		return f.syslog(ev, f.structuredData(ev), ev.Notes)
+	case FORMAT_CEF:
+		return []byte(f.cef(ev))
+	case FORMAT_LEEF:
+		return []byte(f.leef(ev))
+	default:
+		return []byte(f.json(ev))
+	}
+}
+
+// FormatSyslog returns the event as a RFC5424 syslog message, events of
+// other formats are carried as the MSG part
+func (f *SFormatter) FormatSyslog(ev *SEvent) []byte {
+	if f.Name == FORMAT_RFC5424 {
+		return f.Format(ev)
+	}
+	return f.syslog(ev, "-", string(f.Format(ev)))
+}
+
+func eventSeverity(ev *SEvent, low, high int) int {
+	if ev.Success {
+		return low
+	}
+	return high
+}
+
+func eventOutcome(ev *SEvent) string {
+	if ev.Success {
+		return "success"
+	}
+	return "failure"
+}
+
+// syslogHeaderField replaces the characters not allowed in the header
+// fields of RFC5424 and truncates the value to maxLen
+func syslogHeaderField(val string, maxLen int) string {
+	if len(val) == 0 {
+		return "-"
+	}
+	buf := []byte(val)
+	for i := range buf {
+		if buf[i] < 33 || buf[i] > 126 {
+			buf[i] = '_'
+		}
+	}
+	if len(buf) > maxLen {
+		buf = buf[:maxLen]
+	}
+	return string(buf)
+}
+
+var sdParamEscaper = strings.NewReplacer(`\`, `\\`, `"`, `\"`, `]`, `\]`)
+
+func (f *SFormatter) structuredData(ev *SEvent) string {
+	params := []struct {
+		name  string
+		value string
+	}{
+		{"id", ev.Key()},
+		{"obj_type", ev.ObjType},
+		{"obj_id", ev.ObjId},
+		{"obj_name", ev.ObjName},
+		{"action", ev.Action},
+		{"success", strconv.FormatBool(ev.Success)},
+		{"user_id", ev.UserId},
+		{"user", ev.User},
+		{"domain_id", ev.DomainId},
+		{"tenant_id", ev.ProjectId},
+		{"tenant", ev.Project},
+		{"owner_domain_id", ev.OwnerDomainId},
+		{"owner_tenant_id", ev.OwnerProjectId},
+	}
+	sd := strings.Builder{}
+	sd.WriteString("[" + SYSLOG_SD_ID)
+	for _, p := range params {
+		if len(p.value) == 0 {
+			continue
+		}
+		fmt.Fprintf(&sd, " %s=\"%s\"", p.name, sdParamEscaper.Replace(p.value))
+	}
+	sd.WriteString("]")
+	return sd.String()
+}
+
+func (f *SFormatter) syslog(ev *SEvent, sd string, msg string) []byte {
+	pri := SYSLOG_FACILITY*8 + eventSeverity(ev, syslogSeverityNotice, syslogSeverityWarning)
+	line := fmt.Sprintf("<%d>1 %s %s %s - %s %s",
+		pri,
+		ev.OpsTime.UTC().Format("2006-01-02T15:04:05.000000Z07:00"),
+		syslogHeaderField(f.Hostname, 255),
+		syslogHeaderField(ev.Service, 48),
+		syslogHeaderField(ev.Action, 32),
+		sd,
+	)
+	if len(msg) > 0 {
+		line += " " + msg
+	}
+	return []byte(line)
+}
+
+var (
+	cefHeaderEscaper    = strings.NewReplacer(`\`, `\\`, `|`, `\|`, "\r", " ", "\n", " ")
+	cefExtensionEscaper = strings.NewReplacer(`\`, `\\`, `=`, `\=`, "\r", `\r`, "\n", `\n`)
+)
+
+func (f *SFormatter) cef(ev *SEvent) string {
+	header := []string{
+		"CEF:0",
+		cefHeaderEscaper.Replace(VENDOR),
+		cefHeaderEscaper.Replace(PRODUCT),
+		cefHeaderEscaper.Replace(f.Version),
+		cefHeaderEscaper.Replace(ev.ObjType + "." + ev.Action),
+		cefHeaderEscaper.Replace(ev.Action + " " + ev.ObjType),
+		strconv.Itoa(eventSeverity(ev, cefSeverityLow, cefSeverityHigh)),
+	}
+	// custom strings come with a label naming them
+	ext := [][3]string{
+		{"rt", "", strconv.FormatInt(ev.OpsTime.UnixNano()/int64(time.Millisecond), 10)},
+		{"externalId", "", ev.Key()},
+		{"dvchost", "", f.Hostname},
+		{"act", "", ev.Action},
+		{"outcome", "", eventOutcome(ev)},
+		{"suser", "", ev.User},
+		{"suid", "", ev.UserId},
+		{"sntdom", "", ev.Domain},
+		{"cs1", "obj_type", ev.ObjType},
+		{"cs2", "obj_id", ev.ObjId},
+		{"cs3", "obj_name", ev.ObjName},
+		{"cs4", "tenant", ev.Project},
+		{"cs5", "owner_tenant_id", ev.OwnerProjectId},
+		{"cs6", "service", ev.Service},
+		{"msg", "", ev.Notes},
+	}
+	pairs := []string{}
+	for _, kv := range ext {
+		if len(kv[2]) == 0 {
+			continue
+		}
+		if len(kv[1]) > 0 {
+			pairs = append(pairs, kv[0]+"Label="+cefExtensionEscaper.Replace(kv[1]))
+		}
+		pairs = append(pairs, kv[0]+"="+cefExtensionEscaper.Replace(kv[2]))
+	}
+	return strings.Join(header, "|") + "|" + strings.Join(pairs, " ")
+}
+
+var (
+	leefHeaderEscaper = strings.NewReplacer(`\`, `\\`, `|`, `\|`, "\r", " ", "\n", " ")
+	// LEEF 1.0 has no escaping of attribute values, the tab delimiter and
+	// line breaks are replaced with spaces
+	leefValueEscaper = strings.NewReplacer("\t", " ", "\r", " ", "\n", " ")
+)
+
+func (f *SFormatter) leef(ev *SEvent) string {
+	header := []string{
+		"LEEF:1.0",
+		leefHeaderEscaper.Replace(VENDOR),
+		leefHeaderEscaper.Replace(PRODUCT),
+		leefHeaderEscaper.Replace(f.Version),
+		leefHeaderEscaper.Replace(ev.ObjType + "." + ev.Action),
+	}
+	attrs := [][2]string{
+		{"devTime", ev.OpsTime.UTC().Format("Jan 02 2006 15:04:05.000 MST")},
+		{"devTimeFormat", "MMM dd yyyy HH:mm:ss.SSS z"},
+		{"cat", ev.Service},
+		{"sev", strconv.Itoa(eventSeverity(ev, cefSeverityLow, cefSeverityHigh))},
+		{"externalId", ev.Key()},
+		{"action", ev.Action},
+		{"outcome", eventOutcome(ev)},
+		{"usrName", ev.User},
+		{"userId", ev.UserId},
+		{"domain", ev.Domain},
+		{"project", ev.Project},
+		{"objType", ev.ObjType},
+		{"objId", ev.ObjId},
+		{"resource", ev.ObjName},
+		{"ownerProjectId", ev.OwnerProjectId},
+		{"msg", ev.Notes},
+	}
+	pairs := []string{}
+	for _, kv := range attrs {
+		if len(kv[1]) == 0 {
+			continue
+		}
+		pairs = append(pairs, kv[0]+"="+leefValueEscaper.Replace(kv[1]))
+	}
+	return strings.Join(header, "|") + "|" + strings.Join(pairs, "\t")
+}
+
+func (f *SFormatter) json(ev *SEvent) string {
+	data := jsonutils.Marshal(ev).(*jsonutils.JSONDict)
+	data.Set("id", jsonutils.NewString(ev.Key()))
+	if len(f.Hostname) > 0 {
+		data.Set("host", jsonutils.NewString(f.Hostname))
+	}
+	// notes are mostly json encoded, keep them structured
+	if notes, err := jsonutils.ParseString(ev.Notes); err == nil {
+		data.Set("notes", notes)
+	}
+	return data.String()
+}
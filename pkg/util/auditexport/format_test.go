@@ -0,0 +1,109 @@
+// Copyright 2019 Yunion
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package auditexport
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"yunion.io/x/jsonutils"
+)
+
+func testEvent() *SEvent {
+	return &SEvent{
+		Id:      42,
+		Source:  "actionlog",
+		Service: "compute",
+		ObjType: "server",
+		ObjId:   "a3b1",
+		ObjName: `vm "one"]`,
+		Action:  "delete",
+		Notes:   `{"reason":"a=b|c"}`,
+		Success: false,
+		UserId:  "u1",
+		User:    "alice",
+		Domain:  "Default",
+		Project: "system",
+		OpsTime: time.Date(2020, 3, 4, 5, 6, 7, 890000000, time.UTC),
+	}
+}
+
+func testFormatter(format string) *SFormatter {
+	return &SFormatter{Name: format, Hostname: "logger-0", Version: "v3.0"}
+}
+
+func TestFormatRFC5424(t *testing.T) {
+	got := string(testFormatter(FORMAT_RFC5424).Format(testEvent()))
+	want := `<108>1 2020-03-04T05:06:07.890000Z logger-0 compute - delete [onecloud@32473 id="actionlog/42" obj_type="server" obj_id="a3b1" obj_name="vm \"one\"\]" action="delete" success="false" user_id="u1" user="alice" tenant="system"] {"reason":"a=b|c"}`
+	if got != want {
+		t.Errorf("want\n%s\ngot\n%s", want, got)
+	}
+
+	ev := testEvent()
+	ev.Success = true
+	ev.Service = ""
+	ev.Action = "change config"
+	got = string(testFormatter(FORMAT_RFC5424).Format(ev))
+	if !strings.HasPrefix(got, "<109>1 2020-03-04T05:06:07.890000Z logger-0 - - change_config [") {
+		t.Errorf("unexpected header %s", got)
+	}
+}
+
+func TestFormatSyslog(t *testing.T) {
+	got := string(testFormatter(FORMAT_CEF).FormatSyslog(testEvent()))
+	if !strings.HasPrefix(got, "<108>1 2020-03-04T05:06:07.890000Z logger-0 compute - delete - CEF:0|") {
+		t.Errorf("unexpected syslog wrapped cef %s", got)
+	}
+}
+
+func TestFormatCEF(t *testing.T) {
+	got := string(testFormatter(FORMAT_CEF).Format(testEvent()))
+	want := `CEF:0|Yunion|OneCloud|v3.0|server.delete|delete server|7|rt=1583298367890 externalId=actionlog/42 dvchost=logger-0 act=delete outcome=failure suser=alice suid=u1 sntdom=Default cs1Label=obj_type cs1=server cs2Label=obj_id cs2=a3b1 cs3Label=obj_name cs3=vm "one"] cs4Label=tenant cs4=system cs6Label=service cs6=compute msg={"reason":"a\=b|c"}`
+	if got != want {
+		t.Errorf("want\n%s\ngot\n%s", want, got)
+	}
+}
+
+func TestFormatLEEF(t *testing.T) {
+	ev := testEvent()
+	ev.Notes = "line1\nline2\tend"
+	got := string(testFormatter(FORMAT_LEEF).Format(ev))
+	if !strings.HasPrefix(got, "LEEF:1.0|Yunion|OneCloud|v3.0|server.delete|devTime=Mar 04 2020 05:06:07.890 UTC\t") {
+		t.Errorf("unexpected leef header %s", got)
+	}
+	if !strings.HasSuffix(got, "\tmsg=line1 line2 end") {
+		t.Errorf("unexpected leef msg %s", got)
+	}
+	if strings.Contains(got, "\n") {
+		t.Errorf("leef contains line break %q", got)
+	}
+}
+
+func TestFormatJSON(t *testing.T) {
+	got, err := jsonutils.Parse(testFormatter(FORMAT_JSON).Format(testEvent()))
+	if err != nil {
+		t.Fatalf("parse json: %v", err)
+	}
+	if id, _ := got.GetString("id"); id != "actionlog/42" {
+		t.Errorf("want id actionlog/42, got %s", id)
+	}
+	if reason, _ := got.GetString("notes", "reason"); reason != "a=b|c" {
+		t.Errorf("notes are not structured: %s", got)
+	}
+	if success, err := got.Bool("success"); err != nil || success {
+		t.Errorf("want success false, got %s", got)
+	}
+}
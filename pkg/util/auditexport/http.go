@@ -0,0 +1,76 @@
+// Copyright 2019 Yunion
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package auditexport
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+
+	"yunion.io/x/pkg/errors"
+)
+
+// sHttpSender posts a batch of messages as one request, one message per line
+type sHttpSender struct {
+	conf   SConfig
+	client *http.Client
+}
+
+func newHttpSender(conf SConfig) *sHttpSender {
+	transport := &http.Transport{
+		Proxy:           http.ProxyFromEnvironment,
+		TLSClientConfig: conf.tlsConfig(),
+	}
+	return &sHttpSender{
+		conf: conf,
+		client: &http.Client{
+			Transport: transport,
+			Timeout:   conf.timeout(),
+		},
+	}
+}
+
+func (s *sHttpSender) Send(ctx context.Context, msgs []SMessage) error {
+	body := bytes.Buffer{}
+	for _, msg := range msgs {
+		body.Write(msg.Value)
+		body.WriteByte('\n')
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, s.conf.Address, &body)
+	if err != nil {
+		return errors.Wrap(err, "NewRequest")
+	}
+	req.Header.Set("Content-Type", "application/x-ndjson")
+	for k, v := range s.conf.Headers {
+		req.Header.Set(k, v)
+	}
+	resp, err := s.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		msg, _ := ioutil.ReadAll(http.MaxBytesReader(nil, resp.Body, 1024))
+		return fmt.Errorf("unexpected status %s: %s", resp.Status, msg)
+	}
+	return nil
+}
+
+func (s *sHttpSender) Close() error {
+	s.client.CloseIdleConnections()
+	return nil
+}
@@ -0,0 +1,83 @@
+// Copyright 2019 Yunion
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package auditexport
+
+import (
+	"context"
+	"strings"
+
+	"github.com/Shopify/sarama"
+
+	"yunion.io/x/pkg/errors"
+)
+
+// sKafkaSender produces messages keyed by the event with acks from all in
+// sync replicas
+type sKafkaSender struct {
+	topic    string
+	producer sarama.SyncProducer
+}
+
+func newKafkaSender(conf SConfig) (*sKafkaSender, error) {
+	config := sarama.NewConfig()
+	config.ClientID = "onecloud-audit-exporter"
+	config.Version = sarama.V0_10_0_0
+	config.Net.DialTimeout = conf.timeout()
+	config.Net.ReadTimeout = conf.timeout()
+	config.Net.WriteTimeout = conf.timeout()
+	if conf.Tls {
+		config.Net.TLS.Enable = true
+		config.Net.TLS.Config = conf.tlsConfig()
+	}
+	config.Producer.RequiredAcks = sarama.WaitForAll
+	config.Producer.Return.Successes = true
+	config.Producer.Return.Errors = true
+	config.Producer.Retry.Max = 3
+	// keep the messages of the same key in order
+	config.Producer.Partitioner = sarama.NewHashPartitioner
+
+	brokers := strings.Split(conf.Address, ",")
+	for i := range brokers {
+		brokers[i] = strings.TrimSpace(brokers[i])
+	}
+	producer, err := sarama.NewSyncProducer(brokers, config)
+	if err != nil {
+		return nil, errors.Wrapf(err, "connect kafka %s", conf.Address)
+	}
+	return &sKafkaSender{
+		topic:    conf.Topic,
+		producer: producer,
+	}, nil
+}
+
+func (s *sKafkaSender) Send(ctx context.Context, msgs []SMessage) error {
+	pmsgs := make([]*sarama.ProducerMessage, len(msgs))
+	for i := range msgs {
+		pmsgs[i] = &sarama.ProducerMessage{
+			Topic: s.topic,
+			Key:   sarama.StringEncoder(msgs[i].Key),
+			Value: sarama.ByteEncoder(msgs[i].Value),
+		}
+	}
+	err := s.producer.SendMessages(pmsgs)
+	if err != nil {
+		return errors.Wrapf(err, "produce to %s", s.topic)
+	}
+	return nil
+}
+
+func (s *sKafkaSender) Close() error {
+	return s.producer.Close()
+}
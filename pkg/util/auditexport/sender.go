@@ -0,0 +1,219 @@
+// Copyright 2019 Yunion
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package auditexport
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"net/url"
+	"strings"
+	"time"
+
+	"yunion.io/x/pkg/errors"
+
+	"yunion.io/x/onecloud/pkg/httperrors"
+)
+
+const (
+	TYPE_SYSLOG = "syslog"
+	TYPE_KAFKA  = "kafka"
+	TYPE_HTTP   = "http"
+
+	DEFAULT_TIMEOUT = 10 * time.Second
+)
+
+var Types = []string{
+	TYPE_SYSLOG,
+	TYPE_KAFKA,
+	TYPE_HTTP,
+}
+
+// SConfig describes the receiver of exported events
+type SConfig struct {
+	Type string
+	// host:port of the syslog server, comma separated host:port of kafka
+	// brokers or url of the http collector
+	Address string
+	// kafka topic
+	Topic string
+
+	Tls                bool
+	InsecureSkipVerify bool
+	// PEM encoded CA certificates to verify the receiver, system roots are
+	// used if empty
+	CaCert string
+
+	// extra headers of http requests, e.g. Authorization
+	Headers map[string]string
+
+	Timeout time.Duration
+}
+
+// SMessage is a formatted event, Key identifies the event for deduplication
+type SMessage struct {
+	Key   string
+	Value []byte
+}
+
+type ISender interface {
+	// Send returns nil only after all messages are accepted by the receiver,
+	// the messages are sent again after a failure
+	Send(ctx context.Context, msgs []SMessage) error
+	Close() error
+}
+
+// ParseUrl parses receivers given as url, which are
+// syslog://host:port, syslog+tls://host:port, kafka://broker1,broker2/topic,
+// kafka+tls://broker1,broker2/topic and http(s)://collector/path
+func ParseUrl(u string) (SConfig, error) {
+	conf := SConfig{}
+	parsed, err := url.Parse(u)
+	if err != nil {
+		return conf, errors.Wrapf(err, "parse %q", u)
+	}
+	scheme := strings.ToLower(parsed.Scheme)
+	switch scheme {
+	case "syslog", "syslog+tls":
+		conf.Type = TYPE_SYSLOG
+		conf.Address = parsed.Host
+		conf.Tls = scheme == "syslog+tls"
+	case "kafka", "kafka+tls":
+		conf.Type = TYPE_KAFKA
+		conf.Address = parsed.Host
+		conf.Topic = strings.Trim(parsed.Path, "/")
+		conf.Tls = scheme == "kafka+tls"
+	case "http", "https":
+		conf.Type = TYPE_HTTP
+		conf.Address = u
+	default:
+		return conf, errors.Errorf("unsupported scheme %q", parsed.Scheme)
+	}
+	return conf, conf.Validate()
+}
+
+func (conf SConfig) Validate() error {
+	switch conf.Type {
+	case TYPE_SYSLOG:
+		if len(conf.Address) == 0 {
+			return httperrors.NewMissingParameterError("address")
+		}
+	case TYPE_KAFKA:
+		if len(conf.Address) == 0 {
+			return httperrors.NewMissingParameterError("address")
+		}
+		if len(conf.Topic) == 0 {
+			return httperrors.NewMissingParameterError("topic")
+		}
+	case TYPE_HTTP:
+		parsed, err := url.Parse(conf.Address)
+		if err != nil {
+			return httperrors.NewInputParameterError("invalid url %q: %s", conf.Address, err)
+		}
+		if parsed.Scheme != "http" && parsed.Scheme != "https" {
+			return httperrors.NewInputParameterError("unsupported url scheme %q", parsed.Scheme)
+		}
+		if len(parsed.Host) == 0 {
+			return httperrors.NewInputParameterError("missing host of url %q", conf.Address)
+		}
+	default:
+		return httperrors.NewInputParameterError("unsupported type %q, must be one of %s", conf.Type, strings.Join(Types, ","))
+	}
+	if len(conf.CaCert) > 0 {
+		if !x509.NewCertPool().AppendCertsFromPEM([]byte(conf.CaCert)) {
+			return httperrors.NewInputParameterError("invalid ca_cert")
+		}
+	}
+	return nil
+}
+
+func (conf SConfig) tlsConfig() *tls.Config {
+	tlsConf := &tls.Config{
+		InsecureSkipVerify: conf.InsecureSkipVerify,
+	}
+	if len(conf.CaCert) > 0 {
+		pool := x509.NewCertPool()
+		pool.AppendCertsFromPEM([]byte(conf.CaCert))
+		tlsConf.RootCAs = pool
+	}
+	return tlsConf
+}
+
+func (conf SConfig) timeout() time.Duration {
+	if conf.Timeout <= 0 {
+		return DEFAULT_TIMEOUT
+	}
+	return conf.Timeout
+}
+
+func NewSender(conf SConfig) (ISender, error) {
+	err := conf.Validate()
+	if err != nil {
+		return nil, err
+	}
+	switch conf.Type {
+	case TYPE_SYSLOG:
+		return newSyslogSender(conf), nil
+	case TYPE_KAFKA:
+		return newKafkaSender(conf)
+	default:
+		return newHttpSender(conf), nil
+	}
+}
+
+// SExporter formats events and sends them to the receiver
+type SExporter struct {
+	formatter *SFormatter
+	sender    ISender
+	syslog    bool
+}
+
+func NewExporter(conf SConfig, format string) (*SExporter, error) {
+	formatter, err := NewFormatter(format)
+	if err != nil {
+		return nil, err
+	}
+	sender, err := NewSender(conf)
+	if err != nil {
+		return nil, err
+	}
+	return &SExporter{
+		formatter: formatter,
+		sender:    sender,
+		syslog:    conf.Type == TYPE_SYSLOG,
+	}, nil
+}
+
+// Export sends the events in order, it returns nil only when all of them
+// are accepted by the receiver
+func (e *SExporter) Export(ctx context.Context, events []SEvent) error {
+	if len(events) == 0 {
+		return nil
+	}
+	msgs := make([]SMessage, len(events))
+	for i := range events {
+		msgs[i].Key = events[i].Key()
+		if e.syslog {
+			msgs[i].Value = e.formatter.FormatSyslog(&events[i])
+		} else {
+			msgs[i].Value = e.formatter.Format(&events[i])
+		}
+	}
+	return e.sender.Send(ctx, msgs)
+}
+
+func (e *SExporter) Close() error {
+	return e.sender.Close()
+}
@@ -0,0 +1,162 @@
+// Copyright 2019 Yunion
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package auditexport
+
+import (
+	"bufio"
+	"context"
+	"io"
+	"io/ioutil"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"testing"
+)
+
+func TestParseUrl(t *testing.T) {
+	cases := []struct {
+		url  string
+		want SConfig
+	}{
+		{
+			url:  "syslog://10.0.0.1:514",
+			want: SConfig{Type: TYPE_SYSLOG, Address: "10.0.0.1:514"},
+		},
+		{
+			url:  "syslog+tls://siem.example.com:6514",
+			want: SConfig{Type: TYPE_SYSLOG, Address: "siem.example.com:6514", Tls: true},
+		},
+		{
+			url:  "kafka://k1:9092,k2:9092/audit",
+			want: SConfig{Type: TYPE_KAFKA, Address: "k1:9092,k2:9092", Topic: "audit"},
+		},
+		{
+			url:  "https://collector.example.com/v1/events",
+			want: SConfig{Type: TYPE_HTTP, Address: "https://collector.example.com/v1/events"},
+		},
+	}
+	for _, c := range cases {
+		got, err := ParseUrl(c.url)
+		if err != nil {
+			t.Errorf("parse %s: %v", c.url, err)
+			continue
+		}
+		if got.Type != c.want.Type || got.Address != c.want.Address || got.Topic != c.want.Topic || got.Tls != c.want.Tls {
+			t.Errorf("parse %s: want %#v, got %#v", c.url, c.want, got)
+		}
+	}
+	for _, u := range []string{"kafka://k1:9092", "ftp://host/", "syslog://"} {
+		if _, err := ParseUrl(u); err == nil {
+			t.Errorf("parse %s should fail", u)
+		}
+	}
+}
+
+// readOctetCounted reads a message framed by RFC6587 octet counting
+func readOctetCounted(r *bufio.Reader) (string, error) {
+	lenStr, err := r.ReadString(' ')
+	if err != nil {
+		return "", err
+	}
+	n, err := strconv.Atoi(strings.TrimSpace(lenStr))
+	if err != nil {
+		return "", err
+	}
+	buf := make([]byte, n)
+	_, err = io.ReadFull(r, buf)
+	return string(buf), err
+}
+
+func TestSyslogSender(t *testing.T) {
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatalf("listen: %v", err)
+	}
+	defer listener.Close()
+	received := make(chan string, 4)
+	go func() {
+		conn, err := listener.Accept()
+		if err != nil {
+			return
+		}
+		defer conn.Close()
+		r := bufio.NewReader(conn)
+		for {
+			msg, err := readOctetCounted(r)
+			if err != nil {
+				close(received)
+				return
+			}
+			received <- msg
+		}
+	}()
+
+	sender, err := NewSender(SConfig{Type: TYPE_SYSLOG, Address: listener.Addr().String()})
+	if err != nil {
+		t.Fatalf("new sender: %v", err)
+	}
+	msgs := []SMessage{
+		{Key: "a/1", Value: []byte("<109>1 - - - - - first message")},
+		{Key: "a/2", Value: []byte("<109>1 - - - - - second\nmessage")},
+	}
+	if err := sender.Send(context.Background(), msgs); err != nil {
+		t.Fatalf("send: %v", err)
+	}
+	sender.Close()
+	for _, msg := range msgs {
+		if got := <-received; got != string(msg.Value) {
+			t.Errorf("want %q, got %q", msg.Value, got)
+		}
+	}
+}
+
+func TestHttpSender(t *testing.T) {
+	var body string
+	var auth string
+	status := http.StatusOK
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		data, _ := ioutil.ReadAll(r.Body)
+		body = string(data)
+		auth = r.Header.Get("Authorization")
+		w.WriteHeader(status)
+	}))
+	defer srv.Close()
+
+	sender, err := NewSender(SConfig{
+		Type:    TYPE_HTTP,
+		Address: srv.URL,
+		Headers: map[string]string{"Authorization": "Bearer token"},
+	})
+	if err != nil {
+		t.Fatalf("new sender: %v", err)
+	}
+	defer sender.Close()
+	msgs := []SMessage{{Key: "a/1", Value: []byte(`{"id":1}`)}, {Key: "a/2", Value: []byte(`{"id":2}`)}}
+	if err := sender.Send(context.Background(), msgs); err != nil {
+		t.Fatalf("send: %v", err)
+	}
+	if body != "{\"id\":1}\n{\"id\":2}\n" {
+		t.Errorf("unexpected body %q", body)
+	}
+	if auth != "Bearer token" {
+		t.Errorf("header not sent, got %q", auth)
+	}
+	status = http.StatusServiceUnavailable
+	if err := sender.Send(context.Background(), msgs); err == nil {
+		t.Errorf("send should fail on status %d", status)
+	}
+}
@@ -0,0 +1,102 @@
+// Copyright 2019 Yunion
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package auditexport
+
+import (
+	"bufio"
+	"context"
+	"crypto/tls"
+	"net"
+	"strconv"
+	"sync"
+	"time"
+
+	"yunion.io/x/pkg/errors"
+)
+
+// sSyslogSender sends messages over TCP or TLS with the octet counting
+// framing of RFC6587, the connection is kept open between batches
+type sSyslogSender struct {
+	conf SConfig
+
+	lock sync.Mutex
+	conn net.Conn
+}
+
+func newSyslogSender(conf SConfig) *sSyslogSender {
+	return &sSyslogSender{conf: conf}
+}
+
+func (s *sSyslogSender) connect(ctx context.Context) (net.Conn, error) {
+	dialer := &net.Dialer{Timeout: s.conf.timeout()}
+	conn, err := dialer.DialContext(ctx, "tcp", s.conf.Address)
+	if err != nil {
+		return nil, errors.Wrapf(err, "dial %s", s.conf.Address)
+	}
+	if !s.conf.Tls {
+		return conn, nil
+	}
+	tlsConf := s.conf.tlsConfig()
+	if host, _, err := net.SplitHostPort(s.conf.Address); err == nil {
+		tlsConf.ServerName = host
+	}
+	tlsConn := tls.Client(conn, tlsConf)
+	tlsConn.SetDeadline(time.Now().Add(s.conf.timeout()))
+	if err := tlsConn.Handshake(); err != nil {
+		conn.Close()
+		return nil, errors.Wrapf(err, "tls handshake with %s", s.conf.Address)
+	}
+	return tlsConn, nil
+}
+
+func (s *sSyslogSender) Send(ctx context.Context, msgs []SMessage) error {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	if s.conn == nil {
+		conn, err := s.connect(ctx)
+		if err != nil {
+			return err
+		}
+		s.conn = conn
+	}
+	s.conn.SetWriteDeadline(time.Now().Add(s.conf.timeout()))
+	w := bufio.NewWriter(s.conn)
+	for _, msg := range msgs {
+		w.WriteString(strconv.Itoa(len(msg.Value)))
+		w.WriteByte(' ')
+		w.Write(msg.Value)
+	}
+	if err := w.Flush(); err != nil {
+		// the receiver may have got part of the batch, which is sent
+		// again on a new connection
+		s.conn.Close()
+		s.conn = nil
+		return errors.Wrapf(err, "write to %s", s.conf.Address)
+	}
+	return nil
+}
+
+func (s *sSyslogSender) Close() error {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	if s.conn == nil {
+		return nil
+	}
+	err := s.conn.Close()
+	s.conn = nil
+	return err
+}
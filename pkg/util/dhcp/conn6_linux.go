@@ -0,0 +1,183 @@
+// Copyright 2019 Yunion
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//+build linux
+
+package dhcp
+
+import (
+	"fmt"
+	"net"
+
+	"github.com/google/gopacket"
+	"github.com/google/gopacket/layers"
+	"github.com/mdlayher/raw"
+	"golang.org/x/net/bpf"
+	"golang.org/x/sys/unix"
+
+	"yunion.io/x/onecloud/pkg/util/netutils2"
+)
+
+type rawSocketConn6 struct {
+	conn *raw.Conn
+
+	iface *net.Interface
+	ip    net.IP
+}
+
+func newRawSocketConn6(iface string) (conn6, error) {
+	ifi, err := net.InterfaceByName(iface)
+	if err != nil {
+		return nil, fmt.Errorf("interface by name: %v", err)
+	}
+	ip, err := interfaceToIPv6LinkLocal(ifi)
+	if err != nil {
+		return nil, err
+	}
+
+	// ip6 and ((udp dst port 547) or (icmp6 type router-solicitation)),
+	// packets with extension headers are not handled
+	filter, err := bpf.Assemble([]bpf.Instruction{
+		bpf.LoadAbsolute{Off: 12, Size: 2},
+		bpf.JumpIf{Cond: bpf.JumpEqual, Val: unix.ETH_P_IPV6, SkipFalse: 8},
+		// next header
+		bpf.LoadAbsolute{Off: 20, Size: 1},
+		bpf.JumpIf{Cond: bpf.JumpEqual, Val: unix.IPPROTO_UDP, SkipFalse: 2},
+		// udp dport
+		bpf.LoadAbsolute{Off: 56, Size: 2},
+		bpf.JumpIf{Cond: bpf.JumpEqual, Val: DHCPV6_SERVER_PORT, SkipTrue: 3, SkipFalse: 4},
+		bpf.JumpIf{Cond: bpf.JumpEqual, Val: unix.IPPROTO_ICMPV6, SkipFalse: 3},
+		// icmp6 type
+		bpf.LoadAbsolute{Off: 54, Size: 1},
+		bpf.JumpIf{Cond: bpf.JumpEqual, Val: layers.ICMPv6TypeRouterSolicitation, SkipFalse: 1},
+		bpf.RetConstant{Val: 1500},
+		bpf.RetConstant{Val: 0},
+	})
+	if err != nil {
+		return nil, fmt.Errorf("assemble bpf filter: %v", err)
+	}
+	conn, err := raw.ListenPacket(ifi, unix.ETH_P_IPV6, &raw.Config{
+		NoCumulativeStats: true,
+		Filter:            filter,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("listen packet: %v", err)
+	}
+	return &rawSocketConn6{conn, ifi, ip}, nil
+}
+
+func interfaceToIPv6LinkLocal(ifi *net.Interface) (net.IP, error) {
+	addrs, err := ifi.Addrs()
+	if err != nil {
+		return nil, err
+	}
+	for _, addr := range addrs {
+		if ipnet, ok := addr.(*net.IPNet); ok && ipnet.IP.To4() == nil && ipnet.IP.IsLinkLocalUnicast() {
+			return ipnet.IP, nil
+		}
+	}
+	// addr_gen_mode of the bridge may be none
+	return netutils2.IPV6LinkLocal(ifi.HardwareAddr.String())
+}
+
+func (s *rawSocketConn6) Close() error {
+	return s.conn.Close()
+}
+
+func (s *rawSocketConn6) HardwareAddr() net.HardwareAddr {
+	return s.iface.HardwareAddr
+}
+
+func (s *rawSocketConn6) Recv(b []byte) (*Packet6, error) {
+	n, _, err := s.conn.ReadFrom(b)
+	if err != nil {
+		return nil, fmt.Errorf("Read from errror: %s", err)
+	}
+	p := gopacket.NewPacket(b[:n], layers.LayerTypeEthernet, gopacket.Default)
+	if p.ErrorLayer() != nil {
+		return nil, fmt.Errorf("Failed to decode packet: %v", p.ErrorLayer().Error())
+	}
+	ethLayer := p.Layer(layers.LayerTypeEthernet)
+	ip6Layer := p.Layer(layers.LayerTypeIPv6)
+	if ethLayer == nil || ip6Layer == nil {
+		return nil, fmt.Errorf("Fetch ipv6 layer failed")
+	}
+	pkt := &Packet6{
+		SrcMac: ethLayer.(*layers.Ethernet).SrcMAC,
+		SrcIP:  ip6Layer.(*layers.IPv6).SrcIP,
+	}
+	if p.Layer(layers.LayerTypeICMPv6RouterSolicitation) != nil {
+		pkt.RouterSolicit = true
+		return pkt, nil
+	}
+	udpLayer := p.Layer(layers.LayerTypeUDP)
+	if udpLayer == nil {
+		return nil, fmt.Errorf("Fetch udp layer failed")
+	}
+	dhcp6 := new(layers.DHCPv6)
+	if err := dhcp6.DecodeFromBytes(udpLayer.LayerPayload(), gopacket.NilDecodeFeedback); err != nil {
+		return nil, fmt.Errorf("Decode dhcpv6 packet error %s", err)
+	}
+	pkt.DHCPv6 = dhcp6
+	return pkt, nil
+}
+
+func (s *rawSocketConn6) send(dstIP net.IP, dstMac net.HardwareAddr, hopLimit uint8, l ...gopacket.SerializableLayer) error {
+	eth := &layers.Ethernet{
+		EthernetType: layers.EthernetTypeIPv6,
+		SrcMAC:       s.iface.HardwareAddr,
+		DstMAC:       dstMac,
+	}
+	ip := &layers.IPv6{
+		Version:  6,
+		HopLimit: hopLimit,
+		SrcIP:    s.ip,
+		DstIP:    dstIP,
+	}
+	switch ll := l[0].(type) {
+	case *layers.UDP:
+		ip.NextHeader = layers.IPProtocolUDP
+		ll.SetNetworkLayerForChecksum(ip)
+	case *layers.ICMPv6:
+		ip.NextHeader = layers.IPProtocolICMPv6
+		ll.SetNetworkLayerForChecksum(ip)
+	}
+	var (
+		buf  = gopacket.NewSerializeBuffer()
+		opts = gopacket.SerializeOptions{ComputeChecksums: true, FixLengths: true}
+	)
+	if err := gopacket.SerializeLayers(buf, opts, append([]gopacket.SerializableLayer{eth, ip}, l...)...); err != nil {
+		return fmt.Errorf("SerializeLayers error: %s", err)
+	}
+	if _, err := s.conn.WriteTo(buf.Bytes(), &raw.Addr{HardwareAddr: dstMac}); err != nil {
+		return fmt.Errorf("Send packet error %s", err)
+	}
+	return nil
+}
+
+func (s *rawSocketConn6) SendDHCPv6(resp *layers.DHCPv6, dstIP net.IP, dstMac net.HardwareAddr) error {
+	udp := &layers.UDP{
+		SrcPort: DHCPV6_SERVER_PORT,
+		DstPort: DHCPV6_CLIENT_PORT,
+	}
+	return s.send(dstIP, dstMac, 64, udp, resp)
+}
+
+func (s *rawSocketConn6) SendRouterAdvertisement(ra *layers.ICMPv6RouterAdvertisement, dstIP net.IP, dstMac net.HardwareAddr) error {
+	icmp := &layers.ICMPv6{
+		TypeCode: layers.CreateICMPv6TypeCode(layers.ICMPv6TypeRouterAdvertisement, 0),
+	}
+	// neighbor discovery messages must be sent with hop limit 255
+	return s.send(dstIP, dstMac, 255, icmp, ra)
+}
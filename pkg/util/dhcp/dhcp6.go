@@ -0,0 +1,222 @@
+// Copyright 2019 Yunion
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package dhcp
+
+import (
+	"encoding/binary"
+	"fmt"
+	"net"
+	"strings"
+	"time"
+
+	"github.com/google/gopacket/layers"
+)
+
+const (
+	DHCPV6_SERVER_PORT = 547
+	DHCPV6_CLIENT_PORT = 546
+
+	duidTypeLL     = 3
+	hwTypeEthernet = 1
+
+	statusSuccess = 0
+
+	raFlagManaged = 0x80
+	raFlagOther   = 0x40
+
+	prefixFlagOnLink = 0x80
+)
+
+// ResponseConfig6 is what the server knows about the requesting nic
+type ResponseConfig6 struct {
+	// ServerMac is used to build the server DUID
+	ServerMac net.HardwareAddr
+
+	ClientIP6 net.IP
+	PrefixLen int
+
+	DNSServers []net.IP
+	Domain     string
+
+	// RenewalTime and RebindTime are T1 and T2 of the IA_NA
+	RenewalTime       time.Duration
+	RebindTime        time.Duration
+	PreferredLifetime time.Duration
+	ValidLifetime     time.Duration
+
+	MTU int
+	// RouterLifetime of 0 tells guests not to use the advertiser as
+	// default router
+	RouterLifetime time.Duration
+}
+
+func seconds(d time.Duration) uint32 {
+	return uint32(d / time.Second)
+}
+
+func (conf *ResponseConfig6) serverDUID() []byte {
+	duid := make([]byte, 4, 4+len(conf.ServerMac))
+	binary.BigEndian.PutUint16(duid[0:], duidTypeLL)
+	binary.BigEndian.PutUint16(duid[2:], hwTypeEthernet)
+	return append(duid, conf.ServerMac...)
+}
+
+func getDHCPv6Option(pkt *layers.DHCPv6, code layers.DHCPv6Opt) (layers.DHCPv6Option, bool) {
+	for _, opt := range pkt.Options {
+		if opt.Code == code {
+			return opt, true
+		}
+	}
+	return layers.DHCPv6Option{}, false
+}
+
+func encodeDomainList(domains []string) []byte {
+	var buf []byte
+	for _, domain := range domains {
+		for _, label := range strings.Split(strings.Trim(domain, "."), ".") {
+			if len(label) == 0 || len(label) > 63 {
+				continue
+			}
+			buf = append(buf, byte(len(label)))
+			buf = append(buf, label...)
+		}
+		buf = append(buf, 0)
+	}
+	return buf
+}
+
+func statusCodeOption(code uint16, msg string) layers.DHCPv6Option {
+	data := make([]byte, 2, 2+len(msg))
+	binary.BigEndian.PutUint16(data, code)
+	return layers.NewDHCPv6Option(layers.DHCPv6OptStatusCode, append(data, msg...))
+}
+
+func (conf *ResponseConfig6) ianaOption(iaid []byte) layers.DHCPv6Option {
+	addr := make([]byte, 24)
+	copy(addr, conf.ClientIP6.To16())
+	binary.BigEndian.PutUint32(addr[16:], seconds(conf.PreferredLifetime))
+	binary.BigEndian.PutUint32(addr[20:], seconds(conf.ValidLifetime))
+	addrOpt := layers.NewDHCPv6Option(layers.DHCPv6OptIAAddr, addr)
+
+	data := make([]byte, 12, 12+4+len(addr))
+	copy(data, iaid)
+	binary.BigEndian.PutUint32(data[4:], seconds(conf.RenewalTime))
+	binary.BigEndian.PutUint32(data[8:], seconds(conf.RebindTime))
+	hdr := make([]byte, 4)
+	binary.BigEndian.PutUint16(hdr, uint16(addrOpt.Code))
+	binary.BigEndian.PutUint16(hdr[2:], addrOpt.Length)
+	data = append(data, hdr...)
+	data = append(data, addrOpt.Data...)
+	return layers.NewDHCPv6Option(layers.DHCPv6OptIANA, data)
+}
+
+// MakeDHCPv6ReplyPacket answers a client message of stateful dhcpv6.
+// Solicit is answered with Advertise, or Reply when the client asks for
+// rapid commit, the other messages are answered with Reply.
+func MakeDHCPv6ReplyPacket(req *layers.DHCPv6, conf *ResponseConfig6) (*layers.DHCPv6, error) {
+	clientId, ok := getDHCPv6Option(req, layers.DHCPv6OptClientID)
+	if !ok {
+		return nil, fmt.Errorf("dhcpv6 %s without client id", req.MsgType)
+	}
+	if serverId, ok := getDHCPv6Option(req, layers.DHCPv6OptServerID); ok {
+		if string(serverId.Data) != string(conf.serverDUID()) {
+			// destined to another server
+			return nil, nil
+		}
+	}
+
+	resp := &layers.DHCPv6{
+		MsgType:       layers.DHCPv6MsgTypeReply,
+		TransactionID: req.TransactionID,
+	}
+	withAddr := true
+	switch req.MsgType {
+	case layers.DHCPv6MsgTypeSolicit:
+		if _, ok := getDHCPv6Option(req, layers.DHCPv6OptRapidCommit); ok {
+			resp.Options = append(resp.Options, layers.NewDHCPv6Option(layers.DHCPv6OptRapidCommit, nil))
+		} else {
+			resp.MsgType = layers.DHCPv6MsgTypeAdverstise
+		}
+	case layers.DHCPv6MsgTypeRequest, layers.DHCPv6MsgTypeRenew, layers.DHCPv6MsgTypeRebind:
+	case layers.DHCPv6MsgTypeConfirm, layers.DHCPv6MsgTypeRelease, layers.DHCPv6MsgTypeDecline:
+		withAddr = false
+		resp.Options = append(resp.Options, statusCodeOption(statusSuccess, "success"))
+	case layers.DHCPv6MsgTypeInformationRequest:
+		withAddr = false
+	default:
+		return nil, nil
+	}
+
+	resp.Options = append(resp.Options,
+		layers.NewDHCPv6Option(layers.DHCPv6OptClientID, clientId.Data),
+		layers.NewDHCPv6Option(layers.DHCPv6OptServerID, conf.serverDUID()),
+	)
+	if withAddr {
+		iana, ok := getDHCPv6Option(req, layers.DHCPv6OptIANA)
+		if ok && len(iana.Data) >= 4 && conf.ClientIP6 != nil {
+			resp.Options = append(resp.Options, conf.ianaOption(iana.Data[:4]))
+		}
+	}
+	if req.MsgType == layers.DHCPv6MsgTypeConfirm || req.MsgType == layers.DHCPv6MsgTypeRelease || req.MsgType == layers.DHCPv6MsgTypeDecline {
+		return resp, nil
+	}
+	if len(conf.DNSServers) > 0 {
+		dns := make([]byte, 0, 16*len(conf.DNSServers))
+		for _, ip := range conf.DNSServers {
+			dns = append(dns, ip.To16()...)
+		}
+		resp.Options = append(resp.Options, layers.NewDHCPv6Option(layers.DHCPv6OptDNSServers, dns))
+	}
+	if len(conf.Domain) > 0 {
+		resp.Options = append(resp.Options, layers.NewDHCPv6Option(layers.DHCPv6OptDomainList, encodeDomainList([]string{conf.Domain})))
+	}
+	return resp, nil
+}
+
+// MakeRouterAdvertisement tells guests to configure addresses by stateful
+// dhcpv6 and announces the on-link prefix without autonomous flag
+func MakeRouterAdvertisement(conf *ResponseConfig6) *layers.ICMPv6RouterAdvertisement {
+	ra := &layers.ICMPv6RouterAdvertisement{
+		HopLimit:       64,
+		Flags:          raFlagManaged | raFlagOther,
+		RouterLifetime: uint16(seconds(conf.RouterLifetime)),
+	}
+	ra.Options = append(ra.Options, layers.ICMPv6Option{
+		Type: layers.ICMPv6OptSourceAddress,
+		Data: []byte(conf.ServerMac),
+	})
+	if conf.MTU > 0 {
+		mtu := make([]byte, 6)
+		binary.BigEndian.PutUint32(mtu[2:], uint32(conf.MTU))
+		ra.Options = append(ra.Options, layers.ICMPv6Option{
+			Type: layers.ICMPv6OptMTU,
+			Data: mtu,
+		})
+	}
+	if conf.ClientIP6 != nil && conf.PrefixLen > 0 {
+		prefix := conf.ClientIP6.Mask(net.CIDRMask(conf.PrefixLen, 128))
+		info := make([]byte, 30)
+		info[0] = byte(conf.PrefixLen)
+		info[1] = prefixFlagOnLink
+		binary.BigEndian.PutUint32(info[2:], seconds(conf.ValidLifetime))
+		binary.BigEndian.PutUint32(info[6:], seconds(conf.PreferredLifetime))
+		copy(info[14:], prefix)
+		ra.Options = append(ra.Options, layers.ICMPv6Option{
+			Type: layers.ICMPv6OptPrefixInfo,
+			Data: info,
+		})
+	}
+	return ra
+}
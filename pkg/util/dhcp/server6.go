@@ -0,0 +1,117 @@
+// Copyright 2019 Yunion
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package dhcp
+
+import (
+	"net"
+	"runtime/debug"
+
+	"github.com/google/gopacket/layers"
+
+	"yunion.io/x/log"
+)
+
+// Packet6 is either a dhcpv6 client message or a router solicitation
+type Packet6 struct {
+	SrcMac net.HardwareAddr
+	SrcIP  net.IP
+
+	DHCPv6        *layers.DHCPv6
+	RouterSolicit bool
+}
+
+type conn6 interface {
+	Recv(b []byte) (*Packet6, error)
+	SendDHCPv6(resp *layers.DHCPv6, dstIP net.IP, dstMac net.HardwareAddr) error
+	SendRouterAdvertisement(ra *layers.ICMPv6RouterAdvertisement, dstIP net.IP, dstMac net.HardwareAddr) error
+	HardwareAddr() net.HardwareAddr
+	Close() error
+}
+
+type DHCPv6Handler interface {
+	ServeDHCPv6(pkt *layers.DHCPv6, mac net.HardwareAddr) (*layers.DHCPv6, error)
+	ServeRouterSolicit(mac net.HardwareAddr) (*layers.ICMPv6RouterAdvertisement, error)
+}
+
+type DHCPv6Server struct {
+	conn conn6
+}
+
+// raw socket
+func NewDHCPv6Server(iface string) (*DHCPv6Server, error) {
+	conn, err := newRawSocketConn6(iface)
+	if err != nil {
+		return nil, err
+	}
+	return &DHCPv6Server{conn: conn}, nil
+}
+
+func (s *DHCPv6Server) HardwareAddr() net.HardwareAddr {
+	return s.conn.HardwareAddr()
+}
+
+func (s *DHCPv6Server) ListenAndServe(handler DHCPv6Handler) error {
+	defer s.conn.Close()
+	buf := make([]byte, 1500)
+	for {
+		pkt, err := s.conn.Recv(buf)
+		if err != nil {
+			log.Errorf("Receiving DHCPv6 packet: %s", err)
+			continue
+		}
+		go s.serve(handler, pkt)
+	}
+}
+
+func (s *DHCPv6Server) serve(handler DHCPv6Handler, pkt *Packet6) {
+	defer func() {
+		if r := recover(); r != nil {
+			log.Errorf("Serve panic error: %v", r)
+			debug.PrintStack()
+		}
+	}()
+
+	// reply to link local address of the client, or to all nodes when
+	// the solicitation is sent from the unspecified address
+	dstIP := pkt.SrcIP
+	if dstIP.IsUnspecified() {
+		dstIP = net.IPv6linklocalallnodes
+	}
+	if pkt.RouterSolicit {
+		ra, err := handler.ServeRouterSolicit(pkt.SrcMac)
+		if err != nil {
+			log.Warningf("[DHCPv6] handler serve router solicit error: %v", err)
+			return
+		}
+		if ra == nil {
+			return
+		}
+		if err := s.conn.SendRouterAdvertisement(ra, dstIP, pkt.SrcMac); err != nil {
+			log.Errorf("[DHCPv6] failed to send router advertisement to %s: %v", pkt.SrcMac, err)
+		}
+		return
+	}
+	resp, err := handler.ServeDHCPv6(pkt.DHCPv6, pkt.SrcMac)
+	if err != nil {
+		log.Warningf("[DHCPv6] handler serve error: %v", err)
+		return
+	}
+	if resp == nil {
+		return
+	}
+	if err := s.conn.SendDHCPv6(resp, dstIP, pkt.SrcMac); err != nil {
+		log.Errorf("[DHCPv6] failed to response packet for %s: %v", pkt.SrcMac, err)
+	}
+}
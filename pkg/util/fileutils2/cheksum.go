@@ -82,6 +82,15 @@ func SHA256(filename string) (string, error) {
 	return fmt.Sprintf("%x", sums[0]), nil
 }
 
+// MD5SHA256 calculates both md5 and sha256 checksums in one pass
+func MD5SHA256(filename string) (string, string, error) {
+	sums, err := FileHash(filename, []hash.Hash{md5.New(), sha256.New()})
+	if err != nil {
+		return "", "", err
+	}
+	return fmt.Sprintf("%x", sums[0]), fmt.Sprintf("%x", sums[1]), nil
+}
+
 func SHA512(filename string) (string, error) {
 	sums, err := FileHash(filename, []hash.Hash{sha512.New()})
 	if err != nil {
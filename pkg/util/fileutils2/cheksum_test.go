@@ -0,0 +1,48 @@
+// Copyright 2019 Yunion
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package fileutils2
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestMD5SHA256(t *testing.T) {
+	dir, err := ioutil.TempDir("", "checksum")
+	if err != nil {
+		t.Fatalf("TempDir: %s", err)
+	}
+	defer os.RemoveAll(dir)
+
+	filename := filepath.Join(dir, "file")
+	if err := ioutil.WriteFile(filename, []byte("abc"), 0644); err != nil {
+		t.Fatalf("WriteFile: %s", err)
+	}
+	md5sum, sha256sum, err := MD5SHA256(filename)
+	if err != nil {
+		t.Fatalf("MD5SHA256: %s", err)
+	}
+	if want := "900150983cd24fb0d6963f7d28e17f72"; md5sum != want {
+		t.Errorf("md5 want %s got %s", want, md5sum)
+	}
+	if want := "ba7816bf8f01cfea414140de5dae2223b00361a396177a9cb410ff61f20015ad"; sha256sum != want {
+		t.Errorf("sha256 want %s got %s", want, sha256sum)
+	}
+	if sum, _ := SHA256(filename); sum != sha256sum {
+		t.Errorf("SHA256 mismatch %s != %s", sum, sha256sum)
+	}
+}
@@ -0,0 +1,197 @@
+// Copyright 2019 Yunion
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ignition
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/ghodss/yaml"
+
+	"yunion.io/x/pkg/errors"
+)
+
+const (
+	ErrNotIgnition        = errors.Error("NotIgnitionConfig")
+	ErrUnsupportedVersion = errors.Error("UnsupportedVersion")
+	ErrUnsupportedField   = errors.Error("UnsupportedField")
+)
+
+// butane spec version to ignition spec version
+var butaneVersions = map[string]map[string]string{
+	VARIANT_FCOS: {
+		"1.0.0": "3.0.0",
+		"1.1.0": "3.1.0",
+		"1.2.0": "3.2.0",
+		"1.3.0": "3.2.0",
+		"1.4.0": "3.3.0",
+		"1.5.0": "3.4.0",
+	},
+	VARIANT_FLATCAR: {
+		"1.0.0": "3.3.0",
+		"1.1.0": "3.4.0",
+	},
+}
+
+// butane sugar that needs files from the machine running butane or
+// extra translation, not available when translating on the host
+var butaneUnsupported = map[string]bool{
+	"local":                     true,
+	"trees":                     true,
+	"ssh_authorized_keys_local": true,
+	"with_mount_unit":           true,
+	"boot_device":               true,
+	"kernel_arguments":          true,
+}
+
+type SUserConfig struct {
+	// fcos or flatcar, empty for plain ignition configs
+	Variant string
+	// Ignition json config
+	Config []byte
+}
+
+func isIgnitionJSON(data string) bool {
+	data = strings.TrimSpace(data)
+	if !strings.HasPrefix(data, "{") {
+		return false
+	}
+	conf := struct {
+		Ignition struct {
+			Version string `json:"version"`
+		} `json:"ignition"`
+	}{}
+	if err := json.Unmarshal([]byte(data), &conf); err != nil {
+		return false
+	}
+	return strings.HasPrefix(conf.Ignition.Version, "3.")
+}
+
+func isButane(data string) bool {
+	conf := struct {
+		Variant string `json:"variant"`
+		Version string `json:"version"`
+	}{}
+	if err := yaml.Unmarshal([]byte(data), &conf); err != nil {
+		return false
+	}
+	_, ok := butaneVersions[conf.Variant]
+	return ok && len(conf.Version) > 0
+}
+
+// IsUserConfig tells whether user data is an Ignition v3 or Butane config
+func IsUserConfig(data string) bool {
+	return isIgnitionJSON(data) || isButane(data)
+}
+
+// ParseUserConfig accepts user data as Ignition v3 json or Butane yaml and
+// returns the Ignition json config
+func ParseUserConfig(data string) (*SUserConfig, error) {
+	if isIgnitionJSON(data) {
+		return &SUserConfig{Config: []byte(strings.TrimSpace(data))}, nil
+	}
+	if !isButane(data) {
+		return nil, ErrNotIgnition
+	}
+	return translateButane(data)
+}
+
+func translateButane(data string) (*SUserConfig, error) {
+	raw := map[string]interface{}{}
+	if err := yaml.Unmarshal([]byte(data), &raw); err != nil {
+		return nil, errors.Wrap(err, "yaml.Unmarshal")
+	}
+	variant, _ := raw["variant"].(string)
+	version, _ := raw["version"].(string)
+	ignVersion, ok := butaneVersions[variant][version]
+	if !ok {
+		return nil, errors.Wrapf(ErrUnsupportedVersion, "%s %s", variant, version)
+	}
+	delete(raw, "variant")
+	delete(raw, "version")
+
+	conf, err := translateButaneValue(raw, "")
+	if err != nil {
+		return nil, err
+	}
+	confMap := conf.(map[string]interface{})
+	ign, _ := confMap["ignition"].(map[string]interface{})
+	if ign == nil {
+		ign = map[string]interface{}{}
+	}
+	ign["version"] = ignVersion
+	confMap["ignition"] = ign
+
+	ret, err := json.Marshal(confMap)
+	if err != nil {
+		return nil, errors.Wrap(err, "json.Marshal")
+	}
+	return &SUserConfig{Variant: variant, Config: ret}, nil
+}
+
+// translateButaneValue renames snake_case keys to the camelCase used by
+// ignition and turns inline contents into data urls
+func translateButaneValue(val interface{}, field string) (interface{}, error) {
+	switch v := val.(type) {
+	case map[string]interface{}:
+		ret := map[string]interface{}{}
+		for k, sv := range v {
+			if butaneUnsupported[k] {
+				return nil, errors.Wrapf(ErrUnsupportedField, "%s.%s", field, k)
+			}
+			path := k
+			if len(field) > 0 {
+				path = field + "." + k
+			}
+			if k == "inline" {
+				content, ok := sv.(string)
+				if !ok {
+					return nil, errors.Wrapf(ErrUnsupportedField, "%s is not a string", path)
+				}
+				ret["source"] = DataURL([]byte(content))
+				continue
+			}
+			nv, err := translateButaneValue(sv, path)
+			if err != nil {
+				return nil, err
+			}
+			ret[snakeToCamel(k)] = nv
+		}
+		return ret, nil
+	case []interface{}:
+		ret := make([]interface{}, len(v))
+		for i := range v {
+			nv, err := translateButaneValue(v[i], fmt.Sprintf("%s[%d]", field, i))
+			if err != nil {
+				return nil, err
+			}
+			ret[i] = nv
+		}
+		return ret, nil
+	default:
+		return val, nil
+	}
+}
+
+func snakeToCamel(s string) string {
+	parts := strings.Split(s, "_")
+	for i := 1; i < len(parts); i++ {
+		if len(parts[i]) > 0 {
+			parts[i] = strings.ToUpper(parts[i][:1]) + parts[i][1:]
+		}
+	}
+	return strings.Join(parts, "")
+}
@@ -0,0 +1,258 @@
+// Copyright 2019 Yunion
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ignition
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"net/url"
+)
+
+// Ignition spec version of generated configs, supported by Fedora CoreOS
+// and Flatcar Container Linux since 2021
+const SPEC_VERSION = "3.3.0"
+
+const (
+	VARIANT_FCOS    = "fcos"
+	VARIANT_FLATCAR = "flatcar"
+
+	DEFAULT_USER = "core"
+)
+
+type SResource struct {
+	Source string `json:"source,omitempty"`
+}
+
+type SIgnitionConfig struct {
+	Merge []SResource `json:"merge,omitempty"`
+}
+
+type SIgnition struct {
+	Version string           `json:"version"`
+	Config  *SIgnitionConfig `json:"config,omitempty"`
+}
+
+type SPasswdUser struct {
+	Name              string   `json:"name"`
+	PasswordHash      string   `json:"passwordHash,omitempty"`
+	SshAuthorizedKeys []string `json:"sshAuthorizedKeys,omitempty"`
+	Groups            []string `json:"groups,omitempty"`
+}
+
+type SPasswd struct {
+	Users []SPasswdUser `json:"users,omitempty"`
+}
+
+type SFile struct {
+	Path      string    `json:"path"`
+	Mode      *int      `json:"mode,omitempty"`
+	Overwrite *bool     `json:"overwrite,omitempty"`
+	Contents  SResource `json:"contents"`
+}
+
+type SStorage struct {
+	Files []SFile `json:"files,omitempty"`
+}
+
+type SDropin struct {
+	Name     string `json:"name"`
+	Contents string `json:"contents,omitempty"`
+}
+
+type SUnit struct {
+	Name     string    `json:"name"`
+	Enabled  *bool     `json:"enabled,omitempty"`
+	Mask     *bool     `json:"mask,omitempty"`
+	Contents string    `json:"contents,omitempty"`
+	Dropins  []SDropin `json:"dropins,omitempty"`
+}
+
+type SSystemd struct {
+	Units []SUnit `json:"units,omitempty"`
+}
+
+// SConfig is the subset of the Ignition v3 config that is generated for
+// guests, anything beyond it is left to user supplied configs merged in
+// through ignition.config.merge
+type SConfig struct {
+	Ignition SIgnition `json:"ignition"`
+	Passwd   SPasswd   `json:"passwd,omitempty"`
+	Storage  SStorage  `json:"storage,omitempty"`
+	Systemd  SSystemd  `json:"systemd,omitempty"`
+}
+
+func NewConfig() *SConfig {
+	return &SConfig{
+		Ignition: SIgnition{Version: SPEC_VERSION},
+	}
+}
+
+// DataURL encodes content as a RFC 2397 data url accepted by
+// ignition resources
+func DataURL(content []byte) string {
+	if len(content) > 1024 {
+		return "data:;base64," + base64.StdEncoding.EncodeToString(content)
+	}
+	return "data:," + url.PathEscape(string(content))
+}
+
+func (c *SConfig) AddUser(name, passwdHash string, pubkeys []string, groups []string) {
+	for i := range c.Passwd.Users {
+		u := &c.Passwd.Users[i]
+		if u.Name != name {
+			continue
+		}
+		if len(passwdHash) > 0 {
+			u.PasswordHash = passwdHash
+		}
+		for _, k := range pubkeys {
+			if !containsString(u.SshAuthorizedKeys, k) {
+				u.SshAuthorizedKeys = append(u.SshAuthorizedKeys, k)
+			}
+		}
+		for _, g := range groups {
+			if !containsString(u.Groups, g) {
+				u.Groups = append(u.Groups, g)
+			}
+		}
+		return
+	}
+	c.Passwd.Users = append(c.Passwd.Users, SPasswdUser{
+		Name:              name,
+		PasswordHash:      passwdHash,
+		SshAuthorizedKeys: pubkeys,
+		Groups:            groups,
+	})
+}
+
+func (c *SConfig) GetUser(name string) *SPasswdUser {
+	for i := range c.Passwd.Users {
+		if c.Passwd.Users[i].Name == name {
+			return &c.Passwd.Users[i]
+		}
+	}
+	return nil
+}
+
+// AddFile adds or replaces the file at spath, mode is the decimal
+// permission bits, 0 means the ignition default 0644
+func (c *SConfig) AddFile(spath, content string, mode int) {
+	overwrite := true
+	f := SFile{
+		Path:      spath,
+		Overwrite: &overwrite,
+		Contents:  SResource{Source: DataURL([]byte(content))},
+	}
+	if mode > 0 {
+		f.Mode = &mode
+	}
+	for i := range c.Storage.Files {
+		if c.Storage.Files[i].Path == spath {
+			c.Storage.Files[i] = f
+			return
+		}
+	}
+	c.Storage.Files = append(c.Storage.Files, f)
+}
+
+func (c *SConfig) HasFile(spath string) bool {
+	for _, f := range c.Storage.Files {
+		if f.Path == spath {
+			return true
+		}
+	}
+	return false
+}
+
+func (c *SConfig) SetHostname(hn string) {
+	c.AddFile("/etc/hostname", hn+"\n", 0644)
+}
+
+func (c *SConfig) AddUnit(name, content string, enabled bool) {
+	u := SUnit{
+		Name:     name,
+		Contents: content,
+	}
+	if enabled {
+		u.Enabled = &enabled
+	}
+	for i := range c.Systemd.Units {
+		if c.Systemd.Units[i].Name == name {
+			u.Dropins = c.Systemd.Units[i].Dropins
+			c.Systemd.Units[i] = u
+			return
+		}
+	}
+	c.Systemd.Units = append(c.Systemd.Units, u)
+}
+
+func (c *SConfig) AddDropin(unit, name, content string) {
+	d := SDropin{Name: name, Contents: content}
+	for i := range c.Systemd.Units {
+		u := &c.Systemd.Units[i]
+		if u.Name != unit {
+			continue
+		}
+		for j := range u.Dropins {
+			if u.Dropins[j].Name == name {
+				u.Dropins[j] = d
+				return
+			}
+		}
+		u.Dropins = append(u.Dropins, d)
+		return
+	}
+	c.Systemd.Units = append(c.Systemd.Units, SUnit{Name: unit, Dropins: []SDropin{d}})
+}
+
+func (c *SConfig) MaskUnit(name string) {
+	mask := true
+	for i := range c.Systemd.Units {
+		if c.Systemd.Units[i].Name == name {
+			c.Systemd.Units[i].Mask = &mask
+			return
+		}
+	}
+	c.Systemd.Units = append(c.Systemd.Units, SUnit{Name: name, Mask: &mask})
+}
+
+// MergeConfig asks ignition to merge the given ignition json config on top
+// of this one, so that user supplied settings win over generated ones
+func (c *SConfig) MergeConfig(conf []byte) {
+	if c.Ignition.Config == nil {
+		c.Ignition.Config = &SIgnitionConfig{}
+	}
+	c.Ignition.Config.Merge = append(c.Ignition.Config.Merge, SResource{
+		Source: "data:;base64," + base64.StdEncoding.EncodeToString(conf),
+	})
+}
+
+func (c *SConfig) Marshal() ([]byte, error) {
+	return json.Marshal(c)
+}
+
+func (c *SConfig) String() string {
+	data, _ := c.Marshal()
+	return string(data)
+}
+
+func containsString(arr []string, s string) bool {
+	for _, a := range arr {
+		if a == s {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,212 @@
+// Copyright 2019 Yunion
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ignition
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"yunion.io/x/onecloud/pkg/cloudcommon/types"
+)
+
+func TestSConfig(t *testing.T) {
+	conf := NewConfig()
+	conf.SetHostname("fcos1")
+	conf.AddUser(DEFAULT_USER, "", []string{"ssh-rsa AAAA"}, nil)
+	conf.AddUser(DEFAULT_USER, "$2a$10$xxx", []string{"ssh-rsa AAAA", "ssh-ed25519 BBBB"}, []string{"wheel"})
+	conf.AddUnit("hello.service", "[Service]\nExecStart=/bin/true\n", true)
+	conf.AddDropin("hello.service", "10-env.conf", "[Service]\nEnvironment=A=1\n")
+	conf.AddUnit("hello.service", "[Service]\nExecStart=/bin/false\n", true)
+	conf.AddNetworks(VARIANT_FCOS, []*types.SServerNic{
+		{Index: 0, Mac: "00:22:11:33:44:55", Ip: "10.0.0.2", Masklen: 24, Gateway: "10.0.0.1", Dns: "8.8.8.8,1.1.1.1", Manual: true},
+		{Index: 1, Mac: "00:22:11:33:44:56", Ip: "10.1.0.2", Masklen: 24},
+	}, "example.com")
+	conf.MergeConfig([]byte(`{"ignition":{"version":"3.3.0"}}`))
+
+	data, err := conf.Marshal()
+	if err != nil {
+		t.Fatalf("marshal: %v", err)
+	}
+	ret := SConfig{}
+	if err := json.Unmarshal(data, &ret); err != nil {
+		t.Fatalf("unmarshal: %v", err)
+	}
+	if ret.Ignition.Version != SPEC_VERSION {
+		t.Errorf("version %s", ret.Ignition.Version)
+	}
+	if len(ret.Passwd.Users) != 1 {
+		t.Fatalf("users %#v", ret.Passwd.Users)
+	}
+	u := ret.Passwd.Users[0]
+	if u.PasswordHash != "$2a$10$xxx" || len(u.SshAuthorizedKeys) != 2 || len(u.Groups) != 1 {
+		t.Errorf("user %#v", u)
+	}
+	if len(ret.Systemd.Units) != 1 || len(ret.Systemd.Units[0].Dropins) != 1 || !strings.Contains(ret.Systemd.Units[0].Contents, "false") {
+		t.Errorf("units %#v", ret.Systemd.Units)
+	}
+	if len(ret.Storage.Files) != 3 {
+		t.Fatalf("files %#v", ret.Storage.Files)
+	}
+	if ret.Storage.Files[0].Contents.Source != "data:,fcos1%0A" {
+		t.Errorf("hostname %s", ret.Storage.Files[0].Contents.Source)
+	}
+	if *ret.Storage.Files[1].Mode != 0600 {
+		t.Errorf("nmconnection mode %o", *ret.Storage.Files[1].Mode)
+	}
+	if ret.Ignition.Config == nil || len(ret.Ignition.Config.Merge) != 1 {
+		t.Errorf("merge %#v", ret.Ignition.Config)
+	}
+}
+
+func TestNetworkConfig(t *testing.T) {
+	nic := &types.SServerNic{
+		Index:    0,
+		Mac:      "00:22:11:33:44:55",
+		Ip:       "10.0.0.2",
+		Masklen:  24,
+		Gateway:  "10.0.0.1",
+		Dns:      "8.8.8.8",
+		Mtu:      1450,
+		Manual:   true,
+		Ip6:      "fd00::2",
+		Masklen6: 64,
+		Gateway6: "fd00::1",
+		Routes:   []types.SRoute{{"192.168.0.0/16", "10.0.0.254"}},
+	}
+	want := `[Match]
+MACAddress=00:22:11:33:44:55
+
+[Link]
+MTUBytes=1450
+
+[Network]
+Address=10.0.0.2/24
+Gateway=10.0.0.1
+Address=fd00::2/64
+DNS=8.8.8.8
+Domains=example.com
+Gateway=fd00::1
+
+[Route]
+Destination=192.168.0.0/16
+Gateway=10.0.0.254
+`
+	if got := NetworkdConfig(nic, true, "example.com"); got != want {
+		t.Errorf("networkd got\n%s\nwant\n%s", got, want)
+	}
+	want = `[connection]
+id=eth0
+type=ethernet
+autoconnect=true
+
+[ethernet]
+mac-address=00:22:11:33:44:55
+mtu=1450
+
+[ipv4]
+method=manual
+address1=10.0.0.2/24,10.0.0.1
+dns=8.8.8.8;
+dns-search=example.com;
+route1=192.168.0.0/16,10.0.0.254
+
+[ipv6]
+method=manual
+address1=fd00::2/64,fd00::1
+`
+	if got := NMConnection(nic, true, "example.com"); got != want {
+		t.Errorf("nmconnection got\n%s\nwant\n%s", got, want)
+	}
+}
+
+func TestParseUserConfig(t *testing.T) {
+	cases := []struct {
+		name    string
+		data    string
+		variant string
+		want    string
+		wantErr bool
+	}{
+		{
+			name: "ignition",
+			data: `{"ignition":{"version":"3.4.0"},"passwd":{"users":[{"name":"core"}]}}`,
+			want: `{"ignition":{"version":"3.4.0"},"passwd":{"users":[{"name":"core"}]}}`,
+		},
+		{
+			name: "butane",
+			data: `variant: fcos
+version: 1.4.0
+passwd:
+  users:
+    - name: core
+      ssh_authorized_keys:
+        - ssh-rsa AAAA
+storage:
+  files:
+    - path: /etc/motd
+      mode: 0644
+      contents:
+        inline: hello world
+`,
+			variant: VARIANT_FCOS,
+			want:    `{"ignition":{"version":"3.3.0"},"passwd":{"users":[{"name":"core","sshAuthorizedKeys":["ssh-rsa AAAA"]}]},"storage":{"files":[{"contents":{"source":"data:,hello%20world"},"mode":420,"path":"/etc/motd"}]}}`,
+		},
+		{
+			name: "butane local file",
+			data: `variant: flatcar
+version: 1.0.0
+storage:
+  files:
+    - path: /etc/motd
+      contents:
+        local: motd
+`,
+			wantErr: true,
+		},
+		{
+			name: "butane unknown version",
+			data: `variant: fcos
+version: 9.9.9
+`,
+			wantErr: true,
+		},
+		{
+			name:    "cloud-config",
+			data:    "#cloud-config\nhostname: test\n",
+			wantErr: true,
+		},
+	}
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			conf, err := ParseUserConfig(c.data)
+			if c.wantErr {
+				if err == nil {
+					t.Fatalf("want error, got %s", conf.Config)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("ParseUserConfig: %v", err)
+			}
+			if conf.Variant != c.variant {
+				t.Errorf("variant got %q want %q", conf.Variant, c.variant)
+			}
+			if string(conf.Config) != c.want {
+				t.Errorf("got\n%s\nwant\n%s", conf.Config, c.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,173 @@
+// Copyright 2019 Yunion
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ignition
+
+import (
+	"fmt"
+	"strings"
+
+	"yunion.io/x/onecloud/pkg/cloudcommon/types"
+)
+
+func nicName(nic *types.SServerNic) string {
+	return fmt.Sprintf("10-eth%d", nic.Index)
+}
+
+func splitDns(dns string) []string {
+	ret := []string{}
+	for _, d := range strings.Split(dns, ",") {
+		d = strings.TrimSpace(d)
+		if len(d) > 0 {
+			ret = append(ret, d)
+		}
+	}
+	return ret
+}
+
+// NetworkdConfig renders a systemd-networkd .network file matching nic by
+// mac address, used by Flatcar
+func NetworkdConfig(nic *types.SServerNic, isMain bool, domain string) string {
+	cont := "[Match]\n"
+	cont += fmt.Sprintf("MACAddress=%s\n", nic.Mac)
+	if nic.Mtu > 0 {
+		cont += "\n[Link]\n"
+		cont += fmt.Sprintf("MTUBytes=%d\n", nic.Mtu)
+	}
+	cont += "\n[Network]\n"
+	if nic.Manual {
+		cont += fmt.Sprintf("Address=%s/%d\n", nic.Ip, nic.Masklen)
+		if isMain && len(nic.Gateway) > 0 {
+			cont += fmt.Sprintf("Gateway=%s\n", nic.Gateway)
+		}
+		if len(nic.Ip6) > 0 {
+			cont += fmt.Sprintf("Address=%s/%d\n", nic.Ip6, nic.Masklen6)
+		}
+		for _, dns := range splitDns(nic.Dns) {
+			cont += fmt.Sprintf("DNS=%s\n", dns)
+		}
+		for _, dns := range splitDns(nic.Dns6) {
+			cont += fmt.Sprintf("DNS=%s\n", dns)
+		}
+		if len(domain) > 0 {
+			cont += fmt.Sprintf("Domains=%s\n", domain)
+		}
+	} else if len(nic.Ip6) > 0 {
+		cont += "DHCP=yes\n"
+	} else {
+		cont += "DHCP=ipv4\n"
+	}
+	if isMain && len(nic.Gateway6) > 0 {
+		cont += fmt.Sprintf("Gateway=%s\n", nic.Gateway6)
+	}
+	if !nic.Manual && !isMain {
+		cont += "\n[DHCPv4]\n"
+		cont += "UseRoutes=false\n"
+	}
+	for _, r := range nic.Routes {
+		if len(r) < 2 {
+			continue
+		}
+		cont += "\n[Route]\n"
+		cont += fmt.Sprintf("Destination=%s\n", r[0])
+		cont += fmt.Sprintf("Gateway=%s\n", r[1])
+	}
+	return cont
+}
+
+// NMConnection renders a NetworkManager keyfile connection matching nic by
+// mac address, used by Fedora CoreOS
+func NMConnection(nic *types.SServerNic, isMain bool, domain string) string {
+	name := fmt.Sprintf("eth%d", nic.Index)
+	cont := "[connection]\n"
+	cont += fmt.Sprintf("id=%s\n", name)
+	cont += "type=ethernet\n"
+	cont += "autoconnect=true\n"
+	cont += "\n[ethernet]\n"
+	cont += fmt.Sprintf("mac-address=%s\n", strings.ToUpper(nic.Mac))
+	if nic.Mtu > 0 {
+		cont += fmt.Sprintf("mtu=%d\n", nic.Mtu)
+	}
+	cont += "\n[ipv4]\n"
+	if nic.Manual {
+		cont += "method=manual\n"
+		if isMain && len(nic.Gateway) > 0 {
+			cont += fmt.Sprintf("address1=%s/%d,%s\n", nic.Ip, nic.Masklen, nic.Gateway)
+		} else {
+			cont += fmt.Sprintf("address1=%s/%d\n", nic.Ip, nic.Masklen)
+		}
+		if dns := splitDns(nic.Dns); len(dns) > 0 {
+			cont += fmt.Sprintf("dns=%s;\n", strings.Join(dns, ";"))
+		}
+		if len(domain) > 0 {
+			cont += fmt.Sprintf("dns-search=%s;\n", domain)
+		}
+	} else {
+		cont += "method=auto\n"
+		if !isMain {
+			cont += "never-default=true\n"
+		}
+	}
+	for i, r := range nic.Routes {
+		if len(r) < 2 {
+			continue
+		}
+		cont += fmt.Sprintf("route%d=%s,%s\n", i+1, r[0], r[1])
+	}
+	cont += "\n[ipv6]\n"
+	if len(nic.Ip6) == 0 {
+		cont += "method=disabled\n"
+	} else if nic.Manual {
+		cont += "method=manual\n"
+		if isMain && len(nic.Gateway6) > 0 {
+			cont += fmt.Sprintf("address1=%s/%d,%s\n", nic.Ip6, nic.Masklen6, nic.Gateway6)
+		} else {
+			cont += fmt.Sprintf("address1=%s/%d\n", nic.Ip6, nic.Masklen6)
+		}
+		if dns := splitDns(nic.Dns6); len(dns) > 0 {
+			cont += fmt.Sprintf("dns=%s;\n", strings.Join(dns, ";"))
+		}
+	} else {
+		cont += "method=dhcp\n"
+		if isMain && len(nic.Gateway6) > 0 {
+			cont += fmt.Sprintf("gateway=%s\n", nic.Gateway6)
+		}
+	}
+	return cont
+}
+
+// AddNetworks writes network configs of nics in the format native to variant,
+// the first nic with a gateway carries the default route
+func (c *SConfig) AddNetworks(variant string, nics []*types.SServerNic, domain string) {
+	mainIdx := -1
+	for i := range nics {
+		if len(nics[i].Gateway) > 0 {
+			mainIdx = i
+			break
+		}
+	}
+	for i, nic := range nics {
+		if nic.Virtual || len(nic.Mac) == 0 {
+			continue
+		}
+		isMain := i == mainIdx
+		if variant == VARIANT_FLATCAR {
+			spath := fmt.Sprintf("/etc/systemd/network/%s.network", nicName(nic))
+			c.AddFile(spath, NetworkdConfig(nic, isMain, domain), 0644)
+		} else {
+			spath := fmt.Sprintf("/etc/NetworkManager/system-connections/%s.nmconnection", nicName(nic))
+			c.AddFile(spath, NMConnection(nic, isMain, domain), 0600)
+		}
+	}
+}
@@ -41,6 +41,30 @@ func TestNormalizeImageInfo(t *testing.T) {
 			OsType:    "windows",
 			OsVersion: "-",
 		},
+		{
+			Name:      "fedora-coreos-38.20230709.3.0-openstack.x86_64.qcow2",
+			OsDistro:  "Fedora CoreOS",
+			OsType:    "linux",
+			OsVersion: "-",
+		},
+		{
+			Name:      "flatcar_production_openstack_image.img",
+			OsDistro:  "Flatcar",
+			OsType:    "linux",
+			OsVersion: "-",
+		},
+		{
+			Name:      "openSUSE-Leap-15.5-OpenStack.x86_64.qcow2",
+			OsDistro:  "OpenSUSE",
+			OsType:    "linux",
+			OsVersion: "15",
+		},
+		{
+			Name:      "nocloud_alpine-3.18.4-x86_64-bios-cloudinit-r0.qcow2",
+			OsDistro:  "Alpine",
+			OsType:    "linux",
+			OsVersion: "-",
+		},
 		{
 			Name:      "Ubuntu  14.04 32位",
 			OsDistro:  "Ubuntu",
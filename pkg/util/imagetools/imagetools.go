@@ -67,12 +67,18 @@ func normalizeOsDistribution(osDist string, imageName string) string {
 		return "RHEL"
 	} else if strings.Contains(osDist, "ubuntu") {
 		return "Ubuntu"
-	} else if strings.Contains(osDist, "suse") {
-		return "SUSE"
 	} else if strings.Contains(osDist, "opensuse") {
 		return "OpenSUSE"
+	} else if strings.Contains(osDist, "suse") || strings.Contains(osDist, "sles") {
+		return "SUSE"
+	} else if strings.Contains(osDist, "alpine") {
+		return "Alpine"
 	} else if strings.Contains(osDist, "debian") {
 		return "Debian"
+	} else if strings.Contains(osDist, "fedora coreos") || strings.Contains(osDist, "fedora-coreos") || strings.Contains(osDist, "fcos") {
+		return "Fedora CoreOS"
+	} else if strings.Contains(osDist, "flatcar") {
+		return "Flatcar"
 	} else if strings.Contains(osDist, "coreos") {
 		return "CoreOS"
 	} else if strings.Contains(osDist, "aliyun") {
@@ -99,16 +105,19 @@ func normalizeOsDistribution(osDist string, imageName string) string {
 }
 
 var imageVersions = map[string][]string{
-	"CentOS":   {"5", "6", "7"},
-	"RHEL":     {"5", "6", "7", "8"},
-	"FreeBSD":  {"10", "11", "12"},
-	"Ubuntu":   {"10", "12", "14", "16", "18", "19"},
-	"OpenSUSE": {"11", "12"},
-	"SUSE":     {"10", "11", "12", "13"},
-	"Debian":   {"6", "7", "8", "9", "10"},
-	"CoreOS":   {"7"},
-	"EulerOS":  {"2"},
-	"Aliyun":   {},
+	"CentOS":        {"5", "6", "7"},
+	"RHEL":          {"5", "6", "7", "8"},
+	"FreeBSD":       {"10", "11", "12", "13", "14"},
+	"Ubuntu":        {"10", "12", "14", "16", "18", "19"},
+	"OpenSUSE":      {"11", "12", "15"},
+	"SUSE":          {"10", "11", "12", "13", "15"},
+	"Alpine":        {},
+	"Debian":        {"6", "7", "8", "9", "10"},
+	"CoreOS":        {"7"},
+	"Fedora CoreOS": {},
+	"Flatcar":       {},
+	"EulerOS":       {"2"},
+	"Aliyun":        {},
 }
 
 func normalizeOsVersion(imageName string, osDist string, osVersion string) string {
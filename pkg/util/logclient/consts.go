@@ -108,8 +108,9 @@ const (
 	ACT_OPEN_PUBLIC_CONNECTION  = "open_public_connection"
 	ACT_CLOSE_PUBLIC_CONNECTION = "close_public_connection"
 
-	ACT_IMAGE_SAVE  = "image_save"
-	ACT_IMAGE_PROBE = "image_probe"
+	ACT_IMAGE_SAVE   = "image_save"
+	ACT_IMAGE_PROBE  = "image_probe"
+	ACT_IMAGE_VERIFY = "image_verify"
 
 	ACT_AUTHENTICATE = "authenticate"
 
@@ -155,8 +156,10 @@ const (
 	ACT_NATGATEWAY_DISSOCIATE   = "natgateway_dissociate"
 	ACT_LOADBALANCER_DISSOCIATE = "loadbalancer_dissociate"
 
-	ACT_PREPARE = "prepare"
-	ACT_PROBE   = "probe"
+	ACT_PREPARE         = "prepare"
+	ACT_PROBE           = "probe"
+	ACT_BIOS_SETTINGS   = "bios_settings"
+	ACT_FIRMWARE_UPDATE = "firmware_update"
 
 	ACT_INSTANCE_GROUP_BIND   = "instance_group_bind"
 	ACT_INSTANCE_GROUP_UNBIND = "instance_group_unbind"
@@ -206,4 +209,7 @@ const (
 	ACT_CLOUDACCOUNT_SYNC_NETWORK = "sync_network"
 
 	ACT_MERGE_NETWORK = "merge_network"
+
+	ACT_CDN_PURGE_CACHE = "cdn_purge_cache"
+	ACT_CDN_PREFETCH    = "cdn_prefetch"
 )
@@ -0,0 +1,180 @@
+// Copyright 2019 Yunion
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package netutils2
+
+import (
+	"math/big"
+	"math/rand"
+	"net"
+	"strings"
+
+	"yunion.io/x/pkg/errors"
+)
+
+const (
+	IPV6_MIN_PREFIX_LEN = 48
+	IPV6_MAX_PREFIX_LEN = 126
+
+	ErrInvalidIPV6 = errors.Error("InvalidIPV6Error")
+)
+
+// ParseIPV6 returns the 16 bytes form of an IPv6 address, rejecting IPv4
+// and IPv4-mapped addresses.
+func ParseIPV6(addr string) (net.IP, error) {
+	if !strings.Contains(addr, ":") {
+		return nil, errors.Wrapf(ErrInvalidIPV6, "not an ipv6 address %q", addr)
+	}
+	ip := net.ParseIP(addr)
+	if ip == nil || ip.To4() != nil {
+		return nil, errors.Wrapf(ErrInvalidIPV6, "invalid ipv6 address %q", addr)
+	}
+	return ip.To16(), nil
+}
+
+func IsIPV6(addr string) bool {
+	_, err := ParseIPV6(addr)
+	return err == nil
+}
+
+// NormalizeIPV6 returns the canonical (RFC 5952) text form of addr
+func NormalizeIPV6(addr string) (string, error) {
+	ip, err := ParseIPV6(addr)
+	if err != nil {
+		return "", err
+	}
+	return ip.String(), nil
+}
+
+// IPV6Network returns the prefix of length masklen containing addr
+func IPV6Network(addr string, masklen int) (*net.IPNet, error) {
+	ip, err := ParseIPV6(addr)
+	if err != nil {
+		return nil, err
+	}
+	if masklen < 0 || masklen > 128 {
+		return nil, errors.Wrapf(ErrInvalidIPV6, "invalid ipv6 prefix length %d", masklen)
+	}
+	mask := net.CIDRMask(masklen, 128)
+	return &net.IPNet{IP: ip.Mask(mask), Mask: mask}, nil
+}
+
+func ipv6ToInt(ip net.IP) *big.Int {
+	return new(big.Int).SetBytes(ip.To16())
+}
+
+func intToIPV6(i *big.Int) net.IP {
+	buf := i.Bytes()
+	ip := make(net.IP, net.IPv6len)
+	if len(buf) > net.IPv6len {
+		buf = buf[len(buf)-net.IPv6len:]
+	}
+	copy(ip[net.IPv6len-len(buf):], buf)
+	return ip
+}
+
+// IPV6Step returns the address n steps after ip, n may be negative
+func IPV6Step(ip net.IP, n int64) net.IP {
+	v := ipv6ToInt(ip)
+	v.Add(v, big.NewInt(n))
+	if v.Sign() < 0 {
+		v.SetInt64(0)
+	}
+	return intToIPV6(v)
+}
+
+func IPV6StepUp(ip net.IP) net.IP {
+	return IPV6Step(ip, 1)
+}
+
+func IPV6StepDown(ip net.IP) net.IP {
+	return IPV6Step(ip, -1)
+}
+
+type IPV6AddrRange struct {
+	start net.IP
+	end   net.IP
+}
+
+func NewIPV6AddrRange(start, end string) (IPV6AddrRange, error) {
+	r := IPV6AddrRange{}
+	s, err := ParseIPV6(start)
+	if err != nil {
+		return r, errors.Wrap(err, "start")
+	}
+	e, err := ParseIPV6(end)
+	if err != nil {
+		return r, errors.Wrap(err, "end")
+	}
+	if ipv6ToInt(s).Cmp(ipv6ToInt(e)) > 0 {
+		s, e = e, s
+	}
+	r.start, r.end = s, e
+	return r, nil
+}
+
+func (r IPV6AddrRange) StartIp() net.IP {
+	return r.start
+}
+
+func (r IPV6AddrRange) EndIp() net.IP {
+	return r.end
+}
+
+func (r IPV6AddrRange) Contains(ip net.IP) bool {
+	if ip == nil || ip.To4() != nil {
+		return false
+	}
+	v := ipv6ToInt(ip)
+	return ipv6ToInt(r.start).Cmp(v) <= 0 && v.Cmp(ipv6ToInt(r.end)) <= 0
+}
+
+func (r IPV6AddrRange) ContainsRange(r2 IPV6AddrRange) bool {
+	return r.Contains(r2.start) && r.Contains(r2.end)
+}
+
+func (r IPV6AddrRange) IsOverlap(r2 IPV6AddrRange) bool {
+	return ipv6ToInt(r.start).Cmp(ipv6ToInt(r2.end)) <= 0 && ipv6ToInt(r2.start).Cmp(ipv6ToInt(r.end)) <= 0
+}
+
+// Random returns a random address within the range
+func (r IPV6AddrRange) Random() net.IP {
+	s := ipv6ToInt(r.start)
+	size := new(big.Int).Sub(ipv6ToInt(r.end), s)
+	size.Add(size, big.NewInt(1))
+	off := new(big.Int).Rand(rand.New(rand.NewSource(rand.Int63())), size)
+	return intToIPV6(off.Add(off, s))
+}
+
+func (r IPV6AddrRange) String() string {
+	return r.start.String() + "-" + r.end.String()
+}
+
+// IPV6LinkLocal returns the EUI-64 link local address derived from mac
+func IPV6LinkLocal(mac string) (net.IP, error) {
+	hw, err := net.ParseMAC(mac)
+	if err != nil {
+		return nil, errors.Wrapf(err, "parse mac %q", mac)
+	}
+	if len(hw) != 6 {
+		return nil, errors.Wrapf(ErrInvalidIPV6, "invalid mac %q", mac)
+	}
+	ip := make(net.IP, net.IPv6len)
+	ip[0], ip[1] = 0xfe, 0x80
+	ip[8] = hw[0] ^ 0x02
+	ip[9], ip[10] = hw[1], hw[2]
+	ip[11], ip[12] = 0xff, 0xfe
+	ip[13], ip[14], ip[15] = hw[3], hw[4], hw[5]
+	return ip, nil
+}
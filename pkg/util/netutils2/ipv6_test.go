@@ -0,0 +1,100 @@
+// Copyright 2019 Yunion
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package netutils2
+
+import (
+	"net"
+	"testing"
+)
+
+func TestNormalizeIPV6(t *testing.T) {
+	cases := []struct {
+		in      string
+		want    string
+		wantErr bool
+	}{
+		{"2001:DB8:0:0:0:0:0:1", "2001:db8::1", false},
+		{"fd00::0a", "fd00::a", false},
+		{"10.0.0.1", "", true},
+		{"::ffff:10.0.0.1", "", true},
+		{"fd00::g", "", true},
+	}
+	for _, c := range cases {
+		got, err := NormalizeIPV6(c.in)
+		if (err != nil) != c.wantErr {
+			t.Errorf("NormalizeIPV6(%q) err %v, wantErr %v", c.in, err, c.wantErr)
+			continue
+		}
+		if got != c.want {
+			t.Errorf("NormalizeIPV6(%q) = %q, want %q", c.in, got, c.want)
+		}
+	}
+}
+
+func TestIPV6Network(t *testing.T) {
+	n, err := IPV6Network("fd00:1:2:3::10", 64)
+	if err != nil {
+		t.Fatalf("IPV6Network: %v", err)
+	}
+	if n.String() != "fd00:1:2:3::/64" {
+		t.Errorf("got %s", n.String())
+	}
+}
+
+func TestIPV6Step(t *testing.T) {
+	ip := net.ParseIP("fd00::ffff:ffff")
+	if got := IPV6StepUp(ip).String(); got != "fd00::1:0:0" {
+		t.Errorf("StepUp got %s", got)
+	}
+	if got := IPV6StepDown(net.ParseIP("fd00::1:0:0")).String(); got != "fd00::ffff:ffff" {
+		t.Errorf("StepDown got %s", got)
+	}
+}
+
+func TestIPV6AddrRange(t *testing.T) {
+	r, err := NewIPV6AddrRange("fd00::ff", "fd00::2")
+	if err != nil {
+		t.Fatalf("NewIPV6AddrRange: %v", err)
+	}
+	if r.String() != "fd00::2-fd00::ff" {
+		t.Errorf("range %s", r.String())
+	}
+	if !r.Contains(net.ParseIP("fd00::10")) || r.Contains(net.ParseIP("fd00::1")) || r.Contains(net.ParseIP("10.0.0.2")) {
+		t.Errorf("Contains mismatch")
+	}
+	r2, _ := NewIPV6AddrRange("fd00::100", "fd00::200")
+	if r.IsOverlap(r2) {
+		t.Errorf("unexpected overlap")
+	}
+	r3, _ := NewIPV6AddrRange("fd00::f0", "fd00::200")
+	if !r.IsOverlap(r3) {
+		t.Errorf("expected overlap")
+	}
+	for i := 0; i < 32; i++ {
+		if ip := r.Random(); !r.Contains(ip) {
+			t.Errorf("random %s out of range", ip)
+		}
+	}
+}
+
+func TestIPV6LinkLocal(t *testing.T) {
+	ip, err := IPV6LinkLocal("00:22:33:44:55:66")
+	if err != nil {
+		t.Fatalf("IPV6LinkLocal: %v", err)
+	}
+	if ip.String() != "fe80::222:33ff:fe44:5566" {
+		t.Errorf("got %s", ip.String())
+	}
+}
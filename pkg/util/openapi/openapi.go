@@ -0,0 +1,209 @@
+// Copyright 2019 Yunion
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package openapi
+
+import (
+	"encoding/json"
+	"reflect"
+	"sort"
+)
+
+const (
+	OPENAPI_VERSION = "3.0.3"
+	SWAGGER_VERSION = "2.0"
+
+	MIME_JSON = "application/json"
+
+	COMPONENT_SCHEMA_PREFIX  = "#/components/schemas/"
+	DEFINITION_SCHEMA_PREFIX = "#/definitions/"
+
+	// well-known paths where services serve their documents
+	OPENAPI_SPEC_PATH = "api-docs/openapi.json"
+	SWAGGER_SPEC_PATH = "api-docs/swagger.json"
+)
+
+// SDocument is an OpenAPI 3 document, only the subset required to
+// describe the REST API of a service is supported
+type SDocument struct {
+	Openapi    string                `json:"openapi"`
+	Info       SInfo                 `json:"info"`
+	Servers    []SServer             `json:"servers,omitempty"`
+	Tags       []STag                `json:"tags,omitempty"`
+	Paths      map[string]*SPathItem `json:"paths"`
+	Components SComponents           `json:"components"`
+
+	schemaNames map[reflect.Type]string
+}
+
+type SInfo struct {
+	Title       string `json:"title"`
+	Description string `json:"description,omitempty"`
+	Version     string `json:"version"`
+}
+
+type SServer struct {
+	Url         string `json:"url"`
+	Description string `json:"description,omitempty"`
+}
+
+type STag struct {
+	Name        string `json:"name"`
+	Description string `json:"description,omitempty"`
+}
+
+type SComponents struct {
+	Schemas map[string]*SSchema `json:"schemas,omitempty"`
+}
+
+type SPathItem struct {
+	Get    *SOperation `json:"get,omitempty"`
+	Put    *SOperation `json:"put,omitempty"`
+	Post   *SOperation `json:"post,omitempty"`
+	Delete *SOperation `json:"delete,omitempty"`
+	Patch  *SOperation `json:"patch,omitempty"`
+	Head   *SOperation `json:"head,omitempty"`
+}
+
+type SOperation struct {
+	Tags        []string              `json:"tags,omitempty"`
+	Summary     string                `json:"summary,omitempty"`
+	OperationId string                `json:"operationId,omitempty"`
+	Parameters  []SParameter          `json:"parameters,omitempty"`
+	RequestBody *SRequestBody         `json:"requestBody,omitempty"`
+	Responses   map[string]*SResponse `json:"responses"`
+}
+
+type SParameter struct {
+	Name        string   `json:"name"`
+	In          string   `json:"in"`
+	Description string   `json:"description,omitempty"`
+	Required    bool     `json:"required,omitempty"`
+	Schema      *SSchema `json:"schema,omitempty"`
+}
+
+type SRequestBody struct {
+	Description string                `json:"description,omitempty"`
+	Required    bool                  `json:"required,omitempty"`
+	Content     map[string]SMediaType `json:"content"`
+}
+
+type SResponse struct {
+	Description string                `json:"description"`
+	Content     map[string]SMediaType `json:"content,omitempty"`
+}
+
+type SMediaType struct {
+	Schema *SSchema `json:"schema,omitempty"`
+}
+
+type SSchema struct {
+	Ref                  string              `json:"$ref,omitempty"`
+	Type                 string              `json:"type,omitempty"`
+	Format               string              `json:"format,omitempty"`
+	Description          string              `json:"description,omitempty"`
+	Default              interface{}         `json:"default,omitempty"`
+	Enum                 []string            `json:"enum,omitempty"`
+	Items                *SSchema            `json:"items,omitempty"`
+	Properties           map[string]*SSchema `json:"properties,omitempty"`
+	Required             []string            `json:"required,omitempty"`
+	AdditionalProperties *SSchema            `json:"additionalProperties,omitempty"`
+}
+
+func NewDocument(title, version string) *SDocument {
+	return &SDocument{
+		Openapi: OPENAPI_VERSION,
+		Info: SInfo{
+			Title:   title,
+			Version: version,
+		},
+		Paths: make(map[string]*SPathItem),
+		Components: SComponents{
+			Schemas: make(map[string]*SSchema),
+		},
+	}
+}
+
+func NewJsonContent(schema *SSchema) map[string]SMediaType {
+	return map[string]SMediaType{MIME_JSON: {Schema: schema}}
+}
+
+// AddOperation registers op at the given path under the http method,
+// an existing operation for the same method and path is kept
+func (doc *SDocument) AddOperation(method string, path string, op *SOperation) {
+	item, ok := doc.Paths[path]
+	if !ok {
+		item = &SPathItem{}
+		doc.Paths[path] = item
+	}
+	switch method {
+	case "GET":
+		if item.Get == nil {
+			item.Get = op
+		}
+	case "PUT":
+		if item.Put == nil {
+			item.Put = op
+		}
+	case "POST":
+		if item.Post == nil {
+			item.Post = op
+		}
+	case "DELETE":
+		if item.Delete == nil {
+			item.Delete = op
+		}
+	case "PATCH":
+		if item.Patch == nil {
+			item.Patch = op
+		}
+	case "HEAD":
+		if item.Head == nil {
+			item.Head = op
+		}
+	}
+}
+
+func (doc *SDocument) AddTag(name, desc string) {
+	for i := range doc.Tags {
+		if doc.Tags[i].Name == name {
+			return
+		}
+	}
+	doc.Tags = append(doc.Tags, STag{Name: name, Description: desc})
+	sort.Slice(doc.Tags, func(i, j int) bool {
+		return doc.Tags[i].Name < doc.Tags[j].Name
+	})
+}
+
+func (item *SPathItem) Operations() map[string]*SOperation {
+	ret := make(map[string]*SOperation)
+	for method, op := range map[string]*SOperation{
+		"get":    item.Get,
+		"put":    item.Put,
+		"post":   item.Post,
+		"delete": item.Delete,
+		"patch":  item.Patch,
+		"head":   item.Head,
+	} {
+		if op != nil {
+			ret[method] = op
+		}
+	}
+	return ret
+}
+
+func (doc *SDocument) Marshal() ([]byte, error) {
+	return json.MarshalIndent(doc, "", "  ")
+}
@@ -0,0 +1,167 @@
+// Copyright 2019 Yunion
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package openapi
+
+import (
+	"reflect"
+	"testing"
+	"time"
+
+	"yunion.io/x/jsonutils"
+)
+
+type TestBaseInput struct {
+	Name        string `json:"name"`
+	Description string `help:"description of resource"`
+}
+
+type testNode struct {
+	Id       string
+	Children []testNode
+}
+
+type testInput struct {
+	TestBaseInput
+
+	// overrides the embedded field
+	Name string `json:"generate_name"`
+
+	Size      int64 `default:"10"`
+	Enabled   *bool
+	Tags      map[string]string
+	CreatedAt time.Time
+	Metadata  jsonutils.JSONObject
+	Node      *testNode
+	Secret    string `json:"-"`
+	Mode      string `choices:"a|b" default:"a"`
+
+	hidden string
+}
+
+func TestSchemaOf(t *testing.T) {
+	doc := NewDocument("test", "v1")
+	schema := doc.SchemaOf(reflect.TypeOf(&testInput{}))
+	if schema.Ref != COMPONENT_SCHEMA_PREFIX+"openapi.testInput" {
+		t.Fatalf("unexpected ref %q", schema.Ref)
+	}
+	input := doc.Components.Schemas["openapi.testInput"]
+	if input == nil {
+		t.Fatalf("testInput not registered")
+	}
+	cases := map[string]SSchema{
+		"name":          {Type: "string"},
+		"generate_name": {Type: "string"},
+		"description":   {Type: "string", Description: "description of resource"},
+		"size":          {Type: "integer", Format: "int64", Default: int64(10)},
+		"enabled":       {Type: "boolean"},
+		"created_at":    {Type: "string", Format: "date-time"},
+		"metadata":      {Type: "object"},
+		"node":          {Ref: COMPONENT_SCHEMA_PREFIX + "openapi.testNode"},
+		"mode":          {Type: "string", Default: "a", Enum: []string{"a", "b"}},
+	}
+	for name, want := range cases {
+		got, ok := input.Properties[name]
+		if !ok {
+			t.Errorf("missing property %s", name)
+			continue
+		}
+		if !reflect.DeepEqual(*got, want) {
+			t.Errorf("property %s: want %#v got %#v", name, want, *got)
+		}
+	}
+	for _, name := range []string{"secret", "hidden", "test_base_input"} {
+		if _, ok := input.Properties[name]; ok {
+			t.Errorf("unexpected property %s", name)
+		}
+	}
+	if tags := input.Properties["tags"]; tags.Type != "object" || tags.AdditionalProperties.Type != "string" {
+		t.Errorf("unexpected map schema %#v", tags)
+	}
+	node := doc.Components.Schemas["openapi.testNode"]
+	if node == nil || node.Properties["children"].Items.Ref != COMPONENT_SCHEMA_PREFIX+"openapi.testNode" {
+		t.Errorf("recursive schema not resolved: %#v", node)
+	}
+}
+
+func TestQueryParameters(t *testing.T) {
+	doc := NewDocument("test", "v1")
+	params := doc.QueryParameters(reflect.TypeOf(testInput{}))
+	names := make(map[string]SParameter)
+	for _, param := range params {
+		if _, ok := names[param.Name]; ok {
+			t.Errorf("duplicate parameter %s", param.Name)
+		}
+		names[param.Name] = param
+		if param.In != "query" {
+			t.Errorf("parameter %s in %s", param.Name, param.In)
+		}
+	}
+	if names["description"].Description != "description of resource" {
+		t.Errorf("help tag not used as description")
+	}
+	if _, ok := names["size"]; !ok {
+		t.Errorf("missing size")
+	}
+	if params := doc.QueryParameters(reflect.TypeOf("")); params != nil {
+		t.Errorf("non struct should have no parameters")
+	}
+}
+
+func TestToSwagger(t *testing.T) {
+	doc := NewDocument("test", "v1")
+	doc.Servers = []SServer{{Url: "https://example.com:8889/api/v1"}}
+	doc.AddOperation("POST", "/nodes", &SOperation{
+		OperationId: "nodes_create",
+		Parameters: []SParameter{
+			{Name: "ids", In: "query", Schema: &SSchema{Type: "array", Items: &SSchema{Type: "string"}}},
+			{Name: "filter", In: "query", Schema: &SSchema{Type: "object"}},
+		},
+		RequestBody: &SRequestBody{
+			Required: true,
+			Content:  NewJsonContent(doc.SchemaOf(reflect.TypeOf(testNode{}))),
+		},
+		Responses: map[string]*SResponse{
+			"200": {Description: "ok", Content: NewJsonContent(&SSchema{Type: "array", Items: doc.SchemaOf(reflect.TypeOf(testNode{}))})},
+		},
+	})
+	swagger := doc.ToSwagger()
+	if swagger.Host != "example.com:8889" || swagger.BasePath != "/api/v1" || swagger.Schemes[0] != "https" {
+		t.Errorf("unexpected server %s %s %v", swagger.Host, swagger.BasePath, swagger.Schemes)
+	}
+	op := swagger.Paths["/nodes"]["post"]
+	if op == nil {
+		t.Fatalf("missing operation")
+	}
+	if len(op.Parameters) != 3 {
+		t.Fatalf("want 3 parameters, got %d", len(op.Parameters))
+	}
+	if op.Parameters[0].Type != "array" || op.Parameters[1].Type != "string" {
+		t.Errorf("unexpected query parameters %#v", op.Parameters[:2])
+	}
+	if op.Parameters[2].In != "body" || op.Parameters[2].Schema.Ref != DEFINITION_SCHEMA_PREFIX+"openapi.testNode" {
+		t.Errorf("unexpected body parameter %#v", op.Parameters[2])
+	}
+	if op.Responses["200"].Schema.Items.Ref != DEFINITION_SCHEMA_PREFIX+"openapi.testNode" {
+		t.Errorf("response ref not converted")
+	}
+	node := swagger.Definitions["openapi.testNode"]
+	if node.Properties["children"].Items.Ref != DEFINITION_SCHEMA_PREFIX+"openapi.testNode" {
+		t.Errorf("definition ref not converted")
+	}
+	// the original document is left untouched
+	if doc.Components.Schemas["openapi.testNode"].Properties["children"].Items.Ref != COMPONENT_SCHEMA_PREFIX+"openapi.testNode" {
+		t.Errorf("original document modified")
+	}
+}
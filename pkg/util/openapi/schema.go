@@ -0,0 +1,232 @@
+// Copyright 2019 Yunion
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package openapi
+
+import (
+	"fmt"
+	"path"
+	"reflect"
+	"strconv"
+	"strings"
+
+	"yunion.io/x/jsonutils"
+	"yunion.io/x/pkg/gotypes"
+	"yunion.io/x/pkg/util/reflectutils"
+)
+
+var (
+	jsonObjectType = reflect.TypeOf((*jsonutils.JSONObject)(nil)).Elem()
+)
+
+// SchemaOf returns the schema of data type t, named structs are
+// registered as components of doc and referred by $ref
+func (doc *SDocument) SchemaOf(t reflect.Type) *SSchema {
+	if t == nil {
+		return &SSchema{Type: "object"}
+	}
+	for t.Kind() == reflect.Ptr {
+		t = t.Elem()
+	}
+	if t == gotypes.TimeType {
+		return &SSchema{Type: "string", Format: "date-time"}
+	}
+	if t.Kind() == reflect.Interface {
+		// jsonutils.JSONObject and interface{} may be any json value
+		return &SSchema{Type: "object"}
+	}
+	if reflect.PtrTo(t).Implements(jsonObjectType) {
+		return &SSchema{Type: "object"}
+	}
+	switch t.Kind() {
+	case reflect.Bool:
+		return &SSchema{Type: "boolean"}
+	case reflect.Int8, reflect.Int16, reflect.Int32, reflect.Uint8, reflect.Uint16, reflect.Uint32:
+		return &SSchema{Type: "integer", Format: "int32"}
+	case reflect.Int, reflect.Int64, reflect.Uint, reflect.Uint64:
+		return &SSchema{Type: "integer", Format: "int64"}
+	case reflect.Float32:
+		return &SSchema{Type: "number", Format: "float"}
+	case reflect.Float64:
+		return &SSchema{Type: "number", Format: "double"}
+	case reflect.String:
+		return &SSchema{Type: "string"}
+	case reflect.Slice, reflect.Array:
+		if t.Elem().Kind() == reflect.Uint8 {
+			return &SSchema{Type: "string", Format: "byte"}
+		}
+		return &SSchema{Type: "array", Items: doc.SchemaOf(t.Elem())}
+	case reflect.Map:
+		return &SSchema{Type: "object", AdditionalProperties: doc.SchemaOf(t.Elem())}
+	case reflect.Struct:
+		if len(t.Name()) == 0 {
+			return doc.structSchema(t)
+		}
+		return &SSchema{Ref: COMPONENT_SCHEMA_PREFIX + doc.registerSchema(t)}
+	}
+	return &SSchema{Type: "string"}
+}
+
+func (doc *SDocument) registerSchema(t reflect.Type) string {
+	if doc.schemaNames == nil {
+		doc.schemaNames = make(map[reflect.Type]string)
+	}
+	if name, ok := doc.schemaNames[t]; ok {
+		return name
+	}
+	base := schemaName(t)
+	name := base
+	for i := 1; ; i++ {
+		if _, ok := doc.Components.Schemas[name]; !ok {
+			break
+		}
+		name = fmt.Sprintf("%s_%d", base, i)
+	}
+	// register before building the properties to break recursive references
+	doc.schemaNames[t] = name
+	doc.Components.Schemas[name] = &SSchema{Type: "object"}
+	doc.Components.Schemas[name] = doc.structSchema(t)
+	return name
+}
+
+func schemaName(t reflect.Type) string {
+	name := t.Name()
+	if len(t.PkgPath()) > 0 {
+		name = path.Base(t.PkgPath()) + "." + name
+	}
+	return strings.Map(func(r rune) rune {
+		if (r >= 'a' && r <= 'z') || (r >= 'A' && r <= 'Z') || (r >= '0' && r <= '9') || r == '.' || r == '_' || r == '-' {
+			return r
+		}
+		return '_'
+	}, name)
+}
+
+func defaultValue(schemaType string, def string) interface{} {
+	switch schemaType {
+	case "boolean":
+		if val, err := strconv.ParseBool(def); err == nil {
+			return val
+		}
+	case "integer":
+		if val, err := strconv.ParseInt(def, 10, 64); err == nil {
+			return val
+		}
+	case "number":
+		if val, err := strconv.ParseFloat(def, 64); err == nil {
+			return val
+		}
+	}
+	return def
+}
+
+type sSchemaField struct {
+	name   string
+	depth  int
+	schema *SSchema
+}
+
+func (doc *SDocument) structSchema(t reflect.Type) *SSchema {
+	fields := make([]sSchemaField, 0)
+	doc.collectFields(t, 0, &fields)
+	schema := &SSchema{Type: "object", Properties: make(map[string]*SSchema)}
+	depths := make(map[string]int)
+	for _, field := range fields {
+		// the shallowest field wins, like jsonutils does when unmarshaling
+		if depth, ok := depths[field.name]; ok && depth <= field.depth {
+			continue
+		}
+		depths[field.name] = field.depth
+		schema.Properties[field.name] = field.schema
+	}
+	return schema
+}
+
+func (doc *SDocument) collectFields(t reflect.Type, depth int, fields *[]sSchemaField) {
+	for i := 0; i < t.NumField(); i++ {
+		sf := t.Field(i)
+		if !gotypes.IsFieldExportable(sf.Name) {
+			continue
+		}
+		if sf.Anonymous {
+			sft := sf.Type
+			if sft.Kind() == reflect.Ptr {
+				sft = sft.Elem()
+			}
+			if sft.Kind() == reflect.Struct && sft != gotypes.TimeType {
+				doc.collectFields(sft, depth+1, fields)
+				continue
+			}
+			if sft.Kind() == reflect.Interface {
+				continue
+			}
+		}
+		info := reflectutils.ParseStructFieldJsonInfo(sf)
+		if info.Ignore {
+			continue
+		}
+		schema := doc.SchemaOf(sf.Type)
+		if len(schema.Ref) == 0 {
+			if help, ok := info.Tags["help"]; ok {
+				schema.Description = help
+			}
+			if def, ok := info.Tags["default"]; ok {
+				schema.Default = defaultValue(schema.Type, def)
+			}
+			if choices, ok := info.Tags["choices"]; ok {
+				schema.Enum = strings.Split(choices, "|")
+			}
+		}
+		*fields = append(*fields, sSchemaField{
+			name:   info.MarshalName(),
+			depth:  depth,
+			schema: schema,
+		})
+	}
+}
+
+// QueryParameters flattens the top level properties of data type t
+// into query parameters, which is how list filters are passed
+func (doc *SDocument) QueryParameters(t reflect.Type) []SParameter {
+	for t != nil && t.Kind() == reflect.Ptr {
+		t = t.Elem()
+	}
+	if t == nil || t.Kind() != reflect.Struct {
+		return nil
+	}
+	fields := make([]sSchemaField, 0)
+	doc.collectFields(t, 0, &fields)
+	params := make([]SParameter, 0, len(fields))
+	depths := make(map[string]int)
+	index := make(map[string]int)
+	for _, field := range fields {
+		if depth, ok := depths[field.name]; ok && depth <= field.depth {
+			continue
+		}
+		param := SParameter{
+			Name:        field.name,
+			In:          "query",
+			Description: field.schema.Description,
+			Schema:      field.schema,
+		}
+		if idx, ok := index[field.name]; ok {
+			params[idx] = param
+		} else {
+			index[field.name] = len(params)
+			params = append(params, param)
+		}
+		depths[field.name] = field.depth
+	}
+	return params
+}
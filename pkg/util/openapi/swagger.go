@@ -0,0 +1,167 @@
+// Copyright 2019 Yunion
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package openapi
+
+import (
+	"encoding/json"
+	"net/url"
+	"strings"
+)
+
+// SSwagger is a Swagger 2.0 document converted from an OpenAPI 3
+// document for the clients and code generators that only speak 2.0
+type SSwagger struct {
+	Swagger     string                                   `json:"swagger"`
+	Info        SInfo                                    `json:"info"`
+	Host        string                                   `json:"host,omitempty"`
+	BasePath    string                                   `json:"basePath,omitempty"`
+	Schemes     []string                                 `json:"schemes,omitempty"`
+	Consumes    []string                                 `json:"consumes,omitempty"`
+	Produces    []string                                 `json:"produces,omitempty"`
+	Tags        []STag                                   `json:"tags,omitempty"`
+	Paths       map[string]map[string]*SSwaggerOperation `json:"paths"`
+	Definitions map[string]*SSchema                      `json:"definitions,omitempty"`
+}
+
+type SSwaggerOperation struct {
+	Tags        []string                     `json:"tags,omitempty"`
+	Summary     string                       `json:"summary,omitempty"`
+	OperationId string                       `json:"operationId,omitempty"`
+	Parameters  []SSwaggerParameter          `json:"parameters,omitempty"`
+	Responses   map[string]*SSwaggerResponse `json:"responses"`
+}
+
+type SSwaggerParameter struct {
+	Name        string   `json:"name"`
+	In          string   `json:"in"`
+	Description string   `json:"description,omitempty"`
+	Required    bool     `json:"required,omitempty"`
+	Type        string   `json:"type,omitempty"`
+	Format      string   `json:"format,omitempty"`
+	Items       *SSchema `json:"items,omitempty"`
+	Schema      *SSchema `json:"schema,omitempty"`
+}
+
+type SSwaggerResponse struct {
+	Description string   `json:"description"`
+	Schema      *SSchema `json:"schema,omitempty"`
+}
+
+func swaggerSchema(schema *SSchema) *SSchema {
+	if schema == nil {
+		return nil
+	}
+	ret := *schema
+	if len(ret.Ref) > 0 {
+		ret.Ref = DEFINITION_SCHEMA_PREFIX + strings.TrimPrefix(ret.Ref, COMPONENT_SCHEMA_PREFIX)
+	}
+	ret.Items = swaggerSchema(schema.Items)
+	ret.AdditionalProperties = swaggerSchema(schema.AdditionalProperties)
+	if schema.Properties != nil {
+		ret.Properties = make(map[string]*SSchema, len(schema.Properties))
+		for k, v := range schema.Properties {
+			ret.Properties[k] = swaggerSchema(v)
+		}
+	}
+	return &ret
+}
+
+func swaggerParameter(param SParameter) SSwaggerParameter {
+	ret := SSwaggerParameter{
+		Name:        param.Name,
+		In:          param.In,
+		Description: param.Description,
+		Required:    param.Required,
+	}
+	if param.Schema != nil {
+		switch param.Schema.Type {
+		case "array":
+			ret.Type = "array"
+			ret.Items = swaggerSchema(param.Schema.Items)
+		case "boolean", "integer", "number", "string":
+			ret.Type = param.Schema.Type
+			ret.Format = param.Schema.Format
+		default:
+			// swagger 2.0 only allows primitive types outside of the body
+			ret.Type = "string"
+		}
+	}
+	return ret
+}
+
+func swaggerOperation(op *SOperation) *SSwaggerOperation {
+	ret := &SSwaggerOperation{
+		Tags:        op.Tags,
+		Summary:     op.Summary,
+		OperationId: op.OperationId,
+		Responses:   make(map[string]*SSwaggerResponse, len(op.Responses)),
+	}
+	for _, param := range op.Parameters {
+		ret.Parameters = append(ret.Parameters, swaggerParameter(param))
+	}
+	if op.RequestBody != nil {
+		ret.Parameters = append(ret.Parameters, SSwaggerParameter{
+			Name:        "body",
+			In:          "body",
+			Description: op.RequestBody.Description,
+			Required:    op.RequestBody.Required,
+			Schema:      swaggerSchema(op.RequestBody.Content[MIME_JSON].Schema),
+		})
+	}
+	for code, resp := range op.Responses {
+		ret.Responses[code] = &SSwaggerResponse{
+			Description: resp.Description,
+			Schema:      swaggerSchema(resp.Content[MIME_JSON].Schema),
+		}
+	}
+	return ret
+}
+
+// ToSwagger converts doc to a Swagger 2.0 document
+func (doc *SDocument) ToSwagger() *SSwagger {
+	ret := &SSwagger{
+		Swagger:     SWAGGER_VERSION,
+		Info:        doc.Info,
+		Consumes:    []string{MIME_JSON},
+		Produces:    []string{MIME_JSON},
+		Tags:        doc.Tags,
+		Paths:       make(map[string]map[string]*SSwaggerOperation, len(doc.Paths)),
+		Definitions: make(map[string]*SSchema, len(doc.Components.Schemas)),
+	}
+	if len(doc.Servers) > 0 {
+		if u, err := url.Parse(doc.Servers[0].Url); err == nil {
+			ret.Host = u.Host
+			ret.BasePath = u.Path
+			if len(u.Scheme) > 0 {
+				ret.Schemes = []string{u.Scheme}
+			}
+		}
+	}
+	for path, item := range doc.Paths {
+		ops := make(map[string]*SSwaggerOperation)
+		for method, op := range item.Operations() {
+			ops[method] = swaggerOperation(op)
+		}
+		ret.Paths[path] = ops
+	}
+	for name, schema := range doc.Components.Schemas {
+		ret.Definitions[name] = swaggerSchema(schema)
+	}
+	return ret
+}
+
+func (swagger *SSwagger) Marshal() ([]byte, error) {
+	return json.MarshalIndent(swagger, "", "  ")
+}
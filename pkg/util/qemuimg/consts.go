@@ -27,6 +27,9 @@ const (
 	VHD   = TImageFormat("vhd")
 	ISO   = TImageFormat("iso")
 	RAW   = TImageFormat("raw")
+
+	// LUKS is a raw volume with a luks header, e.g. an encrypted rbd image
+	LUKS = TImageFormat("luks")
 )
 
 var supportedImageFormats = []TImageFormat{
@@ -63,6 +66,8 @@ func String2ImageFormat(fmt string) TImageFormat {
 		return ISO
 	case "raw":
 		return RAW
+	case "luks":
+		return LUKS
 	}
 	// log.Fatalf("unknown image format!!! %s", fmt)
 	return TImageFormat(fmt)
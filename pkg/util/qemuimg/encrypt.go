@@ -0,0 +1,167 @@
+// Copyright 2019 Yunion
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package qemuimg
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/pkg/errors"
+
+	api "yunion.io/x/onecloud/pkg/apis/compute"
+)
+
+const (
+	ENCRYPT_FORMAT_LUKS = "luks"
+
+	encryptSecretId = "sec0"
+)
+
+// SetEncryptKey sets the base64 encoded key of a luks encrypted qcow2 image,
+// create, resize and convert then open or format the image with it
+func (img *SQemuImage) SetEncryptKey(key string) {
+	img.EncryptKey = key
+}
+
+func (img *SQemuImage) hasEncryptKey() bool {
+	return len(img.EncryptKey) > 0
+}
+
+// secretObjectArgs writes the key to a file only readable by the owner next
+// to the image, so that the key shows up neither in the command line nor in
+// the log, the returned function removes the file after qemu-img exits
+func (img *SQemuImage) secretObjectArgs() ([]string, func(), error) {
+	f, err := ioutil.TempFile(img.secretDir(), ".secret-")
+	if err != nil {
+		return nil, nil, errors.Wrap(err, "create key file")
+	}
+	cleanup := func() {
+		os.Remove(f.Name())
+	}
+	_, err = f.WriteString(img.EncryptKey)
+	f.Close()
+	if err != nil {
+		cleanup()
+		return nil, nil, errors.Wrap(err, "write key file")
+	}
+	secret := fmt.Sprintf("secret,id=%s,file=%s,format=base64", encryptSecretId, strings.ReplaceAll(f.Name(), ",", ",,"))
+	return []string{"--object", secret}, cleanup, nil
+}
+
+// secretDir is the directory of the key file, images not on a local
+// filesystem (e.g. rbd) keep the key file in the temp dir
+func (img *SQemuImage) secretDir() string {
+	if strings.HasPrefix(img.Path, api.STORAGE_RBD) {
+		return ""
+	}
+	return filepath.Dir(img.Path)
+}
+
+func encryptCreateOptions(format TImageFormat) []string {
+	if format == LUKS {
+		return []string{fmt.Sprintf("key-secret=%s", encryptSecretId)}
+	}
+	return []string{
+		fmt.Sprintf("encrypt.format=%s", ENCRYPT_FORMAT_LUKS),
+		fmt.Sprintf("encrypt.key-secret=%s", encryptSecretId),
+	}
+}
+
+func encryptKeySecretOption(format TImageFormat, secretId string) string {
+	if format == LUKS {
+		// luks volume is opened by the luks driver itself
+		return fmt.Sprintf("key-secret=%s", secretId)
+	}
+	return fmt.Sprintf("encrypt.key-secret=%s", secretId)
+}
+
+// EncryptKeySecretOptions returns the block driver options opening every
+// encrypted layer of the backing chain with the secret object secretId
+func (img *SQemuImage) EncryptKeySecretOptions(secretId string) ([]string, error) {
+	opts := make([]string, 0)
+	prefix := ""
+	curr := img
+	for {
+		if curr.Encryption {
+			opts = append(opts, prefix+encryptKeySecretOption(curr.Format, secretId))
+		}
+		if len(curr.BackFilePath) == 0 {
+			break
+		}
+		back, err := NewQemuImage(curr.BackFilePath)
+		if err != nil {
+			return nil, errors.Wrapf(err, "open backing file %s", curr.BackFilePath)
+		}
+		curr = back
+		prefix += "backing."
+	}
+	return opts, nil
+}
+
+// imageOptsArgs describes the image by --image-opts so that the key secret
+// can be attached to the encrypted layers
+func (img *SQemuImage) imageOptsArgs() ([]string, error) {
+	opts := []string{
+		fmt.Sprintf("driver=%s", img.Format.String()),
+		fmt.Sprintf("file.filename=%s", strings.ReplaceAll(img.Path, ",", ",,")),
+	}
+	secretOpts, err := img.EncryptKeySecretOptions(encryptSecretId)
+	if err != nil {
+		return nil, err
+	}
+	opts = append(opts, secretOpts...)
+	return []string{"--image-opts", strings.Join(opts, ",")}, nil
+}
+
+// SecretImageArgs returns the arguments of qemu-img or qemu-nbd opening the
+// image by the key, the returned function removes the key file
+func (img *SQemuImage) SecretImageArgs() ([]string, func(), error) {
+	if !img.hasEncryptKey() {
+		return nil, nil, fmt.Errorf("missing encrypt key of %s", img.Path)
+	}
+	imageOpts, err := img.imageOptsArgs()
+	if err != nil {
+		return nil, nil, errors.Wrap(err, "image opts")
+	}
+	secretArgs, cleanup, err := img.secretObjectArgs()
+	if err != nil {
+		return nil, nil, errors.Wrap(err, "secret object")
+	}
+	return append(secretArgs, imageOpts...), cleanup, nil
+}
+
+// DecryptTo converts an encrypted image to a plain image, e.g. before the
+// image is saved to glance
+func (img *SQemuImage) DecryptTo(output string, format TImageFormat) error {
+	if !img.hasEncryptKey() {
+		return fmt.Errorf("missing encrypt key of %s", img.Path)
+	}
+	return img.doConvertImage(output, format, nil, false, "")
+}
+
+// EncryptTo converts the image to an image encrypted by the key, e.g. an
+// image cached on rbd to a luks volume of an encrypted disk
+func (img *SQemuImage) EncryptTo(output string, format TImageFormat) error {
+	if !img.hasEncryptKey() {
+		return fmt.Errorf("missing encrypt key of %s", img.Path)
+	}
+	if format != QCOW2 && format != LUKS {
+		return fmt.Errorf("format %s does not support encryption", format)
+	}
+	return img.doConvert(output, format, nil, false, "")
+}
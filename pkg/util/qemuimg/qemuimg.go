@@ -60,6 +60,9 @@ type SQemuImage struct {
 	Encryption      bool
 	Subformat       string
 	IoLevel         TIONiceLevel
+
+	// base64 encoded luks key, never persisted
+	EncryptKey string
 }
 
 func NewQemuImage(path string) (*SQemuImage, error) {
@@ -195,6 +198,9 @@ func (img *SQemuImage) parse() error {
 			return fmt.Errorf("read output fail %s", err)
 		}
 	}
+	if img.Format == LUKS {
+		img.Encryption = true
+	}
 	if img.Format == RAW && fileutils2.IsFile(img.Path) {
 		// test if it is an ISO
 		blkType := fileutils2.GetBlkidType(img.Path)
@@ -214,6 +220,14 @@ func (img *SQemuImage) IsChained() bool {
 }
 
 func (img *SQemuImage) doConvert(name string, format TImageFormat, options []string, compact bool, password string) error {
+	if img.hasEncryptKey() && (format == QCOW2 || format == LUKS) {
+		// keep the converted image encrypted by the same key
+		options = append(options, encryptCreateOptions(format)...)
+	}
+	return img.doConvertImage(name, format, options, compact, password)
+}
+
+func (img *SQemuImage) doConvertImage(name string, format TImageFormat, options []string, compact bool, password string) error {
 	if !img.IsValid() {
 		return fmt.Errorf("self is not valid")
 	}
@@ -222,7 +236,25 @@ func (img *SQemuImage) doConvert(name string, format TImageFormat, options []str
 	if compact {
 		cmdline = append(cmdline, "-c")
 	}
-	cmdline = append(cmdline, "-f", img.Format.String(), "-O", format.String())
+	var source []string
+	if img.hasEncryptKey() {
+		imageOpts, err := img.imageOptsArgs()
+		if err != nil {
+			return errors.Wrap(err, "image opts")
+		}
+		secretArgs, cleanup, err := img.secretObjectArgs()
+		if err != nil {
+			return errors.Wrap(err, "secret object")
+		}
+		defer cleanup()
+		cmdline = append(cmdline, secretArgs...)
+		cmdline = append(cmdline, imageOpts[0])
+		source = imageOpts[1:]
+	} else {
+		cmdline = append(cmdline, "-f", img.Format.String())
+		source = []string{img.Path}
+	}
+	cmdline = append(cmdline, "-O", format.String())
 	if len(password) > 0 {
 		if options == nil {
 			options = make([]string, 0)
@@ -232,8 +264,11 @@ func (img *SQemuImage) doConvert(name string, format TImageFormat, options []str
 	if len(options) > 0 {
 		cmdline = append(cmdline, "-o", strings.Join(options, ","))
 	}
-	cmdline = append(cmdline, img.Path, name)
-	log.Infof("XXXX qemu-img command: %s", cmdline)
+	cmdline = append(cmdline, source...)
+	cmdline = append(cmdline, name)
+	if !img.hasEncryptKey() {
+		log.Infof("XXXX qemu-img command: %s", cmdline)
+	}
 	cmd := procutils.NewRemoteCommandAsFarAsPossible("ionice", cmdline...)
 	var stdin io.WriteCloser
 	var err error
@@ -435,7 +470,17 @@ func (img *SQemuImage) create(sizeMB int, format TImageFormat, options []string)
 		return fmt.Errorf("create: the image is valid??? %s", img.Format)
 	}
 	args := []string{"-c", strconv.Itoa(int(img.IoLevel)),
-		qemutils.GetQemuImg(), "create", "-f", format.String()}
+		qemutils.GetQemuImg(), "create"}
+	if img.hasEncryptKey() {
+		secretArgs, cleanup, err := img.secretObjectArgs()
+		if err != nil {
+			return errors.Wrap(err, "secret object")
+		}
+		defer cleanup()
+		args = append(args, secretArgs...)
+		options = append(options, encryptCreateOptions(format)...)
+	}
+	args = append(args, "-f", format.String())
 	if len(options) > 0 {
 		args = append(args, "-o", strings.Join(options, ","))
 	}
@@ -446,7 +491,7 @@ func (img *SQemuImage) create(sizeMB int, format TImageFormat, options []string)
 	cmd := procutils.NewRemoteCommandAsFarAsPossible("ionice", args...)
 	output, err := cmd.Output()
 	if err != nil {
-		log.Errorf("%v create error %s %s", args, output, err)
+		log.Errorf("%s create error %s %s", img.Path, output, err)
 		return errors.Wrapf(err, "create image failed: %s", output)
 	}
 	return img.parse()
@@ -459,6 +504,14 @@ func (img *SQemuImage) CreateQcow2(sizeMB int, compact bool, backPath string) er
 		if !compact {
 			options = append(options, "cluster_size=2M")
 		}
+		if img.hasEncryptKey() && sizeMB == 0 {
+			// an encrypted backing file can't be opened to probe the size
+			backImg, err := NewQemuImage(backPath)
+			if err != nil {
+				return errors.Wrapf(err, "open backing file %s", backPath)
+			}
+			sizeMB = backImg.GetSizeMB()
+		}
 	} else if !compact {
 		sparseOpts := qcow2SparseOptions()
 		options = append(options, sparseOpts...)
@@ -478,6 +531,15 @@ func (img *SQemuImage) CreateRaw(sizeMB int) error {
 	return img.create(sizeMB, RAW, nil)
 }
 
+// CreateLuks creates a luks volume with sizeMB payload, the image must have
+// an encrypt key
+func (img *SQemuImage) CreateLuks(sizeMB int) error {
+	if !img.hasEncryptKey() {
+		return fmt.Errorf("missing encrypt key of %s", img.Path)
+	}
+	return img.create(sizeMB, LUKS, nil)
+}
+
 func (img *SQemuImage) GetSizeMB() int {
 	return int(img.SizeBytes / 1024 / 1024)
 }
@@ -490,8 +552,24 @@ func (img *SQemuImage) Resize(sizeMB int) error {
 	if !img.IsValid() {
 		return fmt.Errorf("self is not valid")
 	}
-	cmd := procutils.NewRemoteCommandAsFarAsPossible("ionice", "-c", strconv.Itoa(int(img.IoLevel)),
-		qemutils.GetQemuImg(), "resize", img.Path, fmt.Sprintf("%dM", sizeMB))
+	args := []string{"-c", strconv.Itoa(int(img.IoLevel)), qemutils.GetQemuImg(), "resize"}
+	if img.hasEncryptKey() {
+		imageOpts, err := img.imageOptsArgs()
+		if err != nil {
+			return errors.Wrap(err, "image opts")
+		}
+		secretArgs, cleanup, err := img.secretObjectArgs()
+		if err != nil {
+			return errors.Wrap(err, "secret object")
+		}
+		defer cleanup()
+		args = append(args, secretArgs...)
+		args = append(args, imageOpts...)
+	} else {
+		args = append(args, img.Path)
+	}
+	args = append(args, fmt.Sprintf("%dM", sizeMB))
+	cmd := procutils.NewRemoteCommandAsFarAsPossible("ionice", args...)
 	err := cmd.Run()
 	if err != nil {
 		log.Errorf("resize fail %s", err)
@@ -14,7 +14,13 @@
 
 package qemuimg
 
-import "testing"
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
 
 func TestGetQemuImgVersion(t *testing.T) {
 	verStr := `qemu-img version 1.5.3, Copyright (c) 2004-2008 Fabrice Bellard`
@@ -22,6 +28,85 @@ func TestGetQemuImgVersion(t *testing.T) {
 	t.Logf("%s", matches[1])
 }
 
+func TestSecretObjectArgs(t *testing.T) {
+	dir, err := ioutil.TempDir("", "qemuimg")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	img := &SQemuImage{Path: filepath.Join(dir, "disk")}
+	img.SetEncryptKey("c2VjcmV0LWtleQ==")
+	args, cleanup, err := img.secretObjectArgs()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if strings.Contains(strings.Join(args, " "), img.EncryptKey) {
+		t.Errorf("key in command line %s", args)
+	}
+	idx := strings.Index(args[1], "file=")
+	if idx < 0 {
+		t.Fatalf("missing key file in %s", args[1])
+	}
+	keyPath := strings.Split(args[1][idx+len("file="):], ",")[0]
+	info, err := os.Stat(keyPath)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if info.Mode().Perm() != 0600 {
+		t.Errorf("key file mode %s", info.Mode())
+	}
+	content, _ := ioutil.ReadFile(keyPath)
+	if string(content) != img.EncryptKey {
+		t.Errorf("key file content %q", content)
+	}
+	cleanup()
+	if _, err := os.Stat(keyPath); !os.IsNotExist(err) {
+		t.Errorf("key file not removed")
+	}
+}
+
+func TestImageOptsArgs(t *testing.T) {
+	cases := []struct {
+		img  *SQemuImage
+		want string
+	}{
+		{
+			img:  &SQemuImage{Path: "/opt/disks/a,b", Format: QCOW2, Encryption: true},
+			want: "driver=qcow2,file.filename=/opt/disks/a,,b,encrypt.key-secret=sec0",
+		},
+		{
+			img:  &SQemuImage{Path: "rbd:pool/disk:mon_host=10.0.0.1", Format: LUKS, Encryption: true},
+			want: "driver=luks,file.filename=rbd:pool/disk:mon_host=10.0.0.1,key-secret=sec0",
+		},
+		{
+			img:  &SQemuImage{Path: "/opt/disks/plain", Format: QCOW2},
+			want: "driver=qcow2,file.filename=/opt/disks/plain",
+		},
+	}
+	for _, c := range cases {
+		args, err := c.img.imageOptsArgs()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(args) != 2 || args[0] != "--image-opts" || args[1] != c.want {
+			t.Errorf("image opts of %s: got %s want %s", c.img.Path, args, c.want)
+		}
+	}
+}
+
+func TestEncryptCreateOptions(t *testing.T) {
+	if got := strings.Join(encryptCreateOptions(QCOW2), ","); got != "encrypt.format=luks,encrypt.key-secret=sec0" {
+		t.Errorf("qcow2 create options %s", got)
+	}
+	if got := strings.Join(encryptCreateOptions(LUKS), ","); got != "key-secret=sec0" {
+		t.Errorf("luks create options %s", got)
+	}
+	img := &SQemuImage{Path: "rbd:pool/disk"}
+	if dir := img.secretDir(); dir != "" {
+		t.Errorf("key file of rbd image in %q", dir)
+	}
+}
+
 // TODO: rewrite TestQcow2
 /*
 func TestQcow2(t *testing.T) {
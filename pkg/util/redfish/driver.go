@@ -72,6 +72,17 @@ type IRedfishDriver interface {
 	BmcReset(ctx context.Context) error
 
 	GetBiosInfo(ctx context.Context) (SBiosInfo, error)
+	GetBiosAttributes(ctx context.Context) (jsonutils.JSONObject, error)
+	GetBiosSettingsPath(ctx context.Context) (string, error)
+	// SetBiosAttributes stages the BIOS attributes for next boot, returns the path of the
+	// job or task that applies them, if any
+	SetBiosAttributes(ctx context.Context, attrs jsonutils.JSONObject) (string, error)
+
+	GetFirmwareInventory(ctx context.Context) ([]SFirmwareInfo, error)
+	// SimpleUpdate pushes the firmware image at imageUri to the BMC, returns the path of
+	// the task tracking the update, which may be empty if BMC does not expose one
+	SimpleUpdate(ctx context.Context, imageUri string, targets []string) (string, error)
+	GetTaskStatus(ctx context.Context, taskPath string) (STaskStatus, error)
 
 	GetIndicatorLED(ctx context.Context) (bool, error)
 	SetIndicatorLED(ctx context.Context, on bool) error
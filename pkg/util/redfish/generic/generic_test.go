@@ -0,0 +1,188 @@
+// Copyright 2019 Yunion
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package generic
+
+import (
+	"context"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"yunion.io/x/jsonutils"
+
+	"yunion.io/x/onecloud/pkg/util/redfish"
+)
+
+type sMockRedfishServer struct {
+	lock      sync.Mutex
+	resources map[string]string
+	patched   map[string]jsonutils.JSONObject
+	posted    map[string]jsonutils.JSONObject
+	taskPolls int
+}
+
+func newMockRedfishServer() *sMockRedfishServer {
+	return &sMockRedfishServer{
+		resources: map[string]string{
+			"/redfish/v1":                                      `{"RedfishVersion":"1.6.0","Systems":{"@odata.id":"/redfish/v1/Systems"},"UpdateService":{"@odata.id":"/redfish/v1/UpdateService"}}`,
+			"/redfish/v1/Systems":                              `{"Members":[{"@odata.id":"/redfish/v1/Systems/1"}]}`,
+			"/redfish/v1/Systems/1":                            `{"Id":"1","Bios":{"@odata.id":"/redfish/v1/Systems/1/Bios"}}`,
+			"/redfish/v1/Systems/1/Bios":                       `{"Attributes":{"BootMode":"Uefi","HyperThreading":"Enabled"},"@Redfish.Settings":{"SettingsObject":{"@odata.id":"/redfish/v1/Systems/1/Bios/Pending"}}}`,
+			"/redfish/v1/UpdateService":                        `{"FirmwareInventory":{"@odata.id":"/redfish/v1/UpdateService/FirmwareInventory"},"Actions":{"#UpdateService.SimpleUpdate":{"target":"/redfish/v1/UpdateService/Actions/SimpleUpdate"}}}`,
+			"/redfish/v1/UpdateService/FirmwareInventory":      `{"Members":[{"@odata.id":"/redfish/v1/UpdateService/FirmwareInventory/BIOS"},{"@odata.id":"/redfish/v1/UpdateService/FirmwareInventory/BMC"}]}`,
+			"/redfish/v1/UpdateService/FirmwareInventory/BIOS": `{"Id":"BIOS","Name":"System BIOS","Version":"2.10.2","Updateable":true,"Status":{"State":"Enabled","Health":"OK"}}`,
+			"/redfish/v1/UpdateService/FirmwareInventory/BMC":  `{"Id":"BMC","Name":"BMC Firmware","Version":"4.40","Updateable":false,"Status":{"State":"Enabled","Health":"OK"}}`,
+		},
+		patched: make(map[string]jsonutils.JSONObject),
+		posted:  make(map[string]jsonutils.JSONObject),
+	}
+}
+
+func (s *sMockRedfishServer) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	path := strings.TrimSuffix(r.URL.Path, "/")
+	w.Header().Set("Content-Type", "application/json")
+	switch r.Method {
+	case "GET":
+		if path == "/redfish/v1/TaskService/Tasks/1" {
+			s.taskPolls++
+			if s.taskPolls < 2 {
+				w.Write([]byte(`{"TaskState":"Running","PercentComplete":50}`))
+			} else {
+				w.Write([]byte(`{"TaskState":"Completed","PercentComplete":100,"Messages":[{"Message":"update done"}]}`))
+			}
+			return
+		}
+		if body, ok := s.resources[path]; ok {
+			w.Write([]byte(body))
+			return
+		}
+		w.WriteHeader(http.StatusNotFound)
+		w.Write([]byte(`{}`))
+	case "PATCH", "POST":
+		data, _ := ioutil.ReadAll(r.Body)
+		body, _ := jsonutils.Parse(data)
+		if r.Method == "PATCH" {
+			s.patched[path] = body
+		} else {
+			s.posted[path] = body
+			w.Header().Set("Location", "http://"+r.Host+"/redfish/v1/TaskService/Tasks/1")
+			w.WriteHeader(http.StatusAccepted)
+		}
+		w.Write([]byte(`{}`))
+	default:
+		w.WriteHeader(http.StatusMethodNotAllowed)
+	}
+}
+
+func newMockApi(t *testing.T) (*sMockRedfishServer, *httptest.Server, redfish.IRedfishDriver) {
+	mock := newMockRedfishServer()
+	srv := httptest.NewServer(mock)
+	api := NewGenericRedfishApi(srv.URL, "root", "password", false)
+	if err := api.Probe(context.Background()); err != nil {
+		srv.Close()
+		t.Fatalf("Probe: %s", err)
+	}
+	return mock, srv, api
+}
+
+func TestFirmwareInventory(t *testing.T) {
+	_, srv, api := newMockApi(t)
+	defer srv.Close()
+
+	fws, err := api.GetFirmwareInventory(context.Background())
+	if err != nil {
+		t.Fatalf("GetFirmwareInventory: %s", err)
+	}
+	if len(fws) != 2 {
+		t.Fatalf("want 2 firmwares, got %d", len(fws))
+	}
+	if fws[0].Id != "BIOS" || fws[0].Version != "2.10.2" || !fws[0].Updateable || fws[0].Health != "OK" {
+		t.Errorf("unexpected bios firmware %#v", fws[0])
+	}
+	if fws[1].Id != "BMC" || fws[1].Updateable {
+		t.Errorf("unexpected bmc firmware %#v", fws[1])
+	}
+}
+
+func TestBiosAttributes(t *testing.T) {
+	mock, srv, api := newMockApi(t)
+	defer srv.Close()
+
+	ctx := context.Background()
+	attrs, err := api.GetBiosAttributes(ctx)
+	if err != nil {
+		t.Fatalf("GetBiosAttributes: %s", err)
+	}
+	if mode, _ := attrs.GetString("BootMode"); mode != "Uefi" {
+		t.Errorf("want BootMode Uefi, got %s", mode)
+	}
+	settings := jsonutils.NewDict()
+	settings.Add(jsonutils.NewString("Disabled"), "HyperThreading")
+	jobPath, err := api.SetBiosAttributes(ctx, settings)
+	if err != nil {
+		t.Fatalf("SetBiosAttributes: %s", err)
+	}
+	if jobPath != "" {
+		t.Errorf("generic driver should not create job, got %s", jobPath)
+	}
+	body, ok := mock.patched["/redfish/v1/Systems/1/Bios/Pending"]
+	if !ok {
+		t.Fatalf("settings object not patched: %v", mock.patched)
+	}
+	if ht, _ := body.GetString("Attributes", "HyperThreading"); ht != "Disabled" {
+		t.Errorf("want HyperThreading Disabled, got %s", body)
+	}
+}
+
+func TestSimpleUpdate(t *testing.T) {
+	mock, srv, api := newMockApi(t)
+	defer srv.Close()
+
+	ctx := context.Background()
+	taskPath, err := api.SimpleUpdate(ctx, "http://10.0.0.1/images/bios.bin", []string{"/redfish/v1/UpdateService/FirmwareInventory/BIOS"})
+	if err != nil {
+		t.Fatalf("SimpleUpdate: %s", err)
+	}
+	if taskPath != "/redfish/v1/TaskService/Tasks/1" {
+		t.Errorf("unexpected task path %s", taskPath)
+	}
+	body := mock.posted["/redfish/v1/UpdateService/Actions/SimpleUpdate"]
+	if body == nil {
+		t.Fatalf("SimpleUpdate action not posted: %v", mock.posted)
+	}
+	if proto, _ := body.GetString("TransferProtocol"); proto != "HTTP" {
+		t.Errorf("want TransferProtocol HTTP, got %s", body)
+	}
+
+	progress := make([]int, 0)
+	status, err := redfish.WaitTask(ctx, api, taskPath, time.Millisecond, time.Second, func(s redfish.STaskStatus) {
+		progress = append(progress, s.PercentComplete)
+	})
+	if err != nil {
+		t.Fatalf("WaitTask: %s", err)
+	}
+	if !status.IsSucc() || len(status.Messages) != 1 || status.Messages[0] != "update done" {
+		t.Errorf("unexpected task status %#v", status)
+	}
+	if len(progress) != 2 || progress[0] != 50 || progress[1] != 100 {
+		t.Errorf("unexpected progress %v", progress)
+	}
+}
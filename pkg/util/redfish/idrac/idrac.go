@@ -383,3 +383,58 @@ func (r *SIDracRefishApi) GetPowerPath() string {
 func (r *SIDracRefishApi) GetThermalPath() string {
 	return "/redfish/v1/Chassis/System.Embedded.1/Thermal"
 }
+
+// iDRAC stages BIOS settings in Bios/Settings, a configuration job is required to
+// apply them on next reboot
+func (r *SIDracRefishApi) SetBiosAttributes(ctx context.Context, attrs jsonutils.JSONObject) (string, error) {
+	_, err := r.SGenericRefishApi.SetBiosAttributes(ctx, attrs)
+	if err != nil {
+		return "", errors.Wrap(err, "SGenericRefishApi.SetBiosAttributes")
+	}
+	settingsPath, err := r.GetBiosSettingsPath(ctx)
+	if err != nil {
+		return "", errors.Wrap(err, "GetBiosSettingsPath")
+	}
+	managerPath, _, err := r.GetResource(ctx, "Managers", "0")
+	if err != nil {
+		return "", errors.Wrap(err, "GetResource Managers 0")
+	}
+	jobsPath := httputils.JoinPath(managerPath, "Jobs")
+	params := jsonutils.NewDict()
+	params.Add(jsonutils.NewString(settingsPath), "TargetSettingsURI")
+	hdr, _, err := r.Post(ctx, jobsPath, params)
+	if err != nil {
+		return "", errors.Wrapf(err, "r.Post %s", jobsPath)
+	}
+	return r.TrimUrlPath(hdr.Get("Location")), nil
+}
+
+func (r *SIDracRefishApi) GetTaskStatus(ctx context.Context, taskPath string) (redfish.STaskStatus, error) {
+	if !strings.Contains(taskPath, "/Jobs/") {
+		return r.SGenericRefishApi.GetTaskStatus(ctx, taskPath)
+	}
+	status := redfish.STaskStatus{}
+	resp, err := r.Get(ctx, taskPath)
+	if err != nil {
+		return status, errors.Wrapf(err, "r.Get %s", taskPath)
+	}
+	jobState, _ := resp.GetString("JobState")
+	switch jobState {
+	case "Completed":
+		status.TaskState = redfish.TASK_STATE_COMPLETED
+	case "Failed", "CompletedWithErrors":
+		status.TaskState = redfish.TASK_STATE_EXCEPTION
+	case "Scheduled", "New", "Downloaded":
+		status.TaskState = redfish.TASK_STATE_PENDING
+	default:
+		status.TaskState = redfish.TASK_STATE_RUNNING
+	}
+	if pct, err := resp.Int("PercentComplete"); err == nil {
+		status.PercentComplete = int(pct)
+	}
+	msg, _ := resp.GetString("Message")
+	if len(msg) > 0 {
+		status.Messages = []string{msg}
+	}
+	return status, nil
+}
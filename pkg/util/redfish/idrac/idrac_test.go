@@ -0,0 +1,90 @@
+// Copyright 2019 Yunion
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package idrac
+
+import (
+	"context"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"yunion.io/x/jsonutils"
+
+	"yunion.io/x/onecloud/pkg/util/redfish"
+)
+
+func TestBiosSettingsJob(t *testing.T) {
+	resources := map[string]string{
+		"/redfish/v1":                                      `{"RedfishVersion":"1.4.0","AccountService":{"@odata.id":"/redfish/v1/Managers/iDRAC.Embedded.1/AccountService"},"Systems":{"@odata.id":"/redfish/v1/Systems"},"Managers":{"@odata.id":"/redfish/v1/Managers"}}`,
+		"/redfish/v1/Systems":                              `{"Members":[{"@odata.id":"/redfish/v1/Systems/System.Embedded.1"}]}`,
+		"/redfish/v1/Systems/System.Embedded.1":            `{"Bios":{"@odata.id":"/redfish/v1/Systems/System.Embedded.1/Bios"}}`,
+		"/redfish/v1/Systems/System.Embedded.1/Bios":       `{"Attributes":{"LogicalProc":"Enabled"},"@Redfish.Settings":{"SettingsObject":{"@odata.id":"/redfish/v1/Systems/System.Embedded.1/Bios/Settings"}}}`,
+		"/redfish/v1/Managers":                             `{"Members":[{"@odata.id":"/redfish/v1/Managers/iDRAC.Embedded.1"}]}`,
+		"/redfish/v1/Managers/iDRAC.Embedded.1":            `{"Id":"iDRAC.Embedded.1"}`,
+		"/redfish/v1/Managers/iDRAC.Embedded.1/Jobs/JID_1": `{"JobState":"Completed","PercentComplete":100,"Message":"Job completed successfully."}`,
+	}
+	var patchedPath string
+	var jobBody jsonutils.JSONObject
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		path := strings.TrimSuffix(r.URL.Path, "/")
+		w.Header().Set("Content-Type", "application/json")
+		switch r.Method {
+		case "PATCH":
+			patchedPath = path
+		case "POST":
+			data, _ := ioutil.ReadAll(r.Body)
+			jobBody, _ = jsonutils.Parse(data)
+			w.Header().Set("Location", "/redfish/v1/Managers/iDRAC.Embedded.1/Jobs/JID_1")
+			w.WriteHeader(http.StatusOK)
+		case "GET":
+			body, ok := resources[path]
+			if !ok {
+				w.WriteHeader(http.StatusNotFound)
+				body = `{}`
+			}
+			w.Write([]byte(body))
+			return
+		}
+		w.Write([]byte(`{}`))
+	}))
+	defer srv.Close()
+
+	ctx := context.Background()
+	api := NewIDracRedfishApi(srv.URL, "root", "calvin", false)
+	if err := api.Probe(ctx); err != nil {
+		t.Fatalf("Probe: %s", err)
+	}
+	attrs := jsonutils.NewDict()
+	attrs.Add(jsonutils.NewString("Disabled"), "LogicalProc")
+	jobPath, err := api.SetBiosAttributes(ctx, attrs)
+	if err != nil {
+		t.Fatalf("SetBiosAttributes: %s", err)
+	}
+	if patchedPath != "/redfish/v1/Systems/System.Embedded.1/Bios/Settings" {
+		t.Errorf("unexpected patched path %s", patchedPath)
+	}
+	if target, _ := jobBody.GetString("TargetSettingsURI"); target != "/redfish/v1/Systems/System.Embedded.1/Bios/Settings" {
+		t.Errorf("unexpected job request %s", jobBody)
+	}
+	status, err := api.GetTaskStatus(ctx, jobPath)
+	if err != nil {
+		t.Fatalf("GetTaskStatus: %s", err)
+	}
+	if !status.IsSucc() || status.TaskState != redfish.TASK_STATE_COMPLETED {
+		t.Errorf("unexpected job status %#v", status)
+	}
+}
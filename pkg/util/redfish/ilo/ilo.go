@@ -307,3 +307,61 @@ func (r *SILORefishApi) GetPowerPath() string {
 func (r *SILORefishApi) GetThermalPath() string {
 	return "/redfish/v1/Chassis/1/Thermal/"
 }
+
+// iLO does not create a task for SimpleUpdate, the flash progress is reported by
+// the UpdateService itself, so return UpdateService path for GetTaskStatus
+func (r *SILORefishApi) SimpleUpdate(ctx context.Context, imageUri string, targets []string) (string, error) {
+	taskPath, err := r.SGenericRefishApi.SimpleUpdate(ctx, imageUri, targets)
+	if err != nil {
+		return "", errors.Wrap(err, "SGenericRefishApi.SimpleUpdate")
+	}
+	if len(taskPath) > 0 {
+		return taskPath, nil
+	}
+	path, _, err := r.GetResource(ctx, "UpdateService")
+	if err != nil {
+		return "", errors.Wrap(err, "GetResource UpdateService")
+	}
+	return path, nil
+}
+
+func (r *SILORefishApi) GetTaskStatus(ctx context.Context, taskPath string) (redfish.STaskStatus, error) {
+	if !strings.Contains(taskPath, "UpdateService") {
+		return r.SGenericRefishApi.GetTaskStatus(ctx, taskPath)
+	}
+	status := redfish.STaskStatus{}
+	resp, err := r.Get(ctx, taskPath)
+	if err != nil {
+		return status, errors.Wrapf(err, "r.Get %s", taskPath)
+	}
+	oem, err := resp.Get("Oem", "Hpe")
+	if err != nil {
+		oem, err = resp.Get("Oem", "Hp")
+		if err != nil {
+			return status, errors.Wrap(err, "no Oem Hpe UpdateService state")
+		}
+	}
+	if pct, err := oem.Int("FlashProgressPercent"); err == nil {
+		status.PercentComplete = int(pct)
+	}
+	state, _ := oem.GetString("State")
+	switch state {
+	case "Complete":
+		status.TaskState = redfish.TASK_STATE_COMPLETED
+	case "Error":
+		status.TaskState = redfish.TASK_STATE_EXCEPTION
+	case "Idle":
+		if status.PercentComplete >= 100 {
+			status.TaskState = redfish.TASK_STATE_COMPLETED
+		} else {
+			status.TaskState = redfish.TASK_STATE_PENDING
+		}
+	default:
+		status.TaskState = redfish.TASK_STATE_RUNNING
+	}
+	msgId, _ := oem.GetString("Result", "MessageId")
+	if len(msgId) > 0 {
+		status.Messages = []string{msgId}
+	}
+	return status, nil
+}
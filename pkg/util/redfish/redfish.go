@@ -214,11 +214,7 @@ func (r *SBaseRedfishClient) Login(ctx context.Context) error {
 		return errors.Wrap(err, "Login")
 	}
 	r.SessionToken = hdr.Get("X-Auth-Token")
-	r.sessionUrl = hdr.Get("Location")
-	pos := strings.Index(r.sessionUrl, r.IRedfishDriver().BasePath())
-	if pos > 0 {
-		r.sessionUrl = r.sessionUrl[pos:]
-	}
+	r.sessionUrl = r.TrimUrlPath(hdr.Get("Location"))
 	return nil
 }
 
@@ -653,6 +649,139 @@ func (r *SBaseRedfishClient) GetBiosInfo(ctx context.Context) (SBiosInfo, error)
 	return biosInfo, nil
 }
 
+func (r *SBaseRedfishClient) GetBiosAttributes(ctx context.Context) (jsonutils.JSONObject, error) {
+	_, resp, err := r.GetResource(ctx, "Systems", "0", "Bios")
+	if err != nil {
+		return nil, errors.Wrap(err, "GetResource Systems 0 Bios")
+	}
+	attrs, err := resp.Get("Attributes")
+	if err != nil {
+		return nil, errors.Wrap(err, "Get Attributes")
+	}
+	return attrs, nil
+}
+
+func (r *SBaseRedfishClient) GetBiosSettingsPath(ctx context.Context) (string, error) {
+	path, resp, err := r.GetResource(ctx, "Systems", "0", "Bios")
+	if err != nil {
+		return "", errors.Wrap(err, "GetResource Systems 0 Bios")
+	}
+	settingsPath, _ := resp.GetString("@Redfish.Settings", "SettingsObject", r.IRedfishDriver().LinkKey())
+	if len(settingsPath) > 0 {
+		return settingsPath, nil
+	}
+	return httputils.JoinPath(path, "Settings"), nil
+}
+
+func (r *SBaseRedfishClient) SetBiosAttributes(ctx context.Context, attrs jsonutils.JSONObject) (string, error) {
+	path, err := r.IRedfishDriver().GetBiosSettingsPath(ctx)
+	if err != nil {
+		return "", errors.Wrap(err, "GetBiosSettingsPath")
+	}
+	params := jsonutils.NewDict()
+	params.Add(attrs, "Attributes")
+	resp, err := r.Patch(ctx, path, params)
+	if err != nil {
+		return "", errors.Wrapf(err, "r.Patch %s", path)
+	}
+	if r.IsDebug {
+		log.Debugf("%s", resp)
+	}
+	// generic BMC applies pending settings on next reboot, no job to track
+	return "", nil
+}
+
+func (r *SBaseRedfishClient) GetFirmwareInventory(ctx context.Context) ([]SFirmwareInfo, error) {
+	_, resp, err := r.GetResource(ctx, "UpdateService", "FirmwareInventory")
+	if err != nil {
+		return nil, errors.Wrap(err, "GetResource UpdateService FirmwareInventory")
+	}
+	resp = r.IRedfishDriver().GetParent(resp)
+	members, err := resp.GetArray(r.IRedfishDriver().MemberKey())
+	if err != nil {
+		return nil, errors.Wrap(err, "find member error")
+	}
+	ret := make([]SFirmwareInfo, 0)
+	for i := range members {
+		path, err := members[i].GetString(r.IRedfishDriver().LinkKey())
+		if err != nil {
+			return nil, errors.Wrapf(err, "fail to find path at index %d", i)
+		}
+		fwJson, err := r.Get(ctx, path)
+		if err != nil {
+			return nil, errors.Wrapf(err, "r.Get %s", path)
+		}
+		fw := SFirmwareInfo{}
+		err = fwJson.Unmarshal(&fw)
+		if err != nil {
+			return nil, errors.Wrapf(err, "Unmarshal %s", path)
+		}
+		fw.State, _ = fwJson.GetString("Status", "State")
+		fw.Health, _ = fwJson.GetString("Status", "Health")
+		ret = append(ret, fw)
+	}
+	return ret, nil
+}
+
+func (r *SBaseRedfishClient) TrimUrlPath(urlStr string) string {
+	pos := strings.Index(urlStr, r.IRedfishDriver().BasePath())
+	if pos > 0 {
+		return urlStr[pos:]
+	}
+	return urlStr
+}
+
+func (r *SBaseRedfishClient) SimpleUpdate(ctx context.Context, imageUri string, targets []string) (string, error) {
+	path, resp, err := r.GetResource(ctx, "UpdateService")
+	if err != nil {
+		return "", errors.Wrap(err, "GetResource UpdateService")
+	}
+	actionPath, _ := resp.GetString("Actions", "#UpdateService.SimpleUpdate", "target")
+	if len(actionPath) == 0 {
+		actionPath = httputils.JoinPath(path, "Actions/UpdateService.SimpleUpdate")
+	}
+	params := jsonutils.NewDict()
+	params.Add(jsonutils.NewString(imageUri), "ImageURI")
+	if parts, err := url.Parse(imageUri); err == nil && len(parts.Scheme) > 0 {
+		params.Add(jsonutils.NewString(strings.ToUpper(parts.Scheme)), "TransferProtocol")
+	}
+	if len(targets) > 0 {
+		params.Add(jsonutils.NewStringArray(targets), "Targets")
+	}
+	hdr, resp, err := r.Post(ctx, actionPath, params)
+	if err != nil {
+		return "", errors.Wrapf(err, "r.Post %s", actionPath)
+	}
+	taskPath := hdr.Get("Location")
+	if len(taskPath) == 0 && resp != nil {
+		taskPath, _ = resp.GetString(r.IRedfishDriver().LinkKey())
+	}
+	return r.TrimUrlPath(taskPath), nil
+}
+
+func (r *SBaseRedfishClient) GetTaskStatus(ctx context.Context, taskPath string) (STaskStatus, error) {
+	status := STaskStatus{}
+	if len(taskPath) == 0 {
+		return status, errors.Wrap(httperrors.ErrNotFound, "empty task path")
+	}
+	resp, err := r.Get(ctx, taskPath)
+	if err != nil {
+		return status, errors.Wrapf(err, "r.Get %s", taskPath)
+	}
+	status.TaskState, _ = resp.GetString("TaskState")
+	if pct, err := resp.Int("PercentComplete"); err == nil {
+		status.PercentComplete = int(pct)
+	}
+	msgs, _ := resp.GetArray("Messages")
+	for i := range msgs {
+		msg, _ := msgs[i].GetString("Message")
+		if len(msg) > 0 {
+			status.Messages = append(status.Messages, msg)
+		}
+	}
+	return status, nil
+}
+
 func (r *SBaseRedfishClient) GetIndicatorLEDInternal(ctx context.Context, subsys string) (string, string, error) {
 	path, resp, err := r.GetResource(ctx, subsys, "0")
 	if err != nil {
@@ -130,3 +130,50 @@ type SNTPConf struct {
 	ProtocolEnabled bool     `json:"ProtocolEnabled,allowfalse"`
 	TimeZone        string   `json:"TimeZone"`
 }
+
+type SFirmwareInfo struct {
+	Id          string `json:"Id"`
+	Name        string `json:"Name"`
+	Version     string `json:"Version"`
+	SoftwareId  string `json:"SoftwareId"`
+	ReleaseDate string `json:"ReleaseDate"`
+	Updateable  bool   `json:"Updateable,allowfalse"`
+	State       string `json:"State"`
+	Health      string `json:"Health"`
+}
+
+const (
+	TASK_STATE_NEW       = "New"
+	TASK_STATE_STARTING  = "Starting"
+	TASK_STATE_RUNNING   = "Running"
+	TASK_STATE_PENDING   = "Pending"
+	TASK_STATE_COMPLETED = "Completed"
+	TASK_STATE_EXCEPTION = "Exception"
+	TASK_STATE_KILLED    = "Killed"
+	TASK_STATE_CANCELLED = "Cancelled"
+)
+
+type STaskStatus struct {
+	TaskState       string   `json:"TaskState"`
+	PercentComplete int      `json:"PercentComplete"`
+	Messages        []string `json:"Messages"`
+}
+
+func (s STaskStatus) IsFinished() bool {
+	switch s.TaskState {
+	case TASK_STATE_COMPLETED, TASK_STATE_EXCEPTION, TASK_STATE_KILLED, TASK_STATE_CANCELLED:
+		return true
+	}
+	return false
+}
+
+func (s STaskStatus) IsSucc() bool {
+	return s.TaskState == TASK_STATE_COMPLETED
+}
+
+func (s STaskStatus) String() string {
+	if len(s.Messages) == 0 {
+		return fmt.Sprintf("%s %d%%", s.TaskState, s.PercentComplete)
+	}
+	return fmt.Sprintf("%s %d%%: %s", s.TaskState, s.PercentComplete, strings.Join(s.Messages, "; "))
+}
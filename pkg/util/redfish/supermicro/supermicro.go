@@ -250,3 +250,15 @@ func (r *SSupermicroRefishApi) SetNTPConf(ctx context.Context, conf redfish.SNTP
 	}
 	return nil
 }
+
+func (r *SSupermicroRefishApi) GetBiosSettingsPath(ctx context.Context) (string, error) {
+	path, resp, err := r.GetResource(ctx, "Systems", "0", "Bios")
+	if err != nil {
+		return "", errors.Wrap(err, "GetResource Systems 0 Bios")
+	}
+	settingsPath, _ := resp.GetString("@Redfish.Settings", "SettingsObject", "@odata.id")
+	if len(settingsPath) > 0 {
+		return settingsPath, nil
+	}
+	return httputils.JoinPath(path, "SD"), nil
+}
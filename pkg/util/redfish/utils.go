@@ -16,8 +16,12 @@ package redfish
 
 import (
 	"context"
+	"time"
 
+	"yunion.io/x/log"
 	"yunion.io/x/pkg/errors"
+
+	"yunion.io/x/onecloud/pkg/httperrors"
 )
 
 func MountVirtualCdrom(ctx context.Context, api IRedfishDriver, cdromUrl string, boot bool) error {
@@ -50,3 +54,35 @@ func UmountVirtualCdrom(ctx context.Context, api IRedfishDriver) error {
 	}
 	return api.UmountVirtualCdrom(ctx, path)
 }
+
+// WaitTask polls the BMC task at taskPath until it finishes or timeout, progress
+// is called on every state change
+func WaitTask(ctx context.Context, api IRedfishDriver, taskPath string, interval, timeout time.Duration, progress func(STaskStatus)) (STaskStatus, error) {
+	var last STaskStatus
+	startTime := time.Now()
+	for {
+		status, err := api.GetTaskStatus(ctx, taskPath)
+		if err != nil {
+			return last, errors.Wrap(err, "api.GetTaskStatus")
+		}
+		if progress != nil && (status.TaskState != last.TaskState || status.PercentComplete != last.PercentComplete) {
+			progress(status)
+		}
+		last = status
+		if status.IsFinished() {
+			if !status.IsSucc() {
+				return status, errors.Errorf("task %s %s", taskPath, status.String())
+			}
+			return status, nil
+		}
+		if time.Since(startTime) > timeout {
+			return status, errors.Wrapf(httperrors.ErrTimeout, "wait task %s", taskPath)
+		}
+		log.Debugf("task %s: %s", taskPath, status.String())
+		select {
+		case <-ctx.Done():
+			return status, ctx.Err()
+		case <-time.After(interval):
+		}
+	}
+}
@@ -14,7 +14,11 @@
 
 package regutils2
 
-import "regexp"
+import (
+	"net"
+	"regexp"
+	"strings"
+)
 
 /**
  * Parses val with the given regular expression and returns the
@@ -36,3 +40,12 @@ func SubGroupMatch(pattern string, line string) map[string]string {
 	regEx := regexp.MustCompile(pattern)
 	return GetParams(regEx, line)
 }
+
+// MatchCIDR6 reports whether str is an ipv6 network in cidr notation
+func MatchCIDR6(str string) bool {
+	if !strings.Contains(str, ":") {
+		return false
+	}
+	ip, _, err := net.ParseCIDR(str)
+	return err == nil && ip.To4() == nil
+}
@@ -59,3 +59,20 @@ func TestSubGroupMatch(t *testing.T) {
 		})
 	}
 }
+
+func TestMatchCIDR6(t *testing.T) {
+	cases := map[string]bool{
+		"fd00::/64":           true,
+		"2001:db8::1/128":     true,
+		"::/0":                true,
+		"10.0.0.0/8":          false,
+		"::ffff:10.0.0.0/104": false,
+		"fd00::1":             false,
+		"fd00::/129":          false,
+	}
+	for str, want := range cases {
+		if got := MatchCIDR6(str); got != want {
+			t.Errorf("MatchCIDR6(%q) = %v, want %v", str, got, want)
+		}
+	}
+}
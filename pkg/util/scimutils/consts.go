@@ -0,0 +1,74 @@
+// Copyright 2019 Yunion
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package scimutils
+
+const (
+	SCHEMA_USER                    = "urn:ietf:params:scim:schemas:core:2.0:User"
+	SCHEMA_GROUP                   = "urn:ietf:params:scim:schemas:core:2.0:Group"
+	SCHEMA_ENTERPRISE_USER         = "urn:ietf:params:scim:schemas:extension:enterprise:2.0:User"
+	SCHEMA_RESOURCE_TYPE           = "urn:ietf:params:scim:schemas:core:2.0:ResourceType"
+	SCHEMA_SERVICE_PROVIDER_CONFIG = "urn:ietf:params:scim:schemas:core:2.0:ServiceProviderConfig"
+
+	SCHEMA_LIST_RESPONSE = "urn:ietf:params:scim:api:messages:2.0:ListResponse"
+	SCHEMA_PATCH_OP      = "urn:ietf:params:scim:api:messages:2.0:PatchOp"
+	SCHEMA_ERROR         = "urn:ietf:params:scim:api:messages:2.0:Error"
+
+	RESOURCE_TYPE_USER  = "User"
+	RESOURCE_TYPE_GROUP = "Group"
+
+	CONTENT_TYPE = "application/scim+json"
+
+	PATCH_OP_ADD     = "add"
+	PATCH_OP_REPLACE = "replace"
+	PATCH_OP_REMOVE  = "remove"
+
+	FILTER_OP_EQ = "eq"
+	FILTER_OP_NE = "ne"
+	FILTER_OP_CO = "co"
+	FILTER_OP_SW = "sw"
+	FILTER_OP_EW = "ew"
+	FILTER_OP_PR = "pr"
+	FILTER_OP_GT = "gt"
+	FILTER_OP_GE = "ge"
+	FILTER_OP_LT = "lt"
+	FILTER_OP_LE = "le"
+
+	FILTER_OP_AND = "and"
+	FILTER_OP_OR  = "or"
+	FILTER_OP_NOT = "not"
+
+	ERROR_INVALID_FILTER = "invalidFilter"
+	ERROR_TOO_MANY       = "tooMany"
+	ERROR_UNIQUENESS     = "uniqueness"
+	ERROR_MUTABILITY     = "mutability"
+	ERROR_INVALID_SYNTAX = "invalidSyntax"
+	ERROR_INVALID_PATH   = "invalidPath"
+	ERROR_NO_TARGET      = "noTarget"
+	ERROR_INVALID_VALUE  = "invalidValue"
+)
+
+var (
+	compareOperators = []string{
+		FILTER_OP_EQ,
+		FILTER_OP_NE,
+		FILTER_OP_CO,
+		FILTER_OP_SW,
+		FILTER_OP_EW,
+		FILTER_OP_GT,
+		FILTER_OP_GE,
+		FILTER_OP_LT,
+		FILTER_OP_LE,
+	}
+)
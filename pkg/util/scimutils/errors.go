@@ -0,0 +1,68 @@
+// Copyright 2019 Yunion
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package scimutils
+
+import (
+	"fmt"
+	"net/http"
+	"strconv"
+)
+
+// SError is the error response defined in RFC 7644 section 3.12
+type SError struct {
+	Schemas  []string `json:"schemas"`
+	Status   string   `json:"status"`
+	ScimType string   `json:"scimType"`
+	Detail   string   `json:"detail"`
+}
+
+func NewError(status int, scimType string, msg string, params ...interface{}) *SError {
+	if len(params) > 0 {
+		msg = fmt.Sprintf(msg, params...)
+	}
+	return &SError{
+		Schemas:  []string{SCHEMA_ERROR},
+		Status:   strconv.Itoa(status),
+		ScimType: scimType,
+		Detail:   msg,
+	}
+}
+
+func NewBadRequestError(scimType string, msg string, params ...interface{}) *SError {
+	return NewError(http.StatusBadRequest, scimType, msg, params...)
+}
+
+func NewNotFoundError(msg string, params ...interface{}) *SError {
+	return NewError(http.StatusNotFound, "", msg, params...)
+}
+
+func NewConflictError(msg string, params ...interface{}) *SError {
+	return NewError(http.StatusConflict, ERROR_UNIQUENESS, msg, params...)
+}
+
+func (e *SError) Error() string {
+	if len(e.ScimType) > 0 {
+		return fmt.Sprintf("%s %s: %s", e.Status, e.ScimType, e.Detail)
+	}
+	return fmt.Sprintf("%s: %s", e.Status, e.Detail)
+}
+
+func (e *SError) StatusCode() int {
+	code, _ := strconv.Atoi(e.Status)
+	if code == 0 {
+		return http.StatusInternalServerError
+	}
+	return code
+}
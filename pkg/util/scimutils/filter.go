@@ -0,0 +1,461 @@
+// Copyright 2019 Yunion
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package scimutils
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"yunion.io/x/jsonutils"
+	"yunion.io/x/pkg/utils"
+)
+
+// IFilter is the parsed form of a SCIM filter expression, RFC 7644
+// section 3.4.2.2
+type IFilter interface {
+	// Match tells whether a JSON representation of a resource, or an item
+	// of a multi-valued attribute, satisfies the filter
+	Match(obj jsonutils.JSONObject) bool
+	String() string
+}
+
+// SAttrFilter compares an attribute with a value, e.g. userName eq "bjensen",
+// or tests the presence of an attribute, e.g. title pr
+type SAttrFilter struct {
+	Path  string
+	Op    string
+	Value interface{}
+}
+
+// SLogicalFilter combines two filters with and/or
+type SLogicalFilter struct {
+	Op    string
+	Left  IFilter
+	Right IFilter
+}
+
+type SNotFilter struct {
+	Filter IFilter
+}
+
+// SValuePathFilter applies a filter on the items of a multi-valued attribute,
+// e.g. emails[type eq "work" and value co "@example.com"]
+type SValuePathFilter struct {
+	Path   string
+	Filter IFilter
+}
+
+func (f *SAttrFilter) String() string {
+	if f.Op == FILTER_OP_PR {
+		return fmt.Sprintf("%s pr", f.Path)
+	}
+	return fmt.Sprintf("%s %s %s", f.Path, f.Op, jsonutils.Marshal(f.Value))
+}
+
+func (f *SLogicalFilter) String() string {
+	return fmt.Sprintf("(%s %s %s)", f.Left, f.Op, f.Right)
+}
+
+func (f *SNotFilter) String() string {
+	return fmt.Sprintf("not (%s)", f.Filter)
+}
+
+func (f *SValuePathFilter) String() string {
+	return fmt.Sprintf("%s[%s]", f.Path, f.Filter)
+}
+
+func (f *SAttrFilter) Match(obj jsonutils.JSONObject) bool {
+	vals := getAttrValues(obj, f.Path)
+	if f.Op == FILTER_OP_PR {
+		for _, v := range vals {
+			if !isEmptyValue(v) {
+				return true
+			}
+		}
+		return false
+	}
+	if len(vals) == 0 {
+		return f.Op == FILTER_OP_NE && f.Value != nil
+	}
+	for _, v := range vals {
+		if compareValue(v, f.Op, f.Value) {
+			return true
+		}
+	}
+	return false
+}
+
+func (f *SLogicalFilter) Match(obj jsonutils.JSONObject) bool {
+	if f.Op == FILTER_OP_AND {
+		return f.Left.Match(obj) && f.Right.Match(obj)
+	}
+	return f.Left.Match(obj) || f.Right.Match(obj)
+}
+
+func (f *SNotFilter) Match(obj jsonutils.JSONObject) bool {
+	return !f.Filter.Match(obj)
+}
+
+func (f *SValuePathFilter) Match(obj jsonutils.JSONObject) bool {
+	for _, v := range getAttrValues(obj, f.Path) {
+		if f.Filter.Match(v) {
+			return true
+		}
+	}
+	return false
+}
+
+// TrimSchema strips the core schema URN prefix of an attribute path, e.g.
+// urn:ietf:params:scim:schemas:core:2.0:User:userName becomes userName
+func TrimSchema(path string) string {
+	for _, schema := range []string{SCHEMA_USER, SCHEMA_GROUP} {
+		if len(path) > len(schema) && strings.EqualFold(path[:len(schema)+1], schema+":") {
+			return path[len(schema)+1:]
+		}
+	}
+	return path
+}
+
+// getAttr looks up a key of a JSON dict case-insensitively, as SCIM
+// attribute names are case insensitive
+func getAttr(obj jsonutils.JSONObject, name string) (string, jsonutils.JSONObject) {
+	dict, ok := obj.(*jsonutils.JSONDict)
+	if !ok {
+		return "", nil
+	}
+	for k, v := range dict.Value() {
+		if strings.EqualFold(k, name) {
+			return k, v
+		}
+	}
+	return "", nil
+}
+
+// splitAttrPath splits an attribute path into the attribute name and the
+// sub-attribute name, extension schema URN is kept as the attribute name
+func splitAttrPath(path string) (string, string) {
+	path = TrimSchema(path)
+	if strings.HasPrefix(strings.ToLower(path), "urn:") {
+		pos := strings.LastIndexByte(path, ':')
+		return path[:pos], path[pos+1:]
+	}
+	pos := strings.IndexByte(path, '.')
+	if pos < 0 {
+		return path, ""
+	}
+	return path[:pos], path[pos+1:]
+}
+
+func getAttrValues(obj jsonutils.JSONObject, path string) []jsonutils.JSONObject {
+	if arr, ok := obj.(*jsonutils.JSONArray); ok {
+		ret := make([]jsonutils.JSONObject, 0)
+		for _, v := range arr.Value() {
+			ret = append(ret, getAttrValues(v, path)...)
+		}
+		return ret
+	}
+	attr, sub := splitAttrPath(path)
+	_, v := getAttr(obj, attr)
+	if v == nil {
+		return nil
+	}
+	if len(sub) > 0 {
+		return getAttrValues(v, sub)
+	}
+	if arr, ok := v.(*jsonutils.JSONArray); ok {
+		return arr.Value()
+	}
+	return []jsonutils.JSONObject{v}
+}
+
+func isEmptyValue(v jsonutils.JSONObject) bool {
+	switch val := v.(type) {
+	case *jsonutils.JSONString:
+		return len(val.Value()) == 0
+	case *jsonutils.JSONArray:
+		return val.Length() == 0
+	case *jsonutils.JSONDict:
+		return val.Length() == 0
+	}
+	return v == jsonutils.JSONNull
+}
+
+func compareValue(v jsonutils.JSONObject, op string, value interface{}) bool {
+	var cmp int
+	switch val := value.(type) {
+	case nil:
+		isNull := v == jsonutils.JSONNull
+		switch op {
+		case FILTER_OP_EQ:
+			return isNull
+		case FILTER_OP_NE:
+			return !isNull
+		}
+		return false
+	case bool:
+		b, err := v.Bool()
+		if err != nil {
+			return false
+		}
+		switch op {
+		case FILTER_OP_EQ:
+			return b == val
+		case FILTER_OP_NE:
+			return b != val
+		}
+		return false
+	case float64:
+		f, err := v.Float()
+		if err != nil {
+			return false
+		}
+		switch {
+		case f < val:
+			cmp = -1
+		case f > val:
+			cmp = 1
+		}
+	case string:
+		s, err := v.GetString()
+		if err != nil {
+			return false
+		}
+		s = strings.ToLower(s)
+		str := strings.ToLower(val)
+		switch op {
+		case FILTER_OP_CO:
+			return strings.Contains(s, str)
+		case FILTER_OP_SW:
+			return strings.HasPrefix(s, str)
+		case FILTER_OP_EW:
+			return strings.HasSuffix(s, str)
+		}
+		cmp = strings.Compare(s, str)
+	default:
+		return false
+	}
+	switch op {
+	case FILTER_OP_EQ:
+		return cmp == 0
+	case FILTER_OP_NE:
+		return cmp != 0
+	case FILTER_OP_GT:
+		return cmp > 0
+	case FILTER_OP_GE:
+		return cmp >= 0
+	case FILTER_OP_LT:
+		return cmp < 0
+	case FILTER_OP_LE:
+		return cmp <= 0
+	}
+	return false
+}
+
+type sFilterParser struct {
+	tokens []string
+	pos    int
+}
+
+// ParseFilter parses a SCIM filter expression
+func ParseFilter(filter string) (IFilter, error) {
+	tokens, err := tokenizeFilter(filter)
+	if err != nil {
+		return nil, err
+	}
+	if len(tokens) == 0 {
+		return nil, NewBadRequestError(ERROR_INVALID_FILTER, "empty filter")
+	}
+	p := &sFilterParser{tokens: tokens}
+	f, err := p.parseOr()
+	if err != nil {
+		return nil, err
+	}
+	if p.pos < len(p.tokens) {
+		return nil, NewBadRequestError(ERROR_INVALID_FILTER, "unexpected %q in filter", p.tokens[p.pos])
+	}
+	return f, nil
+}
+
+func tokenizeFilter(filter string) ([]string, error) {
+	tokens := make([]string, 0)
+	for i := 0; i < len(filter); {
+		c := filter[i]
+		switch {
+		case c == ' ' || c == '\t':
+			i++
+		case c == '(' || c == ')' || c == '[' || c == ']':
+			tokens = append(tokens, string(c))
+			i++
+		case c == '"':
+			j := i + 1
+			for ; j < len(filter); j++ {
+				if filter[j] == '\\' {
+					j++
+				} else if filter[j] == '"' {
+					break
+				}
+			}
+			if j >= len(filter) {
+				return nil, NewBadRequestError(ERROR_INVALID_FILTER, "unterminated string in filter")
+			}
+			tokens = append(tokens, filter[i:j+1])
+			i = j + 1
+		default:
+			j := i
+			for ; j < len(filter) && !strings.ContainsRune(" \t()[]\"", rune(filter[j])); j++ {
+			}
+			tokens = append(tokens, filter[i:j])
+			i = j
+		}
+	}
+	return tokens, nil
+}
+
+func (p *sFilterParser) peek() string {
+	if p.pos < len(p.tokens) {
+		return p.tokens[p.pos]
+	}
+	return ""
+}
+
+func (p *sFilterParser) next() string {
+	tok := p.peek()
+	p.pos++
+	return tok
+}
+
+func (p *sFilterParser) expect(tok string) error {
+	if p.next() != tok {
+		return NewBadRequestError(ERROR_INVALID_FILTER, "expect %q in filter", tok)
+	}
+	return nil
+}
+
+func (p *sFilterParser) parseOr() (IFilter, error) {
+	left, err := p.parseAnd()
+	if err != nil {
+		return nil, err
+	}
+	for strings.EqualFold(p.peek(), FILTER_OP_OR) {
+		p.next()
+		right, err := p.parseAnd()
+		if err != nil {
+			return nil, err
+		}
+		left = &SLogicalFilter{Op: FILTER_OP_OR, Left: left, Right: right}
+	}
+	return left, nil
+}
+
+func (p *sFilterParser) parseAnd() (IFilter, error) {
+	left, err := p.parseUnary()
+	if err != nil {
+		return nil, err
+	}
+	for strings.EqualFold(p.peek(), FILTER_OP_AND) {
+		p.next()
+		right, err := p.parseUnary()
+		if err != nil {
+			return nil, err
+		}
+		left = &SLogicalFilter{Op: FILTER_OP_AND, Left: left, Right: right}
+	}
+	return left, nil
+}
+
+func (p *sFilterParser) parseUnary() (IFilter, error) {
+	tok := p.peek()
+	switch {
+	case strings.EqualFold(tok, FILTER_OP_NOT):
+		p.next()
+		if err := p.expect("("); err != nil {
+			return nil, err
+		}
+		f, err := p.parseOr()
+		if err != nil {
+			return nil, err
+		}
+		if err := p.expect(")"); err != nil {
+			return nil, err
+		}
+		return &SNotFilter{Filter: f}, nil
+	case tok == "(":
+		p.next()
+		f, err := p.parseOr()
+		if err != nil {
+			return nil, err
+		}
+		if err := p.expect(")"); err != nil {
+			return nil, err
+		}
+		return f, nil
+	}
+	return p.parseAttrExp()
+}
+
+func (p *sFilterParser) parseAttrExp() (IFilter, error) {
+	path := p.next()
+	if len(path) == 0 || strings.ContainsAny(path[:1], "()[]\"") {
+		return nil, NewBadRequestError(ERROR_INVALID_FILTER, "expect attribute path in filter")
+	}
+	path = TrimSchema(path)
+	if p.peek() == "[" {
+		p.next()
+		f, err := p.parseOr()
+		if err != nil {
+			return nil, err
+		}
+		if err := p.expect("]"); err != nil {
+			return nil, err
+		}
+		return &SValuePathFilter{Path: path, Filter: f}, nil
+	}
+	op := strings.ToLower(p.next())
+	if op == FILTER_OP_PR {
+		return &SAttrFilter{Path: path, Op: op}, nil
+	}
+	if !utils.IsInStringArray(op, compareOperators) {
+		return nil, NewBadRequestError(ERROR_INVALID_FILTER, "invalid operator %q in filter", op)
+	}
+	val, err := parseCompValue(p.next())
+	if err != nil {
+		return nil, err
+	}
+	return &SAttrFilter{Path: path, Op: op, Value: val}, nil
+}
+
+func parseCompValue(tok string) (interface{}, error) {
+	if strings.HasPrefix(tok, "\"") {
+		str, err := strconv.Unquote(tok)
+		if err != nil {
+			return nil, NewBadRequestError(ERROR_INVALID_FILTER, "invalid string %s in filter", tok)
+		}
+		return str, nil
+	}
+	switch strings.ToLower(tok) {
+	case "true":
+		return true, nil
+	case "false":
+		return false, nil
+	case "null":
+		return nil, nil
+	}
+	f, err := strconv.ParseFloat(tok, 64)
+	if err != nil {
+		return nil, NewBadRequestError(ERROR_INVALID_FILTER, "invalid value %q in filter", tok)
+	}
+	return f, nil
+}
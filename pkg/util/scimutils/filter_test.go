@@ -0,0 +1,101 @@
+// Copyright 2019 Yunion
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package scimutils
+
+import (
+	"testing"
+
+	"yunion.io/x/jsonutils"
+)
+
+func TestParseFilter(t *testing.T) {
+	cases := []struct {
+		filter string
+		want   string
+	}{
+		{`userName eq "bjensen"`, `userName eq "bjensen"`},
+		{`urn:ietf:params:scim:schemas:core:2.0:User:userName sw "J"`, `userName sw "J"`},
+		{`title pr`, `title pr`},
+		{`title pr and userType eq "Employee"`, `(title pr and userType eq "Employee")`},
+		{`a eq 1 or b eq true and c eq null`, `(a eq 1 or (b eq true and c eq null))`},
+		{`not (active eq false)`, `not (active eq false)`},
+		{`emails[type eq "work" and value co "@example.com"]`, `emails[(type eq "work" and value co "@example.com")]`},
+		{`displayName EQ "a \"quoted\" name"`, `displayName eq "a \"quoted\" name"`},
+	}
+	for _, c := range cases {
+		f, err := ParseFilter(c.filter)
+		if err != nil {
+			t.Errorf("ParseFilter(%s): %s", c.filter, err)
+			continue
+		}
+		if f.String() != c.want {
+			t.Errorf("ParseFilter(%s) got %s want %s", c.filter, f.String(), c.want)
+		}
+	}
+	for _, filter := range []string{
+		``,
+		`userName`,
+		`userName xx "a"`,
+		`userName eq "a`,
+		`(userName eq "a"`,
+		`emails[type eq "work"`,
+		`userName eq "a" foo`,
+	} {
+		_, err := ParseFilter(filter)
+		if err == nil {
+			t.Errorf("ParseFilter(%s) should fail", filter)
+		} else if e, ok := err.(*SError); !ok || e.ScimType != ERROR_INVALID_FILTER {
+			t.Errorf("ParseFilter(%s) unexpected error %s", filter, err)
+		}
+	}
+}
+
+func TestFilterMatch(t *testing.T) {
+	user := jsonutils.Marshal(map[string]interface{}{
+		"userName": "BJensen",
+		"active":   true,
+		"name": map[string]string{
+			"familyName": "Jensen",
+		},
+		"emails": []map[string]string{
+			{"type": "work", "value": "bjensen@example.com"},
+			{"type": "home", "value": "babs@jensen.org"},
+		},
+	})
+	cases := []struct {
+		filter string
+		want   bool
+	}{
+		{`userName eq "bjensen"`, true},
+		{`username ne "bjensen"`, false},
+		{`name.familyName co "ens"`, true},
+		{`name.givenName pr`, false},
+		{`active eq true`, true},
+		{`emails.value ew "jensen.org"`, true},
+		{`emails[type eq "work" and value sw "babs"]`, false},
+		{`emails[type eq "home" and value sw "babs"]`, true},
+		{`not (userName sw "b") or active eq true`, true},
+		{`userName gt "a" and userName lt "c"`, true},
+	}
+	for _, c := range cases {
+		f, err := ParseFilter(c.filter)
+		if err != nil {
+			t.Fatalf("ParseFilter(%s): %s", c.filter, err)
+		}
+		if got := f.Match(user); got != c.want {
+			t.Errorf("%s match got %v want %v", c.filter, got, c.want)
+		}
+	}
+}
@@ -0,0 +1,277 @@
+// Copyright 2019 Yunion
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package scimutils
+
+import (
+	"strings"
+
+	"yunion.io/x/jsonutils"
+	"yunion.io/x/pkg/utils"
+)
+
+// SPath is the parsed form of the path of a PATCH operation, e.g.
+// members[value eq "2819c223"].display
+type SPath struct {
+	Attr    string
+	Filter  IFilter
+	SubAttr string
+}
+
+func ParsePath(path string) (*SPath, error) {
+	path = TrimSchema(strings.TrimSpace(path))
+	ret := SPath{}
+	if pos := strings.IndexByte(path, '['); pos >= 0 {
+		end := strings.LastIndexByte(path, ']')
+		if end < pos {
+			return nil, NewBadRequestError(ERROR_INVALID_PATH, "invalid path %q", path)
+		}
+		filter, err := ParseFilter(path[pos+1 : end])
+		if err != nil {
+			return nil, err
+		}
+		ret.Attr = path[:pos]
+		ret.Filter = filter
+		rest := path[end+1:]
+		if len(rest) > 0 {
+			if rest[0] != '.' || len(rest) == 1 {
+				return nil, NewBadRequestError(ERROR_INVALID_PATH, "invalid path %q", path)
+			}
+			ret.SubAttr = rest[1:]
+		}
+	} else {
+		ret.Attr, ret.SubAttr = splitAttrPath(path)
+	}
+	if len(ret.Attr) == 0 {
+		return nil, NewBadRequestError(ERROR_INVALID_PATH, "invalid path %q", path)
+	}
+	return &ret, nil
+}
+
+// ApplyPatch applies the operations of a PATCH request, RFC 7644 section
+// 3.5.2, on the JSON representation of a resource
+func ApplyPatch(res *jsonutils.JSONDict, ops []SPatchOperation) error {
+	for _, op := range ops {
+		opName := strings.ToLower(op.Op)
+		if !utils.IsInStringArray(opName, []string{PATCH_OP_ADD, PATCH_OP_REPLACE, PATCH_OP_REMOVE}) {
+			return NewBadRequestError(ERROR_INVALID_SYNTAX, "invalid patch operation %q", op.Op)
+		}
+		if len(op.Path) > 0 {
+			err := applyPathOp(res, opName, op.Path, op.Value)
+			if err != nil {
+				return err
+			}
+			continue
+		}
+		if opName == PATCH_OP_REMOVE {
+			return NewBadRequestError(ERROR_NO_TARGET, "path is required by remove operation")
+		}
+		dict, ok := op.Value.(*jsonutils.JSONDict)
+		if !ok {
+			return NewBadRequestError(ERROR_INVALID_VALUE, "value of %s operation without path must be an object", opName)
+		}
+		for _, k := range dict.SortedKeys() {
+			v, _ := dict.Get(k)
+			err := applyPathOp(res, opName, k, v)
+			if err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+func applyPathOp(res *jsonutils.JSONDict, op string, pathStr string, value jsonutils.JSONObject) error {
+	path, err := ParsePath(pathStr)
+	if err != nil {
+		return err
+	}
+	if op != PATCH_OP_REMOVE && value == nil {
+		return NewBadRequestError(ERROR_INVALID_VALUE, "value is required by %s operation on %s", op, pathStr)
+	}
+	key, cur := getAttr(res, path.Attr)
+	if len(key) == 0 {
+		key = path.Attr
+	}
+	if path.Filter != nil {
+		return applyFilteredOp(res, key, cur, path, op, value)
+	}
+	if len(path.SubAttr) > 0 {
+		switch v := cur.(type) {
+		case *jsonutils.JSONArray:
+			for _, item := range v.Value() {
+				if dict, ok := item.(*jsonutils.JSONDict); ok {
+					applySubAttrOp(dict, op, path.SubAttr, value)
+				}
+			}
+		case *jsonutils.JSONDict:
+			applySubAttrOp(v, op, path.SubAttr, value)
+		default:
+			if op == PATCH_OP_REMOVE {
+				return nil
+			}
+			dict := jsonutils.NewDict()
+			applySubAttrOp(dict, op, path.SubAttr, value)
+			res.Set(key, dict)
+		}
+		return nil
+	}
+	switch op {
+	case PATCH_OP_REMOVE:
+		if cur == nil {
+			return nil
+		}
+		if arr, ok := cur.(*jsonutils.JSONArray); ok && value != nil {
+			// remove the given values from a multi-valued attribute
+			removes := toItems(value)
+			items := make([]jsonutils.JSONObject, 0)
+			for _, item := range arr.Value() {
+				if !containsItem(removes, item) {
+					items = append(items, item)
+				}
+			}
+			res.Set(key, jsonutils.NewArray(items...))
+		} else {
+			res.Remove(key)
+		}
+	case PATCH_OP_ADD:
+		switch v := cur.(type) {
+		case *jsonutils.JSONArray:
+			items := v.Value()
+			for _, item := range toItems(value) {
+				if !containsItem(items, item) {
+					items = append(items, item)
+				}
+			}
+			res.Set(key, jsonutils.NewArray(items...))
+		case *jsonutils.JSONDict:
+			mergeDict(v, value)
+		default:
+			res.Set(key, value)
+		}
+	case PATCH_OP_REPLACE:
+		if dict, ok := cur.(*jsonutils.JSONDict); ok {
+			// sub-attributes not specified are left unchanged
+			mergeDict(dict, value)
+		} else {
+			res.Set(key, value)
+		}
+	}
+	return nil
+}
+
+func applyFilteredOp(res *jsonutils.JSONDict, key string, cur jsonutils.JSONObject, path *SPath, op string, value jsonutils.JSONObject) error {
+	var items []jsonutils.JSONObject
+	if arr, ok := cur.(*jsonutils.JSONArray); ok {
+		items = arr.Value()
+	} else if cur != nil {
+		items = []jsonutils.JSONObject{cur}
+	}
+	matched := false
+	newItems := make([]jsonutils.JSONObject, 0, len(items))
+	for _, item := range items {
+		if !path.Filter.Match(item) {
+			newItems = append(newItems, item)
+			continue
+		}
+		matched = true
+		if len(path.SubAttr) > 0 {
+			if dict, ok := item.(*jsonutils.JSONDict); ok {
+				applySubAttrOp(dict, op, path.SubAttr, value)
+			}
+			newItems = append(newItems, item)
+			continue
+		}
+		switch op {
+		case PATCH_OP_REMOVE:
+		case PATCH_OP_ADD:
+			if dict, ok := item.(*jsonutils.JSONDict); ok {
+				mergeDict(dict, value)
+				newItems = append(newItems, dict)
+			} else {
+				newItems = append(newItems, value)
+			}
+		case PATCH_OP_REPLACE:
+			newItems = append(newItems, value)
+		}
+	}
+	if !matched {
+		if op == PATCH_OP_REMOVE {
+			return nil
+		}
+		// create the item, e.g. emails[type eq "work"].value for a user
+		// without work email
+		attrFilter, ok := path.Filter.(*SAttrFilter)
+		if !ok || attrFilter.Op != FILTER_OP_EQ {
+			return NewBadRequestError(ERROR_NO_TARGET, "no item of %s matches %s", path.Attr, path.Filter)
+		}
+		item := jsonutils.NewDict()
+		item.Set(attrFilter.Path, jsonutils.Marshal(attrFilter.Value))
+		if len(path.SubAttr) > 0 {
+			applySubAttrOp(item, op, path.SubAttr, value)
+		} else {
+			mergeDict(item, value)
+		}
+		newItems = append(newItems, item)
+	}
+	res.Set(key, jsonutils.NewArray(newItems...))
+	return nil
+}
+
+func applySubAttrOp(dict *jsonutils.JSONDict, op string, subAttr string, value jsonutils.JSONObject) {
+	key, _ := getAttr(dict, subAttr)
+	if len(key) == 0 {
+		key = subAttr
+	}
+	if op == PATCH_OP_REMOVE {
+		dict.Remove(key)
+	} else {
+		dict.Set(key, value)
+	}
+}
+
+func mergeDict(dict *jsonutils.JSONDict, value jsonutils.JSONObject) {
+	if v, ok := value.(*jsonutils.JSONDict); ok {
+		for k, val := range v.Value() {
+			applySubAttrOp(dict, PATCH_OP_REPLACE, k, val)
+		}
+	}
+}
+
+func toItems(value jsonutils.JSONObject) []jsonutils.JSONObject {
+	if arr, ok := value.(*jsonutils.JSONArray); ok {
+		return arr.Value()
+	}
+	return []jsonutils.JSONObject{value}
+}
+
+// sameItem compares two items of a multi-valued attribute by their value
+// sub-attribute if exists
+func sameItem(a, b jsonutils.JSONObject) bool {
+	_, va := getAttr(a, "value")
+	_, vb := getAttr(b, "value")
+	if va != nil && vb != nil {
+		return va.String() == vb.String()
+	}
+	return a.String() == b.String()
+}
+
+func containsItem(items []jsonutils.JSONObject, item jsonutils.JSONObject) bool {
+	for i := range items {
+		if sameItem(items[i], item) {
+			return true
+		}
+	}
+	return false
+}
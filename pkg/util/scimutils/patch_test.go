@@ -0,0 +1,133 @@
+// Copyright 2019 Yunion
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package scimutils
+
+import (
+	"testing"
+
+	"yunion.io/x/jsonutils"
+)
+
+func TestApplyPatch(t *testing.T) {
+	cases := []struct {
+		name string
+		res  string
+		ops  string
+		want string
+	}{
+		{
+			name: "replace without path",
+			res:  `{"userName":"a","active":true,"name":{"givenName":"A","familyName":"B"}}`,
+			ops:  `[{"op":"replace","value":{"active":false,"name":{"givenName":"C"}}}]`,
+			want: `{"active":false,"name":{"familyName":"B","givenName":"C"},"userName":"a"}`,
+		},
+		{
+			name: "replace sub attribute with dotted key",
+			res:  `{"userName":"a"}`,
+			ops:  `[{"op":"Replace","value":{"name.givenName":"C","urn:ietf:params:scim:schemas:core:2.0:User:displayName":"D"}}]`,
+			want: `{"displayName":"D","name":{"givenName":"C"},"userName":"a"}`,
+		},
+		{
+			name: "add to filtered item creates item",
+			res:  `{"emails":[{"type":"home","value":"h@example.com"}]}`,
+			ops:  `[{"op":"Add","path":"emails[type eq \"work\"].value","value":"w@example.com"}]`,
+			want: `{"emails":[{"type":"home","value":"h@example.com"},{"type":"work","value":"w@example.com"}]}`,
+		},
+		{
+			name: "replace filtered item",
+			res:  `{"emails":[{"type":"work","value":"old@example.com"}]}`,
+			ops:  `[{"op":"replace","path":"emails[type eq \"work\"].value","value":"new@example.com"}]`,
+			want: `{"emails":[{"type":"work","value":"new@example.com"}]}`,
+		},
+		{
+			name: "add members",
+			res:  `{"displayName":"g","members":[{"value":"u1"}]}`,
+			ops:  `[{"op":"add","path":"members","value":[{"value":"u1"},{"value":"u2"}]}]`,
+			want: `{"displayName":"g","members":[{"value":"u1"},{"value":"u2"}]}`,
+		},
+		{
+			name: "remove member by filter",
+			res:  `{"members":[{"value":"u1"},{"value":"u2"}]}`,
+			ops:  `[{"op":"remove","path":"members[value eq \"u1\"]"}]`,
+			want: `{"members":[{"value":"u2"}]}`,
+		},
+		{
+			name: "remove member by value",
+			res:  `{"members":[{"value":"u1"},{"value":"u2"}]}`,
+			ops:  `[{"op":"Remove","path":"members","value":[{"value":"u2"}]}]`,
+			want: `{"members":[{"value":"u1"}]}`,
+		},
+		{
+			name: "remove attribute",
+			res:  `{"userName":"a","nickName":"b"}`,
+			ops:  `[{"op":"remove","path":"nickName"}]`,
+			want: `{"userName":"a"}`,
+		},
+		{
+			name: "add new attribute",
+			res:  `{"userName":"a"}`,
+			ops:  `[{"op":"add","path":"active","value":"False"}]`,
+			want: `{"active":"False","userName":"a"}`,
+		},
+	}
+	for _, c := range cases {
+		res, err := jsonutils.ParseString(c.res)
+		if err != nil {
+			t.Fatalf("%s: parse res: %s", c.name, err)
+		}
+		opsJson, err := jsonutils.ParseString(c.ops)
+		if err != nil {
+			t.Fatalf("%s: parse ops: %s", c.name, err)
+		}
+		ops := make([]SPatchOperation, 0)
+		err = opsJson.Unmarshal(&ops)
+		if err != nil {
+			t.Fatalf("%s: unmarshal ops: %s", c.name, err)
+		}
+		dict := res.(*jsonutils.JSONDict)
+		err = ApplyPatch(dict, ops)
+		if err != nil {
+			t.Errorf("%s: ApplyPatch: %s", c.name, err)
+			continue
+		}
+		if dict.String() != c.want {
+			t.Errorf("%s: got %s want %s", c.name, dict.String(), c.want)
+		}
+	}
+}
+
+func TestApplyPatchError(t *testing.T) {
+	cases := []struct {
+		ops      string
+		scimType string
+	}{
+		{`[{"op":"move","path":"userName","value":"a"}]`, ERROR_INVALID_SYNTAX},
+		{`[{"op":"remove"}]`, ERROR_NO_TARGET},
+		{`[{"op":"replace","value":"a"}]`, ERROR_INVALID_VALUE},
+		{`[{"op":"replace","path":"emails[type co \"w\"].value","value":"a"}]`, ERROR_NO_TARGET},
+		{`[{"op":"replace","path":"emails[type eq].value","value":"a"}]`, ERROR_INVALID_FILTER},
+	}
+	for _, c := range cases {
+		opsJson, _ := jsonutils.ParseString(c.ops)
+		ops := make([]SPatchOperation, 0)
+		opsJson.Unmarshal(&ops)
+		err := ApplyPatch(jsonutils.NewDict(), ops)
+		if err == nil {
+			t.Errorf("%s should fail", c.ops)
+		} else if e, ok := err.(*SError); !ok || e.ScimType != c.scimType {
+			t.Errorf("%s: unexpected error %s", c.ops, err)
+		}
+	}
+}
@@ -0,0 +1,185 @@
+// Copyright 2019 Yunion
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package scimutils
+
+import (
+	"strings"
+	"time"
+
+	"yunion.io/x/jsonutils"
+)
+
+type SMeta struct {
+	ResourceType string    `json:"resourceType"`
+	Created      time.Time `json:"created"`
+	LastModified time.Time `json:"lastModified"`
+	Location     string    `json:"location"`
+}
+
+type SName struct {
+	Formatted  string `json:"formatted"`
+	FamilyName string `json:"familyName"`
+	GivenName  string `json:"givenName"`
+	MiddleName string `json:"middleName"`
+}
+
+// SMultiValuedAttribute is the common sub-attributes of a multi-valued
+// attribute, e.g. emails, phoneNumbers, groups and members
+type SMultiValuedAttribute struct {
+	Value   string `json:"value"`
+	Display string `json:"display"`
+	Type    string `json:"type"`
+	Primary bool   `json:"primary,omitfalse"`
+	Ref     string `json:"$ref"`
+}
+
+type SUser struct {
+	Schemas    []string `json:"schemas"`
+	Id         string   `json:"id"`
+	ExternalId string   `json:"externalId"`
+
+	UserName    string `json:"userName"`
+	Name        *SName `json:"name"`
+	DisplayName string `json:"displayName"`
+	NickName    string `json:"nickName"`
+	Active      *bool  `json:"active"`
+
+	Emails       []SMultiValuedAttribute `json:"emails"`
+	PhoneNumbers []SMultiValuedAttribute `json:"phoneNumbers"`
+	Groups       []SMultiValuedAttribute `json:"groups"`
+
+	Meta *SMeta `json:"meta"`
+}
+
+type SGroup struct {
+	Schemas    []string `json:"schemas"`
+	Id         string   `json:"id"`
+	ExternalId string   `json:"externalId"`
+
+	DisplayName string                  `json:"displayName"`
+	Members     []SMultiValuedAttribute `json:"members"`
+
+	Meta *SMeta `json:"meta"`
+}
+
+type SListResponse struct {
+	Schemas      []string               `json:"schemas"`
+	TotalResults int                    `json:"totalResults"`
+	StartIndex   int                    `json:"startIndex"`
+	ItemsPerPage int                    `json:"itemsPerPage"`
+	Resources    []jsonutils.JSONObject `json:"Resources,allowempty"`
+}
+
+type SPatchOperation struct {
+	Op    string               `json:"op"`
+	Path  string               `json:"path"`
+	Value jsonutils.JSONObject `json:"value"`
+}
+
+type SPatchRequest struct {
+	Schemas    []string          `json:"schemas"`
+	Operations []SPatchOperation `json:"Operations"`
+}
+
+type SSupported struct {
+	Supported bool `json:"supported,allowfalse"`
+}
+
+type SFilterSupported struct {
+	Supported  bool `json:"supported,allowfalse"`
+	MaxResults int  `json:"maxResults"`
+}
+
+type SBulkSupported struct {
+	Supported      bool `json:"supported,allowfalse"`
+	MaxOperations  int  `json:"maxOperations"`
+	MaxPayloadSize int  `json:"maxPayloadSize"`
+}
+
+type SAuthenticationScheme struct {
+	Type        string `json:"type"`
+	Name        string `json:"name"`
+	Description string `json:"description"`
+	Primary     bool   `json:"primary,omitfalse"`
+}
+
+type SServiceProviderConfig struct {
+	Schemas               []string                `json:"schemas"`
+	DocumentationUri      string                  `json:"documentationUri"`
+	Patch                 SSupported              `json:"patch"`
+	Bulk                  SBulkSupported          `json:"bulk"`
+	Filter                SFilterSupported        `json:"filter"`
+	ChangePassword        SSupported              `json:"changePassword"`
+	Sort                  SSupported              `json:"sort"`
+	Etag                  SSupported              `json:"etag"`
+	AuthenticationSchemes []SAuthenticationScheme `json:"authenticationSchemes"`
+	Meta                  *SMeta                  `json:"meta"`
+}
+
+type SResourceType struct {
+	Schemas     []string `json:"schemas"`
+	Id          string   `json:"id"`
+	Name        string   `json:"name"`
+	Endpoint    string   `json:"endpoint"`
+	Description string   `json:"description"`
+	Schema      string   `json:"schema"`
+	Meta        *SMeta   `json:"meta"`
+}
+
+// GetPrimaryValue returns the value of the primary item of a multi-valued
+// attribute, falls back to the first item of type typ, then the first item
+func GetPrimaryValue(attrs []SMultiValuedAttribute, typ string) string {
+	for i := range attrs {
+		if attrs[i].Primary {
+			return attrs[i].Value
+		}
+	}
+	if len(typ) > 0 {
+		for i := range attrs {
+			if strings.EqualFold(attrs[i].Type, typ) {
+				return attrs[i].Value
+			}
+		}
+	}
+	if len(attrs) > 0 {
+		return attrs[0].Value
+	}
+	return ""
+}
+
+// GetDisplayName returns displayName of a user, falls back to the formatted
+// name, then the concatenation of given name and family name
+func (user SUser) GetDisplayName() string {
+	if len(user.DisplayName) > 0 {
+		return user.DisplayName
+	}
+	if user.Name == nil {
+		return ""
+	}
+	if len(user.Name.Formatted) > 0 {
+		return user.Name.Formatted
+	}
+	parts := make([]string, 0, 3)
+	for _, p := range []string{user.Name.GivenName, user.Name.MiddleName, user.Name.FamilyName} {
+		if len(p) > 0 {
+			parts = append(parts, p)
+		}
+	}
+	return strings.Join(parts, " ")
+}
+
+func (user SUser) IsActive() bool {
+	return user.Active == nil || *user.Active
+}
@@ -0,0 +1,78 @@
+// Copyright 2019 Yunion
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package seclib2
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"encoding/base64"
+	"fmt"
+	"io"
+)
+
+const (
+	DATA_KEY_BYTES = 32
+)
+
+// GenerateDataKey returns a random 256 bits key
+func GenerateDataKey() ([]byte, error) {
+	key := make([]byte, DATA_KEY_BYTES)
+	if _, err := io.ReadFull(rand.Reader, key); err != nil {
+		return nil, err
+	}
+	return key, nil
+}
+
+func newGCM(masterKey []byte) (cipher.AEAD, error) {
+	if len(masterKey) != DATA_KEY_BYTES {
+		return nil, fmt.Errorf("invalid master key length %d", len(masterKey))
+	}
+	block, err := aes.NewCipher(masterKey)
+	if err != nil {
+		return nil, err
+	}
+	return cipher.NewGCM(block)
+}
+
+// WrapKey encrypts key with masterKey by AES-256-GCM and returns base64 encoded nonce+ciphertext
+func WrapKey(masterKey, key []byte) (string, error) {
+	gcm, err := newGCM(masterKey)
+	if err != nil {
+		return "", err
+	}
+	nonce := make([]byte, gcm.NonceSize())
+	if _, err := io.ReadFull(rand.Reader, nonce); err != nil {
+		return "", err
+	}
+	return base64.StdEncoding.EncodeToString(gcm.Seal(nonce, nonce, key, nil)), nil
+}
+
+// UnwrapKey decrypts a key wrapped by WrapKey
+func UnwrapKey(masterKey []byte, wrapped string) ([]byte, error) {
+	gcm, err := newGCM(masterKey)
+	if err != nil {
+		return nil, err
+	}
+	secret, err := base64.StdEncoding.DecodeString(wrapped)
+	if err != nil {
+		return nil, err
+	}
+	if len(secret) < gcm.NonceSize() {
+		return nil, fmt.Errorf("wrapped key too short")
+	}
+	nonce := secret[:gcm.NonceSize()]
+	return gcm.Open(nil, nonce, secret[gcm.NonceSize():], nil)
+}
@@ -0,0 +1,51 @@
+// Copyright 2019 Yunion
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package seclib2
+
+import (
+	"bytes"
+	"testing"
+)
+
+func TestWrapKey(t *testing.T) {
+	masterKey, err := GenerateDataKey()
+	if err != nil {
+		t.Fatalf("GenerateDataKey error %s", err)
+	}
+	dataKey, err := GenerateDataKey()
+	if err != nil {
+		t.Fatalf("GenerateDataKey error %s", err)
+	}
+
+	wrapped, err := WrapKey(masterKey, dataKey)
+	if err != nil {
+		t.Fatalf("wrap error %s", err)
+	}
+	unwrapped, err := UnwrapKey(masterKey, wrapped)
+	if err != nil {
+		t.Fatalf("unwrap error %s", err)
+	}
+	if !bytes.Equal(dataKey, unwrapped) {
+		t.Errorf("wrap/unwrap mismatch")
+	}
+
+	otherKey, _ := GenerateDataKey()
+	if _, err := UnwrapKey(otherKey, wrapped); err == nil {
+		t.Errorf("unwrap with another master key should fail")
+	}
+	if _, err := WrapKey([]byte("short"), dataKey); err == nil {
+		t.Errorf("wrap with short master key should fail")
+	}
+}
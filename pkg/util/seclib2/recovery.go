@@ -0,0 +1,64 @@
+// Copyright 2019 Yunion
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package seclib2
+
+import (
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"strings"
+)
+
+// letters and digits of recovery codes, the easily confused 0, 1, l and o
+// are left out
+const recoveryCodeChars = "23456789abcdefghijkmnpqrstuvwxyz"
+
+const recoveryCodeLength = 10
+
+// GenerateRecoveryCodes returns count one-time recovery codes in the form
+// of xxxxx-xxxxx
+func GenerateRecoveryCodes(count int) ([]string, error) {
+	codes := make([]string, count)
+	buf := make([]byte, recoveryCodeLength)
+	for i := range codes {
+		_, err := rand.Read(buf)
+		if err != nil {
+			return nil, err
+		}
+		code := make([]byte, 0, recoveryCodeLength+1)
+		for j, b := range buf {
+			if j == recoveryCodeLength/2 {
+				code = append(code, '-')
+			}
+			code = append(code, recoveryCodeChars[int(b)%len(recoveryCodeChars)])
+		}
+		codes[i] = string(code)
+	}
+	return codes, nil
+}
+
+// HashRecoveryCode returns the hash of a recovery code to store, case,
+// spaces and dashes of the code are ignored
+func HashRecoveryCode(code string) string {
+	code = strings.ToLower(code)
+	code = strings.Map(func(r rune) rune {
+		if r == '-' || r == ' ' {
+			return -1
+		}
+		return r
+	}, code)
+	sum := sha256.Sum256([]byte(code))
+	return hex.EncodeToString(sum[:])
+}
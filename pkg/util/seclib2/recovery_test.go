@@ -0,0 +1,50 @@
+// Copyright 2019 Yunion
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package seclib2
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestGenerateRecoveryCodes(t *testing.T) {
+	codes, err := GenerateRecoveryCodes(10)
+	if err != nil {
+		t.Fatalf("GenerateRecoveryCodes: %s", err)
+	}
+	if len(codes) != 10 {
+		t.Fatalf("want 10 codes, got %d", len(codes))
+	}
+	hashes := map[string]bool{}
+	for _, code := range codes {
+		if len(code) != 11 || code[5] != '-' {
+			t.Errorf("invalid code %s", code)
+		}
+		hashes[HashRecoveryCode(code)] = true
+	}
+	if len(hashes) != len(codes) {
+		t.Errorf("duplicate recovery codes %s", codes)
+	}
+	code := codes[0]
+	if HashRecoveryCode(strings.ToUpper(code)) != HashRecoveryCode(code) {
+		t.Errorf("hash should ignore case")
+	}
+	if HashRecoveryCode(strings.Replace(code, "-", " ", 1)) != HashRecoveryCode(code) {
+		t.Errorf("hash should ignore separators")
+	}
+	if HashRecoveryCode(code[:10]) == HashRecoveryCode(code) {
+		t.Errorf("hash of a different code should differ")
+	}
+}
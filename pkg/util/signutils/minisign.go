@@ -0,0 +1,112 @@
+// Copyright 2019 Yunion
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package signutils
+
+import (
+	"bytes"
+	"crypto/ed25519"
+	"crypto/sha256"
+	"encoding/base64"
+	"fmt"
+	"strings"
+
+	"yunion.io/x/pkg/errors"
+)
+
+// https://jedisct1.github.io/minisign/
+const (
+	minisignAlgorithm       = "Ed"
+	minisignHashedAlgorithm = "ED"
+
+	minisignKeyIdSize   = 8
+	minisignKeySize     = 2 + minisignKeyIdSize + ed25519.PublicKeySize
+	minisignSigSize     = 2 + minisignKeyIdSize + ed25519.SignatureSize
+	minisignCommentLine = "untrusted comment:"
+	minisignTrustedLine = "trusted comment:"
+)
+
+func minisignLines(data string) []string {
+	lines := make([]string, 0)
+	for _, line := range strings.Split(data, "\n") {
+		line = strings.TrimSpace(line)
+		if len(line) == 0 || strings.HasPrefix(line, minisignCommentLine) {
+			continue
+		}
+		lines = append(lines, line)
+	}
+	return lines
+}
+
+func parseMinisignPublicKey(data string) (*SPublicKey, error) {
+	lines := minisignLines(data)
+	if len(lines) != 1 {
+		return nil, errors.Wrap(ErrInvalidKey, "unknown public key format")
+	}
+	raw, err := base64.StdEncoding.DecodeString(lines[0])
+	if err != nil {
+		return nil, errors.Wrapf(ErrInvalidKey, "base64 decode: %s", err)
+	}
+	if len(raw) != minisignKeySize || string(raw[:2]) != minisignAlgorithm {
+		return nil, errors.Wrap(ErrInvalidKey, "invalid minisign public key")
+	}
+	pub := ed25519.PublicKey(raw[2+minisignKeyIdSize:])
+	return &SPublicKey{
+		Algorithm:   KEY_ALGORITHM_MINISIGN,
+		Fingerprint: fmt.Sprintf("%x", sha256.Sum256(pub)),
+		key:         pub,
+		keyId:       raw[2 : 2+minisignKeyIdSize],
+	}, nil
+}
+
+// only the prehashed signatures are supported, legacy signatures sign the whole file
+func (key *SPublicKey) verifyMinisign(digests *SDigests, signature string) error {
+	lines := minisignLines(signature)
+	if len(lines) == 0 {
+		return errors.Wrap(ErrInvalidSignature, "empty signature")
+	}
+	sig, err := base64.StdEncoding.DecodeString(lines[0])
+	if err != nil {
+		return errors.Wrapf(ErrInvalidSignature, "base64 decode: %s", err)
+	}
+	if len(sig) != minisignSigSize {
+		return errors.Wrap(ErrInvalidSignature, "invalid minisign signature")
+	}
+	if string(sig[:2]) != minisignHashedAlgorithm {
+		return errors.Wrapf(ErrInvalidSignature, "unsupported signature algorithm %s", sig[:2])
+	}
+	if !bytes.Equal(sig[2:2+minisignKeyIdSize], key.keyId) {
+		return errors.Wrap(ErrVerificationFailed, "key id mismatch")
+	}
+	if len(digests.Blake2b512) == 0 {
+		return errors.Wrap(ErrVerificationFailed, "missing blake2b digest")
+	}
+	pub := key.key.(ed25519.PublicKey)
+	if !ed25519.Verify(pub, digests.Blake2b512, sig[2+minisignKeyIdSize:]) {
+		return ErrVerificationFailed
+	}
+	// the global signature covers the signature and the trusted comment
+	if len(lines) >= 3 && strings.HasPrefix(lines[1], minisignTrustedLine) {
+		comment := strings.TrimPrefix(lines[1], minisignTrustedLine+" ")
+		globalSig, err := base64.StdEncoding.DecodeString(lines[2])
+		if err != nil {
+			return errors.Wrapf(ErrInvalidSignature, "base64 decode global signature: %s", err)
+		}
+		msg := append(append([]byte{}, sig[2+minisignKeyIdSize:]...), []byte(comment)...)
+		if !ed25519.Verify(pub, msg, globalSig) {
+			return errors.Wrap(ErrVerificationFailed, "invalid global signature")
+		}
+	}
+	return nil
+}
@@ -0,0 +1,166 @@
+// Copyright 2019 Yunion
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package signutils
+
+import (
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/pem"
+	"fmt"
+	"hash"
+	"io"
+	"os"
+	"strings"
+
+	"golang.org/x/crypto/blake2b"
+
+	"yunion.io/x/pkg/errors"
+)
+
+const (
+	// PEM encoded public keys, e.g. the keys generated by cosign,
+	// the signature is the base64 encoded signature of the SHA-256 digest
+	KEY_ALGORITHM_ECDSA = "ecdsa"
+	KEY_ALGORITHM_RSA   = "rsa"
+
+	// minisign public keys, the signature is the content of the .minisig file
+	KEY_ALGORITHM_MINISIGN = "minisign"
+)
+
+// SDigests holds the digests of the signed file, the signatures are
+// verified against the digests so that the file is read only once
+type SDigests struct {
+	Sha256     []byte
+	Blake2b512 []byte
+}
+
+// Digests calculates the SHA-256 digest, and the BLAKE2b-512 digest
+// if required by minisign keys, of the content of the reader
+func Digests(reader io.Reader, withBlake2b bool) (*SDigests, error) {
+	sha256sum := sha256.New()
+	writers := []io.Writer{sha256sum}
+	var blake2bsum hash.Hash
+	if withBlake2b {
+		blake2bsum, _ = blake2b.New512(nil)
+		writers = append(writers, blake2bsum)
+	}
+	_, err := io.Copy(io.MultiWriter(writers...), reader)
+	if err != nil {
+		return nil, errors.Wrap(err, "read")
+	}
+	digests := &SDigests{Sha256: sha256sum.Sum(nil)}
+	if withBlake2b {
+		digests.Blake2b512 = blake2bsum.Sum(nil)
+	}
+	return digests, nil
+}
+
+func FileDigests(filename string, withBlake2b bool) (*SDigests, error) {
+	fp, err := os.Open(filename)
+	if err != nil {
+		return nil, errors.Wrap(err, "Open")
+	}
+	defer fp.Close()
+	return Digests(fp, withBlake2b)
+}
+
+type SPublicKey struct {
+	Algorithm   string
+	Fingerprint string
+
+	key   crypto.PublicKey
+	keyId []byte
+}
+
+// ParsePublicKey parses a PEM encoded PKIX public key or a minisign public key
+func ParsePublicKey(data string) (*SPublicKey, error) {
+	data = strings.TrimSpace(data)
+	if strings.HasPrefix(data, "-----BEGIN") {
+		return parsePemPublicKey(data)
+	}
+	return parseMinisignPublicKey(data)
+}
+
+func parsePemPublicKey(data string) (*SPublicKey, error) {
+	block, _ := pem.Decode([]byte(data))
+	if block == nil {
+		return nil, errors.Wrap(ErrInvalidKey, "invalid PEM block")
+	}
+	pub, err := x509.ParsePKIXPublicKey(block.Bytes)
+	if err != nil {
+		return nil, errors.Wrapf(ErrInvalidKey, "ParsePKIXPublicKey: %s", err)
+	}
+	key := &SPublicKey{
+		Fingerprint: fmt.Sprintf("%x", sha256.Sum256(block.Bytes)),
+		key:         pub,
+	}
+	switch pub.(type) {
+	case *ecdsa.PublicKey:
+		key.Algorithm = KEY_ALGORITHM_ECDSA
+	case *rsa.PublicKey:
+		key.Algorithm = KEY_ALGORITHM_RSA
+	default:
+		// ed25519 signs the whole file instead of its digest, use minisign instead
+		return nil, errors.Wrapf(ErrUnsupportedKey, "%T", pub)
+	}
+	return key, nil
+}
+
+func (key *SPublicKey) RequireBlake2b() bool {
+	return key.Algorithm == KEY_ALGORITHM_MINISIGN
+}
+
+// Verify verifies the detached signature of the file with the given digests
+func (key *SPublicKey) Verify(digests *SDigests, signature string) error {
+	switch key.Algorithm {
+	case KEY_ALGORITHM_MINISIGN:
+		return key.verifyMinisign(digests, signature)
+	}
+	sig, err := base64.StdEncoding.DecodeString(strings.TrimSpace(signature))
+	if err != nil {
+		return errors.Wrapf(ErrInvalidSignature, "base64 decode: %s", err)
+	}
+	if len(digests.Sha256) != sha256.Size {
+		return errors.Wrap(ErrVerificationFailed, "missing sha256 digest")
+	}
+	switch pub := key.key.(type) {
+	case *ecdsa.PublicKey:
+		if !ecdsa.VerifyASN1(pub, digests.Sha256, sig) {
+			return ErrVerificationFailed
+		}
+	case *rsa.PublicKey:
+		err = rsa.VerifyPKCS1v15(pub, crypto.SHA256, digests.Sha256, sig)
+		if err != nil {
+			return errors.Wrap(ErrVerificationFailed, err.Error())
+		}
+	default:
+		return errors.Wrapf(ErrUnsupportedKey, "%T", pub)
+	}
+	return nil
+}
+
+// Verify the signature against each key, returns the index of the first key that verifies
+func VerifyWithKeys(keys []*SPublicKey, digests *SDigests, signature string) (int, error) {
+	for i := range keys {
+		if keys[i].Verify(digests, signature) == nil {
+			return i, nil
+		}
+	}
+	return -1, ErrVerificationFailed
+}
@@ -0,0 +1,161 @@
+// Copyright 2019 Yunion
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package signutils
+
+import (
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/pem"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"golang.org/x/crypto/blake2b"
+)
+
+func pemPublicKey(t *testing.T, pub crypto.PublicKey) string {
+	der, err := x509.MarshalPKIXPublicKey(pub)
+	if err != nil {
+		t.Fatalf("MarshalPKIXPublicKey: %s", err)
+	}
+	return string(pem.EncodeToMemory(&pem.Block{Type: "PUBLIC KEY", Bytes: der}))
+}
+
+func TestPemKeys(t *testing.T) {
+	content := []byte("image content")
+	digest := sha256.Sum256(content)
+	digests := &SDigests{Sha256: digest[:]}
+	tampered := sha256.Sum256([]byte("tampered content"))
+
+	ecKey, _ := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	ecSig, _ := ecdsa.SignASN1(rand.Reader, ecKey, digest[:])
+	rsaKey, _ := rsa.GenerateKey(rand.Reader, 2048)
+	rsaSig, _ := rsa.SignPKCS1v15(rand.Reader, rsaKey, crypto.SHA256, digest[:])
+
+	cases := []struct {
+		name string
+		pub  crypto.PublicKey
+		sig  []byte
+		algo string
+	}{
+		{"ecdsa", &ecKey.PublicKey, ecSig, KEY_ALGORITHM_ECDSA},
+		{"rsa", &rsaKey.PublicKey, rsaSig, KEY_ALGORITHM_RSA},
+	}
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			key, err := ParsePublicKey(pemPublicKey(t, c.pub))
+			if err != nil {
+				t.Fatalf("ParsePublicKey: %s", err)
+			}
+			if key.Algorithm != c.algo {
+				t.Errorf("algorithm want %s got %s", c.algo, key.Algorithm)
+			}
+			sig := base64.StdEncoding.EncodeToString(c.sig)
+			if err := key.Verify(digests, sig); err != nil {
+				t.Errorf("Verify: %s", err)
+			}
+			if err := key.Verify(&SDigests{Sha256: tampered[:]}, sig); err == nil {
+				t.Errorf("tampered digest should not verify")
+			}
+			if err := key.Verify(digests, "not base64!"); err == nil {
+				t.Errorf("invalid signature should not verify")
+			}
+		})
+	}
+
+	edPub, _, _ := ed25519.GenerateKey(rand.Reader)
+	if _, err := ParsePublicKey(pemPublicKey(t, edPub)); err == nil {
+		t.Errorf("ed25519 PEM key should be unsupported")
+	}
+}
+
+func minisignKeyPair(t *testing.T, keyId []byte) (string, ed25519.PrivateKey) {
+	pub, priv, err := ed25519.GenerateKey(rand.Reader)
+	if err != nil {
+		t.Fatalf("GenerateKey: %s", err)
+	}
+	raw := append(append([]byte(minisignAlgorithm), keyId...), pub...)
+	return fmt.Sprintf("untrusted comment: minisign public key\n%s\n", base64.StdEncoding.EncodeToString(raw)), priv
+}
+
+func minisignSign(priv ed25519.PrivateKey, keyId []byte, content []byte, comment string) string {
+	digest := blake2b.Sum512(content)
+	sig := ed25519.Sign(priv, digest[:])
+	globalSig := ed25519.Sign(priv, append(append([]byte{}, sig...), []byte(comment)...))
+	raw := append(append([]byte(minisignHashedAlgorithm), keyId...), sig...)
+	return fmt.Sprintf("untrusted comment: signature from minisign secret key\n%s\ntrusted comment: %s\n%s\n",
+		base64.StdEncoding.EncodeToString(raw), comment, base64.StdEncoding.EncodeToString(globalSig))
+}
+
+func TestMinisign(t *testing.T) {
+	keyId := []byte{1, 2, 3, 4, 5, 6, 7, 8}
+	pubStr, priv := minisignKeyPair(t, keyId)
+	key, err := ParsePublicKey(pubStr)
+	if err != nil {
+		t.Fatalf("ParsePublicKey: %s", err)
+	}
+	if key.Algorithm != KEY_ALGORITHM_MINISIGN || !key.RequireBlake2b() {
+		t.Fatalf("unexpected key %#v", key)
+	}
+
+	dir, err := ioutil.TempDir("", "signutils")
+	if err != nil {
+		t.Fatalf("TempDir: %s", err)
+	}
+	defer os.RemoveAll(dir)
+	content := []byte("image content")
+	filename := filepath.Join(dir, "image")
+	if err := ioutil.WriteFile(filename, content, 0644); err != nil {
+		t.Fatalf("WriteFile: %s", err)
+	}
+	digests, err := FileDigests(filename, true)
+	if err != nil {
+		t.Fatalf("FileDigests: %s", err)
+	}
+	if want := sha256.Sum256(content); string(digests.Sha256) != string(want[:]) {
+		t.Errorf("sha256 digest mismatch")
+	}
+
+	sig := minisignSign(priv, keyId, content, "timestamp:1600000000")
+	if err := key.Verify(digests, sig); err != nil {
+		t.Errorf("Verify: %s", err)
+	}
+	if _, err := VerifyWithKeys([]*SPublicKey{key}, digests, sig); err != nil {
+		t.Errorf("VerifyWithKeys: %s", err)
+	}
+
+	otherSig := minisignSign(priv, keyId, []byte("other content"), "timestamp:1600000000")
+	if err := key.Verify(digests, otherSig); err == nil {
+		t.Errorf("signature of other content should not verify")
+	}
+
+	otherPubStr, _ := minisignKeyPair(t, []byte{8, 7, 6, 5, 4, 3, 2, 1})
+	otherKey, _ := ParsePublicKey(otherPubStr)
+	if idx, err := VerifyWithKeys([]*SPublicKey{otherKey, key}, digests, sig); err != nil || idx != 1 {
+		t.Errorf("VerifyWithKeys want 1 got %d %v", idx, err)
+	}
+	if _, err := VerifyWithKeys([]*SPublicKey{otherKey}, digests, sig); err == nil {
+		t.Errorf("signature should not verify with other keys")
+	}
+}
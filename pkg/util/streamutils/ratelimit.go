@@ -0,0 +1,55 @@
+// Copyright 2019 Yunion
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package streamutils
+
+import (
+	"context"
+	"io"
+
+	"golang.org/x/time/rate"
+)
+
+type sRateLimitedReader struct {
+	ctx     context.Context
+	reader  io.Reader
+	limiter *rate.Limiter
+}
+
+// NewRateLimitedReader wraps reader so that reading from it consumes one
+// token of limiter per byte. The reader is returned as is if limiter is nil
+// or unlimited.
+func NewRateLimitedReader(ctx context.Context, reader io.Reader, limiter *rate.Limiter) io.Reader {
+	if limiter == nil || limiter.Limit() == rate.Inf {
+		return reader
+	}
+	return &sRateLimitedReader{
+		ctx:     ctx,
+		reader:  reader,
+		limiter: limiter,
+	}
+}
+
+func (r *sRateLimitedReader) Read(p []byte) (int, error) {
+	if burst := r.limiter.Burst(); len(p) > burst {
+		p = p[:burst]
+	}
+	n, err := r.reader.Read(p)
+	if n > 0 {
+		if werr := r.limiter.WaitN(r.ctx, n); werr != nil {
+			return n, werr
+		}
+	}
+	return n, err
+}
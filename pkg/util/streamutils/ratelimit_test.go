@@ -0,0 +1,56 @@
+// Copyright 2019 Yunion
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package streamutils
+
+import (
+	"bytes"
+	"context"
+	"io/ioutil"
+	"testing"
+	"time"
+
+	"golang.org/x/time/rate"
+)
+
+func TestNewRateLimitedReader(t *testing.T) {
+	data := bytes.Repeat([]byte("a"), 4096)
+
+	reader := NewRateLimitedReader(context.Background(), bytes.NewReader(data), nil)
+	if _, ok := reader.(*bytes.Reader); !ok {
+		t.Errorf("nil limiter should not wrap reader")
+	}
+
+	limiter := rate.NewLimiter(rate.Limit(8192), 1024)
+	start := time.Now()
+	reader = NewRateLimitedReader(context.Background(), bytes.NewReader(data), limiter)
+	got, err := ioutil.ReadAll(reader)
+	if err != nil {
+		t.Fatalf("read error: %v", err)
+	}
+	if !bytes.Equal(got, data) {
+		t.Errorf("data mismatch")
+	}
+	// burst of 1024 bytes is available at once, the rest 3072 bytes take at least 375ms
+	if elapsed := time.Since(start); elapsed < 300*time.Millisecond {
+		t.Errorf("read too fast: %s", elapsed)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	reader = NewRateLimitedReader(ctx, bytes.NewReader(data), rate.NewLimiter(rate.Limit(1), 4096))
+	if _, err := ioutil.ReadAll(reader); err == nil {
+		t.Errorf("read with canceled context should fail")
+	}
+}
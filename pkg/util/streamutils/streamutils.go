@@ -16,22 +16,25 @@ package streamutils
 
 import (
 	"crypto/md5"
+	"crypto/sha256"
 	"fmt"
 	"hash"
 	"io"
 )
 
 type SStreamProperty struct {
-	CheckSum string
-	Size     int64
+	CheckSum       string
+	Sha256CheckSum string
+	Size           int64
 }
 
 func StreamPipe(reader io.Reader, writer io.Writer, CalChecksum bool, callback func(saved int64)) (*SStreamProperty, error) {
 	sp := SStreamProperty{}
 
-	var md5sum hash.Hash
+	var md5sum, sha256sum hash.Hash
 	if CalChecksum {
 		md5sum = md5.New()
+		sha256sum = sha256.New()
 	}
 
 	buf := make([]byte, 4096)
@@ -41,6 +44,7 @@ func StreamPipe(reader io.Reader, writer io.Writer, CalChecksum bool, callback f
 			sp.Size += int64(n)
 			if CalChecksum {
 				md5sum.Write(buf[:n])
+				sha256sum.Write(buf[:n])
 			}
 			offset := 0
 			for offset < n {
@@ -64,6 +68,7 @@ func StreamPipe(reader io.Reader, writer io.Writer, CalChecksum bool, callback f
 
 	if CalChecksum {
 		sp.CheckSum = fmt.Sprintf("%x", md5sum.Sum(nil))
+		sp.Sha256CheckSum = fmt.Sprintf("%x", sha256sum.Sum(nil))
 	}
 	return &sp, nil
 }
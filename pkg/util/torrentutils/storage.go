@@ -0,0 +1,138 @@
+// Copyright 2019 Yunion
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package torrentutils
+
+import (
+	"os"
+	"sync"
+
+	"github.com/anacrolix/torrent/metainfo"
+	"github.com/anacrolix/torrent/storage"
+
+	"yunion.io/x/pkg/errors"
+)
+
+const (
+	ErrMultiFileTorrent = errors.Error("multi-file torrent is not supported")
+)
+
+type sSingleFileStorage struct {
+	path     string
+	complete bool
+}
+
+// NewSingleFileStorage returns a torrent storage that keeps the data of a
+// single file torrent in path, no matter what name the torrent info records.
+// If complete is true, the file is trusted to hold all pieces already,
+// otherwise pieces of an existing non-empty file are verified before use.
+func NewSingleFileStorage(path string, complete bool) storage.ClientImpl {
+	return &sSingleFileStorage{
+		path:     path,
+		complete: complete,
+	}
+}
+
+var _ storage.ClientImpl = (*sSingleFileStorage)(nil)
+
+func (s *sSingleFileStorage) OpenTorrent(info *metainfo.Info, infoHash metainfo.Hash) (storage.TorrentImpl, error) {
+	if len(info.Files) > 0 {
+		return nil, ErrMultiFileTorrent
+	}
+	verify := false
+	if !s.complete {
+		if fi, err := os.Stat(s.path); err == nil && fi.Size() > 0 {
+			verify = true
+		}
+	}
+	file, err := os.OpenFile(s.path, os.O_RDWR|os.O_CREATE, 0644)
+	if err != nil {
+		return nil, errors.Wrapf(err, "open %s", s.path)
+	}
+	if err := file.Truncate(info.TotalLength()); err != nil {
+		file.Close()
+		return nil, errors.Wrapf(err, "truncate %s", s.path)
+	}
+	t := &sSingleFileTorrent{
+		file:       file,
+		completion: make([]storage.Completion, info.NumPieces()),
+	}
+	for i := range t.completion {
+		switch {
+		case s.complete:
+			t.completion[i] = storage.Completion{Complete: true, Ok: true}
+		case verify:
+			t.completion[i] = storage.Completion{Ok: false}
+		default:
+			t.completion[i] = storage.Completion{Complete: false, Ok: true}
+		}
+	}
+	return t, nil
+}
+
+func (s *sSingleFileStorage) Close() error {
+	return nil
+}
+
+type sSingleFileTorrent struct {
+	lock       sync.Mutex
+	file       *os.File
+	completion []storage.Completion
+}
+
+func (t *sSingleFileTorrent) Piece(p metainfo.Piece) storage.PieceImpl {
+	return &sSingleFilePiece{
+		torrent: t,
+		piece:   p,
+	}
+}
+
+func (t *sSingleFileTorrent) Close() error {
+	return t.file.Close()
+}
+
+func (t *sSingleFileTorrent) setCompletion(index int, complete bool) {
+	t.lock.Lock()
+	defer t.lock.Unlock()
+	t.completion[index] = storage.Completion{Complete: complete, Ok: true}
+}
+
+type sSingleFilePiece struct {
+	torrent *sSingleFileTorrent
+	piece   metainfo.Piece
+}
+
+func (p *sSingleFilePiece) ReadAt(b []byte, off int64) (int, error) {
+	return p.torrent.file.ReadAt(b, p.piece.Offset()+off)
+}
+
+func (p *sSingleFilePiece) WriteAt(b []byte, off int64) (int, error) {
+	return p.torrent.file.WriteAt(b, p.piece.Offset()+off)
+}
+
+func (p *sSingleFilePiece) MarkComplete() error {
+	p.torrent.setCompletion(p.piece.Index(), true)
+	return nil
+}
+
+func (p *sSingleFilePiece) MarkNotComplete() error {
+	p.torrent.setCompletion(p.piece.Index(), false)
+	return nil
+}
+
+func (p *sSingleFilePiece) Completion() storage.Completion {
+	p.torrent.lock.Lock()
+	defer p.torrent.lock.Unlock()
+	return p.torrent.completion[p.piece.Index()]
+}
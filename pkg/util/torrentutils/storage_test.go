@@ -0,0 +1,101 @@
+// Copyright 2019 Yunion
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package torrentutils
+
+import (
+	"bytes"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/anacrolix/torrent/metainfo"
+)
+
+func TestSingleFileStorage(t *testing.T) {
+	dir, err := ioutil.TempDir("", "torrentutils")
+	if err != nil {
+		t.Fatalf("TempDir: %v", err)
+	}
+	defer os.RemoveAll(dir)
+
+	data := bytes.Repeat([]byte("0123456789"), 1000)
+	src := filepath.Join(dir, "image.qcow2")
+	if err := ioutil.WriteFile(src, data, 0644); err != nil {
+		t.Fatalf("WriteFile: %v", err)
+	}
+	info := metainfo.Info{PieceLength: 4096}
+	if err := info.BuildFromFilePath(src); err != nil {
+		t.Fatalf("BuildFromFilePath: %v", err)
+	}
+
+	// seeding an existing file trusts all pieces
+	seed, err := NewSingleFileStorage(src, true).OpenTorrent(&info, metainfo.Hash{})
+	if err != nil {
+		t.Fatalf("OpenTorrent seed: %v", err)
+	}
+	defer seed.Close()
+	if c := seed.Piece(info.Piece(0)).Completion(); !c.Ok || !c.Complete {
+		t.Errorf("seed piece should be complete, got %#v", c)
+	}
+
+	// a fresh download needs no verification and starts empty
+	dst := filepath.Join(dir, "cache.tmp")
+	fetch, err := NewSingleFileStorage(dst, false).OpenTorrent(&info, metainfo.Hash{})
+	if err != nil {
+		t.Fatalf("OpenTorrent fetch: %v", err)
+	}
+	for i := 0; i < info.NumPieces(); i++ {
+		p := info.Piece(i)
+		if c := fetch.Piece(p).Completion(); !c.Ok || c.Complete {
+			t.Errorf("piece %d should be known incomplete, got %#v", i, c)
+		}
+		buf := make([]byte, p.Length())
+		if _, err := seed.Piece(p).ReadAt(buf, 0); err != nil {
+			t.Fatalf("ReadAt piece %d: %v", i, err)
+		}
+		if _, err := fetch.Piece(p).WriteAt(buf, 0); err != nil {
+			t.Fatalf("WriteAt piece %d: %v", i, err)
+		}
+		fetch.Piece(p).MarkComplete()
+		if c := fetch.Piece(p).Completion(); !c.Complete {
+			t.Errorf("piece %d should be complete after MarkComplete", i)
+		}
+	}
+	fetch.Close()
+
+	got, err := ioutil.ReadFile(dst)
+	if err != nil {
+		t.Fatalf("ReadFile: %v", err)
+	}
+	if !bytes.Equal(got, data) {
+		t.Errorf("fetched data mismatch")
+	}
+
+	// resuming over a non-empty file requires verification
+	resume, err := NewSingleFileStorage(dst, false).OpenTorrent(&info, metainfo.Hash{})
+	if err != nil {
+		t.Fatalf("OpenTorrent resume: %v", err)
+	}
+	defer resume.Close()
+	if c := resume.Piece(info.Piece(0)).Completion(); c.Ok {
+		t.Errorf("resumed piece completion should be unknown, got %#v", c)
+	}
+
+	multi := metainfo.Info{PieceLength: 4096, Files: []metainfo.FileInfo{{Length: 1}}}
+	if _, err := NewSingleFileStorage(dst, false).OpenTorrent(&multi, metainfo.Hash{}); err != ErrMultiFileTorrent {
+		t.Errorf("multi-file torrent should be rejected, got %v", err)
+	}
+}
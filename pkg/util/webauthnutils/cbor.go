@@ -0,0 +1,202 @@
+// Copyright 2019 Yunion
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package webauthnutils
+
+import (
+	"encoding/binary"
+	"math"
+
+	"yunion.io/x/pkg/errors"
+)
+
+// decodeCBOR decodes the first CBOR data item of data, which is enough for
+// the attestation objects and COSE keys produced by authenticators. Unsigned
+// and negative integers are decoded to int64, byte strings to []byte, text
+// strings to string, arrays to []interface{} and maps to
+// map[interface{}]interface{}. Indefinite length items are not supported.
+// It returns the decoded item and the number of bytes consumed.
+func decodeCBOR(data []byte) (interface{}, int, error) {
+	return decodeCBORItem(data, 0)
+}
+
+const maxCBORDepth = 16
+
+func decodeCBORItem(data []byte, depth int) (interface{}, int, error) {
+	if depth > maxCBORDepth {
+		return nil, 0, errors.Wrap(ErrInvalidCBOR, "nested too deep")
+	}
+	if len(data) == 0 {
+		return nil, 0, errors.Wrap(ErrInvalidCBOR, "unexpected end of data")
+	}
+	major := data[0] >> 5
+	info := data[0] & 0x1f
+	if major == 7 {
+		return decodeCBORSimple(data, info)
+	}
+	arg, offset, err := decodeCBORArgument(data, info)
+	if err != nil {
+		return nil, 0, err
+	}
+	switch major {
+	case 0:
+		if arg > math.MaxInt64 {
+			return nil, 0, errors.Wrap(ErrInvalidCBOR, "integer overflow")
+		}
+		return int64(arg), offset, nil
+	case 1:
+		if arg > math.MaxInt64 {
+			return nil, 0, errors.Wrap(ErrInvalidCBOR, "integer overflow")
+		}
+		return -1 - int64(arg), offset, nil
+	case 2, 3:
+		if arg > uint64(len(data)-offset) {
+			return nil, 0, errors.Wrap(ErrInvalidCBOR, "string out of range")
+		}
+		end := offset + int(arg)
+		if major == 2 {
+			buf := make([]byte, arg)
+			copy(buf, data[offset:end])
+			return buf, end, nil
+		}
+		return string(data[offset:end]), end, nil
+	case 4:
+		if arg > uint64(len(data)) {
+			return nil, 0, errors.Wrap(ErrInvalidCBOR, "array out of range")
+		}
+		ret := make([]interface{}, 0, arg)
+		for i := uint64(0); i < arg; i++ {
+			item, n, err := decodeCBORItem(data[offset:], depth+1)
+			if err != nil {
+				return nil, 0, err
+			}
+			ret = append(ret, item)
+			offset += n
+		}
+		return ret, offset, nil
+	case 5:
+		if arg > uint64(len(data)) {
+			return nil, 0, errors.Wrap(ErrInvalidCBOR, "map out of range")
+		}
+		ret := make(map[interface{}]interface{}, arg)
+		for i := uint64(0); i < arg; i++ {
+			key, n, err := decodeCBORItem(data[offset:], depth+1)
+			if err != nil {
+				return nil, 0, err
+			}
+			offset += n
+			switch key.(type) {
+			case int64, string:
+			default:
+				return nil, 0, errors.Wrapf(ErrInvalidCBOR, "unsupported map key %v", key)
+			}
+			val, n, err := decodeCBORItem(data[offset:], depth+1)
+			if err != nil {
+				return nil, 0, err
+			}
+			offset += n
+			ret[key] = val
+		}
+		return ret, offset, nil
+	case 6:
+		// tags carry no meaning for webauthn, return the tagged item
+		item, n, err := decodeCBORItem(data[offset:], depth+1)
+		if err != nil {
+			return nil, 0, err
+		}
+		return item, offset + n, nil
+	}
+	return nil, 0, errors.Wrapf(ErrInvalidCBOR, "unsupported major type %d", major)
+}
+
+func decodeCBORArgument(data []byte, info byte) (uint64, int, error) {
+	switch {
+	case info < 24:
+		return uint64(info), 1, nil
+	case info == 24:
+		if len(data) < 2 {
+			break
+		}
+		return uint64(data[1]), 2, nil
+	case info == 25:
+		if len(data) < 3 {
+			break
+		}
+		return uint64(binary.BigEndian.Uint16(data[1:3])), 3, nil
+	case info == 26:
+		if len(data) < 5 {
+			break
+		}
+		return uint64(binary.BigEndian.Uint32(data[1:5])), 5, nil
+	case info == 27:
+		if len(data) < 9 {
+			break
+		}
+		return binary.BigEndian.Uint64(data[1:9]), 9, nil
+	default:
+		return 0, 0, errors.Wrapf(ErrInvalidCBOR, "unsupported additional information %d", info)
+	}
+	return 0, 0, errors.Wrap(ErrInvalidCBOR, "unexpected end of data")
+}
+
+func decodeCBORSimple(data []byte, info byte) (interface{}, int, error) {
+	switch info {
+	case 20:
+		return false, 1, nil
+	case 21:
+		return true, 1, nil
+	case 22, 23:
+		return nil, 1, nil
+	case 25:
+		if len(data) < 3 {
+			break
+		}
+		return halfToFloat(binary.BigEndian.Uint16(data[1:3])), 3, nil
+	case 26:
+		if len(data) < 5 {
+			break
+		}
+		return float64(math.Float32frombits(binary.BigEndian.Uint32(data[1:5]))), 5, nil
+	case 27:
+		if len(data) < 9 {
+			break
+		}
+		return math.Float64frombits(binary.BigEndian.Uint64(data[1:9])), 9, nil
+	default:
+		return nil, 0, errors.Wrapf(ErrInvalidCBOR, "unsupported simple value %d", info)
+	}
+	return nil, 0, errors.Wrap(ErrInvalidCBOR, "unexpected end of data")
+}
+
+func halfToFloat(h uint16) float64 {
+	exp := int(h>>10) & 0x1f
+	mant := float64(h & 0x3ff)
+	var val float64
+	switch exp {
+	case 0:
+		val = math.Ldexp(mant, -24)
+	case 31:
+		if mant == 0 {
+			val = math.Inf(1)
+		} else {
+			val = math.NaN()
+		}
+	default:
+		val = math.Ldexp(mant+1024, exp-25)
+	}
+	if h&0x8000 != 0 {
+		return -val
+	}
+	return val
+}
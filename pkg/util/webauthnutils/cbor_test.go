@@ -0,0 +1,144 @@
+// Copyright 2019 Yunion
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package webauthnutils
+
+import (
+	"bytes"
+	"encoding/binary"
+	"encoding/hex"
+	"reflect"
+	"testing"
+)
+
+// encodeCBOR is a minimal encoder used to build test data
+func encodeCBOR(obj interface{}) []byte {
+	header := func(major byte, arg uint64) []byte {
+		switch {
+		case arg < 24:
+			return []byte{major<<5 | byte(arg)}
+		case arg < 1<<8:
+			return []byte{major<<5 | 24, byte(arg)}
+		case arg < 1<<16:
+			buf := []byte{major<<5 | 25, 0, 0}
+			binary.BigEndian.PutUint16(buf[1:], uint16(arg))
+			return buf
+		default:
+			buf := []byte{major<<5 | 26, 0, 0, 0, 0}
+			binary.BigEndian.PutUint32(buf[1:], uint32(arg))
+			return buf
+		}
+	}
+	switch v := obj.(type) {
+	case int:
+		return encodeCBOR(int64(v))
+	case int64:
+		if v >= 0 {
+			return header(0, uint64(v))
+		}
+		return header(1, uint64(-1-v))
+	case []byte:
+		return append(header(2, uint64(len(v))), v...)
+	case string:
+		return append(header(3, uint64(len(v))), v...)
+	case bool:
+		if v {
+			return []byte{0xf5}
+		}
+		return []byte{0xf4}
+	case []interface{}:
+		buf := header(4, uint64(len(v)))
+		for i := range v {
+			buf = append(buf, encodeCBOR(v[i])...)
+		}
+		return buf
+	case map[interface{}]interface{}:
+		buf := header(5, uint64(len(v)))
+		for k, val := range v {
+			buf = append(buf, encodeCBOR(k)...)
+			buf = append(buf, encodeCBOR(val)...)
+		}
+		return buf
+	}
+	panic("unsupported type")
+}
+
+func TestDecodeCBOR(t *testing.T) {
+	// test vectors from RFC 8949 Appendix A
+	cases := []struct {
+		hex  string
+		want interface{}
+	}{
+		{"00", int64(0)},
+		{"1818", int64(24)},
+		{"1a000f4240", int64(1000000)},
+		{"20", int64(-1)},
+		{"3863", int64(-100)},
+		{"4401020304", []byte{1, 2, 3, 4}},
+		{"6449455446", "IETF"},
+		{"83010203", []interface{}{int64(1), int64(2), int64(3)}},
+		{"a201020304", map[interface{}]interface{}{int64(1): int64(2), int64(3): int64(4)}},
+		{"a26161016162820203", map[interface{}]interface{}{"a": int64(1), "b": []interface{}{int64(2), int64(3)}}},
+		{"f4", false},
+		{"f5", true},
+		{"f6", nil},
+		{"f93c00", float64(1)},
+		{"f9c400", float64(-4)},
+		{"fb3ff199999999999a", float64(1.1)},
+		{"c11a514b67b0", int64(1363896240)},
+	}
+	for _, c := range cases {
+		data, _ := hex.DecodeString(c.hex)
+		got, n, err := decodeCBOR(data)
+		if err != nil {
+			t.Errorf("decode %s: %s", c.hex, err)
+			continue
+		}
+		if n != len(data) {
+			t.Errorf("decode %s: consumed %d of %d bytes", c.hex, n, len(data))
+		}
+		if !reflect.DeepEqual(got, c.want) {
+			t.Errorf("decode %s: want %#v got %#v", c.hex, c.want, got)
+		}
+	}
+	for _, invalid := range []string{
+		"",
+		"1a0000",
+		"5f42010243030405ff",
+		"62ab",
+		"8201",
+		"a1f600",
+		"1c",
+	} {
+		data, _ := hex.DecodeString(invalid)
+		if _, _, err := decodeCBOR(data); err == nil {
+			t.Errorf("decode %s should fail", invalid)
+		}
+	}
+}
+
+func TestEncodeCBOR(t *testing.T) {
+	obj := map[interface{}]interface{}{
+		"fmt":     "none",
+		int64(-2): bytes.Repeat([]byte{1}, 300),
+		"list":    []interface{}{int64(-300), true},
+	}
+	got, n, err := decodeCBOR(encodeCBOR(obj))
+	if err != nil {
+		t.Fatalf("decode: %s", err)
+	}
+	if n != len(encodeCBOR(obj)) || !reflect.DeepEqual(got, obj) {
+		t.Errorf("round trip mismatch: %#v", got)
+	}
+}
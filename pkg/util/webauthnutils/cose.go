@@ -0,0 +1,156 @@
+// Copyright 2019 Yunion
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package webauthnutils
+
+import (
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/elliptic"
+	"crypto/rsa"
+	"crypto/sha256"
+	"encoding/asn1"
+	"math/big"
+
+	"yunion.io/x/pkg/errors"
+)
+
+// COSE algorithm identifiers, https://www.iana.org/assignments/cose/cose.xhtml
+const (
+	COSE_ALG_ES256 = -7
+	COSE_ALG_EDDSA = -8
+	COSE_ALG_RS256 = -257
+)
+
+// COSE key parameters, RFC 8152 section 7 and 13
+const (
+	coseKeyKty = 1
+	coseKeyAlg = 3
+
+	coseKeyCrv = -1
+	coseKeyX   = -2
+	coseKeyY   = -3
+	coseKeyN   = -1
+	coseKeyE   = -2
+
+	coseKtyOKP = 1
+	coseKtyEC2 = 2
+	coseKtyRSA = 3
+
+	coseCrvP256    = 1
+	coseCrvEd25519 = 6
+)
+
+// SupportedAlgorithms are the COSE algorithms accepted for new
+// credentials, in the order of preference
+var SupportedAlgorithms = []int64{
+	COSE_ALG_ES256,
+	COSE_ALG_EDDSA,
+	COSE_ALG_RS256,
+}
+
+// SPublicKey is a credential public key decoded from its COSE_Key form
+type SPublicKey struct {
+	Alg int64
+	Key crypto.PublicKey
+}
+
+// ParsePublicKey decodes a COSE_Key encoded credential public key
+func ParsePublicKey(data []byte) (*SPublicKey, error) {
+	obj, _, err := decodeCBOR(data)
+	if err != nil {
+		return nil, errors.Wrap(err, "decodeCBOR")
+	}
+	return parseCOSEKey(obj)
+}
+
+func parseCOSEKey(obj interface{}) (*SPublicKey, error) {
+	m, ok := obj.(map[interface{}]interface{})
+	if !ok {
+		return nil, errors.Wrap(ErrUnsupportedKey, "COSE key is not a map")
+	}
+	kty, _ := m[int64(coseKeyKty)].(int64)
+	alg, _ := m[int64(coseKeyAlg)].(int64)
+	ret := &SPublicKey{Alg: alg}
+	switch {
+	case kty == coseKtyEC2 && alg == COSE_ALG_ES256:
+		crv, _ := m[int64(coseKeyCrv)].(int64)
+		x, _ := m[int64(coseKeyX)].([]byte)
+		y, _ := m[int64(coseKeyY)].([]byte)
+		if crv != coseCrvP256 || len(x) != 32 || len(y) != 32 {
+			return nil, errors.Wrap(ErrUnsupportedKey, "invalid P-256 key")
+		}
+		pub := &ecdsa.PublicKey{
+			Curve: elliptic.P256(),
+			X:     new(big.Int).SetBytes(x),
+			Y:     new(big.Int).SetBytes(y),
+		}
+		if !pub.Curve.IsOnCurve(pub.X, pub.Y) {
+			return nil, errors.Wrap(ErrUnsupportedKey, "point not on curve")
+		}
+		ret.Key = pub
+	case kty == coseKtyOKP && alg == COSE_ALG_EDDSA:
+		crv, _ := m[int64(coseKeyCrv)].(int64)
+		x, _ := m[int64(coseKeyX)].([]byte)
+		if crv != coseCrvEd25519 || len(x) != ed25519.PublicKeySize {
+			return nil, errors.Wrap(ErrUnsupportedKey, "invalid Ed25519 key")
+		}
+		ret.Key = ed25519.PublicKey(x)
+	case kty == coseKtyRSA && alg == COSE_ALG_RS256:
+		n, _ := m[int64(coseKeyN)].([]byte)
+		e, _ := m[int64(coseKeyE)].([]byte)
+		if len(n) < 256 || len(e) == 0 || len(e) > 4 {
+			return nil, errors.Wrap(ErrUnsupportedKey, "invalid RSA key")
+		}
+		ret.Key = &rsa.PublicKey{
+			N: new(big.Int).SetBytes(n),
+			E: int(new(big.Int).SetBytes(e).Int64()),
+		}
+	default:
+		return nil, errors.Wrapf(ErrUnsupportedKey, "kty %d alg %d", kty, alg)
+	}
+	return ret, nil
+}
+
+// Verify checks the signature of data made by the credential private key
+func (key *SPublicKey) Verify(data []byte, sig []byte) error {
+	switch pub := key.Key.(type) {
+	case *ecdsa.PublicKey:
+		esig := struct {
+			R, S *big.Int
+		}{}
+		rest, err := asn1.Unmarshal(sig, &esig)
+		if err != nil || len(rest) > 0 {
+			return errors.Wrap(ErrVerificationFailed, "malformed ECDSA signature")
+		}
+		digest := sha256.Sum256(data)
+		if !ecdsa.Verify(pub, digest[:], esig.R, esig.S) {
+			return errors.Wrap(ErrVerificationFailed, "ECDSA signature mismatch")
+		}
+	case ed25519.PublicKey:
+		if !ed25519.Verify(pub, data, sig) {
+			return errors.Wrap(ErrVerificationFailed, "Ed25519 signature mismatch")
+		}
+	case *rsa.PublicKey:
+		digest := sha256.Sum256(data)
+		err := rsa.VerifyPKCS1v15(pub, crypto.SHA256, digest[:], sig)
+		if err != nil {
+			return errors.Wrap(ErrVerificationFailed, "RSA signature mismatch")
+		}
+	default:
+		return errors.Wrapf(ErrUnsupportedKey, "alg %d", key.Alg)
+	}
+	return nil
+}
@@ -0,0 +1,454 @@
+// Copyright 2019 Yunion
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package webauthnutils
+
+import (
+	"bytes"
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/base64"
+	"encoding/binary"
+	"strings"
+	"time"
+
+	"yunion.io/x/jsonutils"
+	"yunion.io/x/pkg/errors"
+	"yunion.io/x/pkg/utils"
+)
+
+const (
+	CEREMONY_CREATE = "webauthn.create"
+	CEREMONY_GET    = "webauthn.get"
+
+	CREDENTIAL_TYPE_PUBLIC_KEY = "public-key"
+
+	USER_VERIFICATION_REQUIRED  = "required"
+	USER_VERIFICATION_PREFERRED = "preferred"
+
+	ATTESTATION_NONE = "none"
+
+	RESIDENT_KEY_DISCOURAGED = "discouraged"
+
+	CHALLENGE_LENGTH = 32
+
+	MAX_CREDENTIAL_ID_LENGTH = 1023
+)
+
+// authenticator data flags, https://www.w3.org/TR/webauthn-2/#sctn-authenticator-data
+const (
+	FLAG_USER_PRESENT  = 0x01
+	FLAG_USER_VERIFIED = 0x04
+	FLAG_ATTESTED_DATA = 0x40
+	FLAG_EXTENSIONS    = 0x80
+)
+
+type SRelyingPartyEntity struct {
+	Id   string `json:"id"`
+	Name string `json:"name"`
+}
+
+type SUserEntity struct {
+	// base64url encoded user handle
+	Id          string `json:"id"`
+	Name        string `json:"name"`
+	DisplayName string `json:"displayName"`
+}
+
+type SCredentialParameter struct {
+	Type string `json:"type"`
+	Alg  int64  `json:"alg"`
+}
+
+type SCredentialDescriptor struct {
+	Type string `json:"type"`
+	// base64url encoded credential id
+	Id string `json:"id"`
+}
+
+type SAuthenticatorSelection struct {
+	ResidentKey      string `json:"residentKey"`
+	UserVerification string `json:"userVerification"`
+}
+
+// SCredentialCreationOptions is the JSON form of
+// PublicKeyCredentialCreationOptions, binary fields are base64url encoded
+type SCredentialCreationOptions struct {
+	Rp                     SRelyingPartyEntity     `json:"rp"`
+	User                   SUserEntity             `json:"user"`
+	Challenge              string                  `json:"challenge"`
+	PubKeyCredParams       []SCredentialParameter  `json:"pubKeyCredParams"`
+	Timeout                int64                   `json:"timeout"`
+	ExcludeCredentials     []SCredentialDescriptor `json:"excludeCredentials"`
+	AuthenticatorSelection SAuthenticatorSelection `json:"authenticatorSelection"`
+	Attestation            string                  `json:"attestation"`
+}
+
+// SCredentialRequestOptions is the JSON form of
+// PublicKeyCredentialRequestOptions, binary fields are base64url encoded
+type SCredentialRequestOptions struct {
+	Challenge        string                  `json:"challenge"`
+	Timeout          int64                   `json:"timeout"`
+	RpId             string                  `json:"rpId"`
+	AllowCredentials []SCredentialDescriptor `json:"allowCredentials"`
+	UserVerification string                  `json:"userVerification"`
+}
+
+type SAttestationResponse struct {
+	ClientDataJSON    string `json:"clientDataJSON"`
+	AttestationObject string `json:"attestationObject"`
+}
+
+// SCredentialCreationResponse is the JSON form of the PublicKeyCredential
+// returned by navigator.credentials.create()
+type SCredentialCreationResponse struct {
+	Id       string               `json:"id"`
+	RawId    string               `json:"rawId"`
+	Type     string               `json:"type"`
+	Response SAttestationResponse `json:"response"`
+}
+
+type SAssertionResponse struct {
+	ClientDataJSON    string `json:"clientDataJSON"`
+	AuthenticatorData string `json:"authenticatorData"`
+	Signature         string `json:"signature"`
+	UserHandle        string `json:"userHandle"`
+}
+
+// SCredentialAssertionResponse is the JSON form of the PublicKeyCredential
+// returned by navigator.credentials.get()
+type SCredentialAssertionResponse struct {
+	Id       string             `json:"id"`
+	RawId    string             `json:"rawId"`
+	Type     string             `json:"type"`
+	Response SAssertionResponse `json:"response"`
+}
+
+type SClientData struct {
+	Type        string `json:"type"`
+	Challenge   string `json:"challenge"`
+	Origin      string `json:"origin"`
+	CrossOrigin bool   `json:"crossOrigin"`
+}
+
+type SAuthenticatorData struct {
+	RpIdHash  []byte
+	Flags     byte
+	SignCount uint32
+
+	// attested credential data, only present on registration
+	Aaguid       []byte
+	CredentialId []byte
+	PublicKey    []byte
+}
+
+// SCredential is a registered credential
+type SCredential struct {
+	Id        []byte
+	PublicKey []byte
+	SignCount uint32
+	Aaguid    []byte
+}
+
+// SRelyingParty verifies the ceremonies of a relying party
+type SRelyingParty struct {
+	Id   string
+	Name string
+	// the allowed origins of the ceremonies, e.g. https://cloud.example.com
+	Origins []string
+	// require the authenticator to verify the user, e.g. by PIN or biometrics
+	RequireUserVerification bool
+}
+
+func EncodeBase64(data []byte) string {
+	return base64.RawURLEncoding.EncodeToString(data)
+}
+
+// DecodeBase64 decodes base64url encoded data, with or without padding
+func DecodeBase64(str string) ([]byte, error) {
+	return base64.RawURLEncoding.DecodeString(strings.TrimRight(str, "="))
+}
+
+func NewChallenge() ([]byte, error) {
+	challenge := make([]byte, CHALLENGE_LENGTH)
+	_, err := rand.Read(challenge)
+	if err != nil {
+		return nil, errors.Wrap(err, "rand.Read")
+	}
+	return challenge, nil
+}
+
+func (rp *SRelyingParty) userVerification() string {
+	if rp.RequireUserVerification {
+		return USER_VERIFICATION_REQUIRED
+	}
+	return USER_VERIFICATION_PREFERRED
+}
+
+func credentialDescriptors(credIds [][]byte) []SCredentialDescriptor {
+	ret := make([]SCredentialDescriptor, len(credIds))
+	for i := range credIds {
+		ret[i] = SCredentialDescriptor{
+			Type: CREDENTIAL_TYPE_PUBLIC_KEY,
+			Id:   EncodeBase64(credIds[i]),
+		}
+	}
+	return ret
+}
+
+// NewCreationOptions returns the options of a registration ceremony, the
+// already registered credentials are excluded so that an authenticator
+// is not registered twice
+func (rp *SRelyingParty) NewCreationOptions(challenge []byte, user SUserEntity, excludeCredIds [][]byte, timeout time.Duration) SCredentialCreationOptions {
+	params := make([]SCredentialParameter, len(SupportedAlgorithms))
+	for i, alg := range SupportedAlgorithms {
+		params[i] = SCredentialParameter{Type: CREDENTIAL_TYPE_PUBLIC_KEY, Alg: alg}
+	}
+	return SCredentialCreationOptions{
+		Rp:                 SRelyingPartyEntity{Id: rp.Id, Name: rp.Name},
+		User:               user,
+		Challenge:          EncodeBase64(challenge),
+		PubKeyCredParams:   params,
+		Timeout:            int64(timeout / time.Millisecond),
+		ExcludeCredentials: credentialDescriptors(excludeCredIds),
+		AuthenticatorSelection: SAuthenticatorSelection{
+			ResidentKey:      RESIDENT_KEY_DISCOURAGED,
+			UserVerification: rp.userVerification(),
+		},
+		Attestation: ATTESTATION_NONE,
+	}
+}
+
+// NewRequestOptions returns the options of an authentication ceremony
+func (rp *SRelyingParty) NewRequestOptions(challenge []byte, allowCredIds [][]byte, timeout time.Duration) SCredentialRequestOptions {
+	return SCredentialRequestOptions{
+		Challenge:        EncodeBase64(challenge),
+		Timeout:          int64(timeout / time.Millisecond),
+		RpId:             rp.Id,
+		AllowCredentials: credentialDescriptors(allowCredIds),
+		UserVerification: rp.userVerification(),
+	}
+}
+
+func (rp *SRelyingParty) verifyClientData(raw []byte, ceremony string, challenge []byte) error {
+	obj, err := jsonutils.Parse(raw)
+	if err != nil {
+		return errors.Wrap(ErrInvalidResponse, "invalid clientDataJSON")
+	}
+	clientData := SClientData{}
+	err = obj.Unmarshal(&clientData)
+	if err != nil {
+		return errors.Wrap(ErrInvalidResponse, "invalid clientDataJSON")
+	}
+	if clientData.Type != ceremony {
+		return errors.Wrapf(ErrVerificationFailed, "unexpected ceremony type %s", clientData.Type)
+	}
+	got, err := DecodeBase64(clientData.Challenge)
+	if err != nil || subtle.ConstantTimeCompare(got, challenge) != 1 {
+		return errors.Wrap(ErrVerificationFailed, "challenge mismatch")
+	}
+	if !utils.IsInStringArray(clientData.Origin, rp.Origins) {
+		return errors.Wrapf(ErrVerificationFailed, "unexpected origin %s", clientData.Origin)
+	}
+	if clientData.CrossOrigin {
+		return errors.Wrap(ErrVerificationFailed, "cross origin ceremony")
+	}
+	return nil
+}
+
+func (rp *SRelyingParty) verifyAuthenticatorData(authData *SAuthenticatorData) error {
+	rpIdHash := sha256.Sum256([]byte(rp.Id))
+	if !bytes.Equal(authData.RpIdHash, rpIdHash[:]) {
+		return errors.Wrap(ErrVerificationFailed, "rpIdHash mismatch")
+	}
+	if authData.Flags&FLAG_USER_PRESENT == 0 {
+		return errors.Wrap(ErrVerificationFailed, "user not present")
+	}
+	if rp.RequireUserVerification && authData.Flags&FLAG_USER_VERIFIED == 0 {
+		return errors.Wrap(ErrVerificationFailed, "user not verified")
+	}
+	return nil
+}
+
+// ParseAuthenticatorData decodes the authenticator data
+func ParseAuthenticatorData(data []byte) (*SAuthenticatorData, error) {
+	if len(data) < 37 {
+		return nil, errors.Wrap(ErrInvalidResponse, "authenticator data too short")
+	}
+	ret := &SAuthenticatorData{
+		RpIdHash:  data[:32],
+		Flags:     data[32],
+		SignCount: binary.BigEndian.Uint32(data[33:37]),
+	}
+	offset := 37
+	if ret.Flags&FLAG_ATTESTED_DATA != 0 {
+		if len(data) < offset+18 {
+			return nil, errors.Wrap(ErrInvalidResponse, "attested credential data too short")
+		}
+		ret.Aaguid = data[offset : offset+16]
+		idLen := int(binary.BigEndian.Uint16(data[offset+16 : offset+18]))
+		offset += 18
+		if idLen > MAX_CREDENTIAL_ID_LENGTH || len(data) < offset+idLen {
+			return nil, errors.Wrap(ErrInvalidResponse, "invalid credential id length")
+		}
+		ret.CredentialId = data[offset : offset+idLen]
+		offset += idLen
+		_, n, err := decodeCBOR(data[offset:])
+		if err != nil {
+			return nil, errors.Wrap(err, "decode credential public key")
+		}
+		ret.PublicKey = data[offset : offset+n]
+		offset += n
+	}
+	if ret.Flags&FLAG_EXTENSIONS != 0 {
+		_, n, err := decodeCBOR(data[offset:])
+		if err != nil {
+			return nil, errors.Wrap(err, "decode extensions")
+		}
+		offset += n
+	}
+	if offset != len(data) {
+		return nil, errors.Wrap(ErrInvalidResponse, "trailing bytes in authenticator data")
+	}
+	return ret, nil
+}
+
+// VerifyRegistration verifies the response of a registration ceremony and
+// returns the new credential. Attestation "none" is requested, so the
+// attestation statement is not verified and the credential is trusted on
+// first use.
+func (rp *SRelyingParty) VerifyRegistration(resp SCredentialCreationResponse, challenge []byte) (*SCredential, error) {
+	if resp.Type != CREDENTIAL_TYPE_PUBLIC_KEY {
+		return nil, errors.Wrapf(ErrInvalidResponse, "unsupported credential type %s", resp.Type)
+	}
+	clientDataJSON, err := DecodeBase64(resp.Response.ClientDataJSON)
+	if err != nil {
+		return nil, errors.Wrap(ErrInvalidResponse, "invalid clientDataJSON encoding")
+	}
+	err = rp.verifyClientData(clientDataJSON, CEREMONY_CREATE, challenge)
+	if err != nil {
+		return nil, err
+	}
+	attObjBytes, err := DecodeBase64(resp.Response.AttestationObject)
+	if err != nil {
+		return nil, errors.Wrap(ErrInvalidResponse, "invalid attestationObject encoding")
+	}
+	attObj, _, err := decodeCBOR(attObjBytes)
+	if err != nil {
+		return nil, errors.Wrap(err, "decode attestationObject")
+	}
+	attMap, ok := attObj.(map[interface{}]interface{})
+	if !ok {
+		return nil, errors.Wrap(ErrInvalidResponse, "attestationObject is not a map")
+	}
+	authDataBytes, ok := attMap["authData"].([]byte)
+	if !ok {
+		return nil, errors.Wrap(ErrInvalidResponse, "missing authData")
+	}
+	authData, err := ParseAuthenticatorData(authDataBytes)
+	if err != nil {
+		return nil, err
+	}
+	err = rp.verifyAuthenticatorData(authData)
+	if err != nil {
+		return nil, err
+	}
+	if authData.Flags&FLAG_ATTESTED_DATA == 0 {
+		return nil, errors.Wrap(ErrInvalidResponse, "missing attested credential data")
+	}
+	rawId, err := DecodeBase64(resp.RawId)
+	if err != nil || !bytes.Equal(rawId, authData.CredentialId) {
+		return nil, errors.Wrap(ErrVerificationFailed, "credential id mismatch")
+	}
+	_, err = ParsePublicKey(authData.PublicKey)
+	if err != nil {
+		return nil, errors.Wrap(err, "ParsePublicKey")
+	}
+	return &SCredential{
+		Id:        authData.CredentialId,
+		PublicKey: authData.PublicKey,
+		SignCount: authData.SignCount,
+		Aaguid:    authData.Aaguid,
+	}, nil
+}
+
+// FindCredential returns the index of the credential that made the
+// assertion, or -1 if it is not one of creds
+func (resp SCredentialAssertionResponse) FindCredential(creds []SCredential) int {
+	rawId, err := DecodeBase64(resp.RawId)
+	if err != nil {
+		return -1
+	}
+	for i := range creds {
+		if bytes.Equal(creds[i].Id, rawId) {
+			return i
+		}
+	}
+	return -1
+}
+
+// VerifyAssertion verifies the response of an authentication ceremony
+// made by cred and returns the new signature counter of the authenticator
+func (rp *SRelyingParty) VerifyAssertion(resp SCredentialAssertionResponse, challenge []byte, cred SCredential) (uint32, error) {
+	if resp.Type != CREDENTIAL_TYPE_PUBLIC_KEY {
+		return 0, errors.Wrapf(ErrInvalidResponse, "unsupported credential type %s", resp.Type)
+	}
+	rawId, err := DecodeBase64(resp.RawId)
+	if err != nil || !bytes.Equal(rawId, cred.Id) {
+		return 0, errors.Wrap(ErrVerificationFailed, "credential id mismatch")
+	}
+	clientDataJSON, err := DecodeBase64(resp.Response.ClientDataJSON)
+	if err != nil {
+		return 0, errors.Wrap(ErrInvalidResponse, "invalid clientDataJSON encoding")
+	}
+	err = rp.verifyClientData(clientDataJSON, CEREMONY_GET, challenge)
+	if err != nil {
+		return 0, err
+	}
+	authDataBytes, err := DecodeBase64(resp.Response.AuthenticatorData)
+	if err != nil {
+		return 0, errors.Wrap(ErrInvalidResponse, "invalid authenticatorData encoding")
+	}
+	authData, err := ParseAuthenticatorData(authDataBytes)
+	if err != nil {
+		return 0, err
+	}
+	err = rp.verifyAuthenticatorData(authData)
+	if err != nil {
+		return 0, err
+	}
+	sig, err := DecodeBase64(resp.Response.Signature)
+	if err != nil {
+		return 0, errors.Wrap(ErrInvalidResponse, "invalid signature encoding")
+	}
+	pubKey, err := ParsePublicKey(cred.PublicKey)
+	if err != nil {
+		return 0, errors.Wrap(err, "ParsePublicKey")
+	}
+	clientDataHash := sha256.Sum256(clientDataJSON)
+	signed := make([]byte, 0, len(authDataBytes)+len(clientDataHash))
+	signed = append(signed, authDataBytes...)
+	signed = append(signed, clientDataHash[:]...)
+	err = pubKey.Verify(signed, sig)
+	if err != nil {
+		return 0, err
+	}
+	// a counter not greater than the stored one signals a cloned
+	// authenticator, authenticators without counter always report 0
+	if (authData.SignCount > 0 || cred.SignCount > 0) && authData.SignCount <= cred.SignCount {
+		return 0, errors.Wrapf(ErrVerificationFailed, "signature counter %d not greater than %d", authData.SignCount, cred.SignCount)
+	}
+	return authData.SignCount, nil
+}
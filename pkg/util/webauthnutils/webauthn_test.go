@@ -0,0 +1,247 @@
+// Copyright 2019 Yunion
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package webauthnutils
+
+import (
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/binary"
+	"testing"
+
+	"yunion.io/x/jsonutils"
+	"yunion.io/x/pkg/errors"
+)
+
+const (
+	testRpId   = "cloud.example.com"
+	testOrigin = "https://cloud.example.com"
+)
+
+// sTestAuthenticator simulates an authenticator holding a single credential
+type sTestAuthenticator struct {
+	credId    []byte
+	signer    crypto.Signer
+	coseKey   []byte
+	signCount uint32
+}
+
+func newTestAuthenticator(t *testing.T, alg int64) *sTestAuthenticator {
+	auth := &sTestAuthenticator{credId: []byte("test-credential-id")}
+	switch alg {
+	case COSE_ALG_ES256:
+		priv, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+		if err != nil {
+			t.Fatalf("GenerateKey: %s", err)
+		}
+		x, y := make([]byte, 32), make([]byte, 32)
+		priv.X.FillBytes(x)
+		priv.Y.FillBytes(y)
+		auth.signer = priv
+		auth.coseKey = encodeCBOR(map[interface{}]interface{}{
+			int64(coseKeyKty): int64(coseKtyEC2),
+			int64(coseKeyAlg): int64(COSE_ALG_ES256),
+			int64(coseKeyCrv): int64(coseCrvP256),
+			int64(coseKeyX):   x,
+			int64(coseKeyY):   y,
+		})
+	case COSE_ALG_EDDSA:
+		pub, priv, err := ed25519.GenerateKey(rand.Reader)
+		if err != nil {
+			t.Fatalf("GenerateKey: %s", err)
+		}
+		auth.signer = priv
+		auth.coseKey = encodeCBOR(map[interface{}]interface{}{
+			int64(coseKeyKty): int64(coseKtyOKP),
+			int64(coseKeyAlg): int64(COSE_ALG_EDDSA),
+			int64(coseKeyCrv): int64(coseCrvEd25519),
+			int64(coseKeyX):   []byte(pub),
+		})
+	}
+	return auth
+}
+
+func (auth *sTestAuthenticator) authData(rpId string, flags byte, attested bool) []byte {
+	rpIdHash := sha256.Sum256([]byte(rpId))
+	data := append([]byte{}, rpIdHash[:]...)
+	data = append(data, flags)
+	counter := make([]byte, 4)
+	binary.BigEndian.PutUint32(counter, auth.signCount)
+	data = append(data, counter...)
+	if attested {
+		data = append(data, make([]byte, 16)...)
+		idLen := make([]byte, 2)
+		binary.BigEndian.PutUint16(idLen, uint16(len(auth.credId)))
+		data = append(data, idLen...)
+		data = append(data, auth.credId...)
+		data = append(data, auth.coseKey...)
+	}
+	return data
+}
+
+func clientDataJSON(ceremony string, challenge []byte, origin string) []byte {
+	clientData := jsonutils.Marshal(SClientData{
+		Type:      ceremony,
+		Challenge: EncodeBase64(challenge),
+		Origin:    origin,
+	})
+	return []byte(clientData.String())
+}
+
+func (auth *sTestAuthenticator) create(rpId string, origin string, challenge []byte) SCredentialCreationResponse {
+	attObj := encodeCBOR(map[interface{}]interface{}{
+		"fmt":      ATTESTATION_NONE,
+		"attStmt":  map[interface{}]interface{}{},
+		"authData": auth.authData(rpId, FLAG_USER_PRESENT|FLAG_USER_VERIFIED|FLAG_ATTESTED_DATA, true),
+	})
+	return SCredentialCreationResponse{
+		Id:    EncodeBase64(auth.credId),
+		RawId: EncodeBase64(auth.credId),
+		Type:  CREDENTIAL_TYPE_PUBLIC_KEY,
+		Response: SAttestationResponse{
+			ClientDataJSON:    EncodeBase64(clientDataJSON(CEREMONY_CREATE, challenge, origin)),
+			AttestationObject: EncodeBase64(attObj),
+		},
+	}
+}
+
+func (auth *sTestAuthenticator) get(t *testing.T, rpId string, origin string, challenge []byte, flags byte) SCredentialAssertionResponse {
+	auth.signCount++
+	authData := auth.authData(rpId, flags, false)
+	clientData := clientDataJSON(CEREMONY_GET, challenge, origin)
+	clientDataHash := sha256.Sum256(clientData)
+	signed := append(append([]byte{}, authData...), clientDataHash[:]...)
+	var sig []byte
+	var err error
+	if _, ok := auth.signer.(ed25519.PrivateKey); ok {
+		sig, err = auth.signer.Sign(rand.Reader, signed, crypto.Hash(0))
+	} else {
+		digest := sha256.Sum256(signed)
+		sig, err = auth.signer.Sign(rand.Reader, digest[:], crypto.SHA256)
+	}
+	if err != nil {
+		t.Fatalf("Sign: %s", err)
+	}
+	return SCredentialAssertionResponse{
+		Id:    EncodeBase64(auth.credId),
+		RawId: EncodeBase64(auth.credId),
+		Type:  CREDENTIAL_TYPE_PUBLIC_KEY,
+		Response: SAssertionResponse{
+			ClientDataJSON:    EncodeBase64(clientData),
+			AuthenticatorData: EncodeBase64(authData),
+			Signature:         EncodeBase64(sig),
+		},
+	}
+}
+
+func newChallenge(t *testing.T) []byte {
+	challenge, err := NewChallenge()
+	if err != nil {
+		t.Fatalf("NewChallenge: %s", err)
+	}
+	return challenge
+}
+
+func TestCeremonies(t *testing.T) {
+	rp := &SRelyingParty{
+		Id:      testRpId,
+		Name:    "test",
+		Origins: []string{testOrigin},
+	}
+	for _, alg := range []int64{COSE_ALG_ES256, COSE_ALG_EDDSA} {
+		auth := newTestAuthenticator(t, alg)
+
+		challenge := newChallenge(t)
+		cred, err := rp.VerifyRegistration(auth.create(testRpId, testOrigin, challenge), challenge)
+		if err != nil {
+			t.Fatalf("alg %d VerifyRegistration: %s", alg, err)
+		}
+		_, err = rp.VerifyRegistration(auth.create(testRpId, testOrigin, challenge), newChallenge(t))
+		if errors.Cause(err) != ErrVerificationFailed {
+			t.Errorf("alg %d registration with wrong challenge: %v", alg, err)
+		}
+		_, err = rp.VerifyRegistration(auth.create("evil.example.com", testOrigin, challenge), challenge)
+		if errors.Cause(err) != ErrVerificationFailed {
+			t.Errorf("alg %d registration with wrong rp id: %v", alg, err)
+		}
+
+		challenge = newChallenge(t)
+		resp := auth.get(t, testRpId, testOrigin, challenge, FLAG_USER_PRESENT)
+		if idx := resp.FindCredential([]SCredential{*cred}); idx != 0 {
+			t.Fatalf("alg %d FindCredential: %d", alg, idx)
+		}
+		signCount, err := rp.VerifyAssertion(resp, challenge, *cred)
+		if err != nil {
+			t.Fatalf("alg %d VerifyAssertion: %s", alg, err)
+		}
+		if signCount != auth.signCount {
+			t.Errorf("alg %d sign count: want %d got %d", alg, auth.signCount, signCount)
+		}
+
+		// replaying the assertion is rejected by the signature counter
+		cred.SignCount = signCount
+		_, err = rp.VerifyAssertion(resp, challenge, *cred)
+		if errors.Cause(err) != ErrVerificationFailed {
+			t.Errorf("alg %d replayed assertion: %v", alg, err)
+		}
+
+		challenge = newChallenge(t)
+		_, err = rp.VerifyAssertion(auth.get(t, testRpId, "https://evil.example.com", challenge, FLAG_USER_PRESENT), challenge, *cred)
+		if errors.Cause(err) != ErrVerificationFailed {
+			t.Errorf("alg %d assertion from wrong origin: %v", alg, err)
+		}
+		_, err = rp.VerifyAssertion(auth.get(t, testRpId, testOrigin, challenge, 0), challenge, *cred)
+		if errors.Cause(err) != ErrVerificationFailed {
+			t.Errorf("alg %d assertion without user presence: %v", alg, err)
+		}
+
+		resp = auth.get(t, testRpId, testOrigin, challenge, FLAG_USER_PRESENT)
+		sig, _ := DecodeBase64(resp.Response.Signature)
+		sig[len(sig)-1] ^= 0xff
+		resp.Response.Signature = EncodeBase64(sig)
+		_, err = rp.VerifyAssertion(resp, challenge, *cred)
+		if errors.Cause(err) != ErrVerificationFailed {
+			t.Errorf("alg %d assertion with bad signature: %v", alg, err)
+		}
+
+		rp.RequireUserVerification = true
+		_, err = rp.VerifyAssertion(auth.get(t, testRpId, testOrigin, challenge, FLAG_USER_PRESENT), challenge, *cred)
+		if errors.Cause(err) != ErrVerificationFailed {
+			t.Errorf("alg %d assertion without user verification: %v", alg, err)
+		}
+		rp.RequireUserVerification = false
+	}
+}
+
+func TestParseAuthenticatorData(t *testing.T) {
+	auth := newTestAuthenticator(t, COSE_ALG_ES256)
+	data := auth.authData(testRpId, FLAG_USER_PRESENT|FLAG_ATTESTED_DATA, true)
+	authData, err := ParseAuthenticatorData(data)
+	if err != nil {
+		t.Fatalf("ParseAuthenticatorData: %s", err)
+	}
+	if string(authData.CredentialId) != string(auth.credId) || string(authData.PublicKey) != string(auth.coseKey) {
+		t.Errorf("attested credential data mismatch")
+	}
+	if _, err := ParseAuthenticatorData(append(data, 0)); err == nil {
+		t.Errorf("trailing bytes should fail")
+	}
+	if _, err := ParseAuthenticatorData(data[:36]); err == nil {
+		t.Errorf("short data should fail")
+	}
+}
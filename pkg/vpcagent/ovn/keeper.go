@@ -324,17 +324,47 @@ func (keeper *OVNNorthboundKeeper) ClaimNetwork(ctx context.Context, network *ag
 		dhcpopts.Options["dns_server"] = "{223.5.5.5,223.6.6.6}"
 	}
 
-	var (
-		args      []string
-		ocVersion = fmt.Sprintf("%s.%d", network.UpdatedAt, network.UpdateVersion)
-	)
-	allFound, args := cmp(&keeper.DB, ocVersion,
+	irows := []types.IRow{
 		netLs,
 		netRnp,
 		netNrp,
 		netMdp,
 		dhcpopts,
+	}
+
+	var dhcp6opts *ovn_nb.DHCPOptions
+	if network.IsSupportIPv6() && network.GuestGateway6 != "" {
+		// guests get their addresses by stateful dhcpv6, the router
+		// port advertises itself as default router with M flag set
+		netRnp.Networks = append(netRnp.Networks, fmt.Sprintf("%s/%d", network.GuestGateway6, network.GuestIp6Mask))
+		netRnp.Ipv6RaConfigs = map[string]string{
+			"address_mode":  "dhcpv6_stateful",
+			"send_periodic": "true",
+			"mtu":           fmt.Sprintf("%d", mtu),
+		}
+		dhcp6opts = &ovn_nb.DHCPOptions{
+			Cidr: network.GetIP6Prefix(),
+			Options: map[string]string{
+				"server_id": dhcpMac,
+			},
+			ExternalIds: map[string]string{
+				externalKeyOcRef: dhcp6OptRef(network.Id),
+			},
+		}
+		if network.GuestDns6 != "" {
+			dhcp6opts.Options["dns_server"] = "{" + network.GuestDns6 + "}"
+		}
+		if domain := network.GetDomain6(); domain != "" {
+			dhcp6opts.Options["domain_search"] = fmt.Sprintf("%q", domain)
+		}
+		irows = append(irows, dhcp6opts)
+	}
+
+	var (
+		args      []string
+		ocVersion = fmt.Sprintf("%s.%d", network.UpdatedAt, network.UpdateVersion)
 	)
+	allFound, args := cmp(&keeper.DB, ocVersion, irows...)
 	if allFound {
 		return nil
 	}
@@ -343,6 +373,9 @@ func (keeper *OVNNorthboundKeeper) ClaimNetwork(ctx context.Context, network *ag
 	args = append(args, ovnCreateArgs(netNrp, netNrp.Name)...)
 	args = append(args, ovnCreateArgs(netMdp, netMdp.Name)...)
 	args = append(args, ovnCreateArgs(dhcpopts, "dhcpopts")...)
+	if dhcp6opts != nil {
+		args = append(args, ovnCreateArgs(dhcp6opts, "dhcp6opts")...)
+	}
 	args = append(args, "--", "add", "Logical_Switch", netLs.Name, "ports", "@"+netNrp.Name, "@"+netMdp.Name)
 	args = append(args, "--", "add", "Logical_Router", vpcLrName(network.Vpc.Id), "ports", "@"+netRnp.Name)
 	return keeper.cli.Must(ctx, "ClaimNetwork", args)
@@ -405,6 +438,28 @@ func (keeper *OVNNorthboundKeeper) ClaimVpcEipgw(ctx context.Context, vpc *agent
 	return keeper.cli.Must(ctx, "ClaimVpcEipgw", args)
 }
 
+func dhcp6OptRef(networkId string) string {
+	return networkId + "/v6"
+}
+
+// findDhcpOpt returns uuid of the DHCP_Options row referenced by ocRef
+func (keeper *OVNNorthboundKeeper) findDhcpOpt(ctx context.Context, ocRef string) string {
+	dhcpOptQuery := &ovn_nb.DHCPOptions{
+		ExternalIds: map[string]string{
+			externalKeyOcRef: ocRef,
+		},
+	}
+	if m := keeper.DB.DHCPOptions.FindOneMatchNonZeros(dhcpOptQuery); m != nil {
+		return m.OvsdbUuid()
+	}
+	args := []string{
+		"--bare", "--columns=_uuid", "find", "DHCP_Options",
+		fmt.Sprintf("external_ids:%s=%q", externalKeyOcRef, ocRef),
+	}
+	res := keeper.cli.Must(ctx, "find dhcpopt", args)
+	return strings.TrimSpace(res.Output)
+}
+
 func (keeper *OVNNorthboundKeeper) ClaimGuestnetwork(ctx context.Context, guestnetwork *agentmodels.Guestnetwork) error {
 	var (
 		// Callers assure that guestnetwork.Guest is not nil
@@ -421,26 +476,18 @@ func (keeper *OVNNorthboundKeeper) ClaimGuestnetwork(ctx context.Context, guestn
 		ocQosRef        = fmt.Sprintf("qos/%s/%s/%s", network.Id, guestnetwork.GuestId, guestnetwork.Ifname)
 		ocQosEipRef     = fmt.Sprintf("qos-eip/%s/%s/%s/v2", vpc.Id, guestnetwork.GuestId, guestnetwork.Ifname)
 		dhcpOpt         string
+		dhcp6Opt        string
 	)
 
-	{
-		dhcpOptQuery := &ovn_nb.DHCPOptions{
-			ExternalIds: map[string]string{
-				externalKeyOcRef: guestnetwork.NetworkId,
-			},
-		}
-		if m := keeper.DB.DHCPOptions.FindOneMatchNonZeros(dhcpOptQuery); m != nil {
-			dhcpOpt = m.OvsdbUuid()
-		} else {
-			args := []string{
-				"--bare", "--columns=_uuid", "find", "DHCP_Options",
-				fmt.Sprintf("external_ids:%s=%q", externalKeyOcRef, guestnetwork.NetworkId),
-			}
-			res := keeper.cli.Must(ctx, "find dhcpopt", args)
-			dhcpOpt = strings.TrimSpace(res.Output)
-		}
-		if dhcpOpt == "" {
-			return fmt.Errorf("cannot find dhcpopt for subnet %s", guestnetwork.NetworkId)
+	dhcpOpt = keeper.findDhcpOpt(ctx, guestnetwork.NetworkId)
+	if dhcpOpt == "" {
+		return fmt.Errorf("cannot find dhcpopt for subnet %s", guestnetwork.NetworkId)
+	}
+	withIPv6 := guestnetwork.Ip6Addr != "" && network.IsSupportIPv6()
+	if withIPv6 {
+		dhcp6Opt = keeper.findDhcpOpt(ctx, dhcp6OptRef(guestnetwork.NetworkId))
+		if dhcp6Opt == "" {
+			return fmt.Errorf("cannot find dhcpv6 opt for subnet %s", guestnetwork.NetworkId)
 		}
 	}
 
@@ -454,12 +501,19 @@ func (keeper *OVNNorthboundKeeper) ClaimGuestnetwork(ctx context.Context, guestn
 	}
 	sort.Strings(subIPs[1:])
 	sort.Strings(subIPms[1:])
+	if withIPv6 {
+		subIPs = append(subIPs, guestnetwork.Ip6Addr)
+		subIPms = append(subIPms, guestnetwork.Ip6Addr)
+	}
 	gnp := &ovn_nb.LogicalSwitchPort{
 		Name:          lportName,
 		Addresses:     []string{fmt.Sprintf("%s %s", guestnetwork.MacAddr, strings.Join(subIPs, " "))},
 		Dhcpv4Options: &dhcpOpt,
 		Options:       map[string]string{},
 	}
+	if withIPv6 {
+		gnp.Dhcpv6Options = &dhcp6Opt
+	}
 	if guest.SrcMacCheck.IsFalse() {
 		gnp.Addresses = append(gnp.Addresses, "unknown")
 		// empty, not nil, as match condition
@@ -71,10 +71,27 @@ func ruleToAcl(lport string, rule *agentmodels.SecurityGroupRule) (*ovn_nb.ACL,
 		return nil, errors.Wrapf(errBadSecgroupRule, "unknown action %q", rule.Action)
 	}
 
+	// l3proto is "ip4" or "ip6" when the rule cidr names an address
+	// family.  Rules without cidr, or with 0.0.0.0/0 as it was the default
+	// before ipv6 support, apply to both families
+	l3proto := "ip"
+	cidr := strings.TrimSpace(rule.CIDR)
+	if cidr == "0.0.0.0/0" {
+		cidr = ""
+	} else if cidr != "" {
+		if strings.Contains(cidr, ":") {
+			l3proto = "ip6"
+			if cidr == "::/0" {
+				cidr = ""
+			}
+		} else {
+			l3proto = "ip4"
+		}
+	}
 	addL3Match := func() {
-		matches = append(matches, "ip4")
-		if cidr := strings.TrimSpace(rule.CIDR); cidr != "" && cidr != "0.0.0.0/0" {
-			matches = append(matches, fmt.Sprintf("ip4.%s == %s", l3subfn, cidr))
+		matches = append(matches, l3proto)
+		if cidr != "" {
+			matches = append(matches, fmt.Sprintf("%s.%s == %s", l3proto, l3subfn, cidr))
 		}
 	}
 	addL4Match := func(l4proto string) {
@@ -153,7 +170,14 @@ func ruleToAcl(lport string, rule *agentmodels.SecurityGroupRule) (*ovn_nb.ACL,
 		addL4Match("udp")
 	case secrules.PROTO_ICMP:
 		addL3Match()
-		matches = append(matches, "icmp4")
+		switch l3proto {
+		case "ip4":
+			matches = append(matches, "icmp4")
+		case "ip6":
+			matches = append(matches, "icmp6")
+		default:
+			matches = append(matches, "icmp")
+		}
 	default:
 		return nil, errors.Wrapf(errBadSecgroupRule, "unknown protocol %q", rule.Protocol)
 	}
@@ -15,6 +15,7 @@
 package command
 
 import (
+	"io"
 	"os/exec"
 
 	"yunion.io/x/log"
@@ -35,6 +36,21 @@ type ICommand interface {
 	Scan(d byte, send func(msg string))
 }
 
+// IStream is an interactive shell served in process
+type IStream interface {
+	io.ReadWriteCloser
+	Resize(cols, rows uint16) error
+}
+
+// IStreamCommand is a command connected by an in process client instead of
+// a program started on a local pty
+type IStreamCommand interface {
+	ICommand
+	// Connect opens the shell, a nil stream means login info is still
+	// needed from the prompts
+	Connect(cols, rows uint16) (IStream, error)
+}
+
 type BaseCommand struct {
 	name string
 	args []string
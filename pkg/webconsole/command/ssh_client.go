@@ -0,0 +1,364 @@
+// Copyright 2019 Yunion
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package command
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net"
+	"os/exec"
+	"sync"
+	"time"
+
+	"github.com/pkg/sftp"
+	"golang.org/x/crypto/ssh"
+
+	"yunion.io/x/jsonutils"
+	"yunion.io/x/log"
+	"yunion.io/x/pkg/errors"
+
+	api "yunion.io/x/onecloud/pkg/apis/webconsole"
+	"yunion.io/x/onecloud/pkg/httperrors"
+	"yunion.io/x/onecloud/pkg/mcclient"
+	"yunion.io/x/onecloud/pkg/mcclient/auth"
+	"yunion.io/x/onecloud/pkg/mcclient/modules"
+	"yunion.io/x/onecloud/pkg/mcclient/modules/cloudproxy"
+	"yunion.io/x/onecloud/pkg/util/ansible"
+	ssh_util "yunion.io/x/onecloud/pkg/util/ssh"
+	o "yunion.io/x/onecloud/pkg/webconsole/options"
+)
+
+const (
+	ErrNotLoggedIn = errors.Error("ssh session not logged in")
+)
+
+// SSHClient is a ssh session served by the builtin client, it logs in with
+// password, platform keypair or a private key, optionally through jump
+// hosts, and shares the connection with sftp file transfer
+type SSHClient struct {
+	*sLoginPrompt
+	IP   string
+	Port int
+
+	ownerId    string
+	privateKey string
+	jumpHosts  []ssh_util.ClientConfig
+
+	lock    sync.Mutex
+	clients []*ssh.Client
+}
+
+// fetchProxyEndpoint returns ssh config of a cloudproxy endpoint, the
+// endpoint is looked up as the user first so that only visible ones are used
+func fetchProxyEndpoint(ctx context.Context, userCred mcclient.TokenCredential, id string) (ssh_util.ClientConfig, error) {
+	conf := ssh_util.ClientConfig{}
+	s := auth.GetSession(ctx, userCred, o.Options.Region, "")
+	ep, err := cloudproxy.ProxyEndpoints.Get(s, id, nil)
+	if err != nil {
+		return conf, errors.Wrapf(err, "get proxy endpoint %s", id)
+	}
+	epId, _ := ep.GetString("id")
+	adminSession := auth.GetAdminSession(ctx, o.Options.Region, "")
+	ep, err = cloudproxy.ProxyEndpoints.Get(adminSession, epId, nil)
+	if err != nil {
+		return conf, errors.Wrapf(err, "get proxy endpoint %s", epId)
+	}
+	conf.Username, _ = ep.GetString("user")
+	conf.Host, _ = ep.GetString("host")
+	port, _ := ep.Int("port")
+	conf.Port = int(port)
+	conf.PrivateKey, _ = ep.GetString("private_key")
+	return conf, nil
+}
+
+func fetchJumpHosts(ctx context.Context, userCred mcclient.TokenCredential, hosts []api.SshJumpHost) ([]ssh_util.ClientConfig, error) {
+	ret := []ssh_util.ClientConfig{}
+	for _, host := range hosts {
+		var conf ssh_util.ClientConfig
+		if len(host.ProxyEndpoint) > 0 {
+			var err error
+			conf, err = fetchProxyEndpoint(ctx, userCred, host.ProxyEndpoint)
+			if err != nil {
+				return nil, err
+			}
+		} else {
+			if len(host.Host) == 0 || len(host.Username) == 0 {
+				return nil, httperrors.NewMissingParameterError("jump_hosts.host")
+			}
+			conf = ssh_util.ClientConfig{
+				Host:       host.Host,
+				Port:       host.Port,
+				Username:   host.Username,
+				Password:   host.Password,
+				PrivateKey: host.PrivateKey,
+			}
+		}
+		if conf.Port == 0 {
+			conf.Port = 22
+		}
+		ret = append(ret, conf)
+	}
+	return ret, nil
+}
+
+func NewSSHClientCommand(ctx context.Context, userCred mcclient.TokenCredential, ip string, body jsonutils.JSONObject) (*SSHClient, error) {
+	input := api.SSshRequest{}
+	if body != nil {
+		if data, _ := body.Get("webconsole"); data != nil {
+			if err := data.Unmarshal(&input); err != nil {
+				return nil, httperrors.NewInputParameterError("unmarshal ssh request: %v", err)
+			}
+		}
+	}
+	if input.Port == 0 {
+		input.Port = 22
+	}
+	jumpHosts, err := fetchJumpHosts(ctx, userCred, input.JumpHosts)
+	if err != nil {
+		return nil, err
+	}
+	// the target is only reachable from the last jump host
+	if len(jumpHosts) == 0 {
+		conn, err := net.DialTimeout("tcp", fmt.Sprintf("%s:%d", ip, input.Port), time.Second*2)
+		if err != nil {
+			return nil, fmt.Errorf("IPAddress %s:%d not accessible", ip, input.Port)
+		}
+		conn.Close()
+	}
+
+	cmd := &SSHClient{
+		sLoginPrompt: newLoginPrompt(ip),
+		IP:           ip,
+		Port:         input.Port,
+		ownerId:      userCred.GetUserId(),
+		jumpHosts:    jumpHosts,
+	}
+	switch {
+	case len(input.Username) > 0:
+		cmd.username, cmd.password, cmd.privateKey = input.Username, input.Password, input.PrivateKey
+	case o.Options.EnableAutoLogin:
+		s := auth.GetAdminSession(ctx, o.Options.Region, "v2")
+		key, err := modules.Sshkeypairs.GetById(s, userCred.GetProjectId(), jsonutils.Marshal(map[string]bool{"admin": true}))
+		if err != nil {
+			log.Errorf("get project %s keypair: %v", userCred.GetProjectId(), err)
+			break
+		}
+		cmd.privateKey, _ = key.GetString("private_key")
+		if len(cmd.privateKey) > 0 {
+			cmd.username = ansible.PUBLIC_CLOUD_ANSIBLE_USER
+		}
+	}
+	if len(cmd.username) > 0 && (len(cmd.password) > 0 || len(cmd.privateKey) > 0) {
+		cmd.needShowInfo = false
+	}
+	return cmd, nil
+}
+
+func (c *SSHClient) GetOwnerId() string {
+	return c.ownerId
+}
+
+func (c *SSHClient) GetProtocol() string {
+	return PROTOCOL_TTY
+}
+
+func (c *SSHClient) GetCommand() *exec.Cmd {
+	return nil
+}
+
+func (c *SSHClient) Scan(d byte, send func(msg string)) {
+	c.sLoginPrompt.scan(d, send)
+}
+
+func (c *SSHClient) IsNeedShowInfo() bool {
+	return c.needShowInfo
+}
+
+func (c *SSHClient) ShowInfo() string {
+	return c.sLoginPrompt.show()
+}
+
+func (c *SSHClient) Reconnect() {
+	c.close()
+	c.privateKey = ""
+	c.sLoginPrompt.reset()
+}
+
+func (c *SSHClient) Cleanup() error {
+	c.close()
+	return nil
+}
+
+func (c *SSHClient) close() {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	for i := len(c.clients) - 1; i >= 0; i-- {
+		c.clients[i].Close()
+	}
+	c.clients = nil
+}
+
+func (c *SSHClient) dial() (*ssh.Client, error) {
+	target := ssh_util.ClientConfig{
+		Host:       c.IP,
+		Port:       c.Port,
+		Username:   c.username,
+		Password:   c.password,
+		PrivateKey: c.privateKey,
+	}
+	hops := append(append([]ssh_util.ClientConfig{}, c.jumpHosts...), target)
+	clients := []*ssh.Client{}
+	closeAll := func() {
+		for i := len(clients) - 1; i >= 0; i-- {
+			clients[i].Close()
+		}
+	}
+	for _, hop := range hops {
+		conf, err := hop.ToSshConfig()
+		if err != nil {
+			closeAll()
+			return nil, err
+		}
+		addr := net.JoinHostPort(hop.Host, fmt.Sprintf("%d", hop.Port))
+		var client *ssh.Client
+		if len(clients) == 0 {
+			client, err = ssh.Dial("tcp", addr, conf)
+		} else {
+			var conn net.Conn
+			conn, err = clients[len(clients)-1].Dial("tcp", addr)
+			if err == nil {
+				var sshConn ssh.Conn
+				var chans <-chan ssh.NewChannel
+				var reqs <-chan *ssh.Request
+				sshConn, chans, reqs, err = ssh.NewClientConn(conn, addr, conf)
+				if err != nil {
+					conn.Close()
+				} else {
+					client = ssh.NewClient(sshConn, chans, reqs)
+				}
+			}
+		}
+		if err != nil {
+			closeAll()
+			return nil, errors.Wrapf(err, "ssh %s@%s", hop.Username, addr)
+		}
+		clients = append(clients, client)
+	}
+	c.lock.Lock()
+	c.clients = clients
+	c.lock.Unlock()
+	return clients[len(clients)-1], nil
+}
+
+func (c *SSHClient) getClient() *ssh.Client {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	if len(c.clients) == 0 {
+		return nil
+	}
+	return c.clients[len(c.clients)-1]
+}
+
+func (c *SSHClient) Connect(cols, rows uint16) (IStream, error) {
+	if len(c.username) == 0 || (len(c.password) == 0 && len(c.privateKey) == 0) {
+		return nil, nil
+	}
+	client := c.getClient()
+	if client == nil {
+		var err error
+		client, err = c.dial()
+		if err != nil {
+			return nil, err
+		}
+	}
+	if cols == 0 || rows == 0 {
+		cols, rows = 80, 24
+	}
+	return newSSHStream(client, cols, rows)
+}
+
+// NewSftpClient opens a sftp channel on the connection of the shell
+func (c *SSHClient) NewSftpClient() (*sftp.Client, error) {
+	client := c.getClient()
+	if client == nil {
+		return nil, ErrNotLoggedIn
+	}
+	return sftp.NewClient(client)
+}
+
+type sSSHStream struct {
+	session *ssh.Session
+	stdin   io.WriteCloser
+	reader  *io.PipeReader
+}
+
+func newSSHStream(client *ssh.Client, cols, rows uint16) (*sSSHStream, error) {
+	session, err := client.NewSession()
+	if err != nil {
+		return nil, errors.Wrap(err, "new session")
+	}
+	modes := ssh.TerminalModes{
+		ssh.ECHO:          1,
+		ssh.TTY_OP_ISPEED: 14400,
+		ssh.TTY_OP_OSPEED: 14400,
+	}
+	if err := session.RequestPty("xterm-256color", int(rows), int(cols), modes); err != nil {
+		session.Close()
+		return nil, errors.Wrap(err, "request pty")
+	}
+	stdin, err := session.StdinPipe()
+	if err != nil {
+		session.Close()
+		return nil, errors.Wrap(err, "stdin pipe")
+	}
+	reader, writer := io.Pipe()
+	session.Stdout = writer
+	session.Stderr = writer
+	if err := session.Shell(); err != nil {
+		session.Close()
+		return nil, errors.Wrap(err, "start shell")
+	}
+	go func() {
+		err := session.Wait()
+		if err != nil {
+			log.Debugf("ssh session exit: %v", err)
+		}
+		writer.Close()
+	}()
+	return &sSSHStream{
+		session: session,
+		stdin:   stdin,
+		reader:  reader,
+	}, nil
+}
+
+func (s *sSSHStream) Read(p []byte) (int, error) {
+	return s.reader.Read(p)
+}
+
+func (s *sSSHStream) Write(p []byte) (int, error) {
+	return s.stdin.Write(p)
+}
+
+func (s *sSSHStream) Resize(cols, rows uint16) error {
+	return s.session.WindowChange(int(rows), int(cols))
+}
+
+func (s *sSSHStream) Close() error {
+	s.reader.Close()
+	return s.session.Close()
+}
@@ -0,0 +1,235 @@
+// Copyright 2019 Yunion
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package command
+
+import (
+	"fmt"
+	"io"
+	"net"
+	"testing"
+
+	"github.com/pkg/sftp"
+	"golang.org/x/crypto/ssh"
+
+	"yunion.io/x/onecloud/pkg/util/seclib2"
+	ssh_util "yunion.io/x/onecloud/pkg/util/ssh"
+)
+
+// startTestSSHServer serves an echo shell and sftp for password "secret"
+func startTestSSHServer(t *testing.T) (string, int, func()) {
+	privKey, _, err := seclib2.GenerateRSASSHKeypair()
+	if err != nil {
+		t.Fatalf("generate host key: %v", err)
+	}
+	signer, err := ssh.ParsePrivateKey([]byte(privKey))
+	if err != nil {
+		t.Fatalf("parse host key: %v", err)
+	}
+	conf := &ssh.ServerConfig{
+		PasswordCallback: func(c ssh.ConnMetadata, pass []byte) (*ssh.Permissions, error) {
+			if string(pass) == "secret" {
+				return nil, nil
+			}
+			return nil, fmt.Errorf("password rejected for %s", c.User())
+		},
+	}
+	conf.AddHostKey(signer)
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatalf("listen: %v", err)
+	}
+	go func() {
+		for {
+			conn, err := listener.Accept()
+			if err != nil {
+				return
+			}
+			go serveTestSSHConn(conn, conf)
+		}
+	}()
+	addr := listener.Addr().(*net.TCPAddr)
+	return addr.IP.String(), addr.Port, func() { listener.Close() }
+}
+
+func serveTestSSHConn(conn net.Conn, conf *ssh.ServerConfig) {
+	_, chans, reqs, err := ssh.NewServerConn(conn, conf)
+	if err != nil {
+		return
+	}
+	go ssh.DiscardRequests(reqs)
+	for newChan := range chans {
+		if newChan.ChannelType() == "direct-tcpip" {
+			go forwardTestSSHChannel(newChan)
+			continue
+		}
+		if newChan.ChannelType() != "session" {
+			newChan.Reject(ssh.UnknownChannelType, "unsupported")
+			continue
+		}
+		ch, chReqs, err := newChan.Accept()
+		if err != nil {
+			continue
+		}
+		go func() {
+			for req := range chReqs {
+				switch req.Type {
+				case "pty-req", "window-change":
+					req.Reply(true, nil)
+				case "shell":
+					req.Reply(true, nil)
+					go func() {
+						io.Copy(ch, ch)
+						ch.Close()
+					}()
+				case "subsystem":
+					req.Reply(true, nil)
+					go func() {
+						server, err := sftp.NewServer(ch)
+						if err == nil {
+							server.Serve()
+						}
+						ch.Close()
+					}()
+				default:
+					req.Reply(false, nil)
+				}
+			}
+		}()
+	}
+}
+
+func forwardTestSSHChannel(newChan ssh.NewChannel) {
+	target := struct {
+		Host       string
+		Port       uint32
+		OriginHost string
+		OriginPort uint32
+	}{}
+	if err := ssh.Unmarshal(newChan.ExtraData(), &target); err != nil {
+		newChan.Reject(ssh.ConnectionFailed, err.Error())
+		return
+	}
+	conn, err := net.Dial("tcp", net.JoinHostPort(target.Host, fmt.Sprintf("%d", target.Port)))
+	if err != nil {
+		newChan.Reject(ssh.ConnectionFailed, err.Error())
+		return
+	}
+	ch, reqs, err := newChan.Accept()
+	if err != nil {
+		conn.Close()
+		return
+	}
+	go ssh.DiscardRequests(reqs)
+	go func() {
+		io.Copy(conn, ch)
+		conn.Close()
+	}()
+	io.Copy(ch, conn)
+	ch.Close()
+}
+
+func TestSSHClient(t *testing.T) {
+	ip, port, stop := startTestSSHServer(t)
+	defer stop()
+	c := &SSHClient{
+		sLoginPrompt: newLoginPrompt(ip),
+		IP:           ip,
+		Port:         port,
+	}
+
+	if _, err := c.NewSftpClient(); err != ErrNotLoggedIn {
+		t.Fatalf("sftp before login should fail with %v, got %v", ErrNotLoggedIn, err)
+	}
+	send := func(string) {}
+	for _, d := range []byte("root\r") {
+		c.Scan(d, send)
+	}
+	if stream, err := c.Connect(80, 24); stream != nil || err != nil {
+		t.Fatalf("connect without password should wait for prompts, got %v %v", stream, err)
+	}
+	for _, d := range []byte("wrong\r") {
+		c.Scan(d, send)
+	}
+	if _, err := c.Connect(80, 24); err == nil {
+		t.Fatalf("connect with wrong password should fail")
+	}
+
+	c.Reconnect()
+	for _, d := range []byte("root\rsecret\r") {
+		c.Scan(d, send)
+	}
+	stream, err := c.Connect(80, 24)
+	if err != nil {
+		t.Fatalf("connect: %v", err)
+	}
+	defer c.Cleanup()
+	defer stream.Close()
+	if err := stream.Resize(120, 40); err != nil {
+		t.Errorf("resize: %v", err)
+	}
+	if _, err := stream.Write([]byte("hello")); err != nil {
+		t.Fatalf("write: %v", err)
+	}
+	buf := make([]byte, 5)
+	if _, err := io.ReadFull(stream, buf); err != nil || string(buf) != "hello" {
+		t.Errorf("read %q: %v", buf, err)
+	}
+
+	client, err := c.NewSftpClient()
+	if err != nil {
+		t.Fatalf("sftp: %v", err)
+	}
+	defer client.Close()
+	if _, err := client.Getwd(); err != nil {
+		t.Errorf("sftp getwd: %v", err)
+	}
+}
+
+func TestSSHClientJumpHost(t *testing.T) {
+	jumpIp, jumpPort, stopJump := startTestSSHServer(t)
+	defer stopJump()
+	ip, port, stop := startTestSSHServer(t)
+	defer stop()
+	c := &SSHClient{
+		sLoginPrompt: newLoginPrompt(ip),
+		IP:           ip,
+		Port:         port,
+		jumpHosts: []ssh_util.ClientConfig{
+			{Host: jumpIp, Port: jumpPort, Username: "jump", Password: "wrong"},
+		},
+	}
+	c.username, c.password = "root", "secret"
+	if _, err := c.Connect(80, 24); err == nil {
+		t.Fatalf("connect with wrong jump host password should fail")
+	}
+
+	c.jumpHosts[0].Password = "secret"
+	stream, err := c.Connect(80, 24)
+	if err != nil {
+		t.Fatalf("connect through jump host: %v", err)
+	}
+	defer c.Cleanup()
+	defer stream.Close()
+	if len(c.clients) != 2 {
+		t.Errorf("expect 2 ssh clients, got %d", len(c.clients))
+	}
+	if _, err := stream.Write([]byte("hi")); err != nil {
+		t.Fatalf("write: %v", err)
+	}
+	buf := make([]byte, 2)
+	if _, err := io.ReadFull(stream, buf); err != nil || string(buf) != "hi" {
+		t.Errorf("read %q: %v", buf, err)
+	}
+}
@@ -33,19 +33,76 @@ import (
 	o "yunion.io/x/onecloud/pkg/webconsole/options"
 )
 
-type SSHtoolSol struct {
-	*BaseCommand
-	IP           string
-	Port         int
+// sLoginPrompt collects username and password typed in the web terminal
+type sLoginPrompt struct {
 	username     string
 	password     string
 	failed       int
 	showInfo     string
-	keyFile      string
 	buffer       []byte
 	needShowInfo bool
 }
 
+func newLoginPrompt(ip string) *sLoginPrompt {
+	return &sLoginPrompt{
+		showInfo:     fmt.Sprintf("%s login: ", ip),
+		buffer:       []byte{},
+		needShowInfo: true,
+	}
+}
+
+func (c *sLoginPrompt) scan(d byte, send func(msg string)) {
+	switch d {
+	case '\r': // 换行
+		send("\r\n")
+		if len(c.username) == 0 {
+			c.username = string(c.buffer)
+			c.needShowInfo = true
+		} else if len(c.password) == 0 {
+			c.password = string(c.buffer)
+		}
+		c.buffer = []byte{}
+	case '\u007f': // 退格
+		if len(c.buffer) > 1 {
+			c.buffer = c.buffer[:len(c.buffer)-1]
+		}
+		send("\b \b")
+	default:
+		c.buffer = append(c.buffer, d)
+		if len(c.username) == 0 {
+			send(string(d))
+		}
+	}
+}
+
+func (c *sLoginPrompt) reset() {
+	c.needShowInfo, c.username, c.password = true, "", ""
+}
+
+func (c *sLoginPrompt) show() string {
+	c.needShowInfo = false
+	if len(c.username) == 0 {
+		if c.failed >= 3 {
+			c.failed = 0
+			return "\033c " + c.showInfo // 清屏
+		}
+		c.failed++
+		return c.showInfo
+	}
+	if len(c.password) == 0 {
+		return "Password:"
+	}
+	return ""
+}
+
+type SSHtoolSol struct {
+	*BaseCommand
+	*sLoginPrompt
+	IP      string
+	Port    int
+	keyFile string
+}
+
 func getCommand(ctx context.Context, userCred mcclient.TokenCredential, ip string, port int) (string, *BaseCommand, error) {
 	cmd := NewBaseCommand(o.Options.SshToolPath)
 	if !o.Options.EnableAutoLogin {
@@ -108,14 +165,10 @@ func NewSSHtoolSolCommand(ctx context.Context, userCred mcclient.TokenCredential
 
 	return &SSHtoolSol{
 		BaseCommand:  cmd,
+		sLoginPrompt: newLoginPrompt(ip),
 		IP:           ip,
 		Port:         port,
-		username:     "",
-		failed:       0,
-		showInfo:     fmt.Sprintf("%s login: ", ip),
 		keyFile:      keyFile,
-		buffer:       []byte{},
-		needShowInfo: true,
 	}, nil
 }
 
@@ -165,32 +218,11 @@ func (c *SSHtoolSol) Connect() error {
 }
 
 func (c *SSHtoolSol) Scan(d byte, send func(msg string)) {
-	switch d {
-	case '\r': // 换行
-		send("\r\n")
-		if len(c.username) == 0 {
-			c.username = string(c.buffer)
-			c.needShowInfo = true
-		} else if len(c.password) == 0 {
-			c.password = string(c.buffer)
-		}
-		c.buffer = []byte{}
-	case '\u007f': // 退格
-		if len(c.buffer) > 1 {
-			c.buffer = c.buffer[:len(c.buffer)-1]
-		}
-		send("\b \b")
-	default:
-		c.buffer = append(c.buffer, d)
-		if len(c.username) == 0 {
-			send(string(d))
-		}
-	}
-	return
+	c.sLoginPrompt.scan(d, send)
 }
 
 func (c *SSHtoolSol) Reconnect() {
-	c.needShowInfo, c.username, c.password = true, "", ""
+	c.sLoginPrompt.reset()
 }
 
 func (c *SSHtoolSol) IsNeedShowInfo() bool {
@@ -199,17 +231,5 @@ func (c *SSHtoolSol) IsNeedShowInfo() bool {
 
 func (c *SSHtoolSol) ShowInfo() string {
 	c.BaseCommand = nil
-	c.needShowInfo = false
-	if len(c.username) == 0 {
-		if c.failed >= 3 {
-			c.failed = 0
-			return "\033c " + c.showInfo // 清屏
-		}
-		c.failed++
-		return c.showInfo
-	}
-	if len(c.password) == 0 {
-		return "Password:"
-	}
-	return ""
+	return c.sLoginPrompt.show()
 }
@@ -0,0 +1,98 @@
+// Copyright 2019 Yunion
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package guac
+
+import (
+	"fmt"
+	"io"
+	"strings"
+)
+
+// Config describes the connection guacd makes on behalf of the client
+type Config struct {
+	// Protocol is one of rdp, vnc, ssh and telnet supported by guacd
+	Protocol   string
+	Parameters map[string]string
+
+	Width  int
+	Height int
+	Dpi    int
+
+	AudioMimetypes []string
+	VideoMimetypes []string
+	ImageMimetypes []string
+	Timezone       string
+}
+
+func NewConfig(protocol string) *Config {
+	return &Config{
+		Protocol:       protocol,
+		Parameters:     map[string]string{},
+		Width:          1024,
+		Height:         768,
+		Dpi:            96,
+		AudioMimetypes: []string{"audio/L8", "audio/L16"},
+		ImageMimetypes: []string{"image/png", "image/jpeg", "image/webp"},
+	}
+}
+
+// Handshake selects the protocol and connects, guacd answers the parameter
+// names it accepts which are replied in the same order. It returns the id of
+// the connection
+func Handshake(w io.Writer, r *Reader, conf *Config) (string, error) {
+	send := func(ins *Instruction) error {
+		_, err := w.Write(ins.Bytes())
+		return err
+	}
+	if err := send(NewInstruction("select", conf.Protocol)); err != nil {
+		return "", err
+	}
+	args, err := r.Expect("args")
+	if err != nil {
+		return "", err
+	}
+	instructions := []*Instruction{
+		NewInstruction("size", fmt.Sprintf("%d", conf.Width), fmt.Sprintf("%d", conf.Height), fmt.Sprintf("%d", conf.Dpi)),
+		NewInstruction("audio", conf.AudioMimetypes...),
+		NewInstruction("video", conf.VideoMimetypes...),
+		NewInstruction("image", conf.ImageMimetypes...),
+	}
+	if len(conf.Timezone) > 0 {
+		instructions = append(instructions, NewInstruction("timezone", conf.Timezone))
+	}
+	values := make([]string, len(args.Args))
+	for i, name := range args.Args {
+		// protocol version goes first since guacd 1.1.0, echo it back
+		if strings.HasPrefix(name, "VERSION_") {
+			values[i] = name
+			continue
+		}
+		values[i] = conf.Parameters[name]
+	}
+	instructions = append(instructions, NewInstruction("connect", values...))
+	for _, ins := range instructions {
+		if err := send(ins); err != nil {
+			return "", err
+		}
+	}
+	ready, err := r.Expect("ready")
+	if err != nil {
+		return "", err
+	}
+	if len(ready.Args) == 0 {
+		return "", ErrInvalidInstruction
+	}
+	return ready.Args[0], nil
+}
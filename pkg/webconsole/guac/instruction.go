@@ -0,0 +1,236 @@
+// Copyright 2019 Yunion
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package guac
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+
+	"yunion.io/x/pkg/errors"
+)
+
+const (
+	ErrInvalidInstruction = errors.Error("invalid guacamole instruction")
+
+	// INTERNAL_OPCODE is used by tunnels of guacamole-common-js, these
+	// instructions are never sent to guacd
+	INTERNAL_OPCODE = ""
+)
+
+// Instruction is an element list of the guacamole protocol, like
+// 4.size,4.1024,3.768; whose lengths count unicode characters
+type Instruction struct {
+	Opcode string
+	Args   []string
+}
+
+func NewInstruction(opcode string, args ...string) *Instruction {
+	return &Instruction{Opcode: opcode, Args: args}
+}
+
+func encodeElement(sb *strings.Builder, elem string) {
+	sb.WriteString(strconv.Itoa(utf8.RuneCountInString(elem)))
+	sb.WriteByte('.')
+	sb.WriteString(elem)
+}
+
+func (ins *Instruction) String() string {
+	var sb strings.Builder
+	encodeElement(&sb, ins.Opcode)
+	for _, arg := range ins.Args {
+		sb.WriteByte(',')
+		encodeElement(&sb, arg)
+	}
+	sb.WriteByte(';')
+	return sb.String()
+}
+
+func (ins *Instruction) Bytes() []byte {
+	return []byte(ins.String())
+}
+
+// Parse decodes one complete instruction
+func Parse(data []byte) (*Instruction, error) {
+	elems := []string{}
+	rest := string(data)
+	for {
+		pos := strings.IndexByte(rest, '.')
+		if pos <= 0 {
+			return nil, errors.Wrapf(ErrInvalidInstruction, "missing length in %q", data)
+		}
+		length, err := strconv.Atoi(rest[:pos])
+		if err != nil || length < 0 {
+			return nil, errors.Wrapf(ErrInvalidInstruction, "bad length in %q", data)
+		}
+		rest = rest[pos+1:]
+		// length counts characters, walk them to find the byte offset
+		offset := 0
+		for i := 0; i < length; i++ {
+			if offset >= len(rest) {
+				return nil, errors.Wrapf(ErrInvalidInstruction, "short element in %q", data)
+			}
+			_, size := utf8.DecodeRuneInString(rest[offset:])
+			offset += size
+		}
+		if offset >= len(rest) {
+			return nil, errors.Wrapf(ErrInvalidInstruction, "unterminated %q", data)
+		}
+		elems = append(elems, rest[:offset])
+		term := rest[offset]
+		rest = rest[offset+1:]
+		if term == ';' {
+			break
+		}
+		if term != ',' {
+			return nil, errors.Wrapf(ErrInvalidInstruction, "bad separator %q in %q", term, data)
+		}
+	}
+	return &Instruction{Opcode: elems[0], Args: elems[1:]}, nil
+}
+
+// Reader splits a guacamole stream at instruction boundaries
+type Reader struct {
+	reader *bufio.Reader
+}
+
+func NewReader(r io.Reader) *Reader {
+	return &Reader{reader: bufio.NewReaderSize(r, 8192)}
+}
+
+// ReadRaw returns bytes of the next complete instruction
+func (r *Reader) ReadRaw() ([]byte, error) {
+	ret := []byte{}
+	runeBuf := make([]byte, utf8.UTFMax)
+	for {
+		lenStr, err := r.reader.ReadString('.')
+		if err != nil {
+			return nil, err
+		}
+		length, err := strconv.Atoi(lenStr[:len(lenStr)-1])
+		if err != nil || length < 0 {
+			return nil, errors.Wrapf(ErrInvalidInstruction, "bad length %q", lenStr)
+		}
+		ret = append(ret, lenStr...)
+		for i := 0; i < length; i++ {
+			ch, size, err := r.reader.ReadRune()
+			if err != nil {
+				return nil, err
+			}
+			if ch == utf8.RuneError && size == 1 {
+				return nil, errors.Wrap(ErrInvalidInstruction, "invalid utf8")
+			}
+			n := utf8.EncodeRune(runeBuf, ch)
+			ret = append(ret, runeBuf[:n]...)
+		}
+		term, err := r.reader.ReadByte()
+		if err != nil {
+			return nil, err
+		}
+		ret = append(ret, term)
+		switch term {
+		case ';':
+			return ret, nil
+		case ',':
+		default:
+			return nil, errors.Wrapf(ErrInvalidInstruction, "bad separator %q", term)
+		}
+	}
+}
+
+// ReadSome returns complete instructions already buffered, it blocks only
+// for the first one
+func (r *Reader) ReadSome(max int) ([]byte, error) {
+	ret, err := r.ReadRaw()
+	if err != nil {
+		return nil, err
+	}
+	for len(ret) < max && r.reader.Buffered() > 0 {
+		buf, err := r.reader.Peek(r.reader.Buffered())
+		if err != nil || !hasCompleteInstruction(buf) {
+			break
+		}
+		more, err := r.ReadRaw()
+		if err != nil {
+			return nil, err
+		}
+		ret = append(ret, more...)
+	}
+	return ret, nil
+}
+
+// hasCompleteInstruction tells whether buf starts with a whole instruction
+func hasCompleteInstruction(buf []byte) bool {
+	pos := 0
+	for {
+		dot := -1
+		for i := pos; i < len(buf); i++ {
+			if buf[i] == '.' {
+				dot = i
+				break
+			}
+		}
+		if dot < 0 {
+			return false
+		}
+		length, err := strconv.Atoi(string(buf[pos:dot]))
+		if err != nil {
+			return false
+		}
+		pos = dot + 1
+		for i := 0; i < length; i++ {
+			if pos >= len(buf) || !utf8.FullRune(buf[pos:]) {
+				return false
+			}
+			_, size := utf8.DecodeRune(buf[pos:])
+			pos += size
+		}
+		if pos >= len(buf) {
+			return false
+		}
+		if buf[pos] == ';' {
+			return true
+		}
+		pos++
+	}
+}
+
+// Read returns the next instruction decoded
+func (r *Reader) Read() (*Instruction, error) {
+	data, err := r.ReadRaw()
+	if err != nil {
+		return nil, err
+	}
+	return Parse(data)
+}
+
+// Expect reads an instruction and fails unless it has the opcode, error
+// instructions of guacd are converted to errors
+func (r *Reader) Expect(opcode string) (*Instruction, error) {
+	ins, err := r.Read()
+	if err != nil {
+		return nil, err
+	}
+	if ins.Opcode == "error" {
+		return nil, fmt.Errorf("guacd error: %s", strings.Join(ins.Args, " "))
+	}
+	if ins.Opcode != opcode {
+		return nil, errors.Wrapf(ErrInvalidInstruction, "expect %s, got %s", opcode, ins.Opcode)
+	}
+	return ins, nil
+}
@@ -0,0 +1,128 @@
+// Copyright 2019 Yunion
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package guac
+
+import (
+	"bytes"
+	"net"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestInstruction(t *testing.T) {
+	cases := []struct {
+		ins  *Instruction
+		want string
+	}{
+		{NewInstruction("size", "1024", "768"), "4.size,4.1024,3.768;"},
+		{NewInstruction("clipboard", "中文,;"), "9.clipboard,4.中文,;;"},
+		{NewInstruction(INTERNAL_OPCODE, "ping", "123"), "0.,4.ping,3.123;"},
+		{NewInstruction("nop"), "3.nop;"},
+	}
+	for _, c := range cases {
+		if got := c.ins.String(); got != c.want {
+			t.Errorf("String() = %q, want %q", got, c.want)
+		}
+		ins, err := Parse([]byte(c.want))
+		if err != nil {
+			t.Errorf("Parse(%q) error: %v", c.want, err)
+			continue
+		}
+		if ins.Opcode != c.ins.Opcode || len(ins.Args) != len(c.ins.Args) || (len(ins.Args) > 0 && !reflect.DeepEqual(ins.Args, c.ins.Args)) {
+			t.Errorf("Parse(%q) = %#v, want %#v", c.want, ins, c.ins)
+		}
+	}
+	for _, bad := range []string{"", "4.size", "x.size;", "4.size:3.abc;", "10.size;"} {
+		if _, err := Parse([]byte(bad)); err == nil {
+			t.Errorf("Parse(%q) should fail", bad)
+		}
+	}
+}
+
+func TestReader(t *testing.T) {
+	stream := "4.sync,4.1234;9.clipboard,4.中文,;;3.nop;4.size,1."
+	r := NewReader(strings.NewReader(stream))
+	data, err := r.ReadSome(8192)
+	if err != nil {
+		t.Fatalf("ReadSome error: %v", err)
+	}
+	if string(data) != "4.sync,4.1234;9.clipboard,4.中文,;;3.nop;" {
+		t.Errorf("ReadSome = %q", data)
+	}
+	if _, err := r.ReadRaw(); err == nil {
+		t.Errorf("ReadRaw of partial instruction should fail")
+	}
+}
+
+func TestHandshake(t *testing.T) {
+	client, guacd := net.Pipe()
+	defer client.Close()
+	defer guacd.Close()
+
+	received := make(chan []*Instruction, 1)
+	go func() {
+		r := NewReader(guacd)
+		ins, _ := r.Read()
+		got := []*Instruction{ins}
+		guacd.Write(NewInstruction("args", "VERSION_1_1_0", "hostname", "port", "password", "unknown").Bytes())
+		for {
+			ins, err := r.Read()
+			if err != nil {
+				break
+			}
+			got = append(got, ins)
+			if ins.Opcode == "connect" {
+				break
+			}
+		}
+		received <- got
+		guacd.Write(NewInstruction("ready", "$conn-id").Bytes())
+	}()
+
+	conf := NewConfig("rdp")
+	conf.Parameters = map[string]string{"hostname": "10.0.0.2", "port": "3389", "password": "secret"}
+	connId, err := Handshake(client, NewReader(client), conf)
+	if err != nil {
+		t.Fatalf("Handshake error: %v", err)
+	}
+	if connId != "$conn-id" {
+		t.Errorf("connection id = %s", connId)
+	}
+	got := <-received
+	var buf bytes.Buffer
+	for _, ins := range got {
+		buf.WriteString(ins.String())
+	}
+	want := "6.select,3.rdp;4.size,4.1024,3.768,2.96;5.audio,8.audio/L8,9.audio/L16;5.video;5.image,9.image/png,10.image/jpeg,10.image/webp;" +
+		"7.connect,13.VERSION_1_1_0,8.10.0.0.2,4.3389,6.secret,0.;"
+	if buf.String() != want {
+		t.Errorf("handshake sent %q, want %q", buf.String(), want)
+	}
+}
+
+func TestHandshakeError(t *testing.T) {
+	client, guacd := net.Pipe()
+	defer client.Close()
+	defer guacd.Close()
+
+	go func() {
+		NewReader(guacd).Read()
+		guacd.Write(NewInstruction("error", "Protocol not supported", "515").Bytes())
+	}()
+	if _, err := Handshake(client, NewReader(client), NewConfig("nope")); err == nil {
+		t.Errorf("Handshake should fail on guacd error")
+	}
+}
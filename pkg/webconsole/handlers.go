@@ -51,6 +51,8 @@ func InitHandlers(app *appsrv.Application) {
 	app.AddHandler("POST", ApiPathPrefix+"baremetal/<id>", auth.Authenticate(handleBaremetalShell))
 	app.AddHandler("POST", ApiPathPrefix+"ssh/<ip>", auth.Authenticate(handleSshShell))
 	app.AddHandler("POST", ApiPathPrefix+"server/<id>", auth.Authenticate(handleServerRemoteConsole))
+	app.AddHandler("POST", ApiPathPrefix+"server-rdp/<id>", auth.Authenticate(handleServerRdp))
+	initSftpHandlers(app)
 }
 
 func fetchK8sEnv(ctx context.Context, w http.ResponseWriter, r *http.Request) (*command.K8sEnv, error) {
@@ -163,7 +165,12 @@ func handleSshShell(ctx context.Context, w http.ResponseWriter, r *http.Request)
 		httperrors.GeneralServerError(ctx, w, err)
 		return
 	}
-	cmd, err := command.NewSSHtoolSolCommand(ctx, userCred, env.Params["<ip>"], env.Body)
+	var cmd command.ICommand
+	if o.Options.UseSshTool {
+		cmd, err = command.NewSSHtoolSolCommand(ctx, userCred, env.Params["<ip>"], env.Body)
+	} else {
+		cmd, err = command.NewSSHClientCommand(ctx, userCred, env.Params["<ip>"], env.Body)
+	}
 	if err != nil {
 		httperrors.GeneralServerError(ctx, w, err)
 		return
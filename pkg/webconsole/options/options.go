@@ -30,6 +30,8 @@ type WebConsoleOptions struct {
 	SshToolPath       string `help:"sshtool binary path used to connect server sol" default:"/usr/bin/ssh"`
 	SshpassToolPath   string `help:"sshpass tool binary path used to connect server sol" default:"/usr/bin/sshpass"`
 	EnableAutoLogin   bool   `help:"allow webconsole to log in directly with the cloudroot public key" default:"false"`
+	UseSshTool        bool   `help:"connect ssh by spawning sshtool instead of the builtin client" default:"false"`
+	GuacdAddr         string `help:"address of guacd brokering rdp sessions" default:"127.0.0.1:4822"`
 	ApsaraConsoleAddr string `help:"Apsara console addr" default:"https://xxxx.com.cn/module/ecs/vnc/index.html"`
 	AliyunVncVersion  string `help:"Aliyun vnc version" default:"0.0.8"`
 }
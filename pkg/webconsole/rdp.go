@@ -0,0 +1,141 @@
+// Copyright 2019 Yunion
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package webconsole
+
+import (
+	"context"
+	"net/http"
+	"strings"
+
+	"yunion.io/x/jsonutils"
+	"yunion.io/x/log"
+	"yunion.io/x/pkg/errors"
+
+	cloudproxy_api "yunion.io/x/onecloud/pkg/apis/cloudproxy"
+	compute_api "yunion.io/x/onecloud/pkg/apis/compute"
+	webconsole_api "yunion.io/x/onecloud/pkg/apis/webconsole"
+	"yunion.io/x/onecloud/pkg/httperrors"
+	"yunion.io/x/onecloud/pkg/mcclient"
+	"yunion.io/x/onecloud/pkg/mcclient/modules"
+	"yunion.io/x/onecloud/pkg/mcclient/modules/cloudproxy"
+	"yunion.io/x/onecloud/pkg/webconsole/session"
+)
+
+const DEFAULT_RDP_PORT = 3389
+
+// createRdpForward creates a local forward of cloudproxy to the rdp port of
+// the server, the returned cleanup removes it
+func createRdpForward(s *mcclient.ClientSession, serverId string, port int) (string, int, func(), error) {
+	params := jsonutils.NewDict()
+	params.Set("type", jsonutils.NewString(cloudproxy_api.FORWARD_TYPE_LOCAL))
+	params.Set("remote_port", jsonutils.NewInt(int64(port)))
+	params.Set("server_id", jsonutils.NewString(serverId))
+	forward, err := cloudproxy.Forwards.PerformClassAction(s, "create-from-server", params)
+	if err != nil {
+		return "", 0, nil, errors.Wrapf(err, "create local forward to server %s", serverId)
+	}
+	forwardId, _ := forward.GetString("id")
+	cleanup := func() {
+		if _, err := cloudproxy.Forwards.Delete(s, forwardId, nil); err != nil {
+			log.Errorf("delete forward %s: %v", forwardId, err)
+		}
+	}
+	bindPort, _ := forward.Int("bind_port")
+	agentId, _ := forward.GetString("proxy_agent_id")
+	agent, err := cloudproxy.ProxyAgents.Get(s, agentId, nil)
+	if err != nil {
+		cleanup()
+		return "", 0, nil, errors.Wrapf(err, "get proxy agent %s", agentId)
+	}
+	addr, _ := agent.GetString("advertise_addr")
+	return addr, int(bindPort), cleanup, nil
+}
+
+func getServerRdpHost(details *compute_api.ServerDetails) string {
+	for _, ip := range strings.Split(details.IPs, ",") {
+		ip = strings.TrimSpace(ip)
+		if len(ip) > 0 {
+			return ip
+		}
+	}
+	return details.Eip
+}
+
+func handleServerRdp(ctx context.Context, w http.ResponseWriter, r *http.Request) {
+	env, err := fetchCloudEnv(ctx, w, r)
+	if err != nil {
+		httperrors.GeneralServerError(ctx, w, err)
+		return
+	}
+	input := webconsole_api.SServerRdpRequest{}
+	if env.Body != nil {
+		if data, _ := env.Body.Get("webconsole"); data != nil {
+			if err := data.Unmarshal(&input); err != nil {
+				httperrors.InputParameterError(ctx, w, "unmarshal rdp request: %v", err)
+				return
+			}
+		}
+	}
+	if input.Port <= 0 {
+		input.Port = DEFAULT_RDP_PORT
+	}
+
+	srvId := env.Params["<id>"]
+	obj, err := modules.Servers.Get(env.ClientSessin, srvId, nil)
+	if err != nil {
+		httperrors.GeneralServerError(ctx, w, err)
+		return
+	}
+	details := compute_api.ServerDetails{}
+	if err := obj.Unmarshal(&details); err != nil {
+		httperrors.GeneralServerError(ctx, w, err)
+		return
+	}
+	if details.Status != compute_api.VM_RUNNING {
+		httperrors.GeneralServerError(ctx, w, httperrors.NewInvalidStatusError("server %s is not running", details.Name))
+		return
+	}
+
+	// password is only decryptable without keypair, otherwise the user logs
+	// in on the remote login screen
+	var username, password string
+	loginInfo, err := modules.Servers.GetLoginInfo(env.ClientSessin, details.Id, nil)
+	if err != nil {
+		log.Warningf("get login info of server %s: %v", details.Id, err)
+	} else {
+		username, _ = loginInfo.GetString("username")
+		password, _ = loginInfo.GetString("password")
+	}
+
+	host, port := getServerRdpHost(&details), input.Port
+	var cleanup func()
+	if input.UseCloudproxy {
+		host, port, cleanup, err = createRdpForward(env.ClientSessin, details.Id, input.Port)
+		if err != nil {
+			httperrors.GeneralServerError(ctx, w, err)
+			return
+		}
+	}
+	if len(host) == 0 {
+		if cleanup != nil {
+			cleanup()
+		}
+		httperrors.GeneralServerError(ctx, w, httperrors.NewInvalidStatusError("server %s has no ip address", details.Name))
+		return
+	}
+	info := session.NewRdpInfo(host, port, username, password, cleanup)
+	info.Width, info.Height, info.Dpi = input.Width, input.Height, input.Dpi
+	handleDataSession(ctx, info, w, nil, false)
+}
@@ -0,0 +1,186 @@
+// Copyright 2019 Yunion
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package server
+
+import (
+	"fmt"
+	"net"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/gorilla/websocket"
+
+	"yunion.io/x/log"
+
+	"yunion.io/x/onecloud/pkg/webconsole/guac"
+	o "yunion.io/x/onecloud/pkg/webconsole/options"
+	"yunion.io/x/onecloud/pkg/webconsole/session"
+)
+
+const GUACAMOLE_PROTOL = "guacamole"
+
+var guacUpgrader = websocket.Upgrader{
+	ReadBufferSize:  8192,
+	WriteBufferSize: 8192,
+	CheckOrigin: func(r *http.Request) bool {
+		return true
+	},
+	Subprotocols: []string{GUACAMOLE_PROTOL},
+}
+
+// GuacamoleServer tunnels guacamole-common-js clients to guacd, which
+// speaks RDP to the guest
+type GuacamoleServer struct {
+	Session *session.SSession
+	info    *session.RdpInfo
+}
+
+func NewGuacamoleServer(s *session.SSession) (*GuacamoleServer, error) {
+	info := s.ISessionData.(*session.RdpInfo)
+	if info.Host == "" {
+		return nil, fmt.Errorf("Empty remote host")
+	}
+	if info.Port <= 0 {
+		return nil, fmt.Errorf("Invalid remote port: %d", info.Port)
+	}
+	return &GuacamoleServer{
+		Session: s,
+		info:    info,
+	}, nil
+}
+
+func (s *GuacamoleServer) getConfig(r *http.Request) *guac.Config {
+	conf := guac.NewConfig(session.RDP)
+	for _, size := range []struct {
+		key   string
+		value int
+		dest  *int
+	}{
+		{"width", s.info.Width, &conf.Width},
+		{"height", s.info.Height, &conf.Height},
+		{"dpi", s.info.Dpi, &conf.Dpi},
+	} {
+		if val, err := strconv.Atoi(r.URL.Query().Get(size.key)); err == nil && val > 0 {
+			*size.dest = val
+		} else if size.value > 0 {
+			*size.dest = size.value
+		}
+	}
+	conf.Timezone = r.URL.Query().Get("timezone")
+	conf.Parameters = map[string]string{
+		"hostname":    s.info.Host,
+		"port":        strconv.Itoa(s.info.Port),
+		"username":    s.info.Username,
+		"password":    s.info.Password,
+		"domain":      s.info.Domain,
+		"security":    "any",
+		"ignore-cert": "true",
+		// follow the browser window size
+		"resize-method": "display-update",
+		"width":         strconv.Itoa(conf.Width),
+		"height":        strconv.Itoa(conf.Height),
+		"dpi":           strconv.Itoa(conf.Dpi),
+	}
+	return conf
+}
+
+func (s *GuacamoleServer) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	guacdConn, err := net.DialTimeout("tcp", o.Options.GuacdAddr, 10*time.Second)
+	if err != nil {
+		log.Errorf("Connect to guacd %s error: %v", o.Options.GuacdAddr, err)
+		http.Error(w, "guacd not available", http.StatusBadGateway)
+		return
+	}
+	reader := guac.NewReader(guacdConn)
+	connId, err := guac.Handshake(guacdConn, reader, s.getConfig(r))
+	if err != nil {
+		log.Errorf("Handshake with guacd error: %v", err)
+		guacdConn.Close()
+		http.Error(w, err.Error(), http.StatusBadGateway)
+		return
+	}
+	log.Debugf("guacd connection %s to %s:%d ready", connId, s.info.Host, s.info.Port)
+
+	wsConn, err := guacUpgrader.Upgrade(w, r, nil)
+	if err != nil {
+		log.Errorf("New websocket connection error: %v", err)
+		guacdConn.Close()
+		return
+	}
+	// guacamole-common-js waits for the tunnel uuid before anything else
+	uuid := guac.NewInstruction(guac.INTERNAL_OPCODE, connId)
+	if err := wsConn.WriteMessage(websocket.TextMessage, uuid.Bytes()); err != nil {
+		log.Errorf("Write tunnel uuid error: %v", err)
+		s.onExit(wsConn, guacdConn)
+		return
+	}
+	s.Session.RegisterDuplicateHook(func() {
+		wsConn.Close()
+		guacdConn.Close()
+	})
+	go s.wsToGuacd(wsConn, guacdConn)
+	s.guacdToWs(wsConn, guacdConn, reader)
+}
+
+func (s *GuacamoleServer) wsToGuacd(wsConn *websocket.Conn, guacdConn net.Conn) {
+	defer s.onExit(wsConn, guacdConn)
+
+	for {
+		_, data, err := wsConn.ReadMessage()
+		if err != nil {
+			log.Debugf("Read from websocket error: %v", err)
+			return
+		}
+		// internal instructions are handled by the tunnel, pings are
+		// answered to keep the client alive
+		if len(data) > 2 && string(data[:2]) == "0." {
+			if ins, err := guac.Parse(data); err == nil && len(ins.Args) > 0 && ins.Args[0] == "ping" {
+				if err := wsConn.WriteMessage(websocket.TextMessage, data); err != nil {
+					log.Errorf("Write ping to websocket error: %v", err)
+					return
+				}
+			}
+			continue
+		}
+		if _, err := guacdConn.Write(data); err != nil {
+			log.Errorf("Write to guacd error: %v", err)
+			return
+		}
+	}
+}
+
+func (s *GuacamoleServer) guacdToWs(wsConn *websocket.Conn, guacdConn net.Conn, reader *guac.Reader) {
+	defer s.onExit(wsConn, guacdConn)
+
+	for {
+		// every websocket message must hold whole instructions
+		data, err := reader.ReadSome(8192)
+		if err != nil {
+			log.Debugf("Read from guacd error: %v", err)
+			return
+		}
+		if err := wsConn.WriteMessage(websocket.TextMessage, data); err != nil {
+			log.Errorf("Write to websocket error: %v", err)
+			return
+		}
+	}
+}
+
+func (s *GuacamoleServer) onExit(wsConn *websocket.Conn, guacdConn net.Conn) {
+	wsConn.Close()
+	guacdConn.Close()
+	s.Session.Close()
+}
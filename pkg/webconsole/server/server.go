@@ -59,6 +59,8 @@ func (s *ConnectionServer) ServeHTTP(w http.ResponseWriter, req *http.Request) {
 		srv, err = NewWebsockifyServer(sessionObj)
 	case session.WMKS:
 		srv, err = NewWebsocketProxyServer(sessionObj)
+	case session.RDP:
+		srv, err = NewGuacamoleServer(sessionObj)
 	default:
 		srv, err = NewTTYServer(sessionObj)
 	}
@@ -103,21 +103,16 @@ func initSocketHandler(so socketio.Socket, p *session.Pty) {
 					}
 				})
 			}
-			cmd := p.Session.GetCommand()
-			if cmd != nil {
-				pty, err := pty.Start(cmd)
-				if err != nil {
-					log.Errorf("failed to start cmd: %v, error: %v", cmd, err)
-					so.Emit(OUTPUT_EVENT, err.Error()+"\r\n")
-					return
-				}
-				p.Pty, p.Cmd = pty, cmd
-				if p.OriginSize != nil {
-					p.Resize(p.OriginSize)
-				}
+			if err := p.StartShell(); err != nil {
+				log.Errorf("failed to start shell: %v", err)
+				so.Emit(OUTPUT_EVENT, err.Error()+"\r\n")
+				p.Session.Reconnect()
+				return
 			}
 		} else {
-			p.Pty.Write([]byte(data))
+			if err := p.Write([]byte(data)); err != nil {
+				log.Errorf("[%s] write data error: %v", so.Id(), err)
+			}
 		}
 	})
 
@@ -57,7 +57,11 @@ func StartService() {
 		log.Fatalf("invalid --api-server %s", opts.ApiServer)
 	}
 
-	for _, binPath := range []string{opts.IpmitoolPath, opts.SshToolPath, opts.SshpassToolPath} {
+	binPaths := []string{opts.IpmitoolPath}
+	if opts.UseSshTool {
+		binPaths = append(binPaths, opts.SshToolPath, opts.SshpassToolPath)
+	}
+	for _, binPath := range binPaths {
 		ensureBinExists(binPath)
 	}
 
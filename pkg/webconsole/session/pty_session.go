@@ -24,12 +24,15 @@ import (
 
 	"yunion.io/x/log"
 	"yunion.io/x/pkg/errors"
+
+	"yunion.io/x/onecloud/pkg/webconsole/command"
 )
 
 type Pty struct {
 	Session    *SSession
 	Cmd        *exec.Cmd
 	Pty        *os.File
+	Stream     command.IStream
 	sizeCh     chan os.Signal
 	size       *pty.Winsize
 	OriginSize *pty.Winsize
@@ -44,8 +47,17 @@ func NewPty(session *SSession) (p *Pty, err error) {
 		Exit:    false,
 		Pty:     nil,
 	}
-	log.Debugf("[session %s] Start command: %#v", session.Id, cmd)
-	if cmd != nil {
+	if streamCmd, ok := session.GetStreamCommand(); ok {
+		p.Cmd = nil
+		p.Stream, err = streamCmd.Connect(0, 0)
+		if err != nil {
+			// fallback to login prompts
+			log.Errorf("connect stream error: %v", err)
+			p.Stream, err = nil, nil
+			session.Reconnect()
+		}
+	} else if cmd != nil {
+		log.Debugf("[session %s] Start command: %#v", session.Id, cmd)
 		p.Pty, err = pty.Start(p.Cmd)
 		if err != nil {
 			log.Errorf("start cmd error: %v", err)
@@ -61,17 +73,57 @@ func NewPty(session *SSession) (p *Pty, err error) {
 }
 
 func (p *Pty) IsInShellMode() bool {
+	if p.Stream != nil {
+		return true
+	}
 	if p.Cmd == nil || p.Cmd.Process == nil {
 		return false
 	}
 	return true
 }
 
+// StartShell starts the shell once login info is collected, it does
+// nothing until the command is ready
+func (p *Pty) StartShell() error {
+	if streamCmd, ok := p.Session.GetStreamCommand(); ok {
+		var cols, rows uint16
+		if p.OriginSize != nil {
+			cols, rows = p.OriginSize.Cols, p.OriginSize.Rows
+		}
+		stream, err := streamCmd.Connect(cols, rows)
+		if err != nil {
+			return errors.Wrap(err, "connect")
+		}
+		p.Stream = stream
+		return nil
+	}
+	cmd := p.Session.GetCommand()
+	if cmd == nil {
+		return nil
+	}
+	ptmx, err := pty.Start(cmd)
+	if err != nil {
+		return errors.Wrapf(err, "start cmd %v", cmd)
+	}
+	p.Pty, p.Cmd = ptmx, cmd
+	if p.OriginSize != nil {
+		p.Resize(p.OriginSize)
+	}
+	return nil
+}
+
 func (p *Pty) Read() ([]byte, error) {
 	if !p.IsInShellMode() {
 		return nil, errors.Error("not in shell mode")
 	}
 	buf := make([]byte, 1024)
+	if p.Stream != nil {
+		n, err := p.Stream.Read(buf)
+		if err != nil {
+			return nil, errors.Wrap(err, "Stream.Read")
+		}
+		return buf[0:n], nil
+	}
 	n, err := p.Pty.Read(buf)
 	if err != nil {
 		return nil, errors.Wrap(err, "Pty.Read")
@@ -79,6 +131,16 @@ func (p *Pty) Read() ([]byte, error) {
 	return buf[0:n], nil
 }
 
+func (p *Pty) Write(data []byte) error {
+	var err error
+	if p.Stream != nil {
+		_, err = p.Stream.Write(data)
+	} else if p.Pty != nil {
+		_, err = p.Pty.Write(data)
+	}
+	return err
+}
+
 func (p *Pty) startResizeMonitor() {
 	go func() {
 		for range p.sizeCh {
@@ -95,6 +157,13 @@ func (p *Pty) startResizeMonitor() {
 }
 
 func (p *Pty) Resize(size *pty.Winsize) {
+	if p.Stream != nil {
+		if err := p.Stream.Resize(size.Cols, size.Rows); err != nil {
+			log.Errorf("Resize stream error: %v", err)
+		}
+		p.OriginSize = size
+		return
+	}
 	p.size = size
 	p.sizeCh <- syscall.SIGWINCH
 }
@@ -103,7 +172,7 @@ func (p *Pty) Stop() (err error) {
 	var errs []error
 
 	defer func() {
-		p.Cmd, p.Pty = nil, nil
+		p.Cmd, p.Pty, p.Stream = nil, nil, nil
 	}()
 
 	defer func() {
@@ -132,6 +201,13 @@ func (p *Pty) Stop() (err error) {
 			}
 		}
 	}()
+	defer func() {
+		if p.Stream != nil {
+			if err := p.Stream.Close(); err != nil {
+				log.Errorf("Close stream error: %v", err)
+			}
+		}
+	}()
 	defer func() {
 		if p.Pty != nil {
 			err := p.Pty.Close()
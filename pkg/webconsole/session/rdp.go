@@ -0,0 +1,94 @@
+// Copyright 2019 Yunion
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package session
+
+import (
+	"os/exec"
+
+	"yunion.io/x/pkg/util/stringutils"
+)
+
+// RdpInfo is a RDP session brokered by guacd, credentials are only sent to
+// guacd and never to the browser
+type RdpInfo struct {
+	id string
+
+	Host     string
+	Port     int
+	Username string
+	Password string
+	Domain   string
+
+	Width  int
+	Height int
+	Dpi    int
+
+	cleanup func()
+}
+
+func NewRdpInfo(host string, port int, username, password string, cleanup func()) *RdpInfo {
+	return &RdpInfo{
+		id:       stringutils.UUID4(),
+		Host:     host,
+		Port:     port,
+		Username: username,
+		Password: password,
+		cleanup:  cleanup,
+	}
+}
+
+// GetId implements ISessionData interface
+func (info *RdpInfo) GetId() string {
+	return info.id
+}
+
+// GetProtocol implements ISessionData interface
+func (info *RdpInfo) GetProtocol() string {
+	return RDP
+}
+
+// GetCommand implements ISessionData interface
+func (info *RdpInfo) GetCommand() *exec.Cmd {
+	return nil
+}
+
+// Cleanup implements ISessionData interface
+func (info *RdpInfo) Cleanup() error {
+	if info.cleanup != nil {
+		info.cleanup()
+		info.cleanup = nil
+	}
+	return nil
+}
+
+// IsNeedShowInfo implements ISessionData interface
+func (info *RdpInfo) IsNeedShowInfo() bool {
+	return false
+}
+
+// Reconnect implements ISessionData interface
+func (info *RdpInfo) Reconnect() {
+	return
+}
+
+// Scan implements ISessionData interface
+func (info *RdpInfo) Scan(byte, func(string)) {
+	return
+}
+
+// ShowInfo implements ISessionData interface
+func (info *RdpInfo) ShowInfo() string {
+	return ""
+}
@@ -37,6 +37,7 @@ const (
 	CTYUN     = api.CTYUN
 	HUAWEI    = api.HUAWEI
 	APSARA    = api.APSARA
+	RDP       = api.RDP
 )
 
 type RemoteConsoleInfo struct {
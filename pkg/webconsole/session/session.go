@@ -118,6 +118,20 @@ func (s *RandomSessionData) GetId() string {
 	return s.id
 }
 
+// UnwrapCommand returns the command of the session, or nil for sessions of
+// remote consoles
+func (s *SSession) UnwrapCommand() command.ICommand {
+	if data, ok := s.ISessionData.(*RandomSessionData); ok {
+		return data.ICommand
+	}
+	return nil
+}
+
+func (s *SSession) GetStreamCommand() (command.IStreamCommand, bool) {
+	cmd, ok := s.UnwrapCommand().(command.IStreamCommand)
+	return cmd, ok
+}
+
 type SSession struct {
 	ISessionData
 	Id            string
@@ -0,0 +1,205 @@
+// Copyright 2019 Yunion
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package webconsole
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"path"
+	"strconv"
+
+	"github.com/pkg/sftp"
+
+	"yunion.io/x/jsonutils"
+	"yunion.io/x/log"
+
+	webconsole_api "yunion.io/x/onecloud/pkg/apis/webconsole"
+	"yunion.io/x/onecloud/pkg/appctx"
+	"yunion.io/x/onecloud/pkg/appsrv"
+	"yunion.io/x/onecloud/pkg/cloudcommon/policy"
+	"yunion.io/x/onecloud/pkg/httperrors"
+	"yunion.io/x/onecloud/pkg/mcclient/auth"
+	"yunion.io/x/onecloud/pkg/webconsole/command"
+	"yunion.io/x/onecloud/pkg/webconsole/session"
+)
+
+var sftpWorkerMan = appsrv.NewWorkerManager("sftp_worker", 8, 1024, false)
+
+func initSftpHandlers(app *appsrv.Application) {
+	prefix := ApiPathPrefix + "sftp/<session_id>"
+	app.AddHandler("GET", prefix+"/list", auth.Authenticate(handleSftpList))
+	for _, hi := range []*appsrv.SHandlerInfo{
+		app.AddHandler("GET", prefix+"/download", auth.Authenticate(handleSftpDownload)),
+		app.AddHandler("POST", prefix+"/upload", auth.Authenticate(handleSftpUpload)),
+	} {
+		hi.SetProcessNoTimeout().SetWorkerManager(sftpWorkerMan)
+	}
+}
+
+// getSftpClient opens sftp on the ssh connection of a webconsole session
+// owned by the request user
+func getSftpClient(ctx context.Context) (*sftp.Client, error) {
+	userCred := auth.FetchUserCredential(ctx, policy.FilterPolicyCredential)
+	if userCred == nil {
+		return nil, httperrors.NewUnauthorizedError("No token founded")
+	}
+	sessionId := appctx.AppContextParams(ctx)["<session_id>"]
+	obj, ok := session.Manager.Load(sessionId)
+	if !ok {
+		return nil, httperrors.NewNotFoundError("session %s not found", sessionId)
+	}
+	cmd, ok := obj.(*session.SSession).UnwrapCommand().(*command.SSHClient)
+	if !ok || cmd.GetOwnerId() != userCred.GetUserId() {
+		return nil, httperrors.NewNotFoundError("ssh session %s not found", sessionId)
+	}
+	client, err := cmd.NewSftpClient()
+	if err != nil {
+		if err == command.ErrNotLoggedIn {
+			return nil, httperrors.NewInvalidStatusError("session %s is not logged in", sessionId)
+		}
+		return nil, httperrors.NewGeneralError(err)
+	}
+	return client, nil
+}
+
+func handleSftpList(ctx context.Context, w http.ResponseWriter, r *http.Request) {
+	_, query, _ := appsrv.FetchEnv(ctx, w, r)
+	client, err := getSftpClient(ctx)
+	if err != nil {
+		httperrors.GeneralServerError(ctx, w, err)
+		return
+	}
+	defer client.Close()
+
+	dir, _ := query.GetString("path")
+	if len(dir) == 0 {
+		dir, err = client.Getwd()
+		if err != nil {
+			httperrors.GeneralServerError(ctx, w, err)
+			return
+		}
+	}
+	infos, err := client.ReadDir(dir)
+	if err != nil {
+		httperrors.GeneralServerError(ctx, w, httperrors.NewNotFoundError("read dir %s: %v", dir, err))
+		return
+	}
+	files := []webconsole_api.SftpFileInfo{}
+	for _, info := range infos {
+		files = append(files, webconsole_api.SftpFileInfo{
+			Name:    info.Name(),
+			Size:    info.Size(),
+			Mode:    info.Mode().String(),
+			ModTime: info.ModTime(),
+			IsDir:   info.IsDir(),
+		})
+	}
+	ret := jsonutils.NewDict()
+	ret.Add(jsonutils.NewString(dir), "path")
+	ret.Add(jsonutils.Marshal(files), "files")
+	sendJSON(w, ret)
+}
+
+func handleSftpDownload(ctx context.Context, w http.ResponseWriter, r *http.Request) {
+	_, query, _ := appsrv.FetchEnv(ctx, w, r)
+	filePath, _ := query.GetString("path")
+	if len(filePath) == 0 {
+		httperrors.MissingParameterError(ctx, w, "path")
+		return
+	}
+	client, err := getSftpClient(ctx)
+	if err != nil {
+		httperrors.GeneralServerError(ctx, w, err)
+		return
+	}
+	defer client.Close()
+
+	f, err := client.Open(filePath)
+	if err != nil {
+		httperrors.GeneralServerError(ctx, w, httperrors.NewNotFoundError("open %s: %v", filePath, err))
+		return
+	}
+	defer f.Close()
+	info, err := f.Stat()
+	if err != nil {
+		httperrors.GeneralServerError(ctx, w, err)
+		return
+	}
+	if info.IsDir() {
+		httperrors.InputParameterError(ctx, w, "%s is a directory", filePath)
+		return
+	}
+	w.Header().Set("Content-Type", "application/octet-stream")
+	w.Header().Set("Content-Length", strconv.FormatInt(info.Size(), 10))
+	w.Header().Set("Content-Disposition", fmt.Sprintf("attachment; filename=%q", path.Base(filePath)))
+	if _, err := io.Copy(w, f); err != nil {
+		log.Errorf("sftp download %s: %v", filePath, err)
+	}
+}
+
+// handleSftpUpload saves files of a multipart form into the directory given
+// by path
+func handleSftpUpload(ctx context.Context, w http.ResponseWriter, r *http.Request) {
+	dir := r.URL.Query().Get("path")
+	if len(dir) == 0 {
+		httperrors.MissingParameterError(ctx, w, "path")
+		return
+	}
+	reader, err := r.MultipartReader()
+	if err != nil {
+		httperrors.InputParameterError(ctx, w, "invalid multipart form: %v", err)
+		return
+	}
+	client, err := getSftpClient(ctx)
+	if err != nil {
+		httperrors.GeneralServerError(ctx, w, err)
+		return
+	}
+	defer client.Close()
+
+	uploaded := []string{}
+	for {
+		part, err := reader.NextPart()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			httperrors.InputParameterError(ctx, w, "read multipart form: %v", err)
+			return
+		}
+		if len(part.FileName()) == 0 {
+			continue
+		}
+		target := path.Join(dir, path.Base(part.FileName()))
+		f, err := client.OpenFile(target, os.O_WRONLY|os.O_CREATE|os.O_TRUNC)
+		if err != nil {
+			httperrors.GeneralServerError(ctx, w, httperrors.NewForbiddenError("create %s: %v", target, err))
+			return
+		}
+		_, err = io.Copy(f, part)
+		f.Close()
+		if err != nil {
+			httperrors.GeneralServerError(ctx, w, httperrors.NewGeneralError(err))
+			return
+		}
+		uploaded = append(uploaded, target)
+	}
+	ret := jsonutils.NewDict()
+	ret.Add(jsonutils.NewStringArray(uploaded), "files")
+	sendJSON(w, ret)
+}
@@ -24,6 +24,8 @@ import (
 func InitHandlers(app *appsrv.Application) {
 	db.InitAllManagers()
 
+	db.AddOpenApiHandler("", app)
+
 	db.AddScopeResourceCountHandler("", app)
 
 	for _, manager := range []db.IModelManager{